@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/gin-gonic/gin"
 )
 
@@ -15,22 +18,38 @@ import (
 type FacadeHandler interface {
 	GetBalance(address string) (*big.Int, error)
 	GetAccount(address string) (*state.Account, error)
+	GetTokenBalances(address string) (map[string]*big.Int, error)
+	GetSCDeployment(address string) (*process.SCDeploymentInfo, error)
+	GetSCStorageDiff(address string, fromNonce uint64, toNonce uint64) (*external.SCStorageDiff, error)
+	GetValidatorEarnings(address string, fromEpoch uint32, toEpoch uint32) (*external.ValidatorEarnings, error)
 	IsInterfaceNil() bool
 }
 
 type accountResponse struct {
-	Address  string `json:"address"`
-	Nonce    uint64 `json:"nonce"`
-	Balance  string `json:"balance"`
-	Code     string `json:"code"`
-	CodeHash []byte `json:"codeHash"`
-	RootHash []byte `json:"rootHash"`
+	Address      string             `json:"address"`
+	Nonce        uint64             `json:"nonce"`
+	Balance      string             `json:"balance"`
+	Code         string             `json:"code"`
+	CodeHash     []byte             `json:"codeHash"`
+	CodeMetadata state.CodeMetadata `json:"codeMetadata"`
+	RootHash     []byte             `json:"rootHash"`
+	Tokens       map[string]string  `json:"tokens"`
+}
+
+type scDeploymentResponse struct {
+	Address      string `json:"address"`
+	Deployer     string `json:"deployer"`
+	DeployTxHash string `json:"deployTxHash"`
+	DeployEpoch  uint32 `json:"deployEpoch"`
 }
 
 // Routes defines address related routes
 func Routes(router *gin.RouterGroup) {
 	router.GET("/:address", GetAccount)
 	router.GET("/:address/balance", GetBalance)
+	router.GET("/:address/deployment", GetSCDeployment)
+	router.GET("/:address/storage-diff", GetSCStorageDiff)
+	router.GET("/:address/earnings", GetValidatorEarnings)
 }
 
 // GetAccount returns an accountResponse containing information
@@ -48,7 +67,14 @@ func GetAccount(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error())})
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"account": accountResponseFromBaseAccount(addr, acc)})
+
+	tokenBalances, err := ef.GetTokenBalances(addr)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"account": accountResponseFromBaseAccount(addr, acc, tokenBalances)})
 }
 
 // GetBalance returns the balance for the address parameter
@@ -74,13 +100,121 @@ func GetBalance(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"balance": balance})
 }
 
-func accountResponseFromBaseAccount(address string, account *state.Account) accountResponse {
+// GetSCDeployment returns the deployer, the deploy transaction hash and the deploy epoch of the smart contract
+// having the provided address. Only the smart contracts deployed in the node's shard are known
+func GetSCDeployment(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	addr := c.Param("address")
+	deployment, err := ef.GetSCDeployment(addr)
+	if err == process.ErrSCDeploymentsIndexDisabled {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetSCDeployment.Error(), err.Error())})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetSCDeployment.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"deployment": scDeploymentResponse{
+		Address:      addr,
+		Deployer:     hex.EncodeToString(deployment.Deployer),
+		DeployTxHash: hex.EncodeToString(deployment.DeployTxHash),
+		DeployEpoch:  deployment.Epoch,
+	}})
+}
+
+// GetSCStorageDiff returns the storage keys of the smart contract having the provided address whose values changed
+// between the blocks having the fromNonce and toNonce query parameters
+func GetSCStorageDiff(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	fromNonce, err := strconv.ParseUint(c.Query("fromNonce"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetSCStorageDiff.Error(), errors.ErrInvalidNonce.Error())})
+		return
+	}
+	toNonce, err := strconv.ParseUint(c.Query("toNonce"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetSCStorageDiff.Error(), errors.ErrInvalidNonce.Error())})
+		return
+	}
+	if fromNonce > toNonce {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetSCStorageDiff.Error(), errors.ErrInvalidNoncesRange.Error())})
+		return
+	}
+
+	diff, err := ef.GetSCStorageDiff(c.Param("address"), fromNonce, toNonce)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetSCStorageDiff.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"storageDiff": diff})
+}
+
+// GetValidatorEarnings returns the rewards credited to the rewards address in each epoch between the fromEpoch and
+// toEpoch query parameters, together with their totals
+func GetValidatorEarnings(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	fromEpoch, err := strconv.ParseUint(c.Query("fromEpoch"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetValidatorEarnings.Error(), errors.ErrInvalidEpoch.Error())})
+		return
+	}
+	toEpoch, err := strconv.ParseUint(c.Query("toEpoch"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetValidatorEarnings.Error(), errors.ErrInvalidEpoch.Error())})
+		return
+	}
+	if fromEpoch > toEpoch {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetValidatorEarnings.Error(), errors.ErrInvalidEpochsRange.Error())})
+		return
+	}
+
+	earnings, err := ef.GetValidatorEarnings(c.Param("address"), uint32(fromEpoch), uint32(toEpoch))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotGetValidatorEarnings.Error(), err.Error())})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"earnings": earnings})
+}
+
+func accountResponseFromBaseAccount(
+	address string,
+	account *state.Account,
+	tokenBalances map[string]*big.Int,
+) accountResponse {
+	// the code metadata is validated at deploy time, an account holding an invalid one is reported with no flag set
+	codeMetadata, _ := state.CodeMetadataFromBytes(account.CodeMetadata)
+
+	tokens := make(map[string]string, len(tokenBalances))
+	for tokenName, balance := range tokenBalances {
+		tokens[tokenName] = balance.String()
+	}
+
 	return accountResponse{
-		Address:  address,
-		Nonce:    account.Nonce,
-		Balance:  account.Balance.String(),
-		Code:     hex.EncodeToString(account.GetCode()),
-		CodeHash: account.CodeHash,
-		RootHash: account.RootHash,
+		Address:      address,
+		Nonce:        account.Nonce,
+		Balance:      account.Balance.String(),
+		Code:         hex.EncodeToString(account.GetCode()),
+		CodeHash:     account.CodeHash,
+		CodeMetadata: codeMetadata,
+		RootHash:     account.RootHash,
+		Tokens:       tokens,
 	}
 }
@@ -16,6 +16,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -45,12 +47,14 @@ func init() {
 type AccountResponse struct {
 	GeneralResponse
 	Account struct {
-		Address  string `json:"address"`
-		Nonce    uint64 `json:"nonce"`
-		Balance  string `json:"balance"`
-		Code     string `json:"code"`
-		CodeHash []byte `json:"codeHash"`
-		RootHash []byte `json:"rootHash"`
+		Address      string             `json:"address"`
+		Nonce        uint64             `json:"nonce"`
+		Balance      string             `json:"balance"`
+		Code         string             `json:"code"`
+		CodeHash     []byte             `json:"codeHash"`
+		CodeMetadata state.CodeMetadata `json:"codeMetadata"`
+		RootHash     []byte             `json:"rootHash"`
+		Tokens       map[string]string  `json:"tokens"`
 	} `json:"account"`
 }
 
@@ -233,6 +237,407 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, accountResponse.Error)
 }
 
+func TestGetAccount_ReturnsCodeMetadata(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		GetAccountHandler: func(address string) (*state.Account, error) {
+			return &state.Account{
+				Balance:      big.NewInt(0),
+				CodeHash:     []byte("code hash"),
+				CodeMetadata: []byte{state.MetadataUpgradeable | state.MetadataReadable},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, state.CodeMetadata{Upgradeable: true, Readable: true}, accountResponse.Account.CodeMetadata)
+}
+
+func TestGetAccount_ReturnsTokens(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		GetAccountHandler: func(address string) (*state.Account, error) {
+			return &state.Account{Balance: big.NewInt(0)}, nil
+		},
+		GetTokenBalancesHandler: func(address string) (map[string]*big.Int, error) {
+			return map[string]*big.Int{
+				"token1": big.NewInt(10),
+				"token2": big.NewInt(20),
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, map[string]string{"token1": "10", "token2": "20"}, accountResponse.Account.Tokens)
+}
+
+func TestGetAccount_FailWhenFacadeGetTokenBalancesFails(t *testing.T) {
+	t.Parallel()
+	returnedError := "i am an error"
+	facade := mock.Facade{
+		GetAccountHandler: func(address string) (*state.Account, error) {
+			return &state.Account{Balance: big.NewInt(0)}, nil
+		},
+		GetTokenBalancesHandler: func(address string) (map[string]*big.Int, error) {
+			return nil, errors.New(returnedError)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := AccountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(accountResponse.Error, returnedError))
+}
+
+type scDeploymentResponse struct {
+	GeneralResponse
+	Deployment struct {
+		Address      string `json:"address"`
+		Deployer     string `json:"deployer"`
+		DeployTxHash string `json:"deployTxHash"`
+		DeployEpoch  uint32 `json:"deployEpoch"`
+	} `json:"deployment"`
+}
+
+func TestGetSCDeployment_FailsWithWrongFacadeTypeConversion(t *testing.T) {
+	t.Parallel()
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/address/aabb/deployment", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := scDeploymentResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors2.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestGetSCDeployment_IndexDisabledShouldReturnServiceUnavailable(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		GetSCDeploymentHandler: func(address string) (*process.SCDeploymentInfo, error) {
+			return nil, process.ErrSCDeploymentsIndexDisabled
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/aabb/deployment", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := scDeploymentResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.True(t, strings.Contains(response.Error, process.ErrSCDeploymentsIndexDisabled.Error()))
+}
+
+func TestGetSCDeployment_UnknownContractShouldReturnNotFound(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		GetSCDeploymentHandler: func(address string) (*process.SCDeploymentInfo, error) {
+			return nil, errors.New("key not found")
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/aabb/deployment", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := scDeploymentResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors2.ErrCouldNotGetSCDeployment.Error()))
+}
+
+func TestGetSCDeployment_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		GetSCDeploymentHandler: func(address string) (*process.SCDeploymentInfo, error) {
+			return &process.SCDeploymentInfo{
+				Deployer:     []byte{0x01, 0x02},
+				DeployTxHash: []byte{0x03, 0x04},
+				Epoch:        5,
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/aabb/deployment", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := scDeploymentResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "aabb", response.Deployment.Address)
+	assert.Equal(t, "0102", response.Deployment.Deployer)
+	assert.Equal(t, "0304", response.Deployment.DeployTxHash)
+	assert.Equal(t, uint32(5), response.Deployment.DeployEpoch)
+	assert.Empty(t, response.Error)
+}
+
+type scStorageDiffResponse struct {
+	GeneralResponse
+	StorageDiff external.SCStorageDiff `json:"storageDiff"`
+}
+
+func TestGetSCStorageDiff_FailsWithWrongFacadeTypeConversion(t *testing.T) {
+	t.Parallel()
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/address/aabb/storage-diff?fromNonce=1&toNonce=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := scStorageDiffResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors2.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestGetSCStorageDiff_InvalidNoncesShouldReturnBadRequest(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		GetSCStorageDiffHandler: func(address string, fromNonce uint64, toNonce uint64) (*external.SCStorageDiff, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	urls := []string{
+		"/address/aabb/storage-diff?toNonce=2",
+		"/address/aabb/storage-diff?fromNonce=1",
+		"/address/aabb/storage-diff?fromNonce=a&toNonce=2",
+	}
+	for _, url := range urls {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := scStorageDiffResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, errors2.ErrInvalidNonce.Error()))
+	}
+}
+
+func TestGetSCStorageDiff_InvalidNoncesRangeShouldReturnBadRequest(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		GetSCStorageDiffHandler: func(address string, fromNonce uint64, toNonce uint64) (*external.SCStorageDiff, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/aabb/storage-diff?fromNonce=3&toNonce=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := scStorageDiffResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors2.ErrInvalidNoncesRange.Error()))
+}
+
+func TestGetSCStorageDiff_FacadeErrorShouldReturnNotFound(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		GetSCStorageDiffHandler: func(address string, fromNonce uint64, toNonce uint64) (*external.SCStorageDiff, error) {
+			return nil, errors.New("header not found")
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/aabb/storage-diff?fromNonce=1&toNonce=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := scStorageDiffResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors2.ErrCouldNotGetSCStorageDiff.Error()))
+	assert.True(t, strings.Contains(response.Error, "header not found"))
+}
+
+func TestGetSCStorageDiff_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+	diff := &external.SCStorageDiff{
+		Address:      "aabb",
+		FromNonce:    1,
+		ToNonce:      2,
+		FromRootHash: "01",
+		ToRootHash:   "02",
+		Changes: []external.StorageKeyChange{
+			{Key: "0a", OldValue: "01", NewValue: "02"},
+			{Key: "0b", NewValue: "03"},
+		},
+	}
+	facade := mock.Facade{
+		GetSCStorageDiffHandler: func(address string, fromNonce uint64, toNonce uint64) (*external.SCStorageDiff, error) {
+			assert.Equal(t, "aabb", address)
+			assert.Equal(t, uint64(1), fromNonce)
+			assert.Equal(t, uint64(2), toNonce)
+			return diff, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/aabb/storage-diff?fromNonce=1&toNonce=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := scStorageDiffResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, *diff, response.StorageDiff)
+	assert.Empty(t, response.Error)
+}
+
+type validatorEarningsResponse struct {
+	GeneralResponse
+	Earnings external.ValidatorEarnings `json:"earnings"`
+}
+
+func TestGetValidatorEarnings_FailsWithWrongFacadeTypeConversion(t *testing.T) {
+	t.Parallel()
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/address/aabb/earnings?fromEpoch=1&toEpoch=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := validatorEarningsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors2.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestGetValidatorEarnings_InvalidEpochsShouldReturnBadRequest(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		GetValidatorEarningsHandler: func(address string, fromEpoch uint32, toEpoch uint32) (*external.ValidatorEarnings, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	urls := []string{
+		"/address/aabb/earnings?toEpoch=2",
+		"/address/aabb/earnings?fromEpoch=1",
+		"/address/aabb/earnings?fromEpoch=a&toEpoch=2",
+		"/address/aabb/earnings?fromEpoch=1&toEpoch=4294967296",
+	}
+	for _, url := range urls {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := validatorEarningsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, errors2.ErrInvalidEpoch.Error()))
+	}
+}
+
+func TestGetValidatorEarnings_InvalidEpochsRangeShouldReturnBadRequest(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		GetValidatorEarningsHandler: func(address string, fromEpoch uint32, toEpoch uint32) (*external.ValidatorEarnings, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/aabb/earnings?fromEpoch=3&toEpoch=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := validatorEarningsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors2.ErrInvalidEpochsRange.Error()))
+}
+
+func TestGetValidatorEarnings_FacadeErrorShouldReturnNotFound(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		GetValidatorEarningsHandler: func(address string, fromEpoch uint32, toEpoch uint32) (*external.ValidatorEarnings, error) {
+			return nil, errors.New("miniblock not found")
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/aabb/earnings?fromEpoch=1&toEpoch=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := validatorEarningsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors2.ErrCouldNotGetValidatorEarnings.Error()))
+	assert.True(t, strings.Contains(response.Error, "miniblock not found"))
+}
+
+func TestGetValidatorEarnings_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+	earnings := &external.ValidatorEarnings{
+		Address:   "aabb",
+		FromEpoch: 1,
+		ToEpoch:   2,
+		Epochs: []external.EpochEarnings{
+			{Epoch: 1, NumRewardTxs: 2, Rewards: "30"},
+			{Epoch: 2, NumRewardTxs: 1, Rewards: "12"},
+		},
+		NumRewardTxs: 3,
+		TotalRewards: "42",
+	}
+	facade := mock.Facade{
+		GetValidatorEarningsHandler: func(address string, fromEpoch uint32, toEpoch uint32) (*external.ValidatorEarnings, error) {
+			assert.Equal(t, "aabb", address)
+			assert.Equal(t, uint32(1), fromEpoch)
+			assert.Equal(t, uint32(2), toEpoch)
+			return earnings, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/aabb/earnings?fromEpoch=1&toEpoch=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := validatorEarningsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, *earnings, response.Earnings)
+	assert.Empty(t, response.Error)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
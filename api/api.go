@@ -7,10 +7,21 @@ import (
 	"reflect"
 
 	"github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/api/diagnostics"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/export"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/network"
 	"github.com/ElrondNetwork/elrond-go/api/node"
+	"github.com/ElrondNetwork/elrond-go/api/p2p"
+	"github.com/ElrondNetwork/elrond-go/api/pools"
+	"github.com/ElrondNetwork/elrond-go/api/state"
+	"github.com/ElrondNetwork/elrond-go/api/storage"
+	"github.com/ElrondNetwork/elrond-go/api/subscription"
+	"github.com/ElrondNetwork/elrond-go/api/tracing"
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-contrib/pprof"
 	"github.com/gin-gonic/gin"
@@ -38,6 +49,7 @@ type MainApiHandler interface {
 	PrometheusMonitoring() bool
 	PrometheusJoinURL() string
 	PrometheusNetworkID() string
+	AdminApiToken() string
 	IsInterfaceNil() bool
 }
 
@@ -70,6 +82,35 @@ func Start(elrondFacade MainApiHandler) error {
 	return ws.Run(fmt.Sprintf(":%s", elrondFacade.RestApiPort()))
 }
 
+// StartSeeder will boot up the reduced api used by a node started in seeder mode. Only the status metrics and,
+// if enabled, the prometheus metrics are exposed
+func StartSeeder(restApiPort string, statusMetrics external.StatusMetricsHandler, prometheusMonitoring bool) error {
+	if statusMetrics == nil || statusMetrics.IsInterfaceNil() {
+		return errors.ErrNilStatusMetrics
+	}
+
+	ws := gin.New()
+	ws.Use(gin.Recovery())
+	gin.SetMode(gin.ReleaseMode)
+	ws.Use(cors.Default())
+
+	nodeRoutes := ws.Group("/node")
+	nodeRoutes.GET("/status", func(c *gin.Context) {
+		details, err := statusMetrics.StatusMetricsMap()
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"details": details})
+	})
+	if prometheusMonitoring {
+		nodeRoutes.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	}
+
+	return ws.Run(fmt.Sprintf(":%s", restApiPort))
+}
+
 func joinMonitoringSystem(elrondFacade MainApiHandler) error {
 	prometheusJoinUrl := elrondFacade.PrometheusJoinURL()
 	structToSend := prometheus{
@@ -102,14 +143,31 @@ func registerRoutes(ws *gin.Engine, elrondFacade middleware.ElrondHandler) {
 	nodeRoutes.Use(middleware.WithElrondFacade(elrondFacade))
 	node.Routes(nodeRoutes)
 
+	shardFilter, isShardFilter := elrondFacade.(middleware.ShardFilterHandler)
+	isShardFilterEnabled := isShardFilter && shardFilter.ShardFilterEnabled()
+
 	addressRoutes := ws.Group("/address")
+	if isShardFilterEnabled {
+		addressRoutes.Use(middleware.WithShardFilter(shardFilter, middleware.AddressFromParam("address")))
+	}
 	addressRoutes.Use(middleware.WithElrondFacade(elrondFacade))
 	address.Routes(addressRoutes)
 
 	txRoutes := ws.Group("/transaction")
+	if isShardFilterEnabled {
+		txRoutes.Use(middleware.WithShardFilter(shardFilter, middleware.AddressFromBodyField("sender")))
+	}
 	txRoutes.Use(middleware.WithElrondFacade(elrondFacade))
 	transaction.Routes(txRoutes)
 
+	networkRoutes := ws.Group("/network")
+	networkRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+	network.Routes(networkRoutes)
+
+	subscriptionRoutes := ws.Group("/subscription")
+	subscriptionRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+	subscription.Routes(subscriptionRoutes)
+
 	vmValuesRoutes := ws.Group("/vm-values")
 	vmValuesRoutes.Use(middleware.WithElrondFacade(elrondFacade))
 	vmValues.Routes(vmValuesRoutes)
@@ -122,6 +180,44 @@ func registerRoutes(ws *gin.Engine, elrondFacade middleware.ElrondHandler) {
 	if apiHandler.PprofEnabled() {
 		pprof.Register(ws)
 	}
+
+	adminToken := apiHandler.AdminApiToken()
+	if len(adminToken) > 0 {
+		storageRoutes := ws.Group("/admin/storage")
+		storageRoutes.Use(middleware.WithAdminToken(adminToken))
+		storageRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+		storage.Routes(storageRoutes)
+
+		poolsRoutes := ws.Group("/admin/pools")
+		poolsRoutes.Use(middleware.WithAdminToken(adminToken))
+		poolsRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+		pools.Routes(poolsRoutes)
+
+		tracingRoutes := ws.Group("/admin/tracing")
+		tracingRoutes.Use(middleware.WithAdminToken(adminToken))
+		tracingRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+		tracing.Routes(tracingRoutes)
+
+		p2pRoutes := ws.Group("/admin/p2p")
+		p2pRoutes.Use(middleware.WithAdminToken(adminToken))
+		p2pRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+		p2p.Routes(p2pRoutes)
+
+		diagnosticsRoutes := ws.Group("/admin/diagnostics")
+		diagnosticsRoutes.Use(middleware.WithAdminToken(adminToken))
+		diagnosticsRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+		diagnostics.Routes(diagnosticsRoutes)
+
+		stateRoutes := ws.Group("/admin/state")
+		stateRoutes.Use(middleware.WithAdminToken(adminToken))
+		stateRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+		state.Routes(stateRoutes)
+
+		exportRoutes := ws.Group("/admin/export")
+		exportRoutes.Use(middleware.WithAdminToken(adminToken))
+		exportRoutes.Use(middleware.WithElrondFacade(elrondFacade))
+		export.Routes(exportRoutes)
+	}
 }
 
 func registerValidators() error {
@@ -0,0 +1,31 @@
+package diagnostics
+
+import (
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-gonic/gin"
+)
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	DumpDiagnostics() *external.DiagnosticsReport
+	IsInterfaceNil() bool
+}
+
+// Routes defines the diagnostics routes. They are meant to be registered behind the admin token middleware
+func Routes(router *gin.RouterGroup) {
+	router.POST("/dump", Dump)
+}
+
+// Dump writes the node's diagnostics report to the log and returns it, so it can be attached to a support ticket
+func Dump(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"report": ef.DumpDiagnostics()})
+}
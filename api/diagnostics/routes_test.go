@@ -0,0 +1,133 @@
+package diagnostics_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/diagnostics"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/json"
+	"github.com/stretchr/testify/assert"
+)
+
+const adminToken = "admin token"
+
+type DumpResponse struct {
+	Report *external.DiagnosticsReport `json:"report"`
+	Error  string                      `json:"error"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServer(handler diagnostics.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	diagnosticsRoutes := ws.Group("/admin/diagnostics")
+	diagnosticsRoutes.Use(middleware.WithAdminToken(adminToken))
+	if handler != nil {
+		diagnosticsRoutes.Use(middleware.WithElrondFacade(handler))
+	}
+	diagnostics.Routes(diagnosticsRoutes)
+
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	diagnosticsRoutes := ws.Group("/admin/diagnostics")
+	diagnostics.Routes(diagnosticsRoutes)
+
+	return ws
+}
+
+func newAdminRequest(token string) *http.Request {
+	req, _ := http.NewRequest("POST", "/admin/diagnostics/dump", nil)
+	req.Header.Set(middleware.AdminTokenHeader, token)
+
+	return req
+}
+
+func TestDump_WithoutTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		DumpDiagnosticsHandler: func() *external.DiagnosticsReport {
+			assert.Fail(t, "should have not called this")
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest(""))
+
+	response := DumpResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Equal(t, apiErrors.ErrUnauthorized.Error(), response.Error)
+}
+
+func TestDump_WithWrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest(adminToken))
+
+	response := DumpResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestDump_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	report := &external.DiagnosticsReport{
+		Timestamp:         10,
+		Topics:            []external.TopicStatistics{{Topic: "transactions_0", NumReceived: 10, NumRejected: 2}},
+		Pools:             []external.PoolOccupancy{{Pool: external.TransactionsPoolName, CacheId: "0", NumEntries: 5}},
+		Throttlers:        []external.ThrottlerSaturation{{Topic: "transactions_0", NumProcessing: 1, MaxNumProcessing: 100}},
+		PendingBroadcasts: []external.PendingBroadcasts{{Channel: "transactions_0", NumPending: 4}},
+	}
+	facade := mock.Facade{
+		DumpDiagnosticsHandler: func() *external.DiagnosticsReport {
+			return report
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest(adminToken))
+
+	response := DumpResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, report, response.Report)
+}
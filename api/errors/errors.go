@@ -10,6 +10,9 @@ var ErrInvalidAppContext = errors.New("invalid app context")
 // ErrCouldNotGetAccount signals that a requested account could not be retrieved
 var ErrCouldNotGetAccount = errors.New("could not get requested account")
 
+// ErrCouldNotGetSCDeployment signals that the deployment of a requested smart contract could not be retrieved
+var ErrCouldNotGetSCDeployment = errors.New("could not get requested smart contract deployment")
+
 // ErrGetBalance signals an error in getting the balance for an account
 var ErrGetBalance = errors.New("get balance error")
 
@@ -51,3 +54,69 @@ var ErrGetTransaction = errors.New("transaction getting failed")
 
 // ErrTxNotFound signals an error happened trying to fetch a transaction
 var ErrTxNotFound = errors.New("transaction was not found")
+
+// ErrNilStatusMetrics signals that a nil status metrics handler has been provided
+var ErrNilStatusMetrics = errors.New("nil status metrics handler")
+
+// ErrInvalidHexKey signals that the provided storage key is not a valid hex string
+var ErrInvalidHexKey = errors.New("invalid key, could not decode hex value")
+
+// ErrUnauthorized signals that a request was made to an admin route without the correct admin token
+var ErrUnauthorized = errors.New("unauthorized")
+
+// ErrNilGasPriceStats signals that the gas price statistics are not available on this node
+var ErrNilGasPriceStats = errors.New("gas price statistics are not available")
+
+// ErrNilConfigFingerprint signals that the configuration fingerprint is not available on this node
+var ErrNilConfigFingerprint = errors.New("configuration fingerprint is not available")
+
+// ErrInvalidShardId signals that an invalid shard id was provided
+var ErrInvalidShardId = errors.New("invalid shard id")
+
+// ErrGasPriceStatsNotFound signals that no gas price statistics were recorded for the requested shard
+var ErrGasPriceStatsNotFound = errors.New("no gas price statistics recorded for the requested shard")
+
+// ErrInvalidEpoch signals that an invalid epoch was provided
+var ErrInvalidEpoch = errors.New("invalid epoch")
+
+// ErrInvalidPubKeyHex signals that the provided public key could not be hex decoded
+var ErrInvalidPubKeyHex = errors.New("invalid public key, could not decode hex value")
+
+// ErrAddressFromOtherShard signals that a request refers to an address from a shard that is not served by this node
+var ErrAddressFromOtherShard = errors.New("address belongs to a shard not served by this node")
+
+// ErrAccountsSubscriptionsDisabled signals that the subscriptions to the accounts changes are not enabled on this node
+var ErrAccountsSubscriptionsDisabled = errors.New("accounts subscriptions are not enabled on this node")
+
+// ErrInvalidPeerId signals that the provided peer id could not be base58 decoded
+var ErrInvalidPeerId = errors.New("invalid peer id, could not decode base58 value")
+
+// ErrInvalidRootHash signals that the provided root hash could not be hex decoded
+var ErrInvalidRootHash = errors.New("invalid root hash, could not decode hex value")
+
+// ErrInvalidStartAddress signals that the provided start address could not be hex decoded
+var ErrInvalidStartAddress = errors.New("invalid start address, could not decode hex value")
+
+// ErrInvalidLimit signals that an invalid limit was provided
+var ErrInvalidLimit = errors.New("invalid limit")
+
+// ErrCouldNotGetSCStorageDiff signals that the storage changes of a smart contract could not be computed
+var ErrCouldNotGetSCStorageDiff = errors.New("could not get the smart contract storage changes")
+
+// ErrCouldNotGetValidatorEarnings signals that the rewards credited to a rewards address could not be computed
+var ErrCouldNotGetValidatorEarnings = errors.New("could not get the validator earnings")
+
+// ErrInvalidEpochsRange signals that the provided start epoch is greater than the end epoch
+var ErrInvalidEpochsRange = errors.New("invalid epochs range, the start epoch is greater than the end epoch")
+
+// ErrInvalidNonce signals that an invalid block nonce was provided
+var ErrInvalidNonce = errors.New("invalid nonce")
+
+// ErrInvalidNoncesRange signals that the provided start nonce is greater than the end nonce
+var ErrInvalidNoncesRange = errors.New("invalid nonces range, the start nonce is greater than the end nonce")
+
+// ErrUnsupportedExportFormat signals that the requested export format is not supported
+var ErrUnsupportedExportFormat = errors.New("unsupported export format")
+
+// ErrCouldNotExportChain signals that the requested chain export could not be done
+var ErrCouldNotExportChain = errors.New("could not export the chain data")
@@ -0,0 +1,120 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-gonic/gin"
+)
+
+// CsvContentType is the content type of the CSV exports
+const CsvContentType = "text/csv"
+
+// FormatCsv is the name of the CSV export format, the only one currently supported
+const FormatCsv = "csv"
+
+// ErrorTrailer is the HTTP trailer holding the error that interrupted an export after its first rows were sent
+const ErrorTrailer = "X-Export-Error"
+
+const rowsPerFlush = 100
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	ExportChain(table string, fromNonce uint64, toNonce uint64, handler func(row []string) error) error
+	IsInterfaceNil() bool
+}
+
+// Routes defines the chain export routes. They are meant to be registered behind the admin token middleware
+func Routes(router *gin.RouterGroup) {
+	router.GET("/:table", ExportTable)
+}
+
+// ExportTable streams, as CSV, the rows of the blocks, transactions or scrs table for the blocks of the node's shard
+// between the fromNonce and toNonce query parameters, both included. The first line holds the column names. An
+// error occurring after the first rows were sent is reported in the X-Export-Error trailer
+func ExportTable(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	format := c.DefaultQuery("format", FormatCsv)
+	if format != FormatCsv {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrUnsupportedExportFormat.Error(), format)})
+		return
+	}
+
+	table := c.Param("table")
+	columns, err := external.ExportColumns(table)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotExportChain.Error(), err.Error())})
+		return
+	}
+
+	fromNonce, err := strconv.ParseUint(c.Query("fromNonce"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotExportChain.Error(), errors.ErrInvalidNonce.Error())})
+		return
+	}
+	toNonce, err := strconv.ParseUint(c.Query("toNonce"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotExportChain.Error(), errors.ErrInvalidNonce.Error())})
+		return
+	}
+	if fromNonce > toNonce {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotExportChain.Error(), errors.ErrInvalidNoncesRange.Error())})
+		return
+	}
+
+	writer := csv.NewWriter(c.Writer)
+	fileName := fmt.Sprintf("%s_%d_%d.%s", table, fromNonce, toNonce, FormatCsv)
+	numRows := 0
+	err = ef.ExportChain(table, fromNonce, toNonce, func(row []string) error {
+		if numRows == 0 {
+			errStart := startStream(c, writer, fileName, columns)
+			if errStart != nil {
+				return errStart
+			}
+		}
+
+		errWrite := writer.Write(row)
+		if errWrite != nil {
+			return errWrite
+		}
+
+		numRows++
+		if numRows%rowsPerFlush == 0 {
+			writer.Flush()
+			c.Writer.Flush()
+		}
+
+		return nil
+	})
+	if err != nil && numRows == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrCouldNotExportChain.Error(), err.Error())})
+		return
+	}
+
+	if numRows == 0 {
+		_ = startStream(c, writer, fileName, columns)
+	}
+	writer.Flush()
+	if err != nil {
+		c.Writer.Header().Set(ErrorTrailer, err.Error())
+	}
+	c.Writer.Flush()
+}
+
+func startStream(c *gin.Context, writer *csv.Writer, fileName string, columns []string) error {
+	c.Header("Content-Type", CsvContentType)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	c.Header("Trailer", ErrorTrailer)
+	c.Status(http.StatusOK)
+
+	return writer.Write(columns)
+}
@@ -0,0 +1,214 @@
+package export_test
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/export"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+const adminToken = "admin token"
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServer(handler export.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	exportRoutes := ws.Group("/admin/export")
+	exportRoutes.Use(middleware.WithAdminToken(adminToken))
+	if handler != nil {
+		exportRoutes.Use(middleware.WithElrondFacade(handler))
+	}
+	export.Routes(exportRoutes)
+
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	exportRoutes := ws.Group("/admin/export")
+	export.Routes(exportRoutes)
+
+	return ws
+}
+
+func newAdminRequest(url string, token string) *http.Request {
+	req, _ := http.NewRequest("GET", url, nil)
+	req.Header.Set(middleware.AdminTokenHeader, token)
+
+	return req
+}
+
+func createFacadeWithRows(rows [][]string, err error) *mock.Facade {
+	return &mock.Facade{
+		ExportChainHandler: func(table string, fromNonce uint64, toNonce uint64, handler func(row []string) error) error {
+			for _, row := range rows {
+				errHandler := handler(row)
+				if errHandler != nil {
+					return errHandler
+				}
+			}
+			return err
+		},
+	}
+}
+
+func requireBadRequest(t *testing.T, url string, expectedErr error) {
+	ws := startNodeServer(createFacadeWithRows(nil, nil))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest(url, adminToken))
+
+	response := ErrorResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestExportTable_WithoutTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	req, _ := http.NewRequest("GET", "/admin/export/blocks?fromNonce=1&toNonce=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := ErrorResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Equal(t, apiErrors.ErrUnauthorized.Error(), response.Error)
+}
+
+func TestExportTable_WithWrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/export/blocks?fromNonce=1&toNonce=2", adminToken))
+
+	response := ErrorResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestExportTable_InvalidQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	requireBadRequest(t, "/admin/export/blocks?fromNonce=1&toNonce=2&format=parquet", apiErrors.ErrUnsupportedExportFormat)
+	requireBadRequest(t, "/admin/export/accounts?fromNonce=1&toNonce=2", external.ErrUnknownExportTable)
+	requireBadRequest(t, "/admin/export/blocks?fromNonce=a&toNonce=2", apiErrors.ErrInvalidNonce)
+	requireBadRequest(t, "/admin/export/blocks?fromNonce=1", apiErrors.ErrInvalidNonce)
+	requireBadRequest(t, "/admin/export/blocks?fromNonce=3&toNonce=2", apiErrors.ErrInvalidNoncesRange)
+}
+
+func TestExportTable_ErrorBeforeFirstRowShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("export in progress")
+	ws := startNodeServer(createFacadeWithRows(nil, errExpected))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/export/blocks?fromNonce=1&toNonce=2", adminToken))
+
+	response := ErrorResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errExpected.Error()))
+}
+
+func TestExportTable_ShouldStreamCsv(t *testing.T) {
+	t.Parallel()
+
+	rows := [][]string{{"1", "a,b"}, {"2", "c"}}
+	facade := createFacadeWithRows(rows, nil)
+	facade.ExportChainHandler = func(table string, fromNonce uint64, toNonce uint64, handler func(row []string) error) error {
+		assert.Equal(t, external.ExportTableSCRs, table)
+		assert.Equal(t, uint64(1), fromNonce)
+		assert.Equal(t, uint64(2), toNonce)
+		return createFacadeWithRows(rows, nil).ExportChainHandler(table, fromNonce, toNonce, handler)
+	}
+	ws := startNodeServer(facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/export/scrs?fromNonce=1&toNonce=2&format=csv", adminToken))
+
+	reader := csv.NewReader(resp.Body)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	assert.Nil(t, err)
+	columns, _ := external.ExportColumns(external.ExportTableSCRs)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, export.CsvContentType, resp.Header().Get("Content-Type"))
+	assert.True(t, strings.Contains(resp.Header().Get("Content-Disposition"), "scrs_1_2.csv"))
+	assert.Equal(t, append([][]string{columns}, rows...), records)
+	assert.Equal(t, "", resp.Result().Trailer.Get(export.ErrorTrailer))
+}
+
+func TestExportTable_NoRowsShouldWriteTheColumns(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(createFacadeWithRows(nil, nil))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/export/blocks?fromNonce=1&toNonce=2", adminToken))
+
+	reader := csv.NewReader(resp.Body)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	assert.Nil(t, err)
+	columns, _ := external.ExportColumns(external.ExportTableBlocks)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, [][]string{columns}, records)
+}
+
+func TestExportTable_ErrorAfterFirstRowShouldSetTheTrailer(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("missing block")
+	ws := startNodeServer(createFacadeWithRows([][]string{{"1"}}, errExpected))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/export/blocks?fromNonce=1&toNonce=2", adminToken))
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, errExpected.Error(), resp.Result().Trailer.Get(export.ErrorTrailer))
+}
@@ -1,9 +1,16 @@
 package middleware
 
 import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/gin-gonic/gin"
 )
 
+// AdminTokenHeader is the request header that has to hold the admin token when calling admin routes
+const AdminTokenHeader = "X-Admin-Token"
+
 // ElrondHandler interface defines methods that can be used from `elrondFacade` context variable
 type ElrondHandler interface {
 }
@@ -15,3 +22,18 @@ func WithElrondFacade(elrondFacade ElrondHandler) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// WithAdminToken middleware will reject all requests that do not carry the provided admin token. An empty
+// token rejects every request
+func WithAdminToken(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		providedToken := c.GetHeader(AdminTokenHeader)
+		isAuthorized := len(token) > 0 && subtle.ConstantTimeCompare([]byte(providedToken), []byte(token)) == 1
+		if !isAuthorized {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": errors.ErrUnauthorized.Error()})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// ShardFilterHandler defines what the shard filter middleware needs from the facade
+type ShardFilterHandler interface {
+	ShardFilterEnabled() bool
+	ComputeShardIdOfAddress(address string) (uint32, error)
+	IsShardServed(shardId uint32) bool
+	ShardObserverURL(shardId uint32) (string, bool)
+	ProxyOtherShardsRequests() bool
+}
+
+// AddressExtractor returns the address a request refers to or an empty string if the request is not address based
+type AddressExtractor func(c *gin.Context) string
+
+// RedirectHint is sent back, together with the error, when a request refers to an address from a shard that is not
+// served by this node
+type RedirectHint struct {
+	ShardId uint32 `json:"shardId"`
+	URL     string `json:"url,omitempty"`
+}
+
+// AddressFromParam returns an AddressExtractor reading the address from the provided route parameter
+func AddressFromParam(paramName string) AddressExtractor {
+	return func(c *gin.Context) string {
+		return c.Param(paramName)
+	}
+}
+
+// AddressFromBodyField returns an AddressExtractor reading the address from the provided field of the JSON request
+// body. The body is restored so that it can be read again by the route handler
+func AddressFromBodyField(fieldName string) AddressExtractor {
+	return func(c *gin.Context) string {
+		if c.Request.Body == nil {
+			return ""
+		}
+
+		buff, err := ioutil.ReadAll(c.Request.Body)
+		if err != nil {
+			return ""
+		}
+		c.Request.Body = ioutil.NopCloser(bytes.NewBuffer(buff))
+
+		fields := make(map[string]interface{})
+		err = json.Unmarshal(buff, &fields)
+		if err != nil {
+			return ""
+		}
+
+		address, _ := fields[fieldName].(string)
+		return address
+	}
+}
+
+// WithShardFilter middleware will let through only the requests referring to addresses from the shards served by
+// this node. The other requests are forwarded to the observer configured for the address' shard, if proxying is
+// enabled, or rejected with a redirect hint. Requests whose address can not be determined are let through, so that
+// the route handler will treat them
+func WithShardFilter(handler ShardFilterHandler, extractAddress AddressExtractor) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		address := extractAddress(c)
+		if len(address) == 0 {
+			c.Next()
+			return
+		}
+
+		shardId, err := handler.ComputeShardIdOfAddress(address)
+		if err != nil || handler.IsShardServed(shardId) {
+			c.Next()
+			return
+		}
+
+		observerURL, hasObserver := handler.ShardObserverURL(shardId)
+		if hasObserver && handler.ProxyOtherShardsRequests() {
+			target, errParse := url.Parse(observerURL)
+			if errParse == nil {
+				httputil.NewSingleHostReverseProxy(target).ServeHTTP(c.Writer, c.Request)
+				c.Abort()
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusMisdirectedRequest, gin.H{
+			"error":    errors.ErrAddressFromOtherShard.Error(),
+			"redirect": RedirectHint{ShardId: shardId, URL: observerURL},
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package middleware_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/json"
+	"github.com/stretchr/testify/assert"
+)
+
+const servedAddress = "aa"
+const otherShardAddress = "bb"
+
+type redirectResponse struct {
+	Error    string                  `json:"error"`
+	Redirect middleware.RedirectHint `json:"redirect"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func createShardFilterHandler(observerURL string, proxy bool) *mock.ShardFilterHandlerStub {
+	return &mock.ShardFilterHandlerStub{
+		ComputeShardIdOfAddressCalled: func(address string) (uint32, error) {
+			switch address {
+			case servedAddress:
+				return 0, nil
+			case otherShardAddress:
+				return 1, nil
+			}
+			return 0, errors.New("invalid address")
+		},
+		IsShardServedCalled: func(shardId uint32) bool {
+			return shardId == 0
+		},
+		ShardObserverURLCalled: func(shardId uint32) (string, bool) {
+			return observerURL, len(observerURL) > 0
+		},
+		ProxyOtherShardsRequestsCalled: func() bool {
+			return proxy
+		},
+	}
+}
+
+func startServerWithShardFilter(handler middleware.ShardFilterHandler) *gin.Engine {
+	ws := gin.New()
+
+	addressRoutes := ws.Group("/address")
+	addressRoutes.Use(middleware.WithShardFilter(handler, middleware.AddressFromParam("address")))
+	addressRoutes.GET("/:address", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"served": c.Param("address")})
+	})
+
+	txRoutes := ws.Group("/transaction")
+	txRoutes.Use(middleware.WithShardFilter(handler, middleware.AddressFromBodyField("sender")))
+	txRoutes.POST("/send", func(c *gin.Context) {
+		buff, _ := ioutil.ReadAll(c.Request.Body)
+		c.JSON(http.StatusOK, gin.H{"served": string(buff)})
+	})
+
+	return ws
+}
+
+func TestWithShardFilter_AddressFromServedShardShouldPass(t *testing.T) {
+	t.Parallel()
+
+	ws := startServerWithShardFilter(createShardFilterHandler("", false))
+
+	req, _ := http.NewRequest("GET", "/address/"+servedAddress, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestWithShardFilter_InvalidAddressShouldPass(t *testing.T) {
+	t.Parallel()
+
+	ws := startServerWithShardFilter(createShardFilterHandler("", false))
+
+	req, _ := http.NewRequest("GET", "/address/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestWithShardFilter_AddressFromOtherShardShouldRejectWithRedirectHint(t *testing.T) {
+	t.Parallel()
+
+	ws := startServerWithShardFilter(createShardFilterHandler("http://observer-1", false))
+
+	req, _ := http.NewRequest("GET", "/address/"+otherShardAddress, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := redirectResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusMisdirectedRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrAddressFromOtherShard.Error(), response.Error)
+	assert.Equal(t, uint32(1), response.Redirect.ShardId)
+	assert.Equal(t, "http://observer-1", response.Redirect.URL)
+}
+
+func TestWithShardFilter_SenderFromOtherShardShouldReject(t *testing.T) {
+	t.Parallel()
+
+	ws := startServerWithShardFilter(createShardFilterHandler("", false))
+
+	body := []byte(`{"sender":"` + otherShardAddress + `"}`)
+	req, _ := http.NewRequest("POST", "/transaction/send", bytes.NewBuffer(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusMisdirectedRequest, resp.Code)
+}
+
+func TestWithShardFilter_SenderFromServedShardShouldPassWithUnchangedBody(t *testing.T) {
+	t.Parallel()
+
+	ws := startServerWithShardFilter(createShardFilterHandler("", false))
+
+	body := `{"sender":"` + servedAddress + `"}`
+	req, _ := http.NewRequest("POST", "/transaction/send", bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := make(map[string]string)
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, body, response["served"])
+}
+
+func TestWithShardFilter_ProxyEnabledShouldForwardToObserver(t *testing.T) {
+	t.Parallel()
+
+	observer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("from observer " + r.URL.Path))
+	}))
+	defer observer.Close()
+
+	//a real server is used as the proxy needs a response writer able to notify about closed connections
+	server := httptest.NewServer(startServerWithShardFilter(createShardFilterHandler(observer.URL, true)))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/address/" + otherShardAddress)
+	assert.Nil(t, err)
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, _ := ioutil.ReadAll(resp.Body)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "from observer /address/"+otherShardAddress, string(body))
+}
+
+func TestWithShardFilter_ProxyEnabledWithoutObserverShouldReject(t *testing.T) {
+	t.Parallel()
+
+	ws := startServerWithShardFilter(createShardFilterHandler("", true))
+
+	req, _ := http.NewRequest("GET", "/address/"+otherShardAddress, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusMisdirectedRequest, resp.Code)
+}
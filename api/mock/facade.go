@@ -5,10 +5,15 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/rejection"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
 )
 
 // Facade is the mock implementation of a node router handler
@@ -18,18 +23,40 @@ type Facade struct {
 	ShouldErrorStop                                bool
 	GetCurrentPublicKeyHandler                     func() string
 	TpsBenchmarkHandler                            func() *statistics.TpsBenchmark
+	GasPriceStatsHandler                           func() statistics.GasPriceStatsHandler
+	AccountsNotifierHandler                        func() subscription.AccountsNotifierHandler
+	ConfigFingerprintHandler                       func() *external.ConfigFingerprint
 	GetHeartbeatsHandler                           func() ([]heartbeat.PubKeyHeartbeat, error)
 	BalanceHandler                                 func(string) (*big.Int, error)
 	GetAccountHandler                              func(address string) (*state.Account, error)
+	GetSCDeploymentHandler                         func(address string) (*process.SCDeploymentInfo, error)
+	GetTokenBalancesHandler                        func(address string) (map[string]*big.Int, error)
 	GenerateTransactionHandler                     func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler                          func(hash string) (*transaction.Transaction, error)
-	SendTransactionHandler                         func(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error)
-	CreateTransactionHandler                       func(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error)
+	SendTransactionHandler                         func(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, code string, signature []byte, validityWindow transaction.ValidityWindow) (string, error)
+	CreateTransactionHandler                       func(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string, validityWindow transaction.ValidityWindow) (*transaction.Transaction, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	GetDataValueHandler                            func(address string, funcName string, argsBuff ...[]byte) ([]byte, error)
 	StatusMetricsHandler                           func() external.StatusMetricsHandler
+	GetStorageUnitEntryHandler                     func(unitName string, key []byte) ([]byte, error)
+	StorageUnitsStatsHandler                       func() []external.StorageUnitStats
+	DumpPoolsHandler                               func(poolNames []string) ([]string, error)
+	LoadPoolsDumpHandler                           func(fileName string) (int, error)
+	ExportParticipationProofsHandler               func(epoch uint32, pubKey []byte) (*external.ParticipationProofs, error)
+	EnableMessageTracingHandler                    func(topic string, sampleRate uint32) error
+	DisableMessageTracingHandler                   func(topic string)
+	TracedTopicsHandler                            func() map[string]uint32
+	MessageTracesHandler                           func() []tracing.MessageTraceRecord
+	RejectionsHandler                              func() []rejection.PeerRejections
+	PeerRejectionsHandler                          func(peer p2p.PeerID) rejection.PeerRejections
+	DumpDiagnosticsHandler                         func() *external.DiagnosticsReport
+	CheckReadinessHandler                          func() *external.ReadinessReport
+	IterateAccountsHandler                         func(rootHash []byte, startAfter []byte, maxAccounts int, handler func(account *external.AccountEntry) error) ([]byte, error)
+	GetSCStorageDiffHandler                        func(address string, fromNonce uint64, toNonce uint64) (*external.SCStorageDiff, error)
+	GetValidatorEarningsHandler                    func(address string, fromEpoch uint32, toEpoch uint32) (*external.ValidatorEarnings, error)
+	ExportChainHandler                             func(table string, fromNonce uint64, toNonce uint64, handler func(row []string) error) error
 }
 
 // IsNodeRunning is the mock implementation of a handler's IsNodeRunning method
@@ -53,6 +80,30 @@ func (f *Facade) TpsBenchmark() *statistics.TpsBenchmark {
 	return nil
 }
 
+// ConfigFingerprint is the mock implementation for retrieving the configuration fingerprint
+func (f *Facade) ConfigFingerprint() *external.ConfigFingerprint {
+	if f.ConfigFingerprintHandler != nil {
+		return f.ConfigFingerprintHandler()
+	}
+	return nil
+}
+
+// GasPriceStats is the mock implementation for retrieving the gas price statistics tracker
+func (f *Facade) GasPriceStats() statistics.GasPriceStatsHandler {
+	if f.GasPriceStatsHandler != nil {
+		return f.GasPriceStatsHandler()
+	}
+	return nil
+}
+
+// AccountsNotifier is the mock implementation for retrieving the watched accounts changes notifier
+func (f *Facade) AccountsNotifier() subscription.AccountsNotifierHandler {
+	if f.AccountsNotifierHandler != nil {
+		return f.AccountsNotifierHandler()
+	}
+	return nil
+}
+
 // StopNode is the mock implementation of a handler's StopNode method
 func (f *Facade) StopNode() error {
 	if f.ShouldErrorStop {
@@ -81,6 +132,19 @@ func (f *Facade) GetAccount(address string) (*state.Account, error) {
 	return f.GetAccountHandler(address)
 }
 
+// GetTokenBalances is the mock implementation of a handler's GetTokenBalances method
+func (f *Facade) GetTokenBalances(address string) (map[string]*big.Int, error) {
+	if f.GetTokenBalancesHandler != nil {
+		return f.GetTokenBalancesHandler(address)
+	}
+	return make(map[string]*big.Int), nil
+}
+
+// GetSCDeployment is the mock implementation of a handler's GetSCDeployment method
+func (f *Facade) GetSCDeployment(address string) (*process.SCDeploymentInfo, error) {
+	return f.GetSCDeploymentHandler(address)
+}
+
 // GenerateTransaction is the mock implementation of a handler's GenerateTransaction method
 func (f *Facade) GenerateTransaction(sender string, receiver string, value *big.Int,
 	code string) (*transaction.Transaction, error) {
@@ -98,9 +162,10 @@ func (f *Facade) CreateTransaction(
 	data string,
 	signatureHex string,
 	challenge string,
+	validityWindow transaction.ValidityWindow,
 ) (*transaction.Transaction, error) {
 
-	return f.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge)
+	return f.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge, validityWindow)
 }
 
 // GetTransaction is the mock implementation of a handler's GetTransaction method
@@ -109,8 +174,8 @@ func (f *Facade) GetTransaction(hash string) (*transaction.Transaction, error) {
 }
 
 // SendTransaction is the mock implementation of a handler's SendTransaction method
-func (f *Facade) SendTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error) {
-	return f.SendTransactionHandler(nonce, sender, receiver, value, gasPrice, gasLimit, code, signature)
+func (f *Facade) SendTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, code string, signature []byte, validityWindow transaction.ValidityWindow) (string, error) {
+	return f.SendTransactionHandler(nonce, sender, receiver, value, gasPrice, gasLimit, code, signature, validityWindow)
 }
 
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
@@ -137,6 +202,96 @@ func (f *Facade) StatusMetrics() external.StatusMetricsHandler {
 	return f.StatusMetricsHandler()
 }
 
+// GetStorageUnitEntry is the mock implementation of a handler's GetStorageUnitEntry method
+func (f *Facade) GetStorageUnitEntry(unitName string, key []byte) ([]byte, error) {
+	return f.GetStorageUnitEntryHandler(unitName, key)
+}
+
+// StorageUnitsStats is the mock implementation of a handler's StorageUnitsStats method
+func (f *Facade) StorageUnitsStats() []external.StorageUnitStats {
+	return f.StorageUnitsStatsHandler()
+}
+
+// DumpPools is the mock implementation of a handler's DumpPools method
+func (f *Facade) DumpPools(poolNames []string) ([]string, error) {
+	return f.DumpPoolsHandler(poolNames)
+}
+
+// LoadPoolsDump is the mock implementation of a handler's LoadPoolsDump method
+func (f *Facade) LoadPoolsDump(fileName string) (int, error) {
+	return f.LoadPoolsDumpHandler(fileName)
+}
+
+// ExportParticipationProofs is the mock implementation of a handler's ExportParticipationProofs method
+func (f *Facade) ExportParticipationProofs(epoch uint32, pubKey []byte) (*external.ParticipationProofs, error) {
+	return f.ExportParticipationProofsHandler(epoch, pubKey)
+}
+
+// EnableMessageTracing is the mock implementation of a handler's EnableMessageTracing method
+func (f *Facade) EnableMessageTracing(topic string, sampleRate uint32) error {
+	return f.EnableMessageTracingHandler(topic, sampleRate)
+}
+
+// DisableMessageTracing is the mock implementation of a handler's DisableMessageTracing method
+func (f *Facade) DisableMessageTracing(topic string) {
+	f.DisableMessageTracingHandler(topic)
+}
+
+// TracedTopics is the mock implementation of a handler's TracedTopics method
+func (f *Facade) TracedTopics() map[string]uint32 {
+	return f.TracedTopicsHandler()
+}
+
+// MessageTraces is the mock implementation of a handler's MessageTraces method
+func (f *Facade) MessageTraces() []tracing.MessageTraceRecord {
+	return f.MessageTracesHandler()
+}
+
+// Rejections is the mock implementation of a handler's Rejections method
+func (f *Facade) Rejections() []rejection.PeerRejections {
+	return f.RejectionsHandler()
+}
+
+// PeerRejections is the mock implementation of a handler's PeerRejections method
+func (f *Facade) PeerRejections(peer p2p.PeerID) rejection.PeerRejections {
+	return f.PeerRejectionsHandler(peer)
+}
+
+// DumpDiagnostics is the mock implementation of a handler's DumpDiagnostics method
+func (f *Facade) DumpDiagnostics() *external.DiagnosticsReport {
+	return f.DumpDiagnosticsHandler()
+}
+
+// CheckReadiness is the mock implementation of a handler's CheckReadiness method
+func (f *Facade) CheckReadiness() *external.ReadinessReport {
+	return f.CheckReadinessHandler()
+}
+
+// IterateAccounts is the mock implementation of a handler's IterateAccounts method
+func (f *Facade) IterateAccounts(
+	rootHash []byte,
+	startAfter []byte,
+	maxAccounts int,
+	handler func(account *external.AccountEntry) error,
+) ([]byte, error) {
+	return f.IterateAccountsHandler(rootHash, startAfter, maxAccounts, handler)
+}
+
+// GetSCStorageDiff is the mock implementation of a handler's GetSCStorageDiff method
+func (f *Facade) GetSCStorageDiff(address string, fromNonce uint64, toNonce uint64) (*external.SCStorageDiff, error) {
+	return f.GetSCStorageDiffHandler(address, fromNonce, toNonce)
+}
+
+// GetValidatorEarnings is the mock implementation of a handler's GetValidatorEarnings method
+func (f *Facade) GetValidatorEarnings(address string, fromEpoch uint32, toEpoch uint32) (*external.ValidatorEarnings, error) {
+	return f.GetValidatorEarningsHandler(address, fromEpoch, toEpoch)
+}
+
+// ExportChain is the mock implementation of a handler's ExportChain method
+func (f *Facade) ExportChain(table string, fromNonce uint64, toNonce uint64, handler func(row []string) error) error {
+	return f.ExportChainHandler(table, fromNonce, toNonce, handler)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (f *Facade) IsInterfaceNil() bool {
 	if f == nil {
@@ -0,0 +1,29 @@
+package mock
+
+type ShardFilterHandlerStub struct {
+	ShardFilterEnabledCalled       func() bool
+	ComputeShardIdOfAddressCalled  func(address string) (uint32, error)
+	IsShardServedCalled            func(shardId uint32) bool
+	ShardObserverURLCalled         func(shardId uint32) (string, bool)
+	ProxyOtherShardsRequestsCalled func() bool
+}
+
+func (sfhs *ShardFilterHandlerStub) ShardFilterEnabled() bool {
+	return sfhs.ShardFilterEnabledCalled()
+}
+
+func (sfhs *ShardFilterHandlerStub) ComputeShardIdOfAddress(address string) (uint32, error) {
+	return sfhs.ComputeShardIdOfAddressCalled(address)
+}
+
+func (sfhs *ShardFilterHandlerStub) IsShardServed(shardId uint32) bool {
+	return sfhs.IsShardServedCalled(shardId)
+}
+
+func (sfhs *ShardFilterHandlerStub) ShardObserverURL(shardId uint32) (string, bool) {
+	return sfhs.ShardObserverURLCalled(shardId)
+}
+
+func (sfhs *ShardFilterHandlerStub) ProxyOtherShardsRequests() bool {
+	return sfhs.ProxyOtherShardsRequestsCalled()
+}
@@ -0,0 +1,91 @@
+package network
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-gonic/gin"
+)
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	GasPriceStats() statistics.GasPriceStatsHandler
+	ExportParticipationProofs(epoch uint32, pubKey []byte) (*external.ParticipationProofs, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines network related routes
+func Routes(router *gin.RouterGroup) {
+	router.GET("/gas-stats", GasStats)
+	router.GET("/participation-proofs/:epoch/:pubkey", ParticipationProofs)
+}
+
+// GasStats returns the gas price percentiles of the transactions included in the last blocks, for each sender
+// shard. The results can be restricted to one shard by providing the shard query parameter
+func GasStats(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	gasPriceStats := ef.GasPriceStats()
+	if gasPriceStats == nil || gasPriceStats.IsInterfaceNil() {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": errors.ErrNilGasPriceStats.Error()})
+		return
+	}
+
+	shardParam, hasShardParam := c.GetQuery("shard")
+	if !hasShardParam {
+		c.JSON(http.StatusOK, gin.H{"gasStats": gasPriceStats.GasPriceStats()})
+		return
+	}
+
+	shardId, err := strconv.ParseUint(shardParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errors.ErrInvalidShardId.Error()})
+		return
+	}
+
+	stat, found := gasPriceStats.ShardGasPriceStat(uint32(shardId))
+	if !found {
+		c.JSON(http.StatusNotFound, gin.H{"error": errors.ErrGasPriceStatsNotFound.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"gasStats": []statistics.GasPriceStat{stat}})
+}
+
+// ParticipationProofs exports, for the provided epoch and hex encoded validator public key, the headers of the node's
+// shard signed by that validator together with the data needed to verify the signatures off-node
+func ParticipationProofs(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	epoch, err := strconv.ParseUint(c.Param("epoch"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errors.ErrInvalidEpoch.Error()})
+		return
+	}
+
+	pubKey, err := hex.DecodeString(c.Param("pubkey"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errors.ErrInvalidPubKeyHex.Error()})
+		return
+	}
+
+	proofs, err := ef.ExportParticipationProofs(uint32(epoch), pubKey)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"proofs": proofs})
+}
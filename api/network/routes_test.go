@@ -0,0 +1,250 @@
+package network_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/network"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/json"
+	"github.com/stretchr/testify/assert"
+)
+
+type GasStatsResponse struct {
+	GasStats []statistics.GasPriceStat `json:"gasStats"`
+	Error    string                    `json:"error"`
+}
+
+type ParticipationProofsResponse struct {
+	Proofs *external.ParticipationProofs `json:"proofs"`
+	Error  string                        `json:"error"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServer(handler network.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	networkRoutes := ws.Group("/network")
+	if handler != nil {
+		networkRoutes.Use(middleware.WithElrondFacade(handler))
+	}
+	network.Routes(networkRoutes)
+
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	networkRoutes := ws.Group("/network")
+	network.Routes(networkRoutes)
+
+	return ws
+}
+
+func createFacadeWithGasPriceStats() *mock.Facade {
+	gasPriceStats, _ := statistics.NewGasPriceStats(10)
+	gasPriceStats.AddBlock(0, []uint64{10, 20, 30})
+	gasPriceStats.AddBlock(1, []uint64{40})
+
+	return &mock.Facade{
+		GasPriceStatsHandler: func() statistics.GasPriceStatsHandler {
+			return gasPriceStats
+		},
+	}
+}
+
+func TestGasStats_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/network/gas-stats", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GasStatsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestGasStats_NilGasPriceStatsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	req, _ := http.NewRequest("GET", "/network/gas-stats", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GasStatsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, apiErrors.ErrNilGasPriceStats.Error(), response.Error)
+}
+
+func TestGasStats_ShouldReturnAllShards(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(createFacadeWithGasPriceStats())
+	req, _ := http.NewRequest("GET", "/network/gas-stats", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GasStatsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 2, len(response.GasStats))
+	assert.Equal(t, uint64(20), response.GasStats[0].Median)
+	assert.Equal(t, uint64(40), response.GasStats[1].Median)
+}
+
+func TestGasStats_ShardQueryShouldReturnOnlyThatShard(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(createFacadeWithGasPriceStats())
+	req, _ := http.NewRequest("GET", "/network/gas-stats?shard=1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GasStatsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 1, len(response.GasStats))
+	assert.Equal(t, uint32(1), response.GasStats[0].ShardID)
+	assert.Equal(t, uint32(1), response.GasStats[0].NumTxs)
+}
+
+func TestGasStats_InvalidShardQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(createFacadeWithGasPriceStats())
+	req, _ := http.NewRequest("GET", "/network/gas-stats?shard=abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GasStatsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidShardId.Error(), response.Error)
+}
+
+func TestGasStats_UnknownShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(createFacadeWithGasPriceStats())
+	req, _ := http.NewRequest("GET", "/network/gas-stats?shard=5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GasStatsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.Equal(t, apiErrors.ErrGasPriceStatsNotFound.Error(), response.Error)
+}
+
+func TestParticipationProofs_InvalidEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	req, _ := http.NewRequest("GET", "/network/participation-proofs/abc/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := ParticipationProofsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidEpoch.Error(), response.Error)
+}
+
+func TestParticipationProofs_InvalidPubKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	req, _ := http.NewRequest("GET", "/network/participation-proofs/1/not-hex", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := ParticipationProofsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidPubKeyHex.Error(), response.Error)
+}
+
+func TestParticipationProofs_ExportErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	ws := startNodeServer(&mock.Facade{
+		ExportParticipationProofsHandler: func(epoch uint32, pubKey []byte) (*external.ParticipationProofs, error) {
+			return nil, errExpected
+		},
+	})
+	req, _ := http.NewRequest("GET", "/network/participation-proofs/1/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := ParticipationProofsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errExpected.Error(), response.Error)
+}
+
+func TestParticipationProofs_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{
+		ExportParticipationProofsHandler: func(epoch uint32, pubKey []byte) (*external.ParticipationProofs, error) {
+			return &external.ParticipationProofs{
+				Epoch:               epoch,
+				PubKey:              fmt.Sprintf("%x", pubKey),
+				NumConsensusHeaders: 1,
+				SignedHeaders:       []external.SignedHeaderProof{{Nonce: 5, SignerIndex: 2}},
+			}, nil
+		},
+	})
+	req, _ := http.NewRequest("GET", "/network/participation-proofs/3/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := ParticipationProofsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint32(3), response.Proofs.Epoch)
+	assert.Equal(t, "aabb", response.Proofs.PubKey)
+	assert.Equal(t, 1, len(response.Proofs.SignedHeaders))
+	assert.Equal(t, uint64(5), response.Proofs.SignedHeaders[0].Nonce)
+}
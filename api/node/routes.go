@@ -21,6 +21,8 @@ type FacadeHandler interface {
 	GetHeartbeats() ([]heartbeat.PubKeyHeartbeat, error)
 	TpsBenchmark() *statistics.TpsBenchmark
 	StatusMetrics() external.StatusMetricsHandler
+	ConfigFingerprint() *external.ConfigFingerprint
+	CheckReadiness() *external.ReadinessReport
 	IsInterfaceNil() bool
 }
 
@@ -55,6 +57,9 @@ func Routes(router *gin.RouterGroup) {
 	router.GET("/heartbeatstatus", HeartbeatStatus)
 	router.GET("/statistics", Statistics)
 	router.GET("/status", StatusMetrics)
+	router.GET("/configfingerprint", ConfigFingerprint)
+	router.GET("/live", Live)
+	router.GET("/ready", Ready)
 }
 
 // Address returns the information about the address passed as parameter
@@ -120,6 +125,49 @@ func StatusMetrics(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"details": details})
 }
 
+// ConfigFingerprint returns the fingerprint of the node's consensus-critical configuration, which is the same on all
+// the nodes of a fleet configured identically
+func ConfigFingerprint(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	configFingerprint := ef.ConfigFingerprint()
+	if configFingerprint == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": errors.ErrNilConfigFingerprint.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"configFingerprint": configFingerprint})
+}
+
+// Live answers as long as the node's process is able to serve requests, without checking any of its dependencies.
+// A failure of this probe means the process should be restarted
+func Live(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"live": true})
+}
+
+// Ready answers with the outcome of the readiness probes and with a status code telling if the node is synced and
+// able to serve requests or take part in consensus. A failure of this probe means the node should not receive
+// traffic yet, not that it should be restarted
+func Ready(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	report := ef.CheckReadiness()
+	if !report.Ready {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"readiness": report})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"readiness": report})
+}
+
 func statsFromTpsBenchmark(tpsBenchmark *statistics.TpsBenchmark) statisticsResponse {
 	sr := statisticsResponse{}
 	sr.LiveTPS = tpsBenchmark.LiveTPS()
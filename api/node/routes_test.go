@@ -34,6 +34,21 @@ type StatusResponse struct {
 	Running bool `json:"running"`
 }
 
+type ConfigFingerprintResponse struct {
+	GeneralResponse
+	ConfigFingerprint *external.ConfigFingerprint `json:"configFingerprint"`
+}
+
+type LiveResponse struct {
+	GeneralResponse
+	Live bool `json:"live"`
+}
+
+type ReadinessResponse struct {
+	GeneralResponse
+	Readiness *external.ReadinessReport `json:"readiness"`
+}
+
 type AddressResponse struct {
 	GeneralResponse
 	Address string `json:"address"`
@@ -212,6 +227,37 @@ func TestHeartbeatstatus(t *testing.T) {
 	assert.NotEqual(t, "", statusRsp.Message)
 }
 
+func TestHeartbeatstatusShouldIncludeResourceStats(t *testing.T) {
+	t.Parallel()
+
+	hbStatus := []heartbeat.PubKeyHeartbeat{
+		{
+			HexPublicKey: "pk1",
+			IsActive:     true,
+			ResourceStats: &heartbeat.NodeResourceStats{
+				PeakMemory:    1024,
+				CpuLoadClass:  heartbeat.ResourceClassHigh,
+				DiskFreeClass: heartbeat.ResourceClassLow,
+				SyncState:     heartbeat.SyncStateSyncing,
+			},
+		},
+	}
+	facade := mock.Facade{
+		GetHeartbeatsHandler: func() (heartbeats []heartbeat.PubKeyHeartbeat, e error) {
+			return hbStatus, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/heartbeatstatus", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respStr := resp.Body.String()
+	assert.Equal(t, resp.Code, http.StatusOK)
+	assert.True(t, strings.Contains(respStr, `"cpuLoadClass":"high"`))
+	assert.True(t, strings.Contains(respStr, `"syncState":"syncing"`))
+}
+
 func TestStatistics_FailsWithoutFacade(t *testing.T) {
 	t.Parallel()
 	ws := startNodeServer(nil)
@@ -282,6 +328,137 @@ func TestStatusMetrics_ShouldDisplayMetrics(t *testing.T) {
 	assert.True(t, keyAndValueFoundInResponse)
 }
 
+func TestConfigFingerprint_FailsWithWrongFacadeTypeConversion(t *testing.T) {
+	t.Parallel()
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/node/configfingerprint", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	fingerprintRsp := ConfigFingerprintResponse{}
+	loadResponse(resp.Body, &fingerprintRsp)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors.ErrInvalidAppContext.Error(), fingerprintRsp.Error)
+}
+
+func TestConfigFingerprint_NotComputedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/configfingerprint", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	fingerprintRsp := ConfigFingerprintResponse{}
+	loadResponse(resp.Body, &fingerprintRsp)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, errors.ErrNilConfigFingerprint.Error(), fingerprintRsp.Error)
+}
+
+func TestConfigFingerprint_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	configFingerprint := &external.ConfigFingerprint{
+		Fingerprint: "fingerprint",
+		Components: map[string]string{
+			external.GenesisFingerprintComponent: "genesis hash",
+		},
+	}
+	facade := mock.Facade{}
+	facade.ConfigFingerprintHandler = func() *external.ConfigFingerprint {
+		return configFingerprint
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/configfingerprint", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	fingerprintRsp := ConfigFingerprintResponse{}
+	loadResponse(resp.Body, &fingerprintRsp)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, configFingerprint, fingerprintRsp.ConfigFingerprint)
+}
+
+func TestLive_ReturnsSuccessfullyWithoutFacade(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/node/live", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	liveRsp := LiveResponse{}
+	loadResponse(resp.Body, &liveRsp)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, liveRsp.Live)
+}
+
+func TestReady_FailsWithWrongFacadeTypeConversion(t *testing.T) {
+	t.Parallel()
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/node/ready", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	readinessRsp := ReadinessResponse{}
+	loadResponse(resp.Body, &readinessRsp)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errors.ErrInvalidAppContext.Error(), readinessRsp.Error)
+}
+
+func TestReady_NotReadyShouldReturnServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	report := &external.ReadinessReport{
+		Ready: false,
+		Probes: []external.ProbeResult{
+			{Name: external.SyncedProbeName, Passed: false, Details: "behind"},
+			{Name: external.PeersProbeName, Passed: true, Details: "connected"},
+		},
+	}
+	facade := mock.Facade{}
+	facade.CheckReadinessHandler = func() *external.ReadinessReport {
+		return report
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/ready", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	readinessRsp := ReadinessResponse{}
+	loadResponse(resp.Body, &readinessRsp)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, report, readinessRsp.Readiness)
+}
+
+func TestReady_ReadyShouldReturnSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	report := &external.ReadinessReport{
+		Ready: true,
+		Probes: []external.ProbeResult{
+			{Name: external.SyncedProbeName, Passed: true, Details: "synced"},
+		},
+	}
+	facade := mock.Facade{}
+	facade.CheckReadinessHandler = func() *external.ReadinessReport {
+		return report
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/node/ready", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	readinessRsp := ReadinessResponse{}
+	loadResponse(resp.Body, &readinessRsp)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, report, readinessRsp.Readiness)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
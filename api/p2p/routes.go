@@ -0,0 +1,48 @@
+package p2p
+
+import (
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/rejection"
+	"github.com/gin-gonic/gin"
+	"github.com/mr-tron/base58/base58"
+)
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	Rejections() []rejection.PeerRejections
+	PeerRejections(peer p2p.PeerID) rejection.PeerRejections
+	IsInterfaceNil() bool
+}
+
+// Routes defines the p2p debug routes. They are meant to be registered behind the admin token middleware
+func Routes(router *gin.RouterGroup) {
+	router.GET("/rejections", Rejections)
+}
+
+// Rejections returns the messages rejected by the interceptors during the rolling window, counted per peer, topic and
+// reason, together with the peers reputation penalties. The results can be restricted to one peer by providing its
+// base58 encoded id in the peer query parameter
+func Rejections(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	peerParam, hasPeerParam := c.GetQuery("peer")
+	if !hasPeerParam {
+		c.JSON(http.StatusOK, gin.H{"rejections": ef.Rejections()})
+		return
+	}
+
+	peer, err := base58.Decode(peerParam)
+	if err != nil || len(peer) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errors.ErrInvalidPeerId.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"rejections": []rejection.PeerRejections{ef.PeerRejections(p2p.PeerID(peer))}})
+}
@@ -0,0 +1,173 @@
+package p2p_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/p2p"
+	p2pCore "github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/rejection"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/json"
+	"github.com/stretchr/testify/assert"
+)
+
+const adminToken = "admin token"
+
+type RejectionsResponse struct {
+	Rejections []rejection.PeerRejections `json:"rejections"`
+	Error      string                     `json:"error"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServer(handler p2p.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	p2pRoutes := ws.Group("/admin/p2p")
+	p2pRoutes.Use(middleware.WithAdminToken(adminToken))
+	if handler != nil {
+		p2pRoutes.Use(middleware.WithElrondFacade(handler))
+	}
+	p2p.Routes(p2pRoutes)
+
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	p2pRoutes := ws.Group("/admin/p2p")
+	p2p.Routes(p2pRoutes)
+
+	return ws
+}
+
+func newAdminRequest(url string, token string) *http.Request {
+	req, _ := http.NewRequest("GET", url, nil)
+	req.Header.Set(middleware.AdminTokenHeader, token)
+
+	return req
+}
+
+func TestRejections_WithoutTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		RejectionsHandler: func() []rejection.PeerRejections {
+			assert.Fail(t, "should have not called this")
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/p2p/rejections", ""))
+
+	response := RejectionsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Equal(t, apiErrors.ErrUnauthorized.Error(), response.Error)
+}
+
+func TestRejections_WithWrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/p2p/rejections", adminToken))
+
+	response := RejectionsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestRejections_ShouldReturnAllPeers(t *testing.T) {
+	t.Parallel()
+
+	expectedRejections := []rejection.PeerRejections{
+		{Peer: "peer1", ReputationPenalty: 20, NumRejections: 1, Reasons: map[string]uint64{rejection.ReasonSignature: 1}},
+		{Peer: "peer2", ReputationPenalty: 2, NumRejections: 2, Reasons: map[string]uint64{rejection.ReasonStale: 2}},
+	}
+	facade := mock.Facade{
+		RejectionsHandler: func() []rejection.PeerRejections {
+			return expectedRejections
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/p2p/rejections", adminToken))
+
+	response := RejectionsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 2, len(response.Rejections))
+	assert.Equal(t, expectedRejections[0].Peer, response.Rejections[0].Peer)
+	assert.Equal(t, expectedRejections[1].Reasons, response.Rejections[1].Reasons)
+}
+
+func TestRejections_WithPeerShouldReturnThePeer(t *testing.T) {
+	t.Parallel()
+
+	peer := p2pCore.PeerID("peer")
+	requestedPeer := p2pCore.PeerID("")
+	facade := mock.Facade{
+		PeerRejectionsHandler: func(peer p2pCore.PeerID) rejection.PeerRejections {
+			requestedPeer = peer
+			return rejection.PeerRejections{Peer: peer.Pretty(), NumRejections: 3}
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/p2p/rejections?peer="+peer.Pretty(), adminToken))
+
+	response := RejectionsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, peer, requestedPeer)
+	assert.Equal(t, 1, len(response.Rejections))
+	assert.Equal(t, uint64(3), response.Rejections[0].NumRejections)
+}
+
+func TestRejections_WithInvalidPeerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/p2p/rejections?peer=0OIl", adminToken))
+
+	response := RejectionsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidPeerId.Error(), response.Error)
+}
@@ -0,0 +1,80 @@
+package pools
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	DumpPools(poolNames []string) ([]string, error)
+	LoadPoolsDump(fileName string) (int, error)
+	IsInterfaceNil() bool
+}
+
+// DumpRequest represents the structure on which user input for dumping the pools will validate against
+type DumpRequest struct {
+	Pools []string `form:"pools" json:"pools"`
+}
+
+// LoadRequest represents the structure on which user input for loading a pools dump will validate against
+type LoadRequest struct {
+	File string `form:"file" json:"file" binding:"required"`
+}
+
+// Routes defines the pools debug routes. They are meant to be registered behind the admin token middleware
+func Routes(router *gin.RouterGroup) {
+	router.POST("/dump", Dump)
+	router.POST("/load", Load)
+}
+
+// Dump writes the contents of the requested pools to files on the node and returns the paths of the created files
+func Dump(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	var req DumpRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+
+	files, err := ef.DumpPools(req.Pools)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"files": files})
+}
+
+// Load adds in the node's pools the entries found in the provided dump file
+func Load(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	var req LoadRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+
+	loaded, err := ef.LoadPoolsDump(req.File)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"loaded": loaded})
+}
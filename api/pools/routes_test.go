@@ -0,0 +1,218 @@
+package pools_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/pools"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/json"
+	"github.com/stretchr/testify/assert"
+)
+
+const adminToken = "admin token"
+
+type DumpResponse struct {
+	Files []string `json:"files"`
+	Error string   `json:"error"`
+}
+
+type LoadResponse struct {
+	Loaded int    `json:"loaded"`
+	Error  string `json:"error"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServer(handler pools.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	poolsRoutes := ws.Group("/admin/pools")
+	poolsRoutes.Use(middleware.WithAdminToken(adminToken))
+	if handler != nil {
+		poolsRoutes.Use(middleware.WithElrondFacade(handler))
+	}
+	pools.Routes(poolsRoutes)
+
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	poolsRoutes := ws.Group("/admin/pools")
+	pools.Routes(poolsRoutes)
+
+	return ws
+}
+
+func newAdminRequest(url string, body string, token string) *http.Request {
+	req, _ := http.NewRequest("POST", url, bytes.NewBufferString(body))
+	req.Header.Set(middleware.AdminTokenHeader, token)
+
+	return req
+}
+
+func TestDump_WithoutTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		DumpPoolsHandler: func(poolNames []string) ([]string, error) {
+			assert.Fail(t, "should have not called this")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/pools/dump", `{}`, ""))
+
+	response := DumpResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Equal(t, apiErrors.ErrUnauthorized.Error(), response.Error)
+}
+
+func TestDump_WithWrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/pools/dump", `{}`, adminToken))
+
+	response := DumpResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestDump_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("unknown pool")
+	facade := mock.Facade{
+		DumpPoolsHandler: func(poolNames []string) ([]string, error) {
+			return nil, errExpected
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/pools/dump", `{"pools":["unknown"]}`, adminToken))
+
+	response := DumpResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errExpected.Error(), response.Error)
+}
+
+func TestDump_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	files := []string{"/dumps/transactions.json", "/dumps/headers.json"}
+	facade := mock.Facade{
+		DumpPoolsHandler: func(poolNames []string) ([]string, error) {
+			if len(poolNames) == 2 && poolNames[0] == "transactions" && poolNames[1] == "headers" {
+				return files, nil
+			}
+			return nil, errors.New("unexpected pools")
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/pools/dump", `{"pools":["transactions","headers"]}`, adminToken))
+
+	response := DumpResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, files, response.Files)
+}
+
+func TestLoad_MissingFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		LoadPoolsDumpHandler: func(fileName string) (int, error) {
+			assert.Fail(t, "should have not called this")
+			return 0, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/pools/load", `{}`, adminToken))
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestLoad_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("file not found")
+	facade := mock.Facade{
+		LoadPoolsDumpHandler: func(fileName string) (int, error) {
+			return 0, errExpected
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/pools/load", `{"file":"dump.json"}`, adminToken))
+
+	response := LoadResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, errExpected.Error(), response.Error)
+}
+
+func TestLoad_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		LoadPoolsDumpHandler: func(fileName string) (int, error) {
+			if fileName == "dump.json" {
+				return 7, nil
+			}
+			return 0, errors.New("unexpected file")
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/pools/load", `{"file":"dump.json"}`, adminToken))
+
+	response := LoadResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 7, response.Loaded)
+}
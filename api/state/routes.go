@@ -0,0 +1,108 @@
+package state
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-gonic/gin"
+)
+
+// NdjsonContentType is the content type of the accounts stream, one JSON object per line
+const NdjsonContentType = "application/x-ndjson"
+
+const accountsPerFlush = 100
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	IterateAccounts(rootHash []byte, startAfter []byte, maxAccounts int, handler func(account *external.AccountEntry) error) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// StreamEnd is the last line of an accounts stream. ResumeAfter holds the address that should be provided as
+// startAfter in order to read the following accounts and it is empty if the stream reached the end of the state
+type StreamEnd struct {
+	End         bool   `json:"end"`
+	NumAccounts int    `json:"numAccounts"`
+	ResumeAfter string `json:"resumeAfter,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// Routes defines the state analytics routes. They are meant to be registered behind the admin token middleware
+func Routes(router *gin.RouterGroup) {
+	router.GET("/accounts", Accounts)
+}
+
+// Accounts streams, as newline delimited JSON, the accounts found in the state having the hex encoded rootHash query
+// parameter or in the state of the current block if the parameter is missing. The optional startAfter hex address
+// and limit query parameters allow reading the state in chunks. The stream ends with a StreamEnd line
+func Accounts(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	rootHash, err := hex.DecodeString(c.Query("rootHash"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errors.ErrInvalidRootHash.Error()})
+		return
+	}
+
+	startAfter, err := hex.DecodeString(c.Query("startAfter"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errors.ErrInvalidStartAddress.Error()})
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "0"), 10, 31)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errors.ErrInvalidLimit.Error()})
+		return
+	}
+
+	encoder := json.NewEncoder(c.Writer)
+	streamEnd := &StreamEnd{End: true}
+	resumeAfter, err := ef.IterateAccounts(rootHash, startAfter, int(limit), func(account *external.AccountEntry) error {
+		if streamEnd.NumAccounts == 0 {
+			startStream(c)
+		}
+
+		errEncode := encoder.Encode(account)
+		if errEncode != nil {
+			return errEncode
+		}
+
+		streamEnd.NumAccounts++
+		streamEnd.ResumeAfter = account.Address
+		if streamEnd.NumAccounts%accountsPerFlush == 0 {
+			c.Writer.Flush()
+		}
+
+		return nil
+	})
+	if err != nil && streamEnd.NumAccounts == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if streamEnd.NumAccounts == 0 {
+		startStream(c)
+	}
+	if err != nil {
+		streamEnd.Error = err.Error()
+	} else {
+		streamEnd.ResumeAfter = hex.EncodeToString(resumeAfter)
+	}
+
+	_ = encoder.Encode(streamEnd)
+	c.Writer.Flush()
+}
+
+func startStream(c *gin.Context) {
+	c.Header("Content-Type", NdjsonContentType)
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,294 @@
+package state_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/state"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+const adminToken = "admin token"
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func loadStream(rsp io.Reader) ([]external.AccountEntry, state.StreamEnd) {
+	accounts := make([]external.AccountEntry, 0)
+	streamEnd := state.StreamEnd{}
+
+	scanner := bufio.NewScanner(rsp)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+
+		end := state.StreamEnd{}
+		_ = json.Unmarshal(line, &end)
+		if end.End {
+			streamEnd = end
+			continue
+		}
+
+		account := external.AccountEntry{}
+		_ = json.Unmarshal(line, &account)
+		accounts = append(accounts, account)
+	}
+
+	return accounts, streamEnd
+}
+
+func startNodeServer(handler state.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	stateRoutes := ws.Group("/admin/state")
+	stateRoutes.Use(middleware.WithAdminToken(adminToken))
+	if handler != nil {
+		stateRoutes.Use(middleware.WithElrondFacade(handler))
+	}
+	state.Routes(stateRoutes)
+
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	stateRoutes := ws.Group("/admin/state")
+	state.Routes(stateRoutes)
+
+	return ws
+}
+
+func newAdminRequest(url string, token string) *http.Request {
+	req, _ := http.NewRequest("GET", url, nil)
+	req.Header.Set(middleware.AdminTokenHeader, token)
+
+	return req
+}
+
+func createFacadeWithAccounts(accounts []*external.AccountEntry, resumeAfter []byte, err error) *mock.Facade {
+	return &mock.Facade{
+		IterateAccountsHandler: func(rootHash []byte, startAfter []byte, maxAccounts int, handler func(account *external.AccountEntry) error) ([]byte, error) {
+			for _, account := range accounts {
+				errHandler := handler(account)
+				if errHandler != nil {
+					return nil, errHandler
+				}
+			}
+			return resumeAfter, err
+		},
+	}
+}
+
+func TestAccounts_WithoutTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	req, _ := http.NewRequest("GET", "/admin/state/accounts", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := ErrorResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Equal(t, apiErrors.ErrUnauthorized.Error(), response.Error)
+}
+
+func TestAccounts_WithWrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/state/accounts", adminToken))
+
+	response := ErrorResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestAccounts_InvalidRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/state/accounts?rootHash=not-hex", adminToken))
+
+	response := ErrorResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidRootHash.Error(), response.Error)
+}
+
+func TestAccounts_InvalidStartAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/state/accounts?startAfter=not-hex", adminToken))
+
+	response := ErrorResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidStartAddress.Error(), response.Error)
+}
+
+func TestAccounts_InvalidLimitShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/state/accounts?limit=-1", adminToken))
+
+	response := ErrorResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidLimit.Error(), response.Error)
+}
+
+func TestAccounts_ErrorBeforeFirstAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("root hash not found")
+	ws := startNodeServer(createFacadeWithAccounts(nil, nil, errExpected))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/state/accounts?rootHash=aabb", adminToken))
+
+	response := ErrorResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.Equal(t, errExpected.Error(), response.Error)
+}
+
+func TestAccounts_ShouldPassParametersToFacade(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	facade := &mock.Facade{
+		IterateAccountsHandler: func(rootHash []byte, startAfter []byte, maxAccounts int, handler func(account *external.AccountEntry) error) ([]byte, error) {
+			wasCalled = true
+			assert.Equal(t, []byte{0xaa, 0xbb}, rootHash)
+			assert.Equal(t, []byte{0x01, 0x02}, startAfter)
+			assert.Equal(t, 50, maxAccounts)
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/state/accounts?rootHash=aabb&startAfter=0102&limit=50", adminToken))
+
+	assert.True(t, wasCalled)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestAccounts_ShouldStreamAccountsAndResumeAddress(t *testing.T) {
+	t.Parallel()
+
+	accounts := []*external.AccountEntry{
+		{Address: "01", Nonce: 1, Balance: "100"},
+		{Address: "02", Nonce: 2, Balance: "200", CodeHash: "aa"},
+	}
+	ws := startNodeServer(createFacadeWithAccounts(accounts, []byte{0x02}, nil))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/state/accounts?limit=2", adminToken))
+
+	streamedAccounts, streamEnd := loadStream(resp.Body)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, state.NdjsonContentType, resp.Header().Get("Content-Type"))
+	assert.Equal(t, []external.AccountEntry{*accounts[0], *accounts[1]}, streamedAccounts)
+	assert.Equal(t, state.StreamEnd{End: true, NumAccounts: 2, ResumeAfter: "02"}, streamEnd)
+}
+
+func TestAccounts_EndOfStateShouldNotProvideResumeAddress(t *testing.T) {
+	t.Parallel()
+
+	accounts := []*external.AccountEntry{{Address: "01", Nonce: 1, Balance: "100"}}
+	ws := startNodeServer(createFacadeWithAccounts(accounts, nil, nil))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/state/accounts", adminToken))
+
+	streamedAccounts, streamEnd := loadStream(resp.Body)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 1, len(streamedAccounts))
+	assert.Equal(t, state.StreamEnd{End: true, NumAccounts: 1}, streamEnd)
+}
+
+func TestAccounts_EmptyStateShouldOnlyStreamEnd(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(createFacadeWithAccounts(nil, nil, nil))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/state/accounts", adminToken))
+
+	streamedAccounts, streamEnd := loadStream(resp.Body)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, state.NdjsonContentType, resp.Header().Get("Content-Type"))
+	assert.Equal(t, 0, len(streamedAccounts))
+	assert.Equal(t, state.StreamEnd{End: true}, streamEnd)
+}
+
+func TestAccounts_ErrorAfterFirstAccountsShouldEndStreamWithError(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("trie node missing")
+	accounts := []*external.AccountEntry{
+		{Address: "01", Nonce: 1, Balance: "100"},
+		{Address: "02", Nonce: 2, Balance: "200"},
+	}
+	ws := startNodeServer(createFacadeWithAccounts(accounts, nil, errExpected))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/state/accounts", adminToken))
+
+	streamedAccounts, streamEnd := loadStream(resp.Body)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 2, len(streamedAccounts))
+	assert.Equal(t, state.StreamEnd{End: true, NumAccounts: 2, ResumeAfter: "02", Error: errExpected.Error()}, streamEnd)
+}
@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"encoding/hex"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-gonic/gin"
+)
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	GetStorageUnitEntry(unitName string, key []byte) ([]byte, error)
+	StorageUnitsStats() []external.StorageUnitStats
+	IsInterfaceNil() bool
+}
+
+// Routes defines the storage debug routes. They are meant to be registered behind the admin token middleware
+func Routes(router *gin.RouterGroup) {
+	router.GET("/units", Units)
+	router.GET("/unit/:unit/:key", Entry)
+}
+
+// Units returns the names and stats of the node's storage units
+func Units(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"units": ef.StorageUnitsStats()})
+}
+
+// Entry returns, hex encoded, the raw value stored under the provided hex key in the named storage unit
+func Entry(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	key, err := hex.DecodeString(c.Param("key"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errors.ErrInvalidHexKey.Error()})
+		return
+	}
+
+	value, err := ef.GetStorageUnitEntry(c.Param("unit"), key)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"value": hex.EncodeToString(value)})
+}
@@ -0,0 +1,216 @@
+package storage_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/storage"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/json"
+	"github.com/stretchr/testify/assert"
+)
+
+const adminToken = "admin token"
+
+type EntryResponse struct {
+	Value string `json:"value"`
+	Error string `json:"error"`
+}
+
+type UnitsResponse struct {
+	Units []external.StorageUnitStats `json:"units"`
+	Error string                      `json:"error"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServer(handler storage.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	storageRoutes := ws.Group("/admin/storage")
+	storageRoutes.Use(middleware.WithAdminToken(adminToken))
+	if handler != nil {
+		storageRoutes.Use(middleware.WithElrondFacade(handler))
+	}
+	storage.Routes(storageRoutes)
+
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	storageRoutes := ws.Group("/admin/storage")
+	storage.Routes(storageRoutes)
+
+	return ws
+}
+
+func newAdminRequest(url string, token string) *http.Request {
+	req, _ := http.NewRequest("GET", url, nil)
+	req.Header.Set(middleware.AdminTokenHeader, token)
+
+	return req
+}
+
+func TestUnits_WithoutTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		StorageUnitsStatsHandler: func() []external.StorageUnitStats {
+			assert.Fail(t, "should have not called this")
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/admin/storage/units", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := UnitsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Equal(t, apiErrors.ErrUnauthorized.Error(), response.Error)
+}
+
+func TestUnits_WrongTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/storage/units", "wrong token"))
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+}
+
+func TestUnits_WithWrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/storage/units", adminToken))
+
+	response := UnitsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestUnits_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	stats := []external.StorageUnitStats{
+		{Name: "BlockHeaderUnit", UnitType: 3, CachedItems: 4},
+		{Name: "TransactionUnit", UnitType: 0, CachedItems: 10},
+	}
+	facade := mock.Facade{
+		StorageUnitsStatsHandler: func() []external.StorageUnitStats {
+			return stats
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/storage/units", adminToken))
+
+	response := UnitsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, stats, response.Units)
+}
+
+func TestEntry_InvalidHexKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetStorageUnitEntryHandler: func(unitName string, key []byte) ([]byte, error) {
+			assert.Fail(t, "should have not called this")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/storage/unit/TransactionUnit/not-hex", adminToken))
+
+	response := EntryResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidHexKey.Error(), response.Error)
+}
+
+func TestEntry_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("key not found")
+	facade := mock.Facade{
+		GetStorageUnitEntryHandler: func(unitName string, key []byte) ([]byte, error) {
+			return nil, errExpected
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/storage/unit/TransactionUnit/aabb", adminToken))
+
+	response := EntryResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	assert.Equal(t, errExpected.Error(), response.Error)
+}
+
+func TestEntry_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	value := []byte("raw value")
+	facade := mock.Facade{
+		GetStorageUnitEntryHandler: func(unitName string, key []byte) ([]byte, error) {
+			if unitName == "MetaBlockUnit" && hex.EncodeToString(key) == "aabb" {
+				return value, nil
+			}
+			return nil, errors.New("unexpected parameters")
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("/admin/storage/unit/MetaBlockUnit/aabb", adminToken))
+
+	response := EntryResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, hex.EncodeToString(value), response.Value)
+}
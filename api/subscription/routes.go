@@ -0,0 +1,88 @@
+package subscription
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const writeTimeout = 10 * time.Second
+
+var upgrader = websocket.Upgrader{}
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	AccountsNotifier() subscription.AccountsNotifierHandler
+	IsInterfaceNil() bool
+}
+
+// Routes defines subscription related routes
+func Routes(router *gin.RouterGroup) {
+	router.GET("/accounts", Accounts)
+}
+
+// Accounts upgrades the connection to a WebSocket one on which every change of the nonce or of the balance of the
+// addresses provided as address query parameters is sent as a JSON message, after the block that made the change
+// was committed. The state of all the watched addresses is sent after the first block committed since subscribing
+func Accounts(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	accountsNotifier := ef.AccountsNotifier()
+	if accountsNotifier == nil || accountsNotifier.IsInterfaceNil() {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": errors.ErrAccountsSubscriptionsDisabled.Error()})
+		return
+	}
+
+	subscriberId, changes, err := accountsNotifier.Subscribe(c.QueryArray("address"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	defer accountsNotifier.Unsubscribe(subscriberId)
+
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	connClosed := make(chan struct{})
+	go func() {
+		for {
+			_, _, errRead := conn.NextReader()
+			if errRead != nil {
+				close(connClosed)
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case change, isOpen := <-changes:
+			_ = conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if !isOpen {
+				closeMessage := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "notifications were not consumed in time")
+				_ = conn.WriteMessage(websocket.CloseMessage, closeMessage)
+				return
+			}
+
+			err = conn.WriteJSON(change)
+			if err != nil {
+				return
+			}
+		case <-connClosed:
+			return
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package subscription_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/subscription"
+	coreSubscription "github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/json"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServer(handler subscription.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	subscriptionRoutes := ws.Group("/subscription")
+	if handler != nil {
+		subscriptionRoutes.Use(middleware.WithElrondFacade(handler))
+	}
+	subscription.Routes(subscriptionRoutes)
+
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	subscriptionRoutes := ws.Group("/subscription")
+	subscription.Routes(subscriptionRoutes)
+
+	return ws
+}
+
+func createFacadeWithAccountsNotifier(notifier coreSubscription.AccountsNotifierHandler) *mock.Facade {
+	return &mock.Facade{
+		AccountsNotifierHandler: func() coreSubscription.AccountsNotifierHandler {
+			return notifier
+		},
+	}
+}
+
+func TestAccounts_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/subscription/accounts?address=aa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := ErrorResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestAccounts_DisabledSubscriptionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	req, _ := http.NewRequest("GET", "/subscription/accounts?address=aa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := ErrorResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, apiErrors.ErrAccountsSubscriptionsDisabled.Error(), response.Error)
+}
+
+func TestAccounts_SubscribeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	var requestedAddresses []string
+	notifier := &mock.AccountsNotifierStub{
+		SubscribeCalled: func(addresses []string) (uint64, <-chan *coreSubscription.AccountChange, error) {
+			requestedAddresses = addresses
+			return 0, nil, errExpected
+		},
+	}
+	ws := startNodeServer(createFacadeWithAccountsNotifier(notifier))
+	req, _ := http.NewRequest("GET", "/subscription/accounts?address=aa&address=bb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := ErrorResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, errExpected.Error(), response.Error)
+	assert.Equal(t, []string{"aa", "bb"}, requestedAddresses)
+}
+
+func TestAccounts_ShouldSendTheChangesAndUnsubscribeWhenClosed(t *testing.T) {
+	t.Parallel()
+
+	changes := make(chan *coreSubscription.AccountChange, 1)
+	unsubscribed := make(chan uint64, 1)
+	notifier := &mock.AccountsNotifierStub{
+		SubscribeCalled: func(addresses []string) (uint64, <-chan *coreSubscription.AccountChange, error) {
+			return 7, changes, nil
+		},
+		UnsubscribeCalled: func(subscriberId uint64) {
+			unsubscribed <- subscriberId
+		},
+	}
+	server := httptest.NewServer(startNodeServer(createFacadeWithAccountsNotifier(notifier)))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/subscription/accounts?address=aa"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	assert.Nil(t, err)
+
+	expectedChange := &coreSubscription.AccountChange{
+		Address:    "aa",
+		Nonce:      2,
+		Balance:    "100",
+		BlockNonce: 10,
+		BlockHash:  "hash",
+	}
+	changes <- expectedChange
+
+	receivedChange := &coreSubscription.AccountChange{}
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	err = conn.ReadJSON(receivedChange)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedChange, receivedChange)
+
+	_ = conn.Close()
+	select {
+	case subscriberId := <-unsubscribed:
+		assert.Equal(t, uint64(7), subscriberId)
+	case <-time.After(time.Second):
+		assert.Fail(t, "subscriber was not removed after the connection was closed")
+	}
+}
+
+func TestAccounts_ClosedChangesShouldCloseTheConnection(t *testing.T) {
+	t.Parallel()
+
+	changes := make(chan *coreSubscription.AccountChange)
+	close(changes)
+	notifier := &mock.AccountsNotifierStub{
+		SubscribeCalled: func(addresses []string) (uint64, <-chan *coreSubscription.AccountChange, error) {
+			return 1, changes, nil
+		},
+	}
+	server := httptest.NewServer(startNodeServer(createFacadeWithAccountsNotifier(notifier)))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/subscription/accounts?address=aa"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	assert.Nil(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, _, err = conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.ClosePolicyViolation))
+}
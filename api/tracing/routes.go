@@ -0,0 +1,95 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	EnableMessageTracing(topic string, sampleRate uint32) error
+	DisableMessageTracing(topic string)
+	TracedTopics() map[string]uint32
+	MessageTraces() []tracing.MessageTraceRecord
+	IsInterfaceNil() bool
+}
+
+// EnableRequest represents the structure on which user input for enabling the tracing of a topic will validate against
+type EnableRequest struct {
+	Topic      string `form:"topic" json:"topic" binding:"required"`
+	SampleRate uint32 `form:"sampleRate" json:"sampleRate" binding:"required"`
+}
+
+// DisableRequest represents the structure on which user input for disabling the tracing of a topic will validate against
+type DisableRequest struct {
+	Topic string `form:"topic" json:"topic" binding:"required"`
+}
+
+// Routes defines the message tracing debug routes. They are meant to be registered behind the admin token middleware
+func Routes(router *gin.RouterGroup) {
+	router.POST("/enable", Enable)
+	router.POST("/disable", Disable)
+	router.GET("/traces", Traces)
+}
+
+// Enable starts tracing 1 in sampleRate of the messages received on the requested topic
+func Enable(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	var req EnableRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+
+	err = ef.EnableMessageTracing(req.Topic, req.SampleRate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"topics": ef.TracedTopics()})
+}
+
+// Disable stops tracing the messages received on the requested topic
+func Disable(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	var req DisableRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error())})
+		return
+	}
+
+	ef.DisableMessageTracing(req.Topic)
+
+	c.JSON(http.StatusOK, gin.H{"topics": ef.TracedTopics()})
+}
+
+// Traces returns the traced topics and the kept traces of the sampled messages, oldest first
+func Traces(c *gin.Context) {
+	ef, ok := c.MustGet("elrondFacade").(FacadeHandler)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": errors.ErrInvalidAppContext.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"topics": ef.TracedTopics(),
+		"traces": ef.MessageTraces(),
+	})
+}
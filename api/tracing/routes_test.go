@@ -0,0 +1,233 @@
+package tracing_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/tracing"
+	processTracing "github.com/ElrondNetwork/elrond-go/process/tracing"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/json"
+	"github.com/stretchr/testify/assert"
+)
+
+const adminToken = "admin token"
+
+type TopicsResponse struct {
+	Topics map[string]uint32 `json:"topics"`
+	Error  string            `json:"error"`
+}
+
+type TracesResponse struct {
+	Topics map[string]uint32                   `json:"topics"`
+	Traces []processTracing.MessageTraceRecord `json:"traces"`
+	Error  string                              `json:"error"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServer(handler tracing.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	tracingRoutes := ws.Group("/admin/tracing")
+	tracingRoutes.Use(middleware.WithAdminToken(adminToken))
+	if handler != nil {
+		tracingRoutes.Use(middleware.WithElrondFacade(handler))
+	}
+	tracing.Routes(tracingRoutes)
+
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("elrondFacade", mock.WrongFacade{})
+	})
+	tracingRoutes := ws.Group("/admin/tracing")
+	tracing.Routes(tracingRoutes)
+
+	return ws
+}
+
+func newAdminRequest(method string, url string, body string, token string) *http.Request {
+	req, _ := http.NewRequest(method, url, bytes.NewBufferString(body))
+	req.Header.Set(middleware.AdminTokenHeader, token)
+
+	return req
+}
+
+func TestEnable_WithoutTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		EnableMessageTracingHandler: func(topic string, sampleRate uint32) error {
+			assert.Fail(t, "should have not called this")
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("POST", "/admin/tracing/enable", `{"topic":"transactions_0","sampleRate":10}`, ""))
+
+	response := TopicsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Equal(t, apiErrors.ErrUnauthorized.Error(), response.Error)
+}
+
+func TestEnable_WithWrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("POST", "/admin/tracing/enable", `{"topic":"transactions_0","sampleRate":10}`, adminToken))
+
+	response := TopicsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestEnable_MissingTopicShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("POST", "/admin/tracing/enable", `{"sampleRate":10}`, adminToken))
+
+	response := TopicsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+}
+
+func TestEnable_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("invalid sample rate")
+	facade := mock.Facade{
+		EnableMessageTracingHandler: func(topic string, sampleRate uint32) error {
+			return errExpected
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("POST", "/admin/tracing/enable", `{"topic":"transactions_0","sampleRate":10}`, adminToken))
+
+	response := TopicsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, errExpected.Error(), response.Error)
+}
+
+func TestEnable_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tracedTopics := make(map[string]uint32)
+	facade := mock.Facade{
+		EnableMessageTracingHandler: func(topic string, sampleRate uint32) error {
+			tracedTopics[topic] = sampleRate
+			return nil
+		},
+		TracedTopicsHandler: func() map[string]uint32 {
+			return tracedTopics
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("POST", "/admin/tracing/enable", `{"topic":"transactions_0","sampleRate":10}`, adminToken))
+
+	response := TopicsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, map[string]uint32{"transactions_0": 10}, response.Topics)
+}
+
+func TestDisable_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tracedTopics := map[string]uint32{"transactions_0": 10}
+	facade := mock.Facade{
+		DisableMessageTracingHandler: func(topic string) {
+			delete(tracedTopics, topic)
+		},
+		TracedTopicsHandler: func() map[string]uint32 {
+			return tracedTopics
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("POST", "/admin/tracing/disable", `{"topic":"transactions_0"}`, adminToken))
+
+	response := TopicsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 0, len(response.Topics))
+}
+
+func TestTraces_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	traces := []processTracing.MessageTraceRecord{
+		{
+			Topic:       "transactions_0",
+			PayloadHash: "aabb",
+			PayloadSize: 2,
+			Stages: []processTracing.StageTiming{
+				{Stage: processTracing.StageUnmarshaled, ElapsedNano: 100},
+			},
+		},
+	}
+	facade := mock.Facade{
+		TracedTopicsHandler: func() map[string]uint32 {
+			return map[string]uint32{"transactions_0": 10}
+		},
+		MessageTracesHandler: func() []processTracing.MessageTraceRecord {
+			return traces
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("GET", "/admin/tracing/traces", "", adminToken))
+
+	response := TracesResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, map[string]uint32{"transactions_0": 10}, response.Topics)
+	assert.Equal(t, traces, response.Traces)
+}
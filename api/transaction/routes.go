@@ -13,8 +13,8 @@ import (
 
 // TxService interface defines methods that can be used from `elrondFacade` context variable
 type TxService interface {
-	CreateTransaction(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error)
-	SendTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error)
+	CreateTransaction(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data string, signatureHex string, challenge string, validityWindow transaction.ValidityWindow) (*transaction.Transaction, error)
+	SendTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, code string, signature []byte, validityWindow transaction.ValidityWindow) (string, error)
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	GetTransaction(hash string) (*transaction.Transaction, error)
 	IsInterfaceNil() bool
@@ -35,17 +35,31 @@ type MultipleTxRequest struct {
 	TxCount  int      `form:"txCount" json:"txCount"`
 }
 
-// SendTxRequest represents the structure that maps and validates user input for publishing a new transaction
+// SendTxRequest represents the structure that maps and validates user input for publishing a new transaction.
+// The optional NotBefore and NotAfter fields define the rounds and epochs validity window of the transaction
 type SendTxRequest struct {
-	Sender    string   `form:"sender" json:"sender"`
-	Receiver  string   `form:"receiver" json:"receiver"`
-	Value     *big.Int `form:"value" json:"value"`
-	Data      string   `form:"data" json:"data"`
-	Nonce     uint64   `form:"nonce" json:"nonce"`
-	GasPrice  uint64   `form:"gasPrice" json:"gasPrice"`
-	GasLimit  uint64   `form:"gasLimit" json:"gasLimit"`
-	Signature string   `form:"signature" json:"signature"`
-	Challenge string   `form:"challenge" json:"challenge"`
+	Sender         string   `form:"sender" json:"sender"`
+	Receiver       string   `form:"receiver" json:"receiver"`
+	Value          *big.Int `form:"value" json:"value"`
+	Data           string   `form:"data" json:"data"`
+	Nonce          uint64   `form:"nonce" json:"nonce"`
+	GasPrice       uint64   `form:"gasPrice" json:"gasPrice"`
+	GasLimit       uint64   `form:"gasLimit" json:"gasLimit"`
+	Signature      string   `form:"signature" json:"signature"`
+	Challenge      string   `form:"challenge" json:"challenge"`
+	NotBeforeRound uint64   `form:"notBeforeRound" json:"notBeforeRound,omitempty"`
+	NotAfterRound  uint64   `form:"notAfterRound" json:"notAfterRound,omitempty"`
+	NotBeforeEpoch uint32   `form:"notBeforeEpoch" json:"notBeforeEpoch,omitempty"`
+	NotAfterEpoch  uint32   `form:"notAfterEpoch" json:"notAfterEpoch,omitempty"`
+}
+
+func (req *SendTxRequest) validityWindow() transaction.ValidityWindow {
+	return transaction.ValidityWindow{
+		NotBeforeRound: req.NotBeforeRound,
+		NotAfterRound:  req.NotAfterRound,
+		NotBeforeEpoch: req.NotBeforeEpoch,
+		NotAfterEpoch:  req.NotAfterEpoch,
+	}
 }
 
 //TxResponse represents the structure on which the response will be validated against
@@ -86,7 +100,7 @@ func SendTransaction(c *gin.Context) {
 		return
 	}
 
-	txHash, err := ef.SendTransaction(gtx.Nonce, gtx.Sender, gtx.Receiver, gtx.Value, gtx.GasPrice, gtx.GasLimit, gtx.Data, signature)
+	txHash, err := ef.SendTransaction(gtx.Nonce, gtx.Sender, gtx.Receiver, gtx.Value, gtx.GasPrice, gtx.GasLimit, gtx.Data, signature, gtx.validityWindow())
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error())})
 		return
@@ -122,6 +136,7 @@ func SendMultipleTransactions(c *gin.Context) {
 			receivedTx.Data,
 			receivedTx.Signature,
 			receivedTx.Challenge,
+			receivedTx.validityWindow(),
 		)
 		if err != nil {
 			continue
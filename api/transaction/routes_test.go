@@ -209,7 +209,7 @@ func TestSendTransaction_ErrorWhenFacadeSendTransactionError(t *testing.T) {
 
 	facade := mock.Facade{
 		SendTransactionHandler: func(nonce uint64, sender string, receiver string, value *big.Int,
-			gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error) {
+			gasPrice uint64, gasLimit uint64, code string, signature []byte, validityWindow tr.ValidityWindow) (string, error) {
 			return "", errors.New(errorString)
 		},
 	}
@@ -248,7 +248,7 @@ func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 
 	facade := mock.Facade{
 		SendTransactionHandler: func(nonce uint64, sender string, receiver string, value *big.Int,
-			gasPrice uint64, gasLimit uint64, code string, signature []byte) (string, error) {
+			gasPrice uint64, gasLimit uint64, code string, signature []byte, validityWindow tr.ValidityWindow) (string, error) {
 			return txHash, nil
 		},
 	}
@@ -277,6 +277,37 @@ func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, txHashResponse.TxHash, txHash)
 }
 
+func TestSendTransaction_ShouldPassTheValidityWindow(t *testing.T) {
+	t.Parallel()
+
+	receivedWindow := tr.ValidityWindow{}
+	facade := mock.Facade{
+		SendTransactionHandler: func(nonce uint64, sender string, receiver string, value *big.Int,
+			gasPrice uint64, gasLimit uint64, code string, signature []byte, validityWindow tr.ValidityWindow) (string, error) {
+			receivedWindow = validityWindow
+			return "tx hash", nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonStr := `{"nonce": 1, "sender": "sender", "receiver": "receiver", "value": 10, "signature": "aabbccdd", ` +
+		`"notBeforeRound": 10, "notAfterRound": 20, "notBeforeEpoch": 1, "notAfterEpoch": 2}`
+
+	req, _ := http.NewRequest("POST", "/transaction/send", bytes.NewBuffer([]byte(jsonStr)))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	expectedWindow := tr.ValidityWindow{
+		NotBeforeRound: 10,
+		NotAfterRound:  20,
+		NotBeforeEpoch: 1,
+		NotAfterEpoch:  2,
+	}
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedWindow, receivedWindow)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
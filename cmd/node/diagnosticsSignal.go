@@ -0,0 +1,27 @@
+//+build !windows
+
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+// dumpDiagnosticsOnSignal writes the diagnostics report to the log each time the node receives the SIGUSR1 signal
+func dumpDiagnosticsOnSignal(reporter external.DiagnosticsHandler, log *logger.Logger) {
+	diagnosticsSigs := make(chan os.Signal, 1)
+	signal.Notify(diagnosticsSigs, syscall.SIGUSR1)
+
+	go func() {
+		for range diagnosticsSigs {
+			log.Info("dumping the diagnostics report at user's signal...")
+			reporter.DumpReport()
+		}
+	}()
+
+	log.Info("send the SIGUSR1 signal to dump the diagnostics report to the log")
+}
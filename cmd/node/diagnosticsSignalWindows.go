@@ -0,0 +1,14 @@
+//+build windows
+
+package main
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+// dumpDiagnosticsOnSignal does nothing as there is no user defined signal on windows. The diagnostics report can
+// still be dumped through the admin REST API route
+func dumpDiagnosticsOnSignal(_ external.DiagnosticsHandler, log *logger.Logger) {
+	log.Info("diagnostics report dump on signal is not available on windows")
+}
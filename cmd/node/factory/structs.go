@@ -15,10 +15,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/round"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/genesis"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
+	"github.com/ElrondNetwork/elrond-go/core/random"
 	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
@@ -42,27 +44,39 @@ import (
 	metafactoryDataRetriever "github.com/ElrondNetwork/elrond-go/dataRetriever/factory/metachain"
 	shardfactoryDataRetriever "github.com/ElrondNetwork/elrond-go/dataRetriever/factory/shard"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers/topicResolverSender"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
 	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/chunking"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
 	factoryP2P "github.com/ElrondNetwork/elrond-go/p2p/libp2p/factory"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/privnet"
 	"github.com/ElrondNetwork/elrond-go/p2p/loadBalancer"
+	"github.com/ElrondNetwork/elrond-go/p2p/namespace"
+	"github.com/ElrondNetwork/elrond-go/p2p/refcounting"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/block/interceptors"
 	"github.com/ElrondNetwork/elrond-go/process/block/poolsCleaner"
+	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
+	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/rejection"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	processSync "github.com/ElrondNetwork/elrond-go/process/sync"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
@@ -72,6 +86,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/libp2p/go-libp2p-core/connmgr"
 	libp2pCrypto "github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/urfave/cli"
 )
@@ -94,6 +109,10 @@ var log = logger.DefaultLogger()
 
 const maxTxNonceDeltaAllowed = 15000
 
+// startEpoch is the epoch the node starts in, used to select the hashers of the data structures. There is no epoch
+// change yet, so the node always runs in the genesis epoch
+const startEpoch = uint32(0)
+
 // ErrCreateForkDetector signals that a fork detector could not be created
 //TODO: Extract all others error messages from this file in some defined errors
 var ErrCreateForkDetector = errors.New("could not create fork detector")
@@ -101,13 +120,24 @@ var ErrCreateForkDetector = errors.New("could not create fork detector")
 // Network struct holds the network components of the Elrond protocol
 type Network struct {
 	NetMessenger p2p.Messenger
+	Statistics   p2p.StatisticsHandler
+}
+
+// SeederNetwork struct holds the network components of a node started in seeder mode
+type SeederNetwork struct {
+	NetMessenger p2p.Messenger
+	PeerExchange p2p.PeerExchanger
+	DialBack     p2p.DialBacker
+	ConnLimiter  p2p.ConnectionsLimiter
 }
 
 // Core struct holds the core components of the Elrond protocol
 type Core struct {
 	Hasher                   hashing.Hasher
+	Hashers                  hashing.HasherRegistry
 	Marshalizer              marshal.Marshalizer
 	Trie                     data.Trie
+	TrieStorage              storage.Storer
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
 	StatusHandler            core.AppStatusHandler
 }
@@ -121,10 +151,11 @@ type State struct {
 
 // Data struct holds the data components of the Elrond protocol
 type Data struct {
-	Blkc         data.ChainHandler
-	Store        dataRetriever.StorageService
-	Datapool     dataRetriever.PoolsHolder
-	MetaDatapool dataRetriever.MetaPoolsHolder
+	Blkc           data.ChainHandler
+	Store          dataRetriever.StorageService
+	Datapool       dataRetriever.PoolsHolder
+	MetaDatapool   dataRetriever.MetaPoolsHolder
+	FinalityProofs storage.Cacher
 }
 
 // Crypto struct holds the crypto components of the Elrond protocol
@@ -145,6 +176,10 @@ type Process struct {
 	Rounder               consensus.Rounder
 	ForkDetector          process.ForkDetector
 	BlockProcessor        process.BlockProcessor
+	TxProcessor           process.TransactionProcessor
+	MessageTracer         *tracing.MessageTracer
+	RejectionTracker      *rejection.RejectionTracker
+	SCDeploymentsIndexer  process.SCDeploymentsIndexer
 }
 
 type coreComponentsFactoryArgs struct {
@@ -167,12 +202,18 @@ func CoreComponentsFactory(args *coreComponentsFactoryArgs) (*Core, error) {
 		return nil, errors.New("could not create hasher: " + err.Error())
 	}
 
+	hashers, err := createHasherRegistry(args.config, hasher)
+	if err != nil {
+		return nil, errors.New("could not create hasher registry: " + err.Error())
+	}
+
 	marshalizer, err := getMarshalizerFromConfig(args.config)
 	if err != nil {
 		return nil, errors.New("could not create marshalizer: " + err.Error())
 	}
 
-	merkleTrie, err := getTrie(args.config.AccountsTrieStorage, marshalizer, hasher, args.uniqueID)
+	trieHasher := hashers.HasherForEpoch(hashing.TrieNodesStructure, startEpoch)
+	merkleTrie, trieStorage, err := getTrie(args.config.AccountsTrieStorage, marshalizer, trieHasher, args.uniqueID)
 	if err != nil {
 		return nil, errors.New("error creating trie: " + err.Error())
 	}
@@ -180,8 +221,10 @@ func CoreComponentsFactory(args *coreComponentsFactoryArgs) (*Core, error) {
 
 	return &Core{
 		Hasher:                   hasher,
+		Hashers:                  hashers,
 		Marshalizer:              marshalizer,
 		Trie:                     merkleTrie,
+		TrieStorage:              trieStorage,
 		Uint64ByteSliceConverter: uint64ByteSliceConverter,
 		StatusHandler:            statusHandler.NewNilStatusHandler(),
 	}, nil
@@ -279,13 +322,13 @@ func DataComponentsFactory(args *dataComponentsFactoryArgs) (*Data, error) {
 	}
 
 	if args.shardCoordinator.SelfId() < args.shardCoordinator.NumberOfShards() {
-		datapool, err = createShardDataPoolFromConfig(args.config, args.core.Uint64ByteSliceConverter)
+		datapool, err = createShardDataPoolFromConfig(args.config, args.core.Uint64ByteSliceConverter, args.core.Marshalizer)
 		if err != nil {
 			return nil, errors.New("could not create shard data pools: " + err.Error())
 		}
 	}
 	if args.shardCoordinator.SelfId() == sharding.MetachainShardId {
-		metaDatapool, err = createMetaDataPoolFromConfig(args.config, args.core.Uint64ByteSliceConverter)
+		metaDatapool, err = createMetaDataPoolFromConfig(args.config, args.core.Uint64ByteSliceConverter, args.core.Marshalizer)
 		if err != nil {
 			return nil, errors.New("could not create shard data pools: " + err.Error())
 		}
@@ -294,11 +337,18 @@ func DataComponentsFactory(args *dataComponentsFactoryArgs) (*Data, error) {
 		return nil, errors.New("could not create data pools: ")
 	}
 
+	cacherCfg := getCacherFromConfig(args.config.FinalityProofsDataPool)
+	finalityProofs, err := storageUnit.NewCache(cacherCfg.Type, cacherCfg.Size, cacherCfg.Shards)
+	if err != nil {
+		return nil, errors.New("could not create finality proofs pool: " + err.Error())
+	}
+
 	return &Data{
-		Blkc:         blkc,
-		Store:        store,
-		Datapool:     datapool,
-		MetaDatapool: metaDatapool,
+		Blkc:           blkc,
+		Store:          store,
+		Datapool:       datapool,
+		MetaDatapool:   metaDatapool,
+		FinalityProofs: finalityProofs,
 	}, nil
 }
 
@@ -399,17 +449,73 @@ func NetworkComponentsFactory(p2pConfig *config.P2PConfig, log *logger.Logger, c
 		randReader = rand.Reader
 	}
 
-	netMessenger, err := createNetMessenger(p2pConfig, log, randReader)
+	netMessenger, statistics, err := createNetMessenger(p2pConfig, log, randReader, core)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Network{
 		NetMessenger: netMessenger,
+		Statistics:   statistics,
+	}, nil
+}
+
+// SeederNetworkComponentsFactory creates the network components of a node started in seeder mode. The seeder
+// messenger limits the number of connections and answers the peer exchange requests
+func SeederNetworkComponentsFactory(
+	p2pConfig *config.P2PConfig,
+	generalConfig *config.Config,
+	log *logger.Logger,
+) (*SeederNetwork, error) {
+
+	if !p2pConfig.KadDhtPeerDiscovery.Enabled {
+		return nil, errors.New("kad-dht peer discovery should be enabled in seeder mode")
+	}
+
+	hasher, err := getHasherFromConfig(generalConfig)
+	if err != nil {
+		return nil, errors.New("could not create hasher: " + err.Error())
+	}
+
+	var randReader io.Reader
+	if p2pConfig.Node.Seed != "" {
+		randReader = NewSeedRandReader(hasher.Compute(p2pConfig.Node.Seed))
+	} else {
+		randReader = rand.Reader
+	}
+
+	connLimiter, err := libp2p.NewConnectionLimiter(p2pConfig.Seeder.MaxConnections)
+	if err != nil {
+		return nil, err
+	}
+
+	nm, err := createLibp2pMessenger(p2pConfig, log, randReader, connLimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = nm.EnablePeerExchange(p2pConfig.Seeder.PeerExchangeMaxPeers)
+	if err != nil {
+		log.LogIfError(nm.Close())
+		return nil, err
+	}
+
+	err = nm.EnableDialBack()
+	if err != nil {
+		log.LogIfError(nm.Close())
+		return nil, err
+	}
+
+	return &SeederNetwork{
+		NetMessenger: nm,
+		PeerExchange: nm,
+		DialBack:     nm,
+		ConnLimiter:  connLimiter,
 	}, nil
 }
 
 type processComponentsFactoryArgs struct {
+	config               *config.Config
 	genesisConfig        *sharding.Genesis
 	economicsData        *economics.EconomicsData
 	nodesConfig          *sharding.NodesSetup
@@ -426,6 +532,7 @@ type processComponentsFactoryArgs struct {
 
 // NewProcessComponentsFactoryArgs initializes the arguments necessary for creating the process components
 func NewProcessComponentsFactoryArgs(
+	config *config.Config,
 	genesisConfig *sharding.Genesis,
 	economicsData *economics.EconomicsData,
 	nodesConfig *sharding.NodesSetup,
@@ -440,6 +547,7 @@ func NewProcessComponentsFactoryArgs(
 	coreServiceContainer serviceContainer.Core,
 ) *processComponentsFactoryArgs {
 	return &processComponentsFactoryArgs{
+		config:               config,
 		genesisConfig:        genesisConfig,
 		economicsData:        economicsData,
 		nodesConfig:          nodesConfig,
@@ -457,6 +565,30 @@ func NewProcessComponentsFactoryArgs(
 
 // ProcessComponentsFactory creates the process components
 func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, error) {
+	headerValidator, err := dataValidators.NewEpochHeaderValidator(
+		args.data.Blkc,
+		args.config.GeneralSettings.EpochGraceWindow,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	validityWindowChecker := transaction.NewValidityWindowChecker(args.config.GeneralSettings.TimeLockedTxsEnableEpoch)
+
+	rejectionTracker, err := newRejectionTracker(args.config)
+	if err != nil {
+		return nil, err
+	}
+
+	interceptorsTopicHandler, err := rejection.NewRecordingTopicHandler(
+		args.network.NetMessenger,
+		rejectionTracker,
+		args.config.RejectionTracking.MaxMessageSizeInBytes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	interceptorContainerFactory, resolversContainerFactory, err := newInterceptorAndResolverContainerFactory(
 		args.shardCoordinator,
 		args.nodesCoordinator,
@@ -464,7 +596,10 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		args.crypto,
 		args.state,
 		args.network,
+		interceptorsTopicHandler,
 		args.economicsData,
+		headerValidator,
+		validityWindowChecker,
 	)
 	if err != nil {
 		return nil, err
@@ -476,11 +611,21 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
+	messageTracer, err := newMessageTracer(args.config, args.core, interceptorsContainer)
+	if err != nil {
+		return nil, err
+	}
+
 	resolversContainer, err := resolversContainerFactory.Create()
 	if err != nil {
 		return nil, err
 	}
 
+	err = addFinalityProofInterceptorAndResolver(args, interceptorsTopicHandler, interceptorsContainer, resolversContainer)
+	if err != nil {
+		return nil, err
+	}
+
 	resolversFinder, err := containers.NewResolversFinder(resolversContainer, args.shardCoordinator)
 	if err != nil {
 		return nil, err
@@ -516,10 +661,36 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
-	blockProcessor, err := newBlockProcessor(
+	stateChangesAuditor, err := newStateChangesAuditor(args.config, args.data, args.core)
+	if err != nil {
+		return nil, err
+	}
+
+	specialAddressHolder, err := newSpecialAddressHolder(
+		args.economicsData,
+		args.state,
+		args.shardCoordinator,
+		args.nodesCoordinator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	scDeploymentsIndexer, err := newSCDeploymentsIndexer(args.config, args.data, args.core, specialAddressHolder)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalPolicy, err := preprocess.NewBlockProposalPolicy(args.config.BlockProposalPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	blockProcessor, txProcessor, err := newBlockProcessor(
 		resolversFinder,
 		args.shardCoordinator,
 		args.nodesCoordinator,
+		specialAddressHolder,
 		args.economicsData,
 		args.data,
 		args.core,
@@ -527,6 +698,10 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		forkDetector,
 		shardsGenesisBlocks,
 		args.coreServiceContainer,
+		stateChangesAuditor,
+		scDeploymentsIndexer,
+		proposalPolicy,
+		validityWindowChecker,
 	)
 
 	if err != nil {
@@ -539,9 +714,181 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		Rounder:               rounder,
 		ForkDetector:          forkDetector,
 		BlockProcessor:        blockProcessor,
+		TxProcessor:           txProcessor,
+		MessageTracer:         messageTracer,
+		RejectionTracker:      rejectionTracker,
+		SCDeploymentsIndexer:  scDeploymentsIndexer,
 	}, nil
 }
 
+// addFinalityProofInterceptorAndResolver registers, on the global finality proofs topic, the interceptor storing the
+// verified proofs in the finality proofs pool and the resolver serving them from that pool. Both are added to the
+// containers so that they are traced and reported as the other ones. The interceptor is registered through the
+// provided topic handler, as the other interceptors are, so that its rejections are recorded
+func addFinalityProofInterceptorAndResolver(
+	args *processComponentsFactoryArgs,
+	interceptorsTopicHandler process.TopicHandler,
+	interceptorsContainer process.InterceptorsContainer,
+	resolversContainer dataRetriever.ResolversContainer,
+) error {
+	messenger := args.network.NetMessenger
+	topic := factory.FinalityProofsTopic
+
+	interceptor, err := interceptors.NewFinalityProofInterceptor(
+		args.core.Marshalizer,
+		args.data.FinalityProofs,
+		args.crypto.MultiSigner,
+		args.shardCoordinator,
+		args.nodesCoordinator,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = messenger.CreateTopic(topic, true)
+	if err != nil {
+		return err
+	}
+	err = interceptorsTopicHandler.RegisterMessageProcessor(topic, interceptor)
+	if err != nil {
+		return err
+	}
+	err = interceptorsContainer.Add(topic, interceptor)
+	if err != nil {
+		return err
+	}
+
+	peerListCreator, err := topicResolverSender.NewDiffPeerListCreator(messenger, topic, "")
+	if err != nil {
+		return err
+	}
+	resolverSender, err := topicResolverSender.NewTopicResolverSender(
+		messenger,
+		topic,
+		peerListCreator,
+		args.core.Marshalizer,
+		&random.ConcurrentSafeIntRandomizer{},
+		args.shardCoordinator.SelfId(),
+	)
+	if err != nil {
+		return err
+	}
+	resolver, err := resolvers.NewFinalityProofResolver(resolverSender, args.data.FinalityProofs, args.core.Marshalizer)
+	if err != nil {
+		return err
+	}
+
+	requestTopic := topic + resolverSender.TopicRequestSuffix()
+	err = messenger.CreateTopic(requestTopic, false)
+	if err != nil {
+		return err
+	}
+	err = messenger.RegisterMessageProcessor(requestTopic, resolver)
+	if err != nil {
+		return err
+	}
+
+	return resolversContainer.Add(topic, resolver)
+}
+
+// newMessageTracer creates the message tracer and sets it on all the interceptors able to report their processing
+// stages. The topic found in the config, if any, is traced from start
+func newMessageTracer(
+	config *config.Config,
+	core *Core,
+	interceptorsContainer process.InterceptorsContainer,
+) (*tracing.MessageTracer, error) {
+	messageTracer, err := tracing.NewMessageTracer(core.Hasher, config.MessageTracing.MaxTraces)
+	if err != nil {
+		return nil, err
+	}
+
+	interceptorsContainer.Iterate(func(key string, interceptor process.Interceptor) bool {
+		traceableInterceptor, ok := interceptor.(process.TraceableInterceptor)
+		if !ok {
+			return true
+		}
+
+		err = traceableInterceptor.SetMessageTracer(messageTracer)
+		return err == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(config.MessageTracing.Topic) == 0 {
+		return messageTracer, nil
+	}
+
+	err = messageTracer.EnableTopic(config.MessageTracing.Topic, config.MessageTracing.SampleRate)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info(fmt.Sprintf("tracing 1 in %d messages received on topic %s",
+		config.MessageTracing.SampleRate,
+		config.MessageTracing.Topic))
+
+	return messageTracer, nil
+}
+
+// newRejectionTracker creates the tracker of the messages rejected by the interceptors, with the rolling window and
+// the reputation penalty weights found in the config
+func newRejectionTracker(config *config.Config) (*rejection.RejectionTracker, error) {
+	rejectionConfig := config.RejectionTracking
+	weights := map[string]uint64{
+		rejection.ReasonDecode:        rejectionConfig.Weights.Decode,
+		rejection.ReasonSignature:     rejectionConfig.Weights.Signature,
+		rejection.ReasonStale:         rejectionConfig.Weights.Stale,
+		rejection.ReasonFlood:         rejectionConfig.Weights.Flood,
+		rejection.ReasonSize:          rejectionConfig.Weights.Size,
+		rejection.ReasonShardMismatch: rejectionConfig.Weights.ShardMismatch,
+		rejection.ReasonOther:         rejectionConfig.Weights.Other,
+	}
+
+	return rejection.NewRejectionTracker(
+		time.Duration(rejectionConfig.WindowInSec)*time.Second,
+		rejectionConfig.NumBuckets,
+		rejectionConfig.MaxEntriesPerBucket,
+		weights,
+	)
+}
+
+func newStateChangesAuditor(config *config.Config, data *Data, core *Core) (process.SCStateChangesAuditor, error) {
+	if !config.SCStateChangesAudit.Enabled {
+		return smartContract.NewDisabledStateChangesAuditor(), nil
+	}
+
+	auditStorer := data.Store.GetStorer(dataRetriever.SCStateChangesAuditUnit)
+	if auditStorer == nil {
+		return smartContract.NewDisabledStateChangesAuditor(), nil
+	}
+
+	log.Info("smart contract state changes audit mode is enabled")
+
+	return smartContract.NewStateChangesAuditor(auditStorer, core.Marshalizer)
+}
+
+func newSCDeploymentsIndexer(
+	config *config.Config,
+	data *Data,
+	core *Core,
+	specialAddressHandler process.SpecialAddressHandler,
+) (process.SCDeploymentsIndexer, error) {
+	if !config.SCDeploymentsIndex.Enabled {
+		return smartContract.NewDisabledSCDeploymentsIndexer(), nil
+	}
+
+	indexStorer := data.Store.GetStorer(dataRetriever.SCDeploymentsUnit)
+	if indexStorer == nil {
+		return smartContract.NewDisabledSCDeploymentsIndexer(), nil
+	}
+
+	log.Info("smart contract deployments index is enabled")
+
+	return smartContract.NewSCDeploymentsIndexer(indexStorer, core.Marshalizer, specialAddressHandler)
+}
+
 func prepareGenesisBlock(args *processComponentsFactoryArgs, shardsGenesisBlocks map[uint32]data.HeaderHandler) error {
 	genesisBlock, ok := shardsGenesisBlocks[args.shardCoordinator.SelfId()]
 	if !ok {
@@ -641,14 +988,38 @@ func CreateViews(presenter view.Presenter) ([]factoryViews.Viewer, error) {
 }
 
 func getHasherFromConfig(cfg *config.Config) (hashing.Hasher, error) {
-	switch cfg.Hasher.Type {
-	case "sha256":
-		return sha256.Sha256{}, nil
-	case "blake2b":
-		return blake2b.Blake2b{}, nil
+	hasher, err := hasherFactory.NewHasher(cfg.Hasher.Type)
+	if err != nil {
+		return nil, errors.New("no hasher provided in config file")
 	}
 
-	return nil, errors.New("no hasher provided in config file")
+	return hasher, nil
+}
+
+func createHasherRegistry(cfg *config.Config, defaultHasher hashing.Hasher) (hashing.HasherRegistry, error) {
+	hashers, err := hashing.NewHasherRegistry(defaultHasher)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, structureHasher := range cfg.StructureHashers {
+		if structureHasher.StartEpoch > startEpoch {
+			return nil, fmt.Errorf("start epoch %d is not supported, as there is no epoch change, for structure %s",
+				structureHasher.StartEpoch, structureHasher.Structure)
+		}
+
+		hasher, err := hasherFactory.NewHasher(structureHasher.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%s for structure %s", err.Error(), structureHasher.Structure)
+		}
+
+		err = hashers.Register(structureHasher.Structure, structureHasher.StartEpoch, hasher)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", err.Error(), structureHasher.Structure)
+		}
+	}
+
+	return hashers, nil
 }
 
 func getMarshalizerFromConfig(cfg *config.Config) (marshal.Marshalizer, error) {
@@ -665,7 +1036,7 @@ func getTrie(
 	marshalizer marshal.Marshalizer,
 	hasher hashing.Hasher,
 	uniqueID string,
-) (data.Trie, error) {
+) (data.Trie, storage.Storer, error) {
 
 	accountsTrieStorage, err := storageUnit.NewStorageUnitFromConf(
 		getCacherFromConfig(cfg.Cache),
@@ -673,10 +1044,15 @@ func getTrie(
 		getBloomFromConfig(cfg.Bloom),
 	)
 	if err != nil {
-		return nil, errors.New("error creating accountsTrieStorage: " + err.Error())
+		return nil, nil, errors.New("error creating accountsTrieStorage: " + err.Error())
 	}
 
-	return trie.NewTrie(accountsTrieStorage, marshalizer, hasher)
+	merkleTrie, err := trie.NewTrie(accountsTrieStorage, marshalizer, hasher)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return merkleTrie, accountsTrieStorage, nil
 }
 
 func createBlockChainFromConfig(config *config.Config, coordinator sharding.Coordinator, ash core.AppStatusHandler) (data.ChainHandler, error) {
@@ -750,6 +1126,8 @@ func createShardDataStoreFromConfig(
 	var rewardTxUnit *storageUnit.Unit
 	var metaHdrHashNonceUnit *storageUnit.Unit
 	var shardHdrHashNonceUnit *storageUnit.Unit
+	var scStateChangesAuditUnit *storageUnit.Unit
+	var scDeploymentsUnit *storageUnit.Unit
 	var err error
 
 	defer func() {
@@ -782,6 +1160,12 @@ func createShardDataStoreFromConfig(
 			if shardHdrHashNonceUnit != nil {
 				_ = shardHdrHashNonceUnit.DestroyUnit()
 			}
+			if scStateChangesAuditUnit != nil {
+				_ = scStateChangesAuditUnit.DestroyUnit()
+			}
+			if scDeploymentsUnit != nil {
+				_ = scDeploymentsUnit.DestroyUnit()
+			}
 		}
 	}()
 
@@ -868,6 +1252,26 @@ func createShardDataStoreFromConfig(
 		return nil, err
 	}
 
+	if config.SCStateChangesAudit.Enabled {
+		scStateChangesAuditUnit, err = storageUnit.NewStorageUnitFromConf(
+			getCacherFromConfig(config.SCStateChangesAudit.AuditStorage.Cache),
+			getDBFromConfig(config.SCStateChangesAudit.AuditStorage.DB, uniqueID),
+			getBloomFromConfig(config.SCStateChangesAudit.AuditStorage.Bloom))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if config.SCDeploymentsIndex.Enabled {
+		scDeploymentsUnit, err = storageUnit.NewStorageUnitFromConf(
+			getCacherFromConfig(config.SCDeploymentsIndex.IndexStorage.Cache),
+			getDBFromConfig(config.SCDeploymentsIndex.IndexStorage.DB, uniqueID),
+			getBloomFromConfig(config.SCDeploymentsIndex.IndexStorage.Bloom))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	store := dataRetriever.NewChainStorer()
 	store.AddStorer(dataRetriever.TransactionUnit, txUnit)
 	store.AddStorer(dataRetriever.MiniBlockUnit, miniBlockUnit)
@@ -880,6 +1284,12 @@ func createShardDataStoreFromConfig(
 	hdrNonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardCoordinator.SelfId())
 	store.AddStorer(hdrNonceHashDataUnit, shardHdrHashNonceUnit)
 	store.AddStorer(dataRetriever.HeartbeatUnit, heartbeatStorageUnit)
+	if scStateChangesAuditUnit != nil {
+		store.AddStorer(dataRetriever.SCStateChangesAuditUnit, scStateChangesAuditUnit)
+	}
+	if scDeploymentsUnit != nil {
+		store.AddStorer(dataRetriever.SCDeploymentsUnit, scDeploymentsUnit)
+	}
 
 	return store, err
 }
@@ -1036,6 +1446,7 @@ func createMetaChainDataStoreFromConfig(
 func createShardDataPoolFromConfig(
 	config *config.Config,
 	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter,
+	marshalizer marshal.Marshalizer,
 ) (dataRetriever.PoolsHolder, error) {
 
 	log.Info("creatingShardDataPool from config")
@@ -1098,7 +1509,7 @@ func createShardDataPoolFromConfig(
 		return nil, err
 	}
 
-	return dataPool.NewShardedDataPool(
+	pools, err := dataPool.NewShardedDataPool(
 		txPool,
 		uTxPool,
 		rewardTxPool,
@@ -1108,11 +1519,26 @@ func createShardDataPoolFromConfig(
 		peerChangeBlockBody,
 		metaBlockBody,
 	)
+	if err != nil {
+		return nil, err
+	}
+	if !config.DataPoolsCopy.Enabled {
+		return pools, nil
+	}
+
+	cloner, err := dataPool.NewMarshalizerCloner(marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("data pools will hold and hand out deep copies of the pooled objects")
+	return dataPool.NewCopyingShardedDataPool(pools, cloner)
 }
 
 func createMetaDataPoolFromConfig(
 	config *config.Config,
 	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter,
+	marshalizer marshal.Marshalizer,
 ) (dataRetriever.MetaPoolsHolder, error) {
 	cacherCfg := getCacherFromConfig(config.MetaBlockBodyDataPool)
 	metaBlockBody, err := storageUnit.NewCache(cacherCfg.Type, cacherCfg.Size, cacherCfg.Shards)
@@ -1158,11 +1584,30 @@ func createMetaDataPoolFromConfig(
 		return nil, err
 	}
 
-	return dataPool.NewMetaDataPool(metaBlockBody, txBlockBody, shardHeaders, headersNonces, txPool, uTxPool)
+	pools, err := dataPool.NewMetaDataPool(metaBlockBody, txBlockBody, shardHeaders, headersNonces, txPool, uTxPool)
+	if err != nil {
+		return nil, err
+	}
+	if !config.DataPoolsCopy.Enabled {
+		return pools, nil
+	}
+
+	cloner, err := dataPool.NewMarshalizerCloner(marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("data pools will hold and hand out deep copies of the pooled objects")
+	return dataPool.NewCopyingMetaDataPool(pools, cloner)
 }
 
 func createSingleSigner(config *config.Config) (crypto.SingleSigner, error) {
-	switch config.Consensus.Type {
+	signatureScheme, err := sposFactory.GetSignatureScheme(config.Consensus.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	switch signatureScheme {
 	case BlsConsensusType:
 		return &singlesig.BlsSingleSigner{}, nil
 	case BnConsensusType:
@@ -1173,7 +1618,12 @@ func createSingleSigner(config *config.Config) (crypto.SingleSigner, error) {
 }
 
 func getMultisigHasherFromConfig(cfg *config.Config) (hashing.Hasher, error) {
-	if cfg.Consensus.Type == BlsConsensusType && cfg.MultisigHasher.Type != "blake2b" {
+	signatureScheme, err := sposFactory.GetSignatureScheme(cfg.Consensus.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if signatureScheme == BlsConsensusType && cfg.MultisigHasher.Type != "blake2b" {
 		return nil, errors.New("wrong multisig hasher provided for bls consensus type")
 	}
 
@@ -1181,7 +1631,7 @@ func getMultisigHasherFromConfig(cfg *config.Config) (hashing.Hasher, error) {
 	case "sha256":
 		return sha256.Sha256{}, nil
 	case "blake2b":
-		if cfg.Consensus.Type == BlsConsensusType {
+		if signatureScheme == BlsConsensusType {
 			return blake2b.Blake2b{HashSize: BlsHashSize}, nil
 		}
 		return blake2b.Blake2b{}, nil
@@ -1198,7 +1648,12 @@ func createMultiSigner(
 	keyGen crypto.KeyGenerator,
 ) (crypto.MultiSigner, error) {
 
-	switch config.Consensus.Type {
+	signatureScheme, err := sposFactory.GetSignatureScheme(config.Consensus.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	switch signatureScheme {
 	case BlsConsensusType:
 		blsSigner := &blsMultiSig.KyberMultiSignerBLS{}
 		return multisig.NewBLSMultisig(blsSigner, hasher, pubKeys, privateKey, keyGen, uint16(0))
@@ -1213,7 +1668,82 @@ func createNetMessenger(
 	p2pConfig *config.P2PConfig,
 	log *logger.Logger,
 	randReader io.Reader,
-) (p2p.Messenger, error) {
+	core *Core,
+) (p2p.Messenger, p2p.StatisticsHandler, error) {
+
+	var conMgr connmgr.ConnManager
+	if p2pConfig.PeerDiversity.Enabled {
+		peerDiversityLimiter, err := libp2p.NewPeerDiversityLimiter(
+			p2pConfig.PeerDiversity.MaxPeersPerNetworkGroup,
+			p2pConfig.PeerDiversity.Ipv4PrefixLength,
+			p2pConfig.PeerDiversity.Ipv6PrefixLength,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		log.Info(fmt.Sprintf("Using peer diversity policy: max %d peers per /%d ip v.4 or /%d ip v.6 network group",
+			p2pConfig.PeerDiversity.MaxPeersPerNetworkGroup,
+			p2pConfig.PeerDiversity.Ipv4PrefixLength,
+			p2pConfig.PeerDiversity.Ipv6PrefixLength,
+		))
+		conMgr = peerDiversityLimiter
+	}
+
+	libp2pMes, err := createLibp2pMessenger(p2pConfig, log, randReader, conMgr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var messenger p2p.Messenger = libp2pMes
+
+	if p2pConfig.Node.NetworkNamespace != "" {
+		log.Info(fmt.Sprintf("Using network namespace: %s", p2pConfig.Node.NetworkNamespace))
+
+		messenger, err = namespace.NewNamespacedMessenger(messenger, p2pConfig.Node.NetworkNamespace)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if p2pConfig.Chunking.Enabled {
+		messenger, err = chunking.NewChunkingMessenger(
+			messenger,
+			core.Marshalizer,
+			core.Hashers.HasherForEpoch(hashing.P2PChunksStructure, startEpoch),
+			p2pConfig.Chunking.MaxChunkSizeInBytes,
+			p2pConfig.Chunking.MaxNumChunks,
+			p2pConfig.Chunking.MaxPendingPayloads,
+			time.Duration(p2pConfig.Chunking.ReassemblyTimeoutInSec)*time.Second,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	refCountingMessenger, err := refcounting.NewRefCountingMessenger(messenger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return refCountingMessenger, libp2pMes, nil
+}
+
+type libp2pMessenger interface {
+	p2p.Messenger
+	p2p.PeerExchanger
+	p2p.DialBacker
+	TopicsStatistics() map[string]p2p.TopicStatistics
+	PendingBroadcasts() map[string]int
+	NumPubSubRestarts() uint64
+}
+
+func createLibp2pMessenger(
+	p2pConfig *config.P2PConfig,
+	log *logger.Logger,
+	randReader io.Reader,
+	conMgr connmgr.ConnManager,
+) (libp2pMessenger, error) {
 
 	if p2pConfig.Node.Port < 0 {
 		return nil, errors.New("cannot start node on port < 0")
@@ -1231,11 +1761,42 @@ func createNetMessenger(
 	prvKey, _ := ecdsa.GenerateKey(btcec.S256(), randReader)
 	sk := (*libp2pCrypto.Secp256k1PrivateKey)(prvKey)
 
+	if p2pConfig.Node.SwarmKeyFile != "" {
+		psk, errLoad := privnet.LoadSwarmKey(p2pConfig.Node.SwarmKeyFile)
+		if errLoad != nil {
+			return nil, errLoad
+		}
+
+		protector, errProtector := privnet.NewProtector(psk)
+		if errProtector != nil {
+			return nil, errProtector
+		}
+
+		log.Info(fmt.Sprintf("Joining the private network with swarm key fingerprint: %s",
+			hex.EncodeToString(protector.Fingerprint())))
+
+		nm, errMes := libp2p.NewPrivateNetworkMessenger(
+			context.Background(),
+			p2pConfig.Node.Port,
+			sk,
+			conMgr,
+			loadBalancer.NewOutgoingChannelLoadBalancer(),
+			pDiscoverer,
+			libp2p.ListenAddrWithIp4AndTcp,
+			protector,
+		)
+		if errMes != nil {
+			return nil, errMes
+		}
+
+		return nm, nil
+	}
+
 	nm, err := libp2p.NewNetworkMessenger(
 		context.Background(),
 		p2pConfig.Node.Port,
 		sk,
-		nil,
+		conMgr,
 		loadBalancer.NewOutgoingChannelLoadBalancer(),
 		pDiscoverer,
 		libp2p.ListenAddrWithIp4AndTcp,
@@ -1255,7 +1816,10 @@ func newInterceptorAndResolverContainerFactory(
 	crypto *Crypto,
 	state *State,
 	network *Network,
+	interceptorsTopicHandler process.TopicHandler,
 	economics *economics.EconomicsData,
+	headerValidator process.HeaderValidator,
+	validityWindow process.TxValidityWindowChecker,
 ) (process.InterceptorsContainerFactory, dataRetriever.ResolversContainerFactory, error) {
 
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
@@ -1267,7 +1831,10 @@ func newInterceptorAndResolverContainerFactory(
 			crypto,
 			state,
 			network,
+			interceptorsTopicHandler,
 			economics,
+			headerValidator,
+			validityWindow,
 		)
 	}
 	if shardCoordinator.SelfId() == sharding.MetachainShardId {
@@ -1278,8 +1845,11 @@ func newInterceptorAndResolverContainerFactory(
 			core,
 			crypto,
 			network,
+			interceptorsTopicHandler,
 			state,
 			economics,
+			headerValidator,
+			validityWindow,
 		)
 	}
 
@@ -1294,14 +1864,17 @@ func newShardInterceptorAndResolverContainerFactory(
 	crypto *Crypto,
 	state *State,
 	network *Network,
+	interceptorsTopicHandler process.TopicHandler,
 	economics *economics.EconomicsData,
+	headerValidator process.HeaderValidator,
+	validityWindow process.TxValidityWindowChecker,
 ) (process.InterceptorsContainerFactory, dataRetriever.ResolversContainerFactory, error) {
 
 	interceptorContainerFactory, err := shard.NewInterceptorsContainerFactory(
 		state.AccountsAdapter,
 		shardCoordinator,
 		nodesCoordinator,
-		network.NetMessenger,
+		interceptorsTopicHandler,
 		data.Store,
 		core.Marshalizer,
 		core.Hasher,
@@ -1312,6 +1885,9 @@ func newShardInterceptorAndResolverContainerFactory(
 		state.AddressConverter,
 		maxTxNonceDeltaAllowed,
 		economics,
+		headerValidator,
+		data.Blkc,
+		validityWindow,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -1345,14 +1921,17 @@ func newMetaInterceptorAndResolverContainerFactory(
 	core *Core,
 	crypto *Crypto,
 	network *Network,
+	interceptorsTopicHandler process.TopicHandler,
 	state *State,
 	economics *economics.EconomicsData,
+	headerValidator process.HeaderValidator,
+	validityWindow process.TxValidityWindowChecker,
 ) (process.InterceptorsContainerFactory, dataRetriever.ResolversContainerFactory, error) {
 
 	interceptorContainerFactory, err := metachain.NewInterceptorsContainerFactory(
 		shardCoordinator,
 		nodesCoordinator,
-		network.NetMessenger,
+		interceptorsTopicHandler,
 		data.Store,
 		core.Marshalizer,
 		core.Hasher,
@@ -1364,6 +1943,9 @@ func newMetaInterceptorAndResolverContainerFactory(
 		crypto.TxSignKeyGen,
 		maxTxNonceDeltaAllowed,
 		economics,
+		headerValidator,
+		data.Blkc,
+		validityWindow,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -1507,7 +2089,7 @@ func createInMemoryShardCoordinatorAndAccount(
 
 	accounts := generateInMemoryAccountsAdapter(
 		accountFactory,
-		coreComponents.Hasher,
+		coreComponents.Hashers.HasherForEpoch(hashing.TrieNodesStructure, startEpoch),
 		coreComponents.Marshalizer,
 	)
 
@@ -1528,19 +2110,12 @@ func newForkDetector(
 	return nil, ErrCreateForkDetector
 }
 
-func newBlockProcessor(
-	resolversFinder dataRetriever.ResolversFinder,
-	shardCoordinator sharding.Coordinator,
-	nodesCoordinator sharding.NodesCoordinator,
+func newSpecialAddressHolder(
 	economics *economics.EconomicsData,
-	data *Data,
-	core *Core,
 	state *State,
-	forkDetector process.ForkDetector,
-	shardsGenesisBlocks map[uint32]data.HeaderHandler,
-	coreServiceContainer serviceContainer.Core,
-) (process.BlockProcessor, error) {
-
+	shardCoordinator sharding.Coordinator,
+	nodesCoordinator sharding.NodesCoordinator,
+) (process.SpecialAddressHandler, error) {
 	communityAddr := economics.CommunityAddress()
 	burnAddr := economics.BurnAddress()
 	if communityAddr == "" || burnAddr == "" {
@@ -1568,6 +2143,27 @@ func newBlockProcessor(
 		return nil, err
 	}
 
+	return specialAddressHolder, nil
+}
+
+func newBlockProcessor(
+	resolversFinder dataRetriever.ResolversFinder,
+	shardCoordinator sharding.Coordinator,
+	nodesCoordinator sharding.NodesCoordinator,
+	specialAddressHolder process.SpecialAddressHandler,
+	economics *economics.EconomicsData,
+	data *Data,
+	core *Core,
+	state *State,
+	forkDetector process.ForkDetector,
+	shardsGenesisBlocks map[uint32]data.HeaderHandler,
+	coreServiceContainer serviceContainer.Core,
+	stateChangesAuditor process.SCStateChangesAuditor,
+	scDeploymentsIndexer process.SCDeploymentsIndexer,
+	proposalPolicy process.BlockProposalPolicy,
+	validityWindow process.TxValidityWindowChecker,
+) (process.BlockProcessor, process.TransactionProcessor, error) {
+
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
 		return newShardBlockProcessor(
 			resolversFinder,
@@ -1581,10 +2177,14 @@ func newBlockProcessor(
 			shardsGenesisBlocks,
 			coreServiceContainer,
 			economics,
+			stateChangesAuditor,
+			scDeploymentsIndexer,
+			proposalPolicy,
+			validityWindow,
 		)
 	}
 	if shardCoordinator.SelfId() == sharding.MetachainShardId {
-		return newMetaBlockProcessor(
+		metaProcessor, err := newMetaBlockProcessor(
 			resolversFinder,
 			shardCoordinator,
 			nodesCoordinator,
@@ -1596,9 +2196,10 @@ func newBlockProcessor(
 			shardsGenesisBlocks,
 			coreServiceContainer,
 		)
+		return metaProcessor, nil, err
 	}
 
-	return nil, errors.New("could not create block processor and tracker")
+	return nil, nil, errors.New("could not create block processor and tracker")
 }
 
 func newShardBlockProcessor(
@@ -1613,20 +2214,24 @@ func newShardBlockProcessor(
 	shardsGenesisBlocks map[uint32]data.HeaderHandler,
 	coreServiceContainer serviceContainer.Core,
 	economics *economics.EconomicsData,
-) (process.BlockProcessor, error) {
+	stateChangesAuditor process.SCStateChangesAuditor,
+	scDeploymentsIndexer process.SCDeploymentsIndexer,
+	proposalPolicy process.BlockProposalPolicy,
+	validityWindow process.TxValidityWindowChecker,
+) (process.BlockProcessor, process.TransactionProcessor, error) {
 	argsParser, err := smartContract.NewAtArgumentParser()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	vmFactory, err := shard.NewVMContainerFactory(state.AccountsAdapter, state.AddressConverter)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	vmContainer, err := vmFactory.Create()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
@@ -1640,32 +2245,37 @@ func newShardBlockProcessor(
 		economics,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	interimProcContainer, err := interimProcFactory.Create()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	scForwarder, err := interimProcContainer.Get(dataBlock.SmartContractResultBlock)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	rewardsTxInterim, err := interimProcContainer.Get(dataBlock.RewardsBlock)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	rewardsTxHandler, ok := rewardsTxInterim.(process.TransactionFeeHandler)
 	if !ok {
-		return nil, process.ErrWrongTypeAssertion
+		return nil, nil, process.ErrWrongTypeAssertion
 	}
 
 	internalTransactionProducer, ok := rewardsTxInterim.(process.InternalTransactionProducer)
 	if !ok {
-		return nil, process.ErrWrongTypeAssertion
+		return nil, nil, process.ErrWrongTypeAssertion
+	}
+
+	blockEconomics, ok := rewardsTxInterim.(process.BlockEconomicsHandler)
+	if !ok {
+		return nil, nil, process.ErrWrongTypeAssertion
 	}
 
 	scProcessor, err := smartContract.NewSmartContractProcessor(
@@ -1679,9 +2289,11 @@ func newShardBlockProcessor(
 		shardCoordinator,
 		scForwarder,
 		rewardsTxHandler,
+		stateChangesAuditor,
+		scDeploymentsIndexer,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	requestHandler, err := requestHandlers.NewShardResolverRequestHandler(
@@ -1695,7 +2307,7 @@ func newShardBlockProcessor(
 		MaxTxsToRequest,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	rewardsTxProcessor, err := rewardTransaction.NewRewardTxProcessor(
@@ -1705,12 +2317,12 @@ func newShardBlockProcessor(
 		rewardsTxInterim,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	txTypeHandler, err := coordinator.NewTxTypeHandler(state.AddressConverter, shardCoordinator, state.AccountsAdapter)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	transactionProcessor, err := transaction.NewTxProcessor(
@@ -1723,9 +2335,11 @@ func newShardBlockProcessor(
 		rewardsTxHandler,
 		txTypeHandler,
 		economics,
+		validityWindow,
+		specialAddressHandler,
 	)
 	if err != nil {
-		return nil, errors.New("could not create transaction processor: " + err.Error())
+		return nil, nil, errors.New("could not create transaction processor: " + err.Error())
 	}
 
 	preProcFactory, err := shard.NewPreProcessorsContainerFactory(
@@ -1743,14 +2357,15 @@ func newShardBlockProcessor(
 		rewardsTxProcessor,
 		internalTransactionProducer,
 		economics,
+		proposalPolicy,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	preProcContainer, err := preProcFactory.Create()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	txCoordinator, err := coordinator.NewTransactionCoordinator(
@@ -1762,7 +2377,7 @@ func newShardBlockProcessor(
 		interimProcContainer,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	txPoolsCleaner, err := poolsCleaner.NewTxsPoolsCleaner(
@@ -1772,7 +2387,16 @@ func newShardBlockProcessor(
 		state.AddressConverter,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	headersPoolsCleaner, err := poolsCleaner.NewHeadersPoolsCleaner(
+		data.Datapool.Headers(),
+		data.Datapool.MetaBlocks(),
+		data.Datapool.HeadersNonces(),
+	)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	argumentsBaseProcessor := block.ArgBaseProcessor{
@@ -1787,6 +2411,7 @@ func newShardBlockProcessor(
 		Uint64Converter:       core.Uint64ByteSliceConverter,
 		StartHeaders:          shardsGenesisBlocks,
 		RequestHandler:        requestHandler,
+		HeadersPoolsCleaner:   headersPoolsCleaner,
 		Core:                  coreServiceContainer,
 	}
 	arguments := block.ArgShardProcessor{
@@ -1794,19 +2419,20 @@ func newShardBlockProcessor(
 		DataPool:         data.Datapool,
 		TxCoordinator:    txCoordinator,
 		TxsPoolsCleaner:  txPoolsCleaner,
+		BlockEconomics:   blockEconomics,
 	}
 
 	blockProcessor, err := block.NewShardProcessor(arguments)
 	if err != nil {
-		return nil, errors.New("could not create block processor: " + err.Error())
+		return nil, nil, errors.New("could not create block processor: " + err.Error())
 	}
 
 	err = blockProcessor.SetAppStatusHandler(core.StatusHandler)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return blockProcessor, nil
+	return blockProcessor, transactionProcessor, nil
 }
 
 func newMetaBlockProcessor(
@@ -1834,6 +2460,15 @@ func newMetaBlockProcessor(
 		return nil, err
 	}
 
+	headersPoolsCleaner, err := poolsCleaner.NewHeadersPoolsCleaner(
+		data.MetaDatapool.ShardHeaders(),
+		data.MetaDatapool.MetaBlocks(),
+		data.MetaDatapool.HeadersNonces(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	argumentsBaseProcessor := block.ArgBaseProcessor{
 		Accounts:              state.AccountsAdapter,
 		ForkDetector:          forkDetector,
@@ -1846,6 +2481,7 @@ func newMetaBlockProcessor(
 		Uint64Converter:       core.Uint64ByteSliceConverter,
 		StartHeaders:          shardsGenesisBlocks,
 		RequestHandler:        requestHandler,
+		HeadersPoolsCleaner:   headersPoolsCleaner,
 		Core:                  coreServiceContainer,
 	}
 	arguments := block.ArgMetaProcessor{
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -20,8 +22,11 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/api"
 	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/appStatusPolling"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
@@ -29,17 +34,28 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/statistics/machine"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/kyber"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/lightClient"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/selftest"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/knownPeers"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	factoryVM "github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/invariants"
+	"github.com/ElrondNetwork/elrond-go/process/preload"
+	"github.com/ElrondNetwork/elrond-go/process/replay"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -52,22 +68,29 @@ import (
 )
 
 const (
-	defaultLogPath      = "logs"
-	defaultStatsPath    = "stats"
-	defaultDBPath       = "db"
-	defaultEpochString  = "Epoch"
-	defaultShardString  = "Shard"
-	metachainShardName  = "metachain"
-	milisecondsInSecond = 1000
-	DefaultRestApiPort  = "off"
+	defaultLogPath       = "logs"
+	defaultStatsPath     = "stats"
+	defaultDBPath        = "db"
+	defaultDumpsPath     = "pools-dumps"
+	defaultReplaysPath   = "replays"
+	defaultBundlesPath   = "epoch-bundles"
+	defaultForensicsPath = "state-forensics"
+	defaultEpochString   = "Epoch"
+	defaultShardString   = "Shard"
+	metachainShardName   = "metachain"
+	milisecondsInSecond  = 1000
+	DefaultRestApiPort   = "off"
 )
 
 var (
 	nodeHelpTemplate = `NAME:
    {{.Name}} - {{.Usage}}
 USAGE:
-   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
-   {{if len .Authors}}
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}{{if .VisibleCommands}} [command]{{end}}
+   {{if .VisibleCommands}}
+COMMANDS:
+   {{range .VisibleCommands}}{{.Name}}{{"\t"}}{{.Usage}}
+   {{end}}{{end}}{{if len .Authors}}
 AUTHOR:
    {{range .Authors}}{{ . }}{{end}}
    {{end}}{{if .Commands}}
@@ -265,6 +288,44 @@ VERSION:
 		Value: "",
 	}
 
+	// seederMode defines a flag that starts the node as a p2p seeder (bootnode). A seeder does not process or store
+	// blocks, it only helps the other nodes to discover each other
+	seederMode = cli.BoolFlag{
+		Name:  "seeder-mode",
+		Usage: "Starts the node as a seeder (bootnode): no processing and no storage, only peer discovery and peer exchange",
+	}
+
+	// loadPoolsDump defines a flag for the path of a pools dump file, created through the admin pools REST API route,
+	// whose entries will be added in the node's pools at startup
+	loadPoolsDump = cli.StringFlag{
+		Name:  "load-pools-dump",
+		Usage: "Loads in the node's pools, at startup, the entries of the provided pools dump file",
+		Value: "",
+	}
+
+	// replayBlockNonce defines a flag for the nonce of a stored block that will be re-executed, starting from its
+	// parent's state root. The node writes the replay report and exits without joining the network
+	replayBlockNonce = cli.Uint64Flag{
+		Name:  "replay-block",
+		Usage: "Re-executes the stored block with the provided nonce, writes the replay report in the replays folder and exits",
+	}
+
+	// replayReferenceFile defines a flag for the path of a replay report, usually created by another node, whose
+	// transaction checkpoints will be compared with the ones of the replayed block
+	replayReferenceFile = cli.StringFlag{
+		Name:  "replay-reference",
+		Usage: "The replay report whose checkpoints will be compared with the replayed block's checkpoints",
+		Value: "",
+	}
+
+	// exportEpochBundleFlag defines a flag for the epoch whose proof bundle will be exported from the node's storage.
+	// The node writes the bundle and exits without joining the network
+	exportEpochBundleFlag = cli.Uint64Flag{
+		Name: "export-epoch-bundle",
+		Usage: "Exports the proof bundle of the provided epoch (metachain headers, validator set, notarized shard " +
+			"headers and finality proofs) in the epoch-bundles folder and exits. Should be used on a metachain node",
+	}
+
 	rm *statistics.ResourceMonitor
 )
 
@@ -321,6 +382,11 @@ func main() {
 		enableTxIndexing,
 		workingDirectory,
 		destinationShardAsObserver,
+		seederMode,
+		loadPoolsDump,
+		replayBlockNonce,
+		replayReferenceFile,
+		exportEpochBundleFlag,
 	}
 	app.Authors = []cli.Author{
 		{
@@ -336,6 +402,15 @@ func main() {
 	app.Action = func(c *cli.Context) error {
 		return startNode(c, log, app.Version)
 	}
+	app.Commands = []cli.Command{
+		{
+			Name:  "selftest",
+			Usage: "Checks the node's environment (clock sync, disk throughput, open files limit, p2p port reachability, key files and config consistency) and exits with a pass/fail report",
+			Action: func(c *cli.Context) error {
+				return runSelfTest(c, log)
+			},
+		},
+	}
 
 	err := app.Run(os.Args)
 	if err != nil {
@@ -345,7 +420,12 @@ func main() {
 }
 
 func getSuite(config *config.Config) (crypto.Suite, error) {
-	switch config.Consensus.Type {
+	signatureScheme, err := sposFactory.GetSignatureScheme(config.Consensus.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	switch signatureScheme {
 	case factory.BlsConsensusType:
 		return kyber.NewSuitePairingBn256(), nil
 	case factory.BnConsensusType:
@@ -393,6 +473,10 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		p2pConfig.Node.Port = ctx.GlobalInt(port.Name)
 	}
 
+	if ctx.GlobalBool(seederMode.Name) {
+		return startSeeder(ctx, log, generalConfig, p2pConfig, sigs)
+	}
+
 	genesisConfig, err := sharding.NewGenesisConfig(ctx.GlobalString(genesisFile.Name))
 	if err != nil {
 		return err
@@ -629,6 +713,26 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		return err
 	}
 
+	gasPriceStats, err := statistics.NewGasPriceStats(generalConfig.GasPriceStats.NumBlocks)
+	if err != nil {
+		return err
+	}
+
+	var accountsNotifier *subscription.AccountsNotifier
+	if generalConfig.AccountsSubscriptions.Enabled && shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
+		accountsNotifier, err = subscription.NewAccountsNotifier(
+			stateComponents.AccountsAdapter,
+			stateComponents.AddressConverter,
+			shardCoordinator,
+			generalConfig.AccountsSubscriptions.MaxSubscribers,
+			generalConfig.AccountsSubscriptions.MaxAddressesPerSubscriber,
+			generalConfig.AccountsSubscriptions.BufferSize,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	if generalConfig.Explorer.Enabled {
 		serversConfigurationFileName := ctx.GlobalString(serversConfigurationFile.Name)
 		dbIndexer, err = createElasticIndexer(
@@ -642,11 +746,11 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		if err != nil {
 			return err
 		}
+	}
 
-		err = setServiceContainer(shardCoordinator, tpsBenchmark)
-		if err != nil {
-			return err
-		}
+	err = setServiceContainer(shardCoordinator, tpsBenchmark, gasPriceStats, accountsNotifier)
+	if err != nil {
+		return err
 	}
 
 	economicsData, err := economics.NewEconomicsData(economicsConfig)
@@ -655,6 +759,7 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 	}
 
 	processArgs := factory.NewProcessComponentsFactoryArgs(
+		generalConfig,
 		genesisConfig,
 		economicsData,
 		nodesConfig,
@@ -673,6 +778,14 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		return err
 	}
 
+	if ctx.IsSet(replayBlockNonce.Name) {
+		return replayBlock(ctx, log, workingDir, shardCoordinator, coreComponents, stateComponents, dataComponents, processComponents)
+	}
+
+	if ctx.IsSet(exportEpochBundleFlag.Name) {
+		return exportEpochBundle(ctx, log, workingDir, nodesConfig, shardCoordinator, coreComponents, dataComponents)
+	}
+
 	var elasticIndexer indexer.Indexer
 	if coreServiceContainer == nil || coreServiceContainer.IsInterfaceNil() {
 		elasticIndexer = nil
@@ -698,6 +811,7 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		uint64(ctx.GlobalUint(bootstrapRoundIndex.Name)),
 		version,
 		elasticIndexer,
+		filepath.Join(workingDir, defaultForensicsPath),
 	)
 	if err != nil {
 		return err
@@ -715,11 +829,59 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		return err
 	}
 
-	apiResolver, err := createApiResolver(vmAccountsDB, statusMetrics)
+	diagnosticsReporter, err := external.NewDiagnosticsReporter(
+		networkComponents.Statistics,
+		processComponents.InterceptorsContainer,
+		dataComponents.Datapool,
+		dataComponents.MetaDatapool,
+		shardCoordinator,
+	)
+	if err != nil {
+		return err
+	}
+	dumpDiagnosticsOnSignal(diagnosticsReporter, log)
+
+	readinessChecker, err := external.NewReadinessChecker(external.ArgReadinessChecker{
+		BlockChain:       dataComponents.Blkc,
+		ForkDetector:     processComponents.ForkDetector,
+		Store:            dataComponents.Store,
+		ShardCoordinator: shardCoordinator,
+		Peers:            networkComponents.NetMessenger,
+		NodeType:         nodeType,
+		PrivateKey:       privKey,
+		Config:           generalConfig.Readiness,
+	})
+	if err != nil {
+		return err
+	}
+
+	apiResolver, err := createApiResolver(
+		vmAccountsDB,
+		stateComponents.AccountsAdapter,
+		statusMetrics,
+		dataComponents,
+		shardCoordinator,
+		nodesCoordinator,
+		coreComponents,
+		processComponents,
+		diagnosticsReporter,
+		readinessChecker,
+		filepath.Join(workingDir, defaultDumpsPath),
+		generalConfig.ChainExport,
+	)
 	if err != nil {
 		return err
 	}
 
+	if ctx.IsSet(loadPoolsDump.Name) {
+		dumpFile := ctx.GlobalString(loadPoolsDump.Name)
+		numLoaded, errLoad := apiResolver.LoadPoolsDump(dumpFile)
+		if errLoad != nil {
+			return errLoad
+		}
+		log.Info(fmt.Sprintf("loaded %d pool entries from %s", numLoaded, dumpFile))
+	}
+
 	err = startStatusPolling(
 		currentNode.GetAppStatusHandler(),
 		generalConfig.GeneralSettings.StatusPollingIntervalSec,
@@ -736,6 +898,39 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		return err
 	}
 
+	invariantCheckerCtx, cancelInvariantChecker := context.WithCancel(context.Background())
+	defer cancelInvariantChecker()
+
+	if generalConfig.InvariantChecker.Enabled && shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
+		err = startAccountsInvariantChecker(
+			invariantCheckerCtx,
+			generalConfig.InvariantChecker,
+			nodesConfig,
+			genesisConfig,
+			economicsData,
+			shardCoordinator,
+			coreComponents,
+			stateComponents,
+			dataComponents,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	configFingerprint, err := external.ComputeConfigFingerprint(external.ArgConfigFingerprint{
+		Marshalizer:       coreComponents.Marshalizer,
+		Hasher:            coreComponents.Hasher,
+		GenesisHeaderHash: dataComponents.Blkc.GetGenesisHeaderHash(),
+		NodesSetup:        nodesConfig,
+		Economics:         economicsConfig,
+		GeneralConfig:     generalConfig,
+	})
+	if err != nil {
+		return err
+	}
+	log.Info("configuration fingerprint: " + configFingerprint.Fingerprint)
+
 	restAPIServerDebugMode := !useTermui
 	ef := facade.NewElrondNodeFacade(currentNode, apiResolver, restAPIServerDebugMode)
 
@@ -745,17 +940,50 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 		Prometheus:        usePrometheusBool,
 		PrometheusJoinURL: prometheusJoinUrl,
 		PrometheusJobName: generalConfig.GeneralSettings.NetworkID,
+		AdminApiToken:     getAdminApiToken(ctx.GlobalString(serversConfigurationFile.Name), log),
+		ApiShardFilter:    getApiShardFilterConfig(generalConfig.ApiShardFilter, shardCoordinator),
 	}
 
 	ef.SetLogger(log)
 	ef.SetSyncer(syncer)
 	ef.SetTpsBenchmark(tpsBenchmark)
+	ef.SetGasPriceStats(gasPriceStats)
+	ef.SetAccountsNotifier(accountsNotifier)
+	ef.SetConfigFingerprint(configFingerprint)
+	ef.SetSCDeploymentsIndexer(processComponents.SCDeploymentsIndexer)
 	ef.SetConfig(efConfig)
 
 	wg := sync.WaitGroup{}
 	go ef.StartBackgroundServices(&wg)
 	wg.Wait()
 
+	var knownPeersPersister knownPeers.PeersPersister
+	if p2pConfig.KnownPeers.Enabled {
+		knownPeersPersister, err = knownPeers.NewKnownPeersPersister(
+			filepath.Join(workingDir, defaultDBPath, p2pConfig.KnownPeers.FileName),
+			time.Duration(p2pConfig.KnownPeers.MaxAgeInHours)*time.Hour,
+			p2pConfig.KnownPeers.MaxPeers,
+		)
+		if err != nil {
+			return err
+		}
+
+		reconnectToKnownPeers(
+			knownPeersPersister,
+			networkComponents.NetMessenger,
+			shardId,
+			time.Duration(p2pConfig.KnownPeers.ReconnectTimeoutInSec)*time.Second,
+			log,
+		)
+	}
+
+	if generalConfig.StorerPreloader.Enabled {
+		err = preloadStorers(generalConfig.StorerPreloader, dataComponents, coreComponents, shardCoordinator, log)
+		if err != nil {
+			return err
+		}
+	}
+
 	if !ctx.Bool(withUI.Name) {
 		log.Info("Bootstrapping node....")
 		err = ef.StartNode()
@@ -774,6 +1002,11 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 	log.Info("Application is now running...")
 	<-stop
 
+	if knownPeersPersister != nil && !knownPeersPersister.IsInterfaceNil() {
+		consensusTopic := core.ConsensusTopic + shardCoordinator.CommunicationIdentifier(shardCoordinator.SelfId())
+		saveKnownPeers(knownPeersPersister, networkComponents.NetMessenger, consensusTopic, shardId, log)
+	}
+
 	if rm != nil {
 		err = rm.Close()
 		log.LogIfError(err)
@@ -781,6 +1014,164 @@ func startNode(ctx *cli.Context, log *logger.Logger, version string) error {
 	return nil
 }
 
+func startSeeder(
+	ctx *cli.Context,
+	log *logger.Logger,
+	generalConfig *config.Config,
+	p2pConfig *config.P2PConfig,
+	sigs chan os.Signal,
+) error {
+	log.Info("Starting node in seeder mode...")
+
+	seederNetwork, err := factory.SeederNetworkComponentsFactory(p2pConfig, generalConfig, log)
+	if err != nil {
+		return err
+	}
+
+	statusMetrics := statusHandler.NewStatusMetrics()
+	appStatusHandlers := []core.AppStatusHandler{statusMetrics}
+	_, usePrometheusBool := getPrometheusJoinURLIfAvailable(ctx)
+	if usePrometheusBool {
+		appStatusHandlers = append(appStatusHandlers, statusHandler.NewPrometheusStatusHandler())
+	}
+
+	appStatusHandler, err := statusHandler.NewAppStatusFacadeWithHandlers(appStatusHandlers...)
+	if err != nil {
+		return err
+	}
+
+	appStatusPollingHandler, err := appStatusPolling.NewAppStatusPolling(
+		appStatusHandler,
+		p2pConfig.Seeder.MetricsRefreshIntervalInSec,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = appStatusPollingHandler.RegisterPollingFunc(func(ash core.AppStatusHandler) {
+		numConnectedPeers := uint64(len(seederNetwork.NetMessenger.ConnectedPeers()))
+		numRejectedConnections := seederNetwork.ConnLimiter.NumRejectedConnections()
+		numPeerExchangeRequests := seederNetwork.PeerExchange.NumPeerExchangeRequests()
+		numDialBackRequests := seederNetwork.DialBack.NumDialBackRequests()
+
+		ash.SetUInt64Value(core.MetricSeederConnectedPeers, numConnectedPeers)
+		ash.SetUInt64Value(core.MetricSeederRejectedConnections, numRejectedConnections)
+		ash.SetUInt64Value(core.MetricSeederPeerExchangeRequests, numPeerExchangeRequests)
+		ash.SetUInt64Value(core.MetricSeederDialBackRequests, numDialBackRequests)
+
+		log.Info(fmt.Sprintf("seeder: %d connected peers, %d rejected connections, %d peer exchange requests, "+
+			"%d dial back requests",
+			numConnectedPeers,
+			numRejectedConnections,
+			numPeerExchangeRequests,
+			numDialBackRequests,
+		))
+	})
+	if err != nil {
+		return err
+	}
+	appStatusPollingHandler.Poll()
+
+	go func() {
+		err := api.StartSeeder(ctx.GlobalString(restApiPort.Name), statusMetrics, usePrometheusBool)
+		if err != nil {
+			log.Error("could not start the seeder webserver", err.Error())
+		}
+	}()
+
+	err = seederNetwork.NetMessenger.Bootstrap()
+	if err != nil {
+		return err
+	}
+
+	for _, address := range seederNetwork.NetMessenger.Addresses() {
+		log.Info("seeder address: " + address + "/p2p/" + seederNetwork.NetMessenger.ID().Pretty())
+	}
+
+	log.Info("Seeder is now running...")
+	<-sigs
+	log.Info("terminating at user's signal...")
+
+	return seederNetwork.NetMessenger.Close()
+}
+
+func preloadStorers(
+	preloaderConfig config.StorerPreloaderConfig,
+	dataComponents *factory.Data,
+	coreComponents *factory.Core,
+	shardCoordinator sharding.Coordinator,
+	log *logger.Logger,
+) error {
+	storerPreloader, err := preload.NewStorerPreloader(preload.ArgStorerPreloader{
+		Store:            dataComponents.Store,
+		TrieStorage:      coreComponents.TrieStorage,
+		Marshalizer:      coreComponents.Marshalizer,
+		Uint64Converter:  coreComponents.Uint64ByteSliceConverter,
+		ShardCoordinator: shardCoordinator,
+		Config:           preloaderConfig,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("warming up the storers caches...")
+	stats := storerPreloader.Preload()
+	log.Info(fmt.Sprintf("storers caches warmed up in %v: highest nonce %d, %d headers, %d miniblocks, "+
+		"%d trie nodes, %d missing entries, interrupted: %v",
+		stats.Duration, stats.HighestNonce, stats.NumHeaders, stats.NumMiniBlocks, stats.NumTrieNodes,
+		stats.NumMissing, stats.Interrupted))
+
+	return nil
+}
+
+func reconnectToKnownPeers(
+	persister knownPeers.PeersPersister,
+	messenger p2p.Messenger,
+	shardTag string,
+	timeout time.Duration,
+	log *logger.Logger,
+) {
+	peers, err := persister.Load()
+	if err != nil {
+		log.Warn("could not load the known peers list", err.Error())
+		return
+	}
+	if len(peers) == 0 {
+		return
+	}
+
+	log.Info(fmt.Sprintf("reconnecting to %d known peers...", len(peers)))
+	numConnected, err := knownPeers.ReconnectToKnownPeers(messenger, peers, shardTag, timeout)
+	if err != nil {
+		log.Warn("could not reconnect to the known peers", err.Error())
+		return
+	}
+
+	log.Info(fmt.Sprintf("reconnected to %d out of %d known peers", numConnected, len(peers)))
+}
+
+func saveKnownPeers(
+	persister knownPeers.PeersPersister,
+	messenger p2p.Messenger,
+	shardTopic string,
+	shardTag string,
+	log *logger.Logger,
+) {
+	peers, err := knownPeers.CollectConnectedPeers(messenger, shardTopic, shardTag)
+	if err != nil {
+		log.Warn("could not collect the connected peers", err.Error())
+		return
+	}
+
+	err = persister.Save(peers)
+	if err != nil {
+		log.Warn("could not save the known peers list", err.Error())
+		return
+	}
+
+	log.Info(fmt.Sprintf("saved %d connected peers in the known peers list", len(peers)))
+}
+
 func indexValidatorsListIfNeeded(elasticIndexer indexer.Indexer, coordinator sharding.NodesCoordinator) {
 	if elasticIndexer == nil || elasticIndexer.IsInterfaceNil() {
 		return
@@ -830,6 +1221,7 @@ func initMetrics(
 	appStatusHandler.SetUInt64Value(core.MetricNumShardHeadersFromPool, initUint)
 	appStatusHandler.SetUInt64Value(core.MetricNumShardHeadersProcessed, initUint)
 	appStatusHandler.SetUInt64Value(core.MetricNumTimesInForkChoice, initUint)
+	appStatusHandler.SetUInt64Value(core.MetricNumStaleHeadersRejected, initUint)
 	appStatusHandler.SetStringValue(core.MetricPublicKeyTxSign, initString)
 	appStatusHandler.SetUInt64Value(core.MetricHighestFinalBlockInShard, initUint)
 	appStatusHandler.SetUInt64Value(core.MetricCountConsensusAcceptedBlocks, initUint)
@@ -917,6 +1309,44 @@ func registerPollProbableHighestNonce(
 	return nil
 }
 
+func startAccountsInvariantChecker(
+	ctx context.Context,
+	invariantCheckerConfig config.InvariantCheckerConfig,
+	nodesConfig *sharding.NodesSetup,
+	genesisConfig *sharding.Genesis,
+	rewardsHandler process.RewardsHandler,
+	shardCoordinator sharding.Coordinator,
+	coreComponents *factory.Core,
+	stateComponents *factory.State,
+	dataComponents *factory.Data,
+) error {
+	if invariantCheckerConfig.CheckIntervalInSec < 1 {
+		return errors.New("invalid invariant checker interval")
+	}
+
+	genesisShardSupply, err := genesisConfig.ShardTotalSupply(shardCoordinator, stateComponents.AddressConverter)
+	if err != nil {
+		return err
+	}
+
+	maxRewardsPerRound := uint64(nodesConfig.ConsensusGroupSize + nodesConfig.MetaChainConsensusGroupSize)
+	checker, err := invariants.NewAccountsBalanceChecker(
+		coreComponents.Trie,
+		coreComponents.Marshalizer,
+		dataComponents.Blkc,
+		rewardsHandler,
+		genesisShardSupply,
+		maxRewardsPerRound,
+	)
+	if err != nil {
+		return err
+	}
+
+	checker.StartChecking(ctx, time.Duration(invariantCheckerConfig.CheckIntervalInSec)*time.Second)
+
+	return nil
+}
+
 func startMachineStatisticsPolling(ash core.AppStatusHandler, pollingInterval int) error {
 	if ash == nil {
 		return errors.New("nil AppStatusHandler")
@@ -1006,6 +1436,19 @@ func getPrometheusJoinURLIfAvailable(ctx *cli.Context) (string, bool) {
 	return prometheusJoinUrl, usePrometheusBool
 }
 
+func getAdminApiToken(serversConfigurationFileName string, log *logger.Logger) string {
+	serversConfig, err := core.LoadServersPConfig(serversConfigurationFileName)
+	if err != nil {
+		log.Warn("admin REST API routes disabled, could not load servers config", err.Error())
+		return ""
+	}
+	if serversConfig.AdminApi.Token != "" {
+		log.Info("admin REST API routes enabled")
+	}
+
+	return serversConfig.AdminApi.Token
+}
+
 func getPrometheusJoinURL(serversConfigurationFileName string) (string, error) {
 	serversConfig, err := core.LoadServersPConfig(serversConfigurationFileName)
 	if err != nil {
@@ -1024,6 +1467,19 @@ func getPrometheusJoinURL(serversConfigurationFileName string) (string, error) {
 	return joinURL, nil
 }
 
+// getApiShardFilterConfig returns the API shard filter settings. If no served shards are declared, the node will
+// serve only its own shard
+func getApiShardFilterConfig(
+	filterConfig config.ApiShardFilterConfig,
+	shardCoordinator sharding.Coordinator,
+) config.ApiShardFilterConfig {
+	if len(filterConfig.ServedShards) == 0 {
+		filterConfig.ServedShards = []uint32{shardCoordinator.SelfId()}
+	}
+
+	return filterConfig
+}
+
 func enableGopsIfNeeded(ctx *cli.Context, log *logger.Logger) {
 	var gopsEnabled bool
 	if ctx.IsSet(gopsEn.Name) {
@@ -1223,6 +1679,40 @@ func getConsensusGroupSize(nodesConfig *sharding.NodesSetup, shardCoordinator sh
 	return 0, state.ErrUnknownShardId
 }
 
+// createParticipationGate creates the gate keeping the validator out of consensus while it is not synced or not
+// connected to enough peers. The shard peers are the ones connected on the node's consensus topic and the metachain
+// peers the ones connected on the metachain consensus topic
+func createParticipationGate(
+	gatesConfig config.ConsensusGatesConfig,
+	shardCoordinator sharding.Coordinator,
+	blockChain data.ChainHandler,
+	forkDetector process.ForkDetector,
+	messenger p2p.Messenger,
+) (spos.ParticipationGate, error) {
+	if !gatesConfig.Enabled {
+		return spos.NewDisabledParticipationGate(), nil
+	}
+
+	metachainCoordinator, err := sharding.NewMultiShardCoordinator(
+		shardCoordinator.NumberOfShards(),
+		sharding.MetachainShardId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return spos.NewSyncAndPeersGate(spos.ArgSyncAndPeersGate{
+		BlockChain:        blockChain,
+		ForkDetector:      forkDetector,
+		Messenger:         messenger,
+		ShardTopic:        core.ConsensusTopic + shardCoordinator.CommunicationIdentifier(shardCoordinator.SelfId()),
+		MetachainTopic:    core.ConsensusTopic + metachainCoordinator.CommunicationIdentifier(sharding.MetachainShardId),
+		MaxNoncesBehind:   gatesConfig.MaxNoncesBehind,
+		MinShardPeers:     gatesConfig.MinShardPeers,
+		MinMetachainPeers: gatesConfig.MinMetachainPeers,
+	})
+}
+
 func createNode(
 	config *config.Config,
 	nodesConfig *sharding.NodesSetup,
@@ -1241,12 +1731,24 @@ func createNode(
 	bootstrapRoundIndex uint64,
 	version string,
 	indexer indexer.Indexer,
+	stateForensicsFolder string,
 ) (*node.Node, error) {
 	consensusGroupSize, err := getConsensusGroupSize(nodesConfig, shardCoordinator)
 	if err != nil {
 		return nil, err
 	}
 
+	participationGate, err := createParticipationGate(
+		config.ConsensusGates,
+		shardCoordinator,
+		data.Blkc,
+		process.ForkDetector,
+		network.NetMessenger,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	nd, err := node.NewNode(
 		node.WithMessenger(network.NetMessenger),
 		node.WithHasher(core.Hasher),
@@ -1279,8 +1781,10 @@ func createNode(
 		node.WithTxSingleSigner(crypto.TxSingleSigner),
 		node.WithTxStorageSize(config.TxStorage.Cache.Size),
 		node.WithBootstrapRoundIndex(bootstrapRoundIndex),
+		node.WithStateRecovery(config.StateRecovery, stateForensicsFolder),
 		node.WithAppStatusHandler(core.StatusHandler),
 		node.WithIndexer(indexer),
+		node.WithParticipationGate(participationGate),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
@@ -1329,6 +1833,16 @@ func initLogFileAndStatsMonitor(config *config.Config, pubKey crypto.PublicKey,
 		return err
 	}
 
+	if config.Logger.RoundLogBundles.Enabled {
+		err = log.ApplyOptions(logger.WithRoundLogBundles(
+			config.Logger.RoundLogBundles.MaxRounds,
+			config.Logger.RoundLogBundles.MaxEntriesPerRound,
+		))
+		if err != nil {
+			return err
+		}
+	}
+
 	statsFile, err := core.CreateFile(hexPublicKey, filepath.Join(workingDir, defaultStatsPath), "txt")
 	if err != nil {
 		return err
@@ -1340,10 +1854,18 @@ func initLogFileAndStatsMonitor(config *config.Config, pubKey crypto.PublicKey,
 	return nil
 }
 
-func setServiceContainer(shardCoordinator sharding.Coordinator, tpsBenchmark *statistics.TpsBenchmark) error {
+func setServiceContainer(
+	shardCoordinator sharding.Coordinator,
+	tpsBenchmark *statistics.TpsBenchmark,
+	gasPriceStats *statistics.GasPriceStats,
+	accountsNotifier *subscription.AccountsNotifier,
+) error {
 	var err error
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
-		coreServiceContainer, err = serviceContainer.NewServiceContainer(serviceContainer.WithIndexer(dbIndexer))
+		coreServiceContainer, err = serviceContainer.NewServiceContainer(
+			serviceContainer.WithIndexer(dbIndexer),
+			serviceContainer.WithGasPriceStats(gasPriceStats),
+			serviceContainer.WithAccountsNotifier(accountsNotifier))
 		if err != nil {
 			return err
 		}
@@ -1386,15 +1908,402 @@ func startStatisticsMonitor(file *os.File, config config.ResourceStatsConfig, lo
 	return nil
 }
 
-func createApiResolver(vmAccountsDB vmcommon.BlockchainHook, statusMetrics external.StatusMetricsHandler) (facade.ApiResolver, error) {
+func replayBlock(
+	ctx *cli.Context,
+	log *logger.Logger,
+	workingDir string,
+	shardCoordinator sharding.Coordinator,
+	coreComponents *factory.Core,
+	stateComponents *factory.State,
+	dataComponents *factory.Data,
+	processComponents *factory.Process,
+) error {
+	blockReplayer, err := replay.NewBlockReplayer(
+		dataComponents.Store,
+		coreComponents.Marshalizer,
+		coreComponents.Uint64ByteSliceConverter,
+		stateComponents.AccountsAdapter,
+		processComponents.TxProcessor,
+		shardCoordinator,
+	)
+	if err != nil {
+		return err
+	}
+
+	var reference []replay.TxCheckpoint
+	if ctx.IsSet(replayReferenceFile.Name) {
+		buff, errRead := ioutil.ReadFile(ctx.GlobalString(replayReferenceFile.Name))
+		if errRead != nil {
+			return errRead
+		}
+
+		referenceReport := &replay.Report{}
+		errRead = json.Unmarshal(buff, referenceReport)
+		if errRead != nil {
+			return errRead
+		}
+		reference = referenceReport.Checkpoints
+	}
+
+	nonce := ctx.GlobalUint64(replayBlockNonce.Name)
+	report, err := blockReplayer.ReplayBlock(nonce, reference)
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	reportFolder := filepath.Join(workingDir, defaultReplaysPath)
+	err = os.MkdirAll(reportFolder, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	reportFile := filepath.Join(reportFolder, fmt.Sprintf("replay-%d.json", nonce))
+	err = ioutil.WriteFile(reportFile, buff, 0644)
+	if err != nil {
+		return err
+	}
+
+	log.Info(fmt.Sprintf("replayed block with nonce %d: root hash matches: %v, skipped miniblocks: %d, "+
+		"divergent transaction index: %d, report written in %s",
+		nonce,
+		report.RootHashMatches,
+		report.SkippedMiniBlocks,
+		report.DivergentTxIndex,
+		reportFile,
+	))
+
+	return nil
+}
+
+func exportEpochBundle(
+	ctx *cli.Context,
+	log *logger.Logger,
+	workingDir string,
+	nodesConfig *sharding.NodesSetup,
+	shardCoordinator sharding.Coordinator,
+	coreComponents *factory.Core,
+	dataComponents *factory.Data,
+) error {
+	if shardCoordinator.SelfId() != sharding.MetachainShardId {
+		return errors.New("the epoch bundles can only be exported by metachain nodes")
+	}
+
+	validatorSet, err := lightClient.NewValidatorSetFromNodesSetup(nodesConfig)
+	if err != nil {
+		return err
+	}
+
+	bundleExporter, err := lightClient.NewEpochBundleExporter(
+		dataComponents.Blkc,
+		dataComponents.Store,
+		coreComponents.Marshalizer,
+		coreComponents.Hasher,
+		coreComponents.Uint64ByteSliceConverter,
+		validatorSet,
+	)
+	if err != nil {
+		return err
+	}
+
+	epoch := uint32(ctx.GlobalUint64(exportEpochBundleFlag.Name))
+	bundle, epochStartHash, err := bundleExporter.ExportEpochBundle(epoch)
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	bundleFolder := filepath.Join(workingDir, defaultBundlesPath)
+	err = os.MkdirAll(bundleFolder, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	bundleFile := filepath.Join(bundleFolder, fmt.Sprintf("epoch-%d.json", epoch))
+	err = ioutil.WriteFile(bundleFile, buff, 0644)
+	if err != nil {
+		return err
+	}
+
+	log.Info(fmt.Sprintf("exported epoch %d: %d metachain headers, %d shard headers, epoch-start header hash %s, "+
+		"bundle written in %s",
+		epoch,
+		len(bundle.MetaBlocks),
+		len(bundle.ShardHeaders),
+		hex.EncodeToString(epochStartHash),
+		bundleFile,
+	))
+
+	return nil
+}
+
+func createApiResolver(
+	vmAccountsDB vmcommon.BlockchainHook,
+	accounts state.AccountsAdapter,
+	statusMetrics external.StatusMetricsHandler,
+	dataComponents *factory.Data,
+	shardCoordinator sharding.Coordinator,
+	nodesCoordinator sharding.NodesCoordinator,
+	coreComponents *factory.Core,
+	processComponents *factory.Process,
+	diagnosticsReporter external.DiagnosticsHandler,
+	readinessChecker external.ReadinessHandler,
+	poolsDumpFolder string,
+	chainExportConfig config.ChainExportConfig,
+) (facade.ApiResolver, error) {
 	//TODO replace this with a vm factory
 	cryptoHook := hooks.NewVMCryptoHook()
 	ieleVM := endpoint.NewElrondIeleVM(factoryVM.IELEVirtualMachine, endpoint.ElrondTestnet, vmAccountsDB, cryptoHook)
 
-	scDataGetter, err := smartContract.NewSCDataGetter(ieleVM)
+	scDataGetter, err := smartContract.NewSCDataGetter(ieleVM, accounts)
 	if err != nil {
 		return nil, err
 	}
 
-	return external.NewNodeApiResolver(scDataGetter, statusMetrics)
+	storageUnitsQuerier, err := external.NewStorageUnitsQuerier(dataComponents.Store, shardCoordinator)
+	if err != nil {
+		return nil, err
+	}
+
+	poolsDumper, err := external.NewPoolsDumper(
+		dataComponents.Datapool,
+		dataComponents.MetaDatapool,
+		shardCoordinator,
+		coreComponents.Marshalizer,
+		poolsDumpFolder,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	participationProofsExporter, err := external.NewParticipationProofsExporter(
+		dataComponents.Blkc,
+		dataComponents.Store,
+		nodesCoordinator,
+		shardCoordinator,
+		coreComponents.Marshalizer,
+		coreComponents.Hasher,
+		coreComponents.Uint64ByteSliceConverter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	accountsIterator, err := external.NewAccountsIterator(coreComponents.Trie, coreComponents.Marshalizer, dataComponents.Blkc)
+	if err != nil {
+		return nil, err
+	}
+
+	scStorageDiffer, err := external.NewSCStorageDiffer(
+		coreComponents.Trie,
+		dataComponents.Blkc,
+		dataComponents.Store,
+		shardCoordinator,
+		coreComponents.Marshalizer,
+		coreComponents.Uint64ByteSliceConverter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorEarnings, err := external.NewValidatorEarningsReporter(
+		dataComponents.Blkc,
+		dataComponents.Store,
+		shardCoordinator,
+		coreComponents.Marshalizer,
+		coreComponents.Uint64ByteSliceConverter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	chainExporter, err := external.NewChainExporter(external.ArgChainExporter{
+		BlockChain:       dataComponents.Blkc,
+		Store:            dataComponents.Store,
+		ShardCoordinator: shardCoordinator,
+		Marshalizer:      coreComponents.Marshalizer,
+		Uint64Converter:  coreComponents.Uint64ByteSliceConverter,
+		Config:           chainExportConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return external.NewNodeApiResolver(
+		scDataGetter,
+		statusMetrics,
+		storageUnitsQuerier,
+		poolsDumper,
+		participationProofsExporter,
+		processComponents.MessageTracer,
+		processComponents.RejectionTracker,
+		diagnosticsReporter,
+		readinessChecker,
+		accountsIterator,
+		scStorageDiffer,
+		validatorEarnings,
+		chainExporter,
+	)
+}
+
+// runSelfTest validates, without starting the node, the environment the node would be started in. It prints the
+// report of all the checks and returns an error if any of them failed
+func runSelfTest(ctx *cli.Context, log *logger.Logger) error {
+	log.SetLevel(ctx.GlobalString(logLevel.Name))
+
+	configurationFileName := ctx.GlobalString(configurationFile.Name)
+	generalConfig, err := loadMainConfig(configurationFileName, log)
+	if err != nil {
+		return err
+	}
+
+	p2pConfig, err := core.LoadP2PConfig(ctx.GlobalString(p2pConfigurationFile.Name))
+	if err != nil {
+		return err
+	}
+	if ctx.GlobalIsSet(port.Name) {
+		p2pConfig.Node.Port = ctx.GlobalInt(port.Name)
+	}
+	if ctx.GlobalIsSet(destinationShardAsObserver.Name) {
+		generalConfig.GeneralSettings.DestinationShardAsObserver = ctx.GlobalString(destinationShardAsObserver.Name)
+	}
+
+	workingDir := ctx.GlobalString(workingDirectory.Name)
+	if len(workingDir) == 0 {
+		workingDir, err = os.Getwd()
+		if err != nil {
+			return err
+		}
+	}
+
+	selfTestConfig := generalConfig.SelfTest
+	selfTester := selftest.NewSelfTester()
+	checks := []struct {
+		name  string
+		check selftest.CheckFunc
+	}{
+		{
+			name:  "config consistency",
+			check: selftest.ConfigConsistencyCheck(generalConfig, p2pConfig),
+		},
+		{
+			name: "block signing key",
+			check: func() (string, error) {
+				return checkBlockSigningKey(ctx, log, generalConfig)
+			},
+		},
+		{
+			name: "transaction signing key",
+			check: func() (string, error) {
+				_, _, pubKey, errSigning := factory.GetSigningParams(
+					ctx,
+					log,
+					txSignSk.Name,
+					txSignSkIndex.Name,
+					ctx.GlobalString(initialBalancesSkPemFile.Name),
+					kyber.NewBlakeSHA256Ed25519())
+				if errSigning != nil {
+					return "", errSigning
+				}
+
+				return "public key " + factory.GetPkEncoded(pubKey), nil
+			},
+		},
+		{
+			name: "clock sync",
+			check: selftest.ClockSyncCheck(
+				func() (time.Duration, error) {
+					return ntp.QueryClockOffset(generalConfig.NTPConfig)
+				},
+				time.Duration(selfTestConfig.MaxClockOffsetInMs)*time.Millisecond,
+			),
+		},
+		{
+			name: "disk throughput",
+			check: selftest.DiskThroughputCheck(
+				filepath.Join(workingDir, defaultDBPath),
+				selfTestConfig.DiskTestFileSizeInMB,
+				float64(selfTestConfig.MinDiskWriteMBPerSec),
+			),
+		},
+		{
+			name:  "open files limit",
+			check: selftest.FileDescriptorsCheck(selfTestConfig.MinOpenFiles),
+		},
+		{
+			name: "p2p port reachability",
+			check: selftest.PortReachabilityCheck(
+				p2pConfig.Node.Port,
+				func(port int) (string, error) {
+					address, seeder, errReachability := libp2p.CheckPortReachability(
+						context.Background(),
+						port,
+						p2pConfig.KadDhtPeerDiscovery.InitialPeerList,
+					)
+					if errReachability != nil {
+						return "", errReachability
+					}
+
+					return fmt.Sprintf("%s (dialed by %s)", address, seeder), nil
+				},
+			),
+		},
+	}
+
+	for _, c := range checks {
+		err = selfTester.AddCheck(c.name, c.check)
+		if err != nil {
+			return err
+		}
+	}
+
+	report := selfTester.Run()
+	fmt.Print(report.String())
+	if !report.Passed {
+		return errors.New("self-test failed")
+	}
+
+	return nil
+}
+
+func checkBlockSigningKey(ctx *cli.Context, log *logger.Logger, generalConfig *config.Config) (string, error) {
+	suite, err := getSuite(generalConfig)
+	if err != nil {
+		return "", err
+	}
+
+	_, _, pubKey, err := factory.GetSigningParams(
+		ctx,
+		log,
+		sk.Name,
+		skIndex.Name,
+		ctx.GlobalString(initialNodesSkPemFile.Name),
+		suite)
+	if err != nil {
+		return "", err
+	}
+
+	nodesConfig, err := sharding.NewNodesSetup(ctx.GlobalString(nodesFile.Name), ctx.GlobalUint64(numOfNodes.Name))
+	if err != nil {
+		return "", err
+	}
+
+	shardCoordinator, nodeType, err := createShardCoordinator(nodesConfig, pubKey, generalConfig.GeneralSettings, log)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("public key %s, %s in shard %d",
+		factory.GetPkEncoded(pubKey),
+		nodeType,
+		shardCoordinator.SelfId(),
+	), nil
 }
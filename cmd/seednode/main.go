@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -22,6 +23,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
 	factoryP2P "github.com/ElrondNetwork/elrond-go/p2p/libp2p/factory"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/privnet"
 	"github.com/ElrondNetwork/elrond-go/p2p/loadBalancer"
 	"github.com/btcsuite/btcd/btcec"
 	libp2pCrypto "github.com/libp2p/go-libp2p-core/crypto"
@@ -212,6 +214,37 @@ func createNetMessenger(
 	prvKey, _ := ecdsa.GenerateKey(btcec.S256(), randReader)
 	sk := (*libp2pCrypto.Secp256k1PrivateKey)(prvKey)
 
+	if p2pConfig.Node.SwarmKeyFile != "" {
+		psk, errLoad := privnet.LoadSwarmKey(p2pConfig.Node.SwarmKeyFile)
+		if errLoad != nil {
+			return nil, errLoad
+		}
+
+		protector, errProtector := privnet.NewProtector(psk)
+		if errProtector != nil {
+			return nil, errProtector
+		}
+
+		fmt.Printf("Joining the private network with swarm key fingerprint: %s\n",
+			hex.EncodeToString(protector.Fingerprint()))
+
+		nm, errMes := libp2p.NewPrivateNetworkMessenger(
+			context.Background(),
+			p2pConfig.Node.Port,
+			sk,
+			nil,
+			loadBalancer.NewOutgoingChannelLoadBalancer(),
+			pDiscoverer,
+			libp2p.ListenAddrWithIp4AndTcp,
+			protector,
+		)
+		if errMes != nil {
+			return nil, errMes
+		}
+
+		return nm, nil
+	}
+
 	nm, err := libp2p.NewNetworkMessenger(
 		context.Background(),
 		p2pConfig.Node.Port,
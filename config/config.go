@@ -33,8 +33,16 @@ type StorageConfig struct {
 
 // LoggerConfig will map the json logger configuration
 type LoggerConfig struct {
-	Path            string `json:"path"`
-	StackTraceDepth int    `json:"stackTraceDepth"`
+	Path            string                `json:"path"`
+	StackTraceDepth int                   `json:"stackTraceDepth"`
+	RoundLogBundles RoundLogBundlesConfig `json:"roundLogBundles"`
+}
+
+// RoundLogBundlesConfig will map the settings of the per round log bundles, dumped when a round fails
+type RoundLogBundlesConfig struct {
+	Enabled            bool `json:"enabled"`
+	MaxRounds          int  `json:"maxRounds"`
+	MaxEntriesPerRound int  `json:"maxEntriesPerRound"`
 }
 
 // AddressConfig will map the json address configuration
@@ -48,6 +56,13 @@ type TypeConfig struct {
 	Type string `json:"type"`
 }
 
+// StructureHasherConfig will map the hasher used for a data structure starting with an epoch
+type StructureHasherConfig struct {
+	Structure  string `json:"structure"`
+	Type       string `json:"type"`
+	StartEpoch uint32 `json:"startEpoch"`
+}
+
 // NTPConfig will hold the configuration for NTP queries
 type NTPConfig struct {
 	Host    string
@@ -87,6 +102,7 @@ type Config struct {
 	MiniBlockHeaderHashesDataPool CacheConfig
 	ShardHeadersDataPool          CacheConfig
 	MetaHeaderNoncesDataPool      CacheConfig
+	FinalityProofsDataPool        CacheConfig
 
 	Logger         LoggerConfig
 	Address        AddressConfig
@@ -94,19 +110,42 @@ type Config struct {
 	MultisigHasher TypeConfig
 	Marshalizer    TypeConfig
 
-	ResourceStats   ResourceStatsConfig
-	Heartbeat       HeartbeatConfig
-	GeneralSettings GeneralSettingsConfig
-	Consensus       TypeConfig
-	Explorer        ExplorerConfig
+	StructureHashers []StructureHasherConfig
+
+	ResourceStats    ResourceStatsConfig
+	Heartbeat        HeartbeatConfig
+	InvariantChecker InvariantCheckerConfig
+	GeneralSettings  GeneralSettingsConfig
+	Consensus        TypeConfig
+	Explorer         ExplorerConfig
+	GasPriceStats    GasPriceStatsConfig
+
+	AccountsSubscriptions AccountsSubscriptionsConfig
+
+	SCStateChangesAudit SCStateChangesAuditConfig
+	SCDeploymentsIndex  SCDeploymentsIndexConfig
+	StateRecovery       StateRecoveryConfig
+	MessageTracing      MessageTracingConfig
+	RejectionTracking   RejectionTrackingConfig
+	ConsensusGates      ConsensusGatesConfig
+	Readiness           ReadinessConfig
+	StorerPreloader     StorerPreloaderConfig
+	BlockProposalPolicy BlockProposalPolicyConfig
+	DataPoolsCopy       DataPoolsCopyConfig
+	ChainExport         ChainExportConfig
 
 	NTPConfig NTPConfig
+	SelfTest  SelfTestConfig
+
+	ApiShardFilter ApiShardFilterConfig
 }
 
 // NodeConfig will hold basic p2p settings
 type NodeConfig struct {
-	Port int
-	Seed string
+	Port             int
+	Seed             string
+	NetworkNamespace string
+	SwarmKeyFile     string
 }
 
 // KadDhtPeerDiscoveryConfig will hold the kad-dht discovery config settings
@@ -117,10 +156,47 @@ type KadDhtPeerDiscoveryConfig struct {
 	InitialPeerList      []string
 }
 
+// KnownPeersConfig will hold the settings used for persisting the known peers list between restarts
+type KnownPeersConfig struct {
+	Enabled               bool
+	FileName              string
+	MaxAgeInHours         int
+	MaxPeers              int
+	ReconnectTimeoutInSec int
+}
+
+// SeederConfig will hold the settings used when the node is started in seeder mode
+type SeederConfig struct {
+	MaxConnections              int
+	PeerExchangeMaxPeers        int
+	MetricsRefreshIntervalInSec int
+}
+
+// PeerDiversityConfig will hold the settings used for keeping the connections diverse across networks and providers
+type PeerDiversityConfig struct {
+	Enabled                 bool
+	MaxPeersPerNetworkGroup int
+	Ipv4PrefixLength        int
+	Ipv6PrefixLength        int
+}
+
+// ChunkingConfig will hold the settings used for splitting the oversized payloads into chunks
+type ChunkingConfig struct {
+	Enabled                bool
+	MaxChunkSizeInBytes    int
+	MaxNumChunks           uint32
+	MaxPendingPayloads     int
+	ReassemblyTimeoutInSec int
+}
+
 // P2PConfig will hold all the P2P settings
 type P2PConfig struct {
 	Node                NodeConfig
 	KadDhtPeerDiscovery KadDhtPeerDiscoveryConfig
+	KnownPeers          KnownPeersConfig
+	Seeder              SeederConfig
+	Chunking            ChunkingConfig
+	PeerDiversity       PeerDiversityConfig
 }
 
 // ResourceStatsConfig will hold all resource stats settings
@@ -129,13 +205,65 @@ type ResourceStatsConfig struct {
 	RefreshIntervalInSec int
 }
 
+// InvariantCheckerConfig will hold the accounts balance invariant checker settings
+type InvariantCheckerConfig struct {
+	Enabled            bool
+	CheckIntervalInSec int
+}
+
+// SCStateChangesAuditConfig will hold the settings for the audit log of the state changes applied from smart
+// contract executions
+type SCStateChangesAuditConfig struct {
+	Enabled      bool
+	AuditStorage StorageConfig
+}
+
+// SCDeploymentsIndexConfig will hold the settings for the local index of the smart contracts deployments
+type SCDeploymentsIndexConfig struct {
+	Enabled      bool
+	IndexStorage StorageConfig
+}
+
 // HeartbeatConfig will hold all heartbeat settings
 type HeartbeatConfig struct {
 	Enabled                             bool
 	MinTimeToWaitBetweenBroadcastsInSec int
 	MaxTimeToWaitBetweenBroadcastsInSec int
 	DurationInSecToConsiderUnresponsive int
+	IncludeResourceStats                bool
 	HeartbeatStorage                    StorageConfig
+	Alerts                              HeartbeatAlertsConfig
+}
+
+// HeartbeatAlertsConfig will hold the settings for the alerts raised when watched public keys become inactive or
+// change their shard
+type HeartbeatAlertsConfig struct {
+	Enabled           bool
+	WatchedPublicKeys []string
+	DebounceInSec     int
+	Webhook           WebhookAlertConfig
+	Email             EmailAlertConfig
+	Exec              ExecAlertConfig
+}
+
+// WebhookAlertConfig will hold the settings for the webhook alert driver
+type WebhookAlertConfig struct {
+	URL string
+}
+
+// EmailAlertConfig will hold the settings for the e-mail alert driver
+type EmailAlertConfig struct {
+	SmtpServer string
+	Username   string
+	Password   string
+	From       string
+	To         []string
+}
+
+// ExecAlertConfig will hold the settings for the exec alert driver
+type ExecAlertConfig struct {
+	Command string
+	Args    []string
 }
 
 // GeneralSettingsConfig will hold the general settings for a node
@@ -144,6 +272,111 @@ type GeneralSettingsConfig struct {
 	NetworkID                  string
 	StatusPollingIntervalSec   int
 	NodeDisplayName            string
+	EpochGraceWindow           uint32
+	TimeLockedTxsEnableEpoch   uint32
+}
+
+// GasPriceStatsConfig will hold the settings for the gas price statistics exposed through the REST API
+type GasPriceStatsConfig struct {
+	NumBlocks uint32
+}
+
+// AccountsSubscriptionsConfig will hold the settings for the subscriptions notifying the changes of the watched accounts
+type AccountsSubscriptionsConfig struct {
+	Enabled                   bool
+	MaxSubscribers            uint32
+	MaxAddressesPerSubscriber uint32
+	BufferSize                uint32
+}
+
+// StateRecoveryConfig will hold the settings of the automatic recovery from a diverged accounts state
+type StateRecoveryConfig struct {
+	Enabled             bool
+	MismatchesThreshold uint32
+	RollbackDepth       uint32
+}
+
+// MessageTracingConfig will hold the settings of the sampled tracing of the received messages. The tracing of a
+// topic can also be enabled or disabled at runtime, through the admin routes
+type MessageTracingConfig struct {
+	MaxTraces  int
+	Topic      string
+	SampleRate uint32
+}
+
+// RejectionTrackingConfig will hold the settings of the accounting of the messages rejected by the interceptors: the
+// rolling window, split in NumBuckets buckets each holding at most MaxEntriesPerBucket (peer, topic, reason) entries,
+// the size above which a message is rejected without being processed (0 meaning no limit) and the reputation penalty
+// weight of each rejection reason
+type RejectionTrackingConfig struct {
+	WindowInSec           uint32
+	NumBuckets            int
+	MaxEntriesPerBucket   int
+	MaxMessageSizeInBytes int
+	Weights               RejectionWeightsConfig
+}
+
+// RejectionWeightsConfig will hold the reputation penalty of a rejected message for each rejection reason
+type RejectionWeightsConfig struct {
+	Decode        uint64
+	Signature     uint64
+	Stale         uint64
+	Flood         uint64
+	Size          uint64
+	ShardMismatch uint64
+	Other         uint64
+}
+
+// ConsensusGatesConfig will hold the gates a validator must pass to propose or sign in a round: it must be at most
+// MaxNoncesBehind nonces behind the network's highest nonce and be connected to at least MinShardPeers peers of its
+// shard and MinMetachainPeers metachain peers
+type ConsensusGatesConfig struct {
+	Enabled           bool
+	MaxNoncesBehind   uint64
+	MinShardPeers     uint32
+	MinMetachainPeers uint32
+}
+
+// ReadinessConfig will hold the thresholds used by the readiness probe: how many nonces the node may be behind the
+// network's highest nonce and the minimum number of peers it must be connected to
+type ReadinessConfig struct {
+	MaxNoncesBehind   uint64
+	MinConnectedPeers uint32
+}
+
+// StorerPreloaderConfig will hold the settings of the warm-up of the storers caches done at startup: how many of the
+// most recent headers are read, together with their miniblocks, down to which depth the accounts trie is read and
+// how the reads are throttled
+type StorerPreloaderConfig struct {
+	Enabled           bool
+	NumHeaders        uint64
+	TrieDepth         uint32
+	MaxReadsPerSecond uint32
+	MaxDurationInSec  uint32
+}
+
+// BlockProposalPolicyConfig will hold the rules applied by a leader when selecting the transactions of its proposals:
+// the hex encoded senders whose transactions are never selected, the maximum share of a miniblock, in percents, the
+// value transfers and the smart contract calls may each take and after how many rounds of waiting a transaction is
+// tried before the others. Zero values disable the corresponding rule
+type BlockProposalPolicyConfig struct {
+	ExcludedSenders        []string
+	MaxTransfersPercentage uint32
+	MaxSCCallsPercentage   uint32
+	AgedTxRounds           uint64
+}
+
+// DataPoolsCopyConfig will hold the setting of the defensive copies done by the data pools: when enabled, the headers,
+// miniblocks and transactions are deep copied when added to and when read from the pools
+type DataPoolsCopyConfig struct {
+	Enabled bool
+}
+
+// ChainExportConfig will hold the limits of the chain exports: the maximum number of blocks an export may cover and
+// the maximum number of storage reads an export may do each second. Zero values disable the corresponding limit
+type ChainExportConfig struct {
+	MaxNoncesPerExport uint64
+	MaxReadsPerSecond  uint32
 }
 
 // ExplorerConfig will hold the configuration for the explorer indexer
@@ -156,6 +389,7 @@ type ExplorerConfig struct {
 type ServersConfig struct {
 	ElasticSearch ElasticSearchConfig
 	Prometheus    PrometheusConfig
+	AdminApi      AdminApiConfig
 }
 
 // PrometheusConfig will hold configuration for prometheus, such as the join URL
@@ -165,6 +399,11 @@ type PrometheusConfig struct {
 	StatusRoute       string
 }
 
+// AdminApiConfig will hold the configuration for the admin REST API routes. The routes are disabled if no token is set
+type AdminApiConfig struct {
+	Token string
+}
+
 // ElasticSearchConfig will hold the configuration for the elastic search
 type ElasticSearchConfig struct {
 	Username string
@@ -178,4 +417,29 @@ type FacadeConfig struct {
 	Prometheus        bool
 	PrometheusJoinURL string
 	PrometheusJobName string
+	AdminApiToken     string
+	ApiShardFilter    ApiShardFilterConfig
+}
+
+// SelfTestConfig will hold the thresholds used by the self-test command when validating the node's environment
+type SelfTestConfig struct {
+	MaxClockOffsetInMs   uint32
+	DiskTestFileSizeInMB int
+	MinDiskWriteMBPerSec uint32
+	MinOpenFiles         uint64
+}
+
+// ApiShardFilterConfig will hold the settings used by an observer to answer only the REST API requests for the
+// addresses belonging to the shards it serves
+type ApiShardFilterConfig struct {
+	Enabled        bool
+	ServedShards   []uint32
+	ProxyRequests  bool
+	ShardObservers []ShardObserverConfig
+}
+
+// ShardObserverConfig will hold the URL of an observer serving the given shard
+type ShardObserverConfig struct {
+	ShardId uint32
+	URL     string
 }
@@ -1,11 +1,19 @@
 package broadcast
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
@@ -17,6 +25,7 @@ type commonMessenger struct {
 	privateKey       crypto.PrivateKey
 	shardCoordinator sharding.Coordinator
 	singleSigner     crypto.SingleSigner
+	forkDetector     process.ForkDetector
 }
 
 // BroadcastConsensusMessage will send on consensus topic the consensus message
@@ -41,6 +50,22 @@ func (cm *commonMessenger) BroadcastConsensusMessage(message *consensus.Message)
 	return nil
 }
 
+// BroadcastFinalityProof will send on the finality proofs topic the proof that a header was finalized
+func (cm *commonMessenger) BroadcastFinalityProof(proof *block.FinalityProof) error {
+	if proof == nil {
+		return spos.ErrNilFinalityProof
+	}
+
+	buff, err := cm.marshalizer.Marshal(proof)
+	if err != nil {
+		return err
+	}
+
+	go cm.messenger.Broadcast(factory.FinalityProofsTopic, buff)
+
+	return nil
+}
+
 func (cm *commonMessenger) signMessage(message *consensus.Message) ([]byte, error) {
 	buff, err := cm.marshalizer.Marshal(message)
 	if err != nil {
@@ -54,3 +79,24 @@ func (cm *commonMessenger) signMessage(message *consensus.Message) ([]byte, erro
 
 	return signature, nil
 }
+
+// broadcastIfHeaderNotReceived waits for the given delay and then calls the broadcast handler only if no signed
+// header with the same nonce as the given one has been received in the meantime
+func (cm *commonMessenger) broadcastIfHeaderNotReceived(
+	header data.HeaderHandler,
+	delay time.Duration,
+	broadcastHandler func(),
+) {
+	time.Sleep(delay)
+
+	if cm.forkDetector.ProbableHighestNonce() >= header.GetNonce() {
+		log.Debug(fmt.Sprintf("block with nonce %d has been received, delayed broadcast is not needed\n",
+			header.GetNonce()))
+		return
+	}
+
+	log.Info(fmt.Sprintf("block with nonce %d has not been received in %v, it will be broadcast\n",
+		header.GetNonce(), delay))
+
+	broadcastHandler()
+}
@@ -2,11 +2,15 @@ package broadcast_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
@@ -112,3 +116,43 @@ func TestCommonMessenger_SignMessageShouldErrWhenSignFail(t *testing.T) {
 	_, err2 := cm.SignMessage(msg)
 	assert.Equal(t, err, err2)
 }
+
+func TestCommonMessenger_BroadcastFinalityProofNilProofShouldErr(t *testing.T) {
+	cm, _ := broadcast.NewCommonMessenger(
+		&mock.MarshalizerMock{},
+		&mock.MessengerStub{},
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		&mock.SingleSignerMock{},
+	)
+
+	err := cm.BroadcastFinalityProof(nil)
+	assert.Equal(t, spos.ErrNilFinalityProof, err)
+}
+
+func TestCommonMessenger_BroadcastFinalityProofShouldWork(t *testing.T) {
+	chanBroadcast := make(chan string, 1)
+	messengerMock := &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			chanBroadcast <- topic
+		},
+	}
+
+	cm, _ := broadcast.NewCommonMessenger(
+		&mock.MarshalizerMock{},
+		messengerMock,
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		&mock.SingleSignerMock{},
+	)
+
+	err := cm.BroadcastFinalityProof(&block.FinalityProof{HeaderHash: []byte("header hash")})
+	assert.Nil(t, err)
+
+	select {
+	case topic := <-chanBroadcast:
+		assert.Equal(t, factory.FinalityProofsTopic, topic)
+	case <-time.After(time.Second):
+		assert.Fail(t, "finality proof should have been broadcast")
+	}
+}
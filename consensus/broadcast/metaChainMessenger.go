@@ -1,11 +1,14 @@
 package broadcast
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
@@ -23,9 +26,10 @@ func NewMetaChainMessenger(
 	privateKey crypto.PrivateKey,
 	shardCoordinator sharding.Coordinator,
 	singleSigner crypto.SingleSigner,
+	forkDetector process.ForkDetector,
 ) (*metaChainMessenger, error) {
 
-	err := checkMetaChainNilParameters(marshalizer, messenger, privateKey, shardCoordinator, singleSigner)
+	err := checkMetaChainNilParameters(marshalizer, messenger, privateKey, shardCoordinator, singleSigner, forkDetector)
 	if err != nil {
 		return nil, err
 	}
@@ -36,6 +40,7 @@ func NewMetaChainMessenger(
 		privateKey:       privateKey,
 		shardCoordinator: shardCoordinator,
 		singleSigner:     singleSigner,
+		forkDetector:     forkDetector,
 	}
 
 	mcm := &metaChainMessenger{
@@ -53,6 +58,7 @@ func checkMetaChainNilParameters(
 	privateKey crypto.PrivateKey,
 	shardCoordinator sharding.Coordinator,
 	singleSigner crypto.SingleSigner,
+	forkDetector process.ForkDetector,
 ) error {
 	if marshalizer == nil || marshalizer.IsInterfaceNil() {
 		return spos.ErrNilMarshalizer
@@ -69,6 +75,9 @@ func checkMetaChainNilParameters(
 	if singleSigner == nil || singleSigner.IsInterfaceNil() {
 		return spos.ErrNilSingleSigner
 	}
+	if forkDetector == nil || forkDetector.IsInterfaceNil() {
+		return spos.ErrNilForkDetector
+	}
 
 	return nil
 }
@@ -96,6 +105,27 @@ func (mcm *metaChainMessenger) BroadcastHeader(header data.HeaderHandler) error
 	return nil
 }
 
+// BroadcastBlockWithDelay will send the header, after the given delay, only if the signed header with the same
+// nonce has not been received in the meantime from the consensus group leader
+func (mcm *metaChainMessenger) BroadcastBlockWithDelay(
+	blockBody data.BodyHandler,
+	header data.HeaderHandler,
+	delay time.Duration,
+) error {
+	if header == nil || header.IsInterfaceNil() {
+		return spos.ErrNilMetaHeader
+	}
+
+	go mcm.broadcastIfHeaderNotReceived(header, delay, func() {
+		err := mcm.BroadcastBlock(blockBody, header)
+		if err != nil {
+			log.Error(err.Error())
+		}
+	})
+
+	return nil
+}
+
 // BroadcastMiniBlocks will send on miniblocks topic the miniblocks
 func (mcm *metaChainMessenger) BroadcastMiniBlocks(miniBlocks map[uint32][]byte) error {
 	// meta chain does not need to broadcast miniblocks but this method is created to satisfy the BroadcastMessenger
@@ -1,7 +1,9 @@
 package broadcast_test
 
 import (
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
@@ -22,6 +24,7 @@ func TestMetaChainMessenger_NewMetaChainMessengerNilMarshalizerShouldFail(t *tes
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	assert.Nil(t, mcm)
@@ -40,6 +43,7 @@ func TestMetaChainMessenger_NewMetaChainMessengerNilMessengerShouldFail(t *testi
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	assert.Nil(t, mcm)
@@ -58,6 +62,7 @@ func TestMetaChainMessenger_NewMetaChainMessengerNilPrivateKeyShouldFail(t *test
 		nil,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	assert.Nil(t, mcm)
@@ -76,6 +81,7 @@ func TestMetaChainMessenger_NewMetaChainMessengerNilShardCoordinatorShouldFail(t
 		privateKeyMock,
 		nil,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	assert.Nil(t, mcm)
@@ -94,12 +100,33 @@ func TestMetaChainMessenger_NewMetaChainMessengerNilSingleSignerShouldFail(t *te
 		privateKeyMock,
 		shardCoordinatorMock,
 		nil,
+		&mock.ForkDetectorMock{},
 	)
 
 	assert.Nil(t, mcm)
 	assert.Equal(t, spos.ErrNilSingleSigner, err)
 }
 
+func TestMetaChainMessenger_NewMetaChainMessengerNilForkDetectorShouldFail(t *testing.T) {
+	marshalizerMock := &mock.MarshalizerMock{}
+	messengerMock := &mock.MessengerStub{}
+	privateKeyMock := &mock.PrivateKeyMock{}
+	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
+	singleSignerMock := &mock.SingleSignerMock{}
+
+	mcm, err := broadcast.NewMetaChainMessenger(
+		marshalizerMock,
+		messengerMock,
+		privateKeyMock,
+		shardCoordinatorMock,
+		singleSignerMock,
+		nil,
+	)
+
+	assert.Nil(t, mcm)
+	assert.Equal(t, spos.ErrNilForkDetector, err)
+}
+
 func TestMetaChainMessenger_NewMetaChainMessengerShouldWork(t *testing.T) {
 	marshalizerMock := &mock.MarshalizerMock{}
 	messengerMock := &mock.MessengerStub{}
@@ -113,6 +140,7 @@ func TestMetaChainMessenger_NewMetaChainMessengerShouldWork(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	assert.NotNil(t, mcm)
@@ -132,6 +160,7 @@ func TestMetaChainMessenger_BroadcastBlockShouldErrNilMetaHeader(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	err := mcm.BroadcastBlock(nil, nil)
@@ -152,6 +181,7 @@ func TestMetaChainMessenger_BroadcastBlockShouldErrMockMarshalizer(t *testing.T)
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	err := mcm.BroadcastBlock(nil, &block.MetaBlock{})
@@ -174,6 +204,7 @@ func TestMetaChainMessenger_BroadcastBlockShouldWork(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	err := mcm.BroadcastBlock(nil, &block.MetaBlock{})
@@ -193,6 +224,7 @@ func TestMetaChainMessenger_BroadcastHeaderShouldWork(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	err := mcm.BroadcastHeader(nil)
@@ -212,6 +244,7 @@ func TestMetaChainMessenger_BroadcastMiniBlocksShouldWork(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	err := mcm.BroadcastMiniBlocks(nil)
@@ -231,8 +264,81 @@ func TestMetaChainMessenger_BroadcastTransactionsShouldWork(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	err := mcm.BroadcastTransactions(nil)
 	assert.Nil(t, err)
 }
+
+func TestMetaChainMessenger_BroadcastBlockWithDelayShouldErrNilMetaHeader(t *testing.T) {
+	mcm, _ := broadcast.NewMetaChainMessenger(
+		&mock.MarshalizerMock{},
+		&mock.MessengerStub{},
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		&mock.SingleSignerMock{},
+		&mock.ForkDetectorMock{},
+	)
+
+	err := mcm.BroadcastBlockWithDelay(nil, nil, 0)
+	assert.Equal(t, spos.ErrNilMetaHeader, err)
+}
+
+func TestMetaChainMessenger_BroadcastBlockWithDelayShouldBroadcastIfHeaderNotReceived(t *testing.T) {
+	numBroadcasts := int32(0)
+	messengerMock := &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			atomic.AddInt32(&numBroadcasts, 1)
+		},
+	}
+	forkDetectorMock := &mock.ForkDetectorMock{
+		ProbableHighestNonceCalled: func() uint64 {
+			return 1
+		},
+	}
+
+	mcm, _ := broadcast.NewMetaChainMessenger(
+		&mock.MarshalizerMock{},
+		messengerMock,
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		&mock.SingleSignerMock{},
+		forkDetectorMock,
+	)
+
+	err := mcm.BroadcastBlockWithDelay(nil, &block.MetaBlock{Nonce: 2}, time.Millisecond)
+	time.Sleep(time.Millisecond * 100)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numBroadcasts))
+}
+
+func TestMetaChainMessenger_BroadcastBlockWithDelayShouldNotBroadcastIfHeaderReceived(t *testing.T) {
+	numBroadcasts := int32(0)
+	messengerMock := &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			atomic.AddInt32(&numBroadcasts, 1)
+		},
+	}
+	forkDetectorMock := &mock.ForkDetectorMock{
+		ProbableHighestNonceCalled: func() uint64 {
+			return 3
+		},
+	}
+
+	mcm, _ := broadcast.NewMetaChainMessenger(
+		&mock.MarshalizerMock{},
+		messengerMock,
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		&mock.SingleSignerMock{},
+		forkDetectorMock,
+	)
+
+	err := mcm.BroadcastBlockWithDelay(nil, &block.MetaBlock{Nonce: 2}, time.Millisecond)
+	time.Sleep(time.Millisecond * 100)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&numBroadcasts))
+}
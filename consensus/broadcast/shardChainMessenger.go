@@ -2,6 +2,7 @@ package broadcast
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
@@ -10,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
@@ -28,9 +30,10 @@ func NewShardChainMessenger(
 	privateKey crypto.PrivateKey,
 	shardCoordinator sharding.Coordinator,
 	singleSigner crypto.SingleSigner,
+	forkDetector process.ForkDetector,
 ) (*shardChainMessenger, error) {
 
-	err := checkShardChainNilParameters(marshalizer, messenger, shardCoordinator, privateKey, singleSigner)
+	err := checkShardChainNilParameters(marshalizer, messenger, shardCoordinator, privateKey, singleSigner, forkDetector)
 	if err != nil {
 		return nil, err
 	}
@@ -41,6 +44,7 @@ func NewShardChainMessenger(
 		privateKey:       privateKey,
 		shardCoordinator: shardCoordinator,
 		singleSigner:     singleSigner,
+		forkDetector:     forkDetector,
 	}
 
 	scm := &shardChainMessenger{
@@ -59,6 +63,7 @@ func checkShardChainNilParameters(
 	shardCoordinator sharding.Coordinator,
 	privateKey crypto.PrivateKey,
 	singleSigner crypto.SingleSigner,
+	forkDetector process.ForkDetector,
 ) error {
 	if marshalizer == nil || marshalizer.IsInterfaceNil() {
 		return spos.ErrNilMarshalizer
@@ -75,6 +80,9 @@ func checkShardChainNilParameters(
 	if singleSigner == nil || singleSigner.IsInterfaceNil() {
 		return spos.ErrNilSingleSigner
 	}
+	if forkDetector == nil || forkDetector.IsInterfaceNil() {
+		return spos.ErrNilForkDetector
+	}
 
 	return nil
 }
@@ -131,6 +139,36 @@ func (scm *shardChainMessenger) BroadcastHeader(header data.HeaderHandler) error
 	return nil
 }
 
+// BroadcastBlockWithDelay will send the header and block body, after the given delay, only if the signed header
+// with the same nonce has not been received in the meantime from the consensus group leader
+func (scm *shardChainMessenger) BroadcastBlockWithDelay(
+	blockBody data.BodyHandler,
+	header data.HeaderHandler,
+	delay time.Duration,
+) error {
+	if blockBody == nil || blockBody.IsInterfaceNil() {
+		return spos.ErrNilBody
+	}
+	if header == nil || header.IsInterfaceNil() {
+		return spos.ErrNilHeader
+	}
+
+	go scm.broadcastIfHeaderNotReceived(header, delay, func() {
+		err := scm.BroadcastBlock(blockBody, header)
+		if err != nil {
+			log.Error(err.Error())
+			return
+		}
+
+		err = scm.BroadcastHeader(header)
+		if err != nil {
+			log.Error(err.Error())
+		}
+	})
+
+	return nil
+}
+
 // BroadcastMiniBlocks will send on miniblocks topic the cross-shard miniblocks
 func (scm *shardChainMessenger) BroadcastMiniBlocks(miniBlocks map[uint32][]byte) error {
 	mbs := 0
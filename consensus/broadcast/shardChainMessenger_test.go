@@ -1,6 +1,7 @@
 package broadcast_test
 
 import (
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -24,6 +25,7 @@ func TestShardChainMessenger_NewShardChainMessengerNilMarshalizerShouldFail(t *t
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	assert.Nil(t, scm)
@@ -42,6 +44,7 @@ func TestShardChainMessenger_NewShardChainMessengerNilMessengerShouldFail(t *tes
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	assert.Nil(t, scm)
@@ -60,6 +63,7 @@ func TestShardChainMessenger_NewShardChainMessengerNilPrivateKeyShouldFail(t *te
 		nil,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	assert.Nil(t, scm)
@@ -78,6 +82,7 @@ func TestShardChainMessenger_NewShardChainMessengerNilShardCoordinatorShouldFail
 		privateKeyMock,
 		nil,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	assert.Nil(t, scm)
@@ -96,12 +101,33 @@ func TestShardChainMessenger_NewShardChainMessengerNilSingleSignerShouldFail(t *
 		privateKeyMock,
 		shardCoordinatorMock,
 		nil,
+		&mock.ForkDetectorMock{},
 	)
 
 	assert.Nil(t, scm)
 	assert.Equal(t, spos.ErrNilSingleSigner, err)
 }
 
+func TestShardChainMessenger_NewShardChainMessengerNilForkDetectorShouldFail(t *testing.T) {
+	marshalizerMock := &mock.MarshalizerMock{}
+	messengerMock := &mock.MessengerStub{}
+	privateKeyMock := &mock.PrivateKeyMock{}
+	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
+	singleSignerMock := &mock.SingleSignerMock{}
+
+	scm, err := broadcast.NewShardChainMessenger(
+		marshalizerMock,
+		messengerMock,
+		privateKeyMock,
+		shardCoordinatorMock,
+		singleSignerMock,
+		nil,
+	)
+
+	assert.Nil(t, scm)
+	assert.Equal(t, spos.ErrNilForkDetector, err)
+}
+
 func TestShardChainMessenger_NewShardChainMessengerShouldWork(t *testing.T) {
 	marshalizerMock := &mock.MarshalizerMock{}
 	messengerMock := &mock.MessengerStub{}
@@ -115,6 +141,7 @@ func TestShardChainMessenger_NewShardChainMessengerShouldWork(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	assert.NotNil(t, scm)
@@ -134,6 +161,7 @@ func TestShardChainMessenger_BroadcastBlockShouldErrNilBody(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	err := scm.BroadcastBlock(nil, &block.Header{})
@@ -153,6 +181,7 @@ func TestShardChainMessenger_BroadcastBlockShouldErrNilHeader(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	err := scm.BroadcastBlock(&block.Body{}, nil)
@@ -173,6 +202,7 @@ func TestShardChainMessenger_BroadcastBlockShouldErrMockMarshalizer(t *testing.T
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	err := scm.BroadcastBlock(&block.Body{}, &block.Header{})
@@ -195,6 +225,7 @@ func TestShardChainMessenger_BroadcastBlockShouldWork(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	err := scm.BroadcastBlock(&block.Body{}, &block.Header{})
@@ -214,6 +245,7 @@ func TestShardChainMessenger_BroadcastHeaderShouldErrNilHeader(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	err := scm.BroadcastHeader(nil)
@@ -234,6 +266,7 @@ func TestShardChainMessenger_BroadcastHeaderShouldErrMockMarshalizer(t *testing.
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	err := scm.BroadcastHeader(&block.Header{})
@@ -256,6 +289,7 @@ func TestShardChainMessenger_BroadcastHeaderShouldWork(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	err := scm.BroadcastHeader(&block.Header{})
@@ -282,6 +316,7 @@ func TestShardChainMessenger_BroadcastMiniBlocksShouldBeDone(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	miniBlocks := make(map[uint32][]byte)
@@ -325,6 +360,7 @@ func TestShardChainMessenger_BroadcastTransactionsShouldNotBeCalled(t *testing.T
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	transactions := make(map[string][][]byte)
@@ -374,6 +410,7 @@ func TestShardChainMessenger_BroadcastTransactionsShouldBeCalled(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		singleSignerMock,
+		&mock.ForkDetectorMock{},
 	)
 
 	transactions := make(map[string][][]byte)
@@ -392,3 +429,90 @@ func TestShardChainMessenger_BroadcastTransactionsShouldBeCalled(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, wasCalled)
 }
+
+func TestShardChainMessenger_BroadcastBlockWithDelayShouldErrNilBody(t *testing.T) {
+	scm, _ := broadcast.NewShardChainMessenger(
+		&mock.MarshalizerMock{},
+		&mock.MessengerStub{},
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		&mock.SingleSignerMock{},
+		&mock.ForkDetectorMock{},
+	)
+
+	err := scm.BroadcastBlockWithDelay(nil, &block.Header{}, 0)
+	assert.Equal(t, spos.ErrNilBody, err)
+}
+
+func TestShardChainMessenger_BroadcastBlockWithDelayShouldErrNilHeader(t *testing.T) {
+	scm, _ := broadcast.NewShardChainMessenger(
+		&mock.MarshalizerMock{},
+		&mock.MessengerStub{},
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		&mock.SingleSignerMock{},
+		&mock.ForkDetectorMock{},
+	)
+
+	err := scm.BroadcastBlockWithDelay(&block.Body{}, nil, 0)
+	assert.Equal(t, spos.ErrNilHeader, err)
+}
+
+func TestShardChainMessenger_BroadcastBlockWithDelayShouldBroadcastIfHeaderNotReceived(t *testing.T) {
+	numBroadcasts := int32(0)
+	messengerMock := &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			atomic.AddInt32(&numBroadcasts, 1)
+		},
+	}
+	forkDetectorMock := &mock.ForkDetectorMock{
+		ProbableHighestNonceCalled: func() uint64 {
+			return 1
+		},
+	}
+
+	scm, _ := broadcast.NewShardChainMessenger(
+		&mock.MarshalizerMock{},
+		messengerMock,
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		&mock.SingleSignerMock{},
+		forkDetectorMock,
+	)
+
+	err := scm.BroadcastBlockWithDelay(&block.Body{}, &block.Header{Nonce: 2}, time.Millisecond)
+	time.Sleep(time.Millisecond * 100)
+
+	assert.Nil(t, err)
+	// header and miniblocks in shard, plus header for metachain
+	assert.Equal(t, int32(3), atomic.LoadInt32(&numBroadcasts))
+}
+
+func TestShardChainMessenger_BroadcastBlockWithDelayShouldNotBroadcastIfHeaderReceived(t *testing.T) {
+	numBroadcasts := int32(0)
+	messengerMock := &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			atomic.AddInt32(&numBroadcasts, 1)
+		},
+	}
+	forkDetectorMock := &mock.ForkDetectorMock{
+		ProbableHighestNonceCalled: func() uint64 {
+			return 2
+		},
+	}
+
+	scm, _ := broadcast.NewShardChainMessenger(
+		&mock.MarshalizerMock{},
+		messengerMock,
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		&mock.SingleSignerMock{},
+		forkDetectorMock,
+	)
+
+	err := scm.BroadcastBlockWithDelay(&block.Body{}, &block.Header{Nonce: 2}, time.Millisecond)
+	time.Sleep(time.Millisecond * 100)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&numBroadcasts))
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/metrics"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -30,6 +31,7 @@ type chronology struct {
 	subroundHandlers []consensus.SubroundHandler
 	mutSubrounds     sync.RWMutex
 	appStatusHandler core.AppStatusHandler
+	consensusMetrics consensus.ConsensusMetricsHandler
 }
 
 // NewChronology creates a new chronology object
@@ -52,7 +54,8 @@ func NewChronology(
 		genesisTime:      genesisTime,
 		rounder:          rounder,
 		syncTimer:        syncTimer,
-		appStatusHandler: statusHandler.NewNilStatusHandler()}
+		appStatusHandler: statusHandler.NewNilStatusHandler(),
+		consensusMetrics: metrics.NewNilConsensusMetrics()}
 
 	chr.subroundId = srBeforeStartRound
 
@@ -88,6 +91,16 @@ func (chr *chronology) SetAppStatusHandler(ash core.AppStatusHandler) error {
 	return nil
 }
 
+// SetConsensusMetrics will set the handler which will collect the time needed for each subround to finish
+func (chr *chronology) SetConsensusMetrics(consensusMetrics consensus.ConsensusMetricsHandler) error {
+	if consensusMetrics == nil || consensusMetrics.IsInterfaceNil() {
+		return ErrNilConsensusMetrics
+	}
+
+	chr.consensusMetrics = consensusMetrics
+	return nil
+}
+
 // AddSubround adds new SubroundHandler implementation to the chronology
 func (chr *chronology) AddSubround(subroundHandler consensus.SubroundHandler) {
 	chr.mutSubrounds.Lock()
@@ -140,6 +153,8 @@ func (chr *chronology) startRound() {
 		return
 	}
 
+	chr.consensusMetrics.SubroundFinished(sr.Name())
+
 	chr.subroundId = sr.Next()
 }
 
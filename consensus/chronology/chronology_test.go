@@ -355,3 +355,40 @@ func TestChronology_CheckIfStatusHandlerWorks(t *testing.T) {
 		assert.Fail(t, "AppStatusHandler not working")
 	}
 }
+
+func TestChronology_SetConsensusMetricsWithNilValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	chr, _ := chronology.NewChronology(time.Now(), &mock.RounderMock{}, &mock.SyncTimerMock{})
+	err := chr.SetConsensusMetrics(nil)
+
+	assert.Equal(t, chronology.ErrNilConsensusMetrics, err)
+}
+
+func TestChronology_StartRoundShouldNotifySubroundFinished(t *testing.T) {
+	t.Parallel()
+
+	rounderMock := &mock.RounderMock{}
+	rounderMock.UpdateRound(rounderMock.TimeStamp(), rounderMock.TimeStamp().Add(rounderMock.TimeDuration()))
+	chr, _ := chronology.NewChronology(time.Now(), rounderMock, &mock.SyncTimerMock{})
+
+	finishedSubround := ""
+	_ = chr.SetConsensusMetrics(&mock.ConsensusMetricsStub{
+		SubroundFinishedCalled: func(subroundName string) {
+			finishedSubround = subroundName
+		},
+	})
+
+	srm := initSubroundHandlerMock()
+	chr.AddSubround(srm)
+	chr.SetSubroundId(0)
+	chr.StartRound()
+	assert.Equal(t, "", finishedSubround)
+
+	srm.DoWorkCalled = func(rounder consensus.Rounder) bool {
+		return true
+	}
+	chr.SetSubroundId(0)
+	chr.StartRound()
+	assert.Equal(t, "(TEST)", finishedSubround)
+}
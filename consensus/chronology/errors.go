@@ -12,3 +12,6 @@ var ErrNilSyncTimer = errors.New("sync timer is nil")
 
 // ErrNilAppStatusHandler is raised when the AppStatusHandler is nil when setting it
 var ErrNilAppStatusHandler = errors.New("nil AppStatusHandler")
+
+// ErrNilConsensusMetrics is raised when a valid consensus metrics handler is expected but nil used
+var ErrNilConsensusMetrics = errors.New("consensus metrics handler is nil")
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 )
 
 // Rounder defines the actions which should be handled by a round implementation
@@ -46,6 +47,15 @@ type ChronologyHandler interface {
 	IsInterfaceNil() bool
 }
 
+// ConsensusMetricsHandler defines the behavior of a component which collects, for each round, the time needed for
+// each subround to complete and the number and size of the consensus messages sent and received
+type ConsensusMetricsHandler interface {
+	SubroundFinished(subroundName string)
+	AddSentMessage(msgTypeName string, numBytes int)
+	AddReceivedMessage(msgTypeName string, numBytes int)
+	IsInterfaceNil() bool
+}
+
 // SposFactory defines an interface for a consensus implementation
 type SposFactory interface {
 	GenerateSubrounds()
@@ -55,10 +65,12 @@ type SposFactory interface {
 // BroadcastMessenger defines the behaviour of the broadcast messages by the consensus group
 type BroadcastMessenger interface {
 	BroadcastBlock(data.BodyHandler, data.HeaderHandler) error
+	BroadcastBlockWithDelay(data.BodyHandler, data.HeaderHandler, time.Duration) error
 	BroadcastHeader(data.HeaderHandler) error
 	BroadcastMiniBlocks(map[uint32][]byte) error
 	BroadcastTransactions(map[string][][]byte) error
 	BroadcastConsensusMessage(*Message) error
+	BroadcastFinalityProof(*block.FinalityProof) error
 	IsInterfaceNil() bool
 }
 
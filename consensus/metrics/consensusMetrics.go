@@ -0,0 +1,239 @@
+package metrics
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+)
+
+const bytesSentSampleName = "bytes_sent"
+const bytesReceivedSampleName = "bytes_received"
+
+// maxRoundsInPercentilesWindow bounds the number of the latest rounds of the current epoch the percentiles are
+// computed over, so that the memory used and the time needed to publish a round do not grow with the epoch length
+const maxRoundsInPercentilesWindow = 600
+
+// percentiles holds the percentiles published for each per round metric aggregated over the percentiles window
+var percentiles = []int{50, 90, 99}
+
+// roundMetrics holds the consensus metrics collected during one round
+type roundMetrics struct {
+	roundIndex     int64
+	roundStart     time.Time
+	subroundTimes  map[string]time.Duration
+	messagesByType map[string]uint64
+	bytesSent      uint64
+	bytesReceived  uint64
+}
+
+// consensusMetrics collects the consensus metrics of each round and publishes them, once the round has ended,
+// through the app status handler. Per round values are aggregated over the latest rounds of the current epoch and
+// the resulting percentiles are published as well
+type consensusMetrics struct {
+	rounder          consensus.Rounder
+	syncTimer        ntp.SyncTimer
+	blockChain       data.ChainHandler
+	appStatusHandler core.AppStatusHandler
+
+	mutMetrics     sync.Mutex
+	currentRound   *roundMetrics
+	knownSubrounds map[string]struct{}
+	knownMsgTypes  map[string]struct{}
+	epoch          uint32
+	epochSamples   map[string]*samplesWindow
+}
+
+// NewConsensusMetrics creates a new consensus metrics collector
+func NewConsensusMetrics(
+	rounder consensus.Rounder,
+	syncTimer ntp.SyncTimer,
+	blockChain data.ChainHandler,
+) (*consensusMetrics, error) {
+	if rounder == nil || rounder.IsInterfaceNil() {
+		return nil, ErrNilRounder
+	}
+	if syncTimer == nil || syncTimer.IsInterfaceNil() {
+		return nil, ErrNilSyncTimer
+	}
+	if blockChain == nil || blockChain.IsInterfaceNil() {
+		return nil, ErrNilBlockChain
+	}
+
+	cm := &consensusMetrics{
+		rounder:          rounder,
+		syncTimer:        syncTimer,
+		blockChain:       blockChain,
+		appStatusHandler: statusHandler.NewNilStatusHandler(),
+		knownSubrounds:   make(map[string]struct{}),
+		knownMsgTypes:    make(map[string]struct{}),
+		epochSamples:     make(map[string]*samplesWindow),
+	}
+	cm.epoch = cm.currentEpoch()
+	cm.currentRound = cm.newRoundMetrics()
+
+	return cm, nil
+}
+
+// SetAppStatusHandler will set the AppStatusHandler which will be used for publishing the metrics
+func (cm *consensusMetrics) SetAppStatusHandler(ash core.AppStatusHandler) error {
+	if ash == nil || ash.IsInterfaceNil() {
+		return ErrNilAppStatusHandler
+	}
+
+	cm.mutMetrics.Lock()
+	cm.appStatusHandler = ash
+	cm.mutMetrics.Unlock()
+
+	return nil
+}
+
+// SubroundFinished records the time elapsed from the current round start until the provided subround has finished
+func (cm *consensusMetrics) SubroundFinished(subroundName string) {
+	cm.mutMetrics.Lock()
+	defer cm.mutMetrics.Unlock()
+
+	cm.checkRoundChanged()
+
+	name := metricName(subroundName)
+	cm.knownSubrounds[name] = struct{}{}
+	cm.currentRound.subroundTimes[name] = cm.syncTimer.CurrentTime().Sub(cm.currentRound.roundStart)
+}
+
+// AddSentMessage records a consensus message of the provided type and size sent in the current round
+func (cm *consensusMetrics) AddSentMessage(msgTypeName string, numBytes int) {
+	cm.mutMetrics.Lock()
+	defer cm.mutMetrics.Unlock()
+
+	cm.checkRoundChanged()
+	cm.addMessage(msgTypeName)
+	cm.currentRound.bytesSent += uint64(numBytes)
+}
+
+// AddReceivedMessage records a consensus message of the provided type and size received in the current round
+func (cm *consensusMetrics) AddReceivedMessage(msgTypeName string, numBytes int) {
+	cm.mutMetrics.Lock()
+	defer cm.mutMetrics.Unlock()
+
+	cm.checkRoundChanged()
+	cm.addMessage(msgTypeName)
+	cm.currentRound.bytesReceived += uint64(numBytes)
+}
+
+func (cm *consensusMetrics) addMessage(msgTypeName string) {
+	name := metricName(msgTypeName)
+	cm.knownMsgTypes[name] = struct{}{}
+	cm.currentRound.messagesByType[name]++
+}
+
+// checkRoundChanged publishes the metrics of the tracked round and starts a new one if the rounder has advanced
+func (cm *consensusMetrics) checkRoundChanged() {
+	if cm.rounder.Index() == cm.currentRound.roundIndex {
+		return
+	}
+
+	cm.publishRound(cm.currentRound)
+	cm.currentRound = cm.newRoundMetrics()
+}
+
+func (cm *consensusMetrics) newRoundMetrics() *roundMetrics {
+	return &roundMetrics{
+		roundIndex:     cm.rounder.Index(),
+		roundStart:     cm.rounder.TimeStamp(),
+		subroundTimes:  make(map[string]time.Duration),
+		messagesByType: make(map[string]uint64),
+	}
+}
+
+func (cm *consensusMetrics) publishRound(rm *roundMetrics) {
+	epoch := cm.currentEpoch()
+	if epoch != cm.epoch {
+		cm.epoch = epoch
+		cm.epochSamples = make(map[string]*samplesWindow)
+	}
+
+	cm.appStatusHandler.SetInt64Value(core.MetricConsensusMetricsRound, rm.roundIndex)
+
+	// subrounds not finished in this round are published as zero, so that no value from a previous round remains
+	for name := range cm.knownSubrounds {
+		subroundTime, ok := rm.subroundTimes[name]
+		if !ok {
+			cm.appStatusHandler.SetUInt64Value(core.MetricConsensusSubroundTimePrefix+name, 0)
+			continue
+		}
+
+		subroundTimeInMs := uint64(subroundTime.Nanoseconds() / int64(time.Millisecond))
+		cm.appStatusHandler.SetUInt64Value(core.MetricConsensusSubroundTimePrefix+name, subroundTimeInMs)
+		cm.addEpochSample("subround_time_ms_"+name, subroundTimeInMs)
+	}
+
+	for name := range cm.knownMsgTypes {
+		cm.appStatusHandler.SetUInt64Value(core.MetricConsensusMessagesPrefix+name, rm.messagesByType[name])
+	}
+
+	cm.appStatusHandler.SetUInt64Value(core.MetricConsensusBytesSent, rm.bytesSent)
+	cm.appStatusHandler.SetUInt64Value(core.MetricConsensusBytesReceived, rm.bytesReceived)
+	cm.addEpochSample(bytesSentSampleName, rm.bytesSent)
+	cm.addEpochSample(bytesReceivedSampleName, rm.bytesReceived)
+}
+
+func (cm *consensusMetrics) addEpochSample(sampleName string, value uint64) {
+	samples, ok := cm.epochSamples[sampleName]
+	if !ok {
+		samples = newSamplesWindow(maxRoundsInPercentilesWindow)
+		cm.epochSamples[sampleName] = samples
+	}
+
+	samples.add(value)
+	sorted := samples.sorted()
+
+	for _, p := range percentiles {
+		key := core.MetricConsensusEpochPercentilesPrefix + sampleName + "_p" + strconv.Itoa(p)
+		cm.appStatusHandler.SetUInt64Value(key, percentile(sorted, p))
+	}
+}
+
+func (cm *consensusMetrics) currentEpoch() uint32 {
+	currentHeader := cm.blockChain.GetCurrentBlockHeader()
+	if currentHeader == nil || currentHeader.IsInterfaceNil() {
+		return 0
+	}
+
+	return currentHeader.GetEpoch()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cm *consensusMetrics) IsInterfaceNil() bool {
+	if cm == nil {
+		return true
+	}
+	return false
+}
+
+// percentile returns the nearest-rank percentile of the provided ascending sorted values
+func percentile(sortedValues []uint64, p int) uint64 {
+	if len(sortedValues) == 0 {
+		return 0
+	}
+
+	rank := (p*len(sortedValues) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sortedValues[rank-1]
+}
+
+// metricName converts a subround or message type name as "(START_ROUND)" into a metric key suffix as "start_round"
+func metricName(name string) string {
+	name = strings.Trim(name, "() ")
+	name = strings.Replace(name, " ", "_", -1)
+
+	return strings.ToLower(name)
+}
@@ -0,0 +1,198 @@
+package metrics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/metrics"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/assert"
+)
+
+func createAppStatusHandlerStub(uint64Values map[string]uint64, int64Values map[string]int64) *mock.AppStatusHandlerStub {
+	return &mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			uint64Values[key] = value
+		},
+		SetInt64ValueHandler: func(key string, value int64) {
+			int64Values[key] = value
+		},
+	}
+}
+
+func createBlockChainWithEpoch(epoch *uint32) *mock.BlockChainMock {
+	return &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Epoch: *epoch}
+		},
+	}
+}
+
+func TestNewConsensusMetrics_NilRounderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cm, err := metrics.NewConsensusMetrics(nil, &mock.SyncTimerMock{}, &mock.BlockChainMock{})
+
+	assert.Nil(t, cm)
+	assert.Equal(t, metrics.ErrNilRounder, err)
+}
+
+func TestNewConsensusMetrics_NilSyncTimerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cm, err := metrics.NewConsensusMetrics(&mock.RounderMock{}, nil, &mock.BlockChainMock{})
+
+	assert.Nil(t, cm)
+	assert.Equal(t, metrics.ErrNilSyncTimer, err)
+}
+
+func TestNewConsensusMetrics_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cm, err := metrics.NewConsensusMetrics(&mock.RounderMock{}, &mock.SyncTimerMock{}, nil)
+
+	assert.Nil(t, cm)
+	assert.Equal(t, metrics.ErrNilBlockChain, err)
+}
+
+func TestNewConsensusMetrics_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cm, err := metrics.NewConsensusMetrics(&mock.RounderMock{}, &mock.SyncTimerMock{}, &mock.BlockChainMock{})
+
+	assert.NotNil(t, cm)
+	assert.Nil(t, err)
+}
+
+func TestConsensusMetrics_SetAppStatusHandlerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cm, _ := metrics.NewConsensusMetrics(&mock.RounderMock{}, &mock.SyncTimerMock{}, &mock.BlockChainMock{})
+
+	err := cm.SetAppStatusHandler(nil)
+
+	assert.Equal(t, metrics.ErrNilAppStatusHandler, err)
+}
+
+func TestConsensusMetrics_ShouldPublishRoundMetricsWhenRoundChanges(t *testing.T) {
+	t.Parallel()
+
+	roundStart := time.Unix(1000, 0)
+	currentTime := roundStart
+	rounder := &mock.RounderMock{
+		RoundIndex: 1,
+		TimeStampCalled: func() time.Time {
+			return roundStart
+		},
+	}
+	syncTimer := &mock.SyncTimerMock{
+		CurrentTimeCalled: func() time.Time {
+			return currentTime
+		},
+	}
+	uint64Values := make(map[string]uint64)
+	int64Values := make(map[string]int64)
+	epoch := uint32(0)
+	cm, _ := metrics.NewConsensusMetrics(rounder, syncTimer, createBlockChainWithEpoch(&epoch))
+	_ = cm.SetAppStatusHandler(createAppStatusHandlerStub(uint64Values, int64Values))
+
+	cm.AddReceivedMessage("(BLOCK_BODY)", 100)
+	cm.AddReceivedMessage("(SIGNATURE)", 10)
+	cm.AddSentMessage("(SIGNATURE)", 12)
+	currentTime = roundStart.Add(150 * time.Millisecond)
+	cm.SubroundFinished("(START_ROUND)")
+
+	assert.Equal(t, 0, len(uint64Values))
+
+	rounder.RoundIndex = 2
+	roundStart = roundStart.Add(time.Second)
+	cm.AddReceivedMessage("(BLOCK_BODY)", 1)
+
+	assert.Equal(t, int64(1), int64Values[core.MetricConsensusMetricsRound])
+	assert.Equal(t, uint64(150), uint64Values[core.MetricConsensusSubroundTimePrefix+"start_round"])
+	assert.Equal(t, uint64(1), uint64Values[core.MetricConsensusMessagesPrefix+"block_body"])
+	assert.Equal(t, uint64(2), uint64Values[core.MetricConsensusMessagesPrefix+"signature"])
+	assert.Equal(t, uint64(12), uint64Values[core.MetricConsensusBytesSent])
+	assert.Equal(t, uint64(110), uint64Values[core.MetricConsensusBytesReceived])
+	assert.Equal(t, uint64(110), uint64Values[core.MetricConsensusEpochPercentilesPrefix+"bytes_received_p50"])
+}
+
+func TestConsensusMetrics_ShouldAggregatePercentilesOverEpoch(t *testing.T) {
+	t.Parallel()
+
+	rounder := &mock.RounderMock{RoundIndex: 1}
+	uint64Values := make(map[string]uint64)
+	int64Values := make(map[string]int64)
+	epoch := uint32(0)
+	cm, _ := metrics.NewConsensusMetrics(rounder, &mock.SyncTimerMock{}, createBlockChainWithEpoch(&epoch))
+	_ = cm.SetAppStatusHandler(createAppStatusHandlerStub(uint64Values, int64Values))
+
+	for i := 1; i <= 10; i++ {
+		cm.AddReceivedMessage("(BLOCK_BODY)", i*10)
+		rounder.RoundIndex++
+	}
+	cm.AddReceivedMessage("(BLOCK_BODY)", 0)
+
+	assert.Equal(t, uint64(50), uint64Values[core.MetricConsensusEpochPercentilesPrefix+"bytes_received_p50"])
+	assert.Equal(t, uint64(90), uint64Values[core.MetricConsensusEpochPercentilesPrefix+"bytes_received_p90"])
+	assert.Equal(t, uint64(100), uint64Values[core.MetricConsensusEpochPercentilesPrefix+"bytes_received_p99"])
+
+	epoch = 1
+	rounder.RoundIndex++
+	cm.AddReceivedMessage("(BLOCK_BODY)", 0)
+
+	assert.Equal(t, uint64(0), uint64Values[core.MetricConsensusEpochPercentilesPrefix+"bytes_received_p99"])
+}
+
+func TestConsensusMetrics_PercentilesShouldBeComputedOverTheLatestRounds(t *testing.T) {
+	t.Parallel()
+
+	rounder := &mock.RounderMock{RoundIndex: 1}
+	uint64Values := make(map[string]uint64)
+	int64Values := make(map[string]int64)
+	epoch := uint32(0)
+	cm, _ := metrics.NewConsensusMetrics(rounder, &mock.SyncTimerMock{}, createBlockChainWithEpoch(&epoch))
+	_ = cm.SetAppStatusHandler(createAppStatusHandlerStub(uint64Values, int64Values))
+
+	cm.AddReceivedMessage("(BLOCK_BODY)", 1000)
+	rounder.RoundIndex++
+	cm.AddReceivedMessage("(BLOCK_BODY)", 0)
+
+	assert.Equal(t, uint64(1000), uint64Values[core.MetricConsensusEpochPercentilesPrefix+"bytes_received_p99"])
+
+	for i := 0; i < metrics.MaxRoundsInPercentilesWindow; i++ {
+		cm.AddReceivedMessage("(BLOCK_BODY)", 10)
+		rounder.RoundIndex++
+	}
+	cm.AddReceivedMessage("(BLOCK_BODY)", 0)
+
+	assert.Equal(t, uint64(10), uint64Values[core.MetricConsensusEpochPercentilesPrefix+"bytes_received_p99"])
+}
+
+func TestConsensusMetrics_UnfinishedSubroundShouldPublishZero(t *testing.T) {
+	t.Parallel()
+
+	rounder := &mock.RounderMock{RoundIndex: 1}
+	syncTimer := &mock.SyncTimerMock{
+		CurrentTimeCalled: func() time.Time {
+			return time.Unix(0, 0).Add(time.Second)
+		},
+	}
+	uint64Values := make(map[string]uint64)
+	int64Values := make(map[string]int64)
+	epoch := uint32(0)
+	cm, _ := metrics.NewConsensusMetrics(rounder, syncTimer, createBlockChainWithEpoch(&epoch))
+	_ = cm.SetAppStatusHandler(createAppStatusHandlerStub(uint64Values, int64Values))
+
+	cm.SubroundFinished("(BLOCK)")
+	rounder.RoundIndex++
+	cm.SubroundFinished("(START_ROUND)")
+	assert.Equal(t, uint64(1000), uint64Values[core.MetricConsensusSubroundTimePrefix+"block"])
+
+	rounder.RoundIndex++
+	cm.SubroundFinished("(START_ROUND)")
+	assert.Equal(t, uint64(0), uint64Values[core.MetricConsensusSubroundTimePrefix+"block"])
+}
@@ -0,0 +1,30 @@
+package metrics
+
+// NilConsensusMetrics will be used when a consensus metrics handler is required, but metrics collection is not needed
+type NilConsensusMetrics struct {
+}
+
+// NewNilConsensusMetrics will return an instance of the struct
+func NewNilConsensusMetrics() *NilConsensusMetrics {
+	return new(NilConsensusMetrics)
+}
+
+// SubroundFinished method - won't do anything
+func (ncm *NilConsensusMetrics) SubroundFinished(_ string) {
+}
+
+// AddSentMessage method - won't do anything
+func (ncm *NilConsensusMetrics) AddSentMessage(_ string, _ int) {
+}
+
+// AddReceivedMessage method - won't do anything
+func (ncm *NilConsensusMetrics) AddReceivedMessage(_ string, _ int) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ncm *NilConsensusMetrics) IsInterfaceNil() bool {
+	if ncm == nil {
+		return true
+	}
+	return false
+}
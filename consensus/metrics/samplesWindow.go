@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"sort"
+)
+
+// samplesWindow keeps the last values added, up to its capacity, overwriting the oldest ones once it is full
+type samplesWindow struct {
+	values []uint64
+	next   int
+	isFull bool
+}
+
+func newSamplesWindow(capacity int) *samplesWindow {
+	return &samplesWindow{
+		values: make([]uint64, capacity),
+	}
+}
+
+func (sw *samplesWindow) add(value uint64) {
+	sw.values[sw.next] = value
+	sw.next++
+	if sw.next == len(sw.values) {
+		sw.next = 0
+		sw.isFull = true
+	}
+}
+
+// sorted returns an ascending sorted copy of the values held by the window
+func (sw *samplesWindow) sorted() []uint64 {
+	numValues := sw.next
+	if sw.isFull {
+		numValues = len(sw.values)
+	}
+
+	sortedValues := make([]uint64, numValues)
+	copy(sortedValues, sw.values[:numValues])
+	sort.Slice(sortedValues, func(i, j int) bool {
+		return sortedValues[i] < sortedValues[j]
+	})
+
+	return sortedValues
+}
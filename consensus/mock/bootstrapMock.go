@@ -2,6 +2,7 @@ package mock
 
 import (
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 )
@@ -14,6 +15,7 @@ type BootstrapperMock struct {
 	StartSyncCalled                 func()
 	StopSyncCalled                  func()
 	SetStatusHandlerCalled          func(handler core.AppStatusHandler) error
+	SetIndexerCalled                func(indexer indexer.Indexer) error
 }
 
 func (boot *BootstrapperMock) CreateAndCommitEmptyBlock(shardForCurrentNode uint32) (data.BodyHandler, data.HeaderHandler, error) {
@@ -54,6 +56,13 @@ func (boot *BootstrapperMock) SetStatusHandler(handler core.AppStatusHandler) er
 	return boot.SetStatusHandlerCalled(handler)
 }
 
+func (boot *BootstrapperMock) SetIndexer(indexer indexer.Indexer) error {
+	if boot.SetIndexerCalled != nil {
+		return boot.SetIndexerCalled(indexer)
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (boot *BootstrapperMock) IsInterfaceNil() bool {
 	if boot == nil {
@@ -1,16 +1,21 @@
 package mock
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 )
 
 type BroadcastMessengerMock struct {
 	BroadcastBlockCalled            func(data.BodyHandler, data.HeaderHandler) error
+	BroadcastBlockWithDelayCalled   func(data.BodyHandler, data.HeaderHandler, time.Duration) error
 	BroadcastHeaderCalled           func(data.HeaderHandler) error
 	BroadcastMiniBlocksCalled       func(map[uint32][]byte) error
 	BroadcastTransactionsCalled     func(map[string][][]byte) error
 	BroadcastConsensusMessageCalled func(*consensus.Message) error
+	BroadcastFinalityProofCalled    func(*block.FinalityProof) error
 }
 
 func (bmm *BroadcastMessengerMock) BroadcastBlock(bodyHandler data.BodyHandler, headerhandler data.HeaderHandler) error {
@@ -20,6 +25,17 @@ func (bmm *BroadcastMessengerMock) BroadcastBlock(bodyHandler data.BodyHandler,
 	return nil
 }
 
+func (bmm *BroadcastMessengerMock) BroadcastBlockWithDelay(
+	bodyHandler data.BodyHandler,
+	headerhandler data.HeaderHandler,
+	delay time.Duration,
+) error {
+	if bmm.BroadcastBlockWithDelayCalled != nil {
+		return bmm.BroadcastBlockWithDelayCalled(bodyHandler, headerhandler, delay)
+	}
+	return nil
+}
+
 func (bmm *BroadcastMessengerMock) BroadcastHeader(headerHandler data.HeaderHandler) error {
 	if bmm.BroadcastHeaderCalled != nil {
 		return bmm.BroadcastHeaderCalled(headerHandler)
@@ -48,6 +64,13 @@ func (bmm *BroadcastMessengerMock) BroadcastConsensusMessage(message *consensus.
 	return nil
 }
 
+func (bmm *BroadcastMessengerMock) BroadcastFinalityProof(proof *block.FinalityProof) error {
+	if bmm.BroadcastFinalityProofCalled != nil {
+		return bmm.BroadcastFinalityProofCalled(proof)
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (bmm *BroadcastMessengerMock) IsInterfaceNil() bool {
 	if bmm == nil {
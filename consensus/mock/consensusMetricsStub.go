@@ -0,0 +1,37 @@
+package mock
+
+// ConsensusMetricsStub is a stub implementation of ConsensusMetricsHandler
+type ConsensusMetricsStub struct {
+	SubroundFinishedCalled   func(subroundName string)
+	AddSentMessageCalled     func(msgTypeName string, numBytes int)
+	AddReceivedMessageCalled func(msgTypeName string, numBytes int)
+}
+
+// SubroundFinished calls the stub handler if it is set
+func (cms *ConsensusMetricsStub) SubroundFinished(subroundName string) {
+	if cms.SubroundFinishedCalled != nil {
+		cms.SubroundFinishedCalled(subroundName)
+	}
+}
+
+// AddSentMessage calls the stub handler if it is set
+func (cms *ConsensusMetricsStub) AddSentMessage(msgTypeName string, numBytes int) {
+	if cms.AddSentMessageCalled != nil {
+		cms.AddSentMessageCalled(msgTypeName, numBytes)
+	}
+}
+
+// AddReceivedMessage calls the stub handler if it is set
+func (cms *ConsensusMetricsStub) AddReceivedMessage(msgTypeName string, numBytes int) {
+	if cms.AddReceivedMessageCalled != nil {
+		cms.AddReceivedMessageCalled(msgTypeName, numBytes)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cms *ConsensusMetricsStub) IsInterfaceNil() bool {
+	if cms == nil {
+		return true
+	}
+	return false
+}
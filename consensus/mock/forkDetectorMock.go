@@ -13,6 +13,7 @@ type ForkDetectorMock struct {
 	ProbableHighestNonceCalled              func() uint64
 	ResetProbableHighestNonceIfNeededCalled func()
 	ResetProbableHighestNonceCalled         func()
+	ResetCheckpointsCalled                  func(nonce uint64, round uint64)
 }
 
 func (fdm *ForkDetectorMock) AddHeader(header data.HeaderHandler, hash []byte, state process.BlockHeaderState, finalHeaders []data.HeaderHandler, finalHeadersHashes [][]byte) error {
@@ -43,6 +44,12 @@ func (fdm *ForkDetectorMock) ResetProbableHighestNonce() {
 	fdm.ResetProbableHighestNonceCalled()
 }
 
+func (fdm *ForkDetectorMock) ResetCheckpoints(nonce uint64, round uint64) {
+	if fdm.ResetCheckpointsCalled != nil {
+		fdm.ResetCheckpointsCalled(nonce, round)
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (fdm *ForkDetectorMock) IsInterfaceNil() bool {
 	if fdm == nil {
@@ -0,0 +1,17 @@
+package mock
+
+type ParticipationGateStub struct {
+	CheckParticipationCalled func() error
+}
+
+func (pgs *ParticipationGateStub) CheckParticipation() error {
+	return pgs.CheckParticipationCalled()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pgs *ParticipationGateStub) IsInterfaceNil() bool {
+	if pgs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+type PeersOnTopicHandlerStub struct {
+	ConnectedPeersOnTopicCalled func(topic string) []p2p.PeerID
+}
+
+func (pths *PeersOnTopicHandlerStub) ConnectedPeersOnTopic(topic string) []p2p.PeerID {
+	return pths.ConnectedPeersOnTopicCalled(topic)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pths *PeersOnTopicHandlerStub) IsInterfaceNil() bool {
+	if pths == nil {
+		return true
+	}
+	return false
+}
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -30,7 +32,7 @@ func (sposWorkerMock *SposWorkerMock) RemoveAllReceivedMessagesCalls() {
 	sposWorkerMock.RemoveAllReceivedMessagesCallsCalled()
 }
 
-func (sposWorkerMock *SposWorkerMock) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (sposWorkerMock *SposWorkerMock) ProcessReceivedMessage(_ context.Context, message p2p.MessageP2P) error {
 	return sposWorkerMock.ProcessReceivedMessageCalled(message)
 }
 
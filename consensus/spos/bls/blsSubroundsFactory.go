@@ -17,8 +17,9 @@ type factory struct {
 	consensusState *spos.ConsensusState
 	worker         spos.WorkerHandler
 
-	appStatusHandler core.AppStatusHandler
-	indexer          indexer.Indexer
+	appStatusHandler  core.AppStatusHandler
+	indexer           indexer.Indexer
+	participationGate spos.ParticipationGate
 }
 
 // NewSubroundsFactory creates a new consensusState object
@@ -37,10 +38,11 @@ func NewSubroundsFactory(
 	}
 
 	fct := factory{
-		consensusCore:    consensusDataContainer,
-		consensusState:   consensusState,
-		worker:           worker,
-		appStatusHandler: statusHandler.NewNilStatusHandler(),
+		consensusCore:     consensusDataContainer,
+		consensusState:    consensusState,
+		worker:            worker,
+		appStatusHandler:  statusHandler.NewNilStatusHandler(),
+		participationGate: spos.NewDisabledParticipationGate(),
 	}
 
 	return &fct, nil
@@ -80,6 +82,16 @@ func (fct *factory) SetIndexer(indexer indexer.Indexer) {
 	fct.indexer = indexer
 }
 
+// SetParticipationGate method will update the value of the factory's participation gate
+func (fct *factory) SetParticipationGate(participationGate spos.ParticipationGate) error {
+	if participationGate == nil || participationGate.IsInterfaceNil() {
+		return spos.ErrNilParticipationGate
+	}
+
+	fct.participationGate = participationGate
+	return nil
+}
+
 // GenerateSubrounds will generate the subrounds used in BLS Cns
 func (fct *factory) GenerateSubrounds() error {
 	fct.initConsensusThreshold()
@@ -148,6 +160,11 @@ func (fct *factory) generateStartRoundSubround() error {
 
 	subroundStartRound.SetIndexer(fct.indexer)
 
+	err = subroundStartRound.SetParticipationGate(fct.participationGate)
+	if err != nil {
+		return err
+	}
+
 	fct.consensusCore.Chronology().AddSubround(subroundStartRound)
 
 	return nil
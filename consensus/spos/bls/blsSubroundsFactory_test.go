@@ -323,6 +323,16 @@ func TestFactory_NewFactoryShouldWork(t *testing.T) {
 	assert.NotNil(t, fct)
 }
 
+func TestFactory_SetParticipationGateNilShouldFail(t *testing.T) {
+	t.Parallel()
+
+	fct := *initFactory()
+
+	err := fct.SetParticipationGate(nil)
+
+	assert.Equal(t, spos.ErrNilParticipationGate, err)
+}
+
 func TestFactory_GenerateSubroundStartRoundShouldFailWhenNewSubroundFail(t *testing.T) {
 	t.Parallel()
 
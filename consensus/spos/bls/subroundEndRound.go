@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
@@ -66,6 +67,7 @@ func checkNewSubroundEndRoundParams(
 // doEndRoundJob method does the job of the subround EndRound
 func (sr *subroundEndRound) doEndRoundJob() bool {
 	if !sr.IsSelfLeaderInCurrentRound() { // is NOT self leader in this round?
+		sr.prepareBroadcastBlockDataForValidator()
 		return false
 	}
 
@@ -111,6 +113,11 @@ func (sr *subroundEndRound) doEndRoundJob() bool {
 		log.Error(err.Error())
 	}
 
+	err = sr.broadcastFinalityProof()
+	if err != nil {
+		log.Error(err.Error())
+	}
+
 	log.Info(fmt.Sprintf("%sStep 3: BlockBody and Header has been committed and broadcast\n", sr.SyncTimer().FormattedCurrentTime()))
 
 	err = sr.broadcastMiniBlocksAndTransactions()
@@ -126,12 +133,64 @@ func (sr *subroundEndRound) doEndRoundJob() bool {
 	return true
 }
 
+// prepareBroadcastBlockDataForValidator aggregates the collected signatures, if the validator is the leader fallback,
+// has signed the block and the subround Signature has been finished, and schedules a delayed broadcast of the block
+// which will be sent only if the leader's block has not been received until the end of the round. Only the leader
+// fallback does it, so the network does not get the same block from the whole consensus group. The bn consensus does
+// not need such a fallback as all its members commit and broadcast the block
+func (sr *subroundEndRound) prepareBroadcastBlockDataForValidator() {
+	if !sr.IsSelfLeaderFallbackInCurrentRound() {
+		return
+	}
+	if !sr.IsSelfJobDone(SrSignature) {
+		return
+	}
+	if sr.Status(SrSignature) != spos.SsFinished {
+		return
+	}
+
+	bitmap := sr.GenerateBitmap(SrSignature)
+	err := sr.checkSignaturesValidity(bitmap)
+	if err != nil {
+		log.Debug(err.Error())
+		return
+	}
+
+	sig, err := sr.MultiSigner().AggregateSigs(bitmap)
+	if err != nil {
+		log.Debug(err.Error())
+		return
+	}
+
+	sr.Header.SetPubKeysBitmap(bitmap)
+	sr.Header.SetSignature(sig)
+
+	delay := sr.Rounder().RemainingTime(sr.Rounder().TimeStamp(), sr.Rounder().TimeDuration())
+	err = sr.BroadcastMessenger().BroadcastBlockWithDelay(sr.BlockBody, sr.Header, delay)
+	if err != nil {
+		log.Error(err.Error())
+	}
+}
+
 func (sr *subroundEndRound) updateMetricsForLeader() {
 	sr.appStatusHandler.Increment(core.MetricCountAcceptedBlocks)
 	sr.appStatusHandler.SetStringValue(core.MetricConsensusRoundState,
 		fmt.Sprintf("valid block produced in %f sec", time.Now().Sub(sr.Rounder().TimeStamp()).Seconds()))
 }
 
+// broadcastFinalityProof broadcasts the aggregated signature and the signers bitmap of the committed header, so that
+// its finality can be verified without the consensus messages
+func (sr *subroundEndRound) broadcastFinalityProof() error {
+	headerHash, err := core.CalculateHash(sr.Marshalizer(), sr.Hasher(), sr.Header)
+	if err != nil {
+		return err
+	}
+
+	proof := block.NewFinalityProof(sr.Header, headerHash, sr.Data)
+
+	return sr.BroadcastMessenger().BroadcastFinalityProof(proof)
+}
+
 func (sr *subroundEndRound) broadcastMiniBlocksAndTransactions() error {
 	miniBlocks, transactions, err := sr.BlockProcessor().MarshalizedDataToBroadcast(sr.Header, sr.BlockBody)
 	if err != nil {
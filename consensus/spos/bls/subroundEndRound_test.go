@@ -3,6 +3,7 @@ package bls_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
@@ -443,6 +444,131 @@ func TestSubroundEndRound_DoEndRoundJobAllOK(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundEndRound_DoEndRoundJobShouldBroadcastFinalityProof(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	var proof *block.FinalityProof
+	bm := &mock.BroadcastMessengerMock{
+		BroadcastFinalityProofCalled: func(finalityProof *block.FinalityProof) error {
+			proof = finalityProof
+			return nil
+		},
+	}
+	container.SetBroadcastMessenger(bm)
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.SetSelfPubKey("A")
+
+	sr.Header = &block.Header{Nonce: 7, Round: 8, PrevRandSeed: []byte("prev rand seed")}
+	sr.Data = []byte("signed message hash")
+
+	r := sr.DoEndRoundJob()
+	assert.True(t, r)
+	assert.NotNil(t, proof)
+	assert.NotNil(t, proof.HeaderHash)
+	assert.Equal(t, []byte("signed message hash"), proof.SignedMessageHash)
+	assert.Equal(t, uint64(7), proof.Nonce)
+	assert.Equal(t, uint64(8), proof.Round)
+	assert.Equal(t, []byte("prev rand seed"), proof.PrevRandSeed)
+	assert.Equal(t, sr.Header.GetSignature(), proof.AggregatedSignature)
+	assert.Equal(t, sr.Header.GetPubKeysBitmap(), proof.PubKeysBitmap)
+}
+
+func TestSubroundEndRound_DoEndRoundJobValidatorWithSignatureFinishedShouldBroadcastWithDelay(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	wasCalled := false
+	bm := &mock.BroadcastMessengerMock{
+		BroadcastBlockWithDelayCalled: func(body data.BodyHandler, header data.HeaderHandler, delay time.Duration) error {
+			wasCalled = true
+			return nil
+		},
+	}
+	container.SetBroadcastMessenger(bm)
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.SetSelfPubKey("B")
+	_ = sr.SetSelfJobDone(bls.SrSignature, true)
+	sr.SetStatus(bls.SrSignature, spos.SsFinished)
+
+	sr.Header = &block.Header{}
+
+	r := sr.DoEndRoundJob()
+	assert.False(t, r)
+	assert.True(t, wasCalled)
+	assert.NotNil(t, sr.Header.GetSignature())
+}
+
+func TestSubroundEndRound_DoEndRoundJobValidatorWithSignatureNotFinishedShouldNotBroadcast(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	wasCalled := false
+	bm := &mock.BroadcastMessengerMock{
+		BroadcastBlockWithDelayCalled: func(body data.BodyHandler, header data.HeaderHandler, delay time.Duration) error {
+			wasCalled = true
+			return nil
+		},
+	}
+	container.SetBroadcastMessenger(bm)
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.SetSelfPubKey("B")
+	_ = sr.SetSelfJobDone(bls.SrSignature, true)
+
+	sr.Header = &block.Header{}
+
+	r := sr.DoEndRoundJob()
+	assert.False(t, r)
+	assert.False(t, wasCalled)
+}
+
+func TestSubroundEndRound_DoEndRoundJobValidatorWhichDidNotSignShouldNotBroadcast(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	wasCalled := false
+	bm := &mock.BroadcastMessengerMock{
+		BroadcastBlockWithDelayCalled: func(body data.BodyHandler, header data.HeaderHandler, delay time.Duration) error {
+			wasCalled = true
+			return nil
+		},
+	}
+	container.SetBroadcastMessenger(bm)
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.SetSelfPubKey("B")
+	sr.SetStatus(bls.SrSignature, spos.SsFinished)
+
+	sr.Header = &block.Header{}
+
+	r := sr.DoEndRoundJob()
+	assert.False(t, r)
+	assert.False(t, wasCalled)
+}
+
+func TestSubroundEndRound_DoEndRoundJobValidatorWhichIsNotLeaderFallbackShouldNotBroadcast(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	wasCalled := false
+	bm := &mock.BroadcastMessengerMock{
+		BroadcastBlockWithDelayCalled: func(body data.BodyHandler, header data.HeaderHandler, delay time.Duration) error {
+			wasCalled = true
+			return nil
+		},
+	}
+	container.SetBroadcastMessenger(bm)
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.SetSelfPubKey("C")
+	_ = sr.SetSelfJobDone(bls.SrSignature, true)
+	sr.SetStatus(bls.SrSignature, spos.SsFinished)
+
+	sr.Header = &block.Header{}
+
+	r := sr.DoEndRoundJob()
+	assert.False(t, r)
+	assert.False(t, wasCalled)
+}
+
 func TestSubroundEndRound_DoEndRoundConsensusCheckShouldReturnFalseWhenRoundIsCanceled(t *testing.T) {
 	t.Parallel()
 
@@ -94,8 +94,11 @@ func (sr *subroundSignature) doSignatureJob() bool {
 
 		log.Info(fmt.Sprintf("%sStep 2: signature has been sent\n", sr.SyncTimer().FormattedCurrentTime()))
 
-		// Validator has finished its job for this round
-		sr.RoundCanceled = true
+		// Validator has finished its job for this round, except the leader fallback which also collects the
+		// signatures for a delayed broadcast of the block
+		if !sr.IsSelfLeaderFallbackInCurrentRound() {
+			sr.RoundCanceled = true
+		}
 	}
 
 	err = sr.SetSelfJobDone(SrSignature, true)
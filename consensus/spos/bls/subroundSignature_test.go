@@ -262,7 +262,7 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 
 	r = sr.DoSignatureJob()
 	assert.True(t, r)
-	assert.True(t, sr.RoundCanceled)
+	assert.False(t, sr.RoundCanceled)
 
 	sr.SetJobDone(sr.SelfPubKey(), bls.SrSignature, false)
 	sr.RoundCanceled = false
@@ -272,6 +272,19 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	assert.False(t, sr.RoundCanceled)
 }
 
+func TestSubroundSignature_DoSignatureJobValidatorWhichIsNotLeaderFallbackShouldCancelRound(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundSignatureWithContainer(container)
+	sr.Data = []byte("X")
+	sr.SetSelfPubKey(sr.ConsensusGroup()[2])
+
+	r := sr.DoSignatureJob()
+	assert.True(t, r)
+	assert.True(t, sr.RoundCanceled)
+}
+
 func TestSubroundSignature_ReceivedSignature(t *testing.T) {
 	t.Parallel()
 
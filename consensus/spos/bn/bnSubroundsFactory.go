@@ -17,8 +17,9 @@ type factory struct {
 	consensusState *spos.ConsensusState
 	worker         spos.WorkerHandler
 
-	appStatusHandler core.AppStatusHandler
-	indexer          indexer.Indexer
+	appStatusHandler  core.AppStatusHandler
+	indexer           indexer.Indexer
+	participationGate spos.ParticipationGate
 }
 
 // NewSubroundsFactory creates a new factory for BN subrounds
@@ -39,10 +40,11 @@ func NewSubroundsFactory(
 	}
 
 	fct := factory{
-		consensusCore:    consensusDataContainer,
-		consensusState:   consensusState,
-		worker:           worker,
-		appStatusHandler: statusHandler.NewNilStatusHandler(),
+		consensusCore:     consensusDataContainer,
+		consensusState:    consensusState,
+		worker:            worker,
+		appStatusHandler:  statusHandler.NewNilStatusHandler(),
+		participationGate: spos.NewDisabledParticipationGate(),
 	}
 
 	return &fct, nil
@@ -84,6 +86,16 @@ func (fct *factory) SetIndexer(indexer indexer.Indexer) {
 	fct.indexer = indexer
 }
 
+// SetParticipationGate method will update the value of the factory's participation gate
+func (fct *factory) SetParticipationGate(participationGate spos.ParticipationGate) error {
+	if participationGate == nil || participationGate.IsInterfaceNil() {
+		return spos.ErrNilParticipationGate
+	}
+
+	fct.participationGate = participationGate
+	return nil
+}
+
 // GenerateSubrounds will generate the subrounds used in Belare & Naveen Cns
 func (fct *factory) GenerateSubrounds() error {
 	fct.initConsensusThreshold()
@@ -169,6 +181,11 @@ func (fct *factory) generateStartRoundSubround() error {
 
 	subroundStartRound.SetIndexer(fct.indexer)
 
+	err = subroundStartRound.SetParticipationGate(fct.participationGate)
+	if err != nil {
+		return err
+	}
+
 	fct.consensusCore.Chronology().AddSubround(subroundStartRound)
 
 	return nil
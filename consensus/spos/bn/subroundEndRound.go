@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
@@ -106,6 +107,11 @@ func (sr *subroundEndRound) doEndRoundJob() bool {
 		log.Error(err.Error())
 	}
 
+	err = sr.broadcastFinalityProof()
+	if err != nil {
+		log.Error(err.Error())
+	}
+
 	log.Info(fmt.Sprintf("%sStep 6: TxBlockBody and Header has been committed and broadcast\n", sr.SyncTimer().FormattedCurrentTime()))
 
 	err = sr.broadcastMiniBlocksAndTransactions()
@@ -132,6 +138,19 @@ func (sr *subroundEndRound) updateMetricsForLeader() {
 		fmt.Sprintf("valid block produced in %f sec", time.Now().Sub(sr.Rounder().TimeStamp()).Seconds()))
 }
 
+// broadcastFinalityProof broadcasts the aggregated signature and the signers bitmap of the committed header, so that
+// its finality can be verified without the consensus messages
+func (sr *subroundEndRound) broadcastFinalityProof() error {
+	headerHash, err := core.CalculateHash(sr.Marshalizer(), sr.Hasher(), sr.Header)
+	if err != nil {
+		return err
+	}
+
+	proof := block.NewFinalityProof(sr.Header, headerHash, sr.Data)
+
+	return sr.BroadcastMessenger().BroadcastFinalityProof(proof)
+}
+
 func (sr *subroundEndRound) broadcastMiniBlocksAndTransactions() error {
 	miniBlocks, transactions, err := sr.BlockProcessor().MarshalizedDataToBroadcast(sr.Header, sr.BlockBody)
 	if err != nil {
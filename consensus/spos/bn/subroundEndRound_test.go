@@ -445,6 +445,35 @@ func TestSubroundEndRound_DoEndRoundJobAllOK(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundEndRound_DoEndRoundJobShouldBroadcastFinalityProof(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	var proof *block.FinalityProof
+	bm := &mock.BroadcastMessengerMock{
+		BroadcastFinalityProofCalled: func(finalityProof *block.FinalityProof) error {
+			proof = finalityProof
+			return nil
+		},
+	}
+	container.SetBroadcastMessenger(bm)
+	sr := *initSubroundEndRoundWithContainer(container)
+
+	sr.Header = &block.Header{Nonce: 7, Round: 8, PrevRandSeed: []byte("prev rand seed")}
+	sr.Data = []byte("signed message hash")
+
+	r := sr.DoEndRoundJob()
+	assert.True(t, r)
+	assert.NotNil(t, proof)
+	assert.NotNil(t, proof.HeaderHash)
+	assert.Equal(t, []byte("signed message hash"), proof.SignedMessageHash)
+	assert.Equal(t, uint64(7), proof.Nonce)
+	assert.Equal(t, uint64(8), proof.Round)
+	assert.Equal(t, []byte("prev rand seed"), proof.PrevRandSeed)
+	assert.Equal(t, sr.Header.GetSignature(), proof.AggregatedSignature)
+	assert.Equal(t, sr.Header.GetPubKeysBitmap(), proof.PubKeysBitmap)
+}
+
 func TestSubroundEndRound_DoEndRoundConsensusCheckShouldReturnFalseWhenRoundIsCanceled(t *testing.T) {
 	t.Parallel()
 
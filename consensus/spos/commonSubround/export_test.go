@@ -1,6 +1,8 @@
 package commonSubround
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/data"
 )
@@ -48,3 +50,7 @@ func (sr *SubroundBlock) IsBlockReceived(threshold int) bool {
 func (sr *SubroundBlock) CreateHeader() (data.HeaderHandler, error) {
 	return sr.createHeader()
 }
+
+func (sr *SubroundBlock) ComputeProposalDeadline() time.Duration {
+	return sr.computeProposalDeadline()
+}
@@ -7,10 +7,16 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
+// proposalTimeBudgetPercent specifies the max time the leader may spend executing transactions while creating the
+// block body, as a percentage of the time remaining until the end of the subround Block. The rest of the subround is
+// kept for creating, signing and broadcasting the block header
+const proposalTimeBudgetPercent = 80
+
 // SubroundBlock defines the data needed by the subround Block
 type SubroundBlock struct {
 	*spos.Subround
@@ -104,20 +110,25 @@ func (sr *SubroundBlock) doBlockJob() bool {
 func (sr *SubroundBlock) sendBlockBody() bool {
 	startTime := time.Time{}
 	startTime = sr.RoundTimeStamp
-	maxTime := time.Duration(sr.EndTime())
-	haveTimeInCurrentSubround := func() bool {
+	maxTime := sr.computeProposalDeadline()
+	haveTimeForProposal := func() bool {
 		return sr.Rounder().RemainingTime(startTime, maxTime) > 0
 	}
 
 	blockBody, err := sr.BlockProcessor().CreateBlockBody(
 		uint64(sr.Rounder().Index()),
-		haveTimeInCurrentSubround,
+		haveTimeForProposal,
 	)
 	if err != nil {
 		log.Error(err.Error())
 		return false
 	}
 
+	if !haveTimeForProposal() {
+		log.Info(fmt.Sprintf("%sStep 1: time budget of %v for creating the block body has been reached\n",
+			sr.SyncTimer().FormattedCurrentTime(), maxTime))
+	}
+
 	blkStr, err := sr.Marshalizer().Marshal(blockBody)
 	if err != nil {
 		log.Error(err.Error())
@@ -146,6 +157,30 @@ func (sr *SubroundBlock) sendBlockBody() bool {
 	return true
 }
 
+// computeProposalDeadline returns the deadline, measured from the round start, until which the leader may execute
+// transactions while creating the block body. The deadline leaves time for broadcasting the proposal before the
+// subround Block ends and also for the validators to execute the proposed block before their processing threshold,
+// so that when the budget is exceeded a smaller block is proposed rather than one which can not be validated in time
+func (sr *SubroundBlock) computeProposalDeadline() time.Duration {
+	startTime := sr.RoundTimeStamp
+	subroundEndTime := time.Duration(sr.EndTime())
+	remainingTimeInSubround := sr.Rounder().RemainingTime(startTime, subroundEndTime)
+	if remainingTimeInSubround <= 0 {
+		return subroundEndTime
+	}
+
+	elapsedTime := subroundEndTime - remainingTimeInSubround
+	deadline := elapsedTime + remainingTimeInSubround*proposalTimeBudgetPercent/100
+
+	processingDeadline := sr.Rounder().TimeDuration() * time.Duration(sr.processingThresholdPercentage) / 100
+	validationDeadline := elapsedTime + (processingDeadline-elapsedTime)/2
+	if validationDeadline < deadline {
+		deadline = validationDeadline
+	}
+
+	return deadline
+}
+
 // sendBlockHeader method job the proposed block header in the subround Block
 func (sr *SubroundBlock) sendBlockHeader() bool {
 	hdr, err := sr.createHeader()
@@ -182,6 +217,7 @@ func (sr *SubroundBlock) sendBlockHeader() bool {
 
 	sr.Data = hdrHash
 	sr.Header = hdr
+	logger.SetRoundBlockHash(hdrHash)
 
 	return true
 }
@@ -283,6 +319,7 @@ func (sr *SubroundBlock) ReceivedBlockHeader(cnsDta *consensus.Message) bool {
 
 	sr.Data = cnsDta.BlockHeaderHash
 	sr.Header = sr.BlockProcessor().DecodeBlockHeader(cnsDta.SubRoundData)
+	logger.SetRoundBlockHash(cnsDta.BlockHeaderHash)
 
 	if sr.Header == nil {
 		return false
@@ -338,6 +375,7 @@ func (sr *SubroundBlock) processReceivedBlock(cnsDta *consensus.Message) bool {
 		if err == process.ErrTimeIsOut {
 			sr.RoundCanceled = true
 		}
+		sr.dumpRoundLogs(cnsDta.RoundIndex)
 		return false
 	}
 
@@ -351,6 +389,21 @@ func (sr *SubroundBlock) processReceivedBlock(cnsDta *consensus.Message) bool {
 	return true
 }
 
+// dumpRoundLogs writes the log entries collected during the provided round in a separate file, if the round log
+// bundles are enabled, to ease the investigation of the block processing failures
+func (sr *SubroundBlock) dumpRoundLogs(round int64) {
+	fileName, err := log.DumpRoundLogsToFile(round)
+	if err == logger.ErrRoundLogBundlesNotEnabled {
+		return
+	}
+	if err != nil {
+		log.Debug(fmt.Sprintf("could not dump the logs of round %d: %s\n", round, err.Error()))
+		return
+	}
+
+	log.Info(fmt.Sprintf("logs of round %d have been dumped in %s\n", round, fileName))
+}
+
 // doBlockConsensusCheck method checks if the consensus in the subround Block is achieved
 func (sr *SubroundBlock) doBlockConsensusCheck() bool {
 	if sr.RoundCanceled {
@@ -784,3 +784,90 @@ func RemainingTimeWithStruct(startTime time.Time, maxTime time.Duration) time.Du
 	remainingTime := maxTime - elapsedTime
 	return remainingTime
 }
+
+func createRounderMockWithElapsedTime(roundDuration time.Duration, elapsedTime time.Duration) *mock.RounderMock {
+	return &mock.RounderMock{
+		TimeDurationCalled: func() time.Duration {
+			return roundDuration
+		},
+		RemainingTimeCalled: func(startTime time.Time, maxTime time.Duration) time.Duration {
+			return maxTime - elapsedTime
+		},
+	}
+}
+
+func TestSubroundBlock_ComputeProposalDeadlineShouldKeepTimeForBroadcastingTheHeader(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+	elapsedTime := 5 * roundTimeDuration / 100
+	container.SetRounder(createRounderMockWithElapsedTime(roundTimeDuration, elapsedTime))
+
+	remainingTimeInSubround := time.Duration(sr.EndTime()) - elapsedTime
+	expectedDeadline := elapsedTime + remainingTimeInSubround*80/100
+
+	assert.Equal(t, expectedDeadline, sr.ComputeProposalDeadline())
+	assert.True(t, sr.ComputeProposalDeadline() < time.Duration(sr.EndTime()))
+}
+
+func TestSubroundBlock_ComputeProposalDeadlineShouldKeepTimeForValidatingTheBlock(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+	elapsedTime := 5 * roundTimeDuration / 100
+	shortRoundDuration := 30 * roundTimeDuration / 100
+	container.SetRounder(createRounderMockWithElapsedTime(shortRoundDuration, elapsedTime))
+
+	processingDeadline := shortRoundDuration * processingThresholdPercent / 100
+	expectedDeadline := elapsedTime + (processingDeadline-elapsedTime)/2
+
+	assert.Equal(t, expectedDeadline, sr.ComputeProposalDeadline())
+}
+
+func TestSubroundBlock_ComputeProposalDeadlineAfterSubroundEndShouldReturnSubroundEnd(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+	elapsedTime := time.Duration(sr.EndTime()) + 1
+	container.SetRounder(createRounderMockWithElapsedTime(roundTimeDuration, elapsedTime))
+
+	assert.Equal(t, time.Duration(sr.EndTime()), sr.ComputeProposalDeadline())
+}
+
+func TestSubroundBlock_DoBlockJobShouldStopCreatingTheBodyWhenTheBudgetIsExceeded(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+	elapsedTime := 5 * roundTimeDuration / 100
+	container.SetRounder(&mock.RounderMock{
+		RoundIndex: 1,
+		TimeDurationCalled: func() time.Duration {
+			return roundTimeDuration
+		},
+		RemainingTimeCalled: func(startTime time.Time, maxTime time.Duration) time.Duration {
+			return maxTime - elapsedTime
+		},
+	})
+	deadline := sr.ComputeProposalDeadline()
+
+	haveTimeAtDeadline := true
+	bpm := mock.InitBlockProcessorMock()
+	bpm.CreateBlockCalled = func(round uint64, haveTime func() bool) (data.BodyHandler, error) {
+		assert.True(t, haveTime())
+
+		elapsedTime = deadline
+		haveTimeAtDeadline = haveTime()
+
+		return block.Body{}, nil
+	}
+	container.SetBlockProcessor(bpm)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[0])
+
+	_ = sr.DoBlockJob()
+
+	assert.False(t, haveTimeAtDeadline)
+}
@@ -21,8 +21,9 @@ type SubroundStartRound struct {
 	getSubroundName               func(subroundId int) string
 	executeStoredMessages         func()
 
-	appStatusHandler core.AppStatusHandler
-	indexer          indexer.Indexer
+	appStatusHandler  core.AppStatusHandler
+	indexer           indexer.Indexer
+	participationGate spos.ParticipationGate
 }
 
 // NewSubroundStartRound creates a SubroundStartRound object
@@ -47,6 +48,7 @@ func NewSubroundStartRound(
 		executeStoredMessages,
 		statusHandler.NewNilStatusHandler(),
 		indexer.NewNilIndexer(),
+		spos.NewDisabledParticipationGate(),
 	}
 	srStartRound.Job = srStartRound.doStartRoundJob
 	srStartRound.Check = srStartRound.doStartRoundConsensusCheck
@@ -85,11 +87,22 @@ func (sr *SubroundStartRound) SetIndexer(indexer indexer.Indexer) {
 	sr.indexer = indexer
 }
 
+// SetParticipationGate method sets the gate deciding if the node is fit to take part in the consensus of a round
+func (sr *SubroundStartRound) SetParticipationGate(participationGate spos.ParticipationGate) error {
+	if participationGate == nil || participationGate.IsInterfaceNil() {
+		return spos.ErrNilParticipationGate
+	}
+
+	sr.participationGate = participationGate
+	return nil
+}
+
 // doStartRoundJob method does the job of the subround StartRound
 func (sr *SubroundStartRound) doStartRoundJob() bool {
 	sr.ResetConsensusState()
 	sr.RoundIndex = sr.Rounder().Index()
 	sr.RoundTimeStamp = sr.Rounder().TimeStamp()
+	logger.SetRoundContext(sr.RoundIndex)
 	return true
 }
 
@@ -161,6 +174,18 @@ func (sr *SubroundStartRound) initCurrentRound() bool {
 		return false
 	}
 
+	err = sr.participationGate.CheckParticipation()
+	if err != nil {
+		log.Info(fmt.Sprintf("%scanceled round %d in subround %s, not fit to take part in consensus: %s\n",
+			sr.SyncTimer().FormattedCurrentTime(), sr.Rounder().Index(), sr.getSubroundName(sr.Current()), err.Error()))
+
+		sr.RoundCanceled = true
+
+		sr.appStatusHandler.SetStringValue(core.MetricConsensusState, "not fit for consensus")
+
+		return false
+	}
+
 	sr.appStatusHandler.Increment(core.MetricCountConsensus)
 	sr.appStatusHandler.SetStringValue(core.MetricConsensusState, "participant")
 
@@ -403,6 +403,50 @@ func TestSubroundStartRound_InitCurrentRoundShouldReturnTrue(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundStartRound_SetParticipationGateNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	srStartRound := *initSubroundStartRound()
+
+	err := srStartRound.SetParticipationGate(nil)
+
+	assert.Equal(t, spos.ErrNilParticipationGate, err)
+}
+
+func TestSubroundStartRound_InitCurrentRoundShouldReturnFalseWhenParticipationGateRefuses(t *testing.T) {
+	t.Parallel()
+
+	bootstrapperMock := &mock.BootstrapperMock{}
+	bootstrapperMock.ShouldSyncCalled = func() bool {
+		return false
+	}
+
+	multiSignerResetCalled := false
+	multiSignerMock := mock.InitMultiSignerMock()
+	multiSignerMock.ResetCalled = func(pubKeys []string, index uint16) error {
+		multiSignerResetCalled = true
+		return nil
+	}
+
+	container := mock.InitConsensusCore()
+	container.SetBootStrapper(bootstrapperMock)
+	container.SetMultiSigner(multiSignerMock)
+
+	srStartRound := *initSubroundStartRoundWithContainer(container)
+	err := srStartRound.SetParticipationGate(&mock.ParticipationGateStub{
+		CheckParticipationCalled: func() error {
+			return spos.ErrNotEnoughShardPeers
+		},
+	})
+	assert.Nil(t, err)
+
+	r := srStartRound.InitCurrentRound()
+
+	assert.False(t, r)
+	assert.True(t, srStartRound.RoundCanceled)
+	assert.False(t, multiSignerResetCalled)
+}
+
 func TestSubroundStartRound_GenerateNextConsensusGroupShouldReturnErr(t *testing.T) {
 	t.Parallel()
 
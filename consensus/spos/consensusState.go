@@ -80,6 +80,17 @@ func (cns *ConsensusState) IsSelfLeaderInCurrentRound() bool {
 	return cns.IsNodeLeaderInCurrentRound(cns.selfPubKey)
 }
 
+// IsSelfLeaderFallbackInCurrentRound method checks if the current node is the one which follows the leader in the
+// consensus group of the current round. This node is the only validator which broadcasts the block if the leader
+// fails to do it
+func (cns *ConsensusState) IsSelfLeaderFallbackInCurrentRound() bool {
+	if len(cns.consensusGroup) < 2 {
+		return false
+	}
+
+	return cns.consensusGroup[1] == cns.selfPubKey
+}
+
 // GetLeader method gets the leader of the current round
 func (cns *ConsensusState) GetLeader() (string, error) {
 	if cns.consensusGroup == nil {
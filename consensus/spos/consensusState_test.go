@@ -101,6 +101,26 @@ func TestConsensusState_IsSelfLeaderInCurrentRoundShouldReturnTrue(t *testing.T)
 	assert.False(t, cns.IsSelfLeaderInCurrentRound())
 }
 
+func TestConsensusState_IsSelfLeaderFallbackInCurrentRoundShouldReturnTrue(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusState()
+
+	assert.True(t, cns.IsSelfLeaderFallbackInCurrentRound())
+}
+
+func TestConsensusState_IsSelfLeaderFallbackInCurrentRoundShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusState()
+
+	cns.SetConsensusGroup([]string{"1", "3", "2"})
+	assert.False(t, cns.IsSelfLeaderFallbackInCurrentRound())
+
+	cns.SetConsensusGroup([]string{"2"})
+	assert.False(t, cns.IsSelfLeaderFallbackInCurrentRound())
+}
+
 func TestConsensusState_GetLeaderShoudErrNilConsensusGroup(t *testing.T) {
 	t.Parallel()
 
@@ -2,3 +2,15 @@ package spos
 
 // maxThresholdPercent specifies the max allocated time percent for doing Job as a percentage of the total time of one round
 const maxThresholdPercent = 75
+
+// maxFutureRoundMessages specifies the max number of consensus messages, received early for the next round, which
+// are held until the round starts
+const maxFutureRoundMessages = 1000
+
+// maxFutureRoundMessagesPerSender specifies the max number of consensus messages, received early for the next round,
+// which are held for a single sender
+const maxFutureRoundMessagesPerSender = 10
+
+// futureRoundMessagesMaxAgeInRounds specifies, in round durations, how long a message received early for the next
+// round is held before being dropped
+const futureRoundMessagesMaxAgeInRounds = 2
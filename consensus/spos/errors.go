@@ -133,6 +133,9 @@ var ErrNilBlsSingleSigner = errors.New("BLS single signer should not be nil")
 // ErrNilHeader is raised when an expected header is nil
 var ErrNilHeader = errors.New("header is nil")
 
+// ErrNilFinalityProof is raised when an expected finality proof is nil
+var ErrNilFinalityProof = errors.New("finality proof is nil")
+
 // ErrNilBody is raised when an expected body is nil
 var ErrNilBody = errors.New("body is nil")
 
@@ -147,3 +150,27 @@ var ErrNilExecuteStoredMessages = errors.New("executeStoredMessages is nil")
 
 // ErrNilAppStatusHandler defines the error for setting a nil AppStatusHandler
 var ErrNilAppStatusHandler = errors.New("nil AppStatusHandler")
+
+// ErrNilConsensusMetrics is raised when a valid consensus metrics handler is expected but nil used
+var ErrNilConsensusMetrics = errors.New("consensus metrics handler is nil")
+
+// ErrMessageForFutureRound is raised when a consensus message is received for a round after the next one
+var ErrMessageForFutureRound = errors.New("message is for future round")
+
+// ErrFutureRoundMessagesBufferFull is raised when a message for the next round is received but the buffer is full
+var ErrFutureRoundMessagesBufferFull = errors.New("future round messages buffer is full")
+
+// ErrTooManyFutureRoundMessagesFromSender is raised when a sender exceeds its quota of buffered next round messages
+var ErrTooManyFutureRoundMessagesFromSender = errors.New("too many future round messages from sender")
+
+// ErrNilParticipationGate is raised when a valid participation gate is expected but nil used
+var ErrNilParticipationGate = errors.New("participation gate is nil")
+
+// ErrNodeNotSynced is raised when the node is too far behind the network's highest nonce to take part in consensus
+var ErrNodeNotSynced = errors.New("node is not synced")
+
+// ErrNotEnoughShardPeers is raised when the node is connected to too few peers of its shard to take part in consensus
+var ErrNotEnoughShardPeers = errors.New("not enough connected peers in own shard")
+
+// ErrNotEnoughMetachainPeers is raised when the node is connected to too few metachain peers to take part in consensus
+var ErrNotEnoughMetachainPeers = errors.New("not enough connected metachain peers")
@@ -1,6 +1,8 @@
 package spos
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/marshal"
@@ -9,6 +11,8 @@ import (
 
 type RoundConsensus *roundConsensus
 
+const MaxFutureRoundMessagesPerSender = maxFutureRoundMessagesPerSender
+
 // worker
 
 func (wrk *Worker) BlockProcessor() process.BlockProcessor {
@@ -138,3 +142,27 @@ func (wrk *Worker) SetConsensusStateChangedChannel(consensusStateChangedChannel
 func (wrk *Worker) CheckSelfState(cnsDta *consensus.Message) error {
 	return wrk.checkSelfState(cnsDta)
 }
+
+func (wrk *Worker) NumFutureRoundMessages() int {
+	return wrk.futureRoundMessages.len()
+}
+
+// future round messages
+
+type FutureRoundMessages = futureRoundMessages
+
+func NewFutureRoundMessages(maxMessages int, maxPerSender int, maxMessageAge time.Duration) *FutureRoundMessages {
+	return newFutureRoundMessages(maxMessages, maxPerSender, maxMessageAge)
+}
+
+func (frm *futureRoundMessages) Add(cnsDta *consensus.Message, receivedTime time.Time) error {
+	return frm.add(cnsDta, receivedTime)
+}
+
+func (frm *futureRoundMessages) PopUntilRound(roundIndex int64, currentTime time.Time) []*consensus.Message {
+	return frm.popUntilRound(roundIndex, currentTime)
+}
+
+func (frm *futureRoundMessages) Len() int {
+	return frm.len()
+}
@@ -0,0 +1,114 @@
+package spos
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+type futureRoundMessage struct {
+	cnsDta       *consensus.Message
+	receivedTime time.Time
+}
+
+// futureRoundMessages holds the consensus messages received, slightly early, for a round which has not started yet
+// on this node. The number of messages held in total and per sender is bounded, and messages are dropped if they
+// are not replayed in due time, so that the buffer can not be abused by the consensus group members
+type futureRoundMessages struct {
+	mutMessages   sync.Mutex
+	messages      []*futureRoundMessage
+	numPerSender  map[string]int
+	maxMessages   int
+	maxPerSender  int
+	maxMessageAge time.Duration
+}
+
+func newFutureRoundMessages(maxMessages int, maxPerSender int, maxMessageAge time.Duration) *futureRoundMessages {
+	return &futureRoundMessages{
+		messages:      make([]*futureRoundMessage, 0),
+		numPerSender:  make(map[string]int),
+		maxMessages:   maxMessages,
+		maxPerSender:  maxPerSender,
+		maxMessageAge: maxMessageAge,
+	}
+}
+
+// add buffers the provided message. An error is returned if the buffer or the sender's quota is full
+func (frm *futureRoundMessages) add(cnsDta *consensus.Message, receivedTime time.Time) error {
+	frm.mutMessages.Lock()
+	defer frm.mutMessages.Unlock()
+
+	frm.removeExpired(receivedTime)
+
+	if len(frm.messages) >= frm.maxMessages {
+		return ErrFutureRoundMessagesBufferFull
+	}
+
+	sender := string(cnsDta.PubKey)
+	if frm.numPerSender[sender] >= frm.maxPerSender {
+		return ErrTooManyFutureRoundMessagesFromSender
+	}
+
+	frm.messages = append(frm.messages, &futureRoundMessage{
+		cnsDta:       cnsDta,
+		receivedTime: receivedTime,
+	})
+	frm.numPerSender[sender]++
+
+	return nil
+}
+
+// popUntilRound removes from the buffer and returns the messages for the given round. The messages for older
+// rounds and the expired ones are dropped
+func (frm *futureRoundMessages) popUntilRound(roundIndex int64, currentTime time.Time) []*consensus.Message {
+	frm.mutMessages.Lock()
+	defer frm.mutMessages.Unlock()
+
+	frm.removeExpired(currentTime)
+
+	popped := make([]*consensus.Message, 0)
+	remaining := make([]*futureRoundMessage, 0, len(frm.messages))
+	for _, msg := range frm.messages {
+		if msg.cnsDta.RoundIndex > roundIndex {
+			remaining = append(remaining, msg)
+			continue
+		}
+
+		frm.decreaseSenderCount(string(msg.cnsDta.PubKey))
+		if msg.cnsDta.RoundIndex == roundIndex {
+			popped = append(popped, msg.cnsDta)
+		}
+	}
+	frm.messages = remaining
+
+	return popped
+}
+
+// len returns the number of buffered messages
+func (frm *futureRoundMessages) len() int {
+	frm.mutMessages.Lock()
+	defer frm.mutMessages.Unlock()
+
+	return len(frm.messages)
+}
+
+func (frm *futureRoundMessages) removeExpired(currentTime time.Time) {
+	remaining := make([]*futureRoundMessage, 0, len(frm.messages))
+	for _, msg := range frm.messages {
+		if currentTime.Sub(msg.receivedTime) > frm.maxMessageAge {
+			frm.decreaseSenderCount(string(msg.cnsDta.PubKey))
+			continue
+		}
+
+		remaining = append(remaining, msg)
+	}
+	frm.messages = remaining
+}
+
+func (frm *futureRoundMessages) decreaseSenderCount(sender string) {
+	frm.numPerSender[sender]--
+	if frm.numPerSender[sender] <= 0 {
+		delete(frm.numPerSender, sender)
+	}
+}
@@ -0,0 +1,104 @@
+package spos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/stretchr/testify/assert"
+)
+
+func createFutureRoundMessage(sender string, roundIndex int64) *consensus.Message {
+	return &consensus.Message{
+		PubKey:     []byte(sender),
+		RoundIndex: roundIndex,
+	}
+}
+
+func TestFutureRoundMessages_AddShouldWork(t *testing.T) {
+	t.Parallel()
+
+	frm := spos.NewFutureRoundMessages(10, 2, time.Second)
+	err := frm.Add(createFutureRoundMessage("A", 1), time.Unix(0, 0))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, frm.Len())
+}
+
+func TestFutureRoundMessages_AddBufferFullShouldErr(t *testing.T) {
+	t.Parallel()
+
+	frm := spos.NewFutureRoundMessages(2, 2, time.Second)
+	_ = frm.Add(createFutureRoundMessage("A", 1), time.Unix(0, 0))
+	_ = frm.Add(createFutureRoundMessage("B", 1), time.Unix(0, 0))
+	err := frm.Add(createFutureRoundMessage("C", 1), time.Unix(0, 0))
+
+	assert.Equal(t, spos.ErrFutureRoundMessagesBufferFull, err)
+	assert.Equal(t, 2, frm.Len())
+}
+
+func TestFutureRoundMessages_AddTooManyFromSenderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	frm := spos.NewFutureRoundMessages(10, 2, time.Second)
+	_ = frm.Add(createFutureRoundMessage("A", 1), time.Unix(0, 0))
+	_ = frm.Add(createFutureRoundMessage("A", 1), time.Unix(0, 0))
+	err := frm.Add(createFutureRoundMessage("A", 1), time.Unix(0, 0))
+	assert.Equal(t, spos.ErrTooManyFutureRoundMessagesFromSender, err)
+
+	err = frm.Add(createFutureRoundMessage("B", 1), time.Unix(0, 0))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, frm.Len())
+}
+
+func TestFutureRoundMessages_AddShouldRemoveExpiredMessages(t *testing.T) {
+	t.Parallel()
+
+	frm := spos.NewFutureRoundMessages(1, 1, time.Second)
+	_ = frm.Add(createFutureRoundMessage("A", 1), time.Unix(0, 0))
+	err := frm.Add(createFutureRoundMessage("A", 1), time.Unix(2, 0))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, frm.Len())
+}
+
+func TestFutureRoundMessages_PopUntilRoundShouldReturnOnlyMessagesForRound(t *testing.T) {
+	t.Parallel()
+
+	frm := spos.NewFutureRoundMessages(10, 10, time.Second)
+	_ = frm.Add(createFutureRoundMessage("A", 1), time.Unix(0, 0))
+	_ = frm.Add(createFutureRoundMessage("A", 2), time.Unix(0, 0))
+	_ = frm.Add(createFutureRoundMessage("A", 3), time.Unix(0, 0))
+
+	popped := frm.PopUntilRound(2, time.Unix(0, 0))
+
+	assert.Equal(t, 1, len(popped))
+	assert.Equal(t, int64(2), popped[0].RoundIndex)
+	assert.Equal(t, 1, frm.Len())
+}
+
+func TestFutureRoundMessages_PopUntilRoundShouldDropExpiredMessages(t *testing.T) {
+	t.Parallel()
+
+	frm := spos.NewFutureRoundMessages(10, 10, time.Second)
+	_ = frm.Add(createFutureRoundMessage("A", 1), time.Unix(0, 0))
+	_ = frm.Add(createFutureRoundMessage("B", 1), time.Unix(1, 0))
+
+	popped := frm.PopUntilRound(1, time.Unix(2, 0))
+
+	assert.Equal(t, 1, len(popped))
+	assert.Equal(t, []byte("B"), popped[0].PubKey)
+	assert.Equal(t, 0, frm.Len())
+}
+
+func TestFutureRoundMessages_PopUntilRoundShouldFreeSenderQuota(t *testing.T) {
+	t.Parallel()
+
+	frm := spos.NewFutureRoundMessages(10, 1, time.Second)
+	_ = frm.Add(createFutureRoundMessage("A", 1), time.Unix(0, 0))
+	_ = frm.PopUntilRound(1, time.Unix(0, 0))
+	err := frm.Add(createFutureRoundMessage("A", 2), time.Unix(0, 0))
+
+	assert.Nil(t, err)
+}
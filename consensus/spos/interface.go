@@ -1,6 +1,8 @@
 package spos
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -72,6 +74,13 @@ type SubroundsFactory interface {
 	IsInterfaceNil() bool
 }
 
+// ParticipationGate decides if the node is fit to take part, as leader or as validator, in the consensus of the
+// current round. A node which is not fit should neither propose nor sign
+type ParticipationGate interface {
+	CheckParticipation() error
+	IsInterfaceNil() bool
+}
+
 //WorkerHandler represents the interface for the SposWorker
 type WorkerHandler interface {
 	//AddReceivedMessageCall adds a new handler function for a received messege type
@@ -79,7 +88,7 @@ type WorkerHandler interface {
 	//RemoveAllReceivedMessagesCalls removes all the functions handlers
 	RemoveAllReceivedMessagesCalls()
 	//ProcessReceivedMessage method redirects the received message to the channel which should handle it
-	ProcessReceivedMessage(message p2p.MessageP2P) error
+	ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error
 	//Extend does an extension for the subround with subroundId
 	Extend(subroundId int)
 	//GetConsensusStateChangedChannel gets the channel for the consensusStateChanged
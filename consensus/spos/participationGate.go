@@ -0,0 +1,128 @@
+package spos
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// PeersOnTopicHandler defines the operation used to find out the peers connected on a topic
+type PeersOnTopicHandler interface {
+	ConnectedPeersOnTopic(topic string) []p2p.PeerID
+	IsInterfaceNil() bool
+}
+
+// ArgSyncAndPeersGate holds the components and the thresholds used by the sync and peers participation gate. The
+// peers of the node's shard are the ones connected on ShardTopic and the metachain peers the ones connected on
+// MetachainTopic. For a metachain node both topics should be the same
+type ArgSyncAndPeersGate struct {
+	BlockChain        data.ChainHandler
+	ForkDetector      process.ForkDetector
+	Messenger         PeersOnTopicHandler
+	ShardTopic        string
+	MetachainTopic    string
+	MaxNoncesBehind   uint64
+	MinShardPeers     uint32
+	MinMetachainPeers uint32
+}
+
+// SyncAndPeersGate lets the node take part in consensus only if it is at most MaxNoncesBehind nonces behind the
+// network's highest nonce and it is connected to at least MinShardPeers peers of its shard and MinMetachainPeers
+// metachain peers. It keeps partitioned or freshly restarted validators from proposing or signing blocks built on
+// a stale view of the chain
+type SyncAndPeersGate struct {
+	blockChain        data.ChainHandler
+	forkDetector      process.ForkDetector
+	messenger         PeersOnTopicHandler
+	shardTopic        string
+	metachainTopic    string
+	maxNoncesBehind   uint64
+	minShardPeers     uint32
+	minMetachainPeers uint32
+}
+
+// NewSyncAndPeersGate creates a new SyncAndPeersGate instance
+func NewSyncAndPeersGate(args ArgSyncAndPeersGate) (*SyncAndPeersGate, error) {
+	if args.BlockChain == nil || args.BlockChain.IsInterfaceNil() {
+		return nil, ErrNilBlockChain
+	}
+	if args.ForkDetector == nil || args.ForkDetector.IsInterfaceNil() {
+		return nil, ErrNilForkDetector
+	}
+	if args.Messenger == nil || args.Messenger.IsInterfaceNil() {
+		return nil, ErrNilMessenger
+	}
+
+	return &SyncAndPeersGate{
+		blockChain:        args.BlockChain,
+		forkDetector:      args.ForkDetector,
+		messenger:         args.Messenger,
+		shardTopic:        args.ShardTopic,
+		metachainTopic:    args.MetachainTopic,
+		maxNoncesBehind:   args.MaxNoncesBehind,
+		minShardPeers:     args.MinShardPeers,
+		minMetachainPeers: args.MinMetachainPeers,
+	}, nil
+}
+
+// CheckParticipation returns nil if the node may take part in the consensus of the current round, or an error
+// telling which gate it did not pass
+func (gate *SyncAndPeersGate) CheckParticipation() error {
+	currentNonce := uint64(0)
+	currentHeader := gate.blockChain.GetCurrentBlockHeader()
+	if currentHeader != nil && !currentHeader.IsInterfaceNil() {
+		currentNonce = currentHeader.GetNonce()
+	}
+
+	highestNonce := gate.forkDetector.ProbableHighestNonce()
+	if highestNonce > currentNonce && highestNonce-currentNonce > gate.maxNoncesBehind {
+		return fmt.Errorf("%s: current nonce %d, probable highest nonce %d, max nonces behind %d",
+			ErrNodeNotSynced.Error(), currentNonce, highestNonce, gate.maxNoncesBehind)
+	}
+
+	numShardPeers := len(gate.messenger.ConnectedPeersOnTopic(gate.shardTopic))
+	if uint32(numShardPeers) < gate.minShardPeers {
+		return fmt.Errorf("%s: connected to %d peers, min shard peers %d",
+			ErrNotEnoughShardPeers.Error(), numShardPeers, gate.minShardPeers)
+	}
+
+	numMetachainPeers := len(gate.messenger.ConnectedPeersOnTopic(gate.metachainTopic))
+	if uint32(numMetachainPeers) < gate.minMetachainPeers {
+		return fmt.Errorf("%s: connected to %d peers, min metachain peers %d",
+			ErrNotEnoughMetachainPeers.Error(), numMetachainPeers, gate.minMetachainPeers)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gate *SyncAndPeersGate) IsInterfaceNil() bool {
+	if gate == nil {
+		return true
+	}
+	return false
+}
+
+// disabledParticipationGate lets the node take part in consensus in every round
+type disabledParticipationGate struct {
+}
+
+// NewDisabledParticipationGate creates a participation gate which never keeps the node out of consensus
+func NewDisabledParticipationGate() *disabledParticipationGate {
+	return &disabledParticipationGate{}
+}
+
+// CheckParticipation always returns nil
+func (dpg *disabledParticipationGate) CheckParticipation() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dpg *disabledParticipationGate) IsInterfaceNil() bool {
+	if dpg == nil {
+		return true
+	}
+	return false
+}
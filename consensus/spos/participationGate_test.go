@@ -0,0 +1,156 @@
+package spos_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+const shardTopic = "consensus_0"
+const metachainTopic = "consensus_META"
+
+func createPeers(numPeers int) []p2p.PeerID {
+	peers := make([]p2p.PeerID, numPeers)
+	for i := range peers {
+		peers[i] = p2p.PeerID(strings.Repeat("p", i+1))
+	}
+
+	return peers
+}
+
+func createMockArgSyncAndPeersGate(
+	currentNonce uint64,
+	highestNonce uint64,
+	numShardPeers int,
+	numMetachainPeers int,
+) spos.ArgSyncAndPeersGate {
+	return spos.ArgSyncAndPeersGate{
+		BlockChain: &mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: currentNonce}
+			},
+		},
+		ForkDetector: &mock.ForkDetectorMock{
+			ProbableHighestNonceCalled: func() uint64 {
+				return highestNonce
+			},
+		},
+		Messenger: &mock.PeersOnTopicHandlerStub{
+			ConnectedPeersOnTopicCalled: func(topic string) []p2p.PeerID {
+				if topic == metachainTopic {
+					return createPeers(numMetachainPeers)
+				}
+				return createPeers(numShardPeers)
+			},
+		},
+		ShardTopic:        shardTopic,
+		MetachainTopic:    metachainTopic,
+		MaxNoncesBehind:   2,
+		MinShardPeers:     3,
+		MinMetachainPeers: 2,
+	}
+}
+
+func TestNewSyncAndPeersGate_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgSyncAndPeersGate(10, 10, 3, 2)
+	args.BlockChain = nil
+	gate, err := spos.NewSyncAndPeersGate(args)
+	assert.Nil(t, gate)
+	assert.Equal(t, spos.ErrNilBlockChain, err)
+
+	args = createMockArgSyncAndPeersGate(10, 10, 3, 2)
+	args.ForkDetector = nil
+	gate, err = spos.NewSyncAndPeersGate(args)
+	assert.Nil(t, gate)
+	assert.Equal(t, spos.ErrNilForkDetector, err)
+
+	args = createMockArgSyncAndPeersGate(10, 10, 3, 2)
+	args.Messenger = nil
+	gate, err = spos.NewSyncAndPeersGate(args)
+	assert.Nil(t, gate)
+	assert.Equal(t, spos.ErrNilMessenger, err)
+}
+
+func TestNewSyncAndPeersGate_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	gate, err := spos.NewSyncAndPeersGate(createMockArgSyncAndPeersGate(10, 10, 3, 2))
+
+	assert.Nil(t, err)
+	assert.False(t, gate.IsInterfaceNil())
+}
+
+func TestSyncAndPeersGate_CheckParticipationAllGatesPassedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	gate, _ := spos.NewSyncAndPeersGate(createMockArgSyncAndPeersGate(10, 12, 3, 2))
+
+	assert.Nil(t, gate.CheckParticipation())
+}
+
+func TestSyncAndPeersGate_CheckParticipationNotSyncedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gate, _ := spos.NewSyncAndPeersGate(createMockArgSyncAndPeersGate(10, 13, 3, 2))
+
+	err := gate.CheckParticipation()
+
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), spos.ErrNodeNotSynced.Error()))
+}
+
+func TestSyncAndPeersGate_CheckParticipationWithoutCurrentHeaderShouldCompareWithGenesis(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgSyncAndPeersGate(0, 3, 3, 2)
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return nil
+		},
+	}
+	gate, _ := spos.NewSyncAndPeersGate(args)
+
+	err := gate.CheckParticipation()
+
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), spos.ErrNodeNotSynced.Error()))
+}
+
+func TestSyncAndPeersGate_CheckParticipationNotEnoughShardPeersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gate, _ := spos.NewSyncAndPeersGate(createMockArgSyncAndPeersGate(10, 10, 2, 2))
+
+	err := gate.CheckParticipation()
+
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), spos.ErrNotEnoughShardPeers.Error()))
+}
+
+func TestSyncAndPeersGate_CheckParticipationNotEnoughMetachainPeersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gate, _ := spos.NewSyncAndPeersGate(createMockArgSyncAndPeersGate(10, 10, 3, 1))
+
+	err := gate.CheckParticipation()
+
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), spos.ErrNotEnoughMetachainPeers.Error()))
+}
+
+func TestDisabledParticipationGate_CheckParticipationShouldWork(t *testing.T) {
+	t.Parallel()
+
+	gate := spos.NewDisabledParticipationGate()
+
+	assert.False(t, gate.IsInterfaceNil())
+	assert.Nil(t, gate.CheckParticipation())
+}
@@ -9,3 +9,18 @@ var ErrInvalidConsensusType = errors.New("invalid consensus type")
 
 // ErrInvalidShardId signals that an invalid shard id has been provided
 var ErrInvalidShardId = errors.New("invalid shard id")
+
+// ErrEmptyConsensusTypeName signals that a consensus type is registered with an empty name
+var ErrEmptyConsensusTypeName = errors.New("empty consensus type name")
+
+// ErrEmptySignatureScheme signals that a consensus type is registered without a signature scheme
+var ErrEmptySignatureScheme = errors.New("empty signature scheme")
+
+// ErrNilSubroundsFactoryCreator signals that a consensus type is registered without a subrounds factory creator
+var ErrNilSubroundsFactoryCreator = errors.New("nil subrounds factory creator")
+
+// ErrNilConsensusServiceCreator signals that a consensus type is registered without a consensus service creator
+var ErrNilConsensusServiceCreator = errors.New("nil consensus service creator")
+
+// ErrConsensusTypeAlreadyRegistered signals that a consensus type with the same name has already been registered
+var ErrConsensusTypeAlreadyRegistered = errors.New("consensus type already registered")
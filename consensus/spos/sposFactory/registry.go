@@ -0,0 +1,175 @@
+package sposFactory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bn"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+)
+
+// SubroundsFactoryCreator creates the subrounds factory of a consensus type
+type SubroundsFactoryCreator func(
+	consensusDataContainer spos.ConsensusCoreHandler,
+	consensusState *spos.ConsensusState,
+	worker spos.WorkerHandler,
+	appStatusHandler core.AppStatusHandler,
+	indexer indexer.Indexer,
+	participationGate spos.ParticipationGate,
+) (spos.SubroundsFactory, error)
+
+// ConsensusServiceCreator creates the consensus service of a consensus type
+type ConsensusServiceCreator func() (spos.ConsensusService, error)
+
+// ConsensusType holds what is needed for running a consensus protocol. The signature scheme is the name of one of the
+// signature schemes known by the node (bls or bn) and selects the signers and the multisigner used by the protocol
+type ConsensusType struct {
+	SignatureScheme        string
+	CreateSubroundsFactory SubroundsFactoryCreator
+	CreateConsensusService ConsensusServiceCreator
+}
+
+var mutConsensusTypes sync.RWMutex
+var consensusTypes = make(map[string]ConsensusType)
+
+func init() {
+	_ = RegisterConsensusType(blsConsensusType, ConsensusType{
+		SignatureScheme:        blsConsensusType,
+		CreateSubroundsFactory: createBlsSubroundsFactory,
+		CreateConsensusService: func() (spos.ConsensusService, error) {
+			return bls.NewConsensusService()
+		},
+	})
+	_ = RegisterConsensusType(bnConsensusType, ConsensusType{
+		SignatureScheme:        bnConsensusType,
+		CreateSubroundsFactory: createBnSubroundsFactory,
+		CreateConsensusService: func() (spos.ConsensusService, error) {
+			return bn.NewConsensusService()
+		},
+	})
+}
+
+// RegisterConsensusType makes a consensus type available, under the provided name, to be selected through the
+// Consensus.Type config option. It should be called from an init function, before the node starts
+func RegisterConsensusType(name string, consensusType ConsensusType) error {
+	if len(name) == 0 {
+		return ErrEmptyConsensusTypeName
+	}
+	if len(consensusType.SignatureScheme) == 0 {
+		return ErrEmptySignatureScheme
+	}
+	if consensusType.CreateSubroundsFactory == nil {
+		return ErrNilSubroundsFactoryCreator
+	}
+	if consensusType.CreateConsensusService == nil {
+		return ErrNilConsensusServiceCreator
+	}
+
+	mutConsensusTypes.Lock()
+	defer mutConsensusTypes.Unlock()
+
+	_, found := consensusTypes[name]
+	if found {
+		return ErrConsensusTypeAlreadyRegistered
+	}
+
+	consensusTypes[name] = consensusType
+	return nil
+}
+
+// RegisteredConsensusTypes returns the sorted names of all the registered consensus types
+func RegisteredConsensusTypes() []string {
+	mutConsensusTypes.RLock()
+	defer mutConsensusTypes.RUnlock()
+
+	names := make([]string, 0, len(consensusTypes))
+	for name := range consensusTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// GetSignatureScheme returns the signature scheme used by the provided consensus type
+func GetSignatureScheme(consensusType string) (string, error) {
+	registered, err := getConsensusType(consensusType)
+	if err != nil {
+		return "", err
+	}
+
+	return registered.SignatureScheme, nil
+}
+
+func getConsensusType(name string) (ConsensusType, error) {
+	mutConsensusTypes.RLock()
+	defer mutConsensusTypes.RUnlock()
+
+	consensusType, found := consensusTypes[name]
+	if !found {
+		return ConsensusType{}, ErrInvalidConsensusType
+	}
+
+	return consensusType, nil
+}
+
+func createBlsSubroundsFactory(
+	consensusDataContainer spos.ConsensusCoreHandler,
+	consensusState *spos.ConsensusState,
+	worker spos.WorkerHandler,
+	appStatusHandler core.AppStatusHandler,
+	indexer indexer.Indexer,
+	participationGate spos.ParticipationGate,
+) (spos.SubroundsFactory, error) {
+
+	subRoundFactoryBls, err := bls.NewSubroundsFactory(consensusDataContainer, consensusState, worker)
+	if err != nil {
+		return nil, err
+	}
+
+	err = subRoundFactoryBls.SetAppStatusHandler(appStatusHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	subRoundFactoryBls.SetIndexer(indexer)
+
+	err = subRoundFactoryBls.SetParticipationGate(participationGate)
+	if err != nil {
+		return nil, err
+	}
+
+	return subRoundFactoryBls, nil
+}
+
+func createBnSubroundsFactory(
+	consensusDataContainer spos.ConsensusCoreHandler,
+	consensusState *spos.ConsensusState,
+	worker spos.WorkerHandler,
+	appStatusHandler core.AppStatusHandler,
+	indexer indexer.Indexer,
+	participationGate spos.ParticipationGate,
+) (spos.SubroundsFactory, error) {
+
+	subRoundFactoryBn, err := bn.NewSubroundsFactory(consensusDataContainer, consensusState, worker)
+	if err != nil {
+		return nil, err
+	}
+
+	err = subRoundFactoryBn.SetAppStatusHandler(appStatusHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	subRoundFactoryBn.SetIndexer(indexer)
+
+	err = subRoundFactoryBn.SetParticipationGate(participationGate)
+	if err != nil {
+		return nil, err
+	}
+
+	return subRoundFactoryBn, nil
+}
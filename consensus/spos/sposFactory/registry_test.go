@@ -0,0 +1,218 @@
+package sposFactory_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/stretchr/testify/assert"
+)
+
+// testConsensusType is registered by the tests with dummy creators, so it is excluded from the conformance checks
+const testConsensusType = "experimental"
+
+func createConsensusType() sposFactory.ConsensusType {
+	return sposFactory.ConsensusType{
+		SignatureScheme: "bls",
+		CreateSubroundsFactory: func(
+			consensusDataContainer spos.ConsensusCoreHandler,
+			consensusState *spos.ConsensusState,
+			worker spos.WorkerHandler,
+			appStatusHandler core.AppStatusHandler,
+			indexer indexer.Indexer,
+			participationGate spos.ParticipationGate,
+		) (spos.SubroundsFactory, error) {
+			return nil, nil
+		},
+		CreateConsensusService: func() (spos.ConsensusService, error) {
+			return nil, nil
+		},
+	}
+}
+
+func createConsensusState() *spos.ConsensusState {
+	consensusGroupSize := 9
+	eligibleList := make([]string, 0)
+	for i := 0; i < consensusGroupSize; i++ {
+		eligibleList = append(eligibleList, string(rune(i+65)))
+	}
+
+	rcns := spos.NewRoundConsensus(
+		eligibleList,
+		consensusGroupSize,
+		eligibleList[1])
+	rcns.SetConsensusGroup(eligibleList)
+	rcns.ResetRoundState()
+
+	rstatus := spos.NewRoundStatus()
+	rstatus.ResetRoundStatus()
+
+	cns := spos.NewConsensusState(
+		rcns,
+		spos.NewRoundThreshold(),
+		rstatus,
+	)
+	cns.Data = []byte("X")
+
+	return cns
+}
+
+func createWorker() *mock.SposWorkerMock {
+	return &mock.SposWorkerMock{
+		GetConsensusStateChangedChannelsCalled: func() chan bool {
+			return make(chan bool)
+		},
+		RemoveAllReceivedMessagesCallsCalled: func() {},
+		AddReceivedMessageCallCalled: func(messageType consensus.MessageType, receivedMessageCall func(cnsDta *consensus.Message) bool) {
+		},
+	}
+}
+
+func TestRegisterConsensusType_InvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	err := sposFactory.RegisterConsensusType("", createConsensusType())
+	assert.Equal(t, sposFactory.ErrEmptyConsensusTypeName, err)
+
+	consensusType := createConsensusType()
+	consensusType.SignatureScheme = ""
+	err = sposFactory.RegisterConsensusType("invalid", consensusType)
+	assert.Equal(t, sposFactory.ErrEmptySignatureScheme, err)
+
+	consensusType = createConsensusType()
+	consensusType.CreateSubroundsFactory = nil
+	err = sposFactory.RegisterConsensusType("invalid", consensusType)
+	assert.Equal(t, sposFactory.ErrNilSubroundsFactoryCreator, err)
+
+	consensusType = createConsensusType()
+	consensusType.CreateConsensusService = nil
+	err = sposFactory.RegisterConsensusType("invalid", consensusType)
+	assert.Equal(t, sposFactory.ErrNilConsensusServiceCreator, err)
+
+	assert.NotContains(t, sposFactory.RegisteredConsensusTypes(), "invalid")
+}
+
+func TestRegisterConsensusType_AlreadyRegisteredShouldErr(t *testing.T) {
+	t.Parallel()
+
+	err := sposFactory.RegisterConsensusType("bls", createConsensusType())
+
+	assert.Equal(t, sposFactory.ErrConsensusTypeAlreadyRegistered, err)
+}
+
+func TestRegisterConsensusType_ShouldBeSelectableByName(t *testing.T) {
+	t.Parallel()
+
+	serviceCreated := false
+	consensusType := createConsensusType()
+	consensusType.SignatureScheme = "bn"
+	consensusType.CreateConsensusService = func() (spos.ConsensusService, error) {
+		serviceCreated = true
+		return nil, nil
+	}
+
+	err := sposFactory.RegisterConsensusType(testConsensusType, consensusType)
+	assert.Nil(t, err)
+	assert.Contains(t, sposFactory.RegisteredConsensusTypes(), testConsensusType)
+
+	_, _ = sposFactory.GetConsensusCoreFactory(testConsensusType)
+	assert.True(t, serviceCreated)
+
+	signatureScheme, err := sposFactory.GetSignatureScheme(testConsensusType)
+	assert.Nil(t, err)
+	assert.Equal(t, "bn", signatureScheme)
+}
+
+func TestGetConsensusCoreFactory_UnknownTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	service, err := sposFactory.GetConsensusCoreFactory("unknown")
+
+	assert.Nil(t, service)
+	assert.Equal(t, sposFactory.ErrInvalidConsensusType, err)
+
+	_, err = sposFactory.GetSignatureScheme("unknown")
+	assert.Equal(t, sposFactory.ErrInvalidConsensusType, err)
+}
+
+func TestRegisteredConsensusTypes_ShouldContainBuiltInTypes(t *testing.T) {
+	t.Parallel()
+
+	registered := sposFactory.RegisteredConsensusTypes()
+
+	assert.Contains(t, registered, "bls")
+	assert.Contains(t, registered, "bn")
+}
+
+// TestRegisteredConsensusTypes_Conformance runs the checks that every registered consensus type must pass
+func TestRegisteredConsensusTypes_Conformance(t *testing.T) {
+	knownSignatureSchemes := []string{"bls", "bn"}
+
+	for _, consensusType := range sposFactory.RegisteredConsensusTypes() {
+		if consensusType == testConsensusType {
+			continue
+		}
+
+		consensusType := consensusType
+		t.Run(consensusType, func(t *testing.T) {
+			signatureScheme, err := sposFactory.GetSignatureScheme(consensusType)
+			assert.Nil(t, err)
+			assert.Contains(t, knownSignatureSchemes, signatureScheme)
+
+			service, err := sposFactory.GetConsensusCoreFactory(consensusType)
+			assert.Nil(t, err)
+			if !assert.False(t, service == nil || service.IsInterfaceNil()) {
+				return
+			}
+
+			messageRange := service.GetMessageRange()
+			assert.NotEmpty(t, messageRange)
+			receivedMessages := service.InitReceivedMessages()
+			for _, messageType := range messageRange {
+				_, found := receivedMessages[messageType]
+				assert.True(t, found, "no received messages slot for %s", service.GetStringValue(messageType))
+				assert.NotEmpty(t, service.GetStringValue(messageType))
+			}
+
+			_, err = sposFactory.GetSubroundsFactory(
+				nil,
+				createConsensusState(),
+				createWorker(),
+				consensusType,
+				&mock.AppStatusHandlerMock{},
+				nil,
+				spos.NewDisabledParticipationGate(),
+			)
+			assert.NotNil(t, err, "a subrounds factory should not be created without a consensus core")
+
+			numSubrounds := 0
+			container := mock.InitConsensusCore()
+			container.SetChronology(&mock.ChronologyHandlerMock{
+				AddSubroundCalled: func(subroundHandler consensus.SubroundHandler) {
+					numSubrounds++
+				},
+			})
+			fct, err := sposFactory.GetSubroundsFactory(
+				container,
+				createConsensusState(),
+				createWorker(),
+				consensusType,
+				&mock.AppStatusHandlerMock{},
+				nil,
+				spos.NewDisabledParticipationGate(),
+			)
+			assert.Nil(t, err)
+			if !assert.False(t, fct == nil || fct.IsInterfaceNil()) {
+				return
+			}
+
+			err = fct.GenerateSubrounds()
+			assert.Nil(t, err)
+			assert.True(t, numSubrounds > 0)
+		})
+	}
+}
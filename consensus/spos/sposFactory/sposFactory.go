@@ -4,16 +4,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
-	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
-	"github.com/ElrondNetwork/elrond-go/consensus/spos/bn"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
-// GetSubroundsFactory returns a subrounds factory depending of the given parameter
+// GetSubroundsFactory returns the subrounds factory of the registered consensus type with the given name
 func GetSubroundsFactory(
 	consensusDataContainer spos.ConsensusCoreHandler,
 	consensusState *spos.ConsensusState,
@@ -21,52 +20,32 @@ func GetSubroundsFactory(
 	consensusType string,
 	appStatusHandler core.AppStatusHandler,
 	indexer indexer.Indexer,
+	participationGate spos.ParticipationGate,
 ) (spos.SubroundsFactory, error) {
 
-	switch consensusType {
-	case blsConsensusType:
-		subRoundFactoryBls, err := bls.NewSubroundsFactory(consensusDataContainer, consensusState, worker)
-		if err != nil {
-			return nil, err
-		}
-
-		err = subRoundFactoryBls.SetAppStatusHandler(appStatusHandler)
-		if err != nil {
-			return nil, err
-		}
-
-		subRoundFactoryBls.SetIndexer(indexer)
-
-		return subRoundFactoryBls, nil
-	case bnConsensusType:
-		subRoundFactoryBn, err := bn.NewSubroundsFactory(consensusDataContainer, consensusState, worker)
-		if err != nil {
-			return nil, err
-		}
-
-		err = subRoundFactoryBn.SetAppStatusHandler(appStatusHandler)
-		if err != nil {
-			return nil, err
-		}
-
-		subRoundFactoryBn.SetIndexer(indexer)
-
-		return subRoundFactoryBn, nil
+	registered, err := getConsensusType(consensusType)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, ErrInvalidConsensusType
+	return registered.CreateSubroundsFactory(
+		consensusDataContainer,
+		consensusState,
+		worker,
+		appStatusHandler,
+		indexer,
+		participationGate,
+	)
 }
 
-// GetConsensusCoreFactory returns a consensus service depending of the given parameter
+// GetConsensusCoreFactory returns the consensus service of the registered consensus type with the given name
 func GetConsensusCoreFactory(consensusType string) (spos.ConsensusService, error) {
-	switch consensusType {
-	case blsConsensusType:
-		return bls.NewConsensusService()
-	case bnConsensusType:
-		return bn.NewConsensusService()
+	registered, err := getConsensusType(consensusType)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, ErrInvalidConsensusType
+	return registered.CreateConsensusService()
 }
 
 // GetBroadcastMessenger returns a consensus service depending of the given parameter
@@ -76,14 +55,15 @@ func GetBroadcastMessenger(
 	shardCoordinator sharding.Coordinator,
 	privateKey crypto.PrivateKey,
 	singleSigner crypto.SingleSigner,
+	forkDetector process.ForkDetector,
 ) (consensus.BroadcastMessenger, error) {
 
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
-		return broadcast.NewShardChainMessenger(marshalizer, messenger, privateKey, shardCoordinator, singleSigner)
+		return broadcast.NewShardChainMessenger(marshalizer, messenger, privateKey, shardCoordinator, singleSigner, forkDetector)
 	}
 
 	if shardCoordinator.SelfId() == sharding.MetachainShardId {
-		return broadcast.NewMetaChainMessenger(marshalizer, messenger, privateKey, shardCoordinator, singleSigner)
+		return broadcast.NewMetaChainMessenger(marshalizer, messenger, privateKey, shardCoordinator, singleSigner, forkDetector)
 	}
 
 	return nil, ErrInvalidShardId
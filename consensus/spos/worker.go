@@ -1,6 +1,7 @@
 package spos
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/metrics"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/marshal"
@@ -31,9 +33,11 @@ type Worker struct {
 	shardCoordinator   sharding.Coordinator
 	singleSigner       crypto.SingleSigner
 	syncTimer          ntp.SyncTimer
+	consensusMetrics   consensus.ConsensusMetricsHandler
 
 	receivedMessages      map[consensus.MessageType][]*consensus.Message
 	receivedMessagesCalls map[consensus.MessageType]func(*consensus.Message) bool
+	futureRoundMessages   *futureRoundMessages
 
 	executeMessageChannel        chan *consensus.Message
 	consensusStateChangedChannel chan bool
@@ -88,6 +92,7 @@ func NewWorker(
 		shardCoordinator:   shardCoordinator,
 		singleSigner:       singleSigner,
 		syncTimer:          syncTimer,
+		consensusMetrics:   metrics.NewNilConsensusMetrics(),
 	}
 
 	wrk.executeMessageChannel = make(chan *consensus.Message)
@@ -95,6 +100,11 @@ func NewWorker(
 	wrk.consensusStateChangedChannel = make(chan bool, 1)
 	wrk.bootstrapper.AddSyncStateListener(wrk.receivedSyncState)
 	wrk.initReceivedMessages()
+	wrk.futureRoundMessages = newFutureRoundMessages(
+		maxFutureRoundMessages,
+		maxFutureRoundMessagesPerSender,
+		futureRoundMessagesMaxAgeInRounds*rounder.TimeDuration(),
+	)
 
 	go wrk.checkChannels()
 
@@ -155,6 +165,16 @@ func checkNewWorkerParams(
 	return nil
 }
 
+// SetConsensusMetrics will set the handler which will collect the number and the size of the consensus messages
+func (wrk *Worker) SetConsensusMetrics(consensusMetrics consensus.ConsensusMetricsHandler) error {
+	if consensusMetrics == nil || consensusMetrics.IsInterfaceNil() {
+		return ErrNilConsensusMetrics
+	}
+
+	wrk.consensusMetrics = consensusMetrics
+	return nil
+}
+
 func (wrk *Worker) receivedSyncState(isNodeSynchronized bool) {
 	if isNodeSynchronized {
 		if len(wrk.consensusStateChangedChannel) == 0 {
@@ -202,7 +222,7 @@ func (wrk *Worker) getCleanedList(cnsDataList []*consensus.Message) []*consensus
 }
 
 // ProcessReceivedMessage method redirects the received message to the channel which should handle it
-func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (wrk *Worker) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
 	if message == nil || message.IsInterfaceNil() {
 		return ErrNilMessage
 	}
@@ -235,11 +255,24 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P) error {
 		return ErrMessageForPastRound
 	}
 
+	if cnsDta.RoundIndex > wrk.rounder.Index()+1 {
+		return ErrMessageForFutureRound
+	}
+
+	err = ctx.Err()
+	if err != nil {
+		return err
+	}
+
 	sigVerifErr := wrk.checkSignature(cnsDta)
 	if sigVerifErr != nil {
 		return ErrInvalidSignature
 	}
 
+	//only the messages of the eligible validators, with a valid signature, are accounted, so that no peer can
+	//inflate the metrics
+	wrk.addMessageToMetrics(msgType, cnsDta, len(message.Data()))
+
 	if wrk.consensusService.IsMessageWithBlockHeader(msgType) {
 		headerHash := cnsDta.BlockHeaderHash
 		header := wrk.blockProcessor.DecodeBlockHeader(cnsDta.SubRoundData)
@@ -259,11 +292,28 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P) error {
 		return nil
 	}
 
+	if cnsDta.RoundIndex > wrk.consensusState.RoundIndex {
+		//the message has been received slightly early, so it is held until its round starts on this node
+		return wrk.futureRoundMessages.add(cnsDta, wrk.syncTimer.CurrentTime())
+	}
+
 	go wrk.executeReceivedMessages(cnsDta)
 
 	return nil
 }
 
+// addMessageToMetrics accounts the received message in the consensus metrics. The messages broadcast by this node
+// are also delivered back through the topic validator, so they are accounted here as sent messages
+func (wrk *Worker) addMessageToMetrics(msgType consensus.MessageType, cnsDta *consensus.Message, numBytes int) {
+	msgTypeName := wrk.consensusService.GetStringValue(msgType)
+	if wrk.consensusState.SelfPubKey() == string(cnsDta.PubKey) {
+		wrk.consensusMetrics.AddSentMessage(msgTypeName, numBytes)
+		return
+	}
+
+	wrk.consensusMetrics.AddReceivedMessage(msgTypeName, numBytes)
+}
+
 func (wrk *Worker) checkSelfState(cnsDta *consensus.Message) error {
 	if wrk.consensusState.SelfPubKey() == string(cnsDta.PubKey) {
 		return ErrMessageFromItself
@@ -317,6 +367,8 @@ func (wrk *Worker) executeReceivedMessages(cnsDta *consensus.Message) {
 }
 
 func (wrk *Worker) executeStoredMessages() {
+	wrk.moveFutureRoundMessages()
+
 	for _, i := range wrk.consensusService.GetMessageRange() {
 		cnsDataList := wrk.receivedMessages[i]
 		if len(cnsDataList) == 0 {
@@ -328,6 +380,16 @@ func (wrk *Worker) executeStoredMessages() {
 	}
 }
 
+// moveFutureRoundMessages moves the buffered messages for the current round among the received messages, so that
+// they will be executed as if they have just been received
+func (wrk *Worker) moveFutureRoundMessages() {
+	cnsDataList := wrk.futureRoundMessages.popUntilRound(wrk.consensusState.RoundIndex, wrk.syncTimer.CurrentTime())
+	for _, cnsDta := range cnsDataList {
+		msgType := consensus.MessageType(cnsDta.MsgType)
+		wrk.receivedMessages[msgType] = append(wrk.receivedMessages[msgType], cnsDta)
+	}
+}
+
 func (wrk *Worker) executeMessage(cnsDtaList []*consensus.Message) {
 	for i, cnsDta := range cnsDtaList {
 		if cnsDta == nil {
@@ -1,6 +1,7 @@
 package spos_test
 
 import (
+	"context"
 	"errors"
 	"sync/atomic"
 	"testing"
@@ -597,7 +598,7 @@ func TestWorker_ProcessReceivedMessageTxBlockBodyShouldRetNil(t *testing.T) {
 	)
 	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
 	time.Sleep(time.Second)
-	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff})
+	err := wrk.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: buff})
 
 	assert.Nil(t, err)
 }
@@ -621,7 +622,7 @@ func TestWorker_ProcessReceivedMessageHeaderShouldRetNil(t *testing.T) {
 	)
 	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
 	time.Sleep(time.Second)
-	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff})
+	err := wrk.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: buff})
 
 	assert.Nil(t, err)
 }
@@ -629,7 +630,7 @@ func TestWorker_ProcessReceivedMessageHeaderShouldRetNil(t *testing.T) {
 func TestWorker_ProcessReceivedMessageNilMessageShouldErr(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
-	err := wrk.ProcessReceivedMessage(nil)
+	err := wrk.ProcessReceivedMessage(context.Background(), nil)
 	time.Sleep(time.Second)
 
 	assert.Equal(t, 0, len(wrk.ReceivedMessages()[bn.MtBlockBody]))
@@ -639,7 +640,7 @@ func TestWorker_ProcessReceivedMessageNilMessageShouldErr(t *testing.T) {
 func TestWorker_ProcessReceivedMessageNilMessageDataFieldShouldErr(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
-	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{})
+	err := wrk.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{})
 	time.Sleep(time.Second)
 
 	assert.Equal(t, 0, len(wrk.ReceivedMessages()[bn.MtBlockBody]))
@@ -661,7 +662,7 @@ func TestWorker_ProcessReceivedMessageNodeNotInEligibleListShouldErr(t *testing.
 		0,
 	)
 	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
-	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff})
+	err := wrk.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: buff})
 	time.Sleep(time.Second)
 
 	assert.Equal(t, 0, len(wrk.ReceivedMessages()[bn.MtBlockBody]))
@@ -683,13 +684,88 @@ func TestWorker_ProcessReceivedMessageMessageIsForPastRoundShouldErr(t *testing.
 		-1,
 	)
 	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
-	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff})
+	err := wrk.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: buff})
 	time.Sleep(time.Second)
 
 	assert.Equal(t, 0, len(wrk.ReceivedMessages()[bn.MtBlockBody]))
 	assert.Equal(t, spos.ErrMessageForPastRound, err)
 }
 
+func TestWorker_ProcessReceivedMessageMessageIsForFutureRoundShouldErr(t *testing.T) {
+	t.Parallel()
+	wrk := initWorker()
+	blk := make(block.Body, 0)
+	message, _ := mock.MarshalizerMock{}.Marshal(blk)
+	cnsMsg := consensus.NewConsensusMessage(
+		message,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		[]byte("sig"),
+		int(bn.MtBlockBody),
+		uint64(wrk.Rounder().TimeStamp().Unix()),
+		2,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	err := wrk.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: buff})
+
+	assert.Equal(t, 0, len(wrk.ReceivedMessages()[bn.MtBlockBody]))
+	assert.Equal(t, 0, wrk.NumFutureRoundMessages())
+	assert.Equal(t, spos.ErrMessageForFutureRound, err)
+}
+
+func TestWorker_ProcessReceivedMessageMessageIsForNextRoundShouldBufferAndReplayWhenRoundStarts(t *testing.T) {
+	t.Parallel()
+	wrk := initWorker()
+	blk := make(block.Body, 0)
+	message, _ := mock.MarshalizerMock{}.Marshal(blk)
+	cnsMsg := consensus.NewConsensusMessage(
+		message,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		[]byte("sig"),
+		int(bn.MtBlockBody),
+		uint64(wrk.Rounder().TimeStamp().Unix()),
+		1,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	err := wrk.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: buff})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(wrk.ReceivedMessages()[bn.MtBlockBody]))
+	assert.Equal(t, 1, wrk.NumFutureRoundMessages())
+
+	wrk.ConsensusState().RoundIndex = 1
+	wrk.ExecuteStoredMessages()
+
+	assert.Equal(t, 1, len(wrk.ReceivedMessages()[bn.MtBlockBody]))
+	assert.Equal(t, 0, wrk.NumFutureRoundMessages())
+}
+
+func TestWorker_ProcessReceivedMessageTooManyNextRoundMessagesFromSenderShouldErr(t *testing.T) {
+	t.Parallel()
+	wrk := initWorker()
+	blk := make(block.Body, 0)
+	message, _ := mock.MarshalizerMock{}.Marshal(blk)
+	cnsMsg := consensus.NewConsensusMessage(
+		message,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		[]byte("sig"),
+		int(bn.MtBlockBody),
+		uint64(wrk.Rounder().TimeStamp().Unix()),
+		1,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+
+	var err error
+	for i := 0; i <= spos.MaxFutureRoundMessagesPerSender; i++ {
+		err = wrk.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: buff})
+	}
+
+	assert.Equal(t, spos.ErrTooManyFutureRoundMessagesFromSender, err)
+	assert.Equal(t, spos.MaxFutureRoundMessagesPerSender, wrk.NumFutureRoundMessages())
+}
+
 func TestWorker_ProcessReceivedMessageInvalidSignatureShouldErr(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
@@ -705,7 +781,7 @@ func TestWorker_ProcessReceivedMessageInvalidSignatureShouldErr(t *testing.T) {
 		0,
 	)
 	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
-	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff})
+	err := wrk.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: buff})
 	time.Sleep(time.Second)
 
 	assert.Equal(t, 0, len(wrk.ReceivedMessages()[bn.MtBlockBody]))
@@ -727,7 +803,7 @@ func TestWorker_ProcessReceivedMessageReceivedMessageIsFromSelfShouldRetNilAndNo
 		0,
 	)
 	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
-	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff})
+	err := wrk.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: buff})
 	time.Sleep(time.Second)
 
 	assert.Equal(t, 0, len(wrk.ReceivedMessages()[bn.MtBlockBody]))
@@ -750,7 +826,7 @@ func TestWorker_ProcessReceivedMessageWhenRoundIsCanceledShouldRetNilAndNotProce
 		0,
 	)
 	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
-	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff})
+	err := wrk.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: buff})
 	time.Sleep(time.Second)
 
 	assert.Equal(t, 0, len(wrk.ReceivedMessages()[bn.MtBlockBody]))
@@ -772,7 +848,7 @@ func TestWorker_ProcessReceivedMessageOkValsShouldWork(t *testing.T) {
 		0,
 	)
 	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
-	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff})
+	err := wrk.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: buff})
 	time.Sleep(time.Second)
 
 	assert.Equal(t, 1, len(wrk.ReceivedMessages()[bn.MtBlockHeader]))
@@ -1319,3 +1395,87 @@ func TestWorker_ExecuteStoredMessagesShouldWork(t *testing.T) {
 	rcvMsg = wrk.ReceivedMessages()
 	assert.Equal(t, 0, len(rcvMsg[msgType]))
 }
+
+func TestWorker_SetConsensusMetricsNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wrk := initWorker()
+	err := wrk.SetConsensusMetrics(nil)
+
+	assert.Equal(t, spos.ErrNilConsensusMetrics, err)
+}
+
+func TestWorker_ProcessReceivedMessageShouldAddMessageToMetrics(t *testing.T) {
+	t.Parallel()
+
+	wrk := initWorker()
+	sentBytes := 0
+	receivedBytes := 0
+	receivedTypes := make([]string, 0)
+	_ = wrk.SetConsensusMetrics(&mock.ConsensusMetricsStub{
+		AddSentMessageCalled: func(msgTypeName string, numBytes int) {
+			sentBytes += numBytes
+		},
+		AddReceivedMessageCalled: func(msgTypeName string, numBytes int) {
+			receivedBytes += numBytes
+			receivedTypes = append(receivedTypes, msgTypeName)
+		},
+	})
+
+	blk := make(block.Body, 0)
+	message, _ := mock.MarshalizerMock{}.Marshal(blk)
+	cnsMsg := consensus.NewConsensusMessage(
+		message,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		[]byte("sig"),
+		int(bn.MtBlockBody),
+		uint64(wrk.Rounder().TimeStamp().Unix()),
+		0,
+	)
+	receivedBuff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	_ = wrk.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: receivedBuff})
+
+	cnsMsg.PubKey = []byte(wrk.ConsensusState().SelfPubKey())
+	sentBuff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	_ = wrk.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: sentBuff})
+
+	assert.Equal(t, len(receivedBuff), receivedBytes)
+	assert.Equal(t, len(sentBuff), sentBytes)
+	assert.Equal(t, []string{"(BLOCK_BODY)"}, receivedTypes)
+}
+
+func TestWorker_ProcessReceivedMessageNotEligibleOrNotSignedShouldNotAddMessageToMetrics(t *testing.T) {
+	t.Parallel()
+
+	wrk := initWorker()
+	_ = wrk.SetConsensusMetrics(&mock.ConsensusMetricsStub{
+		AddSentMessageCalled: func(msgTypeName string, numBytes int) {
+			assert.Fail(t, "should have not been called")
+		},
+		AddReceivedMessageCalled: func(msgTypeName string, numBytes int) {
+			assert.Fail(t, "should have not been called")
+		},
+	})
+
+	blk := make(block.Body, 0)
+	message, _ := mock.MarshalizerMock{}.Marshal(blk)
+	cnsMsg := consensus.NewConsensusMessage(
+		message,
+		nil,
+		[]byte("X"),
+		[]byte("sig"),
+		int(bn.MtBlockBody),
+		uint64(wrk.Rounder().TimeStamp().Unix()),
+		0,
+	)
+	notEligibleBuff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	err := wrk.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: notEligibleBuff})
+	assert.Equal(t, spos.ErrSenderNotOk, err)
+
+	cnsMsg.PubKey = []byte(wrk.ConsensusState().SelfPubKey())
+	cnsMsg.Signature = nil
+	notSignedBuff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	err = wrk.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: notSignedBuff})
+	assert.Equal(t, spos.ErrInvalidSignature, err)
+}
@@ -150,9 +150,16 @@ const MetricNumShardHeadersFromPool = "erd_num_shard_headers_from_pool"
 // MetricNumShardHeadersProcessed is the metric that stores number of shard header processed
 const MetricNumShardHeadersProcessed = "erd_num_shard_headers_processed"
 
+// MetricNumStaleHeadersRejected is the metric that counts how many headers with an already committed nonce were
+// rejected by the block processor
+const MetricNumStaleHeadersRejected = "erd_num_stale_headers_rejected"
+
 // MetricNumTimesInForkChoice is the metric that counts how many time a node was in fork choice
 const MetricNumTimesInForkChoice = "erd_fork_choice_count"
 
+// MetricNumStateRecoveries is the metric that counts how many times a node rolled back its diverged state
+const MetricNumStateRecoveries = "erd_state_recoveries_count"
+
 // MaxMiniBlocksInBlock specifies the max number of mini blocks which can be added in one block
 const MaxMiniBlocksInBlock = 100
 
@@ -179,3 +186,48 @@ const MetricLeaderPercentage = "erd_metric_leader_percentage"
 
 //MetricCommunityPercentage is the metric for community rewards percentage
 const MetricCommunityPercentage = "erd_metric_community_percentage"
+
+// MetricConsensusMetricsRound is the metric that stores the round index the per round consensus metrics refer to
+const MetricConsensusMetricsRound = "erd_consensus_metrics_round"
+
+// MetricConsensusSubroundTimePrefix is the prefix of the metrics that store, for the last finished round, the time
+// in milliseconds from the round start to each subround completion. The subround name is appended to the prefix
+const MetricConsensusSubroundTimePrefix = "erd_consensus_subround_time_ms_"
+
+// MetricConsensusMessagesPrefix is the prefix of the metrics that store, for the last finished round, the number of
+// consensus messages sent and received for each message type. The message type name is appended to the prefix
+const MetricConsensusMessagesPrefix = "erd_consensus_messages_"
+
+// MetricConsensusBytesSent is the metric that stores the consensus messages bytes sent in the last finished round
+const MetricConsensusBytesSent = "erd_consensus_bytes_sent"
+
+// MetricConsensusBytesReceived is the metric that stores the consensus messages bytes received in the last finished round
+const MetricConsensusBytesReceived = "erd_consensus_bytes_received"
+
+// MetricConsensusEpochPercentilesPrefix is the prefix of the metrics that store percentiles of the per round
+// consensus metrics, aggregated over the latest rounds of the current epoch
+const MetricConsensusEpochPercentilesPrefix = "erd_consensus_epoch_"
+
+// MetricSeederConnectedPeers is the metric for monitoring the number of peers connected to a node started in
+// seeder mode
+const MetricSeederConnectedPeers = "erd_seeder_connected_peers"
+
+// MetricSeederRejectedConnections is the metric for monitoring the number of inbound connections closed by a
+// seeder because its connections limit was reached
+const MetricSeederRejectedConnections = "erd_seeder_rejected_connections"
+
+// MetricSeederPeerExchangeRequests is the metric for monitoring the number of peer exchange requests served
+// by a seeder
+const MetricSeederPeerExchangeRequests = "erd_seeder_peer_exchange_requests"
+
+// MetricSeederDialBackRequests is the metric for monitoring the number of dial back requests, used by the nodes
+// for checking their port reachability, served by a seeder
+const MetricSeederDialBackRequests = "erd_seeder_dial_back_requests"
+
+// ElrondProtectedKeyPrefix is the prefix of the accounts data trie keys that can not be written by the smart
+// contracts. They hold values managed by the protocol, as the token balances
+const ElrondProtectedKeyPrefix = "ELROND"
+
+// ElrondTokenKeyPrefix is the prefix of the accounts data trie keys holding the token balances. The token name is
+// appended to the prefix
+const ElrondTokenKeyPrefix = ElrondProtectedKeyPrefix + "esdt"
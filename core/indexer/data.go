@@ -43,6 +43,12 @@ type Block struct {
 	TxCount       uint32        `json:"txCount"`
 	StateRootHash string        `json:"stateRootHash"`
 	PrevHash      string        `json:"prevHash"`
+
+	AccumulatedFees string `json:"accumulatedFees,omitempty"`
+	Rewards         string `json:"rewards,omitempty"`
+	LeaderFees      string `json:"leaderFees,omitempty"`
+	CommunityFees   string `json:"communityFees,omitempty"`
+	BurnedFees      string `json:"burnedFees,omitempty"`
 }
 
 //ValidatorsPublicKeys is a structure containing fields for validators public keys
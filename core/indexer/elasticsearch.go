@@ -250,6 +250,70 @@ func (ei *elasticIndexer) SaveMetaBlock(header data.HeaderHandler, signersIndexe
 	go ei.saveHeader(header, signersIndexes)
 }
 
+// RevertIndexedBlock removes from elastic search the block and, if transactions are indexed, the transactions of the
+// provided header. It is called when the header is rolled back, so that the index does not diverge from the chain
+func (ei *elasticIndexer) RevertIndexedBlock(header data.HeaderHandler) {
+	if header == nil || header.IsInterfaceNil() {
+		ei.logger.Warn(ErrNoHeader.Error())
+		return
+	}
+
+	headerHash, err := core.CalculateHash(ei.marshalizer, ei.hasher, header)
+	if err != nil {
+		ei.logger.Warn("could not compute the hash of the reverted header")
+		return
+	}
+
+	ei.removeHeader(headerHash)
+
+	if ei.options.TxIndexingEnabled {
+		ei.removeTransactions(headerHash)
+	}
+}
+
+func (ei *elasticIndexer) removeHeader(headerHash []byte) {
+	req := esapi.DeleteRequest{
+		Index:        blockIndex,
+		DocumentType: "_doc",
+		DocumentID:   hex.EncodeToString(headerHash),
+		Refresh:      "true",
+	}
+
+	res, err := req.Do(context.Background(), ei.db)
+	if err != nil {
+		ei.logger.Warn(fmt.Sprintf("Could not remove the reverted block header: %s", err))
+		return
+	}
+
+	defer closeESResponseBody(res)
+
+	if res.IsError() && res.StatusCode != http.StatusNotFound {
+		ei.logger.Warn(res.String())
+	}
+}
+
+func (ei *elasticIndexer) removeTransactions(headerHash []byte) {
+	query := fmt.Sprintf(`{ "query" : { "term" : { "blockHash" : "%s" } } }`, hex.EncodeToString(headerHash))
+	refresh := true
+	req := esapi.DeleteByQueryRequest{
+		Index:   []string{txIndex},
+		Body:    strings.NewReader(query),
+		Refresh: &refresh,
+	}
+
+	res, err := req.Do(context.Background(), ei.db)
+	if err != nil {
+		ei.logger.Warn(fmt.Sprintf("Could not remove the transactions of the reverted block: %s", err))
+		return
+	}
+
+	defer closeESResponseBody(res)
+
+	if res.IsError() {
+		ei.logger.Warn(res.String())
+	}
+}
+
 // SaveRoundInfo will save data about a round on elastic search
 func (ei *elasticIndexer) SaveRoundInfo(roundInfo RoundInfo) {
 	var buff bytes.Buffer
@@ -355,6 +419,7 @@ func (ei *elasticIndexer) getSerializedElasticBlockAndHeaderHash(header data.Hea
 		StateRootHash: hex.EncodeToString(header.GetRootHash()),
 		PrevHash:      hex.EncodeToString(header.GetPrevHash()),
 	}
+	setElasticBlockFees(&elasticBlock, header)
 
 	serializedBlock, err := json.Marshal(elasticBlock)
 	if err != nil {
@@ -365,6 +430,29 @@ func (ei *elasticIndexer) getSerializedElasticBlockAndHeaderHash(header data.Hea
 	return serializedBlock, headerHash
 }
 
+// setElasticBlockFees adds the fees of a shard block and how they were split between the leader, the community
+// fund and the burn address, so that the supply changes can be followed block by block
+func setElasticBlockFees(elasticBlock *Block, header data.HeaderHandler) {
+	shardHeader, ok := header.(*block.Header)
+	if !ok {
+		return
+	}
+
+	elasticBlock.AccumulatedFees = bigIntToString(shardHeader.AccumulatedFees)
+	elasticBlock.Rewards = bigIntToString(shardHeader.Rewards)
+	elasticBlock.LeaderFees = bigIntToString(shardHeader.LeaderFees)
+	elasticBlock.CommunityFees = bigIntToString(shardHeader.CommunityFees)
+	elasticBlock.BurnedFees = bigIntToString(shardHeader.BurnedFees)
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
 func (ei *elasticIndexer) saveHeader(header data.HeaderHandler, signersIndexes []uint64) {
 	var buff bytes.Buffer
 
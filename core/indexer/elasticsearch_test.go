@@ -49,6 +49,11 @@ func newTestBlockHeader() *block.Header {
 		PeerChanges:      nil,
 		RootHash:         []byte("root hash"),
 		TxCount:          3,
+		AccumulatedFees:  big.NewInt(100),
+		Rewards:          big.NewInt(1100),
+		LeaderFees:       big.NewInt(50),
+		CommunityFees:    big.NewInt(10),
+		BurnedFees:       big.NewInt(40),
 	}
 }
 
@@ -288,6 +293,12 @@ func TestElasticIndexer_getSerializedElasticBlockAndHeaderHash(t *testing.T) {
 		TxCount:       header.TxCount,
 		StateRootHash: hex.EncodeToString(header.RootHash),
 		PrevHash:      hex.EncodeToString(header.PrevHash),
+
+		AccumulatedFees: "100",
+		Rewards:         "1100",
+		LeaderFees:      "50",
+		CommunityFees:   "10",
+		BurnedFees:      "40",
 	}
 	expectedSerializedBlock, _ := json.Marshal(elasticBlock)
 	assert.Equal(t, expectedSerializedBlock, serializedBlock)
@@ -461,3 +472,64 @@ func TestElasticIndexer_SerializeShardInfo(t *testing.T) {
 		assert.NotNil(t, meta)
 	}
 }
+
+func TestElasticIndexer_RevertIndexedBlockShouldRemoveTheBlockAndItsTransactions(t *testing.T) {
+	header := newTestBlockHeader()
+	headerHash, _ := core.CalculateHash(marshalizer, hasher, header)
+
+	deletedBlocks := 0
+	deletedTxsQuery := ""
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" && r.URL.Path == "/blocks/_doc/"+hex.EncodeToString(headerHash) {
+			deletedBlocks++
+		}
+		if r.Method == "POST" && r.URL.Path == "/transactions/_delete_by_query" {
+			buff := new(bytes.Buffer)
+			_, _ = buff.ReadFrom(r.Body)
+			deletedTxsQuery = buff.String()
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	ei := indexer.NewTestElasticIndexer(ts.URL, username, password, shardCoordinator, marshalizer, hasher, log, &indexer.Options{TxIndexingEnabled: true})
+	ei.RevertIndexedBlock(header)
+
+	assert.Equal(t, 1, deletedBlocks)
+	assert.Contains(t, deletedTxsQuery, hex.EncodeToString(headerHash))
+}
+
+func TestElasticIndexer_RevertIndexedBlockTxIndexingDisabledShouldRemoveOnlyTheBlock(t *testing.T) {
+	deletedBlocks := 0
+	deletedTxs := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			deletedBlocks++
+		}
+		if r.URL.Path == "/transactions/_delete_by_query" {
+			deletedTxs++
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	ei := indexer.NewTestElasticIndexer(ts.URL, username, password, shardCoordinator, marshalizer, hasher, log, &indexer.Options{})
+	ei.RevertIndexedBlock(newTestMetaBlock())
+
+	assert.Equal(t, 1, deletedBlocks)
+	assert.Equal(t, 0, deletedTxs)
+}
+
+func TestElasticIndexer_RevertIndexedBlockNilHeaderShouldNotCallElasticSearch(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	ei := indexer.NewTestElasticIndexer(ts.URL, username, password, shardCoordinator, marshalizer, hasher, log, &indexer.Options{TxIndexingEnabled: true})
+	ei.RevertIndexedBlock(nil)
+
+	assert.Equal(t, 0, requests)
+}
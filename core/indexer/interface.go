@@ -10,6 +10,7 @@ import (
 type Indexer interface {
 	SaveBlock(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler, signersIndexes []uint64)
 	SaveMetaBlock(header data.HeaderHandler, signersIndexes []uint64)
+	RevertIndexedBlock(header data.HeaderHandler)
 	SaveRoundInfo(roundInfo RoundInfo)
 	UpdateTPS(tpsBenchmark statistics.TPSBenchmark)
 	SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte)
@@ -23,6 +23,11 @@ func (im *NilIndexer) SaveMetaBlock(header data.HeaderHandler, signersIndexes []
 	return
 }
 
+// RevertIndexedBlock will do nothing
+func (ni *NilIndexer) RevertIndexedBlock(header data.HeaderHandler) {
+	return
+}
+
 // SaveRoundInfo will do nothing
 func (ni *NilIndexer) SaveRoundInfo(info RoundInfo) {
 	return
@@ -4,3 +4,15 @@ import "errors"
 
 // ErrNilFile signals that the provided file is nil
 var ErrNilFile = errors.New("can not use nil file")
+
+// ErrInvalidMaxRounds signals that an invalid maximum number of rounds has been provided
+var ErrInvalidMaxRounds = errors.New("invalid maximum number of rounds")
+
+// ErrInvalidMaxEntriesPerRound signals that an invalid maximum number of log entries per round has been provided
+var ErrInvalidMaxEntriesPerRound = errors.New("invalid maximum number of log entries per round")
+
+// ErrRoundLogBundlesNotEnabled signals that the round log bundles have not been enabled on the logger
+var ErrRoundLogBundlesNotEnabled = errors.New("round log bundles are not enabled")
+
+// ErrRoundLogsNotFound signals that no log entries have been collected for the requested round
+var ErrRoundLogsNotFound = errors.New("no log entries collected for the requested round")
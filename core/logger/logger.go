@@ -28,6 +28,7 @@ const (
 	maxHeadlineLength      = 100
 	fileLifetimeInSeconds  = 3600
 	nrOfFilesToRemember    = 24
+	defaultLogsFolder      = "logs"
 )
 
 // Logger represents the application logger.
@@ -38,6 +39,8 @@ type Logger struct {
 	roll            bool
 	rollLock        sync.Mutex
 	stackTraceDepth int
+	logsFolder      string
+	roundLogs       *roundLogsHook
 }
 
 // Option represents a functional configuration parameter that can operate
@@ -51,6 +54,7 @@ func NewElrondLogger(opts ...Option) *Logger {
 	el := &Logger{
 		logger:          log.New(),
 		stackTraceDepth: defaultStackTraceDepth,
+		logsFolder:      defaultLogsFolder,
 		file:            &LogFileWriter{creationTime: time.Now()},
 	}
 
@@ -254,11 +258,59 @@ func (el *Logger) Headline(message string, timestamp string, delimiter string) s
 
 func (el *Logger) defaultFields() *log.Entry {
 	_, file, line, ok := runtime.Caller(el.stackTraceDepth)
-	return el.logger.WithFields(log.Fields{
+	fields := log.Fields{
 		"file":        file,
 		"line_number": line,
 		"caller_ok":   ok,
-	})
+	}
+	for key, value := range currentRoundContext.fields() {
+		fields[key] = value
+	}
+
+	return el.logger.WithFields(fields)
+}
+
+// DumpRoundLogs writes the log entries collected while the node was processing the provided round
+func (el *Logger) DumpRoundLogs(round int64, w io.Writer) error {
+	if el.roundLogs == nil {
+		return ErrRoundLogBundlesNotEnabled
+	}
+	if w == nil {
+		return errors.New("nil io writer parameter")
+	}
+
+	return el.roundLogs.dump(round, w)
+}
+
+// DumpRoundLogsToFile writes the log entries collected while the node was processing the provided round in a new
+// file, created in the logs folder, and returns the file's name
+func (el *Logger) DumpRoundLogsToFile(round int64) (string, error) {
+	if el.roundLogs == nil {
+		return "", ErrRoundLogBundlesNotEnabled
+	}
+
+	prefix := fmt.Sprintf("round_%d", round)
+	if el.file.prefix != "" {
+		prefix = el.file.prefix + "_" + prefix
+	}
+
+	file, err := newFile(prefix, el.logsFolder, "log")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		errClose := file.Close()
+		if errClose != nil {
+			el.Debug("error closing the round logs file: " + errClose.Error())
+		}
+	}()
+
+	err = el.roundLogs.dump(round, file)
+	if err != nil {
+		return "", err
+	}
+
+	return file.Name(), nil
 }
 
 // WithFile sets up the file option for the Logger
@@ -279,6 +331,7 @@ func WithFileRotation(prefix string, subfolder string, fileExtension string) Opt
 
 		el.roll = true
 		el.file.prefix = prefix
+		el.logsFolder = subfolder
 		el.logFiles = append(el.logFiles, file)
 		el.file.SetWriter(file)
 
@@ -303,6 +356,22 @@ func WithStderrRedirect() Option {
 	}
 }
 
+// WithRoundLogBundles sets up the option to keep in memory, for the most recent maxRounds rounds, at most
+// maxEntriesPerRound of the log entries tagged with the round context, so that they can be dumped on failure
+func WithRoundLogBundles(maxRounds int, maxEntriesPerRound int) Option {
+	return func(el *Logger) error {
+		hook, err := newRoundLogsHook(maxRounds, maxEntriesPerRound)
+		if err != nil {
+			return err
+		}
+
+		el.roundLogs = hook
+		el.logger.AddHook(hook)
+
+		return nil
+	}
+}
+
 // WithStackTraceDepth sets up the stackTraceDepth option for the Logger
 func WithStackTraceDepth(depth int) Option {
 	return func(el *Logger) error {
@@ -0,0 +1,71 @@
+package logger
+
+import (
+	"encoding/hex"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	roundField     = "round"
+	blockHashField = "block_hash"
+)
+
+// roundContext holds the round currently processed by the node and the hash of the block proposed in that round.
+// All the log entries emitted while a round context is set are tagged with these values, so that the logs of a
+// round can be correlated without the need of passing the round information to every logging call
+type roundContext struct {
+	mutContext sync.RWMutex
+	isSet      bool
+	round      int64
+	blockHash  string
+}
+
+var currentRoundContext = &roundContext{}
+
+// SetRoundContext sets the round that the following log entries will be tagged with. The block hash tag is reset
+// as a new round has started
+func SetRoundContext(round int64) {
+	currentRoundContext.mutContext.Lock()
+	currentRoundContext.isSet = true
+	currentRoundContext.round = round
+	currentRoundContext.blockHash = ""
+	currentRoundContext.mutContext.Unlock()
+}
+
+// SetRoundBlockHash sets the block hash that the following log entries, emitted in the current round, will be
+// tagged with
+func SetRoundBlockHash(blockHash []byte) {
+	currentRoundContext.mutContext.Lock()
+	currentRoundContext.blockHash = hex.EncodeToString(blockHash)
+	currentRoundContext.mutContext.Unlock()
+}
+
+// ClearRoundContext removes the round and block hash tags from the following log entries
+func ClearRoundContext() {
+	currentRoundContext.mutContext.Lock()
+	currentRoundContext.isSet = false
+	currentRoundContext.round = 0
+	currentRoundContext.blockHash = ""
+	currentRoundContext.mutContext.Unlock()
+}
+
+// fields returns the tags of the current round context or nil if no round context is set
+func (rc *roundContext) fields() log.Fields {
+	rc.mutContext.RLock()
+	defer rc.mutContext.RUnlock()
+
+	if !rc.isSet {
+		return nil
+	}
+
+	fields := log.Fields{
+		roundField: rc.round,
+	}
+	if len(rc.blockHash) > 0 {
+		fields[blockHashField] = rc.blockHash
+	}
+
+	return fields
+}
@@ -0,0 +1,140 @@
+package logger_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+//the tests in this file are not run in parallel as the round context is shared by all the loggers
+
+func TestRoundContext_EntriesShouldBeTagged(t *testing.T) {
+	defer logger.ClearRoundContext()
+
+	var str bytes.Buffer
+	log := logger.NewElrondLogger()
+	log.SetOutput(&str)
+
+	logger.SetRoundContext(7)
+	logger.SetRoundBlockHash([]byte{0xAB, 0xCD})
+	log.Info("abc")
+
+	logString := str.String()
+	assert.True(t, strings.Contains(logString, `"round":7`))
+	assert.True(t, strings.Contains(logString, `"block_hash":"abcd"`))
+}
+
+func TestRoundContext_NewRoundShouldResetBlockHash(t *testing.T) {
+	defer logger.ClearRoundContext()
+
+	var str bytes.Buffer
+	log := logger.NewElrondLogger()
+	log.SetOutput(&str)
+
+	logger.SetRoundContext(7)
+	logger.SetRoundBlockHash([]byte{0xAB, 0xCD})
+	logger.SetRoundContext(8)
+	log.Info("abc")
+
+	logString := str.String()
+	assert.True(t, strings.Contains(logString, `"round":8`))
+	assert.False(t, strings.Contains(logString, `"block_hash"`))
+}
+
+func TestRoundContext_ClearedContextShouldNotTagEntries(t *testing.T) {
+	var str bytes.Buffer
+	log := logger.NewElrondLogger()
+	log.SetOutput(&str)
+
+	logger.SetRoundContext(7)
+	logger.ClearRoundContext()
+	log.Info("abc")
+
+	logString := str.String()
+	assert.False(t, strings.Contains(logString, `"round"`))
+}
+
+func TestWithRoundLogBundles_InvalidValuesShouldErr(t *testing.T) {
+	log := logger.NewElrondLogger()
+
+	err := log.ApplyOptions(logger.WithRoundLogBundles(0, 10))
+	assert.NotNil(t, err)
+
+	err = log.ApplyOptions(logger.WithRoundLogBundles(10, 0))
+	assert.NotNil(t, err)
+}
+
+func TestDumpRoundLogs_NotEnabledShouldErr(t *testing.T) {
+	log := logger.NewElrondLogger()
+
+	err := log.DumpRoundLogs(1, &bytes.Buffer{})
+	assert.Equal(t, logger.ErrRoundLogBundlesNotEnabled, err)
+
+	_, err = log.DumpRoundLogsToFile(1)
+	assert.Equal(t, logger.ErrRoundLogBundlesNotEnabled, err)
+}
+
+func TestDumpRoundLogs_ShouldDumpOnlyTheRequestedRound(t *testing.T) {
+	defer logger.ClearRoundContext()
+
+	log := logger.NewElrondLogger()
+	log.SetOutput(&bytes.Buffer{})
+	_ = log.ApplyOptions(logger.WithRoundLogBundles(10, 10))
+
+	logger.SetRoundContext(1)
+	log.Debug("message in round 1")
+	logger.SetRoundContext(2)
+	logger.SetRoundBlockHash([]byte{0x01})
+	log.Debug("message in round 2")
+	logger.ClearRoundContext()
+	log.Debug("message without round")
+
+	var dump bytes.Buffer
+	err := log.DumpRoundLogs(2, &dump)
+
+	assert.Nil(t, err)
+	assert.True(t, strings.Contains(dump.String(), "message in round 2"))
+	assert.True(t, strings.Contains(dump.String(), "block_hash=01"))
+	assert.False(t, strings.Contains(dump.String(), "message in round 1"))
+	assert.False(t, strings.Contains(dump.String(), "message without round"))
+}
+
+func TestDumpRoundLogs_OldRoundsShouldBeEvicted(t *testing.T) {
+	defer logger.ClearRoundContext()
+
+	log := logger.NewElrondLogger()
+	log.SetOutput(&bytes.Buffer{})
+	_ = log.ApplyOptions(logger.WithRoundLogBundles(2, 10))
+
+	for round := int64(1); round <= 3; round++ {
+		logger.SetRoundContext(round)
+		log.Debug("message")
+	}
+
+	err := log.DumpRoundLogs(1, &bytes.Buffer{})
+	assert.Equal(t, logger.ErrRoundLogsNotFound, err)
+
+	err = log.DumpRoundLogs(3, &bytes.Buffer{})
+	assert.Nil(t, err)
+}
+
+func TestDumpRoundLogs_EntriesPerRoundShouldBeBounded(t *testing.T) {
+	defer logger.ClearRoundContext()
+
+	log := logger.NewElrondLogger()
+	log.SetOutput(&bytes.Buffer{})
+	_ = log.ApplyOptions(logger.WithRoundLogBundles(2, 2))
+
+	logger.SetRoundContext(1)
+	for i := 0; i < 5; i++ {
+		log.Debug("message")
+	}
+
+	var dump bytes.Buffer
+	_ = log.DumpRoundLogs(1, &dump)
+
+	assert.Equal(t, 2, strings.Count(dump.String(), "message"))
+}
@@ -0,0 +1,114 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const roundLogsTimeFormat = "2006-01-02 15:04:05.000"
+
+// roundLogsHook is a logrus hook that keeps in memory, grouped by round, the log entries tagged with a round
+// context. Only the most recent rounds are kept and the number of entries kept for each round is bounded, so the
+// bundle of a failed round can be dumped after the failure is detected
+type roundLogsHook struct {
+	mutBundles         sync.Mutex
+	bundles            map[int64][]string
+	rounds             []int64
+	maxRounds          int
+	maxEntriesPerRound int
+}
+
+func newRoundLogsHook(maxRounds int, maxEntriesPerRound int) (*roundLogsHook, error) {
+	if maxRounds <= 0 {
+		return nil, ErrInvalidMaxRounds
+	}
+	if maxEntriesPerRound <= 0 {
+		return nil, ErrInvalidMaxEntriesPerRound
+	}
+
+	return &roundLogsHook{
+		bundles:            make(map[int64][]string),
+		rounds:             make([]int64, 0, maxRounds),
+		maxRounds:          maxRounds,
+		maxEntriesPerRound: maxEntriesPerRound,
+	}, nil
+}
+
+// Levels returns the array of levels for which the hook will be applicable
+func (h *roundLogsHook) Levels() []log.Level {
+	return log.AllLevels
+}
+
+// Fire collects the log entry in its round's bundle
+func (h *roundLogsHook) Fire(entry *log.Entry) error {
+	round, ok := entry.Data[roundField].(int64)
+	if !ok {
+		return nil
+	}
+
+	line := fmt.Sprintf("%s %s [%v:%v] %s",
+		entry.Time.Format(roundLogsTimeFormat),
+		entry.Level.String(),
+		entry.Data["file"],
+		entry.Data["line_number"],
+		entry.Message,
+	)
+	blockHash, ok := entry.Data[blockHashField]
+	if ok {
+		line += fmt.Sprintf(" block_hash=%v", blockHash)
+	}
+	extra, ok := entry.Data["extra"].([]interface{})
+	if ok && len(extra) > 0 {
+		line += fmt.Sprintf(" extra=%v", extra)
+	}
+
+	h.mutBundles.Lock()
+	defer h.mutBundles.Unlock()
+
+	bundle, ok := h.bundles[round]
+	if !ok {
+		h.addRound(round)
+	}
+	if len(bundle) >= h.maxEntriesPerRound {
+		return nil
+	}
+	h.bundles[round] = append(bundle, line)
+
+	return nil
+}
+
+func (h *roundLogsHook) addRound(round int64) {
+	if len(h.rounds) >= h.maxRounds {
+		oldestRound := h.rounds[0]
+		h.rounds = h.rounds[1:]
+		delete(h.bundles, oldestRound)
+	}
+
+	h.rounds = append(h.rounds, round)
+	h.bundles[round] = make([]string, 0)
+}
+
+// dump writes the collected log entries of the provided round
+func (h *roundLogsHook) dump(round int64, w io.Writer) error {
+	h.mutBundles.Lock()
+	bundle, ok := h.bundles[round]
+	lines := make([]string, len(bundle))
+	copy(lines, bundle)
+	h.mutBundles.Unlock()
+
+	if !ok {
+		return ErrRoundLogsNotFound
+	}
+
+	for _, line := range lines {
+		_, err := io.WriteString(w, line+"\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package serviceContainer
 import (
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 )
 
 // Core interface will abstract all the subpackage functionalities and will
@@ -10,5 +11,7 @@ import (
 type Core interface {
 	Indexer() indexer.Indexer
 	TPSBenchmark() statistics.TPSBenchmark
+	GasPriceStats() statistics.GasPriceStatsHandler
+	AccountsNotifier() subscription.AccountsNotifierHandler
 	IsInterfaceNil() bool
 }
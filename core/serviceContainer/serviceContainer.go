@@ -3,11 +3,14 @@ package serviceContainer
 import (
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 )
 
 type serviceContainer struct {
-	indexer      indexer.Indexer
-	tpsBenchmark statistics.TPSBenchmark
+	indexer          indexer.Indexer
+	tpsBenchmark     statistics.TPSBenchmark
+	gasPriceStats    statistics.GasPriceStatsHandler
+	accountsNotifier subscription.AccountsNotifierHandler
 }
 
 // Option represents a functional configuration parameter that
@@ -37,6 +40,16 @@ func (sc *serviceContainer) TPSBenchmark() statistics.TPSBenchmark {
 	return sc.tpsBenchmark
 }
 
+// GasPriceStats returns the core package's gas price statistics tracker
+func (sc *serviceContainer) GasPriceStats() statistics.GasPriceStatsHandler {
+	return sc.gasPriceStats
+}
+
+// AccountsNotifier returns the core package's watched accounts changes notifier
+func (sc *serviceContainer) AccountsNotifier() subscription.AccountsNotifierHandler {
+	return sc.accountsNotifier
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sc *serviceContainer) IsInterfaceNil() bool {
 	if sc == nil {
@@ -60,3 +73,19 @@ func WithTPSBenchmark(tpsBenchmark statistics.TPSBenchmark) Option {
 		return nil
 	}
 }
+
+// WithGasPriceStats sets up the gas price statistics tracker for the core serviceContainer
+func WithGasPriceStats(gasPriceStats statistics.GasPriceStatsHandler) Option {
+	return func(sc *serviceContainer) error {
+		sc.gasPriceStats = gasPriceStats
+		return nil
+	}
+}
+
+// WithAccountsNotifier sets up the watched accounts changes notifier for the core serviceContainer
+func WithAccountsNotifier(accountsNotifier subscription.AccountsNotifierHandler) Option {
+	return func(sc *serviceContainer) error {
+		sc.accountsNotifier = accountsNotifier
+		return nil
+	}
+}
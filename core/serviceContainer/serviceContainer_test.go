@@ -4,8 +4,10 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 
 	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -54,3 +56,28 @@ func TestServiceContainer_NewServiceContainerWithNilTPSBenchmark(t *testing.T) {
 	assert.NotNil(t, sc)
 	assert.Nil(t, sc.TPSBenchmark())
 }
+
+func TestServiceContainer_NewServiceContainerWithGasPriceStats(t *testing.T) {
+	gasPriceStats, _ := statistics.NewGasPriceStats(10)
+
+	sc, err := serviceContainer.NewServiceContainer(serviceContainer.WithGasPriceStats(gasPriceStats))
+	assert.Nil(t, err)
+	assert.NotNil(t, sc)
+	assert.Equal(t, gasPriceStats, sc.GasPriceStats())
+}
+
+func TestServiceContainer_NewServiceContainerWithAccountsNotifier(t *testing.T) {
+	accountsNotifier, _ := subscription.NewAccountsNotifier(
+		&mock.AccountsStub{},
+		&mock.AddressConverterStub{},
+		mock.NewOneShardCoordinatorMock(),
+		1,
+		1,
+		1,
+	)
+
+	sc, err := serviceContainer.NewServiceContainer(serviceContainer.WithAccountsNotifier(accountsNotifier))
+	assert.Nil(t, err)
+	assert.NotNil(t, sc)
+	assert.Equal(t, accountsNotifier, sc.AccountsNotifier())
+}
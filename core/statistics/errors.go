@@ -12,3 +12,6 @@ var ErrInvalidRoundDuration = errors.New("invalid round duration")
 
 // ErrNilFileToWriteStats signals that the file where statistics should be written is nil
 var ErrNilFileToWriteStats = errors.New("nil file to write statistics")
+
+// ErrInvalidNumBlocks signals that an invalid number of blocks was provided
+var ErrInvalidNumBlocks = errors.New("invalid number of blocks")
@@ -0,0 +1,135 @@
+package statistics
+
+import (
+	"sort"
+	"sync"
+)
+
+// GasPriceStat holds the gas price percentiles of the transactions, sent from one shard, that were included in the
+// last tracked blocks
+type GasPriceStat struct {
+	ShardID   uint32 `json:"shardID"`
+	NumBlocks uint32 `json:"numBlocks"`
+	NumTxs    uint32 `json:"numTxs"`
+	Min       uint64 `json:"min"`
+	P25       uint64 `json:"p25"`
+	Median    uint64 `json:"median"`
+	P75       uint64 `json:"p75"`
+	P90       uint64 `json:"p90"`
+	Max       uint64 `json:"max"`
+}
+
+// GasPriceStats tracks, for each sender shard, the gas prices of the transactions included in the last committed
+// blocks. The oldest block is evicted when a new block is added for a shard that already has the maximum number
+// of tracked blocks
+type GasPriceStats struct {
+	mut       sync.RWMutex
+	numBlocks uint32
+	blocks    map[uint32][][]uint64
+}
+
+// NewGasPriceStats creates a new GasPriceStats instance tracking, for each shard, the provided number of blocks
+func NewGasPriceStats(numBlocks uint32) (*GasPriceStats, error) {
+	if numBlocks == 0 {
+		return nil, ErrInvalidNumBlocks
+	}
+
+	return &GasPriceStats{
+		numBlocks: numBlocks,
+		blocks:    make(map[uint32][][]uint64),
+	}, nil
+}
+
+// AddBlock records the gas prices of the transactions from the provided sender shard that were included in a
+// committed block
+func (gps *GasPriceStats) AddBlock(shardId uint32, gasPrices []uint64) {
+	prices := make([]uint64, len(gasPrices))
+	copy(prices, gasPrices)
+
+	gps.mut.Lock()
+	defer gps.mut.Unlock()
+
+	shardBlocks := append(gps.blocks[shardId], prices)
+	if uint32(len(shardBlocks)) > gps.numBlocks {
+		shardBlocks = shardBlocks[uint32(len(shardBlocks))-gps.numBlocks:]
+	}
+	gps.blocks[shardId] = shardBlocks
+}
+
+// ShardGasPriceStat returns the gas price percentiles for the provided sender shard. The second returned value is
+// false if no block was recorded for that shard
+func (gps *GasPriceStats) ShardGasPriceStat(shardId uint32) (GasPriceStat, bool) {
+	gps.mut.RLock()
+	defer gps.mut.RUnlock()
+
+	shardBlocks, ok := gps.blocks[shardId]
+	if !ok {
+		return GasPriceStat{}, false
+	}
+
+	return computeGasPriceStat(shardId, shardBlocks), true
+}
+
+// GasPriceStats returns the gas price percentiles for all the shards that had blocks recorded, sorted by shard id
+func (gps *GasPriceStats) GasPriceStats() []GasPriceStat {
+	gps.mut.RLock()
+	defer gps.mut.RUnlock()
+
+	stats := make([]GasPriceStat, 0, len(gps.blocks))
+	for shardId, shardBlocks := range gps.blocks {
+		stats = append(stats, computeGasPriceStat(shardId, shardBlocks))
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].ShardID < stats[j].ShardID
+	})
+
+	return stats
+}
+
+func computeGasPriceStat(shardId uint32, shardBlocks [][]uint64) GasPriceStat {
+	prices := make([]uint64, 0)
+	for _, blockPrices := range shardBlocks {
+		prices = append(prices, blockPrices...)
+	}
+
+	stat := GasPriceStat{
+		ShardID:   shardId,
+		NumBlocks: uint32(len(shardBlocks)),
+		NumTxs:    uint32(len(prices)),
+	}
+	if len(prices) == 0 {
+		return stat
+	}
+
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i] < prices[j]
+	})
+
+	stat.Min = prices[0]
+	stat.P25 = percentile(prices, 25)
+	stat.Median = percentile(prices, 50)
+	stat.P75 = percentile(prices, 75)
+	stat.P90 = percentile(prices, 90)
+	stat.Max = prices[len(prices)-1]
+
+	return stat
+}
+
+// percentile returns the nearest rank percentile of the provided sorted, non empty, values
+func percentile(sortedValues []uint64, percent int) uint64 {
+	rank := (percent*len(sortedValues) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sortedValues[rank-1]
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gps *GasPriceStats) IsInterfaceNil() bool {
+	if gps == nil {
+		return true
+	}
+	return false
+}
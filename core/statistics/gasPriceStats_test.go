@@ -0,0 +1,143 @@
+package statistics_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGasPriceStats_ZeroNumBlocksShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gps, err := statistics.NewGasPriceStats(0)
+
+	assert.Nil(t, gps)
+	assert.Equal(t, statistics.ErrInvalidNumBlocks, err)
+}
+
+func TestNewGasPriceStats_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	gps, err := statistics.NewGasPriceStats(10)
+
+	assert.Nil(t, err)
+	assert.False(t, gps.IsInterfaceNil())
+	assert.Equal(t, 0, len(gps.GasPriceStats()))
+}
+
+func TestGasPriceStats_ShardGasPriceStatUnknownShardShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	gps, _ := statistics.NewGasPriceStats(10)
+	gps.AddBlock(0, []uint64{10})
+
+	_, ok := gps.ShardGasPriceStat(1)
+
+	assert.False(t, ok)
+}
+
+func TestGasPriceStats_ShardGasPriceStatShouldComputePercentiles(t *testing.T) {
+	t.Parallel()
+
+	gps, _ := statistics.NewGasPriceStats(10)
+	gps.AddBlock(0, []uint64{100, 10, 90, 20, 80})
+	gps.AddBlock(0, []uint64{30, 70, 40, 60, 50})
+
+	stat, ok := gps.ShardGasPriceStat(0)
+
+	assert.True(t, ok)
+	assert.Equal(t, uint32(0), stat.ShardID)
+	assert.Equal(t, uint32(2), stat.NumBlocks)
+	assert.Equal(t, uint32(10), stat.NumTxs)
+	assert.Equal(t, uint64(10), stat.Min)
+	assert.Equal(t, uint64(30), stat.P25)
+	assert.Equal(t, uint64(50), stat.Median)
+	assert.Equal(t, uint64(80), stat.P75)
+	assert.Equal(t, uint64(90), stat.P90)
+	assert.Equal(t, uint64(100), stat.Max)
+}
+
+func TestGasPriceStats_EmptyBlocksShouldBeCountedWithoutPrices(t *testing.T) {
+	t.Parallel()
+
+	gps, _ := statistics.NewGasPriceStats(10)
+	gps.AddBlock(0, nil)
+	gps.AddBlock(0, make([]uint64, 0))
+
+	stat, ok := gps.ShardGasPriceStat(0)
+
+	assert.True(t, ok)
+	assert.Equal(t, uint32(2), stat.NumBlocks)
+	assert.Equal(t, uint32(0), stat.NumTxs)
+	assert.Equal(t, uint64(0), stat.Max)
+}
+
+func TestGasPriceStats_OldBlocksShouldBeEvicted(t *testing.T) {
+	t.Parallel()
+
+	gps, _ := statistics.NewGasPriceStats(2)
+	gps.AddBlock(0, []uint64{1000})
+	gps.AddBlock(0, []uint64{10})
+	gps.AddBlock(0, []uint64{20})
+
+	stat, _ := gps.ShardGasPriceStat(0)
+
+	assert.Equal(t, uint32(2), stat.NumBlocks)
+	assert.Equal(t, uint64(10), stat.Min)
+	assert.Equal(t, uint64(20), stat.Max)
+}
+
+func TestGasPriceStats_AddedPricesShouldBeCopied(t *testing.T) {
+	t.Parallel()
+
+	gps, _ := statistics.NewGasPriceStats(2)
+	prices := []uint64{10}
+	gps.AddBlock(0, prices)
+	prices[0] = 20
+
+	stat, _ := gps.ShardGasPriceStat(0)
+
+	assert.Equal(t, uint64(10), stat.Max)
+}
+
+func TestGasPriceStats_GasPriceStatsShouldBeSortedByShard(t *testing.T) {
+	t.Parallel()
+
+	gps, _ := statistics.NewGasPriceStats(2)
+	gps.AddBlock(2, []uint64{30})
+	gps.AddBlock(0, []uint64{10})
+	gps.AddBlock(1, []uint64{20})
+
+	stats := gps.GasPriceStats()
+
+	assert.Equal(t, 3, len(stats))
+	for i, stat := range stats {
+		assert.Equal(t, uint32(i), stat.ShardID)
+		assert.Equal(t, uint64(10*(i+1)), stat.Median)
+	}
+}
+
+func TestGasPriceStats_ConcurrentAccessShouldWork(t *testing.T) {
+	t.Parallel()
+
+	gps, _ := statistics.NewGasPriceStats(5)
+
+	wg := sync.WaitGroup{}
+	wg.Add(20)
+	for i := 0; i < 10; i++ {
+		go func(idx int) {
+			gps.AddBlock(uint32(idx%2), []uint64{uint64(idx)})
+			wg.Done()
+		}(i)
+		go func() {
+			_ = gps.GasPriceStats()
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+
+	stat, _ := gps.ShardGasPriceStat(0)
+	assert.Equal(t, uint32(5), stat.NumBlocks)
+}
@@ -24,6 +24,15 @@ type TPSBenchmark interface {
 	IsInterfaceNil() bool
 }
 
+// GasPriceStatsHandler is an interface used to track the gas prices of the transactions included in the
+// committed blocks
+type GasPriceStatsHandler interface {
+	AddBlock(shardId uint32, gasPrices []uint64)
+	ShardGasPriceStat(shardId uint32) (GasPriceStat, bool)
+	GasPriceStats() []GasPriceStat
+	IsInterfaceNil() bool
+}
+
 // ShardStatistic is an interface used to calculate statistics for the network activity of a specific shard
 type ShardStatistic interface {
 	ShardID() uint32
@@ -0,0 +1,278 @@
+package subscription
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.DefaultLogger()
+
+// AccountChange holds the nonce and the balance of a watched account, as they are after the committed block
+type AccountChange struct {
+	Address    string `json:"address"`
+	Nonce      uint64 `json:"nonce"`
+	Balance    string `json:"balance"`
+	BlockNonce uint64 `json:"blockNonce"`
+	BlockHash  string `json:"blockHash"`
+}
+
+type subscriber struct {
+	addresses []string
+	changes   chan *AccountChange
+	isNew     bool
+}
+
+type watchedAccount struct {
+	address        state.AddressContainer
+	nonce          uint64
+	balance        *big.Int
+	isKnown        bool
+	numSubscribers int
+}
+
+// AccountsNotifier notifies the subscribers each time a committed block changes the nonce or the balance of one
+// of their watched accounts. A new subscriber receives the state of all its watched accounts after the first block
+// committed since it subscribed. A subscriber that does not consume its notifications fast enough is dropped and
+// its channel is closed
+type AccountsNotifier struct {
+	accounts         state.AccountsAdapter
+	addrConverter    state.AddressConverter
+	shardCoordinator sharding.Coordinator
+
+	maxSubscribers            uint32
+	maxAddressesPerSubscriber uint32
+	bufferSize                uint32
+
+	mut              sync.Mutex
+	lastSubscriberId uint64
+	subscribers      map[uint64]*subscriber
+	watched          map[string]*watchedAccount
+}
+
+// NewAccountsNotifier creates a new AccountsNotifier instance
+func NewAccountsNotifier(
+	accounts state.AccountsAdapter,
+	addrConverter state.AddressConverter,
+	shardCoordinator sharding.Coordinator,
+	maxSubscribers uint32,
+	maxAddressesPerSubscriber uint32,
+	bufferSize uint32,
+) (*AccountsNotifier, error) {
+	if accounts == nil || accounts.IsInterfaceNil() {
+		return nil, ErrNilAccountsAdapter
+	}
+	if addrConverter == nil || addrConverter.IsInterfaceNil() {
+		return nil, ErrNilAddressConverter
+	}
+	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+	if maxSubscribers == 0 {
+		return nil, ErrInvalidMaxSubscribers
+	}
+	if maxAddressesPerSubscriber == 0 {
+		return nil, ErrInvalidMaxAddressesPerSubscriber
+	}
+	if bufferSize == 0 {
+		return nil, ErrInvalidBufferSize
+	}
+
+	return &AccountsNotifier{
+		accounts:                  accounts,
+		addrConverter:             addrConverter,
+		shardCoordinator:          shardCoordinator,
+		maxSubscribers:            maxSubscribers,
+		maxAddressesPerSubscriber: maxAddressesPerSubscriber,
+		bufferSize:                bufferSize,
+		subscribers:               make(map[uint64]*subscriber),
+		watched:                   make(map[string]*watchedAccount),
+	}, nil
+}
+
+// Subscribe registers a new subscriber watching the provided hex encoded addresses. It returns the subscriber id,
+// to be used when unsubscribing, and the channel on which the account changes are sent
+func (an *AccountsNotifier) Subscribe(addresses []string) (uint64, <-chan *AccountChange, error) {
+	if len(addresses) == 0 {
+		return 0, nil, ErrNoAddressToWatch
+	}
+	if uint32(len(addresses)) > an.maxAddressesPerSubscriber {
+		return 0, nil, ErrTooManyAddresses
+	}
+
+	addressContainers := make(map[string]state.AddressContainer, len(addresses))
+	for _, address := range addresses {
+		addressContainer, err := an.addrConverter.CreateAddressFromHex(address)
+		if err != nil {
+			return 0, nil, err
+		}
+		if an.shardCoordinator.ComputeId(addressContainer) != an.shardCoordinator.SelfId() {
+			return 0, nil, ErrAddressNotInSelfShard
+		}
+
+		addressContainers[address] = addressContainer
+	}
+
+	an.mut.Lock()
+	defer an.mut.Unlock()
+
+	if uint32(len(an.subscribers)) >= an.maxSubscribers {
+		return 0, nil, ErrTooManySubscribers
+	}
+
+	sub := &subscriber{
+		addresses: make([]string, 0, len(addressContainers)),
+		changes:   make(chan *AccountChange, an.bufferSize),
+		isNew:     true,
+	}
+	for address, addressContainer := range addressContainers {
+		sub.addresses = append(sub.addresses, address)
+
+		account, ok := an.watched[address]
+		if !ok {
+			account = &watchedAccount{address: addressContainer}
+			an.watched[address] = account
+		}
+		account.numSubscribers++
+	}
+
+	an.lastSubscriberId++
+	an.subscribers[an.lastSubscriberId] = sub
+
+	return an.lastSubscriberId, sub.changes, nil
+}
+
+// Unsubscribe removes the subscriber and closes its channel
+func (an *AccountsNotifier) Unsubscribe(subscriberId uint64) {
+	an.mut.Lock()
+	an.removeSubscriber(subscriberId)
+	an.mut.Unlock()
+}
+
+// NumSubscribers returns the number of current subscribers
+func (an *AccountsNotifier) NumSubscribers() int {
+	an.mut.Lock()
+	defer an.mut.Unlock()
+
+	return len(an.subscribers)
+}
+
+// BlockCommitted reads the watched accounts after the block was committed and notifies the subscribers about the
+// accounts whose nonce or balance changed
+func (an *AccountsNotifier) BlockCommitted(header data.HeaderHandler, headerHash []byte) {
+	if header == nil || header.IsInterfaceNil() {
+		return
+	}
+
+	an.mut.Lock()
+	defer an.mut.Unlock()
+
+	if len(an.subscribers) == 0 {
+		return
+	}
+
+	changed := make(map[string]bool)
+	for address, account := range an.watched {
+		nonce, balance, err := an.readAccount(account.address)
+		if err != nil {
+			log.Debug(fmt.Sprintf("accounts notifier could not read account %s: %s", address, err.Error()))
+			continue
+		}
+
+		if account.isKnown && account.nonce == nonce && account.balance.Cmp(balance) == 0 {
+			continue
+		}
+
+		account.nonce = nonce
+		account.balance = balance
+		account.isKnown = true
+		changed[address] = true
+	}
+
+	blockHash := hex.EncodeToString(headerHash)
+	for id, sub := range an.subscribers {
+		isNew := sub.isNew
+		sub.isNew = false
+
+		for _, address := range sub.addresses {
+			account := an.watched[address]
+			if !account.isKnown || (!isNew && !changed[address]) {
+				continue
+			}
+
+			accountChange := &AccountChange{
+				Address:    address,
+				Nonce:      account.nonce,
+				Balance:    account.balance.String(),
+				BlockNonce: header.GetNonce(),
+				BlockHash:  blockHash,
+			}
+
+			select {
+			case sub.changes <- accountChange:
+			default:
+				log.Info(fmt.Sprintf("accounts notifier dropped subscriber %d as it does not consume its notifications", id))
+				an.removeSubscriber(id)
+			}
+
+			_, stillSubscribed := an.subscribers[id]
+			if !stillSubscribed {
+				break
+			}
+		}
+	}
+}
+
+func (an *AccountsNotifier) readAccount(address state.AddressContainer) (uint64, *big.Int, error) {
+	accountHandler, err := an.accounts.GetExistingAccount(address)
+	if err == state.ErrAccNotFound {
+		return 0, big.NewInt(0), nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	account, ok := accountHandler.(*state.Account)
+	if !ok {
+		return 0, nil, state.ErrWrongTypeAssertion
+	}
+
+	balance := big.NewInt(0)
+	if account.Balance != nil {
+		balance.Set(account.Balance)
+	}
+
+	return account.Nonce, balance, nil
+}
+
+func (an *AccountsNotifier) removeSubscriber(subscriberId uint64) {
+	sub, ok := an.subscribers[subscriberId]
+	if !ok {
+		return
+	}
+
+	for _, address := range sub.addresses {
+		account := an.watched[address]
+		account.numSubscribers--
+		if account.numSubscribers == 0 {
+			delete(an.watched, address)
+		}
+	}
+
+	delete(an.subscribers, subscriberId)
+	close(sub.changes)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (an *AccountsNotifier) IsInterfaceNil() bool {
+	if an == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,288 @@
+package subscription_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func createAddressConverter() *mock.AddressConverterStub {
+	return &mock.AddressConverterStub{
+		CreateAddressFromHexCalled: func(hexAddress string) (state.AddressContainer, error) {
+			buff, err := hex.DecodeString(hexAddress)
+			if err != nil {
+				return nil, err
+			}
+			return mock.NewAddressMock(buff), nil
+		},
+	}
+}
+
+func createAccountsStub(accounts map[string]*state.Account) *mock.AccountsStub {
+	return &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+			account, ok := accounts[string(addressContainer.Bytes())]
+			if !ok {
+				return nil, state.ErrAccNotFound
+			}
+			return account, nil
+		},
+	}
+}
+
+func createAccountsNotifier(accounts map[string]*state.Account) *subscription.AccountsNotifier {
+	an, _ := subscription.NewAccountsNotifier(
+		createAccountsStub(accounts),
+		createAddressConverter(),
+		mock.NewOneShardCoordinatorMock(),
+		2,
+		2,
+		2,
+	)
+	return an
+}
+
+func drainChanges(changes <-chan *subscription.AccountChange) []*subscription.AccountChange {
+	received := make([]*subscription.AccountChange, 0)
+	for {
+		select {
+		case change, ok := <-changes:
+			if !ok {
+				return received
+			}
+			received = append(received, change)
+		default:
+			return received
+		}
+	}
+}
+
+//------- NewAccountsNotifier
+
+func TestNewAccountsNotifier_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	an, err := subscription.NewAccountsNotifier(nil, createAddressConverter(), mock.NewOneShardCoordinatorMock(), 1, 1, 1)
+
+	assert.Nil(t, an)
+	assert.Equal(t, subscription.ErrNilAccountsAdapter, err)
+}
+
+func TestNewAccountsNotifier_NilAddressConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	an, err := subscription.NewAccountsNotifier(&mock.AccountsStub{}, nil, mock.NewOneShardCoordinatorMock(), 1, 1, 1)
+
+	assert.Nil(t, an)
+	assert.Equal(t, subscription.ErrNilAddressConverter, err)
+}
+
+func TestNewAccountsNotifier_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	an, err := subscription.NewAccountsNotifier(&mock.AccountsStub{}, createAddressConverter(), nil, 1, 1, 1)
+
+	assert.Nil(t, an)
+	assert.Equal(t, subscription.ErrNilShardCoordinator, err)
+}
+
+func TestNewAccountsNotifier_InvalidLimitsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	an, err := subscription.NewAccountsNotifier(&mock.AccountsStub{}, createAddressConverter(), mock.NewOneShardCoordinatorMock(), 0, 1, 1)
+	assert.Nil(t, an)
+	assert.Equal(t, subscription.ErrInvalidMaxSubscribers, err)
+
+	an, err = subscription.NewAccountsNotifier(&mock.AccountsStub{}, createAddressConverter(), mock.NewOneShardCoordinatorMock(), 1, 0, 1)
+	assert.Nil(t, an)
+	assert.Equal(t, subscription.ErrInvalidMaxAddressesPerSubscriber, err)
+
+	an, err = subscription.NewAccountsNotifier(&mock.AccountsStub{}, createAddressConverter(), mock.NewOneShardCoordinatorMock(), 1, 1, 0)
+	assert.Nil(t, an)
+	assert.Equal(t, subscription.ErrInvalidBufferSize, err)
+}
+
+func TestNewAccountsNotifier_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	an, err := subscription.NewAccountsNotifier(&mock.AccountsStub{}, createAddressConverter(), mock.NewOneShardCoordinatorMock(), 1, 1, 1)
+
+	assert.Nil(t, err)
+	assert.False(t, an.IsInterfaceNil())
+	assert.Equal(t, 0, an.NumSubscribers())
+}
+
+//------- Subscribe
+
+func TestAccountsNotifier_SubscribeWithoutAddressesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	an := createAccountsNotifier(nil)
+
+	_, changes, err := an.Subscribe(nil)
+
+	assert.Equal(t, subscription.ErrNoAddressToWatch, err)
+	assert.Nil(t, changes)
+}
+
+func TestAccountsNotifier_SubscribeTooManyAddressesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	an := createAccountsNotifier(nil)
+
+	_, _, err := an.Subscribe([]string{"aa", "bb", "cc"})
+
+	assert.Equal(t, subscription.ErrTooManyAddresses, err)
+}
+
+func TestAccountsNotifier_SubscribeInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	an := createAccountsNotifier(nil)
+
+	_, _, err := an.Subscribe([]string{"not hex"})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, an.NumSubscribers())
+}
+
+func TestAccountsNotifier_SubscribeAddressFromOtherShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+		return 1
+	}
+	an, _ := subscription.NewAccountsNotifier(&mock.AccountsStub{}, createAddressConverter(), shardCoordinator, 1, 1, 1)
+
+	_, _, err := an.Subscribe([]string{"aa"})
+
+	assert.Equal(t, subscription.ErrAddressNotInSelfShard, err)
+}
+
+func TestAccountsNotifier_SubscribeTooManySubscribersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	an := createAccountsNotifier(nil)
+
+	_, _, _ = an.Subscribe([]string{"aa"})
+	_, _, _ = an.Subscribe([]string{"aa"})
+	_, _, err := an.Subscribe([]string{"aa"})
+
+	assert.Equal(t, subscription.ErrTooManySubscribers, err)
+	assert.Equal(t, 2, an.NumSubscribers())
+}
+
+//------- BlockCommitted
+
+func TestAccountsNotifier_BlockCommittedShouldSendTheStateToNewSubscribers(t *testing.T) {
+	t.Parallel()
+
+	accounts := map[string]*state.Account{
+		"\xaa": {Nonce: 3, Balance: big.NewInt(100)},
+	}
+	an := createAccountsNotifier(accounts)
+	_, changes, _ := an.Subscribe([]string{"aa", "bb"})
+
+	an.BlockCommitted(&block.Header{Nonce: 7}, []byte("hash"))
+
+	received := drainChanges(changes)
+	assert.Equal(t, 2, len(received))
+	for _, change := range received {
+		assert.Equal(t, uint64(7), change.BlockNonce)
+		assert.Equal(t, hex.EncodeToString([]byte("hash")), change.BlockHash)
+		if change.Address == "aa" {
+			assert.Equal(t, uint64(3), change.Nonce)
+			assert.Equal(t, "100", change.Balance)
+		} else {
+			assert.Equal(t, "bb", change.Address)
+			assert.Equal(t, uint64(0), change.Nonce)
+			assert.Equal(t, "0", change.Balance)
+		}
+	}
+}
+
+func TestAccountsNotifier_BlockCommittedShouldSendOnlyTheChangedAccounts(t *testing.T) {
+	t.Parallel()
+
+	accounts := map[string]*state.Account{
+		"\xaa": {Nonce: 3, Balance: big.NewInt(100)},
+		"\xbb": {Nonce: 1, Balance: big.NewInt(5)},
+	}
+	an := createAccountsNotifier(accounts)
+	_, changes, _ := an.Subscribe([]string{"aa", "bb"})
+	an.BlockCommitted(&block.Header{Nonce: 1}, []byte("hash1"))
+	_ = drainChanges(changes)
+
+	an.BlockCommitted(&block.Header{Nonce: 2}, []byte("hash2"))
+	assert.Equal(t, 0, len(drainChanges(changes)))
+
+	accounts["\xbb"] = &state.Account{Nonce: 1, Balance: big.NewInt(50)}
+	an.BlockCommitted(&block.Header{Nonce: 3}, []byte("hash3"))
+
+	received := drainChanges(changes)
+	assert.Equal(t, 1, len(received))
+	assert.Equal(t, "bb", received[0].Address)
+	assert.Equal(t, "50", received[0].Balance)
+	assert.Equal(t, uint64(3), received[0].BlockNonce)
+}
+
+func TestAccountsNotifier_BlockCommittedAccountReadErrorShouldNotNotify(t *testing.T) {
+	t.Parallel()
+
+	accountsStub := &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+			return nil, errors.New("expected error")
+		},
+	}
+	an, _ := subscription.NewAccountsNotifier(accountsStub, createAddressConverter(), mock.NewOneShardCoordinatorMock(), 1, 1, 1)
+	_, changes, _ := an.Subscribe([]string{"aa"})
+
+	an.BlockCommitted(&block.Header{Nonce: 1}, []byte("hash"))
+
+	assert.Equal(t, 0, len(drainChanges(changes)))
+}
+
+func TestAccountsNotifier_BlockCommittedSlowSubscriberShouldBeDropped(t *testing.T) {
+	t.Parallel()
+
+	accounts := map[string]*state.Account{
+		"\xaa": {Nonce: 0, Balance: big.NewInt(0)},
+	}
+	an := createAccountsNotifier(accounts)
+	_, changes, _ := an.Subscribe([]string{"aa"})
+
+	for i := uint64(1); i <= 3; i++ {
+		accounts["\xaa"] = &state.Account{Nonce: i, Balance: big.NewInt(0)}
+		an.BlockCommitted(&block.Header{Nonce: i}, []byte("hash"))
+	}
+
+	assert.Equal(t, 0, an.NumSubscribers())
+	received := drainChanges(changes)
+	assert.Equal(t, 2, len(received))
+	_, ok := <-changes
+	assert.False(t, ok)
+}
+
+//------- Unsubscribe
+
+func TestAccountsNotifier_UnsubscribeShouldCloseTheChannel(t *testing.T) {
+	t.Parallel()
+
+	an := createAccountsNotifier(nil)
+	id, changes, _ := an.Subscribe([]string{"aa"})
+
+	an.Unsubscribe(id)
+	an.Unsubscribe(id)
+
+	_, ok := <-changes
+	assert.False(t, ok)
+	assert.Equal(t, 0, an.NumSubscribers())
+}
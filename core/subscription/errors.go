@@ -0,0 +1,35 @@
+package subscription
+
+import (
+	"errors"
+)
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter was provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilAddressConverter signals that a nil address converter was provided
+var ErrNilAddressConverter = errors.New("nil address converter")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator was provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers was provided
+var ErrInvalidMaxSubscribers = errors.New("invalid maximum number of subscribers")
+
+// ErrInvalidMaxAddressesPerSubscriber signals that an invalid maximum number of addresses per subscriber was provided
+var ErrInvalidMaxAddressesPerSubscriber = errors.New("invalid maximum number of addresses per subscriber")
+
+// ErrInvalidBufferSize signals that an invalid notifications buffer size was provided
+var ErrInvalidBufferSize = errors.New("invalid notifications buffer size")
+
+// ErrNoAddressToWatch signals that a subscription was requested without any address to watch
+var ErrNoAddressToWatch = errors.New("no address to watch")
+
+// ErrTooManyAddresses signals that a subscription was requested for too many addresses
+var ErrTooManyAddresses = errors.New("too many addresses to watch")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers was reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrAddressNotInSelfShard signals that the requested address does not belong to the node's shard
+var ErrAddressNotInSelfShard = errors.New("address does not belong to the node's shard")
@@ -0,0 +1,14 @@
+package subscription
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// AccountsNotifierHandler is an interface used to notify the subscribers about the changes of the watched accounts
+// made by the committed blocks
+type AccountsNotifierHandler interface {
+	Subscribe(addresses []string) (uint64, <-chan *AccountChange, error)
+	Unsubscribe(subscriberId uint64)
+	BlockCommitted(header data.HeaderHandler, headerHash []byte)
+	IsInterfaceNil() bool
+}
@@ -40,6 +40,16 @@ func (ngrt *NumGoRoutineThrottler) EndProcessing() {
 	atomic.AddInt32(&ngrt.counter, -1)
 }
 
+// NumProcessing returns the number of go routines currently started
+func (ngrt *NumGoRoutineThrottler) NumProcessing() int32 {
+	return atomic.LoadInt32(&ngrt.counter)
+}
+
+// MaxNumProcessing returns the maximum number of go routines allowed to run at the same time
+func (ngrt *NumGoRoutineThrottler) MaxNumProcessing() int32 {
+	return ngrt.max
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ngrt *NumGoRoutineThrottler) IsInterfaceNil() bool {
 	if ngrt == nil {
@@ -91,3 +91,17 @@ func TestNumGoRoutineThrottler_CanProcessMessageCounterIsMaxLessOneFromEndProces
 
 	assert.True(t, nt.CanProcess())
 }
+
+func TestNumGoRoutineThrottler_NumProcessingShouldReturnCounterAndMax(t *testing.T) {
+	t.Parallel()
+
+	max := int32(45)
+	nt, _ := throttler.NewNumGoRoutineThrottler(max)
+
+	nt.StartProcessing()
+	nt.StartProcessing()
+	nt.EndProcessing()
+
+	assert.Equal(t, int32(1), nt.NumProcessing())
+	assert.Equal(t, max, nt.MaxNumProcessing())
+}
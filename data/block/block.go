@@ -3,6 +3,7 @@ package block
 import (
 	"fmt"
 	"io"
+	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block/capnp"
@@ -103,6 +104,11 @@ type Header struct {
 	RootHash         []byte            `capid:"13"`
 	MetaBlockHashes  [][]byte          `capid:"14"`
 	TxCount          uint32            `capid:"15"`
+	AccumulatedFees  *big.Int          `capid:"16"`
+	Rewards          *big.Int          `capid:"17"`
+	LeaderFees       *big.Int          `capid:"18"`
+	CommunityFees    *big.Int          `capid:"19"`
+	BurnedFees       *big.Int          `capid:"20"`
 }
 
 // Save saves the serialized data of a Block Header into a stream through Capnp protocol
@@ -165,6 +171,17 @@ func HeaderCapnToGo(src capnp.HeaderCapn, dest *Header) *Header {
 
 	dest.TxCount = src.TxCount()
 
+	dest.AccumulatedFees = big.NewInt(0)
+	_ = dest.AccumulatedFees.GobDecode(src.AccumulatedFees())
+	dest.Rewards = big.NewInt(0)
+	_ = dest.Rewards.GobDecode(src.Rewards())
+	dest.LeaderFees = big.NewInt(0)
+	_ = dest.LeaderFees.GobDecode(src.LeaderFees())
+	dest.CommunityFees = big.NewInt(0)
+	_ = dest.CommunityFees.GobDecode(src.CommunityFees())
+	dest.BurnedFees = big.NewInt(0)
+	_ = dest.BurnedFees.GobDecode(src.BurnedFees())
+
 	return dest
 }
 
@@ -213,6 +230,17 @@ func HeaderGoToCapn(seg *capn.Segment, src *Header) capnp.HeaderCapn {
 
 	dest.SetTxCount(src.TxCount)
 
+	accumulatedFees, _ := src.AccumulatedFees.GobEncode()
+	dest.SetAccumulatedFees(accumulatedFees)
+	rewards, _ := src.Rewards.GobEncode()
+	dest.SetRewards(rewards)
+	leaderFees, _ := src.LeaderFees.GobEncode()
+	dest.SetLeaderFees(leaderFees)
+	communityFees, _ := src.CommunityFees.GobEncode()
+	dest.SetCommunityFees(communityFees)
+	burnedFees, _ := src.BurnedFees.GobEncode()
+	dest.SetBurnedFees(burnedFees)
+
 	return dest
 }
 
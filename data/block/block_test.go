@@ -2,6 +2,7 @@ package block_test
 
 import (
 	"bytes"
+	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -41,6 +42,11 @@ func TestHeader_SaveLoad(t *testing.T) {
 		RootHash:         []byte("root hash"),
 		MetaBlockHashes:  make([][]byte, 0),
 		TxCount:          uint32(10),
+		AccumulatedFees:  big.NewInt(100),
+		Rewards:          big.NewInt(250),
+		LeaderFees:       big.NewInt(50),
+		CommunityFees:    big.NewInt(10),
+		BurnedFees:       big.NewInt(40),
 	}
 
 	var b bytes.Buffer
@@ -12,9 +12,9 @@ import (
 
 type HeaderCapn C.Struct
 
-func NewHeaderCapn(s *C.Segment) HeaderCapn      { return HeaderCapn(s.NewStruct(40, 9)) }
-func NewRootHeaderCapn(s *C.Segment) HeaderCapn  { return HeaderCapn(s.NewRootStruct(40, 9)) }
-func AutoNewHeaderCapn(s *C.Segment) HeaderCapn  { return HeaderCapn(s.NewStructAR(40, 9)) }
+func NewHeaderCapn(s *C.Segment) HeaderCapn      { return HeaderCapn(s.NewStruct(40, 14)) }
+func NewRootHeaderCapn(s *C.Segment) HeaderCapn  { return HeaderCapn(s.NewRootStruct(40, 14)) }
+func AutoNewHeaderCapn(s *C.Segment) HeaderCapn  { return HeaderCapn(s.NewStructAR(40, 14)) }
 func ReadRootHeaderCapn(s *C.Segment) HeaderCapn { return HeaderCapn(s.Root(0).ToStruct()) }
 func (s HeaderCapn) Nonce() uint64               { return C.Struct(s).Get64(0) }
 func (s HeaderCapn) SetNonce(v uint64)           { C.Struct(s).Set64(0, v) }
@@ -54,6 +54,16 @@ func (s HeaderCapn) MetaHdrHashes() C.DataList            { return C.DataList(C.
 func (s HeaderCapn) SetMetaHdrHashes(v C.DataList)        { C.Struct(s).SetObject(8, C.Object(v)) }
 func (s HeaderCapn) TxCount() uint32                      { return C.Struct(s).Get32(36) }
 func (s HeaderCapn) SetTxCount(v uint32)                  { C.Struct(s).Set32(36, v) }
+func (s HeaderCapn) AccumulatedFees() []byte              { return C.Struct(s).GetObject(9).ToData() }
+func (s HeaderCapn) SetAccumulatedFees(v []byte)          { C.Struct(s).SetObject(9, s.Segment.NewData(v)) }
+func (s HeaderCapn) Rewards() []byte                      { return C.Struct(s).GetObject(10).ToData() }
+func (s HeaderCapn) SetRewards(v []byte)                  { C.Struct(s).SetObject(10, s.Segment.NewData(v)) }
+func (s HeaderCapn) LeaderFees() []byte                   { return C.Struct(s).GetObject(11).ToData() }
+func (s HeaderCapn) SetLeaderFees(v []byte)               { C.Struct(s).SetObject(11, s.Segment.NewData(v)) }
+func (s HeaderCapn) CommunityFees() []byte                { return C.Struct(s).GetObject(12).ToData() }
+func (s HeaderCapn) SetCommunityFees(v []byte)            { C.Struct(s).SetObject(12, s.Segment.NewData(v)) }
+func (s HeaderCapn) BurnedFees() []byte                   { return C.Struct(s).GetObject(13).ToData() }
+func (s HeaderCapn) SetBurnedFees(v []byte)               { C.Struct(s).SetObject(13, s.Segment.NewData(v)) }
 func (s HeaderCapn) WriteJSON(w io.Writer) error {
 	b := bufio.NewWriter(w)
 	var err error
@@ -409,6 +419,101 @@ func (s HeaderCapn) WriteJSON(w io.Writer) error {
 			return err
 		}
 	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"accumulatedFees\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.AccumulatedFees()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"rewards\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.Rewards()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"leaderFees\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.LeaderFees()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"communityFees\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.CommunityFees()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"burnedFees\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.BurnedFees()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
 	err = b.WriteByte('}')
 	if err != nil {
 		return err
@@ -776,6 +881,101 @@ func (s HeaderCapn) WriteCapLit(w io.Writer) error {
 			return err
 		}
 	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("accumulatedFees = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.AccumulatedFees()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("rewards = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.Rewards()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("leaderFees = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.LeaderFees()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("communityFees = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.CommunityFees()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("burnedFees = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.BurnedFees()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
 	err = b.WriteByte(')')
 	if err != nil {
 		return err
@@ -792,7 +992,7 @@ func (s HeaderCapn) MarshalCapLit() ([]byte, error) {
 type HeaderCapn_List C.PointerList
 
 func NewHeaderCapnList(s *C.Segment, sz int) HeaderCapn_List {
-	return HeaderCapn_List(s.NewCompositeList(40, 9, sz))
+	return HeaderCapn_List(s.NewCompositeList(40, 14, sz))
 }
 func (s HeaderCapn_List) Len() int            { return C.PointerList(s).Len() }
 func (s HeaderCapn_List) At(i int) HeaderCapn { return HeaderCapn(C.PointerList(s).At(i).ToStruct()) }
@@ -0,0 +1,35 @@
+package block
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// FinalityProof is the compact proof that a header was finalized by its consensus group: the aggregated signature
+// of the group over the signed message hash together with the bitmap of the signers. The consensus group is
+// recomputed from the shard ID, round and previous random seed, so the proof can be verified without the header
+// or the consensus messages
+type FinalityProof struct {
+	HeaderHash          []byte
+	SignedMessageHash   []byte
+	ShardId             uint32
+	Nonce               uint64
+	Round               uint64
+	PrevRandSeed        []byte
+	PubKeysBitmap       []byte
+	AggregatedSignature []byte
+}
+
+// NewFinalityProof creates the finality proof of a signed header. The signed message hash is the hash of the header
+// without its signature and public keys bitmap, as signed by the consensus group
+func NewFinalityProof(header data.HeaderHandler, headerHash []byte, signedMessageHash []byte) *FinalityProof {
+	return &FinalityProof{
+		HeaderHash:          headerHash,
+		SignedMessageHash:   signedMessageHash,
+		ShardId:             header.GetShardID(),
+		Nonce:               header.GetNonce(),
+		Round:               header.GetRound(),
+		PrevRandSeed:        header.GetPrevRandSeed(),
+		PubKeysBitmap:       header.GetPubKeysBitmap(),
+		AggregatedSignature: header.GetSignature(),
+	}
+}
@@ -94,6 +94,7 @@ type Trie interface {
 	Recreate(root []byte) (Trie, error)
 	String() string
 	DeepClone() (Trie, error)
+	IterateLeaves(startAfterKey []byte, handler func(key []byte, value []byte) bool) error
 	IsInterfaceNil() bool
 }
 
@@ -9,15 +9,16 @@ import (
 var errNotImplemented = errors.New("not implemented")
 
 type TrieStub struct {
-	GetCalled         func(key []byte) ([]byte, error)
-	UpdateCalled      func(key, value []byte) error
-	DeleteCalled      func(key []byte) error
-	RootCalled        func() ([]byte, error)
-	ProveCalled       func(key []byte) ([][]byte, error)
-	VerifyProofCalled func(proofs [][]byte, key []byte) (bool, error)
-	CommitCalled      func() error
-	RecreateCalled    func(root []byte) (data.Trie, error)
-	DeepCloneCalled   func() (data.Trie, error)
+	GetCalled           func(key []byte) ([]byte, error)
+	UpdateCalled        func(key, value []byte) error
+	DeleteCalled        func(key []byte) error
+	RootCalled          func() ([]byte, error)
+	ProveCalled         func(key []byte) ([][]byte, error)
+	VerifyProofCalled   func(proofs [][]byte, key []byte) (bool, error)
+	CommitCalled        func() error
+	RecreateCalled      func(root []byte) (data.Trie, error)
+	DeepCloneCalled     func() (data.Trie, error)
+	IterateLeavesCalled func(startAfterKey []byte, handler func(key []byte, value []byte) bool) error
 }
 
 func (ts *TrieStub) Get(key []byte) ([]byte, error) {
@@ -92,6 +93,14 @@ func (ts *TrieStub) DeepClone() (data.Trie, error) {
 	return ts.DeepCloneCalled()
 }
 
+func (ts *TrieStub) IterateLeaves(startAfterKey []byte, handler func(key []byte, value []byte) bool) error {
+	if ts.IterateLeavesCalled != nil {
+		return ts.IterateLeavesCalled(startAfterKey, handler)
+	}
+
+	return errNotImplemented
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ts *TrieStub) IsInterfaceNil() bool {
 	if ts == nil {
@@ -8,10 +8,12 @@ import (
 
 // Account is the struct used in serialization/deserialization
 type Account struct {
-	Nonce    uint64
-	Balance  *big.Int
-	CodeHash []byte
-	RootHash []byte
+	Nonce        uint64
+	Balance      *big.Int
+	CodeHash     []byte
+	CodeMetadata []byte
+	OwnerAddress []byte
+	RootHash     []byte
 
 	addressContainer AddressContainer
 	code             []byte
@@ -120,6 +122,42 @@ func (a *Account) SetCode(code []byte) {
 	a.code = code
 }
 
+// GetCodeMetadata returns the code metadata set on the account when the smart contract was deployed
+func (a *Account) GetCodeMetadata() []byte {
+	return a.CodeMetadata
+}
+
+// SetCodeMetadataWithJournal sets the account's code metadata, saving the old code metadata before changing
+func (a *Account) SetCodeMetadataWithJournal(codeMetadata []byte) error {
+	entry, err := NewJournalEntryCodeMetadata(a, a.CodeMetadata)
+	if err != nil {
+		return err
+	}
+
+	a.accountTracker.Journalize(entry)
+	a.CodeMetadata = codeMetadata
+
+	return a.accountTracker.SaveAccount(a)
+}
+
+// GetOwnerAddress returns the address of the account that deployed the smart contract
+func (a *Account) GetOwnerAddress() []byte {
+	return a.OwnerAddress
+}
+
+// SetOwnerAddressWithJournal sets the account's owner address, saving the old owner address before changing
+func (a *Account) SetOwnerAddressWithJournal(ownerAddress []byte) error {
+	entry, err := NewJournalEntryOwnerAddress(a, a.OwnerAddress)
+	if err != nil {
+		return err
+	}
+
+	a.accountTracker.Journalize(entry)
+	a.OwnerAddress = ownerAddress
+
+	return a.accountTracker.SaveAccount(a)
+}
+
 //------- data trie / root hash
 
 // GetRootHash returns the root hash associated with this account
@@ -230,6 +230,60 @@ func TestAccount_SetCodeHashWithJournal(t *testing.T) {
 	assert.Equal(t, 1, saveAccountCalled)
 }
 
+func TestAccount_SetCodeMetadataWithJournal(t *testing.T) {
+	t.Parallel()
+
+	journalizeCalled := 0
+	saveAccountCalled := 0
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+			journalizeCalled++
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			saveAccountCalled++
+			return nil
+		},
+	}
+
+	acc, err := state.NewAccount(&mock.AddressMock{}, tracker)
+	assert.Nil(t, err)
+
+	codeMetadata := []byte{state.MetadataReadable}
+	err = acc.SetCodeMetadataWithJournal(codeMetadata)
+
+	assert.Nil(t, err)
+	assert.Equal(t, codeMetadata, acc.GetCodeMetadata())
+	assert.Equal(t, 1, journalizeCalled)
+	assert.Equal(t, 1, saveAccountCalled)
+}
+
+func TestAccount_SetOwnerAddressWithJournal(t *testing.T) {
+	t.Parallel()
+
+	journalizeCalled := 0
+	saveAccountCalled := 0
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+			journalizeCalled++
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			saveAccountCalled++
+			return nil
+		},
+	}
+
+	acc, err := state.NewAccount(&mock.AddressMock{}, tracker)
+	assert.Nil(t, err)
+
+	ownerAddress := []byte("owner")
+	err = acc.SetOwnerAddressWithJournal(ownerAddress)
+
+	assert.Nil(t, err)
+	assert.Equal(t, ownerAddress, acc.GetOwnerAddress())
+	assert.Equal(t, 1, journalizeCalled)
+	assert.Equal(t, 1, saveAccountCalled)
+}
+
 func TestAccount_SetRootHashWithJournal(t *testing.T) {
 	t.Parallel()
 
@@ -20,6 +20,10 @@ type AccountsDB struct {
 
 	entries    []JournalEntry
 	mutEntries sync.RWMutex
+
+	committedRootHash []byte
+	readTrie          data.Trie
+	mutCommitted      sync.RWMutex
 }
 
 // NewAccountsDB creates a new account manager
@@ -435,6 +439,11 @@ func (adb *AccountsDB) Commit() ([]byte, error) {
 		return nil, err
 	}
 
+	//Step 4. make the new root hash visible to the read sessions, only after it has been fully persisted
+	adb.mutCommitted.Lock()
+	adb.committedRootHash = root
+	adb.mutCommitted.Unlock()
+
 	return root, nil
 }
 
@@ -472,10 +481,50 @@ func (adb *AccountsDB) RecreateTrie(rootHash []byte) error {
 		return ErrNilTrie
 	}
 
+	adb.mutCommitted.Lock()
 	adb.mainTrie = newTrie
+	adb.committedRootHash = rootHash
+	adb.mutCommitted.Unlock()
+
 	return nil
 }
 
+// NewReadSession opens a read only session over the accounts as they were at the last committed root hash.
+// The session is not affected by the changes done afterwards, so the reads done while a block is processed or
+// committed do not observe partial states. Before the first commit or trie recreation, the session holds no accounts.
+// Concurrent safe.
+func (adb *AccountsDB) NewReadSession() (AccountsReadSession, error) {
+	adb.mutCommitted.Lock()
+	if adb.readTrie == nil {
+		//the sessions' tries are recreated from a trie that is never changed, so opening a session does not
+		//wait for the operations done on the main trie
+		readTrie, err := adb.mainTrie.Recreate(make([]byte, 0))
+		if err != nil {
+			adb.mutCommitted.Unlock()
+			return nil, err
+		}
+		if readTrie == nil || readTrie.IsInterfaceNil() {
+			adb.mutCommitted.Unlock()
+			return nil, ErrNilTrie
+		}
+
+		adb.readTrie = readTrie
+	}
+	readTrie := adb.readTrie
+	rootHash := adb.committedRootHash
+	adb.mutCommitted.Unlock()
+
+	sessionTrie, err := readTrie.Recreate(rootHash)
+	if err != nil {
+		return nil, err
+	}
+	if sessionTrie == nil || sessionTrie.IsInterfaceNil() {
+		return nil, ErrNilTrie
+	}
+
+	return newAccountsReadSession(sessionTrie, rootHash, adb.marshalizer, adb.accountFactory), nil
+}
+
 // Journalize adds a new object to entries list. Concurrent safe.
 func (adb *AccountsDB) Journalize(entry JournalEntry) {
 	if entry == nil || entry.IsInterfaceNil() {
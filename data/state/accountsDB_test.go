@@ -751,3 +751,175 @@ func TestAccountsDB_RecreateTrieOkValsShouldWork(t *testing.T) {
 	assert.True(t, wasCalled)
 
 }
+
+//------- NewReadSession
+
+func TestAccountsDB_NewReadSessionRecreateFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("failure")
+	trieStub := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			return nil, errExpected
+		},
+	}
+	adb := generateAccountDBFromTrie(trieStub)
+
+	session, err := adb.NewReadSession()
+
+	assert.Nil(t, session)
+	assert.Equal(t, errExpected, err)
+}
+
+func TestAccountsDB_NewReadSessionBeforeCommitShouldReadFromEmptyRoot(t *testing.T) {
+	t.Parallel()
+
+	var recreatedRoot []byte
+	readTrie := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			recreatedRoot = root
+			return &mock.TrieStub{}, nil
+		},
+	}
+	trieStub := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			return readTrie, nil
+		},
+	}
+	adb := generateAccountDBFromTrie(trieStub)
+
+	session, err := adb.NewReadSession()
+
+	assert.Nil(t, err)
+	assert.Nil(t, session.RootHash())
+	assert.Nil(t, recreatedRoot)
+}
+
+func TestAccountsDB_NewReadSessionShouldReadFromLastCommittedRoot(t *testing.T) {
+	t.Parallel()
+
+	committedRoot := []byte("committed root")
+	var recreatedRoot []byte
+	readTrie := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			recreatedRoot = root
+			return &mock.TrieStub{}, nil
+		},
+	}
+	trieStub := &mock.TrieStub{
+		CommitCalled: func() error {
+			return nil
+		},
+		RootCalled: func() ([]byte, error) {
+			return committedRoot, nil
+		},
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			return readTrie, nil
+		},
+	}
+	adb := generateAccountDBFromTrie(trieStub)
+	_, _ = adb.Commit()
+
+	session, err := adb.NewReadSession()
+
+	assert.Nil(t, err)
+	assert.Equal(t, committedRoot, session.RootHash())
+	assert.Equal(t, committedRoot, recreatedRoot)
+}
+
+func TestAccountsDB_NewReadSessionShouldReadFromRecreatedRoot(t *testing.T) {
+	t.Parallel()
+
+	recreatedMainRoot := []byte("recreated root")
+	var recreatedRoot []byte
+	readTrie := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			recreatedRoot = root
+			return &mock.TrieStub{}, nil
+		},
+	}
+	trieStub := &mock.TrieStub{}
+	trieStub.RecreateCalled = func(root []byte) (data.Trie, error) {
+		if len(root) == 0 {
+			return readTrie, nil
+		}
+		return trieStub, nil
+	}
+	adb := generateAccountDBFromTrie(trieStub)
+	_ = adb.RecreateTrie(recreatedMainRoot)
+
+	session, err := adb.NewReadSession()
+
+	assert.Nil(t, err)
+	assert.Equal(t, recreatedMainRoot, session.RootHash())
+	assert.Equal(t, recreatedMainRoot, recreatedRoot)
+}
+
+func createAccountsDBWithSessionTrie(sessionTrie data.Trie) *state.AccountsDB {
+	readTrie := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			return sessionTrie, nil
+		},
+	}
+
+	return generateAccountDBFromTrie(&mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			return readTrie, nil
+		},
+	})
+}
+
+func TestAccountsReadSession_GetExistingAccountNilAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb := createAccountsDBWithSessionTrie(&mock.TrieStub{})
+	session, _ := adb.NewReadSession()
+
+	account, err := session.GetExistingAccount(nil)
+
+	assert.Nil(t, account)
+	assert.Equal(t, state.ErrNilAddressContainer, err)
+}
+
+func TestAccountsReadSession_GetExistingAccountNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb := createAccountsDBWithSessionTrie(&mock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, nil
+		},
+	})
+	session, _ := adb.NewReadSession()
+
+	account, err := session.GetExistingAccount(mock.NewAddressMock())
+	hasAccount, errHas := session.HasAccount(mock.NewAddressMock())
+
+	assert.Nil(t, account)
+	assert.Equal(t, state.ErrAccNotFound, err)
+	assert.False(t, hasAccount)
+	assert.Nil(t, errHas)
+}
+
+func TestAccountsReadSession_GetExistingAccountFoundShouldRetAccount(t *testing.T) {
+	t.Parallel()
+
+	adr := mock.NewAddressMock()
+	accnt := mock.NewAccountWrapMock(adr, nil)
+	accnt.MockValue = 45
+	buff, _ := (&mock.MarshalizerMock{}).Marshal(accnt)
+
+	adb := createAccountsDBWithSessionTrie(&mock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return buff, nil
+		},
+	})
+	session, _ := adb.NewReadSession()
+
+	accntRecov, err := session.GetExistingAccount(adr)
+	assert.Nil(t, err)
+	assert.Equal(t, accnt.MockValue, accntRecov.(*mock.AccountWrapMock).MockValue)
+
+	hasAccount, err := session.HasAccount(adr)
+	assert.Nil(t, err)
+	assert.True(t, hasAccount)
+}
@@ -0,0 +1,141 @@
+package state
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// accountsReadSession is a read only view over the accounts, as they were when its root hash was committed.
+// It works on its own instance of the trie so the reads are not affected by the changes done on the main trie
+type accountsReadSession struct {
+	trie           data.Trie
+	rootHash       []byte
+	marshalizer    marshal.Marshalizer
+	accountFactory AccountFactory
+}
+
+func newAccountsReadSession(
+	trie data.Trie,
+	rootHash []byte,
+	marshalizer marshal.Marshalizer,
+	accountFactory AccountFactory,
+) *accountsReadSession {
+	return &accountsReadSession{
+		trie:           trie,
+		rootHash:       rootHash,
+		marshalizer:    marshalizer,
+		accountFactory: accountFactory,
+	}
+}
+
+// RootHash returns the committed root hash the session reads from
+func (ars *accountsReadSession) RootHash() []byte {
+	return ars.rootHash
+}
+
+// HasAccount outputs if the account existed at the session's root hash
+func (ars *accountsReadSession) HasAccount(addressContainer AddressContainer) (bool, error) {
+	if addressContainer == nil || addressContainer.IsInterfaceNil() {
+		return false, ErrNilAddressContainer
+	}
+
+	val, err := ars.trie.Get(addressContainer.Bytes())
+	if err != nil {
+		return false, err
+	}
+
+	return len(val) > 0, nil
+}
+
+// GetExistingAccount returns the account, with its code and data, as it was at the session's root hash.
+// Errors with ErrAccNotFound if the account did not exist
+func (ars *accountsReadSession) GetExistingAccount(addressContainer AddressContainer) (AccountHandler, error) {
+	if addressContainer == nil || addressContainer.IsInterfaceNil() {
+		return nil, ErrNilAddressContainer
+	}
+
+	val, err := ars.trie.Get(addressContainer.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if len(val) == 0 {
+		return nil, ErrAccNotFound
+	}
+
+	acnt, err := ars.accountFactory.CreateAccount(addressContainer, ars)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ars.marshalizer.Unmarshal(acnt, val)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ars.loadCode(acnt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ars.loadDataTrie(acnt)
+	if err != nil {
+		return nil, err
+	}
+
+	return acnt, nil
+}
+
+func (ars *accountsReadSession) loadCode(accountHandler AccountHandler) error {
+	if len(accountHandler.GetCodeHash()) == 0 {
+		return nil
+	}
+	if len(accountHandler.GetCodeHash()) != HashLength {
+		return errors.New("attempt to search a hash not normalized to" +
+			strconv.Itoa(HashLength) + "bytes")
+	}
+
+	val, err := ars.trie.Get(accountHandler.GetCodeHash())
+	if err != nil {
+		return err
+	}
+
+	accountHandler.SetCode(val)
+	return nil
+}
+
+func (ars *accountsReadSession) loadDataTrie(accountHandler AccountHandler) error {
+	if accountHandler.GetRootHash() == nil {
+		return nil
+	}
+	if len(accountHandler.GetRootHash()) != HashLength {
+		return NewErrorTrieNotNormalized(HashLength, len(accountHandler.GetRootHash()))
+	}
+
+	dataTrie, err := ars.trie.Recreate(accountHandler.GetRootHash())
+	if err != nil {
+		return NewErrMissingTrie(accountHandler.GetRootHash())
+	}
+
+	accountHandler.SetDataTrie(dataTrie)
+	return nil
+}
+
+// SaveAccount errors as the accounts can not be changed through a read session
+func (ars *accountsReadSession) SaveAccount(accountHandler AccountHandler) error {
+	return ErrReadOnlySession
+}
+
+// Journalize does nothing as the accounts can not be changed through a read session
+func (ars *accountsReadSession) Journalize(entry JournalEntry) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ars *accountsReadSession) IsInterfaceNil() bool {
+	if ars == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package state
+
+// MetadataUpgradeable is the flag allowing the code of a smart contract to be replaced
+const MetadataUpgradeable byte = 1
+
+// MetadataReadable is the flag allowing the values of a smart contract to be queried
+const MetadataReadable byte = 2
+
+// MetadataPayable is the flag allowing a smart contract to receive value
+const MetadataPayable byte = 4
+
+const allMetadataFlags = MetadataUpgradeable | MetadataReadable | MetadataPayable
+
+// CodeMetadata holds the flags set on a smart contract account at deploy time
+type CodeMetadata struct {
+	Upgradeable bool `json:"upgradeable"`
+	Readable    bool `json:"readable"`
+	Payable     bool `json:"payable"`
+}
+
+// CodeMetadataFromBytes decodes the code metadata from its one byte representation. Empty metadata, as found on the
+// accounts created before the flags existed, has all the flags unset
+func CodeMetadataFromBytes(bytes []byte) (CodeMetadata, error) {
+	if len(bytes) == 0 {
+		return CodeMetadata{}, nil
+	}
+	if len(bytes) > 1 || bytes[0]&^allMetadataFlags != 0 {
+		return CodeMetadata{}, ErrInvalidCodeMetadata
+	}
+
+	return CodeMetadata{
+		Upgradeable: bytes[0]&MetadataUpgradeable != 0,
+		Readable:    bytes[0]&MetadataReadable != 0,
+		Payable:     bytes[0]&MetadataPayable != 0,
+	}, nil
+}
+
+// ToBytes encodes the code metadata on one byte
+func (cm CodeMetadata) ToBytes() []byte {
+	flags := byte(0)
+	if cm.Upgradeable {
+		flags |= MetadataUpgradeable
+	}
+	if cm.Readable {
+		flags |= MetadataReadable
+	}
+	if cm.Payable {
+		flags |= MetadataPayable
+	}
+
+	return []byte{flags}
+}
@@ -0,0 +1,48 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodeMetadataFromBytes_EmptyShouldHaveNoFlags(t *testing.T) {
+	t.Parallel()
+
+	metadata, err := state.CodeMetadataFromBytes(nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, state.CodeMetadata{}, metadata)
+}
+
+func TestCodeMetadataFromBytes_InvalidShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := state.CodeMetadataFromBytes([]byte{1, 2})
+	assert.Equal(t, state.ErrInvalidCodeMetadata, err)
+
+	_, err = state.CodeMetadataFromBytes([]byte{8})
+	assert.Equal(t, state.ErrInvalidCodeMetadata, err)
+}
+
+func TestCodeMetadataFromBytes_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	metadata, err := state.CodeMetadataFromBytes([]byte{state.MetadataUpgradeable | state.MetadataPayable})
+
+	assert.Nil(t, err)
+	assert.Equal(t, state.CodeMetadata{Upgradeable: true, Payable: true}, metadata)
+}
+
+func TestCodeMetadata_ToBytesShouldBeDecodable(t *testing.T) {
+	t.Parallel()
+
+	metadata := state.CodeMetadata{Readable: true, Payable: true}
+	bytes := metadata.ToBytes()
+	decoded, err := state.CodeMetadataFromBytes(bytes)
+
+	assert.Equal(t, []byte{state.MetadataReadable | state.MetadataPayable}, bytes)
+	assert.Nil(t, err)
+	assert.Equal(t, metadata, decoded)
+}
@@ -142,3 +142,9 @@ var ErrNilBLSPublicKey = errors.New("bls public key is nil")
 
 // ErrUnknownAccountType signals that the provided account type is unknown
 var ErrUnknownAccountType = errors.New("account type is unknown")
+
+// ErrReadOnlySession signals that an account change has been attempted through a read session
+var ErrReadOnlySession = errors.New("accounts can not be changed through a read session")
+
+// ErrInvalidCodeMetadata signals that the provided code metadata is invalid
+var ErrInvalidCodeMetadata = errors.New("invalid code metadata")
@@ -93,6 +93,16 @@ type AccountsAdapter interface {
 	PutCode(accountHandler AccountHandler, code []byte) error
 	RemoveCode(codeHash []byte) error
 	SaveDataTrie(accountHandler AccountHandler) error
+	NewReadSession() (AccountsReadSession, error)
+	IsInterfaceNil() bool
+}
+
+// AccountsReadSession is a read only view over the accounts, as they were at the last committed root hash
+// when the session was opened
+type AccountsReadSession interface {
+	RootHash() []byte
+	HasAccount(addressContainer AddressContainer) (bool, error)
+	GetExistingAccount(addressContainer AddressContainer) (AccountHandler, error)
 	IsInterfaceNil() bool
 }
 
@@ -38,3 +38,73 @@ func (jeb *JournalEntryBalance) IsInterfaceNil() bool {
 	}
 	return false
 }
+
+//------- JournalEntryCodeMetadata
+
+// JournalEntryCodeMetadata is used to revert a code metadata change
+type JournalEntryCodeMetadata struct {
+	account         *Account
+	oldCodeMetadata []byte
+}
+
+// NewJournalEntryCodeMetadata outputs a new JournalEntry implementation used to revert a code metadata change
+func NewJournalEntryCodeMetadata(account *Account, oldCodeMetadata []byte) (*JournalEntryCodeMetadata, error) {
+	if account == nil {
+		return nil, ErrNilAccountHandler
+	}
+
+	return &JournalEntryCodeMetadata{
+		account:         account,
+		oldCodeMetadata: oldCodeMetadata,
+	}, nil
+}
+
+// Revert applies undo operation
+func (jecm *JournalEntryCodeMetadata) Revert() (AccountHandler, error) {
+	jecm.account.CodeMetadata = jecm.oldCodeMetadata
+
+	return jecm.account, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jecm *JournalEntryCodeMetadata) IsInterfaceNil() bool {
+	if jecm == nil {
+		return true
+	}
+	return false
+}
+
+//------- JournalEntryOwnerAddress
+
+// JournalEntryOwnerAddress is used to revert an owner address change
+type JournalEntryOwnerAddress struct {
+	account         *Account
+	oldOwnerAddress []byte
+}
+
+// NewJournalEntryOwnerAddress outputs a new JournalEntry implementation used to revert an owner address change
+func NewJournalEntryOwnerAddress(account *Account, oldOwnerAddress []byte) (*JournalEntryOwnerAddress, error) {
+	if account == nil {
+		return nil, ErrNilAccountHandler
+	}
+
+	return &JournalEntryOwnerAddress{
+		account:         account,
+		oldOwnerAddress: oldOwnerAddress,
+	}, nil
+}
+
+// Revert applies undo operation
+func (jeoa *JournalEntryOwnerAddress) Revert() (AccountHandler, error) {
+	jeoa.account.OwnerAddress = jeoa.oldOwnerAddress
+
+	return jeoa.account, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jeoa *JournalEntryOwnerAddress) IsInterfaceNil() bool {
+	if jeoa == nil {
+		return true
+	}
+	return false
+}
@@ -41,3 +41,51 @@ func TestNewJournalEntryBalance_RevertOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, balance, accnt.Balance)
 }
+
+//------- JournalEntryCodeMetadata
+
+func TestNewJournalEntryCodeMetadata_NilAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	entry, err := state.NewJournalEntryCodeMetadata(nil, nil)
+
+	assert.Nil(t, entry)
+	assert.Equal(t, state.ErrNilAccountHandler, err)
+}
+
+func TestNewJournalEntryCodeMetadata_RevertOkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	codeMetadata := []byte{state.MetadataUpgradeable}
+	accnt, _ := state.NewAccount(mock.NewAddressMock(), &mock.AccountTrackerStub{})
+	accnt.CodeMetadata = []byte{state.MetadataPayable}
+	entry, _ := state.NewJournalEntryCodeMetadata(accnt, codeMetadata)
+	_, err := entry.Revert()
+
+	assert.Nil(t, err)
+	assert.Equal(t, codeMetadata, accnt.CodeMetadata)
+}
+
+//------- JournalEntryOwnerAddress
+
+func TestNewJournalEntryOwnerAddress_NilAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	entry, err := state.NewJournalEntryOwnerAddress(nil, nil)
+
+	assert.Nil(t, entry)
+	assert.Equal(t, state.ErrNilAccountHandler, err)
+}
+
+func TestNewJournalEntryOwnerAddress_RevertOkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ownerAddress := []byte("owner")
+	accnt, _ := state.NewAccount(mock.NewAddressMock(), &mock.AccountTrackerStub{})
+	accnt.OwnerAddress = []byte("new owner")
+	entry, _ := state.NewJournalEntryOwnerAddress(accnt, ownerAddress)
+	_, err := entry.Revert()
+
+	assert.Nil(t, err)
+	assert.Equal(t, ownerAddress, accnt.OwnerAddress)
+}
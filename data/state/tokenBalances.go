@@ -0,0 +1,37 @@
+package state
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// TokenBalanceKey returns the data trie key holding the account's balance of the provided token
+func TokenBalanceKey(tokenName string) []byte {
+	return []byte(core.ElrondTokenKeyPrefix + tokenName)
+}
+
+// GetTokenBalances returns the token balances found in the provided data trie, indexed by the token names
+func GetTokenBalances(dataTrie data.Trie) (map[string]*big.Int, error) {
+	tokenBalances := make(map[string]*big.Int)
+	if dataTrie == nil || dataTrie.IsInterfaceNil() {
+		return tokenBalances, nil
+	}
+
+	err := dataTrie.IterateLeaves(nil, func(key []byte, value []byte) bool {
+		if !strings.HasPrefix(string(key), core.ElrondTokenKeyPrefix) {
+			return true
+		}
+
+		tokenName := strings.TrimPrefix(string(key), core.ElrondTokenKeyPrefix)
+		tokenBalances[tokenName] = big.NewInt(0).SetBytes(value)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenBalances, nil
+}
@@ -0,0 +1,64 @@
+package state_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBalanceKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []byte(core.ElrondTokenKeyPrefix+"token"), state.TokenBalanceKey("token"))
+}
+
+func TestGetTokenBalances_NilDataTrieShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	tokenBalances, err := state.GetTokenBalances(nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(tokenBalances))
+}
+
+func TestGetTokenBalances_IterateLeavesErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	trie := &mock.TrieStub{
+		IterateLeavesCalled: func(startAfterKey []byte, handler func(key []byte, value []byte) bool) error {
+			return errExpected
+		},
+	}
+
+	tokenBalances, err := state.GetTokenBalances(trie)
+
+	assert.Nil(t, tokenBalances)
+	assert.Equal(t, errExpected, err)
+}
+
+func TestGetTokenBalances_ShouldReturnOnlyTheTokenKeys(t *testing.T) {
+	t.Parallel()
+
+	trie := &mock.TrieStub{
+		IterateLeavesCalled: func(startAfterKey []byte, handler func(key []byte, value []byte) bool) error {
+			_ = handler(state.TokenBalanceKey("token1"), big.NewInt(10).Bytes())
+			_ = handler(state.TokenBalanceKey("token2"), big.NewInt(20).Bytes())
+			_ = handler([]byte(core.ElrondProtectedKeyPrefix+"other"), []byte("value"))
+			_ = handler([]byte("sc variable"), []byte("value"))
+			return nil
+		},
+	}
+
+	tokenBalances, err := state.GetTokenBalances(trie)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(tokenBalances))
+	assert.Equal(t, big.NewInt(10), tokenBalances["token1"])
+	assert.Equal(t, big.NewInt(20), tokenBalances["token2"])
+}
@@ -12,35 +12,43 @@ import (
 
 type TransactionCapn C.Struct
 
-func NewTransactionCapn(s *C.Segment) TransactionCapn { return TransactionCapn(s.NewStruct(24, 6)) }
+func NewTransactionCapn(s *C.Segment) TransactionCapn { return TransactionCapn(s.NewStruct(48, 6)) }
 func NewRootTransactionCapn(s *C.Segment) TransactionCapn {
-	return TransactionCapn(s.NewRootStruct(24, 6))
+	return TransactionCapn(s.NewRootStruct(48, 6))
 }
 func AutoNewTransactionCapn(s *C.Segment) TransactionCapn {
-	return TransactionCapn(s.NewStructAR(24, 6))
+	return TransactionCapn(s.NewStructAR(48, 6))
 }
 func ReadRootTransactionCapn(s *C.Segment) TransactionCapn {
 	return TransactionCapn(s.Root(0).ToStruct())
 }
-func (s TransactionCapn) Nonce() uint64         { return C.Struct(s).Get64(0) }
-func (s TransactionCapn) SetNonce(v uint64)     { C.Struct(s).Set64(0, v) }
-func (s TransactionCapn) Value() []byte         { return C.Struct(s).GetObject(0).ToData() }
-func (s TransactionCapn) SetValue(v []byte)     { C.Struct(s).SetObject(0, s.Segment.NewData(v)) }
-func (s TransactionCapn) RcvAddr() []byte       { return C.Struct(s).GetObject(1).ToData() }
-func (s TransactionCapn) SetRcvAddr(v []byte)   { C.Struct(s).SetObject(1, s.Segment.NewData(v)) }
-func (s TransactionCapn) SndAddr() []byte       { return C.Struct(s).GetObject(2).ToData() }
-func (s TransactionCapn) SetSndAddr(v []byte)   { C.Struct(s).SetObject(2, s.Segment.NewData(v)) }
-func (s TransactionCapn) GasPrice() uint64      { return C.Struct(s).Get64(8) }
-func (s TransactionCapn) SetGasPrice(v uint64)  { C.Struct(s).Set64(8, v) }
-func (s TransactionCapn) GasLimit() uint64      { return C.Struct(s).Get64(16) }
-func (s TransactionCapn) SetGasLimit(v uint64)  { C.Struct(s).Set64(16, v) }
-func (s TransactionCapn) Data() string          { return C.Struct(s).GetObject(3).ToText() }
-func (s TransactionCapn) DataBytes() []byte     { return C.Struct(s).GetObject(3).ToDataTrimLastByte() }
-func (s TransactionCapn) SetData(v string)      { C.Struct(s).SetObject(3, s.Segment.NewText(v)) }
-func (s TransactionCapn) Signature() []byte     { return C.Struct(s).GetObject(4).ToData() }
-func (s TransactionCapn) SetSignature(v []byte) { C.Struct(s).SetObject(4, s.Segment.NewData(v)) }
-func (s TransactionCapn) Challenge() []byte     { return C.Struct(s).GetObject(5).ToData() }
-func (s TransactionCapn) SetChallenge(v []byte) { C.Struct(s).SetObject(5, s.Segment.NewData(v)) }
+func (s TransactionCapn) Nonce() uint64              { return C.Struct(s).Get64(0) }
+func (s TransactionCapn) SetNonce(v uint64)          { C.Struct(s).Set64(0, v) }
+func (s TransactionCapn) Value() []byte              { return C.Struct(s).GetObject(0).ToData() }
+func (s TransactionCapn) SetValue(v []byte)          { C.Struct(s).SetObject(0, s.Segment.NewData(v)) }
+func (s TransactionCapn) RcvAddr() []byte            { return C.Struct(s).GetObject(1).ToData() }
+func (s TransactionCapn) SetRcvAddr(v []byte)        { C.Struct(s).SetObject(1, s.Segment.NewData(v)) }
+func (s TransactionCapn) SndAddr() []byte            { return C.Struct(s).GetObject(2).ToData() }
+func (s TransactionCapn) SetSndAddr(v []byte)        { C.Struct(s).SetObject(2, s.Segment.NewData(v)) }
+func (s TransactionCapn) GasPrice() uint64           { return C.Struct(s).Get64(8) }
+func (s TransactionCapn) SetGasPrice(v uint64)       { C.Struct(s).Set64(8, v) }
+func (s TransactionCapn) GasLimit() uint64           { return C.Struct(s).Get64(16) }
+func (s TransactionCapn) SetGasLimit(v uint64)       { C.Struct(s).Set64(16, v) }
+func (s TransactionCapn) Data() string               { return C.Struct(s).GetObject(3).ToText() }
+func (s TransactionCapn) DataBytes() []byte          { return C.Struct(s).GetObject(3).ToDataTrimLastByte() }
+func (s TransactionCapn) SetData(v string)           { C.Struct(s).SetObject(3, s.Segment.NewText(v)) }
+func (s TransactionCapn) Signature() []byte          { return C.Struct(s).GetObject(4).ToData() }
+func (s TransactionCapn) SetSignature(v []byte)      { C.Struct(s).SetObject(4, s.Segment.NewData(v)) }
+func (s TransactionCapn) Challenge() []byte          { return C.Struct(s).GetObject(5).ToData() }
+func (s TransactionCapn) SetChallenge(v []byte)      { C.Struct(s).SetObject(5, s.Segment.NewData(v)) }
+func (s TransactionCapn) NotBeforeRound() uint64     { return C.Struct(s).Get64(24) }
+func (s TransactionCapn) SetNotBeforeRound(v uint64) { C.Struct(s).Set64(24, v) }
+func (s TransactionCapn) NotAfterRound() uint64      { return C.Struct(s).Get64(32) }
+func (s TransactionCapn) SetNotAfterRound(v uint64)  { C.Struct(s).Set64(32, v) }
+func (s TransactionCapn) NotBeforeEpoch() uint32     { return C.Struct(s).Get32(40) }
+func (s TransactionCapn) SetNotBeforeEpoch(v uint32) { C.Struct(s).Set32(40, v) }
+func (s TransactionCapn) NotAfterEpoch() uint32      { return C.Struct(s).Get32(44) }
+func (s TransactionCapn) SetNotAfterEpoch(v uint32)  { C.Struct(s).Set32(44, v) }
 func (s TransactionCapn) WriteJSON(w io.Writer) error {
 	b := bufio.NewWriter(w)
 	var err error
@@ -217,6 +225,82 @@ func (s TransactionCapn) WriteJSON(w io.Writer) error {
 			return err
 		}
 	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"notBeforeRound\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.NotBeforeRound()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"notAfterRound\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.NotAfterRound()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"notBeforeEpoch\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.NotBeforeEpoch()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = b.WriteByte(',')
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("\"notAfterEpoch\":")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.NotAfterEpoch()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
 	err = b.WriteByte('}')
 	if err != nil {
 		return err
@@ -405,6 +489,82 @@ func (s TransactionCapn) WriteCapLit(w io.Writer) error {
 			return err
 		}
 	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("notBeforeRound = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.NotBeforeRound()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("notAfterRound = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.NotAfterRound()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("notBeforeEpoch = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.NotBeforeEpoch()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = b.WriteString(", ")
+	if err != nil {
+		return err
+	}
+	_, err = b.WriteString("notAfterEpoch = ")
+	if err != nil {
+		return err
+	}
+	{
+		s := s.NotAfterEpoch()
+		buf, err = json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = b.Write(buf)
+		if err != nil {
+			return err
+		}
+	}
 	err = b.WriteByte(')')
 	if err != nil {
 		return err
@@ -421,7 +581,7 @@ func (s TransactionCapn) MarshalCapLit() ([]byte, error) {
 type TransactionCapn_List C.PointerList
 
 func NewTransactionCapnList(s *C.Segment, sz int) TransactionCapn_List {
-	return TransactionCapn_List(s.NewCompositeList(24, 6, sz))
+	return TransactionCapn_List(s.NewCompositeList(48, 6, sz))
 }
 func (s TransactionCapn_List) Len() int { return C.PointerList(s).Len() }
 func (s TransactionCapn_List) At(i int) TransactionCapn {
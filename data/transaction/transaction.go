@@ -8,17 +8,32 @@ import (
 	"github.com/glycerine/go-capnproto"
 )
 
-// Transaction holds all the data needed for a value transfer
+// Transaction holds all the data needed for a value transfer. The optional NotBefore and NotAfter fields define the
+// validity window of the transaction: it may only be executed from the NotBefore round and epoch up to the NotAfter
+// round and epoch, all included. A zero value leaves the corresponding side of the window open
 type Transaction struct {
-	Nonce     uint64   `capid:"0" json:"nonce"`
-	Value     *big.Int `capid:"1" json:"value"`
-	RcvAddr   []byte   `capid:"2" json:"receiver"`
-	SndAddr   []byte   `capid:"3" json:"sender"`
-	GasPrice  uint64   `capid:"4" json:"gasPrice,omitempty"`
-	GasLimit  uint64   `capid:"5" json:"gasLimit,omitempty"`
-	Data      string   `capid:"6" json:"data,omitempty"`
-	Signature []byte   `capid:"7" json:"signature,omitempty"`
-	Challenge []byte   `capid:"8" json:"challenge,omitempty"`
+	Nonce          uint64   `capid:"0" json:"nonce"`
+	Value          *big.Int `capid:"1" json:"value"`
+	RcvAddr        []byte   `capid:"2" json:"receiver"`
+	SndAddr        []byte   `capid:"3" json:"sender"`
+	GasPrice       uint64   `capid:"4" json:"gasPrice,omitempty"`
+	GasLimit       uint64   `capid:"5" json:"gasLimit,omitempty"`
+	Data           string   `capid:"6" json:"data,omitempty"`
+	Signature      []byte   `capid:"7" json:"signature,omitempty"`
+	Challenge      []byte   `capid:"8" json:"challenge,omitempty"`
+	NotBeforeRound uint64   `capid:"9" json:"notBeforeRound,omitempty"`
+	NotAfterRound  uint64   `capid:"10" json:"notAfterRound,omitempty"`
+	NotBeforeEpoch uint32   `capid:"11" json:"notBeforeEpoch,omitempty"`
+	NotAfterEpoch  uint32   `capid:"12" json:"notAfterEpoch,omitempty"`
+}
+
+// ValidityWindow holds the rounds and epochs bounds of a transaction's validity window. A zero value leaves the
+// corresponding side of the window open
+type ValidityWindow struct {
+	NotBeforeRound uint64
+	NotAfterRound  uint64
+	NotBeforeEpoch uint32
+	NotAfterEpoch  uint32
 }
 
 // Save saves the serialized data of a Transaction into a stream through Capnp protocol
@@ -73,6 +88,11 @@ func TransactionCapnToGo(src capnp.TransactionCapn, dest *Transaction) *Transact
 	dest.Signature = src.Signature()
 	// Challenge
 	dest.Challenge = src.Challenge()
+	// Validity window
+	dest.NotBeforeRound = src.NotBeforeRound()
+	dest.NotAfterRound = src.NotAfterRound()
+	dest.NotBeforeEpoch = src.NotBeforeEpoch()
+	dest.NotAfterEpoch = src.NotAfterEpoch()
 
 	return dest
 }
@@ -91,6 +111,10 @@ func TransactionGoToCapn(seg *capn.Segment, src *Transaction) capnp.TransactionC
 	dest.SetData(src.Data)
 	dest.SetSignature(src.Signature)
 	dest.SetChallenge(src.Challenge)
+	dest.SetNotBeforeRound(src.NotBeforeRound)
+	dest.SetNotAfterRound(src.NotAfterRound)
+	dest.SetNotBeforeEpoch(src.NotBeforeEpoch)
+	dest.SetNotAfterEpoch(src.NotAfterEpoch)
 
 	return dest
 }
@@ -100,6 +124,19 @@ func (tx *Transaction) IsInterfaceNil() bool {
 	return tx == nil
 }
 
+// HasValidityWindow returns true if the transaction may only be executed in a window of rounds or epochs
+func (tx *Transaction) HasValidityWindow() bool {
+	return tx.NotBeforeRound > 0 || tx.NotAfterRound > 0 || tx.NotBeforeEpoch > 0 || tx.NotAfterEpoch > 0
+}
+
+// SetValidityWindow sets the rounds and epochs bounds of the transaction's validity window
+func (tx *Transaction) SetValidityWindow(window ValidityWindow) {
+	tx.NotBeforeRound = window.NotBeforeRound
+	tx.NotAfterRound = window.NotAfterRound
+	tx.NotBeforeEpoch = window.NotBeforeEpoch
+	tx.NotAfterEpoch = window.NotAfterEpoch
+}
+
 // GetValue returns the value of the transaction
 func (tx *Transaction) GetValue() *big.Int {
 	return tx.Value
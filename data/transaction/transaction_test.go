@@ -31,6 +31,28 @@ func TestTransaction_SaveLoad(t *testing.T) {
 	assert.Equal(t, loadTx, tx)
 }
 
+func TestTransaction_SaveLoadWithValidityWindow(t *testing.T) {
+	tx := transaction.Transaction{
+		Nonce:          uint64(1),
+		Value:          big.NewInt(1),
+		RcvAddr:        []byte("receiver_address"),
+		SndAddr:        []byte("sender_address"),
+		Signature:      []byte("signature"),
+		NotBeforeRound: 100,
+		NotAfterRound:  200,
+		NotBeforeEpoch: 3,
+		NotAfterEpoch:  4,
+	}
+
+	var b bytes.Buffer
+	_ = tx.Save(&b)
+
+	loadTx := transaction.Transaction{}
+	_ = loadTx.Load(&b)
+
+	assert.Equal(t, loadTx, tx)
+}
+
 func TestTransaction_GetData(t *testing.T) {
 	t.Parallel()
 
@@ -106,3 +128,30 @@ func TestTransaction_SetValue(t *testing.T) {
 
 	assert.Equal(t, value, tx.Value)
 }
+
+func TestTransaction_SetValidityWindow(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	tx.SetValidityWindow(transaction.ValidityWindow{
+		NotBeforeRound: 1,
+		NotAfterRound:  2,
+		NotBeforeEpoch: 3,
+		NotAfterEpoch:  4,
+	})
+
+	assert.Equal(t, uint64(1), tx.NotBeforeRound)
+	assert.Equal(t, uint64(2), tx.NotAfterRound)
+	assert.Equal(t, uint32(3), tx.NotBeforeEpoch)
+	assert.Equal(t, uint32(4), tx.NotAfterEpoch)
+}
+
+func TestTransaction_HasValidityWindow(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, (&transaction.Transaction{Nonce: 1}).HasValidityWindow())
+	assert.True(t, (&transaction.Transaction{NotBeforeRound: 1}).HasValidityWindow())
+	assert.True(t, (&transaction.Transaction{NotAfterRound: 1}).HasValidityWindow())
+	assert.True(t, (&transaction.Transaction{NotBeforeEpoch: 1}).HasValidityWindow())
+	assert.True(t, (&transaction.Transaction{NotAfterEpoch: 1}).HasValidityWindow())
+}
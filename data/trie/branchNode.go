@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 	"io"
-	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/trie/capnp"
@@ -137,73 +136,7 @@ func (bn *branchNode) setHash(marshalizer marshal.Marshalizer, hasher hashing.Ha
 }
 
 func (bn *branchNode) setRootHash(marshalizer marshal.Marshalizer, hasher hashing.Hasher) error {
-	err := bn.isEmptyOrNil()
-	if err != nil {
-		return err
-	}
-	if bn.getHash() != nil {
-		return nil
-	}
-	if bn.isCollapsed() {
-		hash, err := encodeNodeAndGetHash(bn, marshalizer, hasher)
-		if err != nil {
-			return err
-		}
-		bn.hash = hash
-		return nil
-	}
-
-	var wg sync.WaitGroup
-	errc := make(chan error, nrOfChildren)
-
-	for i := 0; i < nrOfChildren; i++ {
-		if bn.children[i] != nil {
-			wg.Add(1)
-			go bn.children[i].setHashConcurrent(marshalizer, hasher, &wg, errc)
-		}
-	}
-	wg.Wait()
-	if len(errc) != 0 {
-		for err := range errc {
-			return err
-		}
-	}
-
-	hashed, err := bn.hashNode(marshalizer, hasher)
-	if err != nil {
-		return err
-	}
-
-	bn.hash = hashed
-	return nil
-}
-
-func (bn *branchNode) setHashConcurrent(marshalizer marshal.Marshalizer, hasher hashing.Hasher, wg *sync.WaitGroup, c chan error) {
-	defer wg.Done()
-	err := bn.isEmptyOrNil()
-	if err != nil {
-		c <- err
-		return
-	}
-	if bn.getHash() != nil {
-		return
-	}
-	if bn.isCollapsed() {
-		hash, err := encodeNodeAndGetHash(bn, marshalizer, hasher)
-		if err != nil {
-			c <- err
-			return
-		}
-		bn.hash = hash
-		return
-	}
-	hash, err := hashChildrenAndNode(bn, marshalizer, hasher)
-	if err != nil {
-		c <- err
-		return
-	}
-	bn.hash = hash
-	return
+	return setRootHashUsingWorkers(bn, marshalizer, hasher)
 }
 
 func (bn *branchNode) hashChildren(marshalizer marshal.Marshalizer, hasher hashing.Hasher) error {
@@ -229,7 +162,7 @@ func (bn *branchNode) hashNode(marshalizer marshal.Marshalizer, hasher hashing.H
 	}
 	for i := range bn.EncodedChildren {
 		if bn.children[i] != nil {
-			encChild, err := encodeNodeAndGetHash(bn.children[i], marshalizer, hasher)
+			encChild, err := getChildHash(bn.children[i], marshalizer, hasher)
 			if err != nil {
 				return nil, err
 			}
@@ -421,7 +354,7 @@ func (bn *branchNode) delete(key []byte, db data.DBWriteCacher, marshalizer mars
 
 	dirty, newNode, err := bn.children[childPos].delete(key, db, marshalizer)
 	if !dirty || err != nil {
-		return false, nil, err
+		return false, bn, err
 	}
 
 	bn.hash = nil
@@ -537,3 +470,39 @@ func (bn *branchNode) deepClone() node {
 
 	return clonedNode
 }
+
+func (bn *branchNode) iterateLeaves(
+	key []byte,
+	cursor []byte,
+	handler func(key []byte, value []byte) bool,
+	db data.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+) (bool, error) {
+	err := bn.isEmptyOrNil()
+	if err != nil {
+		return false, err
+	}
+
+	for i := range bn.children {
+		childKey := concat(key, byte(i))
+		if isBeforeCursor(childKey, cursor) {
+			continue
+		}
+
+		err = resolveIfCollapsed(bn, byte(i), db, marshalizer)
+		if err != nil {
+			return false, err
+		}
+
+		if bn.children[i] == nil {
+			continue
+		}
+
+		shouldContinue, err := bn.children[i].iterateLeaves(childKey, cursor, handler, db, marshalizer)
+		if err != nil || !shouldContinue {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
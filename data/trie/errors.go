@@ -33,3 +33,9 @@ var ErrEmptyNode = errors.New("the node is empty")
 
 // ErrNilNode is raised when we reach a nil node
 var ErrNilNode = errors.New("the node is nil")
+
+// ErrInvalidLength signals that the length of a hex key is invalid
+var ErrInvalidLength = errors.New("invalid length")
+
+// ErrNilLeafHandler signals that a nil leaf handler has been provided
+var ErrNilLeafHandler = errors.New("nil leaf handler")
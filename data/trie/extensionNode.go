@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 	"io"
-	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/trie/capnp"
@@ -121,15 +120,8 @@ func (en *extensionNode) setHash(marshalizer marshal.Marshalizer, hasher hashing
 	return nil
 }
 
-func (en *extensionNode) setHashConcurrent(marshalizer marshal.Marshalizer, hasher hashing.Hasher, wg *sync.WaitGroup, c chan error) {
-	err := en.setHash(marshalizer, hasher)
-	if err != nil {
-		c <- err
-	}
-	wg.Done()
-}
 func (en *extensionNode) setRootHash(marshalizer marshal.Marshalizer, hasher hashing.Hasher) error {
-	return en.setHash(marshalizer, hasher)
+	return setRootHashUsingWorkers(en, marshalizer, hasher)
 }
 
 func (en *extensionNode) hashChildren(marshalizer marshal.Marshalizer, hasher hashing.Hasher) error {
@@ -152,7 +144,7 @@ func (en *extensionNode) hashNode(marshalizer marshal.Marshalizer, hasher hashin
 		return nil, err
 	}
 	if en.child != nil {
-		encChild, err := encodeNodeAndGetHash(en.child, marshalizer, hasher)
+		encChild, err := getChildHash(en.child, marshalizer, hasher)
 		if err != nil {
 			return nil, err
 		}
@@ -289,7 +281,7 @@ func (en *extensionNode) insert(n *leafNode, db data.DBWriteCacher, marshalizer
 		n.Key = n.Key[keyMatchLen:]
 		dirty, newNode, err := en.child.insert(n, db, marshalizer)
 		if !dirty || err != nil {
-			return false, nil, err
+			return false, en, err
 		}
 		return true, newExtensionNode(en.Key, newNode), nil
 	}
@@ -417,3 +409,28 @@ func (en *extensionNode) deepClone() node {
 
 	return clonedNode
 }
+
+func (en *extensionNode) iterateLeaves(
+	key []byte,
+	cursor []byte,
+	handler func(key []byte, value []byte) bool,
+	db data.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+) (bool, error) {
+	err := en.isEmptyOrNil()
+	if err != nil {
+		return false, err
+	}
+
+	childKey := concat(key, en.Key...)
+	if isBeforeCursor(childKey, cursor) {
+		return true, nil
+	}
+
+	err = resolveIfCollapsed(en, 0, db, marshalizer)
+	if err != nil {
+		return false, err
+	}
+
+	return en.child.iterateLeaves(childKey, cursor, handler, db, marshalizer)
+}
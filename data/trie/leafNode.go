@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 	"io"
-	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/trie/capnp"
@@ -92,14 +91,6 @@ func (ln *leafNode) setHash(marshalizer marshal.Marshalizer, hasher hashing.Hash
 	return nil
 }
 
-func (ln *leafNode) setHashConcurrent(marshalizer marshal.Marshalizer, hasher hashing.Hasher, wg *sync.WaitGroup, c chan error) {
-	err := ln.setHash(marshalizer, hasher)
-	if err != nil {
-		c <- err
-	}
-	wg.Done()
-}
-
 func (ln *leafNode) setRootHash(marshalizer marshal.Marshalizer, hasher hashing.Hasher) error {
 	return ln.setHash(marshalizer, hasher)
 }
@@ -182,6 +173,10 @@ func (ln *leafNode) insert(n *leafNode, db data.DBWriteCacher, marshalizer marsh
 		return false, nil, err
 	}
 	if bytes.Equal(n.Key, ln.Key) {
+		if bytes.Equal(n.Value, ln.Value) {
+			return false, ln, nil
+		}
+
 		ln.Value = n.Value
 		ln.dirty = true
 		ln.hash = nil
@@ -272,3 +267,28 @@ func (ln *leafNode) deepClone() node {
 
 	return clonedNode
 }
+
+func (ln *leafNode) iterateLeaves(
+	key []byte,
+	cursor []byte,
+	handler func(key []byte, value []byte) bool,
+	_ data.DBWriteCacher,
+	_ marshal.Marshalizer,
+) (bool, error) {
+	err := ln.isEmptyOrNil()
+	if err != nil {
+		return false, err
+	}
+
+	hexKey := concat(key, ln.Key...)
+	if isBeforeCursor(hexKey, cursor) {
+		return true, nil
+	}
+
+	nodeKey, err := hexToKeyBytes(hexKey)
+	if err != nil {
+		return false, err
+	}
+
+	return handler(nodeKey, ln.Value), nil
+}
@@ -314,6 +314,22 @@ func TestLeafNode_insertAtSameKey(t *testing.T) {
 	assert.Equal(t, []byte("dogs"), val)
 }
 
+func TestLeafNode_insertSameValueShouldNotBeDirty(t *testing.T) {
+	t.Parallel()
+	db, _ := mock.NewMemDbMock()
+	ln := getLn()
+	ln.dirty = false
+
+	node := newLeafNode([]byte{100, 111, 103}, []byte("dog"))
+	marsh, _ := getTestMarshAndHasher()
+
+	dirty, newNode, err := ln.insert(node, db, marsh)
+	assert.False(t, dirty)
+	assert.Nil(t, err)
+	assert.True(t, ln == newNode)
+	assert.False(t, ln.isDirty())
+}
+
 func TestLeafNode_insertAtDifferentKey(t *testing.T) {
 	t.Parallel()
 	db, _ := mock.NewMemDbMock()
@@ -1,7 +1,9 @@
 package trie
 
 import (
+	"bytes"
 	"io"
+	"runtime"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -14,11 +16,11 @@ const nrOfChildren = 17
 const firstByte = 0
 const maxTrieLevelAfterCommit = 6
 const hexTerminator = 16
+const parallelHashingDepth = 2
 
 type node interface {
 	getHash() []byte
 	setHash(marshalizer marshal.Marshalizer, hasher hashing.Hasher) error
-	setHashConcurrent(marshalizer marshal.Marshalizer, hasher hashing.Hasher, wg *sync.WaitGroup, c chan error)
 	setRootHash(marshalizer marshal.Marshalizer, hasher hashing.Hasher) error
 	getCollapsed(marshalizer marshal.Marshalizer, hasher hashing.Hasher) (node, error) // a collapsed node is a node that instead of the children holds the children hashes
 	isCollapsed() bool
@@ -37,6 +39,7 @@ type node interface {
 	isEmptyOrNil() error
 	print(writer io.Writer, index int)
 	deepClone() node
+	iterateLeaves(key []byte, cursor []byte, handler func(key []byte, value []byte) bool, db data.DBWriteCacher, marshalizer marshal.Marshalizer) (bool, error)
 }
 
 type branchNode struct {
@@ -73,6 +76,103 @@ func hashChildrenAndNode(n node, marshalizer marshal.Marshalizer, hasher hashing
 	return hashed, nil
 }
 
+// setRootHashUsingWorkers computes the hash of the provided root node. The subtries left unchanged since they were
+// last hashed are skipped, the changed ones found parallelHashingDepth levels below the root are hashed by a pool of
+// workers and the few nodes above them are hashed afterwards, reusing the hashes of their children
+func setRootHashUsingWorkers(root node, marshalizer marshal.Marshalizer, hasher hashing.Hasher) error {
+	err := root.isEmptyOrNil()
+	if err != nil {
+		return err
+	}
+	if root.getHash() != nil {
+		return nil
+	}
+
+	subtries := make([]node, 0)
+	collectSubtriesToHash(root, 0, &subtries)
+
+	err = hashSubtriesUsingWorkers(subtries, runtime.NumCPU(), marshalizer, hasher)
+	if err != nil {
+		return err
+	}
+
+	return root.setHash(marshalizer, hasher)
+}
+
+func collectSubtriesToHash(n node, depth int, subtries *[]node) {
+	if n.getHash() != nil {
+		return
+	}
+	if depth == parallelHashingDepth {
+		*subtries = append(*subtries, n)
+		return
+	}
+
+	switch n := n.(type) {
+	case *branchNode:
+		for _, child := range n.children {
+			if child != nil {
+				collectSubtriesToHash(child, depth+1, subtries)
+			}
+		}
+	case *extensionNode:
+		if n.child != nil {
+			collectSubtriesToHash(n.child, depth+1, subtries)
+		}
+	}
+}
+
+func hashSubtriesUsingWorkers(
+	subtries []node,
+	numWorkers int,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) error {
+	if numWorkers > len(subtries) {
+		numWorkers = len(subtries)
+	}
+
+	jobs := make(chan node, len(subtries))
+	for _, subtrie := range subtries {
+		jobs <- subtrie
+	}
+	close(jobs)
+
+	errc := make(chan error, numWorkers)
+	wg := &sync.WaitGroup{}
+	wg.Add(numWorkers)
+	for i := 0; i < numWorkers; i++ {
+		go func() {
+			defer wg.Done()
+
+			for subtrie := range jobs {
+				err := subtrie.setHash(marshalizer, hasher)
+				if err != nil {
+					errc <- err
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(errc) != 0 {
+		return <-errc
+	}
+
+	return nil
+}
+
+// getChildHash returns the hash of the child, computing it only if it was not already computed
+func getChildHash(child node, marshalizer marshal.Marshalizer, hasher hashing.Hasher) ([]byte, error) {
+	childHash := child.getHash()
+	if childHash != nil {
+		return childHash, nil
+	}
+
+	return encodeNodeAndGetHash(child, marshalizer, hasher)
+}
+
 func encodeNodeAndGetHash(n node, marshalizer marshal.Marshalizer, hasher hashing.Hasher) ([]byte, error) {
 	encNode, err := n.getEncodedNode(marshalizer)
 	if err != nil {
@@ -183,6 +283,34 @@ func decodeNode(encNode []byte, marshalizer marshal.Marshalizer) (node, error) {
 	return node, nil
 }
 
+// ChildrenHashes returns the hashes of the children of the provided encoded node, as it is kept in the trie storage.
+// A leaf node has no children
+func ChildrenHashes(encodedNode []byte, marshalizer marshal.Marshalizer) ([][]byte, error) {
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+
+	decNode, err := decodeNode(encodedNode, marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n := decNode.(type) {
+	case *branchNode:
+		hashes := make([][]byte, 0, nrOfChildren)
+		for _, childHash := range n.EncodedChildren {
+			if len(childHash) > 0 {
+				hashes = append(hashes, childHash)
+			}
+		}
+		return hashes, nil
+	case *extensionNode:
+		return [][]byte{n.EncodedChild}, nil
+	default:
+		return nil, nil
+	}
+}
+
 func getEmptyNodeOfType(t byte) (node, error) {
 	var decNode node
 	switch t {
@@ -215,6 +343,37 @@ func keyBytesToHex(str []byte) []byte {
 	return nibbles
 }
 
+// hexToKeyBytes transforms hex nibbles into key bytes
+func hexToKeyBytes(hex []byte) ([]byte, error) {
+	hexLength := len(hex)
+	if hexLength > 0 && hex[hexLength-1] == hexTerminator {
+		hexLength--
+	}
+	if hexLength%2 != 0 {
+		return nil, ErrInvalidLength
+	}
+
+	key := make([]byte, hexLength/2)
+	for i := 0; i < hexLength; i += 2 {
+		key[i/2] = hex[i]*hexTerminator + hex[i+1]
+	}
+
+	return key, nil
+}
+
+// isBeforeCursor returns true if all the keys placed under the given hex path precede or equal the hex cursor.
+// An empty cursor is placed before any key
+func isBeforeCursor(path []byte, cursor []byte) bool {
+	if len(cursor) == 0 {
+		return false
+	}
+	if len(path) >= len(cursor) {
+		return bytes.Compare(path[:len(cursor)], cursor) <= 0
+	}
+
+	return bytes.Compare(path, cursor[:len(path)]) < 0
+}
+
 // prefixLen returns the length of the common prefix of a and b.
 func prefixLen(a, b []byte) int {
 	i := 0
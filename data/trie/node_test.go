@@ -1,6 +1,7 @@
 package trie
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/data/mock"
@@ -348,6 +349,53 @@ func TestNode_decodeNodeInvalidEncoding(t *testing.T) {
 	assert.Equal(t, ErrInvalidEncoding, err)
 }
 
+func TestNode_ChildrenHashesNilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hashes, err := ChildrenHashes([]byte("encoded node"), nil)
+	assert.Nil(t, hashes)
+	assert.Equal(t, ErrNilMarshalizer, err)
+}
+
+func TestNode_ChildrenHashesBranchNode(t *testing.T) {
+	t.Parallel()
+	marsh, _ := getTestMarshAndHasher()
+	_, collapsedBn := getBnAndCollapsedBn()
+
+	encNode, _ := collapsedBn.getEncodedNode(marsh)
+
+	hashes, err := ChildrenHashes(encNode, marsh)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{
+		collapsedBn.EncodedChildren[2],
+		collapsedBn.EncodedChildren[6],
+		collapsedBn.EncodedChildren[13],
+	}, hashes)
+}
+
+func TestNode_ChildrenHashesExtensionNode(t *testing.T) {
+	t.Parallel()
+	marsh, _ := getTestMarshAndHasher()
+	_, collapsedEn := getEnAndCollapsedEn()
+
+	encNode, _ := collapsedEn.getEncodedNode(marsh)
+
+	hashes, err := ChildrenHashes(encNode, marsh)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{collapsedEn.EncodedChild}, hashes)
+}
+
+func TestNode_ChildrenHashesLeafNode(t *testing.T) {
+	t.Parallel()
+	marsh, _ := getTestMarshAndHasher()
+
+	encNode, _ := getLn().getEncodedNode(marsh)
+
+	hashes, err := ChildrenHashes(encNode, marsh)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(hashes))
+}
+
 func TestNode_getEmptyNodeOfTypeBranchNode(t *testing.T) {
 	t.Parallel()
 	bn, err := getEmptyNodeOfType(branch)
@@ -429,3 +477,109 @@ func TestPrefixLen(t *testing.T) {
 		assert.Equal(t, test[i].length, prefixLen(test[i].a, test[i].b))
 	}
 }
+
+func createTrieWithValues(nrValues int) *patriciaMerkleTrie {
+	tr := newEmptyTrie().(*patriciaMerkleTrie)
+	for i := 0; i < nrValues; i++ {
+		key := []byte(strconv.Itoa(i))
+		_ = tr.Update(key, key)
+	}
+
+	return tr
+}
+
+func TestNode_setRootHashUsingWorkersShouldComputeTheSameHashAsSetHash(t *testing.T) {
+	t.Parallel()
+	marsh, hasher := getTestMarshAndHasher()
+	tr1 := createTrieWithValues(1000)
+	tr2 := createTrieWithValues(1000)
+
+	err := tr1.root.setHash(marsh, hasher)
+	assert.Nil(t, err)
+	err = setRootHashUsingWorkers(tr2.root, marsh, hasher)
+	assert.Nil(t, err)
+
+	assert.Equal(t, tr1.root.getHash(), tr2.root.getHash())
+}
+
+func TestNode_setRootHashUsingWorkersAfterChangingAValueShouldComputeTheSameHashAsSetHash(t *testing.T) {
+	t.Parallel()
+	marsh, hasher := getTestMarshAndHasher()
+	tr1 := createTrieWithValues(1000)
+	tr2 := createTrieWithValues(1000)
+	_ = setRootHashUsingWorkers(tr2.root, marsh, hasher)
+
+	_ = tr1.Update([]byte("500"), []byte("changed"))
+	_ = tr2.Update([]byte("500"), []byte("changed"))
+
+	err := tr1.root.setHash(marsh, hasher)
+	assert.Nil(t, err)
+	err = setRootHashUsingWorkers(tr2.root, marsh, hasher)
+	assert.Nil(t, err)
+
+	assert.Equal(t, tr1.root.getHash(), tr2.root.getHash())
+}
+
+func TestNode_collectSubtriesToHashShouldSkipTheHashedSubtries(t *testing.T) {
+	t.Parallel()
+	marsh, hasher := getTestMarshAndHasher()
+	bn, _ := getBnAndCollapsedBn()
+	_ = bn.children[2].setHash(marsh, hasher)
+
+	subtries := make([]node, 0)
+	collectSubtriesToHash(bn, parallelHashingDepth-1, &subtries)
+
+	assert.Equal(t, 2, len(subtries))
+	assert.True(t, bn.children[6] == subtries[0])
+	assert.True(t, bn.children[13] == subtries[1])
+}
+
+func TestNode_hashSubtriesUsingWorkersShouldErr(t *testing.T) {
+	t.Parallel()
+	marsh, hasher := getTestMarshAndHasher()
+	subtries := []node{getLn(), &leafNode{}, getLn()}
+
+	err := hashSubtriesUsingWorkers(subtries, 2, marsh, hasher)
+
+	assert.Equal(t, ErrEmptyNode, err)
+}
+
+func TestNode_hashSubtriesUsingWorkersShouldHashAllTheSubtries(t *testing.T) {
+	t.Parallel()
+	marsh, hasher := getTestMarshAndHasher()
+	subtries := []node{getLn(), getLn(), getLn()}
+
+	err := hashSubtriesUsingWorkers(subtries, 2, marsh, hasher)
+
+	assert.Nil(t, err)
+	for _, subtrie := range subtries {
+		assert.NotNil(t, subtrie.getHash())
+	}
+}
+
+func TestNode_updateWithTheSameValueShouldNotDirtyTheTrie(t *testing.T) {
+	t.Parallel()
+	tr := createTrieWithValues(100)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	_ = tr.Update([]byte("50"), []byte("50"))
+
+	assert.False(t, tr.root.isDirty())
+	newRootHash, _ := tr.Root()
+	assert.Equal(t, rootHash, newRootHash)
+}
+
+func TestNode_deleteMissingKeyShouldKeepTheTrie(t *testing.T) {
+	t.Parallel()
+	tr := createTrieWithValues(100)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	err := tr.Delete([]byte("missing key"))
+
+	assert.Nil(t, err)
+	assert.False(t, tr.root.isDirty())
+	newRootHash, _ := tr.Root()
+	assert.Equal(t, rootHash, newRootHash)
+}
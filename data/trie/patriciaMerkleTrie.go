@@ -273,6 +273,30 @@ func (tr *patriciaMerkleTrie) DeepClone() (data.Trie, error) {
 	return clonedTrie, nil
 }
 
+// IterateLeaves walks the trie leaves in key order, starting right after the provided key, and calls the handler
+// for each of them until the handler returns false. An empty start key begins the walk from the first leaf
+func (tr *patriciaMerkleTrie) IterateLeaves(startAfterKey []byte, handler func(key []byte, value []byte) bool) error {
+	if handler == nil {
+		return ErrNilLeafHandler
+	}
+
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil
+	}
+
+	var cursor []byte
+	if len(startAfterKey) > 0 {
+		cursor = keyBytesToHex(startAfterKey)
+	}
+
+	_, err := tr.root.iterateLeaves([]byte{}, cursor, handler, tr.db, tr.marshalizer)
+
+	return err
+}
+
 // String outputs a graphical view of the trie. Mainly used in tests/debugging
 func (tr *patriciaMerkleTrie) String() string {
 	writer := bytes.NewBuffer(make([]byte, 0))
@@ -1,6 +1,8 @@
 package trie_test
 
 import (
+	"bytes"
+	"sort"
 	"strconv"
 	"testing"
 
@@ -662,3 +664,175 @@ func BenchmarkPatriciaMerkleTrie_RootHashAfterChanging30000NodesInBatchesOf200(b
 		}
 	}
 }
+
+func TestPatriciaMerkleTrie_IterateLeavesNotCommittedTrieShouldWork(t *testing.T) {
+	tr := initTrie()
+
+	leaves := make(map[string][]byte)
+	err := tr.IterateLeaves(nil, func(key []byte, value []byte) bool {
+		leaves[string(key)] = value
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(leaves))
+	assert.Equal(t, []byte("reindeer"), leaves["doe"])
+	assert.Equal(t, []byte("puppy"), leaves["dog"])
+	assert.Equal(t, []byte("cat"), leaves["dogglesworth"])
+}
+
+func collapsedTrieWithSortedKeys(nr int) (data.Trie, [][]byte) {
+	tr, values := initTrieMultipleValues(nr)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	collapsedTrie, _ := tr.Recreate(rootHash)
+
+	sort.Slice(values, func(i, j int) bool {
+		return bytes.Compare(values[i], values[j]) < 0
+	})
+
+	return collapsedTrie, values
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesNilHandlerShouldErr(t *testing.T) {
+	tr := initTrie()
+
+	err := tr.IterateLeaves(nil, nil)
+
+	assert.Equal(t, trie.ErrNilLeafHandler, err)
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesEmptyTrieShouldNotCallHandler(t *testing.T) {
+	db, _ := mock.NewMemDbMock()
+	tr, _ := trie.NewTrie(db, marshalizer, hasher)
+
+	err := tr.IterateLeaves(nil, func(key []byte, value []byte) bool {
+		assert.Fail(t, "should have not been called")
+		return true
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesShouldVisitAllLeavesInOrder(t *testing.T) {
+	tr, values := collapsedTrieWithSortedKeys(20)
+
+	visited := make([][]byte, 0)
+	err := tr.IterateLeaves(nil, func(key []byte, value []byte) bool {
+		assert.Equal(t, key, value)
+		visited = append(visited, key)
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, values, visited)
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesShouldStartAfterKey(t *testing.T) {
+	tr, values := collapsedTrieWithSortedKeys(20)
+
+	visited := make([][]byte, 0)
+	err := tr.IterateLeaves(values[7], func(key []byte, value []byte) bool {
+		visited = append(visited, key)
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, values[8:], visited)
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesStartKeyNotInTrieShouldWork(t *testing.T) {
+	tr, values := collapsedTrieWithSortedKeys(20)
+
+	startAfter := append([]byte{}, values[4]...)
+	startAfter[len(startAfter)-1]++
+
+	visited := make([][]byte, 0)
+	err := tr.IterateLeaves(startAfter, func(key []byte, value []byte) bool {
+		visited = append(visited, key)
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, values[5:], visited)
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesShouldStopWhenHandlerReturnsFalse(t *testing.T) {
+	tr, values := collapsedTrieWithSortedKeys(20)
+
+	visited := make([][]byte, 0)
+	err := tr.IterateLeaves(nil, func(key []byte, value []byte) bool {
+		visited = append(visited, key)
+		return len(visited) < 5
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, values[:5], visited)
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesResumedWalksShouldCoverAllLeaves(t *testing.T) {
+	tr, values := collapsedTrieWithSortedKeys(50)
+
+	visited := make([][]byte, 0)
+	var cursor []byte
+	for {
+		numVisited := 0
+		err := tr.IterateLeaves(cursor, func(key []byte, value []byte) bool {
+			visited = append(visited, key)
+			cursor = key
+			numVisited++
+			return numVisited < 7
+		})
+		assert.Nil(t, err)
+
+		if numVisited < 7 {
+			break
+		}
+	}
+
+	assert.Equal(t, values, visited)
+}
+
+func benchmarkCommitAfterChangingAccounts(b *testing.B, nrOfValuesToModify int, sameValues bool) {
+	tr := emptyTrie()
+	hsh := keccak.Keccak{}
+
+	nrValuesInTrie := 200000
+	values := make([][]byte, nrValuesInTrie)
+
+	for i := 0; i < nrValuesInTrie; i++ {
+		key := hsh.Compute(strconv.Itoa(i))
+		value := append(key, []byte(strconv.Itoa(i))...)
+
+		_ = tr.Update(key, value)
+		values[i] = key
+	}
+	_ = tr.Commit()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for j := 0; j < nrOfValuesToModify; j++ {
+			value := append(values[j], []byte(strconv.Itoa(j))...)
+			if !sameValues {
+				value = append(value, []byte(strconv.Itoa(i))...)
+			}
+			_ = tr.Update(values[j], value)
+		}
+		b.StartTimer()
+
+		_ = tr.Commit()
+	}
+}
+
+func BenchmarkPatriciaMerkleTrie_CommitAfterChanging5000Accounts(b *testing.B) {
+	benchmarkCommitAfterChangingAccounts(b, 5000, false)
+}
+
+func BenchmarkPatriciaMerkleTrie_CommitAfterChanging30000Accounts(b *testing.B) {
+	benchmarkCommitAfterChangingAccounts(b, 30000, false)
+}
+
+func BenchmarkPatriciaMerkleTrie_CommitAfterRewriting5000AccountsWithTheSameValues(b *testing.B) {
+	benchmarkCommitAfterChangingAccounts(b, 5000, true)
+}
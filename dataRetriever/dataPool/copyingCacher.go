@@ -0,0 +1,97 @@
+package dataPool
+
+import (
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// copyingCacher decorates a cacher so that the values are deep copied when added and when read. The object held by
+// the cache is never handed out, so a component altering the object it got from the pool, or the one it has just
+// added, does not change what the other components see. A value that can not be copied is neither added nor returned
+type copyingCacher struct {
+	storage.Cacher
+	cloner dataRetriever.DataCloner
+}
+
+// NewCopyingCacher creates a new cacher that deep copies the values stored in the provided cacher
+func NewCopyingCacher(cacher storage.Cacher, cloner dataRetriever.DataCloner) (*copyingCacher, error) {
+	if cacher == nil || cacher.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilCacher
+	}
+	if cloner == nil || cloner.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilDataCloner
+	}
+
+	return &copyingCacher{
+		Cacher: cacher,
+		cloner: cloner,
+	}, nil
+}
+
+// Put adds a copy of the value to the cache. Returns true if an eviction occurred
+func (cc *copyingCacher) Put(key []byte, value interface{}) (evicted bool) {
+	clone, err := cc.cloner.Clone(value)
+	if err != nil {
+		log.Debug("copying cacher put: " + err.Error())
+		return false
+	}
+
+	return cc.Cacher.Put(key, clone)
+}
+
+// HasOrAdd adds a copy of the value to the cache if the key is not already there
+func (cc *copyingCacher) HasOrAdd(key []byte, value interface{}) (ok, evicted bool) {
+	if cc.Cacher.Has(key) {
+		return true, false
+	}
+
+	clone, err := cc.cloner.Clone(value)
+	if err != nil {
+		log.Debug("copying cacher has or add: " + err.Error())
+		return false, false
+	}
+
+	return cc.Cacher.HasOrAdd(key, clone)
+}
+
+// Get returns a copy of the value found under the key
+func (cc *copyingCacher) Get(key []byte) (value interface{}, ok bool) {
+	value, ok = cc.Cacher.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	return cc.cloneOut(value)
+}
+
+// Peek returns a copy of the value found under the key, without updating the "recently used"-ness of the key
+func (cc *copyingCacher) Peek(key []byte) (value interface{}, ok bool) {
+	value, ok = cc.Cacher.Peek(key)
+	if !ok {
+		return nil, false
+	}
+
+	return cc.cloneOut(value)
+}
+
+func (cc *copyingCacher) cloneOut(value interface{}) (interface{}, bool) {
+	if value == nil {
+		return nil, true
+	}
+
+	clone, err := cc.cloner.Clone(value)
+	if err != nil {
+		log.Debug("copying cacher read: " + err.Error())
+		return nil, false
+	}
+
+	return clone, true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cc *copyingCacher) IsInterfaceNil() bool {
+	if cc == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+package dataPool_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+)
+
+func createCopyingCacher() (storage.Cacher, storage.Cacher) {
+	cacher, _ := storageUnit.NewCache(storageUnit.LRUCache, 100, 1)
+	cloner, _ := dataPool.NewMarshalizerCloner(&mock.MarshalizerMock{})
+	copyingCacher, _ := dataPool.NewCopyingCacher(cacher, cloner)
+
+	return copyingCacher, cacher
+}
+
+func TestNewCopyingCacher_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cloner, _ := dataPool.NewMarshalizerCloner(&mock.MarshalizerMock{})
+
+	cc, err := dataPool.NewCopyingCacher(nil, cloner)
+	assert.Nil(t, cc)
+	assert.Equal(t, dataRetriever.ErrNilCacher, err)
+
+	cc, err = dataPool.NewCopyingCacher(&mock.CacherStub{}, nil)
+	assert.Nil(t, cc)
+	assert.Equal(t, dataRetriever.ErrNilDataCloner, err)
+}
+
+func TestCopyingCacher_PutShouldStoreACopy(t *testing.T) {
+	t.Parallel()
+
+	cc, cacher := createCopyingCacher()
+	hdr := createPooledHeader()
+
+	_ = cc.Put([]byte("key"), hdr)
+	hdr.Nonce = 8
+
+	stored, ok := cacher.Peek([]byte("key"))
+	assert.True(t, ok)
+	assert.Equal(t, createPooledHeader(), stored)
+	assert.False(t, stored == hdr)
+}
+
+func TestCopyingCacher_HasOrAddShouldStoreACopyOnlyOnce(t *testing.T) {
+	t.Parallel()
+
+	cc, cacher := createCopyingCacher()
+	hdr := createPooledHeader()
+
+	ok, _ := cc.HasOrAdd([]byte("key"), hdr)
+	assert.False(t, ok)
+	hdr.Nonce = 8
+
+	ok, _ = cc.HasOrAdd([]byte("key"), hdr)
+	assert.True(t, ok)
+
+	stored, _ := cacher.Peek([]byte("key"))
+	assert.Equal(t, uint64(7), stored.(*block.Header).Nonce)
+}
+
+func TestCopyingCacher_GetAndPeekShouldReturnCopies(t *testing.T) {
+	t.Parallel()
+
+	cc, cacher := createCopyingCacher()
+	_ = cc.Put([]byte("key"), createPooledHeader())
+
+	value, ok := cc.Get([]byte("key"))
+	assert.True(t, ok)
+	value.(*block.Header).RootHash[0] = 'R'
+
+	value, ok = cc.Peek([]byte("key"))
+	assert.True(t, ok)
+	value.(*block.Header).Nonce = 8
+
+	stored, _ := cacher.Peek([]byte("key"))
+	assert.Equal(t, createPooledHeader(), stored)
+
+	value, ok = cc.Get([]byte("missing key"))
+	assert.Nil(t, value)
+	assert.False(t, ok)
+}
+
+func TestCopyingCacher_CloneErrorsShouldNotAddNorReturn(t *testing.T) {
+	t.Parallel()
+
+	putCalled := false
+	cacher := &mock.CacherStub{
+		PutCalled: func(key []byte, value interface{}) (evicted bool) {
+			putCalled = true
+			return false
+		},
+		PeekCalled: func(key []byte) (value interface{}, ok bool) {
+			return createPooledHeader(), true
+		},
+	}
+	cc, _ := dataPool.NewCopyingCacher(cacher, &mock.DataClonerStub{
+		CloneCalled: func(value interface{}) (interface{}, error) {
+			return nil, errors.New("clone error")
+		},
+	})
+
+	_ = cc.Put([]byte("key"), createPooledHeader())
+	assert.False(t, putCalled)
+
+	value, ok := cc.Peek([]byte("key"))
+	assert.Nil(t, value)
+	assert.False(t, ok)
+}
+
+func TestCopyingCacher_OtherOperationsShouldBeForwarded(t *testing.T) {
+	t.Parallel()
+
+	cc, cacher := createCopyingCacher()
+	_ = cc.Put([]byte("key1"), createPooledHeader())
+	_ = cc.Put([]byte("key2"), createPooledHeader())
+
+	assert.Equal(t, 2, cc.Len())
+	assert.True(t, cc.Has([]byte("key1")))
+	assert.Equal(t, cacher.Keys(), cc.Keys())
+
+	cc.Remove([]byte("key1"))
+	assert.False(t, cacher.Has([]byte("key1")))
+	assert.False(t, cc.IsInterfaceNil())
+}
+
+func benchmarkCacherPeek(b *testing.B, cacher storage.Cacher, numTxs int) {
+	keys := make([][]byte, numTxs)
+	for i := 0; i < numTxs; i++ {
+		keys[i] = []byte(fmt.Sprintf("tx%d", i))
+		tx := createPooledTransaction()
+		tx.Nonce = uint64(i)
+		_ = cacher.Put(keys[i], tx)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, key := range keys {
+			value, _ := cacher.Peek(key)
+			_ = value.(*transaction.Transaction)
+		}
+	}
+}
+
+func BenchmarkCacher_PeekThousandTxsWithoutCopies(b *testing.B) {
+	cacher, _ := storageUnit.NewCache(storageUnit.LRUCache, 1000, 1)
+
+	benchmarkCacherPeek(b, cacher, 1000)
+}
+
+func BenchmarkCopyingCacher_PeekThousandTxsJson(b *testing.B) {
+	cacher, _ := storageUnit.NewCache(storageUnit.LRUCache, 1000, 1)
+	cloner, _ := dataPool.NewMarshalizerCloner(&marshal.JsonMarshalizer{})
+	cc, _ := dataPool.NewCopyingCacher(cacher, cloner)
+
+	benchmarkCacherPeek(b, cc, 1000)
+}
+
+func BenchmarkCopyingCacher_PeekThousandTxsCapnp(b *testing.B) {
+	cacher, _ := storageUnit.NewCache(storageUnit.LRUCache, 1000, 1)
+	cloner, _ := dataPool.NewMarshalizerCloner(&marshal.CapnpMarshalizer{})
+	cc, _ := dataPool.NewCopyingCacher(cacher, cloner)
+
+	benchmarkCacherPeek(b, cc, 1000)
+}
@@ -0,0 +1,100 @@
+package dataPool
+
+import (
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// copyingShardedData decorates a sharded data pool so that the data is deep copied when added and when read, either
+// directly or through the shard data stores
+type copyingShardedData struct {
+	dataRetriever.ShardedDataCacherNotifier
+	cloner dataRetriever.DataCloner
+}
+
+// NewCopyingShardedData creates a new sharded data pool that deep copies the data held by the provided one
+func NewCopyingShardedData(
+	shardedData dataRetriever.ShardedDataCacherNotifier,
+	cloner dataRetriever.DataCloner,
+) (*copyingShardedData, error) {
+	if shardedData == nil || shardedData.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilShardedDataCacherNotifier
+	}
+	if cloner == nil || cloner.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilDataCloner
+	}
+
+	return &copyingShardedData{
+		ShardedDataCacherNotifier: shardedData,
+		cloner:                    cloner,
+	}, nil
+}
+
+// ShardDataStore returns the shard data store associated with the cacheId, copying the values it holds
+func (csd *copyingShardedData) ShardDataStore(cacheId string) (c storage.Cacher) {
+	dataStore := csd.ShardedDataCacherNotifier.ShardDataStore(cacheId)
+	if dataStore == nil || dataStore.IsInterfaceNil() {
+		return nil
+	}
+
+	return &copyingCacher{
+		Cacher: dataStore,
+		cloner: csd.cloner,
+	}
+}
+
+// AddData adds a copy of the data to the corresponding shard store
+func (csd *copyingShardedData) AddData(key []byte, data interface{}, cacheId string) {
+	clone, err := csd.cloner.Clone(data)
+	if err != nil {
+		log.Debug("copying sharded data add: " + err.Error())
+		return
+	}
+
+	csd.ShardedDataCacherNotifier.AddData(key, clone, cacheId)
+}
+
+// SearchFirstData searches the key against all shard data stores, returning a copy of the first value found
+func (csd *copyingShardedData) SearchFirstData(key []byte) (value interface{}, ok bool) {
+	value, ok = csd.ShardedDataCacherNotifier.SearchFirstData(key)
+	if !ok || value == nil {
+		return value, ok
+	}
+
+	clone, err := csd.cloner.Clone(value)
+	if err != nil {
+		log.Debug("copying sharded data read: " + err.Error())
+		return nil, false
+	}
+
+	return clone, true
+}
+
+// NumUniqueData returns the number of distinct data held by the decorated pool, if it keeps this accounting
+func (csd *copyingShardedData) NumUniqueData() int {
+	uniqueCounter, ok := csd.ShardedDataCacherNotifier.(dataRetriever.ShardedDataUniqueCounter)
+	if !ok {
+		return 0
+	}
+
+	return uniqueCounter.NumUniqueData()
+}
+
+// NumCrossShardDuplicates returns the number of cross shard duplicates of the decorated pool, if it keeps this
+// accounting
+func (csd *copyingShardedData) NumCrossShardDuplicates() uint64 {
+	uniqueCounter, ok := csd.ShardedDataCacherNotifier.(dataRetriever.ShardedDataUniqueCounter)
+	if !ok {
+		return 0
+	}
+
+	return uniqueCounter.NumCrossShardDuplicates()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (csd *copyingShardedData) IsInterfaceNil() bool {
+	if csd == nil {
+		return true
+	}
+	return false
+}
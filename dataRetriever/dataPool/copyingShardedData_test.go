@@ -0,0 +1,104 @@
+package dataPool_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+)
+
+func createCopyingShardedData() (dataRetriever.ShardedDataCacherNotifier, dataRetriever.ShardedDataCacherNotifier) {
+	sd, _ := shardedData.NewShardedData(storageUnit.CacheConfig{Size: 100, Type: storageUnit.LRUCache})
+	cloner, _ := dataPool.NewMarshalizerCloner(&mock.MarshalizerMock{})
+	csd, _ := dataPool.NewCopyingShardedData(sd, cloner)
+
+	return csd, sd
+}
+
+func TestNewCopyingShardedData_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cloner, _ := dataPool.NewMarshalizerCloner(&mock.MarshalizerMock{})
+
+	csd, err := dataPool.NewCopyingShardedData(nil, cloner)
+	assert.Nil(t, csd)
+	assert.Equal(t, dataRetriever.ErrNilShardedDataCacherNotifier, err)
+
+	csd, err = dataPool.NewCopyingShardedData(&mock.ShardedDataStub{}, nil)
+	assert.Nil(t, csd)
+	assert.Equal(t, dataRetriever.ErrNilDataCloner, err)
+}
+
+func TestCopyingShardedData_AddDataShouldStoreACopy(t *testing.T) {
+	t.Parallel()
+
+	csd, sd := createCopyingShardedData()
+	tx := createPooledTransaction()
+
+	csd.AddData([]byte("key"), tx, "0")
+	tx.Nonce = 4
+
+	stored, ok := sd.ShardDataStore("0").Peek([]byte("key"))
+	assert.True(t, ok)
+	assert.Equal(t, createPooledTransaction(), stored)
+}
+
+func TestCopyingShardedData_ReadsShouldReturnCopies(t *testing.T) {
+	t.Parallel()
+
+	csd, sd := createCopyingShardedData()
+	csd.AddData([]byte("key"), createPooledTransaction(), "0")
+
+	value, ok := csd.SearchFirstData([]byte("key"))
+	assert.True(t, ok)
+	value.(*transaction.Transaction).Nonce = 4
+
+	value, ok = csd.ShardDataStore("0").Peek([]byte("key"))
+	assert.True(t, ok)
+	value.(*transaction.Transaction).SndAddr[0] = 'S'
+
+	stored, _ := sd.ShardDataStore("0").Peek([]byte("key"))
+	assert.Equal(t, createPooledTransaction(), stored)
+
+	value, ok = csd.SearchFirstData([]byte("missing key"))
+	assert.Nil(t, value)
+	assert.False(t, ok)
+}
+
+func TestCopyingShardedData_ShardDataStoreMissingShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	csd, _ := dataPool.NewCopyingShardedData(
+		&mock.ShardedDataStub{
+			ShardDataStoreCalled: func(cacheId string) (c storage.Cacher) {
+				return nil
+			},
+		},
+		&mock.DataClonerStub{},
+	)
+
+	assert.Nil(t, csd.ShardDataStore("0"))
+}
+
+func TestCopyingShardedData_ShouldForwardTheUniqueCounters(t *testing.T) {
+	t.Parallel()
+
+	csd, _ := createCopyingShardedData()
+	csd.AddData([]byte("key"), createPooledTransaction(), "0")
+	csd.AddData([]byte("key"), createPooledTransaction(), "1")
+
+	uniqueCounter := csd.(dataRetriever.ShardedDataUniqueCounter)
+	assert.Equal(t, 1, uniqueCounter.NumUniqueData())
+	assert.Equal(t, uint64(1), uniqueCounter.NumCrossShardDuplicates())
+
+	csd, _ = dataPool.NewCopyingShardedData(&mock.ShardedDataStub{}, &mock.DataClonerStub{})
+	uniqueCounter = csd.(dataRetriever.ShardedDataUniqueCounter)
+	assert.Equal(t, 0, uniqueCounter.NumUniqueData())
+	assert.Equal(t, uint64(0), uniqueCounter.NumCrossShardDuplicates())
+}
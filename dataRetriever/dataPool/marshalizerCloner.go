@@ -0,0 +1,80 @@
+package dataPool
+
+import (
+	"reflect"
+
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// marshalizerCloner deep copies an object by marshalizing it and unmarshalizing the result into a new object of the
+// same type. Any object that can travel through the network can be cloned this way, whatever its fields are
+type marshalizerCloner struct {
+	marshalizer marshal.Marshalizer
+}
+
+// NewMarshalizerCloner creates a new data cloner based on the provided marshalizer
+func NewMarshalizerCloner(marshalizer marshal.Marshalizer) (*marshalizerCloner, error) {
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilMarshalizer
+	}
+
+	return &marshalizerCloner{
+		marshalizer: marshalizer,
+	}, nil
+}
+
+// Clone returns a deep copy of the provided value, having the same type. Both pointers to objects and objects are
+// accepted, as the pools may hold any of them
+func (mc *marshalizerCloner) Clone(value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, dataRetriever.ErrNilValue
+	}
+
+	if buff, ok := value.([]byte); ok {
+		return append(make([]byte, 0, len(buff)), buff...), nil
+	}
+
+	valueType := reflect.TypeOf(value)
+	isPointer := valueType.Kind() == reflect.Ptr
+	if isPointer && reflect.ValueOf(value).IsNil() {
+		return nil, dataRetriever.ErrNilValue
+	}
+
+	objToMarshal := value
+	if !isPointer {
+		// the marshalizers may expect pointers, so a pointer to a shallow copy is marshalized instead
+		ptr := reflect.New(valueType)
+		ptr.Elem().Set(reflect.ValueOf(value))
+		objToMarshal = ptr.Interface()
+	}
+
+	buff, err := mc.marshalizer.Marshal(objToMarshal)
+	if err != nil {
+		return nil, err
+	}
+
+	objType := valueType
+	if isPointer {
+		objType = valueType.Elem()
+	}
+	clone := reflect.New(objType)
+	err = mc.marshalizer.Unmarshal(clone.Interface(), buff)
+	if err != nil {
+		return nil, err
+	}
+
+	if isPointer {
+		return clone.Interface(), nil
+	}
+
+	return clone.Elem().Interface(), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mc *marshalizerCloner) IsInterfaceNil() bool {
+	if mc == nil {
+		return true
+	}
+	return false
+}
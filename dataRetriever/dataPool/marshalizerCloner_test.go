@@ -0,0 +1,177 @@
+package dataPool_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/stretchr/testify/assert"
+)
+
+func createPooledHeader() *block.Header {
+	return &block.Header{
+		Nonce:    7,
+		PrevHash: []byte("prev hash"),
+		RootHash: []byte("root hash"),
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: []byte("mb hash"), SenderShardID: 0, ReceiverShardID: 1, TxCount: 2},
+		},
+		MetaBlockHashes: [][]byte{[]byte("meta hash")},
+		AccumulatedFees: big.NewInt(100),
+	}
+}
+
+func createPooledTransaction() *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:     3,
+		Value:     big.NewInt(1000),
+		SndAddr:   []byte("sender"),
+		RcvAddr:   []byte("receiver"),
+		GasPrice:  10,
+		GasLimit:  100,
+		Data:      "data",
+		Signature: []byte("signature"),
+	}
+}
+
+func TestNewMarshalizerCloner_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mc, err := dataPool.NewMarshalizerCloner(nil)
+
+	assert.Nil(t, mc)
+	assert.Equal(t, dataRetriever.ErrNilMarshalizer, err)
+}
+
+func TestMarshalizerCloner_CloneNilValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mc, _ := dataPool.NewMarshalizerCloner(&mock.MarshalizerMock{})
+
+	clone, err := mc.Clone(nil)
+	assert.Nil(t, clone)
+	assert.Equal(t, dataRetriever.ErrNilValue, err)
+
+	var nilHeader *block.Header
+	clone, err = mc.Clone(nilHeader)
+	assert.Nil(t, clone)
+	assert.Equal(t, dataRetriever.ErrNilValue, err)
+}
+
+func TestMarshalizerCloner_CloneMarshalizerErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mc, _ := dataPool.NewMarshalizerCloner(&mock.MarshalizerMock{Fail: true})
+
+	clone, err := mc.Clone(createPooledHeader())
+
+	assert.Nil(t, clone)
+	assert.NotNil(t, err)
+}
+
+func TestMarshalizerCloner_ClonePointerShouldDeepCopy(t *testing.T) {
+	t.Parallel()
+
+	mc, _ := dataPool.NewMarshalizerCloner(&mock.MarshalizerMock{})
+	hdr := createPooledHeader()
+
+	clone, err := mc.Clone(hdr)
+	assert.Nil(t, err)
+
+	hdrClone, ok := clone.(*block.Header)
+	assert.True(t, ok)
+	assert.Equal(t, hdr, hdrClone)
+
+	hdrClone.PrevHash[0] = 'P'
+	hdrClone.MiniBlockHeaders[0].Hash[0] = 'M'
+	hdrClone.MetaBlockHashes[0][0] = 'H'
+	hdrClone.AccumulatedFees.SetInt64(1)
+	assert.Equal(t, createPooledHeader(), hdr)
+}
+
+func TestMarshalizerCloner_CloneValueShouldKeepType(t *testing.T) {
+	t.Parallel()
+
+	mc, _ := dataPool.NewMarshalizerCloner(&mock.MarshalizerMock{})
+	miniBlock := block.MiniBlock{TxHashes: [][]byte{[]byte("tx hash")}, ReceiverShardID: 1, Type: block.TxBlock}
+
+	clone, err := mc.Clone(miniBlock)
+	assert.Nil(t, err)
+
+	miniBlockClone, ok := clone.(block.MiniBlock)
+	assert.True(t, ok)
+	assert.Equal(t, miniBlock, miniBlockClone)
+
+	miniBlockClone.TxHashes[0][0] = 'T'
+	assert.Equal(t, []byte("tx hash"), miniBlock.TxHashes[0])
+}
+
+func TestMarshalizerCloner_CloneByteSliceShouldCopy(t *testing.T) {
+	t.Parallel()
+
+	mc, _ := dataPool.NewMarshalizerCloner(&mock.MarshalizerMock{})
+	buff := []byte("buff")
+
+	clone, err := mc.Clone(buff)
+	assert.Nil(t, err)
+	assert.Equal(t, buff, clone)
+
+	clone.([]byte)[0] = 'B'
+	assert.Equal(t, []byte("buff"), buff)
+}
+
+func TestMarshalizerCloner_CloneWithCapnpMarshalizerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	mc, _ := dataPool.NewMarshalizerCloner(&marshal.CapnpMarshalizer{})
+	tx := createPooledTransaction()
+
+	clone, err := mc.Clone(tx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, tx, clone)
+	assert.False(t, tx == clone)
+}
+
+func benchmarkMarshalizerClone(b *testing.B, marshalizer marshal.Marshalizer, value interface{}) {
+	mc, _ := dataPool.NewMarshalizerCloner(marshalizer)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := mc.Clone(value)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshalizerCloner_CloneTransactionJson(b *testing.B) {
+	benchmarkMarshalizerClone(b, &marshal.JsonMarshalizer{}, createPooledTransaction())
+}
+
+func BenchmarkMarshalizerCloner_CloneTransactionCapnp(b *testing.B) {
+	benchmarkMarshalizerClone(b, &marshal.CapnpMarshalizer{}, createPooledTransaction())
+}
+
+func BenchmarkMarshalizerCloner_CloneHeaderJson(b *testing.B) {
+	benchmarkMarshalizerClone(b, &marshal.JsonMarshalizer{}, createPooledHeader())
+}
+
+func BenchmarkMarshalizerCloner_CloneHeaderCapnp(b *testing.B) {
+	benchmarkMarshalizerClone(b, &marshal.CapnpMarshalizer{}, createPooledHeader())
+}
+
+func BenchmarkMarshalizerCloner_CloneMiniBlockWithThousandTxsJson(b *testing.B) {
+	miniBlock := &block.MiniBlock{TxHashes: make([][]byte, 1000)}
+	for i := range miniBlock.TxHashes {
+		miniBlock.TxHashes[i] = make([]byte, 32)
+	}
+
+	benchmarkMarshalizerClone(b, &marshal.JsonMarshalizer{}, miniBlock)
+}
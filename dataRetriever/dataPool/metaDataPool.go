@@ -91,3 +91,42 @@ func (mdp *metaDataPool) IsInterfaceNil() bool {
 	}
 	return false
 }
+
+// NewCopyingMetaDataPool creates a meta data pools holder whose pools wrap the ones of the provided holder, deep
+// copying the headers, miniblocks and transactions when added and when read. The headers nonces pool only holds
+// hashes and is used as it is
+func NewCopyingMetaDataPool(pools dataRetriever.MetaPoolsHolder, cloner dataRetriever.DataCloner) (*metaDataPool, error) {
+	if pools == nil || pools.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilDataPoolHolder
+	}
+
+	metaBlocks, err := NewCopyingCacher(pools.MetaBlocks(), cloner)
+	if err != nil {
+		return nil, err
+	}
+	miniBlocks, err := NewCopyingCacher(pools.MiniBlocks(), cloner)
+	if err != nil {
+		return nil, err
+	}
+	shardHeaders, err := NewCopyingCacher(pools.ShardHeaders(), cloner)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := NewCopyingShardedData(pools.Transactions(), cloner)
+	if err != nil {
+		return nil, err
+	}
+	unsignedTransactions, err := NewCopyingShardedData(pools.UnsignedTransactions(), cloner)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMetaDataPool(
+		metaBlocks,
+		miniBlocks,
+		shardHeaders,
+		pools.HeadersNonces(),
+		transactions,
+		unsignedTransactions,
+	)
+}
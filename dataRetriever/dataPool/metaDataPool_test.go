@@ -3,9 +3,12 @@ package dataPool_test
 import (
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -135,3 +138,49 @@ func TestNewMetaDataPool_ConfigOk(t *testing.T) {
 	assert.True(t, transactions == tdp.Transactions())
 	assert.True(t, unsigned == tdp.UnsignedTransactions())
 }
+
+func createMetaPoolsHolderStub() *mock.MetaPoolsHolderStub {
+	shardHeaders, _ := storageUnit.NewCache(storageUnit.LRUCache, 10, 1)
+	hdrsNonces := &mock.Uint64SyncMapCacherStub{}
+
+	return &mock.MetaPoolsHolderStub{
+		MetaBlocksCalled:           func() storage.Cacher { return &mock.CacherStub{} },
+		MiniBlocksCalled:           func() storage.Cacher { return &mock.CacherStub{} },
+		ShardHeadersCalled:         func() storage.Cacher { return shardHeaders },
+		HeadersNoncesCalled:        func() dataRetriever.Uint64SyncMapCacher { return hdrsNonces },
+		TransactionsCalled:         func() dataRetriever.ShardedDataCacherNotifier { return &mock.ShardedDataStub{} },
+		UnsignedTransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier { return &mock.ShardedDataStub{} },
+	}
+}
+
+func TestNewCopyingMetaDataPool_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tdp, err := dataPool.NewCopyingMetaDataPool(nil, &mock.DataClonerStub{})
+	assert.Nil(t, tdp)
+	assert.Equal(t, dataRetriever.ErrNilDataPoolHolder, err)
+
+	tdp, err = dataPool.NewCopyingMetaDataPool(createMetaPoolsHolderStub(), nil)
+	assert.Nil(t, tdp)
+	assert.Equal(t, dataRetriever.ErrNilDataCloner, err)
+}
+
+func TestNewCopyingMetaDataPool_ShouldWrapThePools(t *testing.T) {
+	t.Parallel()
+
+	pools := createMetaPoolsHolderStub()
+	cloner, _ := dataPool.NewMarshalizerCloner(&mock.MarshalizerMock{})
+
+	tdp, err := dataPool.NewCopyingMetaDataPool(pools, cloner)
+	assert.Nil(t, err)
+	assert.True(t, pools.HeadersNonces() == tdp.HeadersNonces())
+
+	hdr := &block.Header{Nonce: 1}
+	_ = tdp.ShardHeaders().Put([]byte("hash"), hdr)
+	value, _ := tdp.ShardHeaders().Get([]byte("hash"))
+	assert.Equal(t, hdr, value)
+
+	stored, _ := pools.ShardHeaders().Peek([]byte("hash"))
+	assert.False(t, hdr == stored)
+	assert.False(t, value == stored)
+}
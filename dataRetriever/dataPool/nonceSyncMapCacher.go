@@ -123,6 +123,22 @@ func (nspc *nonceSyncMapCacher) Remove(nonce uint64, shardId uint32) {
 	nspc.removeNonceFromCacheIfSyncMapIsEmpty(nonce, syncMap)
 }
 
+// Keys returns the nonces found in the cache. Keys that can not be converted back to nonces are skipped
+func (nspc *nonceSyncMapCacher) Keys() []uint64 {
+	keys := nspc.cacher.Keys()
+	nonces := make([]uint64, 0, len(keys))
+	for _, key := range keys {
+		nonce, err := nspc.nonceConverter.ToUint64(key)
+		if err != nil {
+			continue
+		}
+
+		nonces = append(nonces, nonce)
+	}
+
+	return nonces
+}
+
 // RegisterHandler registers a new handler to be called when a new data is added
 func (nspc *nonceSyncMapCacher) RegisterHandler(handler func(nonce uint64, shardId uint32, value []byte)) {
 	if handler == nil {
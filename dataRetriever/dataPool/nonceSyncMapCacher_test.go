@@ -464,6 +464,25 @@ func TestNonceSyncMapCacher_HasFoundShouldRetTrue(t *testing.T) {
 	assert.True(t, has)
 }
 
+func TestNonceSyncMapCacher_KeysShouldReturnAllNonces(t *testing.T) {
+	t.Parallel()
+
+	cacher := mock.NewCacherMock()
+	nonceConverter := mock.NewNonceHashConverterMock()
+	nsmc, _ := dataPool.NewNonceSyncMapCacher(cacher, nonceConverter)
+
+	for _, nonce := range []uint64{3, 5} {
+		syncMap := &dataPool.ShardIdHashSyncMap{}
+		syncMap.Store(0, []byte("X"))
+		nsmc.Merge(nonce, syncMap)
+	}
+	nonces := nsmc.Keys()
+
+	assert.Equal(t, 2, len(nonces))
+	assert.Contains(t, nonces, uint64(3))
+	assert.Contains(t, nonces, uint64(5))
+}
+
 func testRetrievedMapAndAddedNotCalled(
 	t *testing.T,
 	retrievedMap dataRetriever.ShardIdHashMap,
@@ -113,3 +113,52 @@ func (tdp *shardedDataPool) IsInterfaceNil() bool {
 	}
 	return false
 }
+
+// NewCopyingShardedDataPool creates a data pools holder whose pools wrap the ones of the provided holder, deep copying
+// the headers, miniblocks and transactions when added and when read. The headers nonces pool only holds hashes and is
+// used as it is
+func NewCopyingShardedDataPool(pools dataRetriever.PoolsHolder, cloner dataRetriever.DataCloner) (*shardedDataPool, error) {
+	if pools == nil || pools.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilDataPoolHolder
+	}
+
+	transactions, err := NewCopyingShardedData(pools.Transactions(), cloner)
+	if err != nil {
+		return nil, err
+	}
+	unsignedTransactions, err := NewCopyingShardedData(pools.UnsignedTransactions(), cloner)
+	if err != nil {
+		return nil, err
+	}
+	rewardTransactions, err := NewCopyingShardedData(pools.RewardTransactions(), cloner)
+	if err != nil {
+		return nil, err
+	}
+	headers, err := NewCopyingCacher(pools.Headers(), cloner)
+	if err != nil {
+		return nil, err
+	}
+	miniBlocks, err := NewCopyingCacher(pools.MiniBlocks(), cloner)
+	if err != nil {
+		return nil, err
+	}
+	peerChangesBlocks, err := NewCopyingCacher(pools.PeerChangesBlocks(), cloner)
+	if err != nil {
+		return nil, err
+	}
+	metaBlocks, err := NewCopyingCacher(pools.MetaBlocks(), cloner)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewShardedDataPool(
+		transactions,
+		unsignedTransactions,
+		rewardTransactions,
+		headers,
+		pools.HeadersNonces(),
+		miniBlocks,
+		peerChangesBlocks,
+		metaBlocks,
+	)
+}
@@ -3,9 +3,12 @@ package dataPool_test
 import (
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -187,3 +190,68 @@ func TestNewShardedDataPool_OkValsShouldWork(t *testing.T) {
 	assert.True(t, metaChainBlocks == tdp.MetaBlocks())
 	assert.True(t, scResults == tdp.UnsignedTransactions())
 }
+
+//------- NewCopyingShardedDataPool
+
+func createPoolsHolderStub() *mock.PoolsHolderStub {
+	headers, _ := storageUnit.NewCache(storageUnit.LRUCache, 10, 1)
+	hdrsNonces := &mock.Uint64SyncMapCacherStub{}
+
+	return &mock.PoolsHolderStub{
+		TransactionsCalled:         func() dataRetriever.ShardedDataCacherNotifier { return &mock.ShardedDataStub{} },
+		UnsignedTransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier { return &mock.ShardedDataStub{} },
+		RewardTransactionsCalled:   func() dataRetriever.ShardedDataCacherNotifier { return &mock.ShardedDataStub{} },
+		HeadersCalled:              func() storage.Cacher { return headers },
+		HeadersNoncesCalled:        func() dataRetriever.Uint64SyncMapCacher { return hdrsNonces },
+		MiniBlocksCalled:           func() storage.Cacher { return &mock.CacherStub{} },
+		PeerChangesBlocksCalled:    func() storage.Cacher { return &mock.CacherStub{} },
+		MetaBlocksCalled:           func() storage.Cacher { return &mock.CacherStub{} },
+	}
+}
+
+func TestNewCopyingShardedDataPool_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tdp, err := dataPool.NewCopyingShardedDataPool(nil, &mock.DataClonerStub{})
+	assert.Nil(t, tdp)
+	assert.Equal(t, dataRetriever.ErrNilDataPoolHolder, err)
+
+	tdp, err = dataPool.NewCopyingShardedDataPool(createPoolsHolderStub(), nil)
+	assert.Nil(t, tdp)
+	assert.Equal(t, dataRetriever.ErrNilDataCloner, err)
+}
+
+func TestNewCopyingShardedDataPool_NilPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pools := createPoolsHolderStub()
+	pools.MetaBlocksCalled = func() storage.Cacher {
+		return nil
+	}
+
+	tdp, err := dataPool.NewCopyingShardedDataPool(pools, &mock.DataClonerStub{})
+
+	assert.Nil(t, tdp)
+	assert.Equal(t, dataRetriever.ErrNilCacher, err)
+}
+
+func TestNewCopyingShardedDataPool_ShouldWrapThePools(t *testing.T) {
+	t.Parallel()
+
+	pools := createPoolsHolderStub()
+	cloner, _ := dataPool.NewMarshalizerCloner(&mock.MarshalizerMock{})
+
+	tdp, err := dataPool.NewCopyingShardedDataPool(pools, cloner)
+	assert.Nil(t, err)
+	assert.True(t, pools.HeadersNonces() == tdp.HeadersNonces())
+
+	hdr := &block.Header{Nonce: 1}
+	_ = tdp.Headers().Put([]byte("hash"), hdr)
+	value, _ := tdp.Headers().Peek([]byte("hash"))
+	assert.Equal(t, hdr, value)
+	assert.False(t, hdr == value)
+
+	stored, _ := pools.Headers().Peek([]byte("hash"))
+	assert.False(t, hdr == stored)
+	assert.False(t, value == stored)
+}
@@ -196,3 +196,27 @@ var ErrInvalidMaxTxRequest = errors.New("max tx request number is invalid")
 
 // ErrNilPeerListCreator signals that a nil peer list creator implementation has been provided
 var ErrNilPeerListCreator = errors.New("nil peer list creator provided")
+
+// ErrNilContext signals that a nil context has been provided
+var ErrNilContext = errors.New("nil context")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilResolver signals that a nil resolver has been provided
+var ErrNilResolver = errors.New("nil resolver")
+
+// ErrNilEmptyValueHandler signals that a nil handler creating the empty values has been provided
+var ErrNilEmptyValueHandler = errors.New("nil empty value handler")
+
+// ErrRequestedDataNotFound signals that the requested data was received but is no longer found in the pool
+var ErrRequestedDataNotFound = errors.New("requested data not found in pool")
+
+// ErrNilFinalityProofsPool signals that a nil finality proofs pool has been provided
+var ErrNilFinalityProofsPool = errors.New("nil finality proofs pool")
+
+// ErrNilDataCloner signals that a nil data cloner has been provided
+var ErrNilDataCloner = errors.New("nil data cloner")
+
+// ErrNilShardedDataCacherNotifier signals that a nil sharded data cacher notifier has been provided
+var ErrNilShardedDataCacherNotifier = errors.New("nil sharded data cacher notifier")
@@ -1,5 +1,14 @@
 package containers
 
 func (rc *resolversContainer) Insert(key string, value interface{}) bool {
-	return rc.objects.Insert(key, value)
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+
+	_, found := rc.objects[key]
+	if found {
+		return false
+	}
+
+	rc.objects[key] = newResolverEntry(key, value)
+	return true
 }
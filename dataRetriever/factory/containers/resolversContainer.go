@@ -1,31 +1,53 @@
 package containers
 
 import (
+	"fmt"
+	"sync"
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
-	"github.com/cornelk/hashmap"
 )
 
+type resolverEntry struct {
+	resolver interface{}
+	metadata dataRetriever.ContainerElementMetadata
+}
+
 // resolversContainer is a resolvers holder organized by type
 type resolversContainer struct {
-	objects *hashmap.HashMap
+	mut     sync.RWMutex
+	objects map[string]*resolverEntry
 }
 
 // NewResolversContainer will create a new instance of a container
 func NewResolversContainer() *resolversContainer {
 	return &resolversContainer{
-		objects: &hashmap.HashMap{},
+		objects: make(map[string]*resolverEntry),
+	}
+}
+
+func newResolverEntry(key string, resolver interface{}) *resolverEntry {
+	return &resolverEntry{
+		resolver: resolver,
+		metadata: dataRetriever.ContainerElementMetadata{
+			Topic:     key,
+			Type:      fmt.Sprintf("%T", resolver),
+			CreatedAt: time.Now(),
+		},
 	}
 }
 
 // Get returns the object stored at a certain key.
 // Returns an error if the element does not exist
 func (rc *resolversContainer) Get(key string) (dataRetriever.Resolver, error) {
-	value, ok := rc.objects.Get(key)
+	rc.mut.RLock()
+	entry, ok := rc.objects[key]
+	rc.mut.RUnlock()
 	if !ok {
 		return nil, dataRetriever.ErrInvalidContainerKey
 	}
 
-	resolver, ok := value.(dataRetriever.Resolver)
+	resolver, ok := entry.resolver.(dataRetriever.Resolver)
 	if !ok {
 		return nil, dataRetriever.ErrWrongTypeInContainer
 	}
@@ -36,29 +58,43 @@ func (rc *resolversContainer) Get(key string) (dataRetriever.Resolver, error) {
 // Add will add an object at a given key. Returns
 // an error if the element already exists
 func (rc *resolversContainer) Add(key string, resolver dataRetriever.Resolver) error {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+
+	return rc.addUnprotected(key, resolver)
+}
+
+func (rc *resolversContainer) addUnprotected(key string, resolver dataRetriever.Resolver) error {
 	if resolver == nil || resolver.IsInterfaceNil() {
 		return dataRetriever.ErrNilContainerElement
 	}
 
-	ok := rc.objects.Insert(key, resolver)
-
-	if !ok {
+	_, found := rc.objects[key]
+	if found {
 		return dataRetriever.ErrContainerKeyAlreadyExists
 	}
 
+	rc.objects[key] = newResolverEntry(key, resolver)
 	return nil
 }
 
 // AddMultiple will add objects with given keys. Returns
-// an error if one element already exists, lengths mismatch or an interceptor is nil
+// an error if one element already exists, lengths mismatch or a resolver is nil.
+// The objects are added all at once, on error none of them is added
 func (rc *resolversContainer) AddMultiple(keys []string, resolvers []dataRetriever.Resolver) error {
 	if len(keys) != len(resolvers) {
 		return dataRetriever.ErrLenMismatch
 	}
 
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+
 	for idx, key := range keys {
-		err := rc.Add(key, resolvers[idx])
+		err := rc.addUnprotected(key, resolvers[idx])
 		if err != nil {
+			for _, addedKey := range keys[:idx] {
+				delete(rc.objects, addedKey)
+			}
 			return err
 		}
 	}
@@ -66,24 +102,86 @@ func (rc *resolversContainer) AddMultiple(keys []string, resolvers []dataRetriev
 	return nil
 }
 
-// Replace will add (or replace if it already exists) an object at a given key
+// Replace will add (or replace if it already exists) an object at a given key. The readers of the container see
+// either the old or the new object, never a missing one
 func (rc *resolversContainer) Replace(key string, resolver dataRetriever.Resolver) error {
 	if resolver == nil || resolver.IsInterfaceNil() {
 		return dataRetriever.ErrNilContainerElement
 	}
 
-	rc.objects.Set(key, resolver)
+	rc.mut.Lock()
+	rc.objects[key] = newResolverEntry(key, resolver)
+	rc.mut.Unlock()
+
 	return nil
 }
 
 // Remove will remove an object at a given key
 func (rc *resolversContainer) Remove(key string) {
-	rc.objects.Del(key)
+	rc.mut.Lock()
+	delete(rc.objects, key)
+	rc.mut.Unlock()
 }
 
 // Len returns the length of the added objects
 func (rc *resolversContainer) Len() int {
-	return rc.objects.Len()
+	rc.mut.RLock()
+	defer rc.mut.RUnlock()
+
+	return len(rc.objects)
+}
+
+// Keys returns all the keys from the container
+func (rc *resolversContainer) Keys() []string {
+	rc.mut.RLock()
+	defer rc.mut.RUnlock()
+
+	keys := make([]string, 0, len(rc.objects))
+	for key := range rc.objects {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Iterate calls the handler for each object of the container until the handler returns false. The objects are
+// taken from a snapshot of the container, so the handler is free to modify the container
+func (rc *resolversContainer) Iterate(handler func(key string, resolver dataRetriever.Resolver) bool) {
+	if handler == nil {
+		return
+	}
+
+	rc.mut.RLock()
+	snapshot := make(map[string]dataRetriever.Resolver, len(rc.objects))
+	for key, entry := range rc.objects {
+		resolver, ok := entry.resolver.(dataRetriever.Resolver)
+		if !ok {
+			continue
+		}
+
+		snapshot[key] = resolver
+	}
+	rc.mut.RUnlock()
+
+	for key, resolver := range snapshot {
+		shouldContinue := handler(key, resolver)
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
+// Metadata returns the information recorded when the object stored at a certain key was added or replaced.
+// Returns an error if the element does not exist
+func (rc *resolversContainer) Metadata(key string) (dataRetriever.ContainerElementMetadata, error) {
+	rc.mut.RLock()
+	defer rc.mut.RUnlock()
+
+	entry, ok := rc.objects[key]
+	if !ok {
+		return dataRetriever.ContainerElementMetadata{}, dataRetriever.ErrInvalidContainerKey
+	}
+
+	return entry.metadata, nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -1,7 +1,10 @@
 package containers_test
 
 import (
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/containers"
@@ -222,3 +225,191 @@ func TestResolversContainer_LenShouldWork(t *testing.T) {
 	c.Remove("key1")
 	assert.Equal(t, 1, c.Len())
 }
+
+func TestResolversContainer_AddMultipleAlreadyExistingShouldNotAddAny(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewResolversContainer()
+	_ = c.Add("key2", &mock.ResolverStub{})
+
+	keys := []string{"key1", "key2"}
+	resolvers := []dataRetriever.Resolver{&mock.ResolverStub{}, &mock.ResolverStub{}}
+
+	err := c.AddMultiple(keys, resolvers)
+
+	assert.Equal(t, dataRetriever.ErrContainerKeyAlreadyExists, err)
+	assert.Equal(t, 1, c.Len())
+	_, err = c.Get("key1")
+	assert.Equal(t, dataRetriever.ErrInvalidContainerKey, err)
+}
+
+//------- Keys
+
+func TestResolversContainer_KeysShouldWork(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewResolversContainer()
+
+	_ = c.Add("key1", &mock.ResolverStub{})
+	_ = c.Add("key2", &mock.ResolverStub{})
+
+	keys := c.Keys()
+
+	assert.Equal(t, 2, len(keys))
+	assert.Contains(t, keys, "key1")
+	assert.Contains(t, keys, "key2")
+}
+
+//------- Iterate
+
+func TestResolversContainer_IterateNilHandlerShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		assert.Nil(t, r)
+	}()
+
+	c := containers.NewResolversContainer()
+	_ = c.Add("key", &mock.ResolverStub{})
+
+	c.Iterate(nil)
+}
+
+func TestResolversContainer_IterateShouldVisitAll(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewResolversContainer()
+	val1 := &mock.ResolverStub{}
+	val2 := &mock.ResolverStub{}
+	_ = c.Add("key1", val1)
+	_ = c.Add("key2", val2)
+
+	visited := make(map[string]dataRetriever.Resolver)
+	c.Iterate(func(key string, resolver dataRetriever.Resolver) bool {
+		visited[key] = resolver
+		return true
+	})
+
+	assert.Equal(t, 2, len(visited))
+	assert.True(t, visited["key1"] == val1)
+	assert.True(t, visited["key2"] == val2)
+}
+
+func TestResolversContainer_IterateHandlerReturnsFalseShouldStop(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewResolversContainer()
+	_ = c.Add("key1", &mock.ResolverStub{})
+	_ = c.Add("key2", &mock.ResolverStub{})
+
+	numVisited := 0
+	c.Iterate(func(key string, resolver dataRetriever.Resolver) bool {
+		numVisited++
+		return false
+	})
+
+	assert.Equal(t, 1, numVisited)
+}
+
+func TestResolversContainer_IterateShouldAllowModifyingTheContainer(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewResolversContainer()
+	_ = c.Add("key1", &mock.ResolverStub{})
+	_ = c.Add("key2", &mock.ResolverStub{})
+
+	numVisited := 0
+	c.Iterate(func(key string, resolver dataRetriever.Resolver) bool {
+		numVisited++
+		c.Remove(key)
+		_ = c.Add(key+"_new", &mock.ResolverStub{})
+		return true
+	})
+
+	assert.Equal(t, 2, numVisited)
+	assert.Equal(t, 2, c.Len())
+	assert.Contains(t, c.Keys(), "key1_new")
+	assert.Contains(t, c.Keys(), "key2_new")
+}
+
+//------- Metadata
+
+func TestResolversContainer_MetadataNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewResolversContainer()
+
+	_, err := c.Metadata("key")
+
+	assert.Equal(t, dataRetriever.ErrInvalidContainerKey, err)
+}
+
+func TestResolversContainer_MetadataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewResolversContainer()
+	before := time.Now()
+	_ = c.Add("key", &mock.ResolverStub{})
+
+	metadata, err := c.Metadata("key")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "key", metadata.Topic)
+	assert.Equal(t, "*mock.ResolverStub", metadata.Type)
+	assert.False(t, metadata.CreatedAt.Before(before))
+}
+
+func TestResolversContainer_ReplaceShouldRecordNewMetadata(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewResolversContainer()
+	_ = c.Add("key", &mock.ResolverStub{})
+	oldMetadata, _ := c.Metadata("key")
+
+	time.Sleep(time.Millisecond)
+	_ = c.Replace("key", &mock.ResolverStub{})
+	newMetadata, err := c.Metadata("key")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "key", newMetadata.Topic)
+	assert.True(t, newMetadata.CreatedAt.After(oldMetadata.CreatedAt))
+}
+
+//------- Concurrency
+
+func TestResolversContainer_ConcurrentAccessShouldWork(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewResolversContainer()
+	numGoRoutines := 50
+	wg := &sync.WaitGroup{}
+	wg.Add(numGoRoutines)
+	for i := 0; i < numGoRoutines; i++ {
+		go func(idx int) {
+			key := fmt.Sprintf("key%d", idx%5)
+			switch idx % 6 {
+			case 0:
+				_ = c.Add(key, &mock.ResolverStub{})
+			case 1:
+				_ = c.Replace(key, &mock.ResolverStub{})
+			case 2:
+				c.Remove(key)
+			case 3:
+				_, _ = c.Get(key)
+				_, _ = c.Metadata(key)
+			case 4:
+				c.Iterate(func(key string, resolver dataRetriever.Resolver) bool {
+					return true
+				})
+			case 5:
+				_ = c.Keys()
+				_ = c.Len()
+			}
+			wg.Done()
+		}(i)
+	}
+
+	wg.Wait()
+	assert.True(t, c.Len() <= 5)
+}
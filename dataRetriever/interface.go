@@ -1,6 +1,7 @@
 package dataRetriever
 
 import (
+	"context"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -34,6 +35,10 @@ const (
 	MetaHdrNonceHashDataUnit UnitType = 9
 	// HeartbeatUnit is the heartbeat storage unit identifier
 	HeartbeatUnit UnitType = 10
+	// SCStateChangesAuditUnit is the smart contract state changes audit log unit identifier
+	SCStateChangesAuditUnit UnitType = 11
+	// SCDeploymentsUnit is the smart contract deployments index unit identifier
+	SCDeploymentsUnit UnitType = 12
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -46,7 +51,20 @@ const (
 // Resolver defines what a data resolver should do
 type Resolver interface {
 	RequestDataFromHash(hash []byte) error
-	ProcessReceivedMessage(message p2p.MessageP2P) error
+	ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error
+	IsInterfaceNil() bool
+}
+
+// PoolRequester defines what a requester fetching the data from a pool, a storer or the network should do
+type PoolRequester interface {
+	GetOrRequest(
+		ctx context.Context,
+		key []byte,
+		pool storage.Cacher,
+		storer storage.Storer,
+		resolver Resolver,
+		newEmptyValue func() interface{},
+	) (interface{}, error)
 	IsInterfaceNil() bool
 }
 
@@ -81,6 +99,9 @@ type ResolversContainer interface {
 	Replace(key string, val Resolver) error
 	Remove(key string)
 	Len() int
+	Keys() []string
+	Iterate(handler func(key string, resolver Resolver) bool)
+	Metadata(key string) (ContainerElementMetadata, error)
 	IsInterfaceNil() bool
 }
 
@@ -110,6 +131,7 @@ type TopicHandler interface {
 	HasTopic(name string) bool
 	CreateTopic(name string, createChannelForTopic bool) error
 	RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error
+	RemoveTopic(name string) error
 }
 
 // TopicMessageHandler defines the functionality needed by structs to manage topics, message processors and to send data
@@ -177,6 +199,20 @@ type ShardedDataCacherNotifier interface {
 	CreateShardStore(cacheId string)
 }
 
+// DataCloner creates deep copies of the objects held by the data pools, so that the components sharing a pool can not
+// alter each other's objects
+type DataCloner interface {
+	Clone(value interface{}) (interface{}, error)
+	IsInterfaceNil() bool
+}
+
+// ShardedDataUniqueCounter defines the accounting of a sharded data pool where the data held by several shard
+// stores, as cross shard duplicates, is counted once
+type ShardedDataUniqueCounter interface {
+	NumUniqueData() int
+	NumCrossShardDuplicates() uint64
+}
+
 // ShardIdHashMap represents a map for shardId and hash
 type ShardIdHashMap interface {
 	Load(shardId uint32) ([]byte, bool)
@@ -194,6 +230,7 @@ type Uint64SyncMapCacher interface {
 	Remove(nonce uint64, shardId uint32)
 	RegisterHandler(handler func(nonce uint64, shardId uint32, value []byte))
 	Has(nonce uint64, shardId uint32) bool
+	Keys() []uint64
 	IsInterfaceNil() bool
 }
 
@@ -80,7 +80,15 @@ func (cm *CacherMock) RemoveOldest() {
 }
 
 func (cm *CacherMock) Keys() [][]byte {
-	panic("implement me")
+	cm.mut.Lock()
+	defer cm.mut.Unlock()
+
+	keys := make([][]byte, 0, len(cm.dataMap))
+	for key := range cm.dataMap {
+		keys = append(keys, []byte(key))
+	}
+
+	return keys
 }
 
 func (cm *CacherMock) Len() int {
@@ -0,0 +1,17 @@
+package mock
+
+type DataClonerStub struct {
+	CloneCalled func(value interface{}) (interface{}, error)
+}
+
+func (dcs *DataClonerStub) Clone(value interface{}) (interface{}, error) {
+	return dcs.CloneCalled(value)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dcs *DataClonerStub) IsInterfaceNil() bool {
+	if dcs == nil {
+		return true
+	}
+	return false
+}
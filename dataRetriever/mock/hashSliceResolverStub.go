@@ -1,6 +1,9 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-go/p2p"
+import (
+	"context"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
 
 type HashSliceResolverStub struct {
 	RequestDataFromHashCalled      func(hash []byte) error
@@ -16,7 +19,7 @@ func (hsrs *HashSliceResolverStub) RequestDataFromHash(hash []byte) error {
 	return errNotImplemented
 }
 
-func (hsrs *HashSliceResolverStub) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (hsrs *HashSliceResolverStub) ProcessReceivedMessage(_ context.Context, message p2p.MessageP2P) error {
 	if hsrs.ProcessReceivedMessageCalled != nil {
 		return hsrs.ProcessReceivedMessageCalled(message)
 	}
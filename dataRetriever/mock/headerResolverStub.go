@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/pkg/errors"
 )
@@ -21,7 +23,7 @@ func (hrs *HeaderResolverStub) RequestDataFromHash(hash []byte) error {
 	return errNotImplemented
 }
 
-func (hrs *HeaderResolverStub) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (hrs *HeaderResolverStub) ProcessReceivedMessage(_ context.Context, message p2p.MessageP2P) error {
 	if hrs.ProcessReceivedMessageCalled != nil {
 		return hrs.ProcessReceivedMessageCalled(message)
 	}
@@ -20,6 +20,7 @@ type MessengerStub struct {
 	BroadcastCalled                   func(topic string, buff []byte)
 	RegisterMessageProcessorCalled    func(topic string, handler p2p.MessageProcessor) error
 	UnregisterMessageProcessorCalled  func(topic string) error
+	RemoveTopicCalled                 func(name string) error
 	SendToConnectedPeerCalled         func(topic string, buff []byte, peerID p2p.PeerID) error
 	OutgoingChannelLoadBalancerCalled func() p2p.ChannelLoadBalancer
 	BootstrapCalled                   func() error
@@ -33,6 +34,10 @@ func (ms *MessengerStub) UnregisterMessageProcessor(topic string) error {
 	return ms.UnregisterMessageProcessorCalled(topic)
 }
 
+func (ms *MessengerStub) RemoveTopic(name string) error {
+	return ms.RemoveTopicCalled(name)
+}
+
 func (ms *MessengerStub) Broadcast(topic string, buff []byte) {
 	ms.BroadcastCalled(topic, buff)
 }
@@ -11,6 +11,7 @@ type Uint64SyncMapCacherStub struct {
 	RemoveCalled          func(nonce uint64, shardId uint32)
 	RegisterHandlerCalled func(handler func(nonce uint64, shardId uint32, value []byte))
 	HasCalled             func(nonce uint64, shardId uint32) bool
+	KeysCalled            func() []uint64
 }
 
 func (usmcs *Uint64SyncMapCacherStub) Clear() {
@@ -37,6 +38,10 @@ func (usmcs *Uint64SyncMapCacherStub) Remove(nonce uint64, shardId uint32) {
 	usmcs.RemoveCalled(nonce, shardId)
 }
 
+func (usmcs *Uint64SyncMapCacherStub) Keys() []uint64 {
+	return usmcs.KeysCalled()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (usmcs *Uint64SyncMapCacherStub) IsInterfaceNil() bool {
 	if usmcs == nil {
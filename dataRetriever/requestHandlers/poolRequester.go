@@ -0,0 +1,202 @@
+package requestHandlers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+type inFlightKey struct {
+	pool storage.Cacher
+	key  string
+}
+
+type inFlightRequest struct {
+	chReceived chan struct{}
+	numWaiters int
+}
+
+// poolRequester fetches the data needed by the processors from a data pool, falling back on the storer and
+// on requesting the data from the network. Concurrent callers waiting for the same key share a single in-flight
+// request, so the same data is not requested more than once at a time
+type poolRequester struct {
+	marshalizer     marshal.Marshalizer
+	mutRequests     sync.Mutex
+	inFlight        map[inFlightKey]*inFlightRequest
+	registeredPools map[storage.Cacher]struct{}
+}
+
+// NewPoolRequester creates a new pool requester instance
+func NewPoolRequester(marshalizer marshal.Marshalizer) (*poolRequester, error) {
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilMarshalizer
+	}
+
+	return &poolRequester{
+		marshalizer:     marshalizer,
+		inFlight:        make(map[inFlightKey]*inFlightRequest),
+		registeredPools: make(map[storage.Cacher]struct{}),
+	}, nil
+}
+
+// GetOrRequest returns the value found in the pool under the provided key or, if missing, the value found in the
+// storer, unmarshalled in the empty value created by the provided handler, so that the caller gets the same type
+// as the one held by the pool. If the data is in neither, it is requested through the resolver (only if there is
+// no request in flight for the same key) and the call blocks until the requested data is added in the pool or the
+// context is done, in which case the context's error is returned
+func (pr *poolRequester) GetOrRequest(
+	ctx context.Context,
+	key []byte,
+	pool storage.Cacher,
+	storer storage.Storer,
+	resolver dataRetriever.Resolver,
+	newEmptyValue func() interface{},
+) (interface{}, error) {
+	if ctx == nil {
+		return nil, dataRetriever.ErrNilContext
+	}
+	if pool == nil || pool.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilCacher
+	}
+	if storer == nil || storer.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilStorer
+	}
+	if resolver == nil || resolver.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilResolver
+	}
+	if newEmptyValue == nil {
+		return nil, dataRetriever.ErrNilEmptyValueHandler
+	}
+
+	value, ok := pool.Peek(key)
+	if ok {
+		return value, nil
+	}
+
+	buff, err := storer.Get(key)
+	if err == nil {
+		return pr.unmarshalValue(buff, newEmptyValue)
+	}
+
+	request, err := pr.addWaiter(key, pool, resolver)
+	if err != nil {
+		return nil, err
+	}
+	defer pr.removeWaiter(key, pool, request)
+
+	//the data might have been added between the first check and the handler registration
+	value, ok = pool.Peek(key)
+	if ok {
+		return value, nil
+	}
+
+	select {
+	case <-request.chReceived:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	value, ok = pool.Peek(key)
+	if !ok {
+		return nil, dataRetriever.ErrRequestedDataNotFound
+	}
+
+	return value, nil
+}
+
+func (pr *poolRequester) unmarshalValue(buff []byte, newEmptyValue func() interface{}) (interface{}, error) {
+	value := newEmptyValue()
+	err := pr.marshalizer.Unmarshal(value, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// addWaiter returns the in-flight request for the provided key, sending a new request if there is none
+func (pr *poolRequester) addWaiter(
+	key []byte,
+	pool storage.Cacher,
+	resolver dataRetriever.Resolver,
+) (*inFlightRequest, error) {
+	pr.mutRequests.Lock()
+	defer pr.mutRequests.Unlock()
+
+	pr.registerPool(pool)
+
+	ifk := inFlightKey{pool: pool, key: string(key)}
+	request, ok := pr.inFlight[ifk]
+	if ok {
+		request.numWaiters++
+		return request, nil
+	}
+
+	err := resolver.RequestDataFromHash(key)
+	if err != nil {
+		return nil, err
+	}
+
+	request = &inFlightRequest{
+		chReceived: make(chan struct{}),
+		numWaiters: 1,
+	}
+	pr.inFlight[ifk] = request
+
+	return request, nil
+}
+
+// removeWaiter drops the in-flight request once no caller is waiting for it anymore, so that a new request will
+// be sent if the data is needed again
+func (pr *poolRequester) removeWaiter(key []byte, pool storage.Cacher, request *inFlightRequest) {
+	pr.mutRequests.Lock()
+	defer pr.mutRequests.Unlock()
+
+	request.numWaiters--
+	if request.numWaiters > 0 {
+		return
+	}
+
+	ifk := inFlightKey{pool: pool, key: string(key)}
+	if pr.inFlight[ifk] == request {
+		delete(pr.inFlight, ifk)
+	}
+}
+
+// registerPool subscribes, only once for each pool, to the keys added in the pool
+func (pr *poolRequester) registerPool(pool storage.Cacher) {
+	_, ok := pr.registeredPools[pool]
+	if ok {
+		return
+	}
+
+	pr.registeredPools[pool] = struct{}{}
+	pool.RegisterHandler(func(key []byte) {
+		pr.receivedKey(pool, key)
+	})
+}
+
+func (pr *poolRequester) receivedKey(pool storage.Cacher, key []byte) {
+	pr.mutRequests.Lock()
+	defer pr.mutRequests.Unlock()
+
+	ifk := inFlightKey{pool: pool, key: string(key)}
+	request, ok := pr.inFlight[ifk]
+	if !ok {
+		return
+	}
+
+	close(request.chReceived)
+	delete(pr.inFlight, ifk)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pr *poolRequester) IsInterfaceNil() bool {
+	if pr == nil {
+		return true
+	}
+	return false
+}
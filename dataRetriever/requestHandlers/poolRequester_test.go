@@ -0,0 +1,255 @@
+package requestHandlers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/stretchr/testify/assert"
+)
+
+type testValue struct {
+	Field string
+}
+
+func newEmptyValue() interface{} {
+	return &testValue{}
+}
+
+func createStorerWithNoData() *mock.StorerStub {
+	return &mock.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, errors.New("key not found")
+		},
+	}
+}
+
+func createResolverWithCounter(numRequests *int32) *mock.ResolverStub {
+	return &mock.ResolverStub{
+		RequestDataFromHashCalled: func(hash []byte) error {
+			atomic.AddInt32(numRequests, 1)
+			return nil
+		},
+	}
+}
+
+func TestNewPoolRequester_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pr, err := NewPoolRequester(nil)
+
+	assert.Nil(t, pr)
+	assert.Equal(t, dataRetriever.ErrNilMarshalizer, err)
+}
+
+func TestNewPoolRequester_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pr, err := NewPoolRequester(&mock.MarshalizerMock{})
+
+	assert.NotNil(t, pr)
+	assert.Nil(t, err)
+}
+
+func TestPoolRequester_GetOrRequestNilContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pool, _ := lrucache.NewCache(10)
+	pr, _ := NewPoolRequester(&mock.MarshalizerMock{})
+	value, err := pr.GetOrRequest(nil, []byte("key"), pool, createStorerWithNoData(), &mock.ResolverStub{}, newEmptyValue)
+
+	assert.Nil(t, value)
+	assert.Equal(t, dataRetriever.ErrNilContext, err)
+}
+
+func TestPoolRequester_GetOrRequestNilPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pr, _ := NewPoolRequester(&mock.MarshalizerMock{})
+	value, err := pr.GetOrRequest(context.Background(), []byte("key"), nil, createStorerWithNoData(), &mock.ResolverStub{}, newEmptyValue)
+
+	assert.Nil(t, value)
+	assert.Equal(t, dataRetriever.ErrNilCacher, err)
+}
+
+func TestPoolRequester_GetOrRequestNilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pool, _ := lrucache.NewCache(10)
+	pr, _ := NewPoolRequester(&mock.MarshalizerMock{})
+	value, err := pr.GetOrRequest(context.Background(), []byte("key"), pool, nil, &mock.ResolverStub{}, newEmptyValue)
+
+	assert.Nil(t, value)
+	assert.Equal(t, dataRetriever.ErrNilStorer, err)
+}
+
+func TestPoolRequester_GetOrRequestNilResolverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pool, _ := lrucache.NewCache(10)
+	pr, _ := NewPoolRequester(&mock.MarshalizerMock{})
+	value, err := pr.GetOrRequest(context.Background(), []byte("key"), pool, createStorerWithNoData(), nil, newEmptyValue)
+
+	assert.Nil(t, value)
+	assert.Equal(t, dataRetriever.ErrNilResolver, err)
+}
+
+func TestPoolRequester_GetOrRequestNilEmptyValueHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pool, _ := lrucache.NewCache(10)
+	pr, _ := NewPoolRequester(&mock.MarshalizerMock{})
+	value, err := pr.GetOrRequest(context.Background(), []byte("key"), pool, createStorerWithNoData(), &mock.ResolverStub{}, nil)
+
+	assert.Nil(t, value)
+	assert.Equal(t, dataRetriever.ErrNilEmptyValueHandler, err)
+}
+
+func TestPoolRequester_GetOrRequestFoundInPoolShouldNotRequest(t *testing.T) {
+	t.Parallel()
+
+	numRequests := int32(0)
+	pool, _ := lrucache.NewCache(10)
+	pool.Put([]byte("key"), "value")
+	pr, _ := NewPoolRequester(&mock.MarshalizerMock{})
+
+	value, err := pr.GetOrRequest(context.Background(), []byte("key"), pool, createStorerWithNoData(), createResolverWithCounter(&numRequests), newEmptyValue)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "value", value)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&numRequests))
+}
+
+func TestPoolRequester_GetOrRequestFoundInStorerShouldReturnUnmarshalledValueAndNotRequest(t *testing.T) {
+	t.Parallel()
+
+	numRequests := int32(0)
+	marshalizer := &mock.MarshalizerMock{}
+	pool, _ := lrucache.NewCache(10)
+	storer := &mock.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return marshalizer.Marshal(&testValue{Field: "value"})
+		},
+	}
+	pr, _ := NewPoolRequester(marshalizer)
+
+	value, err := pr.GetOrRequest(context.Background(), []byte("key"), pool, storer, createResolverWithCounter(&numRequests), newEmptyValue)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &testValue{Field: "value"}, value)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&numRequests))
+}
+
+func TestPoolRequester_GetOrRequestFoundInStorerUnmarshalFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numRequests := int32(0)
+	pool, _ := lrucache.NewCache(10)
+	storer := &mock.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return []byte("not a marshalized value"), nil
+		},
+	}
+	pr, _ := NewPoolRequester(&mock.MarshalizerMock{})
+
+	value, err := pr.GetOrRequest(context.Background(), []byte("key"), pool, storer, createResolverWithCounter(&numRequests), newEmptyValue)
+
+	assert.Nil(t, value)
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&numRequests))
+}
+
+func TestPoolRequester_GetOrRequestRequestErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	pool, _ := lrucache.NewCache(10)
+	resolver := &mock.ResolverStub{
+		RequestDataFromHashCalled: func(hash []byte) error {
+			return errExpected
+		},
+	}
+	pr, _ := NewPoolRequester(&mock.MarshalizerMock{})
+
+	value, err := pr.GetOrRequest(context.Background(), []byte("key"), pool, createStorerWithNoData(), resolver, newEmptyValue)
+
+	assert.Nil(t, value)
+	assert.Equal(t, errExpected, err)
+}
+
+func TestPoolRequester_GetOrRequestContextDoneShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numRequests := int32(0)
+	pool, _ := lrucache.NewCache(10)
+	pr, _ := NewPoolRequester(&mock.MarshalizerMock{})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	value, err := pr.GetOrRequest(ctx, []byte("key"), pool, createStorerWithNoData(), createResolverWithCounter(&numRequests), newEmptyValue)
+
+	assert.Nil(t, value)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numRequests))
+}
+
+func TestPoolRequester_GetOrRequestConcurrentCallersShouldRequestOnce(t *testing.T) {
+	t.Parallel()
+
+	numRequests := int32(0)
+	pool, _ := lrucache.NewCache(10)
+	pr, _ := NewPoolRequester(&mock.MarshalizerMock{})
+	resolver := createResolverWithCounter(&numRequests)
+	storer := createStorerWithNoData()
+
+	numCallers := 10
+	wg := sync.WaitGroup{}
+	wg.Add(numCallers)
+	values := make([]interface{}, numCallers)
+	errs := make([]error, numCallers)
+	for i := 0; i < numCallers; i++ {
+		go func(idx int) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*2)
+			defer cancel()
+
+			values[idx], errs[idx] = pr.GetOrRequest(ctx, []byte("key"), pool, storer, resolver, newEmptyValue)
+			wg.Done()
+		}(i)
+	}
+
+	time.Sleep(time.Millisecond * 200)
+	pool.Put([]byte("key"), "value")
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numRequests))
+	for i := 0; i < numCallers; i++ {
+		assert.Nil(t, errs[i])
+		assert.Equal(t, "value", values[i])
+	}
+}
+
+func TestPoolRequester_GetOrRequestAfterTimeoutShouldRequestAgain(t *testing.T) {
+	t.Parallel()
+
+	numRequests := int32(0)
+	pool, _ := lrucache.NewCache(10)
+	pr, _ := NewPoolRequester(&mock.MarshalizerMock{})
+	resolver := createResolverWithCounter(&numRequests)
+	storer := createStorerWithNoData()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	_, _ = pr.GetOrRequest(ctx, []byte("key"), pool, storer, resolver, newEmptyValue)
+	cancel()
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond*50)
+	_, _ = pr.GetOrRequest(ctx, []byte("key"), pool, storer, resolver, newEmptyValue)
+	cancel()
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&numRequests))
+}
@@ -0,0 +1,102 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// FinalityProofResolver is a wrapper over Resolver that is specialized in resolving finality proofs requests
+type FinalityProofResolver struct {
+	dataRetriever.TopicResolverSender
+	finalityProofs storage.Cacher
+	marshalizer    marshal.Marshalizer
+}
+
+// NewFinalityProofResolver creates a new finality proof resolver. The proofs are served only from the pool, keyed by
+// the hash of the header they were created for
+func NewFinalityProofResolver(
+	senderResolver dataRetriever.TopicResolverSender,
+	finalityProofs storage.Cacher,
+	marshalizer marshal.Marshalizer,
+) (*FinalityProofResolver, error) {
+
+	if senderResolver == nil || senderResolver.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilResolverSender
+	}
+	if finalityProofs == nil || finalityProofs.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilFinalityProofsPool
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, dataRetriever.ErrNilMarshalizer
+	}
+
+	return &FinalityProofResolver{
+		TopicResolverSender: senderResolver,
+		finalityProofs:      finalityProofs,
+		marshalizer:         marshalizer,
+	}, nil
+}
+
+// ProcessReceivedMessage will be the callback func from the p2p.Messenger and will be called each time a new message was received
+// (for the topic this validator was registered to, usually a request topic)
+func (fpRes *FinalityProofResolver) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
+	rd := &dataRetriever.RequestData{}
+	err := rd.Unmarshal(fpRes.marshalizer, message)
+	if err != nil {
+		return err
+	}
+
+	err = ctx.Err()
+	if err != nil {
+		return err
+	}
+
+	buff, err := fpRes.resolveFinalityProofRequest(rd)
+	if err != nil {
+		return err
+	}
+
+	if buff == nil {
+		log.Debug(fmt.Sprintf("missing data: %v", rd))
+		return nil
+	}
+
+	return fpRes.Send(buff, message.Peer())
+}
+
+func (fpRes *FinalityProofResolver) resolveFinalityProofRequest(rd *dataRetriever.RequestData) ([]byte, error) {
+	if rd.Type != dataRetriever.HashType {
+		return nil, dataRetriever.ErrRequestTypeNotImplemented
+	}
+	if rd.Value == nil {
+		return nil, dataRetriever.ErrNilValue
+	}
+
+	proof, ok := fpRes.finalityProofs.Peek(rd.Value)
+	if !ok {
+		return nil, nil
+	}
+
+	return fpRes.marshalizer.Marshal(proof)
+}
+
+// RequestDataFromHash requests the finality proof of a header from other peers, having input the header hash
+func (fpRes *FinalityProofResolver) RequestDataFromHash(hash []byte) error {
+	return fpRes.SendOnRequestTopic(&dataRetriever.RequestData{
+		Type:  dataRetriever.HashType,
+		Value: hash,
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fpRes *FinalityProofResolver) IsInterfaceNil() bool {
+	if fpRes == nil {
+		return true
+	}
+	return false
+}
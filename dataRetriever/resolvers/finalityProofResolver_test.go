@@ -0,0 +1,186 @@
+package resolvers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+//------- NewFinalityProofResolver
+
+func TestNewFinalityProofResolver_NilResolverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fpRes, err := NewFinalityProofResolver(
+		nil,
+		&mock.CacherStub{},
+		&mock.MarshalizerMock{},
+	)
+
+	assert.Equal(t, dataRetriever.ErrNilResolverSender, err)
+	assert.Nil(t, fpRes)
+}
+
+func TestNewFinalityProofResolver_NilFinalityProofsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fpRes, err := NewFinalityProofResolver(
+		&mock.TopicResolverSenderStub{},
+		nil,
+		&mock.MarshalizerMock{},
+	)
+
+	assert.Equal(t, dataRetriever.ErrNilFinalityProofsPool, err)
+	assert.Nil(t, fpRes)
+}
+
+func TestNewFinalityProofResolver_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fpRes, err := NewFinalityProofResolver(
+		&mock.TopicResolverSenderStub{},
+		&mock.CacherStub{},
+		nil,
+	)
+
+	assert.Equal(t, dataRetriever.ErrNilMarshalizer, err)
+	assert.Nil(t, fpRes)
+}
+
+func TestNewFinalityProofResolver_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	fpRes, err := NewFinalityProofResolver(
+		&mock.TopicResolverSenderStub{},
+		&mock.CacherStub{},
+		&mock.MarshalizerMock{},
+	)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, fpRes)
+}
+
+//------- ProcessReceivedMessage
+
+func TestFinalityProofResolver_ProcessReceivedMessageWrongTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	fpRes, _ := NewFinalityProofResolver(
+		&mock.TopicResolverSenderStub{},
+		&mock.CacherStub{},
+		marshalizer,
+	)
+
+	data, _ := marshalizer.Marshal(&dataRetriever.RequestData{Type: dataRetriever.NonceType, Value: []byte("aaa")})
+	msg := &mock.P2PMessageMock{DataField: data}
+
+	assert.Equal(t, dataRetriever.ErrRequestTypeNotImplemented, fpRes.ProcessReceivedMessage(context.Background(), msg))
+}
+
+func TestFinalityProofResolver_ProcessReceivedMessageNilValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	fpRes, _ := NewFinalityProofResolver(
+		&mock.TopicResolverSenderStub{},
+		&mock.CacherStub{},
+		marshalizer,
+	)
+
+	data, _ := marshalizer.Marshal(&dataRetriever.RequestData{Type: dataRetriever.HashType, Value: nil})
+	msg := &mock.P2PMessageMock{DataField: data}
+
+	assert.Equal(t, dataRetriever.ErrNilValue, fpRes.ProcessReceivedMessage(context.Background(), msg))
+}
+
+func TestFinalityProofResolver_ProcessReceivedMessageFoundInPoolShouldSend(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	proof := &block.FinalityProof{HeaderHash: []byte("aaa"), Nonce: 10}
+	var sentBuff []byte
+	fpRes, _ := NewFinalityProofResolver(
+		&mock.TopicResolverSenderStub{
+			SendCalled: func(buff []byte, peer p2p.PeerID) error {
+				sentBuff = buff
+				return nil
+			},
+		},
+		&mock.CacherStub{
+			PeekCalled: func(key []byte) (value interface{}, ok bool) {
+				if bytes.Equal([]byte("aaa"), key) {
+					return proof, true
+				}
+				return nil, false
+			},
+		},
+		marshalizer,
+	)
+
+	data, _ := marshalizer.Marshal(&dataRetriever.RequestData{Type: dataRetriever.HashType, Value: []byte("aaa")})
+	msg := &mock.P2PMessageMock{DataField: data}
+
+	err := fpRes.ProcessReceivedMessage(context.Background(), msg)
+
+	expectedBuff, _ := marshalizer.Marshal(proof)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBuff, sentBuff)
+}
+
+func TestFinalityProofResolver_ProcessReceivedMessageMissingShouldNotSend(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	fpRes, _ := NewFinalityProofResolver(
+		&mock.TopicResolverSenderStub{
+			SendCalled: func(buff []byte, peer p2p.PeerID) error {
+				assert.Fail(t, "should have not sent anything")
+				return nil
+			},
+		},
+		&mock.CacherStub{
+			PeekCalled: func(key []byte) (value interface{}, ok bool) {
+				return nil, false
+			},
+		},
+		marshalizer,
+	)
+
+	data, _ := marshalizer.Marshal(&dataRetriever.RequestData{Type: dataRetriever.HashType, Value: []byte("aaa")})
+	msg := &mock.P2PMessageMock{DataField: data}
+
+	assert.Nil(t, fpRes.ProcessReceivedMessage(context.Background(), msg))
+}
+
+//------- RequestDataFromHash
+
+func TestFinalityProofResolver_RequestDataFromHashShouldWork(t *testing.T) {
+	t.Parallel()
+
+	requested := &dataRetriever.RequestData{}
+	res := &mock.TopicResolverSenderStub{
+		SendOnRequestTopicCalled: func(rd *dataRetriever.RequestData) error {
+			requested = rd
+			return nil
+		},
+	}
+
+	fpRes, _ := NewFinalityProofResolver(
+		res,
+		&mock.CacherStub{},
+		&mock.MarshalizerMock{},
+	)
+
+	assert.Nil(t, fpRes.RequestDataFromHash([]byte("aaa")))
+	assert.Equal(t, &dataRetriever.RequestData{
+		Type:  dataRetriever.HashType,
+		Value: []byte("aaa"),
+	}, requested)
+}
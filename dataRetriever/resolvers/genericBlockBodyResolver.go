@@ -1,6 +1,7 @@
 package resolvers
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -54,13 +55,18 @@ func NewGenericBlockBodyResolver(
 
 // ProcessReceivedMessage will be the callback func from the p2p.Messenger and will be called each time a new message was received
 // (for the topic this validator was registered to, usually a request topic)
-func (gbbRes *genericBlockBodyResolver) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (gbbRes *genericBlockBodyResolver) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
 	rd := &dataRetriever.RequestData{}
 	err := rd.Unmarshal(gbbRes.marshalizer, message)
 	if err != nil {
 		return err
 	}
 
+	err = ctx.Err()
+	if err != nil {
+		return err
+	}
+
 	buff, err := gbbRes.resolveBlockBodyRequest(rd)
 	if err != nil {
 		return err
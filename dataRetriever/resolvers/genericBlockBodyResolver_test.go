@@ -2,6 +2,7 @@ package resolvers_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"testing"
 
@@ -97,7 +98,7 @@ func TestNewGenericBlockBodyResolver_ProcessReceivedMessageNilValueShouldErr(t *
 		&mock.MarshalizerMock{},
 	)
 
-	err := gbbRes.ProcessReceivedMessage(createRequestMsg(dataRetriever.HashType, nil))
+	err := gbbRes.ProcessReceivedMessage(context.Background(), createRequestMsg(dataRetriever.HashType, nil))
 	assert.Equal(t, dataRetriever.ErrNilValue, err)
 }
 
@@ -111,7 +112,7 @@ func TestGenericBlockBodyResolver_ProcessReceivedMessageWrongTypeShouldErr(t *te
 		&mock.MarshalizerMock{},
 	)
 
-	err := gbbRes.ProcessReceivedMessage(createRequestMsg(dataRetriever.NonceType, make([]byte, 0)))
+	err := gbbRes.ProcessReceivedMessage(context.Background(), createRequestMsg(dataRetriever.NonceType, make([]byte, 0)))
 	assert.Equal(t, dataRetriever.ErrInvalidRequestType, err)
 }
 
@@ -153,7 +154,7 @@ func TestGenericBlockBodyResolver_ProcessReceivedMessageFoundInPoolShouldRetValA
 		marshalizer,
 	)
 
-	err := gbbRes.ProcessReceivedMessage(createRequestMsg(
+	err := gbbRes.ProcessReceivedMessage(context.Background(), createRequestMsg(
 		dataRetriever.HashArrayType,
 		requestedBuff))
 
@@ -203,7 +204,7 @@ func TestGenericBlockBodyResolver_ProcessReceivedMessageFoundInPoolMarshalizerFa
 		marshalizer,
 	)
 
-	err := gbbRes.ProcessReceivedMessage(createRequestMsg(
+	err := gbbRes.ProcessReceivedMessage(context.Background(), createRequestMsg(
 		dataRetriever.HashArrayType,
 		requestedBuff))
 
@@ -247,7 +248,7 @@ func TestGenericBlockBodyResolver_ProcessReceivedMessageNotFoundInPoolShouldRetF
 		marshalizer,
 	)
 
-	err := gbbRes.ProcessReceivedMessage(createRequestMsg(
+	err := gbbRes.ProcessReceivedMessage(context.Background(), createRequestMsg(
 		dataRetriever.HashType,
 		requestedBuff))
 
@@ -289,7 +290,7 @@ func TestGenericBlockBodyResolver_ProcessReceivedMessageMissingDataShouldNotSend
 		marshalizer,
 	)
 
-	_ = gbbRes.ProcessReceivedMessage(createRequestMsg(
+	_ = gbbRes.ProcessReceivedMessage(context.Background(), createRequestMsg(
 		dataRetriever.HashType,
 		requestedBuff))
 
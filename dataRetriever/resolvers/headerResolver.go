@@ -1,6 +1,7 @@
 package resolvers
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go/core/logger"
@@ -72,11 +73,16 @@ func NewHeaderResolver(
 
 // ProcessReceivedMessage will be the callback func from the p2p.Messenger and will be called each time a new message was received
 // (for the topic this validator was registered to, usually a request topic)
-func (hdrRes *HeaderResolver) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (hdrRes *HeaderResolver) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
 	rd, err := hdrRes.parseReceivedMessage(message)
 	if err != nil {
 		return err
 	}
+
+	err = ctx.Err()
+	if err != nil {
+		return err
+	}
 	var buff []byte
 
 	switch rd.Type {
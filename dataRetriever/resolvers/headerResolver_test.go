@@ -2,6 +2,7 @@ package resolvers_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"testing"
 
@@ -166,7 +167,7 @@ func TestHeaderResolver_ProcessReceivedMessageNilValueShouldErr(t *testing.T) {
 		mock.NewNonceHashConverterMock(),
 	)
 
-	err := hdrRes.ProcessReceivedMessage(createRequestMsg(dataRetriever.NonceType, nil))
+	err := hdrRes.ProcessReceivedMessage(context.Background(), createRequestMsg(dataRetriever.NonceType, nil))
 	assert.Equal(t, dataRetriever.ErrNilValue, err)
 }
 
@@ -183,7 +184,7 @@ func TestHeaderResolver_ProcessReceivedMessageRequestUnknownTypeShouldErr(t *tes
 		mock.NewNonceHashConverterMock(),
 	)
 
-	err := hdrRes.ProcessReceivedMessage(createRequestMsg(254, make([]byte, 0)))
+	err := hdrRes.ProcessReceivedMessage(context.Background(), createRequestMsg(254, make([]byte, 0)))
 	assert.Equal(t, dataRetriever.ErrResolveTypeUnknown, err)
 
 }
@@ -223,7 +224,7 @@ func TestHeaderResolver_ValidateRequestHashTypeFoundInHdrPoolShouldSearchAndSend
 		mock.NewNonceHashConverterMock(),
 	)
 
-	err := hdrRes.ProcessReceivedMessage(createRequestMsg(dataRetriever.HashType, requestedData))
+	err := hdrRes.ProcessReceivedMessage(context.Background(), createRequestMsg(dataRetriever.HashType, requestedData))
 	assert.Nil(t, err)
 	assert.True(t, searchWasCalled)
 	assert.True(t, sendWasCalled)
@@ -270,7 +271,7 @@ func TestHeaderResolver_ProcessReceivedMessageRequestHashTypeFoundInHdrPoolMarsh
 		mock.NewNonceHashConverterMock(),
 	)
 
-	err := hdrRes.ProcessReceivedMessage(createRequestMsg(dataRetriever.HashType, requestedData))
+	err := hdrRes.ProcessReceivedMessage(context.Background(), createRequestMsg(dataRetriever.HashType, requestedData))
 	assert.Equal(t, errExpected, err)
 }
 
@@ -315,7 +316,7 @@ func TestHeaderResolver_ProcessReceivedMessageRequestRetFromStorageShouldRetValA
 		mock.NewNonceHashConverterMock(),
 	)
 
-	err := hdrRes.ProcessReceivedMessage(createRequestMsg(dataRetriever.HashType, requestedData))
+	err := hdrRes.ProcessReceivedMessage(context.Background(), createRequestMsg(dataRetriever.HashType, requestedData))
 	assert.Nil(t, err)
 	assert.True(t, wasGotFromStorage)
 	assert.True(t, wasSent)
@@ -338,7 +339,7 @@ func TestHeaderResolver_ProcessReceivedMessageRequestNonceTypeInvalidSliceShould
 		mock.NewNonceHashConverterMock(),
 	)
 
-	err := hdrRes.ProcessReceivedMessage(createRequestMsg(dataRetriever.NonceType, []byte("aaa")))
+	err := hdrRes.ProcessReceivedMessage(context.Background(), createRequestMsg(dataRetriever.NonceType, []byte("aaa")))
 	assert.Equal(t, dataRetriever.ErrInvalidNonceByteSlice, err)
 }
 
@@ -375,7 +376,7 @@ func TestHeaderResolver_ProcessReceivedMessageRequestNonceTypeNotFoundInHdrNonce
 		nonceConverter,
 	)
 
-	err := hdrRes.ProcessReceivedMessage(createRequestMsg(
+	err := hdrRes.ProcessReceivedMessage(context.Background(), createRequestMsg(
 		dataRetriever.NonceType,
 		nonceConverter.ToByteSlice(requestedNonce)))
 	assert.Nil(t, err)
@@ -438,7 +439,7 @@ func TestHeaderResolver_ProcessReceivedMessageRequestNonceTypeFoundInHdrNoncePoo
 		nonceConverter,
 	)
 
-	err := hdrRes.ProcessReceivedMessage(createRequestMsg(
+	err := hdrRes.ProcessReceivedMessage(context.Background(), createRequestMsg(
 		dataRetriever.NonceType,
 		nonceConverter.ToByteSlice(requestedNonce)))
 
@@ -508,7 +509,7 @@ func TestHeaderResolver_ProcessReceivedMessageRequestNonceTypeFoundInHdrNoncePoo
 		nonceConverter,
 	)
 
-	err := hdrRes.ProcessReceivedMessage(createRequestMsg(
+	err := hdrRes.ProcessReceivedMessage(context.Background(), createRequestMsg(
 		dataRetriever.NonceType,
 		nonceConverter.ToByteSlice(requestedNonce)))
 
@@ -575,7 +576,7 @@ func TestHeaderResolver_ProcessReceivedMessageRequestNonceTypeFoundInHdrNoncePoo
 		nonceConverter,
 	)
 
-	err := hdrRes.ProcessReceivedMessage(createRequestMsg(
+	err := hdrRes.ProcessReceivedMessage(context.Background(), createRequestMsg(
 		dataRetriever.NonceType,
 		nonceConverter.ToByteSlice(requestedNonce)))
 
@@ -1,6 +1,8 @@
 package resolvers
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -57,7 +59,7 @@ func NewTxResolver(
 
 // ProcessReceivedMessage will be the callback func from the p2p.Messenger and will be called each time a new message was received
 // (for the topic this validator was registered to, usually a request topic)
-func (txRes *TxResolver) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (txRes *TxResolver) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
 	rd := &dataRetriever.RequestData{}
 	err := rd.Unmarshal(txRes.marshalizer, message)
 	if err != nil {
@@ -68,6 +70,11 @@ func (txRes *TxResolver) ProcessReceivedMessage(message p2p.MessageP2P) error {
 		return dataRetriever.ErrNilValue
 	}
 
+	err = ctx.Err()
+	if err != nil {
+		return err
+	}
+
 	switch rd.Type {
 	case dataRetriever.HashType:
 		buff, err := txRes.resolveTxRequestByHash(rd.Value)
@@ -76,7 +83,7 @@ func (txRes *TxResolver) ProcessReceivedMessage(message p2p.MessageP2P) error {
 		}
 		return txRes.Send(buff, message.Peer())
 	case dataRetriever.HashArrayType:
-		return txRes.resolveTxRequestByHashArray(rd.Value, message.Peer())
+		return txRes.resolveTxRequestByHashArray(ctx, rd.Value, message.Peer())
 	default:
 		return dataRetriever.ErrRequestTypeNotImplemented
 	}
@@ -113,7 +120,7 @@ func (txRes *TxResolver) fetchTxAsByteSlice(hash []byte) ([]byte, error) {
 	return txRes.txStorage.Get(hash)
 }
 
-func (txRes *TxResolver) resolveTxRequestByHashArray(hashesBuff []byte, pid p2p.PeerID) error {
+func (txRes *TxResolver) resolveTxRequestByHashArray(ctx context.Context, hashesBuff []byte, pid p2p.PeerID) error {
 	//TODO this can be optimized by searching in corresponding datapool (taken by topic name)
 	hashes := make([][]byte, 0)
 	err := txRes.marshalizer.Unmarshal(&hashes, hashesBuff)
@@ -123,6 +130,10 @@ func (txRes *TxResolver) resolveTxRequestByHashArray(hashesBuff []byte, pid p2p.
 
 	txsBuffSlice := make([][]byte, 0)
 	for _, hash := range hashes {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
 		tx, err := txRes.fetchTxAsByteSlice(hash)
 		if err != nil {
 			//it might happen to error on a tx (maybe it is missing) but should continue
@@ -2,6 +2,7 @@ package resolvers
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"testing"
 
@@ -121,7 +122,7 @@ func TestTxResolver_ProcessReceivedMessageNilMessageShouldErr(t *testing.T) {
 		&mock.DataPackerStub{},
 	)
 
-	err := txRes.ProcessReceivedMessage(nil)
+	err := txRes.ProcessReceivedMessage(context.Background(), nil)
 
 	assert.Equal(t, dataRetriever.ErrNilMessage, err)
 }
@@ -143,7 +144,7 @@ func TestTxResolver_ProcessReceivedMessageWrongTypeShouldErr(t *testing.T) {
 
 	msg := &mock.P2PMessageMock{DataField: data}
 
-	err := txRes.ProcessReceivedMessage(msg)
+	err := txRes.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Equal(t, dataRetriever.ErrRequestTypeNotImplemented, err)
 }
@@ -165,7 +166,7 @@ func TestTxResolver_ProcessReceivedMessageNilValueShouldErr(t *testing.T) {
 
 	msg := &mock.P2PMessageMock{DataField: data}
 
-	err := txRes.ProcessReceivedMessage(msg)
+	err := txRes.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Equal(t, dataRetriever.ErrNilValue, err)
 }
@@ -206,7 +207,7 @@ func TestTxResolver_ProcessReceivedMessageFoundInTxPoolShouldSearchAndSend(t *te
 
 	msg := &mock.P2PMessageMock{DataField: data}
 
-	err := txRes.ProcessReceivedMessage(msg)
+	err := txRes.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Nil(t, err)
 	assert.True(t, searchWasCalled)
@@ -251,7 +252,7 @@ func TestTxResolver_ProcessReceivedMessageFoundInTxPoolMarshalizerFailShouldRetN
 
 	msg := &mock.P2PMessageMock{DataField: data}
 
-	err := txRes.ProcessReceivedMessage(msg)
+	err := txRes.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Equal(t, errExpected, err)
 }
@@ -299,7 +300,7 @@ func TestTxResolver_ProcessReceivedMessageFoundInTxStorageShouldRetValAndSend(t
 
 	msg := &mock.P2PMessageMock{DataField: data}
 
-	err := txRes.ProcessReceivedMessage(msg)
+	err := txRes.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Nil(t, err)
 	assert.True(t, searchWasCalled)
@@ -340,7 +341,7 @@ func TestTxResolver_ProcessReceivedMessageFoundInTxStorageCheckRetError(t *testi
 
 	msg := &mock.P2PMessageMock{DataField: data}
 
-	err := txRes.ProcessReceivedMessage(msg)
+	err := txRes.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Equal(t, errExpected, err)
 
@@ -399,12 +400,50 @@ func TestTxResolver_ProcessReceivedMessageRequestedTwoSmallTransactionsShouldCal
 
 	msg := &mock.P2PMessageMock{DataField: data}
 
-	err := txRes.ProcessReceivedMessage(msg)
+	err := txRes.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Nil(t, err)
 	assert.True(t, sendSliceWasCalled)
 }
 
+func TestTxResolver_ProcessReceivedMessageCanceledContextShouldNotSearchNorSend(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	wasSearched := false
+	txPool := &mock.ShardedDataStub{
+		SearchFirstDataCalled: func(key []byte) (value interface{}, ok bool) {
+			wasSearched = true
+			return nil, false
+		},
+	}
+
+	wasSent := false
+	txRes, _ := NewTxResolver(
+		&mock.TopicResolverSenderStub{
+			SendCalled: func(buff []byte, peer p2p.PeerID) error {
+				wasSent = true
+				return nil
+			},
+		},
+		txPool,
+		&mock.StorerStub{},
+		marshalizer,
+		&mock.DataPackerStub{},
+	)
+
+	buff, _ := marshalizer.Marshal([][]byte{[]byte("txHash1"), []byte("txHash2")})
+	data, _ := marshalizer.Marshal(&dataRetriever.RequestData{Type: dataRetriever.HashArrayType, Value: buff})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := txRes.ProcessReceivedMessage(ctx, &mock.P2PMessageMock{DataField: data})
+
+	assert.Equal(t, context.Canceled, err)
+	assert.False(t, wasSearched)
+	assert.False(t, wasSent)
+}
+
 //------- RequestTransactionFromHash
 
 func TestTxResolver_RequestDataFromHashShouldWork(t *testing.T) {
@@ -2,6 +2,7 @@ package shardedData
 
 import (
 	"sync"
+	"sync/atomic"
 
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -16,6 +17,12 @@ var log = logger.DefaultLogger()
 //  hashes, to a corresponding identifier. It is able to add or remove
 //  data given the shard id it is associated with. It can
 //  also merge and split pools when required
+//
+// The same data can arrive in several shard stores, as it is gossiped both in its sender
+//  and in its receiver shard. Such a cross shard duplicate is held by all the shard stores
+//  it arrived in, so it can be found by each of them, but as a single logical entry: the
+//  stores share the same value, the data is counted once and removing it after execution
+//  cleans it from all the stores
 type shardedData struct {
 	mutShardedDataStore sync.RWMutex
 	// shardedDataStore is a key value store
@@ -26,6 +33,8 @@ type shardedData struct {
 
 	mutAddedDataHandlers sync.RWMutex
 	addedDataHandlers    []func(key []byte)
+
+	numCrossShardDuplicates uint64
 }
 
 type shardStore struct {
@@ -100,8 +109,41 @@ func (sd *shardedData) ShardDataStore(cacheId string) (c storage.Cacher) {
 	return mp.DataStore
 }
 
-// AddData will add data to the corresponding shard store
+// AddData will add data to the corresponding shard store. If the data is already held by other
+//  shard stores, the value they hold is added instead, so that all of them share it
 func (sd *shardedData) AddData(key []byte, data interface{}, cacheId string) {
+	existingData, isCrossShardDuplicate := sd.searchDataInOtherShardStores(key, cacheId)
+	if isCrossShardDuplicate {
+		data = existingData
+	}
+
+	added := sd.addData(key, data, cacheId)
+	if added && isCrossShardDuplicate {
+		atomic.AddUint64(&sd.numCrossShardDuplicates, 1)
+	}
+}
+
+func (sd *shardedData) searchDataInOtherShardStores(key []byte, cacheId string) (value interface{}, ok bool) {
+	sd.mutShardedDataStore.RLock()
+	defer sd.mutShardedDataStore.RUnlock()
+
+	for k, m := range sd.shardedDataStore {
+		if k == cacheId || m == nil || m.DataStore == nil {
+			continue
+		}
+
+		value, ok = m.DataStore.Peek(key)
+		if ok {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+// addData adds data to the corresponding shard store and notifies the handlers. The handlers are
+//  notified for each shard store, as they look the data up in the shard store they expect it in
+func (sd *shardedData) addData(key []byte, data interface{}, cacheId string) bool {
 	var mp *shardStore
 
 	sd.mutShardedDataStore.Lock()
@@ -120,6 +162,8 @@ func (sd *shardedData) AddData(key []byte, data interface{}, cacheId string) {
 		}
 		sd.mutAddedDataHandlers.RUnlock()
 	}
+
+	return !found
 }
 
 // SearchFirstData searches the key against all shard data store, retrieving first value found
@@ -149,13 +193,23 @@ func (sd *shardedData) RemoveSetOfDataFromPool(keys [][]byte, cacheId string) {
 	}
 }
 
-// RemoveData will remove data hash from the corresponding shard store
+// RemoveData will remove data hash from the corresponding shard store. If the shard store held it,
+//  the data is also removed from the other shard stores holding it as a cross shard duplicate
 func (sd *shardedData) RemoveData(key []byte, cacheId string) {
-	mpdata := sd.ShardDataStore(cacheId)
+	removed := sd.removeDataFromShardStore(key, cacheId)
+	if removed {
+		sd.RemoveDataFromAllShards(key)
+	}
+}
 
-	if mpdata != nil {
-		mpdata.Remove(key)
+func (sd *shardedData) removeDataFromShardStore(key []byte, cacheId string) bool {
+	mpdata := sd.ShardDataStore(cacheId)
+	if mpdata == nil || !mpdata.Has(key) {
+		return false
 	}
+
+	mpdata.Remove(key)
+	return true
 }
 
 // RemoveDataFromAllShards will remove data from the store given only
@@ -183,7 +237,7 @@ func (sd *shardedData) MergeShardStores(sourceCacheId, destCacheId string) {
 	if sourceStore != nil {
 		for _, key := range sourceStore.Keys() {
 			val, _ := sourceStore.Get(key)
-			sd.AddData(key, val, destCacheId)
+			sd.addData(key, val, destCacheId)
 		}
 	}
 
@@ -200,8 +254,8 @@ func (sd *shardedData) MoveData(sourceCacheId, destCacheId string, key [][]byte)
 		for _, key := range key {
 			val, ok := sourceStore.Get(key)
 			if ok {
-				sd.AddData(key, val, destCacheId)
-				sd.RemoveData(key, sourceCacheId)
+				sd.addData(key, val, destCacheId)
+				sd.removeDataFromShardStore(key, sourceCacheId)
 			}
 		}
 	}
@@ -225,6 +279,31 @@ func (sd *shardedData) ClearShardStore(cacheId string) {
 	mp.Clear()
 }
 
+// NumUniqueData returns the number of distinct data held by all the shard stores, counting the cross shard
+//  duplicates once
+func (sd *shardedData) NumUniqueData() int {
+	uniqueKeys := make(map[string]struct{})
+
+	sd.mutShardedDataStore.RLock()
+	for _, m := range sd.shardedDataStore {
+		if m == nil || m.DataStore == nil {
+			continue
+		}
+
+		for _, key := range m.DataStore.Keys() {
+			uniqueKeys[string(key)] = struct{}{}
+		}
+	}
+	sd.mutShardedDataStore.RUnlock()
+
+	return len(uniqueKeys)
+}
+
+// NumCrossShardDuplicates returns how many times a data was added to a shard store while being held by another one
+func (sd *shardedData) NumCrossShardDuplicates() uint64 {
+	return atomic.LoadUint64(&sd.numCrossShardDuplicates)
+}
+
 // RegisterHandler registers a new handler to be called when a new data is added
 func (sd *shardedData) RegisterHandler(handler func(key []byte)) {
 	if handler == nil {
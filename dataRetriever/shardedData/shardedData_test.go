@@ -202,6 +202,85 @@ func TestShardedData_MoveData(t *testing.T) {
 		"Mini pool for shard 3 should have 2 elements")
 }
 
+func TestShardedData_AddDataCrossShardDuplicateShouldShareValue(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := shardedData.NewShardedData(defaultTestConfig)
+
+	tx := &transaction.Transaction{Nonce: 1}
+	sd.AddData([]byte("tx_hash1"), tx, "0_1")
+	sd.AddData([]byte("tx_hash1"), &transaction.Transaction{Nonce: 1}, "1")
+	sd.AddData([]byte("tx_hash2"), &transaction.Transaction{Nonce: 2}, "1")
+
+	value, ok := sd.ShardDataStore("1").Peek([]byte("tx_hash1"))
+	assert.True(t, ok)
+	assert.True(t, tx == value)
+	assert.Equal(t, 1, sd.ShardDataStore("0_1").Len())
+	assert.Equal(t, 2, sd.ShardDataStore("1").Len())
+	assert.Equal(t, 2, sd.NumUniqueData())
+	assert.Equal(t, uint64(1), sd.NumCrossShardDuplicates())
+}
+
+func TestShardedData_AddDataSameShardStoreShouldNotCountDuplicate(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := shardedData.NewShardedData(defaultTestConfig)
+
+	sd.AddData([]byte("tx_hash1"), &transaction.Transaction{Nonce: 1}, "1")
+	sd.AddData([]byte("tx_hash1"), &transaction.Transaction{Nonce: 1}, "1")
+
+	assert.Equal(t, 1, sd.NumUniqueData())
+	assert.Equal(t, uint64(0), sd.NumCrossShardDuplicates())
+}
+
+func TestShardedData_RemoveDataShouldCleanCrossShardDuplicates(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := shardedData.NewShardedData(defaultTestConfig)
+
+	sd.AddData([]byte("tx_hash1"), &transaction.Transaction{Nonce: 1}, "0_1")
+	sd.AddData([]byte("tx_hash1"), &transaction.Transaction{Nonce: 1}, "1")
+	sd.AddData([]byte("tx_hash2"), &transaction.Transaction{Nonce: 2}, "1")
+
+	sd.RemoveData([]byte("tx_hash1"), "0_1")
+
+	assert.Equal(t, 0, sd.ShardDataStore("0_1").Len())
+	assert.Equal(t, 1, sd.ShardDataStore("1").Len())
+	assert.True(t, sd.ShardDataStore("1").Has([]byte("tx_hash2")))
+	assert.Equal(t, 1, sd.NumUniqueData())
+}
+
+func TestShardedData_RemoveDataNotHeldShouldNotCleanOtherShardStores(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := shardedData.NewShardedData(defaultTestConfig)
+
+	sd.AddData([]byte("tx_hash1"), &transaction.Transaction{Nonce: 1}, "1")
+	sd.AddData([]byte("tx_hash2"), &transaction.Transaction{Nonce: 2}, "2")
+
+	sd.RemoveData([]byte("tx_hash1"), "2")
+
+	assert.Equal(t, 1, sd.ShardDataStore("1").Len())
+	assert.Equal(t, 1, sd.ShardDataStore("2").Len())
+}
+
+func TestShardedData_MoveDataShouldNotCountDuplicates(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := shardedData.NewShardedData(defaultTestConfig)
+
+	sd.AddData([]byte("tx_hash1"), &transaction.Transaction{Nonce: 1}, "1")
+	sd.AddData([]byte("tx_hash2"), &transaction.Transaction{Nonce: 2}, "1")
+
+	sd.MoveData("1", "2", [][]byte{[]byte("tx_hash1")})
+	sd.MergeShardStores("1", "3")
+
+	assert.Equal(t, 1, sd.ShardDataStore("2").Len())
+	assert.Equal(t, 1, sd.ShardDataStore("3").Len())
+	assert.Equal(t, 2, sd.NumUniqueData())
+	assert.Equal(t, uint64(0), sd.NumCrossShardDuplicates())
+}
+
 func TestShardedData_RegisterAddedDataHandlerNilHandlerShouldIgnore(t *testing.T) {
 	t.Parallel()
 
@@ -1,6 +1,7 @@
 package facade
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -10,11 +11,16 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/rejection"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
 )
 
 // DefaultRestPort is the default port the REST API will start on if not specified
@@ -31,6 +37,10 @@ type ElrondNodeFacade struct {
 	syncer                 ntp.SyncTimer
 	log                    *logger.Logger
 	tpsBenchmark           *statistics.TpsBenchmark
+	gasPriceStats          statistics.GasPriceStatsHandler
+	accountsNotifier       subscription.AccountsNotifierHandler
+	configFingerprint      *external.ConfigFingerprint
+	scDeploymentsIndexer   process.SCDeploymentsIndexer
 	config                 *config.FacadeConfig
 	restAPIServerDebugMode bool
 }
@@ -71,6 +81,41 @@ func (ef *ElrondNodeFacade) TpsBenchmark() *statistics.TpsBenchmark {
 	return ef.tpsBenchmark
 }
 
+// SetGasPriceStats sets the gas price statistics tracker
+func (ef *ElrondNodeFacade) SetGasPriceStats(gasPriceStats statistics.GasPriceStatsHandler) {
+	ef.gasPriceStats = gasPriceStats
+}
+
+// GasPriceStats returns the gas price statistics tracker
+func (ef *ElrondNodeFacade) GasPriceStats() statistics.GasPriceStatsHandler {
+	return ef.gasPriceStats
+}
+
+// SetAccountsNotifier sets the watched accounts changes notifier
+func (ef *ElrondNodeFacade) SetAccountsNotifier(accountsNotifier subscription.AccountsNotifierHandler) {
+	ef.accountsNotifier = accountsNotifier
+}
+
+// AccountsNotifier returns the watched accounts changes notifier
+func (ef *ElrondNodeFacade) AccountsNotifier() subscription.AccountsNotifierHandler {
+	return ef.accountsNotifier
+}
+
+// SetConfigFingerprint sets the fingerprint of the node's consensus-critical configuration
+func (ef *ElrondNodeFacade) SetConfigFingerprint(configFingerprint *external.ConfigFingerprint) {
+	ef.configFingerprint = configFingerprint
+}
+
+// ConfigFingerprint returns the fingerprint of the node's consensus-critical configuration
+func (ef *ElrondNodeFacade) ConfigFingerprint() *external.ConfigFingerprint {
+	return ef.configFingerprint
+}
+
+// SetSCDeploymentsIndexer sets the index of the smart contracts deployed in the node's shard
+func (ef *ElrondNodeFacade) SetSCDeploymentsIndexer(scDeploymentsIndexer process.SCDeploymentsIndexer) {
+	ef.scDeploymentsIndexer = scDeploymentsIndexer
+}
+
 // SetConfig sets the configuration options for the facade
 func (ef *ElrondNodeFacade) SetConfig(facadeConfig *config.FacadeConfig) {
 	ef.config = facadeConfig
@@ -177,9 +222,10 @@ func (ef *ElrondNodeFacade) CreateTransaction(
 	data string,
 	signatureHex string,
 	challenge string,
+	validityWindow transaction.ValidityWindow,
 ) (*transaction.Transaction, error) {
 
-	return ef.node.CreateTransaction(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge)
+	return ef.node.CreateTransaction(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge, validityWindow)
 }
 
 // SendTransaction will send a new transaction on the topic channel
@@ -192,9 +238,10 @@ func (ef *ElrondNodeFacade) SendTransaction(
 	gasLimit uint64,
 	transactionData string,
 	signature []byte,
+	validityWindow transaction.ValidityWindow,
 ) (string, error) {
 
-	return ef.node.SendTransaction(nonce, senderHex, receiverHex, value, gasPrice, gasLimit, transactionData, signature)
+	return ef.node.SendTransaction(nonce, senderHex, receiverHex, value, gasPrice, gasLimit, transactionData, signature, validityWindow)
 }
 
 // SendBulkTransactions will send a bulk of transactions on the topic channel
@@ -213,6 +260,26 @@ func (ef *ElrondNodeFacade) GetAccount(address string) (*state.Account, error) {
 	return ef.node.GetAccount(address)
 }
 
+// GetTokenBalances returns the token balances held by the account having the provided address
+func (ef *ElrondNodeFacade) GetTokenBalances(address string) (map[string]*big.Int, error) {
+	return ef.node.GetTokenBalances(address)
+}
+
+// GetSCDeployment returns the deployer, the deploy transaction hash and the deploy epoch of the smart contract having
+// the provided hex encoded address
+func (ef *ElrondNodeFacade) GetSCDeployment(address string) (*process.SCDeploymentInfo, error) {
+	if ef.scDeploymentsIndexer == nil || ef.scDeploymentsIndexer.IsInterfaceNil() {
+		return nil, process.ErrSCDeploymentsIndexDisabled
+	}
+
+	scAddress, err := hex.DecodeString(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return ef.scDeploymentsIndexer.GetDeployment(scAddress)
+}
+
 // GetCurrentPublicKey gets the current nodes public Key
 func (ef *ElrondNodeFacade) GetCurrentPublicKey() string {
 	return ef.node.GetCurrentPublicKey()
@@ -238,6 +305,171 @@ func (ef *ElrondNodeFacade) GetVmValue(address string, funcName string, argsBuff
 	return ef.apiResolver.GetVmValue(address, funcName, argsBuff...)
 }
 
+// GetStorageUnitEntry returns the raw value stored under the provided key in the named storage unit
+func (ef *ElrondNodeFacade) GetStorageUnitEntry(unitName string, key []byte) ([]byte, error) {
+	return ef.apiResolver.GetStorageUnitEntry(unitName, key)
+}
+
+// StorageUnitsStats returns the names and stats of the node's storage units
+func (ef *ElrondNodeFacade) StorageUnitsStats() []external.StorageUnitStats {
+	return ef.apiResolver.StorageUnitsStats()
+}
+
+// DumpPools writes the contents of the named pools to files and returns the paths of the created files
+func (ef *ElrondNodeFacade) DumpPools(poolNames []string) ([]string, error) {
+	return ef.apiResolver.DumpPools(poolNames)
+}
+
+// LoadPoolsDump adds in the node's pools the entries found in the provided dump file
+func (ef *ElrondNodeFacade) LoadPoolsDump(fileName string) (int, error) {
+	return ef.apiResolver.LoadPoolsDump(fileName)
+}
+
+// ExportParticipationProofs returns the proofs of the headers of the provided epoch signed by the given validator
+func (ef *ElrondNodeFacade) ExportParticipationProofs(epoch uint32, pubKey []byte) (*external.ParticipationProofs, error) {
+	return ef.apiResolver.ExportParticipationProofs(epoch, pubKey)
+}
+
+// EnableMessageTracing starts tracing 1 in sampleRate of the messages received on the provided topic
+func (ef *ElrondNodeFacade) EnableMessageTracing(topic string, sampleRate uint32) error {
+	return ef.apiResolver.EnableMessageTracing(topic, sampleRate)
+}
+
+// DisableMessageTracing stops tracing the messages received on the provided topic
+func (ef *ElrondNodeFacade) DisableMessageTracing(topic string) {
+	ef.apiResolver.DisableMessageTracing(topic)
+}
+
+// TracedTopics returns the traced topics together with their sample rates
+func (ef *ElrondNodeFacade) TracedTopics() map[string]uint32 {
+	return ef.apiResolver.TracedTopics()
+}
+
+// MessageTraces returns the kept traces of the sampled messages, oldest first
+func (ef *ElrondNodeFacade) MessageTraces() []tracing.MessageTraceRecord {
+	return ef.apiResolver.MessageTraces()
+}
+
+// Rejections returns the messages rejected by the interceptors during the rolling window, for all the peers, the most
+// penalized peers first
+func (ef *ElrondNodeFacade) Rejections() []rejection.PeerRejections {
+	return ef.apiResolver.Rejections()
+}
+
+// PeerRejections returns the messages of the provided peer rejected by the interceptors during the rolling window
+func (ef *ElrondNodeFacade) PeerRejections(peer p2p.PeerID) rejection.PeerRejections {
+	return ef.apiResolver.PeerRejections(peer)
+}
+
+// DumpDiagnostics writes the node's diagnostics report to the log and returns it
+func (ef *ElrondNodeFacade) DumpDiagnostics() *external.DiagnosticsReport {
+	return ef.apiResolver.DumpDiagnostics()
+}
+
+// CheckReadiness runs the readiness probes and returns their outcome
+func (ef *ElrondNodeFacade) CheckReadiness() *external.ReadinessReport {
+	return ef.apiResolver.CheckReadiness()
+}
+
+// IterateAccounts provides to the handler, in address order, at most maxAccounts accounts placed after the startAfter
+// address in the state having the provided root hash. It returns the address from which the walk should be resumed
+func (ef *ElrondNodeFacade) IterateAccounts(
+	rootHash []byte,
+	startAfter []byte,
+	maxAccounts int,
+	handler func(account *external.AccountEntry) error,
+) ([]byte, error) {
+	return ef.apiResolver.IterateAccounts(rootHash, startAfter, maxAccounts, handler)
+}
+
+// GetSCStorageDiff returns the storage keys of the smart contract having the provided hex encoded address whose values
+// changed between the blocks with the provided nonces
+func (ef *ElrondNodeFacade) GetSCStorageDiff(address string, fromNonce uint64, toNonce uint64) (*external.SCStorageDiff, error) {
+	scAddress, err := hex.DecodeString(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return ef.apiResolver.SCStorageDiff(scAddress, fromNonce, toNonce)
+}
+
+// GetValidatorEarnings returns the rewards credited to the provided hex encoded rewards address in each epoch between
+// fromEpoch and toEpoch, together with their totals
+func (ef *ElrondNodeFacade) GetValidatorEarnings(address string, fromEpoch uint32, toEpoch uint32) (*external.ValidatorEarnings, error) {
+	rewardsAddress, err := hex.DecodeString(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return ef.apiResolver.ValidatorEarnings(rewardsAddress, fromEpoch, toEpoch)
+}
+
+// ExportChain provides to the handler the rows of the provided table for each block between fromNonce and toNonce
+func (ef *ElrondNodeFacade) ExportChain(table string, fromNonce uint64, toNonce uint64, handler func(row []string) error) error {
+	return ef.apiResolver.ExportChain(table, fromNonce, toNonce, handler)
+}
+
+// AdminApiToken returns the token required by the admin routes. An empty token means the admin routes are disabled
+func (ef *ElrondNodeFacade) AdminApiToken() string {
+	if ef.config == nil {
+		return ""
+	}
+	return ef.config.AdminApiToken
+}
+
+// ShardFilterEnabled returns true if the REST API requests for addresses from other shards should be filtered
+func (ef *ElrondNodeFacade) ShardFilterEnabled() bool {
+	if ef.config == nil {
+		return false
+	}
+	return ef.config.ApiShardFilter.Enabled
+}
+
+// ComputeShardIdOfAddress returns the id of the shard the provided hex encoded address belongs to
+func (ef *ElrondNodeFacade) ComputeShardIdOfAddress(address string) (uint32, error) {
+	return ef.node.ComputeShardIdOfAddress(address)
+}
+
+// IsShardServed returns true if the REST API requests for the addresses from the provided shard are answered
+// by this node
+func (ef *ElrondNodeFacade) IsShardServed(shardId uint32) bool {
+	if ef.config == nil {
+		return false
+	}
+
+	for _, servedShard := range ef.config.ApiShardFilter.ServedShards {
+		if servedShard == shardId {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ShardObserverURL returns the URL of the observer configured for the provided shard, if any
+func (ef *ElrondNodeFacade) ShardObserverURL(shardId uint32) (string, bool) {
+	if ef.config == nil {
+		return "", false
+	}
+
+	for _, observer := range ef.config.ApiShardFilter.ShardObservers {
+		if observer.ShardId == shardId && len(observer.URL) > 0 {
+			return observer.URL, true
+		}
+	}
+
+	return "", false
+}
+
+// ProxyOtherShardsRequests returns true if the REST API requests for addresses from other shards should be
+// forwarded to the observers configured for those shards
+func (ef *ElrondNodeFacade) ProxyOtherShardsRequests() bool {
+	if ef.config == nil {
+		return false
+	}
+	return ef.config.ApiShardFilter.ProxyRequests
+}
+
 // PprofEnabled returns if profiling mode should be active or not on the application
 func (ef *ElrondNodeFacade) PprofEnabled() bool {
 	return ef.config.PprofEnabled
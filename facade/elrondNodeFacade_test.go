@@ -12,7 +12,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/facade/mock"
+	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/rejection"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -274,15 +279,28 @@ func TestElrondNodeFacade_SetSyncer(t *testing.T) {
 func TestElrondNodeFacade_SendTransaction(t *testing.T) {
 	called := 0
 	node := &mock.NodeMock{}
-	node.SendTransactionHandler = func(nonce uint64, sender string, receiver string, amount *big.Int, code string, signature []byte) (string, error) {
+	node.SendTransactionHandler = func(nonce uint64, sender string, receiver string, amount *big.Int, code string, signature []byte, validityWindow transaction.ValidityWindow) (string, error) {
 		called++
 		return "", nil
 	}
 	ef := createElrondNodeFacadeWithMockResolver(node)
-	_, _ = ef.SendTransaction(1, "test", "test", big.NewInt(0), 0, 0, "code", []byte{})
+	_, _ = ef.SendTransaction(1, "test", "test", big.NewInt(0), 0, 0, "code", []byte{}, transaction.ValidityWindow{})
 	assert.Equal(t, called, 1)
 }
 
+func TestElrondNodeFacade_SendTransactionShouldPassTheValidityWindow(t *testing.T) {
+	validityWindow := transaction.ValidityWindow{NotBeforeRound: 10, NotAfterEpoch: 2}
+	receivedWindow := transaction.ValidityWindow{}
+	node := &mock.NodeMock{}
+	node.SendTransactionHandler = func(nonce uint64, sender string, receiver string, amount *big.Int, code string, signature []byte, validityWindow transaction.ValidityWindow) (string, error) {
+		receivedWindow = validityWindow
+		return "", nil
+	}
+	ef := createElrondNodeFacadeWithMockResolver(node)
+	_, _ = ef.SendTransaction(1, "test", "test", big.NewInt(0), 0, 0, "code", []byte{}, validityWindow)
+	assert.Equal(t, validityWindow, receivedWindow)
+}
+
 func TestElrondNodeFacade_GetAccount(t *testing.T) {
 	called := 0
 	node := &mock.NodeMock{}
@@ -295,6 +313,18 @@ func TestElrondNodeFacade_GetAccount(t *testing.T) {
 	assert.Equal(t, called, 1)
 }
 
+func TestElrondNodeFacade_GetTokenBalances(t *testing.T) {
+	called := 0
+	node := &mock.NodeMock{}
+	node.GetTokenBalancesHandler = func(address string) (map[string]*big.Int, error) {
+		called++
+		return nil, nil
+	}
+	ef := createElrondNodeFacadeWithMockResolver(node)
+	_, _ = ef.GetTokenBalances("test")
+	assert.Equal(t, called, 1)
+}
+
 func TestElrondNodeFacade_GetCurrentPublicKey(t *testing.T) {
 	called := 0
 	node := &mock.NodeMock{}
@@ -369,6 +399,419 @@ func TestElrondNodeFacade_GetDataValue(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestElrondNodeFacade_GetStorageUnitEntry(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	ef := NewElrondNodeFacade(
+		&mock.NodeMock{},
+		&mock.ApiResolverStub{
+			GetStorageUnitEntryHandler: func(unitName string, key []byte) ([]byte, error) {
+				wasCalled = true
+				return make([]byte, 0), nil
+			},
+		},
+		false,
+	)
+
+	_, _ = ef.GetStorageUnitEntry("TransactionUnit", []byte("key"))
+	assert.True(t, wasCalled)
+}
+
+func TestElrondNodeFacade_DumpPoolsAndLoadPoolsDump(t *testing.T) {
+	t.Parallel()
+
+	dumpCalled := false
+	loadCalled := false
+	ef := NewElrondNodeFacade(
+		&mock.NodeMock{},
+		&mock.ApiResolverStub{
+			DumpPoolsHandler: func(poolNames []string) ([]string, error) {
+				dumpCalled = true
+				return nil, nil
+			},
+			LoadPoolsDumpHandler: func(fileName string) (int, error) {
+				loadCalled = true
+				return 0, nil
+			},
+		},
+		false,
+	)
+
+	_, _ = ef.DumpPools([]string{"transactions"})
+	_, _ = ef.LoadPoolsDump("file")
+	assert.True(t, dumpCalled)
+	assert.True(t, loadCalled)
+}
+
+func TestElrondNodeFacade_ExportParticipationProofs(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	ef := NewElrondNodeFacade(
+		&mock.NodeMock{},
+		&mock.ApiResolverStub{
+			ExportParticipationProofsHandler: func(epoch uint32, pubKey []byte) (*external.ParticipationProofs, error) {
+				wasCalled = true
+				return nil, nil
+			},
+		},
+		false,
+	)
+
+	_, _ = ef.ExportParticipationProofs(1, []byte("pubKey"))
+	assert.True(t, wasCalled)
+}
+
+func TestElrondNodeFacade_MessageTracing(t *testing.T) {
+	t.Parallel()
+
+	enableCalled := false
+	disableCalled := false
+	tracedTopicsCalled := false
+	tracesCalled := false
+	ef := NewElrondNodeFacade(
+		&mock.NodeMock{},
+		&mock.ApiResolverStub{
+			EnableMessageTracingHandler: func(topic string, sampleRate uint32) error {
+				enableCalled = true
+				return nil
+			},
+			DisableMessageTracingHandler: func(topic string) {
+				disableCalled = true
+			},
+			TracedTopicsHandler: func() map[string]uint32 {
+				tracedTopicsCalled = true
+				return nil
+			},
+			MessageTracesHandler: func() []tracing.MessageTraceRecord {
+				tracesCalled = true
+				return nil
+			},
+		},
+		false,
+	)
+
+	_ = ef.EnableMessageTracing("topic", 10)
+	ef.DisableMessageTracing("topic")
+	_ = ef.TracedTopics()
+	_ = ef.MessageTraces()
+
+	assert.True(t, enableCalled)
+	assert.True(t, disableCalled)
+	assert.True(t, tracedTopicsCalled)
+	assert.True(t, tracesCalled)
+}
+
+func TestElrondNodeFacade_Rejections(t *testing.T) {
+	t.Parallel()
+
+	rejectionsCalled := false
+	requestedPeer := p2p.PeerID("")
+	ef := NewElrondNodeFacade(
+		&mock.NodeMock{},
+		&mock.ApiResolverStub{
+			RejectionsHandler: func() []rejection.PeerRejections {
+				rejectionsCalled = true
+				return nil
+			},
+			PeerRejectionsHandler: func(peer p2p.PeerID) rejection.PeerRejections {
+				requestedPeer = peer
+				return rejection.PeerRejections{}
+			},
+		},
+		false,
+	)
+
+	_ = ef.Rejections()
+	_ = ef.PeerRejections("peer")
+
+	assert.True(t, rejectionsCalled)
+	assert.Equal(t, p2p.PeerID("peer"), requestedPeer)
+}
+
+func TestElrondNodeFacade_DumpDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	expectedReport := &external.DiagnosticsReport{Timestamp: 10}
+	ef := NewElrondNodeFacade(
+		&mock.NodeMock{},
+		&mock.ApiResolverStub{
+			DumpDiagnosticsHandler: func() *external.DiagnosticsReport {
+				return expectedReport
+			},
+		},
+		false,
+	)
+
+	assert.Equal(t, expectedReport, ef.DumpDiagnostics())
+}
+
+func TestElrondNodeFacade_CheckReadiness(t *testing.T) {
+	t.Parallel()
+
+	expectedReport := &external.ReadinessReport{Ready: true}
+	ef := NewElrondNodeFacade(
+		&mock.NodeMock{},
+		&mock.ApiResolverStub{
+			CheckReadinessHandler: func() *external.ReadinessReport {
+				return expectedReport
+			},
+		},
+		false,
+	)
+
+	assert.Equal(t, expectedReport, ef.CheckReadiness())
+}
+
+func TestElrondNodeFacade_GetSCStorageDiffInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ef := NewElrondNodeFacade(
+		&mock.NodeMock{},
+		&mock.ApiResolverStub{
+			SCStorageDiffHandler: func(address []byte, fromNonce uint64, toNonce uint64) (*external.SCStorageDiff, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		},
+		false,
+	)
+
+	diff, err := ef.GetSCStorageDiff("not hex", 1, 2)
+
+	assert.Nil(t, diff)
+	assert.NotNil(t, err)
+}
+
+func TestElrondNodeFacade_GetSCStorageDiff(t *testing.T) {
+	t.Parallel()
+
+	expectedDiff := &external.SCStorageDiff{Address: "aabb", FromNonce: 1, ToNonce: 2}
+	ef := NewElrondNodeFacade(
+		&mock.NodeMock{},
+		&mock.ApiResolverStub{
+			SCStorageDiffHandler: func(address []byte, fromNonce uint64, toNonce uint64) (*external.SCStorageDiff, error) {
+				assert.Equal(t, []byte{0xaa, 0xbb}, address)
+				assert.Equal(t, uint64(1), fromNonce)
+				assert.Equal(t, uint64(2), toNonce)
+				return expectedDiff, nil
+			},
+		},
+		false,
+	)
+
+	diff, err := ef.GetSCStorageDiff("aabb", 1, 2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedDiff, diff)
+}
+
+func TestElrondNodeFacade_GetValidatorEarningsInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ef := NewElrondNodeFacade(
+		&mock.NodeMock{},
+		&mock.ApiResolverStub{
+			ValidatorEarningsHandler: func(address []byte, fromEpoch uint32, toEpoch uint32) (*external.ValidatorEarnings, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		},
+		false,
+	)
+
+	earnings, err := ef.GetValidatorEarnings("not hex", 1, 2)
+
+	assert.Nil(t, earnings)
+	assert.NotNil(t, err)
+}
+
+func TestElrondNodeFacade_GetValidatorEarnings(t *testing.T) {
+	t.Parallel()
+
+	expectedEarnings := &external.ValidatorEarnings{Address: "aabb", FromEpoch: 1, ToEpoch: 2, TotalRewards: "10"}
+	ef := NewElrondNodeFacade(
+		&mock.NodeMock{},
+		&mock.ApiResolverStub{
+			ValidatorEarningsHandler: func(address []byte, fromEpoch uint32, toEpoch uint32) (*external.ValidatorEarnings, error) {
+				assert.Equal(t, []byte{0xaa, 0xbb}, address)
+				assert.Equal(t, uint32(1), fromEpoch)
+				assert.Equal(t, uint32(2), toEpoch)
+				return expectedEarnings, nil
+			},
+		},
+		false,
+	)
+
+	earnings, err := ef.GetValidatorEarnings("aabb", 1, 2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedEarnings, earnings)
+}
+
+func TestElrondNodeFacade_ExportChain(t *testing.T) {
+	t.Parallel()
+
+	ef := NewElrondNodeFacade(
+		&mock.NodeMock{},
+		&mock.ApiResolverStub{
+			ExportChainHandler: func(table string, fromNonce uint64, toNonce uint64, handler func(row []string) error) error {
+				assert.Equal(t, "blocks", table)
+				assert.Equal(t, uint64(1), fromNonce)
+				assert.Equal(t, uint64(2), toNonce)
+				return handler([]string{"1"})
+			},
+		},
+		false,
+	)
+
+	var rows [][]string
+	err := ef.ExportChain("blocks", 1, 2, func(row []string) error {
+		rows = append(rows, row)
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"1"}}, rows)
+}
+
+func TestElrondNodeFacade_IterateAccounts(t *testing.T) {
+	t.Parallel()
+
+	expectedAccount := &external.AccountEntry{Address: "0102", Nonce: 1, Balance: "10"}
+	ef := NewElrondNodeFacade(
+		&mock.NodeMock{},
+		&mock.ApiResolverStub{
+			IterateAccountsHandler: func(rootHash []byte, startAfter []byte, maxAccounts int, handler func(account *external.AccountEntry) error) ([]byte, error) {
+				assert.Equal(t, []byte("root"), rootHash)
+				assert.Equal(t, []byte("start"), startAfter)
+				assert.Equal(t, 5, maxAccounts)
+				return []byte("resume"), handler(expectedAccount)
+			},
+		},
+		false,
+	)
+
+	providedAccounts := make([]*external.AccountEntry, 0)
+	resume, err := ef.IterateAccounts([]byte("root"), []byte("start"), 5, func(account *external.AccountEntry) error {
+		providedAccounts = append(providedAccounts, account)
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("resume"), resume)
+	assert.Equal(t, []*external.AccountEntry{expectedAccount}, providedAccounts)
+}
+
+func TestElrondNodeFacade_AdminApiTokenNilConfigShouldBeEmpty(t *testing.T) {
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+	ef.SetConfig(nil)
+
+	assert.Equal(t, "", ef.AdminApiToken())
+}
+
+func TestElrondNodeFacade_AdminApiToken(t *testing.T) {
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+	ef.SetConfig(&config.FacadeConfig{
+		AdminApiToken: "token",
+	})
+
+	assert.Equal(t, "token", ef.AdminApiToken())
+}
+
+func TestElrondNodeFacade_ShardFilterNilConfigShouldBeDisabled(t *testing.T) {
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+	ef.SetConfig(nil)
+
+	assert.False(t, ef.ShardFilterEnabled())
+	assert.False(t, ef.IsShardServed(0))
+	assert.False(t, ef.ProxyOtherShardsRequests())
+	_, ok := ef.ShardObserverURL(0)
+	assert.False(t, ok)
+}
+
+func TestElrondNodeFacade_ShardFilterSettings(t *testing.T) {
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+	ef.SetConfig(&config.FacadeConfig{
+		ApiShardFilter: config.ApiShardFilterConfig{
+			Enabled:       true,
+			ServedShards:  []uint32{0, 2},
+			ProxyRequests: true,
+			ShardObservers: []config.ShardObserverConfig{
+				{ShardId: 1, URL: "http://observer-1"},
+			},
+		},
+	})
+
+	assert.True(t, ef.ShardFilterEnabled())
+	assert.True(t, ef.ProxyOtherShardsRequests())
+	assert.True(t, ef.IsShardServed(0))
+	assert.False(t, ef.IsShardServed(1))
+	assert.True(t, ef.IsShardServed(2))
+
+	url, ok := ef.ShardObserverURL(1)
+	assert.True(t, ok)
+	assert.Equal(t, "http://observer-1", url)
+	_, ok = ef.ShardObserverURL(3)
+	assert.False(t, ok)
+}
+
+func TestElrondNodeFacade_ComputeShardIdOfAddressShouldCallNode(t *testing.T) {
+	node := &mock.NodeMock{
+		ComputeShardIdOfAddressHandler: func(address string) (uint32, error) {
+			return 3, nil
+		},
+	}
+	ef := NewElrondNodeFacade(node, &mock.ApiResolverStub{}, false)
+
+	shardId, err := ef.ComputeShardIdOfAddress("address")
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(3), shardId)
+}
+
+func TestElrondNodeFacade_GetSCDeploymentWithoutIndexerShouldErr(t *testing.T) {
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+
+	deployment, err := ef.GetSCDeployment("aabb")
+
+	assert.Nil(t, deployment)
+	assert.Equal(t, process.ErrSCDeploymentsIndexDisabled, err)
+}
+
+func TestElrondNodeFacade_GetSCDeploymentInvalidHexAddressShouldErr(t *testing.T) {
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+	ef.SetSCDeploymentsIndexer(&mock.SCDeploymentsIndexerStub{})
+
+	deployment, err := ef.GetSCDeployment("not hex")
+
+	assert.Nil(t, deployment)
+	assert.NotNil(t, err)
+}
+
+func TestElrondNodeFacade_GetSCDeploymentShouldCallIndexer(t *testing.T) {
+	expectedDeployment := &process.SCDeploymentInfo{
+		Deployer:     []byte("deployer"),
+		DeployTxHash: []byte("tx hash"),
+		Epoch:        3,
+	}
+	ef := createElrondNodeFacadeWithMockNodeAndResolver()
+	ef.SetSCDeploymentsIndexer(&mock.SCDeploymentsIndexerStub{
+		GetDeploymentCalled: func(scAddress []byte) (*process.SCDeploymentInfo, error) {
+			if string(scAddress) == string([]byte{0xaa, 0xbb}) {
+				return expectedDeployment, nil
+			}
+			return nil, errors.New("not found")
+		},
+	})
+
+	deployment, err := ef.GetSCDeployment("aabb")
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedDeployment, deployment)
+}
+
 func TestElrondNodeFacade_RestApiPortNilConfig(t *testing.T) {
 	ef := createElrondNodeFacadeWithMockNodeAndResolver()
 	ef.SetConfig(nil)
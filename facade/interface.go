@@ -7,6 +7,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/rejection"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
 )
 
 //NodeWrapper contains all functions that a node should contain.
@@ -34,10 +37,11 @@ type NodeWrapper interface {
 
 	//CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64,
-		gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error)
+		gasLimit uint64, data string, signatureHex string, challenge string,
+		validityWindow transaction.ValidityWindow) (*transaction.Transaction, error)
 
 	//SendTransaction will send a new transaction on the 'send transactions pipe' channel
-	SendTransaction(nonce uint64, senderHex string, receiverHex string, value *big.Int, gasPrice uint64, gasLimit uint64, transactionData string, signature []byte) (string, error)
+	SendTransaction(nonce uint64, senderHex string, receiverHex string, value *big.Int, gasPrice uint64, gasLimit uint64, transactionData string, signature []byte, validityWindow transaction.ValidityWindow) (string, error)
 
 	//SendBulkTransactions will send a bulk of transactions on the 'send transactions pipe' channel
 	SendBulkTransactions(txs []*transaction.Transaction) (uint64, error)
@@ -60,9 +64,15 @@ type NodeWrapper interface {
 	//  about the account corelated with provided address
 	GetAccount(address string) (*state.Account, error)
 
+	// GetTokenBalances returns the token balances held by the account having the provided address
+	GetTokenBalances(address string) (map[string]*big.Int, error)
+
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []heartbeat.PubKeyHeartbeat
 
+	// ComputeShardIdOfAddress returns the id of the shard the provided hex encoded address belongs to
+	ComputeShardIdOfAddress(address string) (uint32, error)
+
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -71,5 +81,22 @@ type NodeWrapper interface {
 type ApiResolver interface {
 	GetVmValue(address string, funcName string, argsBuff ...[]byte) ([]byte, error)
 	StatusMetrics() external.StatusMetricsHandler
+	GetStorageUnitEntry(unitName string, key []byte) ([]byte, error)
+	StorageUnitsStats() []external.StorageUnitStats
+	DumpPools(poolNames []string) ([]string, error)
+	LoadPoolsDump(fileName string) (int, error)
+	ExportParticipationProofs(epoch uint32, pubKey []byte) (*external.ParticipationProofs, error)
+	EnableMessageTracing(topic string, sampleRate uint32) error
+	DisableMessageTracing(topic string)
+	TracedTopics() map[string]uint32
+	MessageTraces() []tracing.MessageTraceRecord
+	Rejections() []rejection.PeerRejections
+	PeerRejections(peer p2p.PeerID) rejection.PeerRejections
+	DumpDiagnostics() *external.DiagnosticsReport
+	CheckReadiness() *external.ReadinessReport
+	IterateAccounts(rootHash []byte, startAfter []byte, maxAccounts int, handler func(account *external.AccountEntry) error) ([]byte, error)
+	SCStorageDiff(address []byte, fromNonce uint64, toNonce uint64) (*external.SCStorageDiff, error)
+	ValidatorEarnings(address []byte, fromEpoch uint32, toEpoch uint32) (*external.ValidatorEarnings, error)
+	ExportChain(table string, fromNonce uint64, toNonce uint64, handler func(row []string) error) error
 	IsInterfaceNil() bool
 }
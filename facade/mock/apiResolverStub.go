@@ -2,11 +2,31 @@ package mock
 
 import (
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/rejection"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
 )
 
 type ApiResolverStub struct {
-	GetVmValueHandler    func(address string, funcName string, argsBuff ...[]byte) ([]byte, error)
-	StatusMetricsHandler func() external.StatusMetricsHandler
+	GetVmValueHandler                func(address string, funcName string, argsBuff ...[]byte) ([]byte, error)
+	StatusMetricsHandler             func() external.StatusMetricsHandler
+	GetStorageUnitEntryHandler       func(unitName string, key []byte) ([]byte, error)
+	StorageUnitsStatsHandler         func() []external.StorageUnitStats
+	DumpPoolsHandler                 func(poolNames []string) ([]string, error)
+	LoadPoolsDumpHandler             func(fileName string) (int, error)
+	ExportParticipationProofsHandler func(epoch uint32, pubKey []byte) (*external.ParticipationProofs, error)
+	EnableMessageTracingHandler      func(topic string, sampleRate uint32) error
+	DisableMessageTracingHandler     func(topic string)
+	TracedTopicsHandler              func() map[string]uint32
+	MessageTracesHandler             func() []tracing.MessageTraceRecord
+	RejectionsHandler                func() []rejection.PeerRejections
+	PeerRejectionsHandler            func(peer p2p.PeerID) rejection.PeerRejections
+	DumpDiagnosticsHandler           func() *external.DiagnosticsReport
+	CheckReadinessHandler            func() *external.ReadinessReport
+	IterateAccountsHandler           func(rootHash []byte, startAfter []byte, maxAccounts int, handler func(account *external.AccountEntry) error) ([]byte, error)
+	SCStorageDiffHandler             func(address []byte, fromNonce uint64, toNonce uint64) (*external.SCStorageDiff, error)
+	ValidatorEarningsHandler         func(address []byte, fromEpoch uint32, toEpoch uint32) (*external.ValidatorEarnings, error)
+	ExportChainHandler               func(table string, fromNonce uint64, toNonce uint64, handler func(row []string) error) error
 }
 
 func (ars *ApiResolverStub) GetVmValue(address string, funcName string, argsBuff ...[]byte) ([]byte, error) {
@@ -17,6 +37,79 @@ func (ars *ApiResolverStub) StatusMetrics() external.StatusMetricsHandler {
 	return ars.StatusMetricsHandler()
 }
 
+func (ars *ApiResolverStub) GetStorageUnitEntry(unitName string, key []byte) ([]byte, error) {
+	return ars.GetStorageUnitEntryHandler(unitName, key)
+}
+
+func (ars *ApiResolverStub) StorageUnitsStats() []external.StorageUnitStats {
+	return ars.StorageUnitsStatsHandler()
+}
+
+func (ars *ApiResolverStub) DumpPools(poolNames []string) ([]string, error) {
+	return ars.DumpPoolsHandler(poolNames)
+}
+
+func (ars *ApiResolverStub) LoadPoolsDump(fileName string) (int, error) {
+	return ars.LoadPoolsDumpHandler(fileName)
+}
+
+func (ars *ApiResolverStub) ExportParticipationProofs(epoch uint32, pubKey []byte) (*external.ParticipationProofs, error) {
+	return ars.ExportParticipationProofsHandler(epoch, pubKey)
+}
+
+func (ars *ApiResolverStub) EnableMessageTracing(topic string, sampleRate uint32) error {
+	return ars.EnableMessageTracingHandler(topic, sampleRate)
+}
+
+func (ars *ApiResolverStub) DisableMessageTracing(topic string) {
+	ars.DisableMessageTracingHandler(topic)
+}
+
+func (ars *ApiResolverStub) TracedTopics() map[string]uint32 {
+	return ars.TracedTopicsHandler()
+}
+
+func (ars *ApiResolverStub) MessageTraces() []tracing.MessageTraceRecord {
+	return ars.MessageTracesHandler()
+}
+
+func (ars *ApiResolverStub) Rejections() []rejection.PeerRejections {
+	return ars.RejectionsHandler()
+}
+
+func (ars *ApiResolverStub) PeerRejections(peer p2p.PeerID) rejection.PeerRejections {
+	return ars.PeerRejectionsHandler(peer)
+}
+
+func (ars *ApiResolverStub) DumpDiagnostics() *external.DiagnosticsReport {
+	return ars.DumpDiagnosticsHandler()
+}
+
+func (ars *ApiResolverStub) CheckReadiness() *external.ReadinessReport {
+	return ars.CheckReadinessHandler()
+}
+
+func (ars *ApiResolverStub) IterateAccounts(
+	rootHash []byte,
+	startAfter []byte,
+	maxAccounts int,
+	handler func(account *external.AccountEntry) error,
+) ([]byte, error) {
+	return ars.IterateAccountsHandler(rootHash, startAfter, maxAccounts, handler)
+}
+
+func (ars *ApiResolverStub) SCStorageDiff(address []byte, fromNonce uint64, toNonce uint64) (*external.SCStorageDiff, error) {
+	return ars.SCStorageDiffHandler(address, fromNonce, toNonce)
+}
+
+func (ars *ApiResolverStub) ValidatorEarnings(address []byte, fromEpoch uint32, toEpoch uint32) (*external.ValidatorEarnings, error) {
+	return ars.ValidatorEarningsHandler(address, fromEpoch, toEpoch)
+}
+
+func (ars *ApiResolverStub) ExportChain(table string, fromNonce uint64, toNonce uint64, handler func(row []string) error) error {
+	return ars.ExportChainHandler(table, fromNonce, toNonce, handler)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ars *ApiResolverStub) IsInterfaceNil() bool {
 	if ars == nil {
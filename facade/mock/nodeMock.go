@@ -19,15 +19,17 @@ type NodeMock struct {
 	GetBalanceHandler          func(address string) (*big.Int, error)
 	GenerateTransactionHandler func(sender string, receiver string, amount *big.Int, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler   func(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64,
-		gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error)
+		gasLimit uint64, data string, signatureHex string, challenge string, validityWindow transaction.ValidityWindow) (*transaction.Transaction, error)
 	GetTransactionHandler                          func(hash string) (*transaction.Transaction, error)
-	SendTransactionHandler                         func(nonce uint64, sender string, receiver string, amount *big.Int, code string, signature []byte) (string, error)
+	SendTransactionHandler                         func(nonce uint64, sender string, receiver string, amount *big.Int, code string, signature []byte, validityWindow transaction.ValidityWindow) (string, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
 	GetAccountHandler                              func(address string) (*state.Account, error)
+	GetTokenBalancesHandler                        func(address string) (map[string]*big.Int, error)
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	GetHeartbeatsHandler                           func() []heartbeat.PubKeyHeartbeat
+	ComputeShardIdOfAddressHandler                 func(address string) (uint32, error)
 }
 
 func (nm *NodeMock) Address() (string, error) {
@@ -67,17 +69,17 @@ func (nm *NodeMock) GenerateTransaction(sender string, receiver string, amount *
 }
 
 func (nm *NodeMock) CreateTransaction(nonce uint64, value *big.Int, receiverHex string, senderHex string, gasPrice uint64,
-	gasLimit uint64, data string, signatureHex string, challenge string) (*transaction.Transaction, error) {
+	gasLimit uint64, data string, signatureHex string, challenge string, validityWindow transaction.ValidityWindow) (*transaction.Transaction, error) {
 
-	return nm.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge)
+	return nm.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, challenge, validityWindow)
 }
 
 func (nm *NodeMock) GetTransaction(hash string) (*transaction.Transaction, error) {
 	return nm.GetTransactionHandler(hash)
 }
 
-func (nm *NodeMock) SendTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, transactionData string, signature []byte) (string, error) {
-	return nm.SendTransactionHandler(nonce, sender, receiver, value, transactionData, signature)
+func (nm *NodeMock) SendTransaction(nonce uint64, sender string, receiver string, value *big.Int, gasPrice uint64, gasLimit uint64, transactionData string, signature []byte, validityWindow transaction.ValidityWindow) (string, error) {
+	return nm.SendTransactionHandler(nonce, sender, receiver, value, transactionData, signature, validityWindow)
 }
 
 func (nm *NodeMock) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
@@ -100,10 +102,18 @@ func (nm *NodeMock) GetAccount(address string) (*state.Account, error) {
 	return nm.GetAccountHandler(address)
 }
 
+func (nm *NodeMock) GetTokenBalances(address string) (map[string]*big.Int, error) {
+	return nm.GetTokenBalancesHandler(address)
+}
+
 func (nm *NodeMock) GetHeartbeats() []heartbeat.PubKeyHeartbeat {
 	return nm.GetHeartbeatsHandler()
 }
 
+func (nm *NodeMock) ComputeShardIdOfAddress(address string) (uint32, error) {
+	return nm.ComputeShardIdOfAddressHandler(address)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nm *NodeMock) IsInterfaceNil() bool {
 	if nm == nil {
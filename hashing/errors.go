@@ -0,0 +1,14 @@
+package hashing
+
+import (
+	"errors"
+)
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrUnknownHashedStructure signals that a hasher was registered for a data structure not hashed through the registry
+var ErrUnknownHashedStructure = errors.New("unknown hashed structure")
+
+// ErrHasherAlreadyRegistered signals that a hasher was already registered for the same structure and start epoch
+var ErrHasherAlreadyRegistered = errors.New("hasher already registered for the structure and start epoch")
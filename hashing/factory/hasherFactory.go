@@ -0,0 +1,27 @@
+package factory
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+)
+
+// ErrUnknownHasherType signals that a hasher of an unknown type was requested
+var ErrUnknownHasherType = errors.New("unknown hasher type")
+
+// NewHasher creates a hasher of the given type: sha256, blake2b or keccak
+func NewHasher(hasherType string) (hashing.Hasher, error) {
+	switch hasherType {
+	case "sha256":
+		return sha256.Sha256{}, nil
+	case "blake2b":
+		return blake2b.Blake2b{}, nil
+	case "keccak":
+		return keccak.Keccak{}, nil
+	}
+
+	return nil, ErrUnknownHasherType
+}
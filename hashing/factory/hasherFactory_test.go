@@ -0,0 +1,36 @@
+package factory_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHasher_UnknownTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hasher, err := factory.NewHasher("unknown")
+
+	assert.Nil(t, hasher)
+	assert.Equal(t, factory.ErrUnknownHasherType, err)
+}
+
+func TestNewHasher_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hasher, err := factory.NewHasher("sha256")
+	assert.Nil(t, err)
+	assert.Equal(t, sha256.Sha256{}, hasher)
+
+	hasher, err = factory.NewHasher("blake2b")
+	assert.Nil(t, err)
+	assert.Equal(t, blake2b.Blake2b{}, hasher)
+
+	hasher, err = factory.NewHasher("keccak")
+	assert.Nil(t, err)
+	assert.Equal(t, keccak.Keccak{}, hasher)
+}
@@ -7,3 +7,10 @@ type Hasher interface {
 	Size() int
 	IsInterfaceNil() bool
 }
+
+// HasherRegistry provides, for each hashed data structure, the hasher used in an epoch
+type HasherRegistry interface {
+	HasherForEpoch(structure string, epoch uint32) Hasher
+	DefaultHasher() Hasher
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,101 @@
+package hashing
+
+import (
+	"sort"
+	"sync"
+)
+
+// TrieNodesStructure identifies the nodes of the accounts tries
+const TrieNodesStructure = "trieNodes"
+
+// P2PChunksStructure identifies the chunks, and the payloads split in chunks, of the large p2p messages
+const P2PChunksStructure = "p2pChunks"
+
+// hashedStructures holds the data structures whose hasher can be chosen through the registry
+var hashedStructures = map[string]struct{}{
+	TrieNodesStructure: {},
+	P2PChunksStructure: {},
+}
+
+type hasherVersion struct {
+	startEpoch uint32
+	hasher     Hasher
+}
+
+type hasherRegistry struct {
+	defaultHasher Hasher
+	mutHashers    sync.RWMutex
+	hashers       map[string][]hasherVersion
+}
+
+// NewHasherRegistry creates a new hasher registry. The structures without a hasher registered for an epoch are
+// hashed with the default hasher
+func NewHasherRegistry(defaultHasher Hasher) (*hasherRegistry, error) {
+	if defaultHasher == nil || defaultHasher.IsInterfaceNil() {
+		return nil, ErrNilHasher
+	}
+
+	return &hasherRegistry{
+		defaultHasher: defaultHasher,
+		hashers:       make(map[string][]hasherVersion),
+	}, nil
+}
+
+// Register sets the hasher used for a data structure starting with the given epoch, until the next registered
+// start epoch of the same structure
+func (hr *hasherRegistry) Register(structure string, startEpoch uint32, hasher Hasher) error {
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return ErrNilHasher
+	}
+	if _, ok := hashedStructures[structure]; !ok {
+		return ErrUnknownHashedStructure
+	}
+
+	hr.mutHashers.Lock()
+	defer hr.mutHashers.Unlock()
+
+	versions := hr.hashers[structure]
+	for _, version := range versions {
+		if version.startEpoch == startEpoch {
+			return ErrHasherAlreadyRegistered
+		}
+	}
+
+	versions = append(versions, hasherVersion{startEpoch: startEpoch, hasher: hasher})
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].startEpoch < versions[j].startEpoch
+	})
+	hr.hashers[structure] = versions
+
+	return nil
+}
+
+// HasherForEpoch returns the hasher of the data structure registered with the greatest start epoch not after the
+// given epoch, or the default hasher if there is none
+func (hr *hasherRegistry) HasherForEpoch(structure string, epoch uint32) Hasher {
+	hr.mutHashers.RLock()
+	defer hr.mutHashers.RUnlock()
+
+	hasher := hr.defaultHasher
+	for _, version := range hr.hashers[structure] {
+		if version.startEpoch > epoch {
+			break
+		}
+		hasher = version.hasher
+	}
+
+	return hasher
+}
+
+// DefaultHasher returns the hasher used for all the data structures not registered
+func (hr *hasherRegistry) DefaultHasher() Hasher {
+	return hr.defaultHasher
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hr *hasherRegistry) IsInterfaceNil() bool {
+	if hr == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package hashing_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHasherRegistry_NilDefaultHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hr, err := hashing.NewHasherRegistry(nil)
+
+	assert.Nil(t, hr)
+	assert.Equal(t, hashing.ErrNilHasher, err)
+}
+
+func TestNewHasherRegistry_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hr, err := hashing.NewHasherRegistry(sha256.Sha256{})
+
+	assert.Nil(t, err)
+	assert.False(t, hr.IsInterfaceNil())
+	assert.Equal(t, sha256.Sha256{}, hr.DefaultHasher())
+}
+
+func TestHasherRegistry_RegisterNilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := hashing.NewHasherRegistry(sha256.Sha256{})
+
+	err := hr.Register(hashing.TrieNodesStructure, 0, nil)
+
+	assert.Equal(t, hashing.ErrNilHasher, err)
+}
+
+func TestHasherRegistry_RegisterUnknownStructureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := hashing.NewHasherRegistry(sha256.Sha256{})
+
+	err := hr.Register("unknown", 0, keccak.Keccak{})
+
+	assert.Equal(t, hashing.ErrUnknownHashedStructure, err)
+}
+
+func TestHasherRegistry_RegisterSameStartEpochTwiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := hashing.NewHasherRegistry(sha256.Sha256{})
+	_ = hr.Register(hashing.TrieNodesStructure, 3, keccak.Keccak{})
+
+	err := hr.Register(hashing.TrieNodesStructure, 3, blake2b.Blake2b{})
+
+	assert.Equal(t, hashing.ErrHasherAlreadyRegistered, err)
+	assert.Equal(t, keccak.Keccak{}, hr.HasherForEpoch(hashing.TrieNodesStructure, 3))
+}
+
+func TestHasherRegistry_HasherForEpochNotRegisteredShouldReturnDefault(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := hashing.NewHasherRegistry(sha256.Sha256{})
+	_ = hr.Register(hashing.TrieNodesStructure, 0, keccak.Keccak{})
+
+	assert.Equal(t, sha256.Sha256{}, hr.HasherForEpoch(hashing.P2PChunksStructure, 0))
+}
+
+func TestHasherRegistry_HasherForEpochShouldSelectVersion(t *testing.T) {
+	t.Parallel()
+
+	hr, _ := hashing.NewHasherRegistry(sha256.Sha256{})
+	_ = hr.Register(hashing.TrieNodesStructure, 10, blake2b.Blake2b{})
+	_ = hr.Register(hashing.TrieNodesStructure, 5, keccak.Keccak{})
+
+	assert.Equal(t, sha256.Sha256{}, hr.HasherForEpoch(hashing.TrieNodesStructure, 4))
+	assert.Equal(t, keccak.Keccak{}, hr.HasherForEpoch(hashing.TrieNodesStructure, 5))
+	assert.Equal(t, keccak.Keccak{}, hr.HasherForEpoch(hashing.TrieNodesStructure, 9))
+	assert.Equal(t, blake2b.Blake2b{}, hr.HasherForEpoch(hashing.TrieNodesStructure, 10))
+	assert.Equal(t, blake2b.Blake2b{}, hr.HasherForEpoch(hashing.TrieNodesStructure, 100))
+}
@@ -0,0 +1,192 @@
+//go:build byzantine
+// +build byzantine
+
+package byzantine
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/stretchr/testify/assert"
+)
+
+var stepDelay = time.Second * 2
+
+func setupNodes(
+	numHonestNodes int,
+	config integrationTests.ByzantineConfig,
+) ([]*integrationTests.TestProcessorNode, *integrationTests.TestByzantineNode, p2p.Messenger) {
+
+	maxShards := uint32(1)
+	shardId := uint32(0)
+
+	advertiser := integrationTests.CreateMessengerWithKadDht(context.Background(), "")
+	_ = advertiser.Bootstrap()
+	advertiserAddr := integrationTests.GetConnectableAddress(advertiser)
+
+	honestNodes := make([]*integrationTests.TestProcessorNode, numHonestNodes)
+	for i := 0; i < numHonestNodes; i++ {
+		honestNodes[i] = integrationTests.NewTestProcessorNode(maxShards, shardId, shardId, advertiserAddr)
+	}
+	byzantineNode := integrationTests.NewTestByzantineNode(
+		integrationTests.NewTestProcessorNode(maxShards, shardId, shardId, advertiserAddr),
+		config,
+	)
+
+	for _, n := range honestNodes {
+		_ = n.Messenger.Bootstrap()
+	}
+	_ = byzantineNode.Messenger.Bootstrap()
+
+	fmt.Println("Delaying for nodes p2p bootstrap...")
+	time.Sleep(stepDelay)
+
+	return honestNodes, byzantineNode, advertiser
+}
+
+func closeNodes(
+	honestNodes []*integrationTests.TestProcessorNode,
+	byzantineNode *integrationTests.TestByzantineNode,
+	advertiser p2p.Messenger,
+) {
+	_ = advertiser.Close()
+	for _, n := range honestNodes {
+		_ = n.Messenger.Close()
+	}
+	_ = byzantineNode.Messenger.Close()
+}
+
+func proposeAndBroadcastBlock(byzantineNode *integrationTests.TestByzantineNode, round uint64, nonce uint64) {
+	body, header, _ := byzantineNode.ProposeBlock(round, nonce)
+	byzantineNode.BroadcastBlock(body, header)
+	byzantineNode.CommitBlock(body, header)
+
+	fmt.Println("Delaying for disseminating headers and miniblocks...")
+	time.Sleep(stepDelay)
+}
+
+func TestByzantineNode_WithheldProposalShouldNotReachTheHonestNodes(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	honestNodes, byzantineNode, advertiser := setupNodes(3, integrationTests.ByzantineConfig{
+		WithholdProposals: true,
+	})
+	defer closeNodes(honestNodes, byzantineNode, advertiser)
+
+	proposeAndBroadcastBlock(byzantineNode, 1, 1)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&byzantineNode.NumWithheldProposals))
+	for _, n := range honestNodes {
+		assert.Equal(t, int32(0), atomic.LoadInt32(&n.CounterHdrRecv))
+	}
+}
+
+func TestByzantineNode_ConflictingHeadersShouldReachTheHonestNodes(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	honestNodes, byzantineNode, advertiser := setupNodes(3, integrationTests.ByzantineConfig{
+		SendConflictingHeaders: true,
+	})
+	defer closeNodes(honestNodes, byzantineNode, advertiser)
+
+	nonce := uint64(1)
+	proposeAndBroadcastBlock(byzantineNode, 1, nonce)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&byzantineNode.NumConflictingHeaders))
+	for _, n := range honestNodes {
+		numHeadersWithNonce := 0
+		for _, key := range n.ShardDataPool.Headers().Keys() {
+			value, ok := n.ShardDataPool.Headers().Peek(key)
+			if !ok {
+				continue
+			}
+
+			hdr, ok := value.(*block.Header)
+			if ok && hdr.Nonce == nonce {
+				numHeadersWithNonce++
+			}
+		}
+
+		// the two headers with the same nonce are the input of the fork detection
+		assert.Equal(t, 2, numHeadersWithNonce)
+	}
+}
+
+func TestByzantineNode_CorruptMiniBlocksShouldBeRejectedByTheHonestNodes(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	honestNodes, byzantineNode, advertiser := setupNodes(3, integrationTests.ByzantineConfig{
+		CorruptMiniBlocks: true,
+	})
+	defer closeNodes(honestNodes, byzantineNode, advertiser)
+
+	_, header, _ := byzantineNode.ProposeBlock(1, 1)
+	body := block.Body{
+		{
+			ReceiverShardID: 0,
+			SenderShardID:   0,
+			TxHashes: [][]byte{
+				integrationTests.TestHasher.Compute("tx1"),
+			},
+		},
+	}
+	byzantineNode.BroadcastBlock(body, header)
+
+	fmt.Println("Delaying for disseminating headers and miniblocks...")
+	time.Sleep(stepDelay)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&byzantineNode.NumCorruptMiniBlocks))
+	for _, n := range honestNodes {
+		assert.Equal(t, int32(1), atomic.LoadInt32(&n.CounterHdrRecv))
+		assert.Equal(t, int32(0), atomic.LoadInt32(&n.CounterMbRecv))
+	}
+}
+
+func TestByzantineNode_SpamShouldNotFillTheHonestNodesPools(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	numSpamMessages := 100
+	honestNodes, byzantineNode, advertiser := setupNodes(3, integrationTests.ByzantineConfig{
+		SpamMessagesPerTopic: numSpamMessages,
+		SpamMessageSize:      128,
+	})
+	defer closeNodes(honestNodes, byzantineNode, advertiser)
+
+	selfIdentifier := byzantineNode.ShardCoordinator.CommunicationIdentifier(byzantineNode.ShardCoordinator.SelfId())
+	byzantineNode.Config.SpamTopics = []string{
+		factory.TransactionTopic + selfIdentifier,
+		factory.MiniBlocksTopic + selfIdentifier,
+	}
+	byzantineNode.Spam()
+
+	fmt.Println("Delaying for disseminating the spam messages...")
+	time.Sleep(stepDelay)
+
+	assert.Equal(t, int32(2*numSpamMessages), atomic.LoadInt32(&byzantineNode.NumSpamMessages))
+	for _, n := range honestNodes {
+		assert.Equal(t, int32(0), atomic.LoadInt32(&n.CounterTxRecv))
+		assert.Equal(t, int32(0), atomic.LoadInt32(&n.CounterMbRecv))
+	}
+
+	// the honest nodes should still accept the valid blocks after being spammed
+	byzantineNode.Config.SpamTopics = nil
+	proposeAndBroadcastBlock(byzantineNode, 1, 1)
+	for _, n := range honestNodes {
+		assert.Equal(t, int32(1), atomic.LoadInt32(&n.CounterHdrRecv))
+	}
+}
@@ -0,0 +1,314 @@
+package fuzz
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	procBlock "github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+)
+
+const corpusDirName = "corpus"
+
+// FuzzFunctions maps the name of each fuzz function, as given to go-fuzz-build through the -func flag, to the
+// function itself
+var FuzzFunctions = map[string]func(input []byte) int{
+	"FuzzShardInterceptors": FuzzShardInterceptors,
+	"FuzzMetaInterceptors":  FuzzMetaInterceptors,
+	"FuzzShardResolvers":    FuzzShardResolvers,
+	"FuzzMetaResolvers":     FuzzMetaResolvers,
+}
+
+// Seeds returns the corpus seeds of the provided fuzz function, indexed by a file name. The seeds are messages
+// marshaled the same way the nodes marshal them on the wire, prefixed by the byte selecting their target
+func Seeds(fuzzFunction string) (map[string][]byte, error) {
+	switch fuzzFunction {
+	case "FuzzShardInterceptors":
+		return ShardHarness().interceptorSeeds()
+	case "FuzzMetaInterceptors":
+		return MetaHarness().interceptorSeeds()
+	case "FuzzShardResolvers":
+		return ShardHarness().resolverSeeds()
+	case "FuzzMetaResolvers":
+		return MetaHarness().resolverSeeds()
+	default:
+		return nil, ErrUnknownFuzzFunction
+	}
+}
+
+// WriteCorpus writes the seeds of each fuzz function in the corpus directory of its go-fuzz working directory,
+// found under workdir and named after the fuzz function. The existing corpus entries are kept
+func WriteCorpus(workdir string) error {
+	for fuzzFunction := range FuzzFunctions {
+		seeds, err := Seeds(fuzzFunction)
+		if err != nil {
+			return err
+		}
+
+		corpusDir := filepath.Join(workdir, fuzzFunction, corpusDirName)
+		err = os.MkdirAll(corpusDir, os.ModePerm)
+		if err != nil {
+			return err
+		}
+
+		for name, seed := range seeds {
+			err = ioutil.WriteFile(filepath.Join(corpusDir, name), seed, 0644)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h *Harness) interceptorSeeds() (map[string][]byte, error) {
+	return seedsOf(h.interceptors, h.interceptorPayloads)
+}
+
+func (h *Harness) resolverSeeds() (map[string][]byte, error) {
+	return seedsOf(h.resolvers, func(topic string) ([][]byte, error) {
+		return requestPayloads()
+	})
+}
+
+func seedsOf(targets []*target, payloadsOf func(topic string) ([][]byte, error)) (map[string][]byte, error) {
+	seeds := make(map[string][]byte)
+	for index, t := range targets {
+		payloads, err := payloadsOf(t.topic)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, payload := range payloads {
+			name := fmt.Sprintf("%s-%d", t.topic, i)
+			seeds[name] = append([]byte{byte(index)}, payload...)
+		}
+	}
+
+	return seeds, nil
+}
+
+// interceptorPayloads returns well formed messages of the data type intercepted on the provided topic
+func (h *Harness) interceptorPayloads(topic string) ([][]byte, error) {
+	baseTopic := strings.Split(topic, "_")[0]
+	selfId := h.shardCoordinator.SelfId()
+
+	var objects []interface{}
+	switch baseTopic {
+	case factory.TransactionTopic:
+		sender := h.addressInShard(selfId)
+		objects = []interface{}{
+			txBatch{&transaction.Transaction{
+				Nonce:     0,
+				Value:     big.NewInt(10),
+				RcvAddr:   h.addressInShard(0),
+				SndAddr:   sender,
+				GasPrice:  integrationTests.MinTxGasPrice,
+				GasLimit:  integrationTests.MinTxGasLimit,
+				Signature: []byte("signature"),
+			}},
+			txBatch{&transaction.Transaction{
+				Nonce:          1,
+				Value:          big.NewInt(0),
+				RcvAddr:        h.addressInShard(0),
+				SndAddr:        sender,
+				GasPrice:       integrationTests.MinTxGasPrice,
+				GasLimit:       integrationTests.MinTxGasLimit + 100,
+				Data:           "function@01",
+				Signature:      []byte("signature"),
+				NotBeforeRound: 1,
+				NotAfterRound:  100,
+			}},
+		}
+	case factory.UnsignedTransactionTopic:
+		objects = []interface{}{
+			txBatch{&smartContractResult.SmartContractResult{
+				Nonce:   1,
+				Value:   big.NewInt(10),
+				RcvAddr: h.addressInShard(selfId),
+				SndAddr: h.addressInShard(0),
+				Data:    "data",
+				TxHash:  integrationTests.TestHasher.Compute("tx"),
+			}},
+		}
+	case factory.RewardsTransactionTopic:
+		objects = []interface{}{
+			txBatch{&rewardTx.RewardTx{
+				Round:   1,
+				Epoch:   0,
+				Value:   big.NewInt(10),
+				RcvAddr: h.addressInShard(selfId),
+				ShardId: selfId,
+			}},
+		}
+	case factory.HeadersTopic, factory.ShardHeadersForMetachainTopic:
+		objects = []interface{}{createHeader(0)}
+	case factory.MetachainBlocksTopic:
+		objects = []interface{}{createMetaBlock()}
+	case factory.MiniBlocksTopic:
+		receiverShardId := selfId
+		if receiverShardId >= h.shardCoordinator.NumberOfShards() {
+			// the metachain nodes only receive the miniblocks exchanged between shards
+			receiverShardId = 0
+		}
+		objects = []interface{}{
+			[]*block.MiniBlock{
+				{
+					TxHashes:        [][]byte{integrationTests.TestHasher.Compute("tx")},
+					ReceiverShardID: receiverShardId,
+					SenderShardID:   0,
+					Type:            block.TxBlock,
+				},
+			},
+		}
+	case factory.PeerChBodyTopic:
+		objects = []interface{}{
+			&procBlock.InterceptedPeerBlockBody{
+				PeerBlockBody: []*block.PeerChange{{PubKey: []byte("public key"), ShardIdDest: selfId}},
+			},
+		}
+	case factory.FinalityProofsTopic:
+		header := createHeader(0)
+		objects = []interface{}{
+			block.NewFinalityProof(
+				header,
+				integrationTests.TestHasher.Compute("header"),
+				integrationTests.TestHasher.Compute("signed header"),
+			),
+		}
+	default:
+		return nil, ErrUnknownTopic
+	}
+
+	return marshalAll(objects)
+}
+
+// requestPayloads returns a request of each type handled by the resolvers
+func requestPayloads() ([][]byte, error) {
+	hashes, err := integrationTests.TestMarshalizer.Marshal([][]byte{
+		integrationTests.TestHasher.Compute("first"),
+		integrationTests.TestHasher.Compute("second"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalAll([]interface{}{
+		&dataRetriever.RequestData{Type: dataRetriever.HashType, Value: integrationTests.TestHasher.Compute("hash")},
+		&dataRetriever.RequestData{Type: dataRetriever.HashArrayType, Value: hashes},
+		&dataRetriever.RequestData{Type: dataRetriever.NonceType, Value: integrationTests.TestUint64Converter.ToByteSlice(1)},
+	})
+}
+
+// txBatch holds transactions sent, as the nodes do, in a single message holding the slice of the marshaled
+// transactions
+type txBatch []interface{}
+
+func marshalAll(objects []interface{}) ([][]byte, error) {
+	payloads := make([][]byte, 0, len(objects))
+	for _, obj := range objects {
+		batch, isBatch := obj.(txBatch)
+		if isBatch {
+			txsBuff, err := marshalAll(batch)
+			if err != nil {
+				return nil, err
+			}
+
+			obj = txsBuff
+		}
+
+		buff, err := integrationTests.TestMarshalizer.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+
+		payloads = append(payloads, buff)
+	}
+
+	return payloads, nil
+}
+
+// addressInShard returns an address belonging to the provided shard
+func (h *Harness) addressInShard(shardId uint32) []byte {
+	for i := 0; i < 256; i++ {
+		address := make([]byte, integrationTests.TestAddressConverter.AddressLen())
+		address[len(address)-1] = byte(i)
+		container, err := integrationTests.TestAddressConverter.CreateAddressFromPublicKeyBytes(address)
+		if err != nil {
+			continue
+		}
+		if h.shardCoordinator.ComputeId(container) == shardId {
+			return address
+		}
+	}
+
+	return make([]byte, integrationTests.TestAddressConverter.AddressLen())
+}
+
+func createHeader(shardId uint32) *block.Header {
+	return &block.Header{
+		Nonce:         1,
+		PrevHash:      integrationTests.TestHasher.Compute("prev header"),
+		PrevRandSeed:  []byte("prev rand seed"),
+		RandSeed:      []byte("rand seed"),
+		PubKeysBitmap: []byte{1},
+		ShardId:       shardId,
+		TimeStamp:     1,
+		Round:         1,
+		BlockBodyType: block.TxBlock,
+		Signature:     []byte("signature"),
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{
+				Hash:            integrationTests.TestHasher.Compute("miniblock"),
+				SenderShardID:   shardId,
+				ReceiverShardID: shardId,
+				TxCount:         1,
+				Type:            block.TxBlock,
+			},
+		},
+		RootHash:        integrationTests.TestHasher.Compute("root"),
+		TxCount:         1,
+		AccumulatedFees: big.NewInt(0),
+	}
+}
+
+func createMetaBlock() *block.MetaBlock {
+	return &block.MetaBlock{
+		Nonce:     1,
+		Round:     1,
+		TimeStamp: 1,
+		ShardInfo: []block.ShardData{
+			{
+				ShardId:    0,
+				HeaderHash: integrationTests.TestHasher.Compute("header"),
+				ShardMiniBlockHeaders: []block.ShardMiniBlockHeader{
+					{
+						Hash:            integrationTests.TestHasher.Compute("miniblock"),
+						ReceiverShardId: 1,
+						SenderShardId:   0,
+						TxCount:         1,
+					},
+				},
+				TxCount: 1,
+			},
+		},
+		Signature:     []byte("signature"),
+		PubKeysBitmap: []byte{1},
+		PrevHash:      integrationTests.TestHasher.Compute("prev meta block"),
+		PrevRandSeed:  []byte("prev rand seed"),
+		RandSeed:      []byte("rand seed"),
+		RootHash:      integrationTests.TestHasher.Compute("root"),
+		TxCount:       1,
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go/integrationTests/fuzz"
+	"github.com/urfave/cli"
+)
+
+var workdir = cli.StringFlag{
+	Name:  "workdir",
+	Usage: "The directory holding the go-fuzz working directory of each fuzz function",
+	Value: "./workdir",
+}
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "Fuzzing corpus generation tool"
+	app.Version = "v0.0.1"
+	app.Usage = "This binary writes the seeds of the interceptors and resolvers fuzz functions in their go-fuzz corpus"
+	app.Flags = []cli.Flag{workdir}
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return fuzz.WriteCorpus(c.GlobalString(workdir.Name))
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,14 @@
+package fuzz
+
+import (
+	"errors"
+)
+
+// ErrEmptyInput signals that an empty fuzzed input has been provided, lacking the byte selecting the target
+var ErrEmptyInput = errors.New("empty fuzzed input")
+
+// ErrUnknownFuzzFunction signals that the provided fuzz function does not exist
+var ErrUnknownFuzzFunction = errors.New("unknown fuzz function")
+
+// ErrUnknownTopic signals that no seeds are known for the provided topic
+var ErrUnknownTopic = errors.New("unknown topic")
@@ -0,0 +1,76 @@
+package fuzz
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+const selfShardId = 0
+
+var shardHarness *Harness
+var metaHarness *Harness
+var onceShard sync.Once
+var onceMeta sync.Once
+
+// ShardHarness returns the harness of a shard node, created on first use and shared by the fuzz functions
+func ShardHarness() *Harness {
+	onceShard.Do(func() {
+		shardHarness = mustCreateHarness(selfShardId)
+	})
+
+	return shardHarness
+}
+
+// MetaHarness returns the harness of a metachain node, created on first use and shared by the fuzz functions
+func MetaHarness() *Harness {
+	onceMeta.Do(func() {
+		metaHarness = mustCreateHarness(sharding.MetachainShardId)
+	})
+
+	return metaHarness
+}
+
+// mustCreateHarness panics if the harness can not be created, as the fuzzer would otherwise report the
+// misconfiguration as a crash of each fuzzed input
+func mustCreateHarness(shardId uint32) *Harness {
+	h, err := NewHarness(shardId)
+	if err != nil {
+		panic("could not create the fuzzing harness: " + err.Error())
+	}
+
+	return h
+}
+
+// FuzzShardInterceptors is the fuzz function of the interceptors of a shard node
+func FuzzShardInterceptors(input []byte) int {
+	return fuzzResult(ShardHarness().ProcessInterceptorMessage(input))
+}
+
+// FuzzMetaInterceptors is the fuzz function of the interceptors of a metachain node
+func FuzzMetaInterceptors(input []byte) int {
+	return fuzzResult(MetaHarness().ProcessInterceptorMessage(input))
+}
+
+// FuzzShardResolvers is the fuzz function of the resolvers of a shard node
+func FuzzShardResolvers(input []byte) int {
+	return fuzzResult(ShardHarness().ProcessResolverMessage(input))
+}
+
+// FuzzMetaResolvers is the fuzz function of the resolvers of a metachain node
+func FuzzMetaResolvers(input []byte) int {
+	return fuzzResult(MetaHarness().ProcessResolverMessage(input))
+}
+
+// fuzzResult tells the fuzzer to favour the inputs accepted by the processors, as they reach deeper code paths, and
+// to drop the inputs lacking the target selecting byte
+func fuzzResult(err error) int {
+	if err == ErrEmptyInput {
+		return -1
+	}
+	if err != nil {
+		return 0
+	}
+
+	return 1
+}
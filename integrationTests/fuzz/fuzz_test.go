@@ -0,0 +1,108 @@
+package fuzz_test
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/integrationTests/fuzz"
+	"github.com/stretchr/testify/assert"
+)
+
+const numMutationsPerSeed = 50
+
+func TestFuzzFunctions_EmptyInputShouldBeDropped(t *testing.T) {
+	for name, fuzzFunction := range fuzz.FuzzFunctions {
+		assert.Equal(t, -1, fuzzFunction(nil), name)
+	}
+}
+
+func TestFuzzFunctions_SeedsShouldCoverAllTargets(t *testing.T) {
+	harnesses := map[string][]string{
+		"FuzzShardInterceptors": fuzz.ShardHarness().InterceptorTopics(),
+		"FuzzMetaInterceptors":  fuzz.MetaHarness().InterceptorTopics(),
+		"FuzzShardResolvers":    fuzz.ShardHarness().ResolverTopics(),
+		"FuzzMetaResolvers":     fuzz.MetaHarness().ResolverTopics(),
+	}
+
+	for name, topics := range harnesses {
+		seeds, err := fuzz.Seeds(name)
+		assert.Nil(t, err)
+
+		coveredTargets := make(map[byte]struct{})
+		for _, seed := range seeds {
+			coveredTargets[seed[0]] = struct{}{}
+		}
+		assert.Equal(t, len(topics), len(coveredTargets), name)
+	}
+}
+
+func TestFuzzFunctions_SeedsShouldBeAccepted(t *testing.T) {
+	for name, fuzzFunction := range fuzz.FuzzFunctions {
+		seeds, _ := fuzz.Seeds(name)
+
+		numAccepted := 0
+		for _, seed := range seeds {
+			if fuzzFunction(seed) == 1 {
+				numAccepted++
+			}
+		}
+		assert.True(t, numAccepted > 0, name)
+	}
+}
+
+func TestFuzzFunctions_MutatedSeedsShouldNotPanic(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for name, fuzzFunction := range fuzz.FuzzFunctions {
+		seeds, _ := fuzz.Seeds(name)
+
+		for _, seed := range seeds {
+			for i := 0; i < numMutationsPerSeed; i++ {
+				_ = fuzzFunction(mutate(r, seed))
+			}
+		}
+	}
+}
+
+// mutate returns a copy of the input, keeping the target selecting byte, with a few bytes flipped and randomly
+// truncated, so that the decoders see malformed payloads
+func mutate(r *rand.Rand, input []byte) []byte {
+	mutated := append(make([]byte, 0, len(input)), input...)
+	for i := 0; i < 1+r.Intn(4); i++ {
+		position := 1 + r.Intn(len(mutated)-1)
+		mutated[position] ^= byte(1 + r.Intn(255))
+	}
+	if r.Intn(4) == 0 {
+		mutated = mutated[:1+r.Intn(len(mutated)-1)]
+	}
+
+	return mutated
+}
+
+func TestWriteCorpus_ShouldWriteTheSeedsOfEachFuzzFunction(t *testing.T) {
+	workdir, err := ioutil.TempDir("", "fuzz")
+	assert.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(workdir)
+	}()
+
+	err = fuzz.WriteCorpus(workdir)
+	assert.Nil(t, err)
+
+	for name := range fuzz.FuzzFunctions {
+		seeds, _ := fuzz.Seeds(name)
+		files, errRead := ioutil.ReadDir(filepath.Join(workdir, name, "corpus"))
+		assert.Nil(t, errRead)
+		assert.Equal(t, len(seeds), len(files), name)
+	}
+}
+
+func TestSeeds_UnknownFuzzFunctionShouldErr(t *testing.T) {
+	seeds, err := fuzz.Seeds("FuzzUnknown")
+
+	assert.Nil(t, seeds)
+	assert.Equal(t, fuzz.ErrUnknownFuzzFunction, err)
+}
@@ -0,0 +1,371 @@
+package fuzz
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/partitioning"
+	"github.com/ElrondNetwork/elrond-go/core/random"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	metaResolvers "github.com/ElrondNetwork/elrond-go/dataRetriever/factory/metachain"
+	shardResolvers "github.com/ElrondNetwork/elrond-go/dataRetriever/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers/topicResolverSender"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/interceptors"
+	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
+	"github.com/ElrondNetwork/elrond-go/process/economics"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	metaInterceptors "github.com/ElrondNetwork/elrond-go/process/factory/metachain"
+	shardInterceptors "github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+const numOfShards = 2
+const maxTxNonceDeltaAllowed = 100
+const epochGraceWindow = 1
+const finalityProofsCacheSize = 1000
+
+// target is a message processor fed with the fuzzed messages of its topic
+type target struct {
+	topic     string
+	processor p2p.MessageProcessor
+}
+
+// Harness holds the interceptors and the resolvers of a node, created through the same container factories the node
+// uses, and feeds them the fuzzed messages as if they were received from a peer. The signatures are not verified, so
+// that the fuzzed messages reach the code paths following the signature checks
+type Harness struct {
+	shardCoordinator sharding.Coordinator
+	peerMessenger    p2p.Messenger
+	interceptors     []*target
+	resolvers        []*target
+}
+
+// NewHarness creates the interceptors and resolvers of a node of the provided shard, the metachain included
+func NewHarness(selfShardId uint32) (*Harness, error) {
+	network, err := memp2p.NewNetwork()
+	if err != nil {
+		return nil, err
+	}
+	nodeMessenger, err := memp2p.NewMessenger(network)
+	if err != nil {
+		return nil, err
+	}
+	peerMessenger, err := memp2p.NewMessenger(network)
+	if err != nil {
+		return nil, err
+	}
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(numOfShards, selfShardId)
+	if err != nil {
+		return nil, err
+	}
+
+	var interceptorsContainer process.InterceptorsContainer
+	var resolversContainer dataRetriever.ResolversContainer
+	if selfShardId == sharding.MetachainShardId {
+		interceptorsContainer, resolversContainer, err = createMetaContainers(shardCoordinator, nodeMessenger)
+	} else {
+		interceptorsContainer, resolversContainer, err = createShardContainers(shardCoordinator, nodeMessenger)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = addFinalityProofInterceptorAndResolver(shardCoordinator, nodeMessenger, interceptorsContainer, resolversContainer)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &Harness{
+		shardCoordinator: shardCoordinator,
+		peerMessenger:    peerMessenger,
+		interceptors:     make([]*target, 0),
+		resolvers:        make([]*target, 0),
+	}
+	interceptorsContainer.Iterate(func(key string, interceptor process.Interceptor) bool {
+		h.interceptors = append(h.interceptors, &target{topic: key, processor: interceptor})
+		return true
+	})
+	resolversContainer.Iterate(func(key string, resolver dataRetriever.Resolver) bool {
+		h.resolvers = append(h.resolvers, &target{topic: key, processor: resolver})
+		return true
+	})
+	sortTargets(h.interceptors)
+	sortTargets(h.resolvers)
+
+	return h, nil
+}
+
+// sortTargets orders the targets by topic, so that the first byte of a fuzzed input always selects the same target
+func sortTargets(targets []*target) {
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].topic < targets[j].topic
+	})
+}
+
+// InterceptorTopics returns the topics of the interceptors, in the order they are selected by the fuzzed inputs
+func (h *Harness) InterceptorTopics() []string {
+	return topics(h.interceptors)
+}
+
+// ResolverTopics returns the topics of the resolvers, in the order they are selected by the fuzzed inputs
+func (h *Harness) ResolverTopics() []string {
+	return topics(h.resolvers)
+}
+
+func topics(targets []*target) []string {
+	result := make([]string, 0, len(targets))
+	for _, t := range targets {
+		result = append(result, t.topic)
+	}
+
+	return result
+}
+
+// ProcessInterceptorMessage hands the fuzzed input to an interceptor. The first byte of the input selects the
+// interceptor, the remaining bytes being the message payload
+func (h *Harness) ProcessInterceptorMessage(input []byte) error {
+	return h.processMessage(h.interceptors, input)
+}
+
+// ProcessResolverMessage hands the fuzzed input to a resolver. The first byte of the input selects the resolver, the
+// remaining bytes being the request payload
+func (h *Harness) ProcessResolverMessage(input []byte) error {
+	return h.processMessage(h.resolvers, input)
+}
+
+func (h *Harness) processMessage(targets []*target, input []byte) error {
+	if len(input) == 0 {
+		return ErrEmptyInput
+	}
+
+	t := targets[int(input[0])%len(targets)]
+	message, err := memp2p.NewMessage(t.topic, input[1:], h.peerMessenger.ID())
+	if err != nil {
+		return err
+	}
+
+	return t.processor.ProcessReceivedMessage(context.Background(), message)
+}
+
+func createShardContainers(
+	shardCoordinator sharding.Coordinator,
+	messenger dataRetriever.TopicMessageHandler,
+) (process.InterceptorsContainer, dataRetriever.ResolversContainer, error) {
+	store := integrationTests.CreateShardStore(numOfShards)
+	dataPool := integrationTests.CreateTestShardDataPool(nil)
+	accounts, _, _ := integrationTests.CreateAccountsDB(0)
+	blockChain := integrationTests.CreateShardChain()
+	feeHandler, headerValidator, err := createValidators(blockChain)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	interceptorsFactory, err := shardInterceptors.NewInterceptorsContainerFactory(
+		accounts,
+		shardCoordinator,
+		&mock.NodesCoordinatorMock{},
+		messenger,
+		store,
+		integrationTests.TestMarshalizer,
+		integrationTests.TestHasher,
+		&mock.KeyGenMock{},
+		&mock.SignerMock{},
+		integrationTests.TestMultiSig,
+		dataPool,
+		integrationTests.TestAddressConverter,
+		maxTxNonceDeltaAllowed,
+		feeHandler,
+		headerValidator,
+		blockChain,
+		transaction.NewValidityWindowChecker(0),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	interceptorsContainer, err := interceptorsFactory.Create()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataPacker, err := partitioning.NewSimpleDataPacker(integrationTests.TestMarshalizer)
+	if err != nil {
+		return nil, nil, err
+	}
+	resolversFactory, err := shardResolvers.NewResolversContainerFactory(
+		shardCoordinator,
+		messenger,
+		store,
+		integrationTests.TestMarshalizer,
+		dataPool,
+		integrationTests.TestUint64Converter,
+		dataPacker,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	resolversContainer, err := resolversFactory.Create()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return interceptorsContainer, resolversContainer, nil
+}
+
+func createMetaContainers(
+	shardCoordinator sharding.Coordinator,
+	messenger dataRetriever.TopicMessageHandler,
+) (process.InterceptorsContainer, dataRetriever.ResolversContainer, error) {
+	store := integrationTests.CreateMetaStore(shardCoordinator)
+	dataPool := integrationTests.CreateTestMetaDataPool()
+	accounts, _, _ := integrationTests.CreateAccountsDB(0)
+	blockChain := integrationTests.CreateMetaChain()
+	feeHandler, headerValidator, err := createValidators(blockChain)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	interceptorsFactory, err := metaInterceptors.NewInterceptorsContainerFactory(
+		shardCoordinator,
+		&mock.NodesCoordinatorMock{},
+		messenger,
+		store,
+		integrationTests.TestMarshalizer,
+		integrationTests.TestHasher,
+		integrationTests.TestMultiSig,
+		dataPool,
+		accounts,
+		integrationTests.TestAddressConverter,
+		&mock.SignerMock{},
+		&mock.KeyGenMock{},
+		maxTxNonceDeltaAllowed,
+		feeHandler,
+		headerValidator,
+		blockChain,
+		transaction.NewValidityWindowChecker(0),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	interceptorsContainer, err := interceptorsFactory.Create()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataPacker, err := partitioning.NewSimpleDataPacker(integrationTests.TestMarshalizer)
+	if err != nil {
+		return nil, nil, err
+	}
+	resolversFactory, err := metaResolvers.NewResolversContainerFactory(
+		shardCoordinator,
+		messenger,
+		store,
+		integrationTests.TestMarshalizer,
+		dataPool,
+		integrationTests.TestUint64Converter,
+		dataPacker,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	resolversContainer, err := resolversFactory.Create()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return interceptorsContainer, resolversContainer, nil
+}
+
+func createValidators(blockChain data.ChainHandler) (process.FeeHandler, process.HeaderValidator, error) {
+	feeHandler, err := economics.NewEconomicsData(
+		&config.ConfigEconomics{
+			EconomicsAddresses: config.EconomicsAddresses{
+				CommunityAddress: "addr1",
+				BurnAddress:      "addr2",
+			},
+			RewardsSettings: config.RewardsSettings{
+				RewardsValue:        "1000",
+				CommunityPercentage: 0.10,
+				LeaderPercentage:    0.50,
+				BurnPercentage:      0.40,
+			},
+			FeeSettings: config.FeeSettings{
+				MinGasPrice: strconv.FormatUint(integrationTests.MinTxGasPrice, 10),
+				MinGasLimit: strconv.FormatUint(integrationTests.MinTxGasLimit, 10),
+			},
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	headerValidator, err := dataValidators.NewEpochHeaderValidator(blockChain, epochGraceWindow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return feeHandler, headerValidator, nil
+}
+
+// addFinalityProofInterceptorAndResolver adds the finality proofs interceptor and resolver, created by the node
+// outside the container factories
+func addFinalityProofInterceptorAndResolver(
+	shardCoordinator sharding.Coordinator,
+	messenger dataRetriever.TopicMessageHandler,
+	interceptorsContainer process.InterceptorsContainer,
+	resolversContainer dataRetriever.ResolversContainer,
+) error {
+	topic := factory.FinalityProofsTopic
+	finalityProofs, err := lrucache.NewCache(finalityProofsCacheSize)
+	if err != nil {
+		return err
+	}
+
+	interceptor, err := interceptors.NewFinalityProofInterceptor(
+		integrationTests.TestMarshalizer,
+		finalityProofs,
+		integrationTests.TestMultiSig,
+		shardCoordinator,
+		&mock.NodesCoordinatorMock{},
+	)
+	if err != nil {
+		return err
+	}
+	err = interceptorsContainer.Add(topic, interceptor)
+	if err != nil {
+		return err
+	}
+
+	peerListCreator, err := topicResolverSender.NewDiffPeerListCreator(messenger, topic, "")
+	if err != nil {
+		return err
+	}
+	resolverSender, err := topicResolverSender.NewTopicResolverSender(
+		messenger,
+		topic,
+		peerListCreator,
+		integrationTests.TestMarshalizer,
+		&random.ConcurrentSafeIntRandomizer{},
+		shardCoordinator.SelfId(),
+	)
+	if err != nil {
+		return err
+	}
+	resolver, err := resolvers.NewFinalityProofResolver(resolverSender, finalityProofs, integrationTests.TestMarshalizer)
+	if err != nil {
+		return err
+	}
+
+	return resolversContainer.Add(topic+resolverSender.TopicRequestSuffix(), resolver)
+}
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
@@ -17,7 +19,7 @@ func (hrm *HeaderResolverMock) RequestDataFromHash(hash []byte) error {
 	return hrm.RequestDataFromHashCalled(hash)
 }
 
-func (hrm *HeaderResolverMock) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (hrm *HeaderResolverMock) ProcessReceivedMessage(_ context.Context, message p2p.MessageP2P) error {
 	if hrm.ProcessReceivedMessageCalled == nil {
 		return nil
 	}
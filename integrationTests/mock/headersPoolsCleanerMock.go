@@ -0,0 +1,30 @@
+package mock
+
+import "time"
+
+type HeadersPoolsCleanerMock struct {
+	CleanCalled             func(finalNonces map[uint32]uint64, duration time.Duration) (bool, error)
+	NumRemovedHeadersCalled func() uint64
+}
+
+func (hpcm *HeadersPoolsCleanerMock) Clean(finalNonces map[uint32]uint64, duration time.Duration) (bool, error) {
+	if hpcm.CleanCalled != nil {
+		return hpcm.CleanCalled(finalNonces, duration)
+	}
+	return false, nil
+}
+
+func (hpcm *HeadersPoolsCleanerMock) NumRemovedHeaders() uint64 {
+	if hpcm.NumRemovedHeadersCalled != nil {
+		return hpcm.NumRemovedHeadersCalled()
+	}
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hpcm *HeadersPoolsCleanerMock) IsInterfaceNil() bool {
+	if hpcm == nil {
+		return true
+	}
+	return false
+}
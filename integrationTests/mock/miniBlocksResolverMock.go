@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
@@ -21,7 +23,7 @@ func (hrm *MiniBlocksResolverMock) RequestDataFromHashArray(hashes [][]byte) err
 	return hrm.RequestDataFromHashArrayCalled(hashes)
 }
 
-func (hrm *MiniBlocksResolverMock) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (hrm *MiniBlocksResolverMock) ProcessReceivedMessage(_ context.Context, message p2p.MessageP2P) error {
 	return hrm.ProcessReceivedMessageCalled(message)
 }
 
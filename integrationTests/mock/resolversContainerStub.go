@@ -5,11 +5,14 @@ import (
 )
 
 type ResolversContainerStub struct {
-	GetCalled     func(key string) (dataRetriever.Resolver, error)
-	AddCalled     func(key string, val dataRetriever.Resolver) error
-	ReplaceCalled func(key string, val dataRetriever.Resolver) error
-	RemoveCalled  func(key string)
-	LenCalled     func() int
+	GetCalled      func(key string) (dataRetriever.Resolver, error)
+	AddCalled      func(key string, val dataRetriever.Resolver) error
+	ReplaceCalled  func(key string, val dataRetriever.Resolver) error
+	RemoveCalled   func(key string)
+	LenCalled      func() int
+	KeysCalled     func() []string
+	IterateCalled  func(handler func(key string, resolver dataRetriever.Resolver) bool)
+	MetadataCalled func(key string) (dataRetriever.ContainerElementMetadata, error)
 }
 
 func (rcs *ResolversContainerStub) Get(key string) (dataRetriever.Resolver, error) {
@@ -36,6 +39,18 @@ func (rcs *ResolversContainerStub) Len() int {
 	return rcs.LenCalled()
 }
 
+func (rcs *ResolversContainerStub) Keys() []string {
+	return rcs.KeysCalled()
+}
+
+func (rcs *ResolversContainerStub) Iterate(handler func(key string, resolver dataRetriever.Resolver) bool) {
+	rcs.IterateCalled(handler)
+}
+
+func (rcs *ResolversContainerStub) Metadata(key string) (dataRetriever.ContainerElementMetadata, error) {
+	return rcs.MetadataCalled(key)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rcs *ResolversContainerStub) IsInterfaceNil() bool {
 	if rcs == nil {
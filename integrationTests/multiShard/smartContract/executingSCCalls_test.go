@@ -45,7 +45,7 @@ func deploySmartContract(t *testing.T, nodeToProcess *testNode, roundNumber uint
 		vm.CreateEmptyAddress().Bytes(),
 		senderNonce,
 		big.NewInt(0),
-		scCode+"@"+hex.EncodeToString(factory.InternalTestingVM),
+		scCode+"@"+hex.EncodeToString(factory.InternalTestingVM)+"@"+vm.DeployCodeMetadata,
 		initialValueForInternalVariable,
 	)
 
@@ -14,6 +14,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
@@ -45,7 +46,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p/loadBalancer"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
+	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	metaProcess "github.com/ElrondNetwork/elrond-go/process/factory/metachain"
@@ -317,6 +320,7 @@ func createNetNode(
 	store := createTestShardStore(shardCoordinator.NumberOfShards())
 	uint64Converter := uint64ByteSlice.NewBigEndianConverter()
 	dataPacker, _ := partitioning.NewSimpleDataPacker(testMarshalizer)
+	hdrValidator, _ := dataValidators.NewNilHeaderValidator()
 
 	interceptorContainerFactory, _ := shard.NewInterceptorsContainerFactory(
 		accntAdapter,
@@ -333,6 +337,9 @@ func createNetNode(
 		testAddressConverter,
 		maxTxNonceDeltaAllowed,
 		createMockTxFeeHandler(),
+		hdrValidator,
+		blkc,
+		transaction.NewValidityWindowChecker(0),
 	)
 	interceptorsContainer, err := interceptorContainerFactory.Create()
 	if err != nil {
@@ -382,6 +389,7 @@ func createNetNode(
 	rewardsInter, _ := interimProcContainer.Get(dataBlock.RewardsBlock)
 	rewardsHandler, _ := rewardsInter.(process.TransactionFeeHandler)
 	internalTxProducer, _ := rewardsInter.(process.InternalTransactionProducer)
+	blockEconomics, _ := rewardsInter.(process.BlockEconomicsHandler)
 	rewardProcessor, _ := rewardTransaction.NewRewardTxProcessor(
 		accntAdapter,
 		addrConv,
@@ -405,6 +413,8 @@ func createNetNode(
 		shardCoordinator,
 		scForwarder,
 		rewardsHandler,
+		smartContract.NewDisabledStateChangesAuditor(),
+		smartContract.NewDisabledSCDeploymentsIndexer(),
 	)
 
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(addrConv, shardCoordinator, accntAdapter)
@@ -419,8 +429,15 @@ func createNetNode(
 		rewardsHandler,
 		txTypeHandler,
 		createMockTxFeeHandler(),
+		transaction.NewValidityWindowChecker(0),
+		mock.NewSpecialAddressHandlerMock(
+			testAddressConverter,
+			shardCoordinator,
+			nodesCoordinator,
+		),
 	)
 
+	proposalPolicy, _ := preprocess.NewBlockProposalPolicy(config.BlockProposalPolicyConfig{})
 	fact, _ := shard.NewPreProcessorsContainerFactory(
 		shardCoordinator,
 		store,
@@ -436,6 +453,7 @@ func createNetNode(
 		rewardProcessor,
 		internalTxProducer,
 		createMockTxFeeHandler(),
+		proposalPolicy,
 	)
 	container, _ := fact.Create()
 
@@ -474,14 +492,16 @@ func createNetNode(
 				shardCoordinator,
 				nodesCoordinator,
 			),
-			Uint64Converter: uint64Converter,
-			StartHeaders:    genesisBlocks,
-			RequestHandler:  requestHandler,
-			Core:            &mock.ServiceContainerMock{},
+			Uint64Converter:     uint64Converter,
+			StartHeaders:        genesisBlocks,
+			RequestHandler:      requestHandler,
+			HeadersPoolsCleaner: &mock.HeadersPoolsCleanerMock{},
+			Core:                &mock.ServiceContainerMock{},
 		},
 		DataPool:        dPool,
 		TxCoordinator:   tc,
 		TxsPoolsCleaner: &mock.TxPoolsCleanerMock{},
+		BlockEconomics:  blockEconomics,
 	}
 
 	blockProcessor, _ := block.NewShardProcessor(arguments)
@@ -650,6 +670,11 @@ func createNodes(
 				shardCoordinator,
 				KeyPair.sk,
 				&singlesig.SchnorrSigner{},
+				&mock.ForkDetectorMock{
+					ProbableHighestNonceCalled: func() uint64 {
+						return 0
+					},
+				},
 			)
 
 			shardNodes[j] = testNode
@@ -777,6 +802,7 @@ func createMetaNetNode(
 			return fee
 		},
 	}
+	hdrValidator, _ := dataValidators.NewNilHeaderValidator()
 
 	interceptorContainerFactory, _ := metaProcess.NewInterceptorsContainerFactory(
 		shardCoordinator,
@@ -793,6 +819,9 @@ func createMetaNetNode(
 		params.keyGen,
 		maxTxNonceDeltaAllowed,
 		feeHandler,
+		hdrValidator,
+		tn.blkc,
+		transaction.NewValidityWindowChecker(0),
 	)
 	interceptorsContainer, err := interceptorContainerFactory.Create()
 	if err != nil {
@@ -848,10 +877,11 @@ func createMetaNetNode(
 				shardCoordinator,
 				nodesCoordinator,
 			),
-			Uint64Converter: uint64Converter,
-			StartHeaders:    genesisBlocks,
-			RequestHandler:  requestHandler,
-			Core:            &mock.ServiceContainerMock{},
+			Uint64Converter:     uint64Converter,
+			StartHeaders:        genesisBlocks,
+			RequestHandler:      requestHandler,
+			HeadersPoolsCleaner: &mock.HeadersPoolsCleanerMock{},
+			Core:                &mock.ServiceContainerMock{},
 		},
 		DataPool: dPool,
 	}
@@ -867,6 +897,11 @@ func createMetaNetNode(
 		shardCoordinator,
 		keyPair.sk,
 		params.singleSigner,
+		&mock.ForkDetectorMock{
+			ProbableHighestNonceCalled: func() uint64 {
+				return 0
+			},
+		},
 	)
 
 	n, err := node.NewNode(
@@ -2,6 +2,7 @@ package peerDiscovery
 
 import (
 	"bytes"
+	"context"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -21,7 +22,7 @@ func NewMessageProcessor(chanDone chan struct{}, requiredVal []byte) *MessagePro
 	}
 }
 
-func (mp *MessageProcesssor) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (mp *MessageProcesssor) ProcessReceivedMessage(_ context.Context, message p2p.MessageP2P) error {
 	if bytes.Equal(mp.RequiredValue, message.Data()) {
 		mp.mutDataReceived.Lock()
 		mp.wasDataReceived = true
@@ -20,7 +20,7 @@ type messageProcessorStub struct {
 	ProcessReceivedMessageCalled func(message p2p.MessageP2P) error
 }
 
-func (mps *messageProcessorStub) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (mps *messageProcessorStub) ProcessReceivedMessage(_ context.Context, message p2p.MessageP2P) error {
 	return mps.ProcessReceivedMessageCalled(message)
 }
 
@@ -0,0 +1,171 @@
+package state
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/stretchr/testify/assert"
+)
+
+func getSessionBalance(t *testing.T, session state.AccountsReadSession, adr state.AddressContainer) *big.Int {
+	account, err := session.GetExistingAccount(adr)
+	assert.Nil(t, err)
+	if err != nil {
+		return big.NewInt(0)
+	}
+
+	return account.(*state.Account).Balance
+}
+
+func TestAccountsReadSession_ShouldNotSeeTheUncommittedChanges(t *testing.T) {
+	t.Parallel()
+
+	adr1, _, adb := integrationTests.GenerateAddressJournalAccountAccountsDB()
+	adr2 := integrationTests.CreateRandomAddress()
+
+	account1, _ := adb.GetAccountWithJournal(adr1)
+	_ = account1.(*state.Account).SetBalanceWithJournal(big.NewInt(40))
+	committedRoot, err := adb.Commit()
+	assert.Nil(t, err)
+
+	//changes done while the next block is processed
+	_ = account1.(*state.Account).SetBalanceWithJournal(big.NewInt(10))
+	account2, _ := adb.GetAccountWithJournal(adr2)
+	_ = account2.(*state.Account).SetBalanceWithJournal(big.NewInt(30))
+
+	session, err := adb.NewReadSession()
+	assert.Nil(t, err)
+	assert.Equal(t, committedRoot, session.RootHash())
+	assert.Equal(t, big.NewInt(40), getSessionBalance(t, session, adr1))
+	hasAccount, err := session.HasAccount(adr2)
+	assert.Nil(t, err)
+	assert.False(t, hasAccount)
+
+	newRoot, err := adb.Commit()
+	assert.Nil(t, err)
+
+	//the already opened session keeps on reading from its root hash
+	assert.Equal(t, big.NewInt(40), getSessionBalance(t, session, adr1))
+
+	newSession, err := adb.NewReadSession()
+	assert.Nil(t, err)
+	assert.Equal(t, newRoot, newSession.RootHash())
+	assert.Equal(t, big.NewInt(10), getSessionBalance(t, newSession, adr1))
+	assert.Equal(t, big.NewInt(30), getSessionBalance(t, newSession, adr2))
+}
+
+func TestAccountsReadSession_ShouldReadTheCommittedDataTrie(t *testing.T) {
+	t.Parallel()
+
+	adr, _, adb := integrationTests.GenerateAddressJournalAccountAccountsDB()
+	key := []byte("key")
+
+	account, _ := adb.GetAccountWithJournal(adr)
+	account.DataTrieTracker().SaveKeyValue(key, []byte("committed value"))
+	_ = adb.SaveDataTrie(account)
+	_, _ = adb.Commit()
+
+	account, _ = adb.GetAccountWithJournal(adr)
+	account.DataTrieTracker().SaveKeyValue(key, []byte("uncommitted value"))
+	_ = adb.SaveDataTrie(account)
+
+	session, _ := adb.NewReadSession()
+	sessionAccount, err := session.GetExistingAccount(adr)
+	assert.Nil(t, err)
+
+	value, err := sessionAccount.DataTrieTracker().RetrieveValue(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("committed value"), value)
+}
+
+func TestAccountsReadSession_ShouldFollowTheRecreatedTrie(t *testing.T) {
+	t.Parallel()
+
+	adr, _, adb := integrationTests.GenerateAddressJournalAccountAccountsDB()
+
+	account, _ := adb.GetAccountWithJournal(adr)
+	_ = account.(*state.Account).SetBalanceWithJournal(big.NewInt(40))
+	oldRoot, _ := adb.Commit()
+
+	_ = account.(*state.Account).SetBalanceWithJournal(big.NewInt(50))
+	_, _ = adb.Commit()
+
+	//rollback
+	err := adb.RecreateTrie(oldRoot)
+	assert.Nil(t, err)
+
+	session, _ := adb.NewReadSession()
+	assert.Equal(t, oldRoot, session.RootHash())
+	assert.Equal(t, big.NewInt(40), getSessionBalance(t, session, adr))
+}
+
+func TestAccountsReadSession_AccountsShouldNotBeChangeable(t *testing.T) {
+	t.Parallel()
+
+	adr, _, adb := integrationTests.GenerateAddressJournalAccountAccountsDB()
+
+	account, _ := adb.GetAccountWithJournal(adr)
+	_ = account.(*state.Account).SetBalanceWithJournal(big.NewInt(40))
+	committedRoot, _ := adb.Commit()
+
+	session, _ := adb.NewReadSession()
+	sessionAccount, _ := session.GetExistingAccount(adr)
+
+	err := sessionAccount.(*state.Account).SetBalanceWithJournal(big.NewInt(1000))
+	assert.Equal(t, state.ErrReadOnlySession, err)
+	assert.Equal(t, 0, adb.JournalLen())
+
+	rootHash, _ := adb.RootHash()
+	assert.Equal(t, committedRoot, rootHash)
+}
+
+func TestAccountsReadSession_ConcurrentCommitsShouldNotBeObservedPartially(t *testing.T) {
+	t.Parallel()
+
+	numBlocks := 100
+	numReads := 500
+	total := int64(1000)
+
+	adr1, _, adb := integrationTests.GenerateAddressJournalAccountAccountsDB()
+	adr2 := integrationTests.CreateRandomAddress()
+
+	account1, _ := adb.GetAccountWithJournal(adr1)
+	account2, _ := adb.GetAccountWithJournal(adr2)
+	_ = account1.(*state.Account).SetBalanceWithJournal(big.NewInt(total))
+	_ = account2.(*state.Account).SetBalanceWithJournal(big.NewInt(0))
+	_, _ = adb.Commit()
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+
+	//each block moves a value from the first account to the second one, the sum of the balances being preserved
+	go func() {
+		for i := 0; i < numBlocks; i++ {
+			integrationTests.AdbEmulateBalanceTxSafeExecution(
+				account1.(*state.Account),
+				account2.(*state.Account),
+				adb,
+				big.NewInt(1),
+			)
+			_, _ = adb.Commit()
+		}
+		wg.Done()
+	}()
+
+	go func() {
+		for i := 0; i < numReads; i++ {
+			session, err := adb.NewReadSession()
+			assert.Nil(t, err)
+
+			balance1 := getSessionBalance(t, session, adr1)
+			balance2 := getSessionBalance(t, session, adr2)
+			assert.Equal(t, big.NewInt(total), big.NewInt(0).Add(balance1, balance2))
+		}
+		wg.Done()
+	}()
+
+	wg.Wait()
+}
@@ -0,0 +1,157 @@
+//go:build byzantine
+// +build byzantine
+
+package integrationTests
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+// ByzantineConfig holds the misbehaviours a TestByzantineNode is configured with
+type ByzantineConfig struct {
+	// WithholdProposals makes the node commit its proposed blocks without broadcasting them
+	WithholdProposals bool
+	// SendConflictingHeaders makes the node broadcast, along each proposed header, a second header with the same
+	// nonce and round but a different root hash
+	SendConflictingHeaders bool
+	// CorruptMiniBlocks makes the node broadcast its proposed miniblocks with invalid sender and receiver shard ids
+	CorruptMiniBlocks bool
+	// SpamTopics are the topics flooded with random payloads on each Spam call
+	SpamTopics []string
+	// SpamMessagesPerTopic is the number of messages sent on each spammed topic on each Spam call
+	SpamMessagesPerTopic int
+	// SpamMessageSize is the size in bytes of the spam messages
+	SpamMessageSize int
+}
+
+// TestByzantineNode is a TestProcessorNode misbehaving in the configured ways. It is meant for the integration tests
+// that check the defenses of the honest nodes and it is only compiled with the byzantine build tag
+type TestByzantineNode struct {
+	*TestProcessorNode
+	Config ByzantineConfig
+
+	NumWithheldProposals  int32
+	NumConflictingHeaders int32
+	NumCorruptMiniBlocks  int32
+	NumSpamMessages       int32
+}
+
+// NewTestByzantineNode wraps the provided node so that it misbehaves as configured
+func NewTestByzantineNode(tpn *TestProcessorNode, config ByzantineConfig) *TestByzantineNode {
+	return &TestByzantineNode{
+		TestProcessorNode: tpn,
+		Config:            config,
+	}
+}
+
+// BroadcastBlock broadcasts the block and body to the connected peers, applying the configured block misbehaviours
+func (tbn *TestByzantineNode) BroadcastBlock(body data.BodyHandler, header data.HeaderHandler) {
+	if tbn.Config.WithholdProposals {
+		atomic.AddInt32(&tbn.NumWithheldProposals, 1)
+		return
+	}
+
+	miniBlocks, transactions, _ := tbn.BlockProcessor.MarshalizedDataToBroadcast(header, body)
+	if tbn.Config.CorruptMiniBlocks {
+		body = tbn.corruptBody(body)
+		miniBlocks = tbn.corruptMarshalizedMiniBlocks(miniBlocks)
+	}
+
+	_ = tbn.BroadcastMessenger.BroadcastBlock(body, header)
+	_ = tbn.BroadcastMessenger.BroadcastHeader(header)
+	_ = tbn.BroadcastMessenger.BroadcastMiniBlocks(miniBlocks)
+	_ = tbn.BroadcastMessenger.BroadcastTransactions(transactions)
+
+	if tbn.Config.SendConflictingHeaders {
+		conflictingHeader := createConflictingHeader(header)
+		if conflictingHeader == nil {
+			return
+		}
+
+		atomic.AddInt32(&tbn.NumConflictingHeaders, 1)
+		_ = tbn.BroadcastMessenger.BroadcastBlock(body, conflictingHeader)
+		_ = tbn.BroadcastMessenger.BroadcastHeader(conflictingHeader)
+	}
+}
+
+// Spam broadcasts the configured number of random payloads on each of the configured topics
+func (tbn *TestByzantineNode) Spam() {
+	for _, topic := range tbn.Config.SpamTopics {
+		for i := 0; i < tbn.Config.SpamMessagesPerTopic; i++ {
+			tbn.Messenger.Broadcast(topic, GenerateRandomSlice(tbn.Config.SpamMessageSize))
+			atomic.AddInt32(&tbn.NumSpamMessages, 1)
+		}
+	}
+
+	fmt.Printf("byzantine node %s sent %d spam messages\n",
+		tbn.Messenger.ID().Pretty(),
+		len(tbn.Config.SpamTopics)*tbn.Config.SpamMessagesPerTopic,
+	)
+}
+
+// corruptBody returns a copy of the provided body in which all miniblocks have invalid shard ids. The body itself is
+// not altered as the node commits it
+func (tbn *TestByzantineNode) corruptBody(body data.BodyHandler) data.BodyHandler {
+	shardBody, ok := body.(dataBlock.Body)
+	if !ok {
+		return body
+	}
+
+	return tbn.corruptMiniBlocks(shardBody)
+}
+
+func (tbn *TestByzantineNode) corruptMiniBlocks(body dataBlock.Body) dataBlock.Body {
+	invalidShardId := tbn.ShardCoordinator.NumberOfShards() + 1
+
+	corruptBody := make(dataBlock.Body, 0, len(body))
+	for _, miniBlock := range body {
+		corruptMiniBlock := *miniBlock
+		corruptMiniBlock.SenderShardID = invalidShardId
+		corruptMiniBlock.ReceiverShardID = invalidShardId
+		corruptBody = append(corruptBody, &corruptMiniBlock)
+		atomic.AddInt32(&tbn.NumCorruptMiniBlocks, 1)
+	}
+
+	return corruptBody
+}
+
+func (tbn *TestByzantineNode) corruptMarshalizedMiniBlocks(miniBlocks map[uint32][]byte) map[uint32][]byte {
+	corruptMiniBlocks := make(map[uint32][]byte, len(miniBlocks))
+	for shardId, buff := range miniBlocks {
+		body := make(dataBlock.Body, 0)
+		err := TestMarshalizer.Unmarshal(&body, buff)
+		if err != nil {
+			continue
+		}
+
+		corruptBuff, err := TestMarshalizer.Marshal(tbn.corruptMiniBlocks(body))
+		if err != nil {
+			continue
+		}
+
+		corruptMiniBlocks[shardId] = corruptBuff
+	}
+
+	return corruptMiniBlocks
+}
+
+// createConflictingHeader returns a header having the same nonce and round as the provided one but a different
+// root hash, so that it has a different hash
+func createConflictingHeader(header data.HeaderHandler) data.HeaderHandler {
+	switch hdr := header.(type) {
+	case *dataBlock.Header:
+		conflictingHdr := *hdr
+		conflictingHdr.RootHash = GenerateRandomSlice(32)
+		return &conflictingHdr
+	case *dataBlock.MetaBlock:
+		conflictingHdr := *hdr
+		conflictingHdr.RootHash = GenerateRandomSlice(32)
+		return &conflictingHdr
+	default:
+		return nil
+	}
+}
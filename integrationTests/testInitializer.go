@@ -57,6 +57,10 @@ import (
 var stepDelay = time.Second
 var p2pBootstrapStepDelay = 5 * time.Second
 
+// DeployCodeMetadata is the hex encoded code metadata of the test smart contracts, that are upgradeable, readable
+// and payable
+var DeployCodeMetadata = hex.EncodeToString(state.CodeMetadata{Upgradeable: true, Readable: true, Payable: true}.ToBytes())
+
 // GetConnectableAddress returns a non circuit, non windows default connectable address for provided messenger
 func GetConnectableAddress(mes p2p.Messenger) string {
 	for _, addr := range mes.Addresses() {
@@ -453,6 +457,8 @@ func CreateSimpleTxProcessor(accnts state.AccountsAdapter) process.TransactionPr
 				return fee
 			},
 		},
+		txProc.NewValidityWindowChecker(0),
+		&mock.SpecialAddressHandlerMock{},
 	)
 
 	return txProcessor
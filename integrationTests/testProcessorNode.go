@@ -33,7 +33,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
+	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	metaProcess "github.com/ElrondNetwork/elrond-go/process/factory/metachain"
@@ -119,6 +121,7 @@ type TestProcessorNode struct {
 	ArgsParser             process.ArgumentsParser
 	ScProcessor            process.SmartContractProcessor
 	RewardsProcessor       process.RewardTransactionProcessor
+	BlockEconomics         process.BlockEconomicsHandler
 	PreProcessorsContainer process.PreProcessorsContainer
 
 	ForkDetector       process.ForkDetector
@@ -223,10 +226,11 @@ func (tpn *TestProcessorNode) initTestNode() {
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.SingleSigner,
+		tpn.ForkDetector,
 	)
 	tpn.setGenesisBlock()
 	tpn.initNode()
-	tpn.ScDataGetter, _ = smartContract.NewSCDataGetter(tpn.VmDataGetter)
+	tpn.ScDataGetter, _ = smartContract.NewSCDataGetter(tpn.VmDataGetter, tpn.AccntState)
 	tpn.addHandlersForCounters()
 }
 
@@ -284,6 +288,7 @@ func (tpn *TestProcessorNode) initEconomicsData() {
 
 func (tpn *TestProcessorNode) initInterceptors() {
 	var err error
+	hdrValidator, _ := dataValidators.NewNilHeaderValidator()
 	if tpn.ShardCoordinator.SelfId() == sharding.MetachainShardId {
 		interceptorContainerFactory, _ := metaProcess.NewInterceptorsContainerFactory(
 			tpn.ShardCoordinator,
@@ -300,6 +305,9 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			tpn.OwnAccount.KeygenTxSign,
 			maxTxNonceDeltaAllowed,
 			tpn.EconomicsData,
+			hdrValidator,
+			tpn.BlockChain,
+			transaction.NewValidityWindowChecker(0),
 		)
 
 		tpn.InterceptorsContainer, err = interceptorContainerFactory.Create()
@@ -322,6 +330,9 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			TestAddressConverter,
 			maxTxNonceDeltaAllowed,
 			tpn.EconomicsData,
+			hdrValidator,
+			tpn.BlockChain,
+			transaction.NewValidityWindowChecker(0),
 		)
 
 		tpn.InterceptorsContainer, err = interceptorContainerFactory.Create()
@@ -402,6 +413,7 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 	rewardsInter, _ := tpn.InterimProcContainer.Get(dataBlock.RewardsBlock)
 	rewardsHandler, _ := rewardsInter.(process.TransactionFeeHandler)
 	internalTxProducer, _ := rewardsInter.(process.InternalTransactionProducer)
+	tpn.BlockEconomics, _ = rewardsInter.(process.BlockEconomicsHandler)
 
 	tpn.RewardsProcessor, _ = rewardTransaction.NewRewardTxProcessor(
 		tpn.AccntState,
@@ -430,6 +442,8 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 		tpn.ShardCoordinator,
 		tpn.ScrForwarder,
 		rewardsHandler,
+		smartContract.NewDisabledStateChangesAuditor(),
+		smartContract.NewDisabledSCDeploymentsIndexer(),
 	)
 
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(TestAddressConverter, tpn.ShardCoordinator, tpn.AccntState)
@@ -444,8 +458,11 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 		rewardsHandler,
 		txTypeHandler,
 		tpn.EconomicsData,
+		transaction.NewValidityWindowChecker(0),
+		tpn.SpecialAddressHandler,
 	)
 
+	proposalPolicy, _ := preprocess.NewBlockProposalPolicy(config.BlockProposalPolicyConfig{})
 	fact, _ := shard.NewPreProcessorsContainerFactory(
 		tpn.ShardCoordinator,
 		tpn.Storage,
@@ -461,6 +478,7 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 		tpn.RewardsProcessor,
 		internalTxProducer,
 		tpn.EconomicsData,
+		proposalPolicy,
 	)
 	tpn.PreProcessorsContainer, _ = fact.Create()
 
@@ -501,6 +519,7 @@ func (tpn *TestProcessorNode) initBlockProcessor() {
 		Uint64Converter:       TestUint64Converter,
 		StartHeaders:          tpn.GenesisBlocks,
 		RequestHandler:        tpn.RequestHandler,
+		HeadersPoolsCleaner:   &mock.HeadersPoolsCleanerMock{},
 		Core:                  nil,
 	}
 
@@ -518,6 +537,7 @@ func (tpn *TestProcessorNode) initBlockProcessor() {
 			DataPool:         tpn.ShardDataPool,
 			TxCoordinator:    tpn.TxCoordinator,
 			TxsPoolsCleaner:  &mock.TxPoolsCleanerMock{},
+			BlockEconomics:   tpn.BlockEconomics,
 		}
 
 		tpn.BlockProcessor, err = block.NewShardProcessor(arguments)
@@ -593,6 +613,12 @@ func (tpn *TestProcessorNode) SendTransaction(tx *dataTransaction.Transaction) (
 		tx.GasLimit,
 		tx.Data,
 		tx.Signature,
+		dataTransaction.ValidityWindow{
+			NotBeforeRound: tx.NotBeforeRound,
+			NotAfterRound:  tx.NotAfterRound,
+			NotBeforeEpoch: tx.NotBeforeEpoch,
+			NotAfterEpoch:  tx.NotAfterEpoch,
+		},
 	)
 	return txHash, err
 }
@@ -68,11 +68,12 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.SingleSigner,
+		tpn.ForkDetector,
 	)
 	tpn.initBootstrapper()
 	tpn.setGenesisBlock()
 	tpn.initNode()
-	tpn.ScDataGetter, _ = smartContract.NewSCDataGetter(tpn.VmDataGetter)
+	tpn.ScDataGetter, _ = smartContract.NewSCDataGetter(tpn.VmDataGetter, tpn.AccntState)
 	tpn.addHandlersForCounters()
 }
 
@@ -91,6 +92,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		Uint64Converter:       TestUint64Converter,
 		StartHeaders:          tpn.GenesisBlocks,
 		RequestHandler:        tpn.RequestHandler,
+		HeadersPoolsCleaner:   &mock.HeadersPoolsCleanerMock{},
 		Core:                  nil,
 	}
 
@@ -113,6 +115,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 			DataPool:         tpn.ShardDataPool,
 			TxCoordinator:    tpn.TxCoordinator,
 			TxsPoolsCleaner:  &mock.TxPoolsCleanerMock{},
+			BlockEconomics:   tpn.BlockEconomics,
 		}
 
 		tpn.BlockProcessor, err = block.NewShardProcessor(arguments)
@@ -36,7 +36,7 @@ func TestDeployAgarioContract(t *testing.T) {
 		big.NewInt(0),
 		gasPrice,
 		gasLimit,
-		string(scCode)+"@"+hex.EncodeToString(factory.IELEVirtualMachine),
+		string(scCode)+"@"+hex.EncodeToString(factory.IELEVirtualMachine)+"@"+vm.DeployCodeMetadata,
 		round,
 		txProc,
 		accnts,
@@ -71,7 +71,7 @@ func TestAgarioContractTopUpShouldWork(t *testing.T) {
 		big.NewInt(0),
 		gasPrice,
 		gasLimit,
-		string(scCode)+"@"+hex.EncodeToString(factory.IELEVirtualMachine),
+		string(scCode)+"@"+hex.EncodeToString(factory.IELEVirtualMachine)+"@"+vm.DeployCodeMetadata,
 		round,
 		txProc,
 		accnts,
@@ -130,7 +130,7 @@ func TestAgarioContractTopUpAnfWithdrawShouldWork(t *testing.T) {
 		big.NewInt(0),
 		gasPrice,
 		gasLimit,
-		string(scCode)+"@"+hex.EncodeToString(factory.IELEVirtualMachine),
+		string(scCode)+"@"+hex.EncodeToString(factory.IELEVirtualMachine)+"@"+vm.DeployCodeMetadata,
 		round,
 		txProc,
 		accnts,
@@ -215,7 +215,7 @@ func TestAgarioContractJoinGameReward(t *testing.T) {
 		big.NewInt(0),
 		gasPrice,
 		gasLimit,
-		string(scCode)+"@"+hex.EncodeToString(factory.IELEVirtualMachine),
+		string(scCode)+"@"+hex.EncodeToString(factory.IELEVirtualMachine)+"@"+vm.DeployCodeMetadata,
 		round,
 		txProc,
 		accnts,
@@ -350,7 +350,7 @@ func BenchmarkAgarioJoinGame(b *testing.B) {
 		big.NewInt(0),
 		gasPrice,
 		gasLimit,
-		string(scCode)+"@"+hex.EncodeToString(factory.IELEVirtualMachine),
+		string(scCode)+"@"+hex.EncodeToString(factory.IELEVirtualMachine)+"@"+vm.DeployCodeMetadata,
 		round,
 		txProc,
 		accnts,
@@ -389,7 +389,7 @@ func BenchmarkAgarioJoinGame(b *testing.B) {
 
 func getIntValueFromSC(accnts state.AccountsAdapter, scAddressBytes []byte, funcName string, args ...[]byte) *big.Int {
 	ieleVM, _ := vm.CreateVMAndBlockchainHook(accnts)
-	scgd, _ := smartContract.NewSCDataGetter(ieleVM)
+	scgd, _ := smartContract.NewSCDataGetter(ieleVM, accnts)
 
 	returnedVals, _ := scgd.Get(scAddressBytes, funcName, args...)
 	return big.NewInt(0).SetBytes(returnedVals)
@@ -31,7 +31,7 @@ func TestVMInvalidSmartContractCodeShouldNotGenerateAccount(t *testing.T) {
 		Value:    big.NewInt(0),
 		SndAddr:  senderAddressBytes,
 		RcvAddr:  vm.CreateEmptyAddress().Bytes(),
-		Data:     string(scCode) + "@" + hex.EncodeToString(factory.IELEVirtualMachine),
+		Data:     string(scCode) + "@" + hex.EncodeToString(factory.IELEVirtualMachine) + "@" + vm.DeployCodeMetadata,
 		GasPrice: gasPrice,
 		GasLimit: gasLimit,
 	}
@@ -57,8 +57,8 @@ func TestVmDeployWithTransferAndGasShouldDeploySCCode(t *testing.T) {
 	transferOnCalls := big.NewInt(50)
 
 	initialValueForInternalVariable := uint64(45)
-	scCode := fmt.Sprintf("0000003B6302690003616464690004676574416700000001616101550468000100016161015406010A6161015506F6000068000200006161005401F6000101@%s@%X",
-		hex.EncodeToString(factory.IELEVirtualMachine), initialValueForInternalVariable)
+	scCode := fmt.Sprintf("0000003B6302690003616464690004676574416700000001616101550468000100016161015406010A6161015506F6000068000200006161005401F6000101@%s@%s@%X",
+		hex.EncodeToString(factory.IELEVirtualMachine), vm.DeployCodeMetadata, initialValueForInternalVariable)
 
 	tx := vm.CreateTx(
 		t,
@@ -108,8 +108,8 @@ func TestVMDeployWithTransferWithInsufficientGasShouldReturnErr(t *testing.T) {
 	transferOnCalls := big.NewInt(50)
 
 	initialValueForInternalVariable := uint64(45)
-	scCode := fmt.Sprintf("0000003B6302690003616464690004676574416700000001616101550468000100016161015406010A6161015506F6000068000200006161005401F6000101@%s@%X",
-		hex.EncodeToString(factory.IELEVirtualMachine), initialValueForInternalVariable)
+	scCode := fmt.Sprintf("0000003B6302690003616464690004676574416700000001616101550468000100016161015406010A6161015506F6000068000200006161005401F6000101@%s@%s@%X",
+		hex.EncodeToString(factory.IELEVirtualMachine), vm.DeployCodeMetadata, initialValueForInternalVariable)
 
 	tx := vm.CreateTx(
 		t,
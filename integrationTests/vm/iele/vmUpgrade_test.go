@@ -0,0 +1,71 @@
+package mockVM
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/vm"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVmUpgradeContractShouldReplaceCodeOnlyForOwnerAndIfUpgradeable(t *testing.T) {
+	newScCode, err := ioutil.ReadFile(agarioFile)
+	assert.Nil(t, err)
+
+	senderAddressBytes := []byte("12345678901234567890123456789012")
+	senderNonce := uint64(11)
+	senderBalance := big.NewInt(100000000)
+	round := uint64(444)
+	gasPrice := uint64(1)
+	gasLimit := uint64(1000000)
+
+	scCode := "0000003B6302690003616464690004676574416700000001616101550468000100016161015406010A6161015506F6000068000200006161005401F6000101"
+	txProc, accnts, blockchainHook := vm.CreatePreparedTxProcessorAndAccountsWithIeleVM(t, senderNonce, senderAddressBytes, senderBalance)
+	deployContract(
+		t,
+		senderAddressBytes,
+		senderNonce,
+		big.NewInt(0),
+		gasPrice,
+		gasLimit,
+		fmt.Sprintf("%s@%s@%s@%X", scCode, hex.EncodeToString(factory.IELEVirtualMachine), vm.DeployCodeMetadata, 45),
+		round,
+		txProc,
+		accnts,
+	)
+	scAddressBytes, _ := blockchainHook.NewAddress(senderAddressBytes, senderNonce, factory.IELEVirtualMachine)
+
+	notUpgradeableMetadata := hex.EncodeToString(state.CodeMetadata{Readable: true, Payable: true}.ToBytes())
+	upgradeData := smartContract.UpgradeContractFunction + "@" + string(newScCode) + "@" + notUpgradeableMetadata
+
+	otherAddressBytes := []byte("12345678901234567890123456789000")
+	_ = vm.CreateAccount(accnts, otherAddressBytes, 0, senderBalance)
+	txUpgrade := vm.CreateTx(t, otherAddressBytes, scAddressBytes, 0, big.NewInt(0), gasPrice, gasLimit, upgradeData)
+	err = txProc.ProcessTransaction(txUpgrade, round)
+	assert.Equal(t, process.ErrNotContractOwner, err)
+
+	txUpgrade = vm.CreateTx(t, senderAddressBytes, scAddressBytes, senderNonce+1, big.NewInt(0), gasPrice, gasLimit, upgradeData)
+	err = txProc.ProcessTransaction(txUpgrade, round)
+	assert.Nil(t, err)
+	_, err = accnts.Commit()
+	assert.Nil(t, err)
+
+	senderAccount, _ := accnts.GetExistingAccount(state.NewAddress(senderAddressBytes))
+	assert.Equal(t, senderNonce+2, senderAccount.GetNonce())
+	scAccount, _ := accnts.GetExistingAccount(state.NewAddress(scAddressBytes))
+	newScCodeBytes, _ := hex.DecodeString(string(newScCode))
+	assert.Equal(t, newScCodeBytes, scAccount.GetCode())
+	assert.Equal(t, notUpgradeableMetadata, hex.EncodeToString(scAccount.(*state.Account).GetCodeMetadata()))
+	assert.Equal(t, senderAddressBytes, scAccount.(*state.Account).GetOwnerAddress())
+
+	txUpgrade = vm.CreateTx(t, senderAddressBytes, scAddressBytes, senderNonce+2, big.NewInt(0), gasPrice, gasLimit, upgradeData)
+	err = txProc.ProcessTransaction(txUpgrade, round)
+	assert.Equal(t, process.ErrUpgradeNotAllowed, err)
+}
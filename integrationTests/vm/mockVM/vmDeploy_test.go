@@ -22,7 +22,7 @@ func TestVmDeployWithoutTransferShouldDeploySCCode(t *testing.T) {
 	transferOnCalls := big.NewInt(0)
 
 	initialValueForInternalVariable := uint64(45)
-	scCode := fmt.Sprintf("aaaa@%s@%X", hex.EncodeToString(factory.InternalTestingVM), initialValueForInternalVariable)
+	scCode := fmt.Sprintf("aaaa@%s@%s@%X", hex.EncodeToString(factory.InternalTestingVM), vm.DeployCodeMetadata, initialValueForInternalVariable)
 
 	tx := vm.CreateTx(
 		t,
@@ -71,7 +71,7 @@ func TestVmDeployWithTransferShouldDeploySCCode(t *testing.T) {
 	transferOnCalls := big.NewInt(50)
 
 	initialValueForInternalVariable := uint64(45)
-	scCode := fmt.Sprintf("aaaa@%s@%X", hex.EncodeToString(factory.InternalTestingVM), initialValueForInternalVariable)
+	scCode := fmt.Sprintf("aaaa@%s@%s@%X", hex.EncodeToString(factory.InternalTestingVM), vm.DeployCodeMetadata, initialValueForInternalVariable)
 
 	tx := vm.CreateTx(
 		t,
@@ -120,7 +120,7 @@ func TestVmDeployWithTransferAndGasShouldDeploySCCode(t *testing.T) {
 	transferOnCalls := big.NewInt(50)
 
 	initialValueForInternalVariable := uint64(45)
-	scCode := fmt.Sprintf("aaaa@%s@%X", hex.EncodeToString(factory.InternalTestingVM), initialValueForInternalVariable)
+	scCode := fmt.Sprintf("aaaa@%s@%s@%X", hex.EncodeToString(factory.InternalTestingVM), vm.DeployCodeMetadata, initialValueForInternalVariable)
 
 	tx := vm.CreateTx(
 		t,
@@ -171,7 +171,7 @@ func TestVMDeployWithTransferWithInsufficientGasShouldReturnErr(t *testing.T) {
 	transferOnCalls := big.NewInt(50)
 
 	initialValueForInternalVariable := uint64(45)
-	scCode := fmt.Sprintf("aaaa@%s@%X", hex.EncodeToString(factory.InternalTestingVM), initialValueForInternalVariable)
+	scCode := fmt.Sprintf("aaaa@%s@%s@%X", hex.EncodeToString(factory.InternalTestingVM), vm.DeployCodeMetadata, initialValueForInternalVariable)
 
 	tx := vm.CreateTx(
 		t,
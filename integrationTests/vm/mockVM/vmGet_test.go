@@ -17,7 +17,7 @@ func TestVmGetShouldReturnValue(t *testing.T) {
 	accnts, destinationAddressBytes, expectedValueForVar := deploySmartContract(t)
 
 	mockVM := vm.CreateOneSCExecutorMockVM(accnts)
-	scgd, _ := smartContract.NewSCDataGetter(mockVM)
+	scgd, _ := smartContract.NewSCDataGetter(mockVM, accnts)
 
 	functionName := "Get"
 	returnedVals, err := scgd.Get(destinationAddressBytes, functionName)
@@ -37,7 +37,7 @@ func deploySmartContract(t *testing.T) (state.AccountsAdapter, []byte, *big.Int)
 	transferOnCalls := big.NewInt(0)
 
 	initialValueForInternalVariable := uint64(45)
-	scCode := fmt.Sprintf("aaaa@%s@%X", hex.EncodeToString(factory.InternalTestingVM), initialValueForInternalVariable)
+	scCode := fmt.Sprintf("aaaa@%s@%s@%X", hex.EncodeToString(factory.InternalTestingVM), vm.DeployCodeMetadata, initialValueForInternalVariable)
 
 	tx := vm.CreateTx(
 		t,
@@ -31,6 +31,10 @@ var testHasher = sha256.Sha256{}
 var oneShardCoordinator = mock.NewMultiShardsCoordinatorMock(1)
 var addrConv, _ = addressConverters.NewPlainAddressConverter(32, "0x")
 
+// DeployCodeMetadata is the hex encoded code metadata of the test smart contracts, that are upgradeable, readable
+// and payable
+var DeployCodeMetadata = hex.EncodeToString(state.CodeMetadata{Upgradeable: true, Readable: true, Payable: true}.ToBytes())
+
 type accountFactory struct {
 }
 
@@ -102,6 +106,8 @@ func CreateTxProcessorWithOneSCExecutorMockVM(accnts state.AccountsAdapter, opGa
 		oneShardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		smartContract.NewDisabledStateChangesAuditor(),
+		smartContract.NewDisabledSCDeploymentsIndexer(),
 	)
 
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(
@@ -119,6 +125,8 @@ func CreateTxProcessorWithOneSCExecutorMockVM(accnts state.AccountsAdapter, opGa
 		&mock.UnsignedTxHandlerMock{},
 		txTypeHandler,
 		&mock.FeeHandlerStub{},
+		transaction.NewValidityWindowChecker(0),
+		&mock.SpecialAddressHandlerMock{},
 	)
 
 	return txProcessor
@@ -163,6 +171,8 @@ func CreateTxProcessorWithOneSCExecutorIeleVM(
 		oneShardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		smartContract.NewDisabledStateChangesAuditor(),
+		smartContract.NewDisabledSCDeploymentsIndexer(),
 	)
 
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(
@@ -180,6 +190,8 @@ func CreateTxProcessorWithOneSCExecutorIeleVM(
 		&mock.UnsignedTxHandlerMock{},
 		txTypeHandler,
 		&mock.FeeHandlerStub{},
+		transaction.NewValidityWindowChecker(0),
+		&mock.SpecialAddressHandlerMock{},
 	)
 
 	return txProcessor, blockChainHook
@@ -207,6 +219,8 @@ func TestDeployedContractContents(
 	assert.Equal(t, testHasher.Compute(string(scCodeBytes)), destinationRecovAccount.GetCodeHash())
 	//test code
 	assert.Equal(t, scCodeBytes, destinationRecovAccount.GetCode())
+	//test code metadata
+	assert.Equal(t, DeployCodeMetadata, hex.EncodeToString(destinationRecovShardAccount.GetCodeMetadata()))
 	//in this test we know we have a as a variable inside the contract, we can ask directly its value
 	// using trackableDataTrie functionality
 	assert.NotNil(t, destinationRecovShardAccount.GetRootHash())
@@ -0,0 +1,329 @@
+package lightClient
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	processBlock "github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// EpochBundle holds everything needed to verify offline the headers of an epoch: the validator set computing the
+// consensus groups, the metachain headers of the epoch ordered by nonce, the first one being the epoch-start header,
+// the shard headers notarized by them and the finality proofs of all those headers. The headers are kept
+// marshalized, exactly as they were stored by the exporting node
+type EpochBundle struct {
+	Epoch          uint32                 `json:"epoch"`
+	ValidatorSet   *ValidatorSet          `json:"validatorSet"`
+	MetaBlocks     [][]byte               `json:"metaBlocks"`
+	ShardHeaders   [][]byte               `json:"shardHeaders"`
+	FinalityProofs []*block.FinalityProof `json:"finalityProofs"`
+}
+
+// VerifiedEpochBundle holds the headers of an epoch bundle that passed the verification
+type VerifiedEpochBundle struct {
+	Epoch          uint32
+	EpochStartHash []byte
+	MetaBlocks     []*VerifiedHeader
+	ShardHeaders   []*VerifiedShardHeader
+}
+
+// VerifiedShardHeader holds a shard header notarized by a verified metachain header, whose signature was verified,
+// together with its hash
+type VerifiedShardHeader struct {
+	Hash   []byte
+	Header *block.Header
+}
+
+// ArgEpochBundleVerifier holds all dependencies required by the epoch bundle verifier in order to create new instances
+type ArgEpochBundleVerifier struct {
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	MultiSigVerifier crypto.MultiSigVerifier
+}
+
+// EpochBundleVerifier verifies epoch bundles without any connection to the network. The only things trusted are the
+// hash of the epoch-start metachain header, which the auditor gets from a source of its own choice, and the bundle's
+// validator set, which should be compared with the published nodes setup
+type EpochBundleVerifier struct {
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	multiSigVerifier crypto.MultiSigVerifier
+}
+
+// NewEpochBundleVerifier creates a new EpochBundleVerifier instance
+func NewEpochBundleVerifier(args ArgEpochBundleVerifier) (*EpochBundleVerifier, error) {
+	if args.Marshalizer == nil || args.Marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if args.Hasher == nil || args.Hasher.IsInterfaceNil() {
+		return nil, ErrNilHasher
+	}
+	if args.MultiSigVerifier == nil || args.MultiSigVerifier.IsInterfaceNil() {
+		return nil, ErrNilMultiSigVerifier
+	}
+
+	return &EpochBundleVerifier{
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		multiSigVerifier: args.MultiSigVerifier,
+	}, nil
+}
+
+// Verify checks that the epoch-start metachain header has the trusted hash and was signed by its consensus group,
+// that the following metachain headers form a signed chain starting from it, that the shard headers are exactly the
+// ones notarized by the metachain headers and were signed by their consensus groups and that each header has a
+// finality proof matching it
+func (ebv *EpochBundleVerifier) Verify(bundle *EpochBundle, trustedEpochStartHash []byte) (*VerifiedEpochBundle, error) {
+	if bundle == nil {
+		return nil, ErrNilEpochBundle
+	}
+	if len(trustedEpochStartHash) == 0 {
+		return nil, ErrEmptyTrustedHash
+	}
+	if len(bundle.MetaBlocks) == 0 {
+		return nil, ErrEmptyEpochBundle
+	}
+
+	nodesCoordinator, err := NewNodesCoordinatorFromValidatorSet(bundle.ValidatorSet, ebv.hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	metaBlocks, err := ebv.unmarshalMetaBlocks(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	epochStartHeader, err := ebv.verifyEpochStart(metaBlocks[0], trustedEpochStartHash, nodesCoordinator)
+	if err != nil {
+		return nil, err
+	}
+
+	metachainVerifier, err := NewMetachainVerifier(ArgMetachainVerifier{
+		Marshalizer:      ebv.marshalizer,
+		Hasher:           ebv.hasher,
+		MultiSigVerifier: ebv.multiSigVerifier,
+		NodesCoordinator: nodesCoordinator,
+		TrustedHeader:    metaBlocks[0],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	verifiedMetaBlocks, err := metachainVerifier.VerifyChain(metaBlocks[1:])
+	if err != nil {
+		return nil, err
+	}
+	verifiedMetaBlocks = append([]*VerifiedHeader{epochStartHeader}, verifiedMetaBlocks...)
+
+	headers := make(map[string]data.HeaderHandler)
+	notarized := make(map[string]bool)
+	for _, verifiedMetaBlock := range verifiedMetaBlocks {
+		headers[string(verifiedMetaBlock.Hash)] = verifiedMetaBlock.Header
+		for _, shardData := range verifiedMetaBlock.Header.ShardInfo {
+			notarized[string(shardData.HeaderHash)] = true
+		}
+	}
+
+	shardHeaders, err := ebv.verifyShardHeaders(bundle.ShardHeaders, notarized, nodesCoordinator)
+	if err != nil {
+		return nil, err
+	}
+	for _, shardHeader := range shardHeaders {
+		headers[string(shardHeader.Hash)] = shardHeader.Header
+	}
+
+	err = ebv.verifyFinalityProofs(bundle.FinalityProofs, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VerifiedEpochBundle{
+		Epoch:          bundle.Epoch,
+		EpochStartHash: epochStartHeader.Hash,
+		MetaBlocks:     verifiedMetaBlocks,
+		ShardHeaders:   shardHeaders,
+	}, nil
+}
+
+func (ebv *EpochBundleVerifier) unmarshalMetaBlocks(bundle *EpochBundle) ([]*block.MetaBlock, error) {
+	metaBlocks := make([]*block.MetaBlock, 0, len(bundle.MetaBlocks))
+	for _, buff := range bundle.MetaBlocks {
+		metaBlock := &block.MetaBlock{}
+		err := ebv.marshalizer.Unmarshal(metaBlock, buff)
+		if err != nil {
+			return nil, err
+		}
+		if metaBlock.Epoch != bundle.Epoch {
+			return nil, ErrWrongEpoch
+		}
+
+		metaBlocks = append(metaBlocks, metaBlock)
+	}
+
+	return metaBlocks, nil
+}
+
+// verifyEpochStart checks the epoch-start header against the trusted hash. Its signature is checked as well, so that
+// a bundle whose validator set differs from the one that produced the epoch fails right from the checkpoint
+func (ebv *EpochBundleVerifier) verifyEpochStart(
+	header *block.MetaBlock,
+	trustedHash []byte,
+	nodesCoordinator sharding.NodesCoordinator,
+) (*VerifiedHeader, error) {
+	hash, err := core.CalculateHash(ebv.marshalizer, ebv.hasher, header)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(hash, trustedHash) {
+		return nil, ErrUntrustedEpochStart
+	}
+
+	interceptedHeader := processBlock.NewInterceptedMetaHeader(
+		ebv.multiSigVerifier,
+		nodesCoordinator,
+		ebv.marshalizer,
+		ebv.hasher,
+	)
+	interceptedHeader.MetaBlock = header
+	err = interceptedHeader.VerifySig()
+	if err != nil {
+		return nil, err
+	}
+
+	return &VerifiedHeader{
+		Hash:                  hash,
+		Header:                header,
+		UnverifiedPeerChanges: header.PeerInfo,
+	}, nil
+}
+
+func (ebv *EpochBundleVerifier) verifyShardHeaders(
+	buffs [][]byte,
+	notarized map[string]bool,
+	nodesCoordinator sharding.NodesCoordinator,
+) ([]*VerifiedShardHeader, error) {
+	shardHeaders := make([]*VerifiedShardHeader, 0, len(buffs))
+	found := make(map[string]bool, len(buffs))
+	for _, buff := range buffs {
+		interceptedHeader := processBlock.NewInterceptedHeader(
+			ebv.multiSigVerifier,
+			nodesCoordinator,
+			ebv.marshalizer,
+			ebv.hasher,
+		)
+		err := ebv.marshalizer.Unmarshal(interceptedHeader.Header, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		hash, err := core.CalculateHash(ebv.marshalizer, ebv.hasher, interceptedHeader.Header)
+		if err != nil {
+			return nil, err
+		}
+		if !notarized[string(hash)] {
+			return nil, ErrShardHeaderNotNotarized
+		}
+
+		err = interceptedHeader.VerifySig()
+		if err != nil {
+			return nil, err
+		}
+
+		shardHeaders = append(shardHeaders, &VerifiedShardHeader{
+			Hash:   hash,
+			Header: interceptedHeader.Header,
+		})
+		found[string(hash)] = true
+	}
+
+	for hash := range notarized {
+		if !found[hash] {
+			return nil, ErrMissingShardHeader
+		}
+	}
+
+	return shardHeaders, nil
+}
+
+// verifyFinalityProofs checks that each header has a finality proof identical to the one created from the header.
+// The headers' signatures were already verified, so a matching proof is valid as well
+func (ebv *EpochBundleVerifier) verifyFinalityProofs(
+	proofs []*block.FinalityProof,
+	headers map[string]data.HeaderHandler,
+) error {
+	proven := make(map[string]bool, len(proofs))
+	for _, proof := range proofs {
+		if proof == nil {
+			return ErrUnknownFinalityProof
+		}
+
+		header, ok := headers[string(proof.HeaderHash)]
+		if !ok {
+			return ErrUnknownFinalityProof
+		}
+
+		signedMessageHash, err := signedMessageHash(ebv.marshalizer, ebv.hasher, header)
+		if err != nil {
+			return err
+		}
+
+		expectedProof := block.NewFinalityProof(header, proof.HeaderHash, signedMessageHash)
+		if !isSameFinalityProof(proof, expectedProof) {
+			return ErrFinalityProofMismatch
+		}
+
+		proven[string(proof.HeaderHash)] = true
+	}
+
+	for hash := range headers {
+		if !proven[hash] {
+			return ErrMissingFinalityProof
+		}
+	}
+
+	return nil
+}
+
+func isSameFinalityProof(proof *block.FinalityProof, expected *block.FinalityProof) bool {
+	return bytes.Equal(proof.SignedMessageHash, expected.SignedMessageHash) &&
+		proof.ShardId == expected.ShardId &&
+		proof.Nonce == expected.Nonce &&
+		proof.Round == expected.Round &&
+		bytes.Equal(proof.PrevRandSeed, expected.PrevRandSeed) &&
+		bytes.Equal(proof.PubKeysBitmap, expected.PubKeysBitmap) &&
+		bytes.Equal(proof.AggregatedSignature, expected.AggregatedSignature)
+}
+
+// signedMessageHash computes the hash of the header without its signature and bitmap, as this is the message signed
+// by the consensus group
+func signedMessageHash(marshalizer marshal.Marshalizer, hasher hashing.Hasher, header data.HeaderHandler) ([]byte, error) {
+	switch hdr := header.(type) {
+	case *block.Header:
+		headerCopy := *hdr
+		headerCopy.Signature = nil
+		headerCopy.PubKeysBitmap = nil
+		return core.CalculateHash(marshalizer, hasher, headerCopy)
+	case *block.MetaBlock:
+		headerCopy := *hdr
+		headerCopy.Signature = nil
+		headerCopy.PubKeysBitmap = nil
+		return core.CalculateHash(marshalizer, hasher, headerCopy)
+	default:
+		return nil, ErrWrongTypeAssertion
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ebv *EpochBundleVerifier) IsInterfaceNil() bool {
+	if ebv == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+package lightClient
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// EpochBundleExporter builds epoch bundles from the headers found in the node's storage. It should run on a
+// metachain node, as only the metachain nodes store the headers of all the shards
+type EpochBundleExporter struct {
+	blockChain      data.ChainHandler
+	store           dataRetriever.StorageService
+	marshalizer     marshal.Marshalizer
+	hasher          hashing.Hasher
+	uint64Converter typeConverters.Uint64ByteSliceConverter
+	validatorSet    *ValidatorSet
+}
+
+// NewEpochBundleExporter creates a new EpochBundleExporter instance. The validator set is added as it is in every
+// exported bundle
+func NewEpochBundleExporter(
+	blockChain data.ChainHandler,
+	store dataRetriever.StorageService,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	uint64Converter typeConverters.Uint64ByteSliceConverter,
+	validatorSet *ValidatorSet,
+) (*EpochBundleExporter, error) {
+	if blockChain == nil || blockChain.IsInterfaceNil() {
+		return nil, ErrNilBlockChain
+	}
+	if store == nil || store.IsInterfaceNil() {
+		return nil, ErrNilStore
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, ErrNilHasher
+	}
+	if uint64Converter == nil || uint64Converter.IsInterfaceNil() {
+		return nil, ErrNilUint64Converter
+	}
+	if validatorSet == nil {
+		return nil, ErrNilValidatorSet
+	}
+
+	return &EpochBundleExporter{
+		blockChain:      blockChain,
+		store:           store,
+		marshalizer:     marshalizer,
+		hasher:          hasher,
+		uint64Converter: uint64Converter,
+		validatorSet:    validatorSet,
+	}, nil
+}
+
+// ExportEpochBundle returns the bundle of the provided epoch, built from the stored metachain headers of that epoch,
+// the shard headers they notarized and the finality proofs of all of them. It also returns the hash of the
+// epoch-start metachain header, which the auditors should confirm from another source before trusting the bundle
+func (ebe *EpochBundleExporter) ExportEpochBundle(epoch uint32) (*EpochBundle, []byte, error) {
+	bundle := &EpochBundle{
+		Epoch:          epoch,
+		ValidatorSet:   ebe.validatorSet,
+		MetaBlocks:     make([][]byte, 0),
+		ShardHeaders:   make([][]byte, 0),
+		FinalityProofs: make([]*block.FinalityProof, 0),
+	}
+
+	currentHeader := ebe.blockChain.GetCurrentBlockHeader()
+	if currentHeader == nil || currentHeader.IsInterfaceNil() {
+		return nil, nil, ErrEpochNotFound
+	}
+
+	var epochStartHash []byte
+	for nonce := uint64(1); nonce <= currentHeader.GetNonce(); nonce++ {
+		metaBlock, metaBlockHash, err := process.GetMetaHeaderFromStorageWithNonce(
+			nonce,
+			ebe.store,
+			ebe.uint64Converter,
+			ebe.marshalizer,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		if metaBlock.Epoch < epoch {
+			continue
+		}
+		if metaBlock.Epoch > epoch {
+			break
+		}
+
+		if epochStartHash == nil {
+			epochStartHash = metaBlockHash
+		}
+
+		err = ebe.addHeader(bundle, metaBlock, metaBlockHash, dataRetriever.MetaBlockUnit)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, shardData := range metaBlock.ShardInfo {
+			shardHeader, errGet := process.GetShardHeaderFromStorage(shardData.HeaderHash, ebe.marshalizer, ebe.store)
+			if errGet != nil {
+				return nil, nil, errGet
+			}
+
+			err = ebe.addHeader(bundle, shardHeader, shardData.HeaderHash, dataRetriever.BlockHeaderUnit)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if epochStartHash == nil {
+		return nil, nil, ErrEpochNotFound
+	}
+
+	return bundle, epochStartHash, nil
+}
+
+// addHeader adds the header, as stored, and its finality proof in the bundle
+func (ebe *EpochBundleExporter) addHeader(
+	bundle *EpochBundle,
+	header data.HeaderHandler,
+	headerHash []byte,
+	unit dataRetriever.UnitType,
+) error {
+	buff, err := process.GetMarshalizedHeaderFromStorage(unit, headerHash, ebe.marshalizer, ebe.store)
+	if err != nil {
+		return err
+	}
+
+	signedMessageHash, err := signedMessageHash(ebe.marshalizer, ebe.hasher, header)
+	if err != nil {
+		return err
+	}
+
+	if unit == dataRetriever.MetaBlockUnit {
+		bundle.MetaBlocks = append(bundle.MetaBlocks, buff)
+	} else {
+		bundle.ShardHeaders = append(bundle.ShardHeaders, buff)
+	}
+	bundle.FinalityProofs = append(bundle.FinalityProofs, block.NewFinalityProof(header, headerHash, signedMessageHash))
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ebe *EpochBundleExporter) IsInterfaceNil() bool {
+	if ebe == nil {
+		return true
+	}
+	return false
+}
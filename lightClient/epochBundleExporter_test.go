@@ -0,0 +1,197 @@
+package lightClient_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/lightClient"
+	"github.com/ElrondNetwork/elrond-go/lightClient/mock"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+type epochBundleTestEnv struct {
+	blockChain *mock.BlockChainMock
+	store      dataRetriever.StorageService
+	lastMeta   *block.MetaBlock
+}
+
+// createEpochBundleTestEnv stores a metachain with one header in epoch 0 and three headers in epoch 1, each of them
+// notarizing a shard header
+func createEpochBundleTestEnv() *epochBundleTestEnv {
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.MetaBlockUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.MetaHdrNonceHashDataUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.BlockHeaderUnit, mock.NewStorerMock())
+
+	env := &epochBundleTestEnv{
+		blockChain: &mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return nil
+			},
+		},
+		store: store,
+		lastMeta: &block.MetaBlock{
+			Nonce:    0,
+			Round:    0,
+			RandSeed: []byte("genesis rand seed"),
+		},
+	}
+
+	env.addMetaBlock(0)
+	env.addMetaBlock(1)
+	env.addMetaBlock(1)
+	env.addMetaBlock(1)
+
+	return env
+}
+
+func (env *epochBundleTestEnv) addMetaBlock(epoch uint32) {
+	marshalizer := &marshal.JsonMarshalizer{}
+	hasher := sha256.Sha256{}
+
+	metaBlock := createNextHeader(env.lastMeta)
+	metaBlock.Epoch = epoch
+
+	shardHeader := &block.Header{
+		Nonce:            metaBlock.Nonce,
+		Round:            metaBlock.Round,
+		ShardId:          0,
+		PrevRandSeed:     append([]byte("shard rand seed "), byte(metaBlock.Nonce)),
+		PubKeysBitmap:    []byte{1},
+		Signature:        []byte("shard aggregated signature"),
+		MiniBlockHeaders: make([]block.MiniBlockHeader, 0),
+	}
+	buff, _ := marshalizer.Marshal(shardHeader)
+	shardHeaderHash := hasher.Compute(string(buff))
+	_ = env.store.Put(dataRetriever.BlockHeaderUnit, shardHeaderHash, buff)
+	metaBlock.ShardInfo = []block.ShardData{{ShardId: 0, HeaderHash: shardHeaderHash}}
+
+	buff, _ = marshalizer.Marshal(metaBlock)
+	metaBlockHash := hasher.Compute(string(buff))
+	_ = env.store.Put(dataRetriever.MetaBlockUnit, metaBlockHash, buff)
+	nonceToByteSlice := uint64ByteSlice.NewBigEndianConverter().ToByteSlice(metaBlock.Nonce)
+	_ = env.store.Put(dataRetriever.MetaHdrNonceHashDataUnit, nonceToByteSlice, metaBlockHash)
+
+	env.lastMeta = metaBlock
+	env.blockChain.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return metaBlock
+	}
+}
+
+func createValidatorSet() *lightClient.ValidatorSet {
+	nodesSetup, _ := sharding.NewNodesSetup("mock/nodesSetupMock.json", 0xFFFFFFFFFFFFFFFF)
+	validatorSet, _ := lightClient.NewValidatorSetFromNodesSetup(nodesSetup)
+
+	return validatorSet
+}
+
+func (env *epochBundleTestEnv) createExporter() *lightClient.EpochBundleExporter {
+	ebe, _ := lightClient.NewEpochBundleExporter(
+		env.blockChain,
+		env.store,
+		&marshal.JsonMarshalizer{},
+		sha256.Sha256{},
+		uint64ByteSlice.NewBigEndianConverter(),
+		createValidatorSet(),
+	)
+
+	return ebe
+}
+
+//------- NewEpochBundleExporter
+
+func TestNewEpochBundleExporter_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	env := createEpochBundleTestEnv()
+	converter := uint64ByteSlice.NewBigEndianConverter()
+
+	ebe, err := lightClient.NewEpochBundleExporter(nil, env.store, &marshal.JsonMarshalizer{}, sha256.Sha256{}, converter, createValidatorSet())
+	assert.Nil(t, ebe)
+	assert.Equal(t, lightClient.ErrNilBlockChain, err)
+
+	ebe, err = lightClient.NewEpochBundleExporter(env.blockChain, nil, &marshal.JsonMarshalizer{}, sha256.Sha256{}, converter, createValidatorSet())
+	assert.Nil(t, ebe)
+	assert.Equal(t, lightClient.ErrNilStore, err)
+
+	ebe, err = lightClient.NewEpochBundleExporter(env.blockChain, env.store, nil, sha256.Sha256{}, converter, createValidatorSet())
+	assert.Nil(t, ebe)
+	assert.Equal(t, lightClient.ErrNilMarshalizer, err)
+
+	ebe, err = lightClient.NewEpochBundleExporter(env.blockChain, env.store, &marshal.JsonMarshalizer{}, nil, converter, createValidatorSet())
+	assert.Nil(t, ebe)
+	assert.Equal(t, lightClient.ErrNilHasher, err)
+
+	ebe, err = lightClient.NewEpochBundleExporter(env.blockChain, env.store, &marshal.JsonMarshalizer{}, sha256.Sha256{}, nil, createValidatorSet())
+	assert.Nil(t, ebe)
+	assert.Equal(t, lightClient.ErrNilUint64Converter, err)
+
+	ebe, err = lightClient.NewEpochBundleExporter(env.blockChain, env.store, &marshal.JsonMarshalizer{}, sha256.Sha256{}, converter, nil)
+	assert.Nil(t, ebe)
+	assert.Equal(t, lightClient.ErrNilValidatorSet, err)
+}
+
+func TestNewEpochBundleExporter_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ebe := createEpochBundleTestEnv().createExporter()
+
+	assert.NotNil(t, ebe)
+	assert.False(t, ebe.IsInterfaceNil())
+}
+
+//------- ExportEpochBundle
+
+func TestEpochBundleExporter_ExportEpochBundleWithoutBlocksShouldErr(t *testing.T) {
+	t.Parallel()
+
+	env := createEpochBundleTestEnv()
+	env.blockChain.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return nil
+	}
+
+	bundle, epochStartHash, err := env.createExporter().ExportEpochBundle(0)
+
+	assert.Nil(t, bundle)
+	assert.Nil(t, epochStartHash)
+	assert.Equal(t, lightClient.ErrEpochNotFound, err)
+}
+
+func TestEpochBundleExporter_ExportEpochBundleUnknownEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bundle, _, err := createEpochBundleTestEnv().createExporter().ExportEpochBundle(2)
+
+	assert.Nil(t, bundle)
+	assert.Equal(t, lightClient.ErrEpochNotFound, err)
+}
+
+func TestEpochBundleExporter_ExportEpochBundleShouldAddOnlyTheEpochHeaders(t *testing.T) {
+	t.Parallel()
+
+	env := createEpochBundleTestEnv()
+
+	bundle, epochStartHash, err := env.createExporter().ExportEpochBundle(1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), bundle.Epoch)
+	assert.Equal(t, createValidatorSet(), bundle.ValidatorSet)
+	assert.Equal(t, 3, len(bundle.MetaBlocks))
+	assert.Equal(t, 3, len(bundle.ShardHeaders))
+	assert.Equal(t, 6, len(bundle.FinalityProofs))
+
+	epochStart := &block.MetaBlock{}
+	_ = (&marshal.JsonMarshalizer{}).Unmarshal(epochStart, bundle.MetaBlocks[0])
+	assert.Equal(t, uint64(2), epochStart.Nonce)
+	expectedHash, _ := core.CalculateHash(&marshal.JsonMarshalizer{}, sha256.Sha256{}, epochStart)
+	assert.Equal(t, expectedHash, epochStartHash)
+	assert.Equal(t, expectedHash, bundle.FinalityProofs[0].HeaderHash)
+	assert.Equal(t, epochStart.Signature, bundle.FinalityProofs[0].AggregatedSignature)
+}
@@ -0,0 +1,190 @@
+package lightClient_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/lightClient"
+	"github.com/ElrondNetwork/elrond-go/lightClient/mock"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgEpochBundleVerifier() lightClient.ArgEpochBundleVerifier {
+	return lightClient.ArgEpochBundleVerifier{
+		Marshalizer:      &marshal.JsonMarshalizer{},
+		Hasher:           sha256.Sha256{},
+		MultiSigVerifier: mock.NewMultiSigner(),
+	}
+}
+
+func createEpochBundleAndVerifier() (*lightClient.EpochBundle, []byte, *lightClient.EpochBundleVerifier) {
+	bundle, epochStartHash, _ := createEpochBundleTestEnv().createExporter().ExportEpochBundle(1)
+	ebv, _ := lightClient.NewEpochBundleVerifier(createMockArgEpochBundleVerifier())
+
+	return bundle, epochStartHash, ebv
+}
+
+//------- NewEpochBundleVerifier
+
+func TestNewEpochBundleVerifier_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgEpochBundleVerifier()
+	args.Marshalizer = nil
+	ebv, err := lightClient.NewEpochBundleVerifier(args)
+	assert.Nil(t, ebv)
+	assert.Equal(t, lightClient.ErrNilMarshalizer, err)
+
+	args = createMockArgEpochBundleVerifier()
+	args.Hasher = nil
+	ebv, err = lightClient.NewEpochBundleVerifier(args)
+	assert.Nil(t, ebv)
+	assert.Equal(t, lightClient.ErrNilHasher, err)
+
+	args = createMockArgEpochBundleVerifier()
+	args.MultiSigVerifier = nil
+	ebv, err = lightClient.NewEpochBundleVerifier(args)
+	assert.Nil(t, ebv)
+	assert.Equal(t, lightClient.ErrNilMultiSigVerifier, err)
+}
+
+func TestNewEpochBundleVerifier_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ebv, err := lightClient.NewEpochBundleVerifier(createMockArgEpochBundleVerifier())
+
+	assert.Nil(t, err)
+	assert.False(t, ebv.IsInterfaceNil())
+}
+
+//------- Verify
+
+func TestEpochBundleVerifier_VerifyInvalidInputShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bundle, epochStartHash, ebv := createEpochBundleAndVerifier()
+
+	_, err := ebv.Verify(nil, epochStartHash)
+	assert.Equal(t, lightClient.ErrNilEpochBundle, err)
+
+	_, err = ebv.Verify(bundle, nil)
+	assert.Equal(t, lightClient.ErrEmptyTrustedHash, err)
+
+	_, err = ebv.Verify(&lightClient.EpochBundle{ValidatorSet: bundle.ValidatorSet}, epochStartHash)
+	assert.Equal(t, lightClient.ErrEmptyEpochBundle, err)
+
+	bundle.ValidatorSet = nil
+	_, err = ebv.Verify(bundle, epochStartHash)
+	assert.Equal(t, lightClient.ErrNilValidatorSet, err)
+}
+
+func TestEpochBundleVerifier_VerifyExportedBundleShouldWork(t *testing.T) {
+	t.Parallel()
+
+	exported, epochStartHash, ebv := createEpochBundleAndVerifier()
+	buff, _ := json.Marshal(exported)
+	bundle := &lightClient.EpochBundle{}
+	_ = json.Unmarshal(buff, bundle)
+
+	verified, err := ebv.Verify(bundle, epochStartHash)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), verified.Epoch)
+	assert.Equal(t, epochStartHash, verified.EpochStartHash)
+	assert.Equal(t, 3, len(verified.MetaBlocks))
+	assert.Equal(t, uint64(2), verified.MetaBlocks[0].Header.Nonce)
+	assert.Equal(t, uint64(4), verified.MetaBlocks[2].Header.Nonce)
+	assert.Equal(t, 3, len(verified.ShardHeaders))
+	assert.Equal(t, verified.MetaBlocks[1].Header.ShardInfo[0].HeaderHash, verified.ShardHeaders[1].Hash)
+}
+
+func TestEpochBundleVerifier_VerifyUntrustedEpochStartShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bundle, _, ebv := createEpochBundleAndVerifier()
+
+	_, err := ebv.Verify(bundle, []byte("other hash"))
+
+	assert.Equal(t, lightClient.ErrUntrustedEpochStart, err)
+}
+
+func TestEpochBundleVerifier_VerifyWrongEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bundle, epochStartHash, ebv := createEpochBundleAndVerifier()
+	bundle.Epoch = 2
+
+	_, err := ebv.Verify(bundle, epochStartHash)
+
+	assert.Equal(t, lightClient.ErrWrongEpoch, err)
+}
+
+func TestEpochBundleVerifier_VerifyBrokenChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bundle, epochStartHash, ebv := createEpochBundleAndVerifier()
+	bundle.MetaBlocks = append(bundle.MetaBlocks[:1], bundle.MetaBlocks[2])
+
+	_, err := ebv.Verify(bundle, epochStartHash)
+
+	assert.Equal(t, lightClient.ErrWrongNonce, err)
+}
+
+func TestEpochBundleVerifier_VerifyMissingShardHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bundle, epochStartHash, ebv := createEpochBundleAndVerifier()
+	bundle.ShardHeaders = bundle.ShardHeaders[1:]
+
+	_, err := ebv.Verify(bundle, epochStartHash)
+
+	assert.Equal(t, lightClient.ErrMissingShardHeader, err)
+}
+
+func TestEpochBundleVerifier_VerifyNotNotarizedShardHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bundle, epochStartHash, ebv := createEpochBundleAndVerifier()
+	buff, _ := (&marshal.JsonMarshalizer{}).Marshal(&block.Header{Nonce: 100, PubKeysBitmap: []byte{1}})
+	bundle.ShardHeaders = append(bundle.ShardHeaders, buff)
+
+	_, err := ebv.Verify(bundle, epochStartHash)
+
+	assert.Equal(t, lightClient.ErrShardHeaderNotNotarized, err)
+}
+
+func TestEpochBundleVerifier_VerifyMissingFinalityProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bundle, epochStartHash, ebv := createEpochBundleAndVerifier()
+	bundle.FinalityProofs = bundle.FinalityProofs[1:]
+
+	_, err := ebv.Verify(bundle, epochStartHash)
+
+	assert.Equal(t, lightClient.ErrMissingFinalityProof, err)
+}
+
+func TestEpochBundleVerifier_VerifyUnknownFinalityProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bundle, epochStartHash, ebv := createEpochBundleAndVerifier()
+	bundle.FinalityProofs = append(bundle.FinalityProofs, &block.FinalityProof{HeaderHash: []byte("unknown")})
+
+	_, err := ebv.Verify(bundle, epochStartHash)
+
+	assert.Equal(t, lightClient.ErrUnknownFinalityProof, err)
+}
+
+func TestEpochBundleVerifier_VerifyTamperedFinalityProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bundle, epochStartHash, ebv := createEpochBundleAndVerifier()
+	bundle.FinalityProofs[1].AggregatedSignature = []byte("other signature")
+
+	_, err := ebv.Verify(bundle, epochStartHash)
+
+	assert.Equal(t, lightClient.ErrFinalityProofMismatch, err)
+}
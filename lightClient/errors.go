@@ -0,0 +1,86 @@
+package lightClient
+
+import (
+	"errors"
+)
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilMultiSigVerifier signals that a nil multi-signature verifier has been provided
+var ErrNilMultiSigVerifier = errors.New("nil multi-signature verifier")
+
+// ErrNilNodesCoordinator signals that a nil nodes coordinator has been provided
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNilNodesSetup signals that a nil nodes setup has been provided
+var ErrNilNodesSetup = errors.New("nil nodes setup")
+
+// ErrNilTrustedHeader signals that the checkpoint header was not provided
+var ErrNilTrustedHeader = errors.New("nil trusted header")
+
+// ErrNilHeader signals that a nil header has been provided for verification
+var ErrNilHeader = errors.New("nil header")
+
+// ErrWrongNonce signals that a header does not follow, by nonce, the last verified header
+var ErrWrongNonce = errors.New("header nonce does not follow the last verified header")
+
+// ErrWrongRound signals that a header was not produced in a round after the one of the last verified header
+var ErrWrongRound = errors.New("header round is not after the last verified header round")
+
+// ErrWrongPrevHash signals that a header does not link to the hash of the last verified header
+var ErrWrongPrevHash = errors.New("header previous hash does not match the last verified header hash")
+
+// ErrWrongPrevRandSeed signals that a header previous random seed is not the last verified header random seed
+var ErrWrongPrevRandSeed = errors.New("header previous random seed does not match the last verified header random seed")
+
+// ErrNilValidatorSet signals that a nil validator set has been provided
+var ErrNilValidatorSet = errors.New("nil validator set")
+
+// ErrNilStore signals that a nil storage service has been provided
+var ErrNilStore = errors.New("nil store")
+
+// ErrNilBlockChain signals that a nil blockchain has been provided
+var ErrNilBlockChain = errors.New("nil blockchain")
+
+// ErrNilUint64Converter signals that a nil uint64 <-> byte slice converter has been provided
+var ErrNilUint64Converter = errors.New("nil uint64 converter")
+
+// ErrEpochNotFound signals that no stored metachain header belongs to the requested epoch
+var ErrEpochNotFound = errors.New("no metachain header found for the epoch")
+
+// ErrNilEpochBundle signals that a nil epoch bundle has been provided for verification
+var ErrNilEpochBundle = errors.New("nil epoch bundle")
+
+// ErrEmptyEpochBundle signals that the epoch bundle does not hold any metachain header
+var ErrEmptyEpochBundle = errors.New("epoch bundle without metachain headers")
+
+// ErrEmptyTrustedHash signals that the trusted hash of the epoch-start metachain header was not provided
+var ErrEmptyTrustedHash = errors.New("empty trusted epoch-start hash")
+
+// ErrUntrustedEpochStart signals that the epoch-start metachain header of the bundle does not have the trusted hash
+var ErrUntrustedEpochStart = errors.New("epoch-start metachain header hash does not match the trusted hash")
+
+// ErrWrongEpoch signals that a header of the bundle does not belong to the bundle's epoch
+var ErrWrongEpoch = errors.New("header does not belong to the bundle epoch")
+
+// ErrShardHeaderNotNotarized signals that a shard header of the bundle was not notarized by any of its metachain headers
+var ErrShardHeaderNotNotarized = errors.New("shard header not notarized by the bundle metachain headers")
+
+// ErrMissingShardHeader signals that a shard header notarized by the bundle metachain headers is not in the bundle
+var ErrMissingShardHeader = errors.New("notarized shard header missing from the bundle")
+
+// ErrMissingFinalityProof signals that a header of the bundle does not have its finality proof in the bundle
+var ErrMissingFinalityProof = errors.New("finality proof missing from the bundle")
+
+// ErrUnknownFinalityProof signals that a finality proof of the bundle was not created for any of its headers
+var ErrUnknownFinalityProof = errors.New("finality proof of a header not in the bundle")
+
+// ErrFinalityProofMismatch signals that a finality proof does not match the header it was created for
+var ErrFinalityProofMismatch = errors.New("finality proof does not match its header")
+
+// ErrWrongTypeAssertion signals that a wrong type assertion occurred
+var ErrWrongTypeAssertion = errors.New("wrong type assertion")
@@ -0,0 +1,184 @@
+package lightClient
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	processBlock "github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgMetachainVerifier holds all dependencies required by the metachain verifier in order to create new instances
+type ArgMetachainVerifier struct {
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	MultiSigVerifier crypto.MultiSigVerifier
+	NodesCoordinator sharding.NodesCoordinator
+	TrustedHeader    *block.MetaBlock
+}
+
+// VerifiedHeader holds a metachain header whose finality was verified together with its hash and the validator set
+// transitions it notarized. The transitions are part of the signed header but they are neither checked against the
+// staking data nor applied to the consensus groups used by the verifier, so the caller should treat them as unverified
+type VerifiedHeader struct {
+	Hash                  []byte
+	Header                *block.MetaBlock
+	UnverifiedPeerChanges []block.PeerData
+}
+
+// MetachainVerifier verifies, starting from a trusted metachain header, that each following metachain header links
+// to the previous one and was signed by its consensus group. The headers can be fetched from any node, through the
+// storage API, as they do not need to be trusted.
+// The consensus groups are computed from the validator set in force at the checkpoint for all the verified headers:
+// the registrations and deregistrations notarized by the verified headers are reported to the caller, unverified, but,
+// as in the nodes, they do not change the eligible lists
+type MetachainVerifier struct {
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	multiSigVerifier crypto.MultiSigVerifier
+	nodesCoordinator sharding.NodesCoordinator
+
+	mutLastVerified  sync.RWMutex
+	lastVerified     *block.MetaBlock
+	lastVerifiedHash []byte
+}
+
+// NewMetachainVerifier creates a new metachain verifier having the provided header as checkpoint
+func NewMetachainVerifier(args ArgMetachainVerifier) (*MetachainVerifier, error) {
+	if args.Marshalizer == nil || args.Marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if args.Hasher == nil || args.Hasher.IsInterfaceNil() {
+		return nil, ErrNilHasher
+	}
+	if args.MultiSigVerifier == nil || args.MultiSigVerifier.IsInterfaceNil() {
+		return nil, ErrNilMultiSigVerifier
+	}
+	if args.NodesCoordinator == nil || args.NodesCoordinator.IsInterfaceNil() {
+		return nil, ErrNilNodesCoordinator
+	}
+	if args.TrustedHeader == nil {
+		return nil, ErrNilTrustedHeader
+	}
+
+	trustedHash, err := core.CalculateHash(args.Marshalizer, args.Hasher, args.TrustedHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MetachainVerifier{
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		multiSigVerifier: args.MultiSigVerifier,
+		nodesCoordinator: args.NodesCoordinator,
+		lastVerified:     args.TrustedHeader,
+		lastVerifiedHash: trustedHash,
+	}, nil
+}
+
+// VerifyMarshalizedHeader unmarshals a metachain header, as returned by the nodes, and verifies it
+func (mv *MetachainVerifier) VerifyMarshalizedHeader(buff []byte) (*VerifiedHeader, error) {
+	header := &block.MetaBlock{}
+	err := mv.marshalizer.Unmarshal(header, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return mv.VerifyHeader(header)
+}
+
+// VerifyChain verifies, in order, the headers following the last verified header. The headers verified before the
+// first failure are returned together with the error
+func (mv *MetachainVerifier) VerifyChain(headers []*block.MetaBlock) ([]*VerifiedHeader, error) {
+	verified := make([]*VerifiedHeader, 0, len(headers))
+	for _, header := range headers {
+		verifiedHeader, err := mv.VerifyHeader(header)
+		if err != nil {
+			return verified, err
+		}
+
+		verified = append(verified, verifiedHeader)
+	}
+
+	return verified, nil
+}
+
+// VerifyHeader verifies that the header follows the last verified header and that it was signed by the leader and
+// the required part of its consensus group. On success, the header becomes the last verified header
+func (mv *MetachainVerifier) VerifyHeader(header *block.MetaBlock) (*VerifiedHeader, error) {
+	if header == nil {
+		return nil, ErrNilHeader
+	}
+
+	mv.mutLastVerified.Lock()
+	defer mv.mutLastVerified.Unlock()
+
+	err := mv.checkLink(header)
+	if err != nil {
+		return nil, err
+	}
+
+	interceptedHeader := processBlock.NewInterceptedMetaHeader(
+		mv.multiSigVerifier,
+		mv.nodesCoordinator,
+		mv.marshalizer,
+		mv.hasher,
+	)
+	interceptedHeader.MetaBlock = header
+	err = interceptedHeader.VerifySig()
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := core.CalculateHash(mv.marshalizer, mv.hasher, header)
+	if err != nil {
+		return nil, err
+	}
+
+	mv.lastVerified = header
+	mv.lastVerifiedHash = hash
+
+	return &VerifiedHeader{
+		Hash:                  hash,
+		Header:                header,
+		UnverifiedPeerChanges: header.PeerInfo,
+	}, nil
+}
+
+func (mv *MetachainVerifier) checkLink(header *block.MetaBlock) error {
+	if header.Nonce != mv.lastVerified.Nonce+1 {
+		return ErrWrongNonce
+	}
+	if header.Round <= mv.lastVerified.Round {
+		return ErrWrongRound
+	}
+	if !bytes.Equal(header.PrevHash, mv.lastVerifiedHash) {
+		return ErrWrongPrevHash
+	}
+	if !bytes.Equal(header.PrevRandSeed, mv.lastVerified.RandSeed) {
+		return ErrWrongPrevRandSeed
+	}
+
+	return nil
+}
+
+// LastVerifiedHeader returns the last verified header, or the checkpoint if none was verified yet, and its hash
+func (mv *MetachainVerifier) LastVerifiedHeader() (*block.MetaBlock, []byte) {
+	mv.mutLastVerified.RLock()
+	defer mv.mutLastVerified.RUnlock()
+
+	return mv.lastVerified, mv.lastVerifiedHash
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mv *MetachainVerifier) IsInterfaceNil() bool {
+	if mv == nil {
+		return true
+	}
+	return false
+}
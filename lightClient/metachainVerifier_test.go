@@ -0,0 +1,291 @@
+package lightClient_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/lightClient"
+	"github.com/ElrondNetwork/elrond-go/lightClient/mock"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTrustedHeader() *block.MetaBlock {
+	return &block.MetaBlock{
+		Nonce:    10,
+		Round:    20,
+		RandSeed: []byte("rand seed 10"),
+	}
+}
+
+func createNextHeader(prev *block.MetaBlock) *block.MetaBlock {
+	prevHash, _ := core.CalculateHash(&marshal.JsonMarshalizer{}, sha256.Sha256{}, prev)
+
+	return &block.MetaBlock{
+		Nonce:         prev.Nonce + 1,
+		Round:         prev.Round + 2,
+		PrevHash:      prevHash,
+		PrevRandSeed:  prev.RandSeed,
+		RandSeed:      append([]byte("rand seed "), byte(prev.Nonce+1)),
+		PubKeysBitmap: []byte{1},
+		Signature:     []byte("aggregated signature"),
+	}
+}
+
+func createNodesCoordinator() sharding.NodesCoordinator {
+	nodesSetup, _ := sharding.NewNodesSetup("mock/nodesSetupMock.json", 0xFFFFFFFFFFFFFFFF)
+	nodesCoordinator, _ := lightClient.NewNodesCoordinatorFromNodesSetup(nodesSetup, sha256.Sha256{})
+
+	return nodesCoordinator
+}
+
+func createMockArgMetachainVerifier() lightClient.ArgMetachainVerifier {
+	return lightClient.ArgMetachainVerifier{
+		Marshalizer:      &marshal.JsonMarshalizer{},
+		Hasher:           sha256.Sha256{},
+		MultiSigVerifier: mock.NewMultiSigner(),
+		NodesCoordinator: createNodesCoordinator(),
+		TrustedHeader:    createTrustedHeader(),
+	}
+}
+
+//------- NewMetachainVerifier
+
+func TestNewMetachainVerifier_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgMetachainVerifier()
+	args.Marshalizer = nil
+	mv, err := lightClient.NewMetachainVerifier(args)
+
+	assert.Nil(t, mv)
+	assert.Equal(t, lightClient.ErrNilMarshalizer, err)
+}
+
+func TestNewMetachainVerifier_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgMetachainVerifier()
+	args.Hasher = nil
+	mv, err := lightClient.NewMetachainVerifier(args)
+
+	assert.Nil(t, mv)
+	assert.Equal(t, lightClient.ErrNilHasher, err)
+}
+
+func TestNewMetachainVerifier_NilMultiSigVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgMetachainVerifier()
+	args.MultiSigVerifier = nil
+	mv, err := lightClient.NewMetachainVerifier(args)
+
+	assert.Nil(t, mv)
+	assert.Equal(t, lightClient.ErrNilMultiSigVerifier, err)
+}
+
+func TestNewMetachainVerifier_NilNodesCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgMetachainVerifier()
+	args.NodesCoordinator = nil
+	mv, err := lightClient.NewMetachainVerifier(args)
+
+	assert.Nil(t, mv)
+	assert.Equal(t, lightClient.ErrNilNodesCoordinator, err)
+}
+
+func TestNewMetachainVerifier_NilTrustedHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgMetachainVerifier()
+	args.TrustedHeader = nil
+	mv, err := lightClient.NewMetachainVerifier(args)
+
+	assert.Nil(t, mv)
+	assert.Equal(t, lightClient.ErrNilTrustedHeader, err)
+}
+
+func TestNewMetachainVerifier_ShouldStartFromTrustedHeader(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgMetachainVerifier()
+	mv, err := lightClient.NewMetachainVerifier(args)
+
+	assert.Nil(t, err)
+	lastVerified, lastVerifiedHash := mv.LastVerifiedHeader()
+	expectedHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, args.TrustedHeader)
+	assert.Equal(t, args.TrustedHeader, lastVerified)
+	assert.Equal(t, expectedHash, lastVerifiedHash)
+}
+
+//------- VerifyHeader
+
+func TestMetachainVerifier_VerifyHeaderNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mv, _ := lightClient.NewMetachainVerifier(createMockArgMetachainVerifier())
+
+	verified, err := mv.VerifyHeader(nil)
+
+	assert.Nil(t, verified)
+	assert.Equal(t, lightClient.ErrNilHeader, err)
+}
+
+func TestMetachainVerifier_VerifyHeaderWrongNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgMetachainVerifier()
+	mv, _ := lightClient.NewMetachainVerifier(args)
+	header := createNextHeader(args.TrustedHeader)
+	header.Nonce++
+
+	_, err := mv.VerifyHeader(header)
+
+	assert.Equal(t, lightClient.ErrWrongNonce, err)
+}
+
+func TestMetachainVerifier_VerifyHeaderWrongRoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgMetachainVerifier()
+	mv, _ := lightClient.NewMetachainVerifier(args)
+	header := createNextHeader(args.TrustedHeader)
+	header.Round = args.TrustedHeader.Round
+
+	_, err := mv.VerifyHeader(header)
+
+	assert.Equal(t, lightClient.ErrWrongRound, err)
+}
+
+func TestMetachainVerifier_VerifyHeaderWrongPrevHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgMetachainVerifier()
+	mv, _ := lightClient.NewMetachainVerifier(args)
+	header := createNextHeader(args.TrustedHeader)
+	header.PrevHash = []byte("other hash")
+
+	_, err := mv.VerifyHeader(header)
+
+	assert.Equal(t, lightClient.ErrWrongPrevHash, err)
+}
+
+func TestMetachainVerifier_VerifyHeaderWrongPrevRandSeedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgMetachainVerifier()
+	mv, _ := lightClient.NewMetachainVerifier(args)
+	header := createNextHeader(args.TrustedHeader)
+	header.PrevRandSeed = []byte("other rand seed")
+
+	_, err := mv.VerifyHeader(header)
+
+	assert.Equal(t, lightClient.ErrWrongPrevRandSeed, err)
+}
+
+func TestMetachainVerifier_VerifyHeaderLeaderNotSignedShouldErrAndNotAdvance(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgMetachainVerifier()
+	mv, _ := lightClient.NewMetachainVerifier(args)
+	header := createNextHeader(args.TrustedHeader)
+	header.PubKeysBitmap = []byte{0}
+
+	_, err := mv.VerifyHeader(header)
+
+	assert.Equal(t, process.ErrBlockProposerSignatureMissing, err)
+	lastVerified, _ := mv.LastVerifiedHeader()
+	assert.Equal(t, args.TrustedHeader, lastVerified)
+}
+
+func TestMetachainVerifier_VerifyHeaderShouldAdvanceAndReportUnverifiedPeerChanges(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgMetachainVerifier()
+	mv, _ := lightClient.NewMetachainVerifier(args)
+	header := createNextHeader(args.TrustedHeader)
+	header.PeerInfo = []block.PeerData{
+		{PublicKey: []byte("new validator"), Action: block.PeerRegistrantion, Value: big.NewInt(10)},
+	}
+
+	verified, err := mv.VerifyHeader(header)
+
+	assert.Nil(t, err)
+	expectedHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, header)
+	assert.Equal(t, expectedHash, verified.Hash)
+	assert.Equal(t, header, verified.Header)
+	assert.Equal(t, header.PeerInfo, verified.UnverifiedPeerChanges)
+	lastVerified, lastVerifiedHash := mv.LastVerifiedHeader()
+	assert.Equal(t, header, lastVerified)
+	assert.Equal(t, expectedHash, lastVerifiedHash)
+}
+
+//------- VerifyChain
+
+func TestMetachainVerifier_VerifyChainShouldVerifyAllHeaders(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgMetachainVerifier()
+	mv, _ := lightClient.NewMetachainVerifier(args)
+	first := createNextHeader(args.TrustedHeader)
+	second := createNextHeader(first)
+	third := createNextHeader(second)
+
+	verified, err := mv.VerifyChain([]*block.MetaBlock{first, second, third})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(verified))
+	lastVerified, _ := mv.LastVerifiedHeader()
+	assert.Equal(t, third, lastVerified)
+}
+
+func TestMetachainVerifier_VerifyChainShouldStopAtFirstFailure(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgMetachainVerifier()
+	mv, _ := lightClient.NewMetachainVerifier(args)
+	first := createNextHeader(args.TrustedHeader)
+	second := createNextHeader(first)
+	second.PrevHash = []byte("forged link")
+	third := createNextHeader(second)
+
+	verified, err := mv.VerifyChain([]*block.MetaBlock{first, second, third})
+
+	assert.Equal(t, lightClient.ErrWrongPrevHash, err)
+	assert.Equal(t, 1, len(verified))
+	lastVerified, _ := mv.LastVerifiedHeader()
+	assert.Equal(t, first, lastVerified)
+}
+
+//------- VerifyMarshalizedHeader
+
+func TestMetachainVerifier_VerifyMarshalizedHeaderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgMetachainVerifier()
+	mv, _ := lightClient.NewMetachainVerifier(args)
+	header := createNextHeader(args.TrustedHeader)
+	buff, _ := args.Marshalizer.Marshal(header)
+
+	verified, err := mv.VerifyMarshalizedHeader(buff)
+
+	assert.Nil(t, err)
+	assert.Equal(t, header.Nonce, verified.Header.Nonce)
+}
+
+func TestMetachainVerifier_VerifyMarshalizedHeaderInvalidDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mv, _ := lightClient.NewMetachainVerifier(createMockArgMetachainVerifier())
+
+	verified, err := mv.VerifyMarshalizedHeader([]byte("not a header"))
+
+	assert.Nil(t, verified)
+	assert.NotNil(t, err)
+}
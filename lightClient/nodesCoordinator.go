@@ -0,0 +1,104 @@
+package lightClient
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// lightClientPublicKey is the public key given to the nodes coordinator, as a light client is not a validator
+var lightClientPublicKey = []byte("light client")
+
+// ValidatorInfo holds the public key and the address of a validator
+type ValidatorInfo struct {
+	PubKey  []byte `json:"pubKey"`
+	Address []byte `json:"address"`
+}
+
+// ValidatorSet holds what is needed to compute the consensus groups: the consensus group sizes, the number of shards
+// and the eligible validators of each shard, in the order they are given to the nodes coordinator
+type ValidatorSet struct {
+	ShardConsensusGroupSize uint32                     `json:"shardConsensusGroupSize"`
+	MetaConsensusGroupSize  uint32                     `json:"metaConsensusGroupSize"`
+	NumShards               uint32                     `json:"numShards"`
+	Validators              map[uint32][]ValidatorInfo `json:"validators"`
+}
+
+// NewValidatorSetFromNodesSetup creates the validator set described by the nodes setup
+func NewValidatorSetFromNodesSetup(nodesSetup *sharding.NodesSetup) (*ValidatorSet, error) {
+	if nodesSetup == nil {
+		return nil, ErrNilNodesSetup
+	}
+
+	validators := make(map[uint32][]ValidatorInfo)
+	for shardId, nodesInfo := range nodesSetup.InitialNodesInfo() {
+		shardValidators := make([]ValidatorInfo, 0, len(nodesInfo))
+		for _, nodeInfo := range nodesInfo {
+			shardValidators = append(shardValidators, ValidatorInfo{
+				PubKey:  nodeInfo.PubKey(),
+				Address: nodeInfo.Address(),
+			})
+		}
+		validators[shardId] = shardValidators
+	}
+
+	return &ValidatorSet{
+		ShardConsensusGroupSize: nodesSetup.ConsensusGroupSize,
+		MetaConsensusGroupSize:  nodesSetup.MetaChainConsensusGroupSize,
+		NumShards:               nodesSetup.NumberOfShards(),
+		Validators:              validators,
+	}, nil
+}
+
+// NewNodesCoordinatorFromNodesSetup creates the nodes coordinator computing the consensus groups of the validator
+// set described by the nodes setup, the same way the nodes started with that setup do
+func NewNodesCoordinatorFromNodesSetup(
+	nodesSetup *sharding.NodesSetup,
+	hasher hashing.Hasher,
+) (sharding.NodesCoordinator, error) {
+	validatorSet, err := NewValidatorSetFromNodesSetup(nodesSetup)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewNodesCoordinatorFromValidatorSet(validatorSet, hasher)
+}
+
+// NewNodesCoordinatorFromValidatorSet creates the nodes coordinator computing the consensus groups of the provided
+// validator set
+func NewNodesCoordinatorFromValidatorSet(
+	validatorSet *ValidatorSet,
+	hasher hashing.Hasher,
+) (sharding.NodesCoordinator, error) {
+	if validatorSet == nil {
+		return nil, ErrNilValidatorSet
+	}
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, ErrNilHasher
+	}
+
+	validators := make(map[uint32][]sharding.Validator)
+	for shardId, validatorsInfo := range validatorSet.Validators {
+		shardValidators := make([]sharding.Validator, 0, len(validatorsInfo))
+		for _, validatorInfo := range validatorsInfo {
+			validator, err := sharding.NewValidator(big.NewInt(0), 0, validatorInfo.PubKey, validatorInfo.Address)
+			if err != nil {
+				return nil, err
+			}
+
+			shardValidators = append(shardValidators, validator)
+		}
+		validators[shardId] = shardValidators
+	}
+
+	return sharding.NewIndexHashedNodesCoordinator(sharding.ArgNodesCoordinator{
+		ShardConsensusGroupSize: int(validatorSet.ShardConsensusGroupSize),
+		MetaConsensusGroupSize:  int(validatorSet.MetaConsensusGroupSize),
+		Hasher:                  hasher,
+		ShardId:                 sharding.MetachainShardId,
+		NbShards:                validatorSet.NumShards,
+		Nodes:                   validators,
+		SelfPublicKey:           lightClientPublicKey,
+	})
+}
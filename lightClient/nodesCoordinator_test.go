@@ -0,0 +1,46 @@
+package lightClient_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/lightClient"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNodesCoordinatorFromNodesSetup_NilNodesSetupShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nodesCoordinator, err := lightClient.NewNodesCoordinatorFromNodesSetup(nil, sha256.Sha256{})
+
+	assert.Nil(t, nodesCoordinator)
+	assert.Equal(t, lightClient.ErrNilNodesSetup, err)
+}
+
+func TestNewNodesCoordinatorFromNodesSetup_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nodesSetup, _ := sharding.NewNodesSetup("mock/nodesSetupMock.json", 0xFFFFFFFFFFFFFFFF)
+	nodesCoordinator, err := lightClient.NewNodesCoordinatorFromNodesSetup(nodesSetup, nil)
+
+	assert.Nil(t, nodesCoordinator)
+	assert.Equal(t, lightClient.ErrNilHasher, err)
+}
+
+func TestNewNodesCoordinatorFromNodesSetup_ShouldSelectFromMetachainValidators(t *testing.T) {
+	t.Parallel()
+
+	nodesSetup, _ := sharding.NewNodesSetup("mock/nodesSetupMock.json", 0xFFFFFFFFFFFFFFFF)
+	nodesCoordinator, err := lightClient.NewNodesCoordinatorFromNodesSetup(nodesSetup, sha256.Sha256{})
+	assert.Nil(t, err)
+
+	pubKeys, err := nodesCoordinator.GetValidatorsPublicKeys([]byte("randomness"), 1, sharding.MetachainShardId)
+	assert.Nil(t, err)
+	assert.Equal(t, int(nodesSetup.MetaChainConsensusGroupSize), len(pubKeys))
+
+	metachainPubKeys, _ := nodesSetup.InitialNodesPubKeysForShard(sharding.MetachainShardId)
+	for _, pubKey := range pubKeys {
+		assert.Contains(t, metachainPubKeys, pubKey)
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -379,6 +381,27 @@ func WithBootstrapRoundIndex(bootstrapRoundIndex uint64) Option {
 	}
 }
 
+// WithStateRecovery sets up the automatic recovery from a diverged accounts state and the folder where the state
+// forensic records are written
+func WithStateRecovery(stateRecoveryConfig config.StateRecoveryConfig, stateForensicsFolder string) Option {
+	return func(n *Node) error {
+		n.stateRecoveryConfig = stateRecoveryConfig
+		n.stateForensicsFolder = stateForensicsFolder
+		return nil
+	}
+}
+
+// WithParticipationGate sets up the gate deciding if the node is fit to take part in the consensus of a round
+func WithParticipationGate(participationGate spos.ParticipationGate) Option {
+	return func(n *Node) error {
+		if participationGate == nil || participationGate.IsInterfaceNil() {
+			return ErrNilParticipationGate
+		}
+		n.participationGate = participationGate
+		return nil
+	}
+}
+
 // WithAppStatusHandler sets up which handler will monitor the status of the node
 func WithAppStatusHandler(aph core.AppStatusHandler) Option {
 	return func(n *Node) error {
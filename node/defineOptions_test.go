@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
@@ -802,3 +804,45 @@ func TestWithIndexer_ShouldWork(t *testing.T) {
 	assert.Equal(t, indexer, node.indexer)
 	assert.Nil(t, err)
 }
+
+func TestWithParticipationGate_NilParticipationGateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithParticipationGate(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilParticipationGate, err)
+}
+
+func TestWithParticipationGate_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	participationGate := spos.NewDisabledParticipationGate()
+	opt := WithParticipationGate(participationGate)
+	err := opt(node)
+
+	assert.True(t, node.participationGate == participationGate)
+	assert.Nil(t, err)
+}
+
+func TestWithStateRecovery_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	stateRecoveryConfig := config.StateRecoveryConfig{
+		Enabled:             true,
+		MismatchesThreshold: 3,
+		RollbackDepth:       10,
+	}
+	opt := WithStateRecovery(stateRecoveryConfig, "state-forensics")
+	err := opt(node)
+
+	assert.Equal(t, stateRecoveryConfig, node.stateRecoveryConfig)
+	assert.Equal(t, "state-forensics", node.stateForensicsFolder)
+	assert.Nil(t, err)
+}
@@ -121,5 +121,8 @@ var ErrSystemBusyGeneratingTransactions = errors.New("system busy while generati
 // ErrNilStatusHandler is returned when the status handler is nil
 var ErrNilStatusHandler = errors.New("nil AppStatusHandler")
 
+// ErrNilParticipationGate signals that a nil consensus participation gate has been provided
+var ErrNilParticipationGate = errors.New("nil participation gate")
+
 // ErrNoTxToProcess signals that no transaction were sent for processing
 var ErrNoTxToProcess = errors.New("no transaction to process")
@@ -0,0 +1,155 @@
+package external
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// AccountEntry is one account found in the accounts trie. The address and the code hash are hex encoded and the
+// balance is written in base 10, so that it does not lose precision when read by JSON clients
+type AccountEntry struct {
+	Address  string `json:"address"`
+	Nonce    uint64 `json:"nonce"`
+	Balance  string `json:"balance"`
+	CodeHash string `json:"codeHash,omitempty"`
+}
+
+// AccountsIterator walks the accounts trie, as it was at a given root hash, in the order of the accounts addresses.
+// The walks can be resumed from any address, so that a client can read the whole state in chunks and continue an
+// interrupted read without starting over
+type AccountsIterator struct {
+	accountsTrie data.Trie
+	marshalizer  marshal.Marshalizer
+	blockChain   data.ChainHandler
+}
+
+// NewAccountsIterator creates a new AccountsIterator instance
+func NewAccountsIterator(
+	accountsTrie data.Trie,
+	marshalizer marshal.Marshalizer,
+	blockChain data.ChainHandler,
+) (*AccountsIterator, error) {
+	if accountsTrie == nil || accountsTrie.IsInterfaceNil() {
+		return nil, ErrNilAccountsTrie
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if blockChain == nil || blockChain.IsInterfaceNil() {
+		return nil, ErrNilBlockChain
+	}
+
+	return &AccountsIterator{
+		accountsTrie: accountsTrie,
+		marshalizer:  marshalizer,
+		blockChain:   blockChain,
+	}, nil
+}
+
+// IterateAccounts calls the handler, in address order, for the accounts placed after the startAfter address in the
+// trie having the provided root hash. An empty root hash selects the state of the current block and an empty
+// startAfter address starts with the first account. At most maxAccounts accounts are provided, 0 meaning no limit.
+// The returned address is the one from which a following call should resume or nil if all the accounts were provided
+func (ai *AccountsIterator) IterateAccounts(
+	rootHash []byte,
+	startAfter []byte,
+	maxAccounts int,
+	handler func(account *AccountEntry) error,
+) ([]byte, error) {
+	if handler == nil {
+		return nil, ErrNilAccountHandler
+	}
+	if maxAccounts < 0 {
+		return nil, ErrInvalidMaxAccounts
+	}
+
+	if len(rootHash) == 0 {
+		rootHash = ai.currentRootHash()
+		if len(rootHash) == 0 {
+			return nil, ErrNoRootHash
+		}
+	}
+
+	tr, err := ai.accountsTrie.Recreate(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	numAccounts := 0
+	var lastAddress []byte
+	var resumeAddress []byte
+	var handlerErr error
+	err = tr.IterateLeaves(startAfter, func(key []byte, value []byte) bool {
+		entry, ok := ai.decodeAccount(key, value)
+		if !ok {
+			return true
+		}
+
+		if maxAccounts > 0 && numAccounts == maxAccounts {
+			resumeAddress = lastAddress
+			return false
+		}
+
+		handlerErr = handler(entry)
+		if handlerErr != nil {
+			return false
+		}
+
+		numAccounts++
+		lastAddress = key
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if handlerErr != nil {
+		return nil, handlerErr
+	}
+
+	return resumeAddress, nil
+}
+
+func (ai *AccountsIterator) currentRootHash() []byte {
+	header := ai.blockChain.GetCurrentBlockHeader()
+	if header == nil || header.IsInterfaceNil() {
+		header = ai.blockChain.GetGenesisHeader()
+	}
+	if header == nil || header.IsInterfaceNil() {
+		return nil
+	}
+
+	return header.GetRootHash()
+}
+
+// decodeAccount returns false for the leaves that do not hold accounts, as the smart contracts code is kept in the
+// same trie, under the code hash
+func (ai *AccountsIterator) decodeAccount(key []byte, value []byte) (*AccountEntry, bool) {
+	account := &state.Account{}
+	err := ai.marshalizer.Unmarshal(account, value)
+	if err != nil || account.Balance == nil {
+		return nil, false
+	}
+
+	entry := &AccountEntry{
+		Address: hex.EncodeToString(key),
+		Nonce:   account.Nonce,
+		Balance: account.Balance.String(),
+	}
+	if len(account.CodeHash) > 0 {
+		entry.CodeHash = hex.EncodeToString(account.CodeHash)
+	}
+
+	return entry, true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ai *AccountsIterator) IsInterfaceNil() bool {
+	if ai == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,243 @@
+package external_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type trieLeaf struct {
+	key   []byte
+	value []byte
+}
+
+func createAccountLeaf(address string, nonce uint64, balance int64, codeHash []byte) trieLeaf {
+	marshalizer := &mock.MarshalizerFake{}
+	value, _ := marshalizer.Marshal(&state.Account{Nonce: nonce, Balance: big.NewInt(balance), CodeHash: codeHash})
+
+	return trieLeaf{key: []byte(address), value: value}
+}
+
+func createTrieWithLeaves(expectedRootHash []byte, leaves []trieLeaf) *mock.TrieStub {
+	recreatedTrie := &mock.TrieStub{
+		IterateLeavesCalled: func(startAfterKey []byte, handler func(key []byte, value []byte) bool) error {
+			for _, leaf := range leaves {
+				if bytes.Compare(leaf.key, startAfterKey) <= 0 {
+					continue
+				}
+				if !handler(leaf.key, leaf.value) {
+					return nil
+				}
+			}
+			return nil
+		},
+	}
+
+	return &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			if !bytes.Equal(root, expectedRootHash) {
+				return nil, errors.New("unknown root hash")
+			}
+			return recreatedTrie, nil
+		},
+	}
+}
+
+func createBlockChainWithRootHash(rootHash []byte) *mock.BlockChainMock {
+	return &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{RootHash: rootHash}
+		},
+	}
+}
+
+func createTestAccountsLeaves() []trieLeaf {
+	return []trieLeaf{
+		createAccountLeaf("addr1", 1, 100, nil),
+		createAccountLeaf("addr2", 2, 200, []byte("code hash")),
+		{key: []byte("code hash"), value: []byte("code")},
+		createAccountLeaf("user3", 3, 300, nil),
+		createAccountLeaf("user4", 4, 400, nil),
+	}
+}
+
+func collectAccounts(accounts *[]*external.AccountEntry) func(account *external.AccountEntry) error {
+	return func(account *external.AccountEntry) error {
+		*accounts = append(*accounts, account)
+		return nil
+	}
+}
+
+func TestNewAccountsIterator_NilAccountsTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ai, err := external.NewAccountsIterator(nil, &mock.MarshalizerFake{}, &mock.BlockChainMock{})
+
+	assert.Nil(t, ai)
+	assert.Equal(t, external.ErrNilAccountsTrie, err)
+}
+
+func TestNewAccountsIterator_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ai, err := external.NewAccountsIterator(&mock.TrieStub{}, nil, &mock.BlockChainMock{})
+
+	assert.Nil(t, ai)
+	assert.Equal(t, external.ErrNilMarshalizer, err)
+}
+
+func TestNewAccountsIterator_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ai, err := external.NewAccountsIterator(&mock.TrieStub{}, &mock.MarshalizerFake{}, nil)
+
+	assert.Nil(t, ai)
+	assert.Equal(t, external.ErrNilBlockChain, err)
+}
+
+func TestNewAccountsIterator_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ai, err := external.NewAccountsIterator(&mock.TrieStub{}, &mock.MarshalizerFake{}, &mock.BlockChainMock{})
+
+	assert.NotNil(t, ai)
+	assert.Nil(t, err)
+}
+
+func TestAccountsIterator_IterateAccountsNilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ai, _ := external.NewAccountsIterator(&mock.TrieStub{}, &mock.MarshalizerFake{}, &mock.BlockChainMock{})
+
+	resume, err := ai.IterateAccounts([]byte("root"), nil, 0, nil)
+
+	assert.Nil(t, resume)
+	assert.Equal(t, external.ErrNilAccountHandler, err)
+}
+
+func TestAccountsIterator_IterateAccountsNegativeMaxAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ai, _ := external.NewAccountsIterator(&mock.TrieStub{}, &mock.MarshalizerFake{}, &mock.BlockChainMock{})
+
+	resume, err := ai.IterateAccounts([]byte("root"), nil, -1, collectAccounts(&[]*external.AccountEntry{}))
+
+	assert.Nil(t, resume)
+	assert.Equal(t, external.ErrInvalidMaxAccounts, err)
+}
+
+func TestAccountsIterator_IterateAccountsNoBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	blockChain := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return nil
+		},
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return nil
+		},
+	}
+	ai, _ := external.NewAccountsIterator(&mock.TrieStub{}, &mock.MarshalizerFake{}, blockChain)
+
+	resume, err := ai.IterateAccounts(nil, nil, 0, collectAccounts(&[]*external.AccountEntry{}))
+
+	assert.Nil(t, resume)
+	assert.Equal(t, external.ErrNoRootHash, err)
+}
+
+func TestAccountsIterator_IterateAccountsRecreateErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := createTrieWithLeaves([]byte("root"), createTestAccountsLeaves())
+	ai, _ := external.NewAccountsIterator(tr, &mock.MarshalizerFake{}, &mock.BlockChainMock{})
+
+	resume, err := ai.IterateAccounts([]byte("other root"), nil, 0, collectAccounts(&[]*external.AccountEntry{}))
+
+	assert.Nil(t, resume)
+	assert.NotNil(t, err)
+}
+
+func TestAccountsIterator_IterateAccountsShouldProvideAllAccountsAndSkipCode(t *testing.T) {
+	t.Parallel()
+
+	tr := createTrieWithLeaves([]byte("root"), createTestAccountsLeaves())
+	ai, _ := external.NewAccountsIterator(tr, &mock.MarshalizerFake{}, &mock.BlockChainMock{})
+
+	accounts := make([]*external.AccountEntry, 0)
+	resume, err := ai.IterateAccounts([]byte("root"), nil, 0, collectAccounts(&accounts))
+
+	assert.Nil(t, err)
+	assert.Nil(t, resume)
+	assert.Equal(t, 4, len(accounts))
+	assert.Equal(t, &external.AccountEntry{
+		Address:  hex.EncodeToString([]byte("addr2")),
+		Nonce:    2,
+		Balance:  "200",
+		CodeHash: hex.EncodeToString([]byte("code hash")),
+	}, accounts[1])
+	assert.Equal(t, hex.EncodeToString([]byte("user4")), accounts[3].Address)
+	assert.Equal(t, "", accounts[3].CodeHash)
+}
+
+func TestAccountsIterator_IterateAccountsEmptyRootHashShouldUseCurrentBlock(t *testing.T) {
+	t.Parallel()
+
+	tr := createTrieWithLeaves([]byte("current root"), createTestAccountsLeaves())
+	ai, _ := external.NewAccountsIterator(tr, &mock.MarshalizerFake{}, createBlockChainWithRootHash([]byte("current root")))
+
+	accounts := make([]*external.AccountEntry, 0)
+	_, err := ai.IterateAccounts(nil, nil, 0, collectAccounts(&accounts))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(accounts))
+}
+
+func TestAccountsIterator_IterateAccountsMaxAccountsReachedShouldReturnResumeAddress(t *testing.T) {
+	t.Parallel()
+
+	tr := createTrieWithLeaves([]byte("root"), createTestAccountsLeaves())
+	ai, _ := external.NewAccountsIterator(tr, &mock.MarshalizerFake{}, &mock.BlockChainMock{})
+
+	accounts := make([]*external.AccountEntry, 0)
+	resume, err := ai.IterateAccounts([]byte("root"), nil, 2, collectAccounts(&accounts))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("addr2"), resume)
+	assert.Equal(t, 2, len(accounts))
+
+	accounts = make([]*external.AccountEntry, 0)
+	resume, err = ai.IterateAccounts([]byte("root"), resume, 2, collectAccounts(&accounts))
+
+	assert.Nil(t, err)
+	assert.Nil(t, resume)
+	assert.Equal(t, 2, len(accounts))
+	assert.Equal(t, hex.EncodeToString([]byte("user3")), accounts[0].Address)
+	assert.Equal(t, hex.EncodeToString([]byte("user4")), accounts[1].Address)
+}
+
+func TestAccountsIterator_IterateAccountsHandlerErrorShouldStop(t *testing.T) {
+	t.Parallel()
+
+	tr := createTrieWithLeaves([]byte("root"), createTestAccountsLeaves())
+	ai, _ := external.NewAccountsIterator(tr, &mock.MarshalizerFake{}, &mock.BlockChainMock{})
+
+	expectedErr := errors.New("expected error")
+	numCalls := 0
+	resume, err := ai.IterateAccounts([]byte("root"), nil, 0, func(account *external.AccountEntry) error {
+		numCalls++
+		return expectedErr
+	})
+
+	assert.Nil(t, resume)
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 1, numCalls)
+}
@@ -0,0 +1,332 @@
+package external
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ExportTableBlocks is the name of the export table holding one row per block
+const ExportTableBlocks = "blocks"
+
+// ExportTableTransactions is the name of the export table holding one row per transaction included in a block
+const ExportTableTransactions = "transactions"
+
+// ExportTableSCRs is the name of the export table holding one row per smart contract result included in a block
+const ExportTableSCRs = "scrs"
+
+// exportColumns holds the schema of each export table. The columns may only be appended, never renamed, removed or
+// reordered, so that the analysis tools reading the exports keep working
+var exportColumns = map[string][]string{
+	ExportTableBlocks: {
+		"nonce", "round", "epoch", "hash", "prevHash", "rootHash", "timestamp", "txCount", "numMiniBlocks",
+		"accumulatedFees",
+	},
+	ExportTableTransactions: {
+		"blockNonce", "blockHash", "miniBlockHash", "senderShard", "receiverShard", "hash", "nonce", "sender",
+		"receiver", "value", "gasPrice", "gasLimit", "data", "signature",
+	},
+	ExportTableSCRs: {
+		"blockNonce", "blockHash", "miniBlockHash", "senderShard", "receiverShard", "hash", "nonce", "sender",
+		"receiver", "value", "data", "txHash",
+	},
+}
+
+// ExportColumns returns the columns of the provided export table, in the order the values appear in its rows
+func ExportColumns(table string) ([]string, error) {
+	columns, ok := exportColumns[table]
+	if !ok {
+		return nil, ErrUnknownExportTable
+	}
+
+	return append(make([]string, 0, len(columns)), columns...), nil
+}
+
+// ArgChainExporter holds the components needed by the chain exporter
+type ArgChainExporter struct {
+	BlockChain       data.ChainHandler
+	Store            dataRetriever.StorageService
+	ShardCoordinator sharding.Coordinator
+	Marshalizer      marshal.Marshalizer
+	Uint64Converter  typeConverters.Uint64ByteSliceConverter
+	Config           config.ChainExportConfig
+}
+
+// ChainExporter reads, directly from the node's storage, the blocks of the node's shard found in a nonce range and
+// hands them out as rows of a table. The storage reads are throttled and only one export may run at a time, so that
+// the exports do not starve the node's own processing
+type ChainExporter struct {
+	blockChain       data.ChainHandler
+	store            dataRetriever.StorageService
+	shardCoordinator sharding.Coordinator
+	marshalizer      marshal.Marshalizer
+	uint64Converter  typeConverters.Uint64ByteSliceConverter
+	maxNonces        uint64
+	readInterval     time.Duration
+	exporting        int32
+}
+
+// exportRun holds the state of one export
+type exportRun struct {
+	table     string
+	handler   func(row []string) error
+	startTime time.Time
+	numReads  int64
+}
+
+// NewChainExporter creates a new ChainExporter instance
+func NewChainExporter(args ArgChainExporter) (*ChainExporter, error) {
+	if args.BlockChain == nil || args.BlockChain.IsInterfaceNil() {
+		return nil, ErrNilBlockChain
+	}
+	if args.Store == nil || args.Store.IsInterfaceNil() {
+		return nil, ErrNilStore
+	}
+	if args.ShardCoordinator == nil || args.ShardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+	if args.Marshalizer == nil || args.Marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if args.Uint64Converter == nil || args.Uint64Converter.IsInterfaceNil() {
+		return nil, ErrNilUint64Converter
+	}
+
+	ce := &ChainExporter{
+		blockChain:       args.BlockChain,
+		store:            args.Store,
+		shardCoordinator: args.ShardCoordinator,
+		marshalizer:      args.Marshalizer,
+		uint64Converter:  args.Uint64Converter,
+		maxNonces:        args.Config.MaxNoncesPerExport,
+	}
+	if args.Config.MaxReadsPerSecond > 0 {
+		ce.readInterval = time.Second / time.Duration(args.Config.MaxReadsPerSecond)
+	}
+
+	return ce, nil
+}
+
+// Export calls the handler with the rows of the provided table for each block between fromNonce and toNonce, both
+// included. The range is capped to the nonce of the current block. The export stops at the first error, including
+// the ones returned by the handler
+func (ce *ChainExporter) Export(table string, fromNonce uint64, toNonce uint64, handler func(row []string) error) error {
+	if _, ok := exportColumns[table]; !ok {
+		return ErrUnknownExportTable
+	}
+	if handler == nil {
+		return ErrNilExportHandler
+	}
+	if fromNonce > toNonce {
+		return ErrInvalidNoncesRange
+	}
+	if ce.maxNonces > 0 && toNonce-fromNonce >= ce.maxNonces {
+		return ErrExportRangeTooLarge
+	}
+	if ce.shardCoordinator.SelfId() == sharding.MetachainShardId {
+		return ErrExportNotSupportedOnMetachain
+	}
+	if !atomic.CompareAndSwapInt32(&ce.exporting, 0, 1) {
+		return ErrExportInProgress
+	}
+	defer atomic.StoreInt32(&ce.exporting, 0)
+
+	currentHeader := ce.blockChain.GetCurrentBlockHeader()
+	if currentHeader == nil || currentHeader.IsInterfaceNil() {
+		return nil
+	}
+	if toNonce > currentHeader.GetNonce() {
+		toNonce = currentHeader.GetNonce()
+	}
+
+	run := &exportRun{
+		table:     table,
+		handler:   handler,
+		startTime: time.Now(),
+	}
+	for nonce := fromNonce; nonce <= toNonce; nonce++ {
+		if nonce == 0 {
+			// the genesis block is not stored by nonce
+			continue
+		}
+
+		err := ce.exportBlock(run, nonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ce *ChainExporter) exportBlock(run *exportRun, nonce uint64) error {
+	ce.throttle(run, 2)
+	header, headerHash, err := process.GetShardHeaderFromStorageWithNonce(
+		nonce,
+		ce.shardCoordinator.SelfId(),
+		ce.store,
+		ce.uint64Converter,
+		ce.marshalizer,
+	)
+	if err != nil {
+		return err
+	}
+
+	switch run.table {
+	case ExportTableBlocks:
+		return run.handler(blockRow(header, headerHash))
+	case ExportTableTransactions:
+		return ce.exportMiniBlocks(run, header, headerHash, block.TxBlock, dataRetriever.TransactionUnit)
+	default:
+		return ce.exportMiniBlocks(run, header, headerHash, block.SmartContractResultBlock, dataRetriever.UnsignedTransactionUnit)
+	}
+}
+
+func (ce *ChainExporter) exportMiniBlocks(
+	run *exportRun,
+	header *block.Header,
+	headerHash []byte,
+	miniBlockType block.Type,
+	unit dataRetriever.UnitType,
+) error {
+	for _, miniBlockHeader := range header.MiniBlockHeaders {
+		if miniBlockHeader.Type != miniBlockType {
+			continue
+		}
+
+		ce.throttle(run, 1)
+		miniBlockBytes, err := ce.store.Get(dataRetriever.MiniBlockUnit, miniBlockHeader.Hash)
+		if err != nil {
+			return err
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = ce.marshalizer.Unmarshal(miniBlock, miniBlockBytes)
+		if err != nil {
+			return err
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			ce.throttle(run, 1)
+			txBytes, err := ce.store.Get(unit, txHash)
+			if err != nil {
+				return err
+			}
+
+			txValues, err := ce.txValues(miniBlockType, txBytes)
+			if err != nil {
+				return err
+			}
+
+			row := []string{
+				strconv.FormatUint(header.Nonce, 10),
+				hex.EncodeToString(headerHash),
+				hex.EncodeToString(miniBlockHeader.Hash),
+				strconv.FormatUint(uint64(miniBlock.SenderShardID), 10),
+				strconv.FormatUint(uint64(miniBlock.ReceiverShardID), 10),
+				hex.EncodeToString(txHash),
+			}
+			err = run.handler(append(row, txValues...))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// txValues returns the values of the transaction or smart contract result columns that follow the hash column
+func (ce *ChainExporter) txValues(miniBlockType block.Type, txBytes []byte) ([]string, error) {
+	if miniBlockType == block.TxBlock {
+		tx := &transaction.Transaction{}
+		err := ce.marshalizer.Unmarshal(tx, txBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{
+			strconv.FormatUint(tx.Nonce, 10),
+			hex.EncodeToString(tx.SndAddr),
+			hex.EncodeToString(tx.RcvAddr),
+			bigIntToString(tx.Value),
+			strconv.FormatUint(tx.GasPrice, 10),
+			strconv.FormatUint(tx.GasLimit, 10),
+			tx.Data,
+			hex.EncodeToString(tx.Signature),
+		}, nil
+	}
+
+	scr := &smartContractResult.SmartContractResult{}
+	err := ce.marshalizer.Unmarshal(scr, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{
+		strconv.FormatUint(scr.Nonce, 10),
+		hex.EncodeToString(scr.SndAddr),
+		hex.EncodeToString(scr.RcvAddr),
+		bigIntToString(scr.Value),
+		scr.Data,
+		hex.EncodeToString(scr.TxHash),
+	}, nil
+}
+
+func blockRow(header *block.Header, headerHash []byte) []string {
+	return []string{
+		strconv.FormatUint(header.Nonce, 10),
+		strconv.FormatUint(header.Round, 10),
+		strconv.FormatUint(uint64(header.Epoch), 10),
+		hex.EncodeToString(headerHash),
+		hex.EncodeToString(header.PrevHash),
+		hex.EncodeToString(header.RootHash),
+		strconv.FormatUint(header.TimeStamp, 10),
+		strconv.FormatUint(uint64(header.TxCount), 10),
+		strconv.Itoa(len(header.MiniBlockHeaders)),
+		bigIntToString(header.AccumulatedFees),
+	}
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// throttle delays the next numReads storage reads so that the export does not exceed the configured reads rate
+func (ce *ChainExporter) throttle(run *exportRun, numReads int64) {
+	run.numReads += numReads
+	if ce.readInterval == 0 {
+		return
+	}
+
+	earliestReadTime := run.startTime.Add(time.Duration(run.numReads-numReads) * ce.readInterval)
+	waitTime := time.Until(earliestReadTime)
+	if waitTime > 0 {
+		time.Sleep(waitTime)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ce *ChainExporter) IsInterfaceNil() bool {
+	if ce == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,403 @@
+package external_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+type chainExportTestEnv struct {
+	store       dataRetriever.StorageService
+	blockChain  *mock.BlockChainMock
+	marshalizer *mock.MarshalizerFake
+	hasher      *mock.HasherFake
+	headers     map[uint64][]byte
+}
+
+func createChainExportTestEnv() *chainExportTestEnv {
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.BlockHeaderUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.MiniBlockUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.TransactionUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.UnsignedTransactionUnit, mock.NewStorerMock())
+
+	return &chainExportTestEnv{
+		store:       store,
+		blockChain:  &mock.BlockChainMock{},
+		marshalizer: &mock.MarshalizerFake{},
+		hasher:      &mock.HasherFake{},
+		headers:     make(map[uint64][]byte),
+	}
+}
+
+func (env *chainExportTestEnv) createArgs() external.ArgChainExporter {
+	return external.ArgChainExporter{
+		BlockChain:       env.blockChain,
+		Store:            env.store,
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		Marshalizer:      env.marshalizer,
+		Uint64Converter:  uint64ByteSlice.NewBigEndianConverter(),
+		Config:           config.ChainExportConfig{MaxNoncesPerExport: 100},
+	}
+}
+
+func (env *chainExportTestEnv) createExporter() *external.ChainExporter {
+	ce, _ := external.NewChainExporter(env.createArgs())
+
+	return ce
+}
+
+func (env *chainExportTestEnv) put(unit dataRetriever.UnitType, object interface{}) []byte {
+	buff, _ := env.marshalizer.Marshal(object)
+	hash := env.hasher.Compute(string(buff))
+	_ = env.store.Put(unit, hash, buff)
+
+	return hash
+}
+
+// commitBlock stores the header with the provided nonce holding a miniblock with the provided transactions and a
+// miniblock with the provided smart contract results. The header becomes the current block header
+func (env *chainExportTestEnv) commitBlock(
+	nonce uint64,
+	txs []*transaction.Transaction,
+	scrs []*smartContractResult.SmartContractResult,
+) {
+	header := &block.Header{
+		Nonce:           nonce,
+		Round:           nonce + 10,
+		Epoch:           1,
+		PrevHash:        env.headers[nonce-1],
+		RootHash:        []byte("root hash"),
+		TimeStamp:       nonce * 5,
+		TxCount:         uint32(len(txs) + len(scrs)),
+		AccumulatedFees: big.NewInt(int64(nonce)),
+	}
+
+	txMiniBlock := &block.MiniBlock{SenderShardID: 0, ReceiverShardID: 0, Type: block.TxBlock}
+	for _, tx := range txs {
+		txMiniBlock.TxHashes = append(txMiniBlock.TxHashes, env.put(dataRetriever.TransactionUnit, tx))
+	}
+	scrMiniBlock := &block.MiniBlock{SenderShardID: 1, ReceiverShardID: 0, Type: block.SmartContractResultBlock}
+	for _, scr := range scrs {
+		scrMiniBlock.TxHashes = append(scrMiniBlock.TxHashes, env.put(dataRetriever.UnsignedTransactionUnit, scr))
+	}
+	header.MiniBlockHeaders = []block.MiniBlockHeader{
+		{Hash: env.put(dataRetriever.MiniBlockUnit, txMiniBlock), Type: block.TxBlock},
+		{Hash: env.put(dataRetriever.MiniBlockUnit, scrMiniBlock), SenderShardID: 1, Type: block.SmartContractResultBlock},
+	}
+
+	hash := env.put(dataRetriever.BlockHeaderUnit, header)
+	env.headers[nonce] = hash
+	_ = env.store.Put(dataRetriever.ShardHdrNonceHashDataUnit, uint64ByteSlice.NewBigEndianConverter().ToByteSlice(nonce), hash)
+	env.blockChain.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return header
+	}
+}
+
+func createExportedTx(nonce uint64) *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:     nonce,
+		Value:     big.NewInt(1000),
+		SndAddr:   []byte("sender"),
+		RcvAddr:   []byte("receiver"),
+		GasPrice:  10,
+		GasLimit:  100,
+		Data:      "data",
+		Signature: []byte("signature"),
+	}
+}
+
+func exportRows(ce *external.ChainExporter, table string, fromNonce uint64, toNonce uint64) ([][]string, error) {
+	rows := make([][]string, 0)
+	err := ce.Export(table, fromNonce, toNonce, func(row []string) error {
+		rows = append(rows, row)
+		return nil
+	})
+
+	return rows, err
+}
+
+func TestExportColumns(t *testing.T) {
+	t.Parallel()
+
+	columns, err := external.ExportColumns("accounts")
+	assert.Nil(t, columns)
+	assert.Equal(t, external.ErrUnknownExportTable, err)
+
+	columns, err = external.ExportColumns(external.ExportTableTransactions)
+	assert.Nil(t, err)
+	assert.Equal(t, "blockNonce", columns[0])
+	assert.Equal(t, "signature", columns[len(columns)-1])
+
+	columns[0] = "altered"
+	columns, _ = external.ExportColumns(external.ExportTableTransactions)
+	assert.Equal(t, "blockNonce", columns[0])
+}
+
+func TestNewChainExporter_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	env := createChainExportTestEnv()
+
+	args := env.createArgs()
+	args.BlockChain = nil
+	ce, err := external.NewChainExporter(args)
+	assert.Nil(t, ce)
+	assert.Equal(t, external.ErrNilBlockChain, err)
+
+	args = env.createArgs()
+	args.Store = nil
+	ce, err = external.NewChainExporter(args)
+	assert.Nil(t, ce)
+	assert.Equal(t, external.ErrNilStore, err)
+
+	args = env.createArgs()
+	args.ShardCoordinator = nil
+	ce, err = external.NewChainExporter(args)
+	assert.Nil(t, ce)
+	assert.Equal(t, external.ErrNilShardCoordinator, err)
+
+	args = env.createArgs()
+	args.Marshalizer = nil
+	ce, err = external.NewChainExporter(args)
+	assert.Nil(t, ce)
+	assert.Equal(t, external.ErrNilMarshalizer, err)
+
+	args = env.createArgs()
+	args.Uint64Converter = nil
+	ce, err = external.NewChainExporter(args)
+	assert.Nil(t, ce)
+	assert.Equal(t, external.ErrNilUint64Converter, err)
+}
+
+func TestNewChainExporter_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ce := createChainExportTestEnv().createExporter()
+
+	assert.NotNil(t, ce)
+	assert.False(t, ce.IsInterfaceNil())
+}
+
+func TestChainExporter_ExportInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ce := createChainExportTestEnv().createExporter()
+	handler := func(row []string) error { return nil }
+
+	err := ce.Export("accounts", 1, 2, handler)
+	assert.Equal(t, external.ErrUnknownExportTable, err)
+
+	err = ce.Export(external.ExportTableBlocks, 1, 2, nil)
+	assert.Equal(t, external.ErrNilExportHandler, err)
+
+	err = ce.Export(external.ExportTableBlocks, 3, 2, handler)
+	assert.Equal(t, external.ErrInvalidNoncesRange, err)
+
+	err = ce.Export(external.ExportTableBlocks, 1, 101, handler)
+	assert.Equal(t, external.ErrExportRangeTooLarge, err)
+}
+
+func TestChainExporter_ExportOnMetachainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	env := createChainExportTestEnv()
+	shardCoordinator := mock.NewMultipleShardsCoordinatorMock()
+	shardCoordinator.CurrentShard = sharding.MetachainShardId
+	args := env.createArgs()
+	args.ShardCoordinator = shardCoordinator
+	ce, _ := external.NewChainExporter(args)
+
+	err := ce.Export(external.ExportTableBlocks, 1, 2, func(row []string) error { return nil })
+
+	assert.Equal(t, external.ErrExportNotSupportedOnMetachain, err)
+}
+
+func TestChainExporter_ExportBlocksShouldStopAtCurrentBlock(t *testing.T) {
+	t.Parallel()
+
+	env := createChainExportTestEnv()
+	env.commitBlock(1, nil, nil)
+	env.commitBlock(2, []*transaction.Transaction{createExportedTx(1)}, nil)
+	ce := env.createExporter()
+
+	rows, err := exportRows(ce, external.ExportTableBlocks, 0, 50)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(rows))
+	assert.Equal(t, []string{
+		"2",
+		"12",
+		"1",
+		hex.EncodeToString(env.headers[2]),
+		hex.EncodeToString(env.headers[1]),
+		hex.EncodeToString([]byte("root hash")),
+		"10",
+		"1",
+		"2",
+		"2",
+	}, rows[1])
+
+	columns, _ := external.ExportColumns(external.ExportTableBlocks)
+	for _, row := range rows {
+		assert.Equal(t, len(columns), len(row))
+	}
+}
+
+func TestChainExporter_ExportTransactionsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	env := createChainExportTestEnv()
+	tx := createExportedTx(7)
+	tx.Value = nil
+	env.commitBlock(1, []*transaction.Transaction{createExportedTx(1), createExportedTx(2)}, nil)
+	env.commitBlock(2, []*transaction.Transaction{tx}, nil)
+	ce := env.createExporter()
+
+	rows, err := exportRows(ce, external.ExportTableTransactions, 2, 2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rows))
+	txBuff, _ := env.marshalizer.Marshal(tx)
+	assert.Equal(t, []string{
+		"2",
+		hex.EncodeToString(env.headers[2]),
+		rows[0][2],
+		"0",
+		"0",
+		hex.EncodeToString(env.hasher.Compute(string(txBuff))),
+		"7",
+		hex.EncodeToString([]byte("sender")),
+		hex.EncodeToString([]byte("receiver")),
+		"0",
+		"10",
+		"100",
+		"data",
+		hex.EncodeToString([]byte("signature")),
+	}, rows[0])
+
+	rows, err = exportRows(ce, external.ExportTableTransactions, 1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(rows))
+}
+
+func TestChainExporter_ExportSCRsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	env := createChainExportTestEnv()
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:   3,
+		Value:   big.NewInt(50),
+		SndAddr: []byte("contract"),
+		RcvAddr: []byte("receiver"),
+		Data:    "@6f6b",
+		TxHash:  []byte("tx hash"),
+	}
+	env.commitBlock(1, []*transaction.Transaction{createExportedTx(1)}, []*smartContractResult.SmartContractResult{scr})
+	ce := env.createExporter()
+
+	rows, err := exportRows(ce, external.ExportTableSCRs, 1, 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rows))
+	columns, _ := external.ExportColumns(external.ExportTableSCRs)
+	assert.Equal(t, len(columns), len(rows[0]))
+	assert.Equal(t, "1", rows[0][3])
+	assert.Equal(t, "0", rows[0][4])
+	assert.Equal(t, []string{
+		"3",
+		hex.EncodeToString([]byte("contract")),
+		hex.EncodeToString([]byte("receiver")),
+		"50",
+		"@6f6b",
+		hex.EncodeToString([]byte("tx hash")),
+	}, rows[0][6:])
+}
+
+func TestChainExporter_ExportMissingBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	env := createChainExportTestEnv()
+	env.commitBlock(2, nil, nil)
+	ce := env.createExporter()
+
+	rows, err := exportRows(ce, external.ExportTableBlocks, 1, 2)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, len(rows))
+}
+
+func TestChainExporter_ExportHandlerErrorShouldStop(t *testing.T) {
+	t.Parallel()
+
+	env := createChainExportTestEnv()
+	env.commitBlock(1, nil, nil)
+	env.commitBlock(2, nil, nil)
+	ce := env.createExporter()
+	expectedErr := errors.New("expected error")
+
+	numCalls := 0
+	err := ce.Export(external.ExportTableBlocks, 1, 2, func(row []string) error {
+		numCalls++
+		return expectedErr
+	})
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestChainExporter_ExportShouldRunOneAtATime(t *testing.T) {
+	t.Parallel()
+
+	env := createChainExportTestEnv()
+	env.commitBlock(1, nil, nil)
+	ce := env.createExporter()
+
+	var innerErr error
+	err := ce.Export(external.ExportTableBlocks, 1, 1, func(row []string) error {
+		_, innerErr = exportRows(ce, external.ExportTableBlocks, 1, 1)
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, external.ErrExportInProgress, innerErr)
+
+	rows, err := exportRows(ce, external.ExportTableBlocks, 1, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rows))
+}
+
+func TestChainExporter_ExportShouldThrottleReads(t *testing.T) {
+	t.Parallel()
+
+	env := createChainExportTestEnv()
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		env.commitBlock(nonce, nil, nil)
+	}
+	args := env.createArgs()
+	args.Config.MaxReadsPerSecond = 50
+	ce, _ := external.NewChainExporter(args)
+
+	startTime := time.Now()
+	rows, err := exportRows(ce, external.ExportTableBlocks, 1, 3)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(rows))
+	// 6 reads at 50 reads per second: the last header is read at least 4 read intervals after the start
+	assert.True(t, time.Since(startTime) >= 4*20*time.Millisecond)
+}
@@ -0,0 +1,131 @@
+package external
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+const (
+	// GenesisFingerprintComponent is the fingerprint component holding the genesis header hash
+	GenesisFingerprintComponent = "genesis"
+	// ChainParamsFingerprintComponent is the fingerprint component holding the nodes setup: start time, round
+	// duration, consensus group sizes and initial nodes
+	ChainParamsFingerprintComponent = "chainParams"
+	// EconomicsFingerprintComponent is the fingerprint component holding the economics: fee and gas settings,
+	// rewards and economics addresses
+	EconomicsFingerprintComponent = "economics"
+	// ProtocolFingerprintComponent is the fingerprint component holding the consensus type, the hashers and their
+	// enable epochs, the marshalizer, the address format, the network ID, the epoch grace window of the headers and
+	// the time locked transactions enable epoch
+	ProtocolFingerprintComponent = "protocol"
+)
+
+// fingerprintComponents holds the fingerprint components in the order their hashes are concatenated
+var fingerprintComponents = []string{
+	GenesisFingerprintComponent,
+	ChainParamsFingerprintComponent,
+	EconomicsFingerprintComponent,
+	ProtocolFingerprintComponent,
+}
+
+// ArgConfigFingerprint holds the consensus-critical configuration a node fingerprints
+type ArgConfigFingerprint struct {
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+	GenesisHeaderHash []byte
+	NodesSetup        *sharding.NodesSetup
+	Economics         *config.ConfigEconomics
+	GeneralConfig     *config.Config
+}
+
+// ConfigFingerprint holds the hash of a node's consensus-critical configuration together with the hashes of its
+// components, so that a mismatch between two nodes can be narrowed down to a component
+type ConfigFingerprint struct {
+	Fingerprint string            `json:"fingerprint"`
+	Components  map[string]string `json:"components"`
+}
+
+// protocolConfig holds the settings of the general config that nodes must agree upon
+type protocolConfig struct {
+	Consensus        config.TypeConfig
+	Hasher           config.TypeConfig
+	MultisigHasher   config.TypeConfig
+	Marshalizer      config.TypeConfig
+	StructureHashers []config.StructureHasherConfig
+	Address          config.AddressConfig
+
+	NetworkID                string
+	EpochGraceWindow         uint32
+	TimeLockedTxsEnableEpoch uint32
+}
+
+// ComputeConfigFingerprint computes the deterministic fingerprint of the consensus-critical configuration. Nodes
+// running the same network with identical configurations produce the same fingerprint
+func ComputeConfigFingerprint(args ArgConfigFingerprint) (*ConfigFingerprint, error) {
+	if args.Marshalizer == nil || args.Marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if args.Hasher == nil || args.Hasher.IsInterfaceNil() {
+		return nil, ErrNilHasher
+	}
+	if len(args.GenesisHeaderHash) == 0 {
+		return nil, ErrNilGenesisHeaderHash
+	}
+	if args.NodesSetup == nil {
+		return nil, ErrNilNodesSetup
+	}
+	if args.Economics == nil {
+		return nil, ErrNilEconomicsConfig
+	}
+	if args.GeneralConfig == nil {
+		return nil, ErrNilGeneralConfig
+	}
+
+	hashes := make(map[string][]byte, len(fingerprintComponents))
+	hashes[GenesisFingerprintComponent] = args.GenesisHeaderHash
+
+	var err error
+	hashes[ChainParamsFingerprintComponent], err = core.CalculateHash(args.Marshalizer, args.Hasher, args.NodesSetup)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes[EconomicsFingerprintComponent], err = core.CalculateHash(args.Marshalizer, args.Hasher, args.Economics)
+	if err != nil {
+		return nil, err
+	}
+
+	protocol := &protocolConfig{
+		Consensus:        args.GeneralConfig.Consensus,
+		Hasher:           args.GeneralConfig.Hasher,
+		MultisigHasher:   args.GeneralConfig.MultisigHasher,
+		Marshalizer:      args.GeneralConfig.Marshalizer,
+		StructureHashers: args.GeneralConfig.StructureHashers,
+		Address:          args.GeneralConfig.Address,
+
+		NetworkID:                args.GeneralConfig.GeneralSettings.NetworkID,
+		EpochGraceWindow:         args.GeneralConfig.GeneralSettings.EpochGraceWindow,
+		TimeLockedTxsEnableEpoch: args.GeneralConfig.GeneralSettings.TimeLockedTxsEnableEpoch,
+	}
+	hashes[ProtocolFingerprintComponent], err = core.CalculateHash(args.Marshalizer, args.Hasher, protocol)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint := &ConfigFingerprint{
+		Components: make(map[string]string, len(fingerprintComponents)),
+	}
+	concatenatedHashes := make([]byte, 0)
+	for _, component := range fingerprintComponents {
+		concatenatedHashes = append(concatenatedHashes, hashes[component]...)
+		fingerprint.Components[component] = hex.EncodeToString(hashes[component])
+	}
+	fingerprint.Fingerprint = hex.EncodeToString(args.Hasher.Compute(string(concatenatedHashes)))
+
+	return fingerprint, nil
+}
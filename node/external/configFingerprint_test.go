@@ -0,0 +1,218 @@
+package external_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+func createArgConfigFingerprint() external.ArgConfigFingerprint {
+	return external.ArgConfigFingerprint{
+		Marshalizer:       &marshal.JsonMarshalizer{},
+		Hasher:            sha256.Sha256{},
+		GenesisHeaderHash: []byte("genesis header hash"),
+		NodesSetup: &sharding.NodesSetup{
+			StartTime:          1000,
+			RoundDuration:      4000,
+			ConsensusGroupSize: 21,
+			MinNodesPerShard:   21,
+			InitialNodes: []*sharding.InitialNode{
+				{PubKey: "pk1", Address: "addr1"},
+			},
+		},
+		Economics: &config.ConfigEconomics{
+			FeeSettings: config.FeeSettings{
+				MinGasPrice: "10",
+				MinGasLimit: "5",
+			},
+		},
+		GeneralConfig: &config.Config{
+			Consensus:   config.TypeConfig{Type: "bls"},
+			Hasher:      config.TypeConfig{Type: "blake2b"},
+			Marshalizer: config.TypeConfig{Type: "json"},
+			StructureHashers: []config.StructureHasherConfig{
+				{Structure: "trieNodes", Type: "blake2b", StartEpoch: 0},
+			},
+			TxDataPool: config.CacheConfig{Size: 100},
+		},
+	}
+}
+
+func TestComputeConfigFingerprint_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgConfigFingerprint()
+	args.Marshalizer = nil
+	fingerprint, err := external.ComputeConfigFingerprint(args)
+
+	assert.Nil(t, fingerprint)
+	assert.Equal(t, external.ErrNilMarshalizer, err)
+}
+
+func TestComputeConfigFingerprint_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgConfigFingerprint()
+	args.Hasher = nil
+	fingerprint, err := external.ComputeConfigFingerprint(args)
+
+	assert.Nil(t, fingerprint)
+	assert.Equal(t, external.ErrNilHasher, err)
+}
+
+func TestComputeConfigFingerprint_EmptyGenesisHeaderHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgConfigFingerprint()
+	args.GenesisHeaderHash = nil
+	fingerprint, err := external.ComputeConfigFingerprint(args)
+
+	assert.Nil(t, fingerprint)
+	assert.Equal(t, external.ErrNilGenesisHeaderHash, err)
+}
+
+func TestComputeConfigFingerprint_NilNodesSetupShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgConfigFingerprint()
+	args.NodesSetup = nil
+	fingerprint, err := external.ComputeConfigFingerprint(args)
+
+	assert.Nil(t, fingerprint)
+	assert.Equal(t, external.ErrNilNodesSetup, err)
+}
+
+func TestComputeConfigFingerprint_NilEconomicsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgConfigFingerprint()
+	args.Economics = nil
+	fingerprint, err := external.ComputeConfigFingerprint(args)
+
+	assert.Nil(t, fingerprint)
+	assert.Equal(t, external.ErrNilEconomicsConfig, err)
+}
+
+func TestComputeConfigFingerprint_NilGeneralConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgConfigFingerprint()
+	args.GeneralConfig = nil
+	fingerprint, err := external.ComputeConfigFingerprint(args)
+
+	assert.Nil(t, fingerprint)
+	assert.Equal(t, external.ErrNilGeneralConfig, err)
+}
+
+func TestComputeConfigFingerprint_SameConfigShouldGiveSameFingerprint(t *testing.T) {
+	t.Parallel()
+
+	fingerprint1, err := external.ComputeConfigFingerprint(createArgConfigFingerprint())
+	assert.Nil(t, err)
+	fingerprint2, _ := external.ComputeConfigFingerprint(createArgConfigFingerprint())
+
+	assert.Equal(t, fingerprint1, fingerprint2)
+	assert.Equal(t, 4, len(fingerprint1.Components))
+	assert.NotEmpty(t, fingerprint1.Fingerprint)
+}
+
+func TestComputeConfigFingerprint_NotConsensusCriticalChangeShouldNotChangeFingerprint(t *testing.T) {
+	t.Parallel()
+
+	fingerprint1, _ := external.ComputeConfigFingerprint(createArgConfigFingerprint())
+
+	args := createArgConfigFingerprint()
+	args.GeneralConfig.TxDataPool.Size = 200
+	args.GeneralConfig.GeneralSettings.NodeDisplayName = "display name"
+	fingerprint2, _ := external.ComputeConfigFingerprint(args)
+
+	assert.Equal(t, fingerprint1, fingerprint2)
+}
+
+func TestComputeConfigFingerprint_ChangeShouldOnlyChangeItsComponent(t *testing.T) {
+	t.Parallel()
+
+	fingerprint1, _ := external.ComputeConfigFingerprint(createArgConfigFingerprint())
+
+	args := createArgConfigFingerprint()
+	args.GeneralConfig.StructureHashers[0].StartEpoch = 5
+	fingerprint2, _ := external.ComputeConfigFingerprint(args)
+
+	assert.NotEqual(t, fingerprint1.Fingerprint, fingerprint2.Fingerprint)
+	for component, hash := range fingerprint1.Components {
+		if component == external.ProtocolFingerprintComponent {
+			assert.NotEqual(t, hash, fingerprint2.Components[component])
+			continue
+		}
+		assert.Equal(t, hash, fingerprint2.Components[component])
+	}
+}
+
+func TestComputeConfigFingerprint_TimeLockedTxsEnableEpochChangeShouldChangeProtocolComponent(t *testing.T) {
+	t.Parallel()
+
+	fingerprint1, _ := external.ComputeConfigFingerprint(createArgConfigFingerprint())
+
+	args := createArgConfigFingerprint()
+	args.GeneralConfig.GeneralSettings.TimeLockedTxsEnableEpoch = 10
+	fingerprint2, _ := external.ComputeConfigFingerprint(args)
+
+	assert.NotEqual(t, fingerprint1.Fingerprint, fingerprint2.Fingerprint)
+	assert.NotEqual(t,
+		fingerprint1.Components[external.ProtocolFingerprintComponent],
+		fingerprint2.Components[external.ProtocolFingerprintComponent],
+	)
+}
+
+func TestComputeConfigFingerprint_NetworkIDChangeShouldChangeProtocolComponent(t *testing.T) {
+	t.Parallel()
+
+	fingerprint1, _ := external.ComputeConfigFingerprint(createArgConfigFingerprint())
+
+	args := createArgConfigFingerprint()
+	args.GeneralConfig.GeneralSettings.NetworkID = "testnet"
+	fingerprint2, _ := external.ComputeConfigFingerprint(args)
+
+	assert.NotEqual(t, fingerprint1.Fingerprint, fingerprint2.Fingerprint)
+	assert.NotEqual(t,
+		fingerprint1.Components[external.ProtocolFingerprintComponent],
+		fingerprint2.Components[external.ProtocolFingerprintComponent],
+	)
+}
+
+func TestComputeConfigFingerprint_EpochGraceWindowChangeShouldChangeProtocolComponent(t *testing.T) {
+	t.Parallel()
+
+	fingerprint1, _ := external.ComputeConfigFingerprint(createArgConfigFingerprint())
+
+	args := createArgConfigFingerprint()
+	args.GeneralConfig.GeneralSettings.EpochGraceWindow = 2
+	fingerprint2, _ := external.ComputeConfigFingerprint(args)
+
+	assert.NotEqual(t, fingerprint1.Fingerprint, fingerprint2.Fingerprint)
+	assert.NotEqual(t,
+		fingerprint1.Components[external.ProtocolFingerprintComponent],
+		fingerprint2.Components[external.ProtocolFingerprintComponent],
+	)
+}
+
+func TestComputeConfigFingerprint_ChainParamsChangeShouldChangeFingerprint(t *testing.T) {
+	t.Parallel()
+
+	fingerprint1, _ := external.ComputeConfigFingerprint(createArgConfigFingerprint())
+
+	args := createArgConfigFingerprint()
+	args.NodesSetup.RoundDuration = 5000
+	fingerprint2, _ := external.ComputeConfigFingerprint(args)
+
+	assert.NotEqual(t, fingerprint1.Fingerprint, fingerprint2.Fingerprint)
+	assert.NotEqual(t,
+		fingerprint1.Components[external.ChainParamsFingerprintComponent],
+		fingerprint2.Components[external.ChainParamsFingerprintComponent],
+	)
+}
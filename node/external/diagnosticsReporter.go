@@ -0,0 +1,340 @@
+package external
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/display"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.DefaultLogger()
+
+// DiagnosticsReport is a snapshot, taken at once, of the messages received on each topic, of the pools occupancy, of
+// the interceptors throttlers saturation, of the broadcasts waiting to be sent and of the number of pubsub restarts
+type DiagnosticsReport struct {
+	Timestamp         int64                 `json:"timestamp"`
+	Topics            []TopicStatistics     `json:"topics"`
+	Pools             []PoolOccupancy       `json:"pools"`
+	Throttlers        []ThrottlerSaturation `json:"throttlers"`
+	PendingBroadcasts []PendingBroadcasts   `json:"pendingBroadcasts"`
+	NumPubSubRestarts uint64                `json:"numPubSubRestarts"`
+}
+
+// TopicStatistics holds the number of messages received on an interceptor or resolver topic and how many of them
+// were rejected
+type TopicStatistics struct {
+	Topic       string `json:"topic"`
+	NumReceived uint64 `json:"numReceived"`
+	NumRejected uint64 `json:"numRejected"`
+}
+
+// PoolOccupancy holds the number of entries of a pool. The cache ID is set only for the caches of the sharded pools,
+// while the entry of a whole sharded pool counts the cross shard duplicates once and reports how many there were
+type PoolOccupancy struct {
+	Pool                    string `json:"pool"`
+	CacheId                 string `json:"cacheId,omitempty"`
+	NumEntries              int    `json:"numEntries"`
+	NumCrossShardDuplicates uint64 `json:"numCrossShardDuplicates,omitempty"`
+}
+
+// ThrottlerSaturation holds the number of messages processed at the same time by an interceptor out of the maximum
+// allowed by its throttler
+type ThrottlerSaturation struct {
+	Topic            string `json:"topic"`
+	NumProcessing    int32  `json:"numProcessing"`
+	MaxNumProcessing int32  `json:"maxNumProcessing"`
+}
+
+// PendingBroadcasts holds the number of broadcasts waiting to be sent on an outgoing channel
+type PendingBroadcasts struct {
+	Channel    string `json:"channel"`
+	NumPending int    `json:"numPending"`
+}
+
+type namedCacher struct {
+	name   string
+	cacher storage.Cacher
+}
+
+type namedShardedPool struct {
+	name string
+	pool dataRetriever.ShardedDataCacherNotifier
+}
+
+// DiagnosticsReporter gathers, in one consistent report, the statistics needed when investigating a node's
+// behavior and writes that report to the log
+type DiagnosticsReporter struct {
+	messengerStats p2p.StatisticsHandler
+	interceptors   process.InterceptorsContainer
+	cachers        []namedCacher
+	shardedPools   []namedShardedPool
+	headersNonces  dataRetriever.Uint64SyncMapCacher
+	cacheIds       []string
+}
+
+// NewDiagnosticsReporter creates a new DiagnosticsReporter instance reporting on the pools of a shard node, if
+// dataPool is provided, or on the pools of a metachain node otherwise
+func NewDiagnosticsReporter(
+	messengerStats p2p.StatisticsHandler,
+	interceptors process.InterceptorsContainer,
+	dataPool dataRetriever.PoolsHolder,
+	metaDataPool dataRetriever.MetaPoolsHolder,
+	shardCoordinator sharding.Coordinator,
+) (*DiagnosticsReporter, error) {
+	if messengerStats == nil || messengerStats.IsInterfaceNil() {
+		return nil, ErrNilMessengerStatistics
+	}
+	if interceptors == nil || interceptors.IsInterfaceNil() {
+		return nil, ErrNilInterceptorsContainer
+	}
+	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+
+	dr := &DiagnosticsReporter{
+		messengerStats: messengerStats,
+		interceptors:   interceptors,
+	}
+
+	switch {
+	case dataPool != nil && !dataPool.IsInterfaceNil():
+		dr.cachers = []namedCacher{
+			{name: HeadersPoolName, cacher: dataPool.Headers()},
+			{name: MiniBlocksPoolName, cacher: dataPool.MiniBlocks()},
+			{name: "peerChangesBlocks", cacher: dataPool.PeerChangesBlocks()},
+			{name: "metaBlocks", cacher: dataPool.MetaBlocks()},
+		}
+		dr.shardedPools = []namedShardedPool{
+			{name: TransactionsPoolName, pool: dataPool.Transactions()},
+			{name: "unsignedTransactions", pool: dataPool.UnsignedTransactions()},
+			{name: "rewardTransactions", pool: dataPool.RewardTransactions()},
+		}
+		dr.headersNonces = dataPool.HeadersNonces()
+	case metaDataPool != nil && !metaDataPool.IsInterfaceNil():
+		dr.cachers = []namedCacher{
+			{name: HeadersPoolName, cacher: metaDataPool.ShardHeaders()},
+			{name: MiniBlocksPoolName, cacher: metaDataPool.MiniBlocks()},
+			{name: "metaBlocks", cacher: metaDataPool.MetaBlocks()},
+		}
+		dr.shardedPools = []namedShardedPool{
+			{name: TransactionsPoolName, pool: metaDataPool.Transactions()},
+			{name: "unsignedTransactions", pool: metaDataPool.UnsignedTransactions()},
+		}
+		dr.headersNonces = metaDataPool.HeadersNonces()
+	default:
+		return nil, ErrNilDataPool
+	}
+
+	shardIds := []uint32{sharding.MetachainShardId}
+	for shardId := uint32(0); shardId < shardCoordinator.NumberOfShards(); shardId++ {
+		shardIds = append(shardIds, shardId)
+	}
+	dr.cacheIds = make([]string, 0, len(shardIds)*len(shardIds))
+	for _, senderShardId := range shardIds {
+		for _, destShardId := range shardIds {
+			dr.cacheIds = append(dr.cacheIds, process.ShardCacherIdentifier(senderShardId, destShardId))
+		}
+	}
+	sort.Strings(dr.cacheIds)
+
+	return dr, nil
+}
+
+// CreateReport gathers the node's diagnostics statistics. The topics, pools, throttlers and outgoing channels are
+// sorted by name
+func (dr *DiagnosticsReporter) CreateReport() *DiagnosticsReport {
+	return &DiagnosticsReport{
+		Timestamp:         time.Now().Unix(),
+		Topics:            dr.topicsStatistics(),
+		Pools:             dr.poolsOccupancy(),
+		Throttlers:        dr.throttlersSaturation(),
+		PendingBroadcasts: dr.pendingBroadcasts(),
+		NumPubSubRestarts: dr.messengerStats.NumPubSubRestarts(),
+	}
+}
+
+// DumpReport creates a diagnostics report, writes it to the log and returns it
+func (dr *DiagnosticsReporter) DumpReport() *DiagnosticsReport {
+	report := dr.CreateReport()
+	log.Info(FormatDiagnosticsReport(report))
+
+	return report
+}
+
+func (dr *DiagnosticsReporter) topicsStatistics() []TopicStatistics {
+	topicsStats := make([]TopicStatistics, 0)
+	for topic, stats := range dr.messengerStats.TopicsStatistics() {
+		topicsStats = append(topicsStats, TopicStatistics{
+			Topic:       topic,
+			NumReceived: stats.NumReceived,
+			NumRejected: stats.NumRejected,
+		})
+	}
+
+	sort.Slice(topicsStats, func(i, j int) bool {
+		return topicsStats[i].Topic < topicsStats[j].Topic
+	})
+
+	return topicsStats
+}
+
+func (dr *DiagnosticsReporter) poolsOccupancy() []PoolOccupancy {
+	pools := make([]PoolOccupancy, 0)
+	for _, nc := range dr.cachers {
+		if nc.cacher == nil || nc.cacher.IsInterfaceNil() {
+			continue
+		}
+
+		pools = append(pools, PoolOccupancy{Pool: nc.name, NumEntries: nc.cacher.Len()})
+	}
+
+	if dr.headersNonces != nil && !dr.headersNonces.IsInterfaceNil() {
+		pools = append(pools, PoolOccupancy{Pool: "headersNonces", NumEntries: len(dr.headersNonces.Keys())})
+	}
+
+	for _, nsp := range dr.shardedPools {
+		if nsp.pool == nil || nsp.pool.IsInterfaceNil() {
+			continue
+		}
+
+		uniqueCounter, ok := nsp.pool.(dataRetriever.ShardedDataUniqueCounter)
+		if ok {
+			pools = append(pools, PoolOccupancy{
+				Pool:                    nsp.name,
+				NumEntries:              uniqueCounter.NumUniqueData(),
+				NumCrossShardDuplicates: uniqueCounter.NumCrossShardDuplicates(),
+			})
+		}
+
+		for _, cacheId := range dr.cacheIds {
+			cacher := nsp.pool.ShardDataStore(cacheId)
+			if cacher == nil || cacher.IsInterfaceNil() {
+				continue
+			}
+
+			pools = append(pools, PoolOccupancy{Pool: nsp.name, CacheId: cacheId, NumEntries: cacher.Len()})
+		}
+	}
+
+	sort.SliceStable(pools, func(i, j int) bool {
+		return pools[i].Pool < pools[j].Pool
+	})
+
+	return pools
+}
+
+func (dr *DiagnosticsReporter) throttlersSaturation() []ThrottlerSaturation {
+	throttlers := make([]ThrottlerSaturation, 0)
+	dr.interceptors.Iterate(func(topic string, interceptor process.Interceptor) bool {
+		throttledInterceptor, ok := interceptor.(process.ThrottledInterceptor)
+		if !ok {
+			return true
+		}
+
+		saturation, ok := throttledInterceptor.Throttler().(process.ThrottlerSaturationHandler)
+		if !ok {
+			return true
+		}
+
+		throttlers = append(throttlers, ThrottlerSaturation{
+			Topic:            topic,
+			NumProcessing:    saturation.NumProcessing(),
+			MaxNumProcessing: saturation.MaxNumProcessing(),
+		})
+
+		return true
+	})
+
+	sort.Slice(throttlers, func(i, j int) bool {
+		return throttlers[i].Topic < throttlers[j].Topic
+	})
+
+	return throttlers
+}
+
+func (dr *DiagnosticsReporter) pendingBroadcasts() []PendingBroadcasts {
+	pending := make([]PendingBroadcasts, 0)
+	for channel, numPending := range dr.messengerStats.PendingBroadcasts() {
+		pending = append(pending, PendingBroadcasts{Channel: channel, NumPending: numPending})
+	}
+
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].Channel < pending[j].Channel
+	})
+
+	return pending
+}
+
+// FormatDiagnosticsReport renders the report as a set of tables, ready to be attached to a support ticket
+func FormatDiagnosticsReport(report *DiagnosticsReport) string {
+	topicsLines := make([]*display.LineData, 0, len(report.Topics))
+	for _, ts := range report.Topics {
+		topicsLines = append(topicsLines, display.NewLineData(false, []string{
+			ts.Topic,
+			fmt.Sprintf("%d", ts.NumReceived),
+			fmt.Sprintf("%d", ts.NumRejected),
+		}))
+	}
+
+	poolsLines := make([]*display.LineData, 0, len(report.Pools))
+	for _, po := range report.Pools {
+		poolsLines = append(poolsLines, display.NewLineData(false, []string{
+			po.Pool,
+			po.CacheId,
+			fmt.Sprintf("%d", po.NumEntries),
+			fmt.Sprintf("%d", po.NumCrossShardDuplicates),
+		}))
+	}
+
+	throttlersLines := make([]*display.LineData, 0, len(report.Throttlers))
+	for _, ts := range report.Throttlers {
+		throttlersLines = append(throttlersLines, display.NewLineData(false, []string{
+			ts.Topic,
+			fmt.Sprintf("%d/%d", ts.NumProcessing, ts.MaxNumProcessing),
+		}))
+	}
+
+	pendingLines := make([]*display.LineData, 0, len(report.PendingBroadcasts))
+	for _, pb := range report.PendingBroadcasts {
+		pendingLines = append(pendingLines, display.NewLineData(false, []string{
+			pb.Channel,
+			fmt.Sprintf("%d", pb.NumPending),
+		}))
+	}
+
+	builder := &strings.Builder{}
+	builder.WriteString(fmt.Sprintf("diagnostics report at %s\n", time.Unix(report.Timestamp, 0).UTC().Format(time.RFC3339)))
+	writeTable(builder, []string{"Topic", "Received", "Rejected"}, topicsLines)
+	writeTable(builder, []string{"Pool", "Cache ID", "Entries", "Cross shard duplicates"}, poolsLines)
+	writeTable(builder, []string{"Throttled topic", "Processing"}, throttlersLines)
+	writeTable(builder, []string{"Outgoing channel", "Pending broadcasts"}, pendingLines)
+	builder.WriteString(fmt.Sprintf("pubsub restarts: %d\n", report.NumPubSubRestarts))
+
+	return builder.String()
+}
+
+func writeTable(builder *strings.Builder, header []string, lines []*display.LineData) {
+	tbl, err := display.CreateTableString(header, lines)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+
+	builder.WriteString(tbl)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dr *DiagnosticsReporter) IsInterfaceNil() bool {
+	if dr == nil {
+		return true
+	}
+	return false
+}
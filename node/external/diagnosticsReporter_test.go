@@ -0,0 +1,151 @@
+package external_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/throttler"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMessengerStatistics() *mock.MessengerStatisticsStub {
+	return &mock.MessengerStatisticsStub{
+		TopicsStatisticsCalled: func() map[string]p2p.TopicStatistics {
+			return map[string]p2p.TopicStatistics{
+				"transactions_0":        {NumReceived: 10, NumRejected: 2},
+				"shardBlocks_0_REQUEST": {NumReceived: 3},
+			}
+		},
+		PendingBroadcastsCalled: func() map[string]int {
+			return map[string]int{"transactions_0": 4}
+		},
+		NumPubSubRestartsCalled: func() uint64 {
+			return 2
+		},
+	}
+}
+
+func createFullPoolsHolder() *mock.PoolsHolderStub {
+	pools := createPoolsHolder()
+	pools.UnsignedTransactionsCalled = func() dataRetriever.ShardedDataCacherNotifier {
+		return nil
+	}
+	pools.RewardTransactionsCalled = func() dataRetriever.ShardedDataCacherNotifier {
+		return nil
+	}
+	pools.PeerChangesBlocksCalled = func() storage.Cacher {
+		return nil
+	}
+	pools.MetaBlocksCalled = func() storage.Cacher {
+		return nil
+	}
+
+	return pools
+}
+
+func TestNewDiagnosticsReporter_NilMessengerStatisticsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dr, err := external.NewDiagnosticsReporter(nil, &mock.InterceptorsContainerStub{}, createFullPoolsHolder(), nil, mock.NewOneShardCoordinatorMock())
+
+	assert.Nil(t, dr)
+	assert.Equal(t, external.ErrNilMessengerStatistics, err)
+}
+
+func TestNewDiagnosticsReporter_NilInterceptorsContainerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dr, err := external.NewDiagnosticsReporter(createMessengerStatistics(), nil, createFullPoolsHolder(), nil, mock.NewOneShardCoordinatorMock())
+
+	assert.Nil(t, dr)
+	assert.Equal(t, external.ErrNilInterceptorsContainer, err)
+}
+
+func TestNewDiagnosticsReporter_NilPoolsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dr, err := external.NewDiagnosticsReporter(createMessengerStatistics(), &mock.InterceptorsContainerStub{}, nil, nil, mock.NewOneShardCoordinatorMock())
+
+	assert.Nil(t, dr)
+	assert.Equal(t, external.ErrNilDataPool, err)
+}
+
+func TestNewDiagnosticsReporter_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dr, err := external.NewDiagnosticsReporter(createMessengerStatistics(), &mock.InterceptorsContainerStub{}, createFullPoolsHolder(), nil, nil)
+
+	assert.Nil(t, dr)
+	assert.Equal(t, external.ErrNilShardCoordinator, err)
+}
+
+func TestDiagnosticsReporter_DumpReportShouldGatherAllStatistics(t *testing.T) {
+	t.Parallel()
+
+	pools := createFullPoolsHolder()
+	pools.Transactions().AddData([]byte("tx1"), "tx1", process.ShardCacherIdentifier(0, 0))
+	pools.Transactions().AddData([]byte("tx2"), "tx2", process.ShardCacherIdentifier(0, 0))
+	pools.MiniBlocks().Put([]byte("mb"), "mb")
+
+	txThrottler, _ := throttler.NewNumGoRoutineThrottler(100)
+	txThrottler.StartProcessing()
+	interceptors := &mock.InterceptorsContainerStub{
+		IterateCalled: func(handler func(key string, interceptor process.Interceptor) bool) {
+			txInterceptor := &mock.ThrottledInterceptorStub{
+				ThrottlerCalled: func() process.InterceptorThrottler {
+					return txThrottler
+				},
+			}
+			if !handler("transactions_0", txInterceptor) {
+				return
+			}
+			_ = handler("shardBlocks_0", &mock.InterceptorStub{})
+		},
+	}
+
+	dr, _ := external.NewDiagnosticsReporter(createMessengerStatistics(), interceptors, pools, nil, mock.NewOneShardCoordinatorMock())
+	report := dr.DumpReport()
+
+	assert.Equal(t, []external.TopicStatistics{
+		{Topic: "shardBlocks_0_REQUEST", NumReceived: 3},
+		{Topic: "transactions_0", NumReceived: 10, NumRejected: 2},
+	}, report.Topics)
+	assert.Equal(t, []external.PoolOccupancy{
+		{Pool: external.HeadersPoolName, NumEntries: 0},
+		{Pool: "headersNonces", NumEntries: 0},
+		{Pool: external.MiniBlocksPoolName, NumEntries: 1},
+		{Pool: external.TransactionsPoolName, NumEntries: 2},
+		{Pool: external.TransactionsPoolName, CacheId: process.ShardCacherIdentifier(0, 0), NumEntries: 2},
+	}, report.Pools)
+	assert.Equal(t, []external.ThrottlerSaturation{
+		{Topic: "transactions_0", NumProcessing: 1, MaxNumProcessing: 100},
+	}, report.Throttlers)
+	assert.Equal(t, []external.PendingBroadcasts{
+		{Channel: "transactions_0", NumPending: 4},
+	}, report.PendingBroadcasts)
+	assert.Equal(t, uint64(2), report.NumPubSubRestarts)
+	assert.NotZero(t, report.Timestamp)
+}
+
+func TestFormatDiagnosticsReport_ShouldContainAllSections(t *testing.T) {
+	t.Parallel()
+
+	formatted := external.FormatDiagnosticsReport(&external.DiagnosticsReport{
+		Topics:            []external.TopicStatistics{{Topic: "transactions_0", NumReceived: 10}},
+		Pools:             []external.PoolOccupancy{{Pool: external.MiniBlocksPoolName, NumEntries: 1}},
+		Throttlers:        []external.ThrottlerSaturation{{Topic: "transactions_0", NumProcessing: 1, MaxNumProcessing: 100}},
+		PendingBroadcasts: []external.PendingBroadcasts{{Channel: "headers", NumPending: 4}},
+		NumPubSubRestarts: 3,
+	})
+
+	assert.Contains(t, formatted, "transactions_0")
+	assert.Contains(t, formatted, external.MiniBlocksPoolName)
+	assert.Contains(t, formatted, "1/100")
+	assert.Contains(t, formatted, "headers")
+	assert.Contains(t, formatted, "pubsub restarts: 3")
+}
@@ -22,3 +22,126 @@ var ErrNilScDataGetter = errors.New("nil SC data getter")
 
 // ErrNilStatusMetrics signals that a nil status metrics was provided
 var ErrNilStatusMetrics = errors.New("nil status metrics handler")
+
+// ErrNilStorageUnitsQuerier signals that a nil storage units querier was provided
+var ErrNilStorageUnitsQuerier = errors.New("nil storage units querier")
+
+// ErrUnknownStorageUnit signals that the requested storage unit does not exist on this node
+var ErrUnknownStorageUnit = errors.New("unknown storage unit")
+
+// ErrNilDataPool signals that no data pool was provided
+var ErrNilDataPool = errors.New("nil data pool")
+
+// ErrEmptyDumpFolder signals that an empty folder name was provided for the pool dumps
+var ErrEmptyDumpFolder = errors.New("empty pools dump folder")
+
+// ErrUnknownPool signals that the requested pool can not be dumped or loaded
+var ErrUnknownPool = errors.New("unknown pool")
+
+// ErrNilPoolsDumper signals that a nil pools dumper was provided
+var ErrNilPoolsDumper = errors.New("nil pools dumper")
+
+// ErrNilNodesCoordinator signals that a nil nodes coordinator was provided
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilUint64Converter signals that a nil uint64 converter was provided
+var ErrNilUint64Converter = errors.New("nil uint64 converter")
+
+// ErrEmptyPubKey signals that an empty public key was provided
+var ErrEmptyPubKey = errors.New("empty public key")
+
+// ErrNilParticipationProofsExporter signals that a nil participation proofs exporter was provided
+var ErrNilParticipationProofsExporter = errors.New("nil participation proofs exporter")
+
+// ErrNilMessageTracer signals that a nil message tracer was provided
+var ErrNilMessageTracer = errors.New("nil message tracer")
+
+// ErrNilRejectionTracker signals that a nil rejection tracker was provided
+var ErrNilRejectionTracker = errors.New("nil rejection tracker")
+
+// ErrNilMessengerStatistics signals that a nil messenger statistics handler was provided
+var ErrNilMessengerStatistics = errors.New("nil messenger statistics handler")
+
+// ErrNilInterceptorsContainer signals that a nil interceptors container was provided
+var ErrNilInterceptorsContainer = errors.New("nil interceptors container")
+
+// ErrNilDiagnosticsReporter signals that a nil diagnostics reporter was provided
+var ErrNilDiagnosticsReporter = errors.New("nil diagnostics reporter")
+
+// ErrNilGenesisHeaderHash signals that a nil genesis header hash was provided
+var ErrNilGenesisHeaderHash = errors.New("nil genesis header hash")
+
+// ErrNilNodesSetup signals that a nil nodes setup was provided
+var ErrNilNodesSetup = errors.New("nil nodes setup")
+
+// ErrNilEconomicsConfig signals that a nil economics config was provided
+var ErrNilEconomicsConfig = errors.New("nil economics config")
+
+// ErrNilGeneralConfig signals that a nil general config was provided
+var ErrNilGeneralConfig = errors.New("nil general config")
+
+// ErrNilForkDetector signals that a nil fork detector was provided
+var ErrNilForkDetector = errors.New("nil fork detector")
+
+// ErrNilPeersCounter signals that a nil peers counter was provided
+var ErrNilPeersCounter = errors.New("nil peers counter")
+
+// ErrNilReadinessChecker signals that a nil readiness checker was provided
+var ErrNilReadinessChecker = errors.New("nil readiness checker")
+
+// ErrNilAccountsTrie signals that a nil accounts trie was provided
+var ErrNilAccountsTrie = errors.New("nil accounts trie")
+
+// ErrNilAccountHandler signals that a nil account handler was provided
+var ErrNilAccountHandler = errors.New("nil account handler")
+
+// ErrInvalidMaxAccounts signals that a negative maximum number of accounts was provided
+var ErrInvalidMaxAccounts = errors.New("invalid maximum number of accounts")
+
+// ErrNoRootHash signals that no root hash was provided and the node has no block to take it from
+var ErrNoRootHash = errors.New("no root hash available")
+
+// ErrNilAccountsIterator signals that a nil accounts iterator was provided
+var ErrNilAccountsIterator = errors.New("nil accounts iterator")
+
+// ErrEmptyAddress signals that an empty address was provided
+var ErrEmptyAddress = errors.New("empty address")
+
+// ErrInvalidNoncesRange signals that the provided start nonce is greater than the end nonce
+var ErrInvalidNoncesRange = errors.New("invalid nonces range, the start nonce is greater than the end nonce")
+
+// ErrAddressFromOtherShard signals that the provided address belongs to a shard not served by this node
+var ErrAddressFromOtherShard = errors.New("address belongs to a shard not served by this node")
+
+// ErrNilGenesisHeader signals that the genesis header is not available
+var ErrNilGenesisHeader = errors.New("nil genesis header")
+
+// ErrNilSCStorageDiffer signals that a nil smart contract storage differ was provided
+var ErrNilSCStorageDiffer = errors.New("nil smart contract storage differ")
+
+// ErrInvalidEpochsRange signals that the provided start epoch is greater than the end epoch
+var ErrInvalidEpochsRange = errors.New("invalid epochs range, the start epoch is greater than the end epoch")
+
+// ErrNilValidatorEarningsReporter signals that a nil validator earnings reporter was provided
+var ErrNilValidatorEarningsReporter = errors.New("nil validator earnings reporter")
+
+// ErrUnknownExportTable signals that the requested export table does not exist
+var ErrUnknownExportTable = errors.New("unknown export table")
+
+// ErrNilExportHandler signals that a nil handler was provided for the exported rows
+var ErrNilExportHandler = errors.New("nil export rows handler")
+
+// ErrExportRangeTooLarge signals that the requested export covers more blocks than allowed
+var ErrExportRangeTooLarge = errors.New("export nonces range too large")
+
+// ErrExportNotSupportedOnMetachain signals that an export was requested from a metachain node
+var ErrExportNotSupportedOnMetachain = errors.New("chain export is not supported on metachain nodes")
+
+// ErrExportInProgress signals that an export was requested while another one is running
+var ErrExportInProgress = errors.New("another export is in progress")
+
+// ErrNilChainExporter signals that a nil chain exporter was provided
+var ErrNilChainExporter = errors.New("nil chain exporter")
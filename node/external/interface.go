@@ -1,5 +1,11 @@
 package external
 
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/rejection"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
+)
+
 // ScDataGetter defines how data should be get from a SC account
 type ScDataGetter interface {
 	Get(scAddress []byte, funcName string, args ...[]byte) ([]byte, error)
@@ -11,3 +17,78 @@ type StatusMetricsHandler interface {
 	StatusMetricsMap() (map[string]interface{}, error)
 	IsInterfaceNil() bool
 }
+
+// StorageUnitsHandler defines the read-only operations used to inspect the node's storage units
+type StorageUnitsHandler interface {
+	GetEntry(unitName string, key []byte) ([]byte, error)
+	UnitsStats() []StorageUnitStats
+	IsInterfaceNil() bool
+}
+
+// PoolsDumpHandler defines the operations used to dump the node's pools to files and to load such dumps
+type PoolsDumpHandler interface {
+	DumpPools(poolNames []string) ([]string, error)
+	LoadPoolsDump(fileName string) (int, error)
+	IsInterfaceNil() bool
+}
+
+// ParticipationProofsHandler defines the operation used to export the consensus participation proofs of a validator
+type ParticipationProofsHandler interface {
+	ExportParticipationProofs(epoch uint32, pubKey []byte) (*ParticipationProofs, error)
+	IsInterfaceNil() bool
+}
+
+// DiagnosticsHandler defines the operation used to write the node's diagnostics report to the log
+type DiagnosticsHandler interface {
+	DumpReport() *DiagnosticsReport
+	IsInterfaceNil() bool
+}
+
+// MessageTracingHandler defines the operations used to control the sampled tracing of the received messages and to
+// read the kept traces
+type MessageTracingHandler interface {
+	EnableTopic(topic string, sampleRate uint32) error
+	DisableTopic(topic string)
+	TracedTopics() map[string]uint32
+	Traces() []tracing.MessageTraceRecord
+	IsInterfaceNil() bool
+}
+
+// RejectionTrackingHandler defines the operations used to read the messages rejected by the interceptors during the
+// rolling window, counted per peer, topic and reason
+type RejectionTrackingHandler interface {
+	Rejections() []rejection.PeerRejections
+	PeerRejections(peer p2p.PeerID) rejection.PeerRejections
+	IsInterfaceNil() bool
+}
+
+// AccountsIteratingHandler defines the operation used to walk, in chunks, the accounts found at a given root hash
+type AccountsIteratingHandler interface {
+	IterateAccounts(rootHash []byte, startAfter []byte, maxAccounts int, handler func(account *AccountEntry) error) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// SCStorageDiffHandler defines the operation used to compare the storage of a smart contract between two blocks
+type SCStorageDiffHandler interface {
+	StorageDiff(address []byte, fromNonce uint64, toNonce uint64) (*SCStorageDiff, error)
+	IsInterfaceNil() bool
+}
+
+// ValidatorEarningsHandler defines the operation used to compute the rewards credited to a rewards address per epoch
+type ValidatorEarningsHandler interface {
+	Earnings(address []byte, fromEpoch uint32, toEpoch uint32) (*ValidatorEarnings, error)
+	IsInterfaceNil() bool
+}
+
+// ChainExportHandler defines the operation used to export, as rows of a table, the blocks found in a nonce range
+type ChainExportHandler interface {
+	Export(table string, fromNonce uint64, toNonce uint64, handler func(row []string) error) error
+	IsInterfaceNil() bool
+}
+
+// ReadinessHandler defines the operation used to decide if the node is ready to serve requests or take part in
+// consensus
+type ReadinessHandler interface {
+	CheckReadiness() *ReadinessReport
+	IsInterfaceNil() bool
+}
@@ -1,23 +1,98 @@
 package external
 
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/rejection"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
+)
+
 // NodeApiResolver can resolve API requests
 type NodeApiResolver struct {
 	scDataGetter         ScDataGetter
 	statusMetricsHandler StatusMetricsHandler
+	storageUnitsQuerier  StorageUnitsHandler
+	poolsDumper          PoolsDumpHandler
+	participationProofs  ParticipationProofsHandler
+	messageTracer        MessageTracingHandler
+	rejectionTracker     RejectionTrackingHandler
+	diagnostics          DiagnosticsHandler
+	readinessChecker     ReadinessHandler
+	accountsIterator     AccountsIteratingHandler
+	scStorageDiffer      SCStorageDiffHandler
+	validatorEarnings    ValidatorEarningsHandler
+	chainExporter        ChainExportHandler
 }
 
 // NewNodeApiResolver creates a new NodeApiResolver instance
-func NewNodeApiResolver(scDataGetter ScDataGetter, statusMetricsHandler StatusMetricsHandler) (*NodeApiResolver, error) {
+func NewNodeApiResolver(
+	scDataGetter ScDataGetter,
+	statusMetricsHandler StatusMetricsHandler,
+	storageUnitsQuerier StorageUnitsHandler,
+	poolsDumper PoolsDumpHandler,
+	participationProofs ParticipationProofsHandler,
+	messageTracer MessageTracingHandler,
+	rejectionTracker RejectionTrackingHandler,
+	diagnostics DiagnosticsHandler,
+	readinessChecker ReadinessHandler,
+	accountsIterator AccountsIteratingHandler,
+	scStorageDiffer SCStorageDiffHandler,
+	validatorEarnings ValidatorEarningsHandler,
+	chainExporter ChainExportHandler,
+) (*NodeApiResolver, error) {
 	if scDataGetter == nil || scDataGetter.IsInterfaceNil() {
 		return nil, ErrNilScDataGetter
 	}
 	if statusMetricsHandler == nil || statusMetricsHandler.IsInterfaceNil() {
 		return nil, ErrNilStatusMetrics
 	}
+	if storageUnitsQuerier == nil || storageUnitsQuerier.IsInterfaceNil() {
+		return nil, ErrNilStorageUnitsQuerier
+	}
+	if poolsDumper == nil || poolsDumper.IsInterfaceNil() {
+		return nil, ErrNilPoolsDumper
+	}
+	if participationProofs == nil || participationProofs.IsInterfaceNil() {
+		return nil, ErrNilParticipationProofsExporter
+	}
+	if messageTracer == nil || messageTracer.IsInterfaceNil() {
+		return nil, ErrNilMessageTracer
+	}
+	if rejectionTracker == nil || rejectionTracker.IsInterfaceNil() {
+		return nil, ErrNilRejectionTracker
+	}
+	if diagnostics == nil || diagnostics.IsInterfaceNil() {
+		return nil, ErrNilDiagnosticsReporter
+	}
+	if readinessChecker == nil || readinessChecker.IsInterfaceNil() {
+		return nil, ErrNilReadinessChecker
+	}
+	if accountsIterator == nil || accountsIterator.IsInterfaceNil() {
+		return nil, ErrNilAccountsIterator
+	}
+	if scStorageDiffer == nil || scStorageDiffer.IsInterfaceNil() {
+		return nil, ErrNilSCStorageDiffer
+	}
+	if validatorEarnings == nil || validatorEarnings.IsInterfaceNil() {
+		return nil, ErrNilValidatorEarningsReporter
+	}
+	if chainExporter == nil || chainExporter.IsInterfaceNil() {
+		return nil, ErrNilChainExporter
+	}
 
 	return &NodeApiResolver{
 		scDataGetter:         scDataGetter,
 		statusMetricsHandler: statusMetricsHandler,
+		storageUnitsQuerier:  storageUnitsQuerier,
+		poolsDumper:          poolsDumper,
+		participationProofs:  participationProofs,
+		messageTracer:        messageTracer,
+		rejectionTracker:     rejectionTracker,
+		diagnostics:          diagnostics,
+		readinessChecker:     readinessChecker,
+		accountsIterator:     accountsIterator,
+		scStorageDiffer:      scStorageDiffer,
+		validatorEarnings:    validatorEarnings,
+		chainExporter:        chainExporter,
 	}, nil
 }
 
@@ -31,6 +106,99 @@ func (nar *NodeApiResolver) StatusMetrics() StatusMetricsHandler {
 	return nar.statusMetricsHandler
 }
 
+// GetStorageUnitEntry returns the raw value stored under the provided key in the named storage unit
+func (nar *NodeApiResolver) GetStorageUnitEntry(unitName string, key []byte) ([]byte, error) {
+	return nar.storageUnitsQuerier.GetEntry(unitName, key)
+}
+
+// StorageUnitsStats returns the names and stats of the node's storage units
+func (nar *NodeApiResolver) StorageUnitsStats() []StorageUnitStats {
+	return nar.storageUnitsQuerier.UnitsStats()
+}
+
+// DumpPools writes the contents of the named pools to files and returns the paths of the created files
+func (nar *NodeApiResolver) DumpPools(poolNames []string) ([]string, error) {
+	return nar.poolsDumper.DumpPools(poolNames)
+}
+
+// LoadPoolsDump adds in the node's pools the entries found in the provided dump file
+func (nar *NodeApiResolver) LoadPoolsDump(fileName string) (int, error) {
+	return nar.poolsDumper.LoadPoolsDump(fileName)
+}
+
+// ExportParticipationProofs returns the proofs of the headers of the provided epoch signed by the given validator
+func (nar *NodeApiResolver) ExportParticipationProofs(epoch uint32, pubKey []byte) (*ParticipationProofs, error) {
+	return nar.participationProofs.ExportParticipationProofs(epoch, pubKey)
+}
+
+// EnableMessageTracing starts tracing 1 in sampleRate of the messages received on the provided topic
+func (nar *NodeApiResolver) EnableMessageTracing(topic string, sampleRate uint32) error {
+	return nar.messageTracer.EnableTopic(topic, sampleRate)
+}
+
+// DisableMessageTracing stops tracing the messages received on the provided topic
+func (nar *NodeApiResolver) DisableMessageTracing(topic string) {
+	nar.messageTracer.DisableTopic(topic)
+}
+
+// TracedTopics returns the traced topics together with their sample rates
+func (nar *NodeApiResolver) TracedTopics() map[string]uint32 {
+	return nar.messageTracer.TracedTopics()
+}
+
+// MessageTraces returns the kept traces of the sampled messages, oldest first
+func (nar *NodeApiResolver) MessageTraces() []tracing.MessageTraceRecord {
+	return nar.messageTracer.Traces()
+}
+
+// Rejections returns the messages rejected by the interceptors during the rolling window, for all the peers, the most
+// penalized peers first
+func (nar *NodeApiResolver) Rejections() []rejection.PeerRejections {
+	return nar.rejectionTracker.Rejections()
+}
+
+// PeerRejections returns the messages of the provided peer rejected by the interceptors during the rolling window
+func (nar *NodeApiResolver) PeerRejections(peer p2p.PeerID) rejection.PeerRejections {
+	return nar.rejectionTracker.PeerRejections(peer)
+}
+
+// DumpDiagnostics writes the node's diagnostics report to the log and returns it
+func (nar *NodeApiResolver) DumpDiagnostics() *DiagnosticsReport {
+	return nar.diagnostics.DumpReport()
+}
+
+// CheckReadiness runs the readiness probes and returns their outcome
+func (nar *NodeApiResolver) CheckReadiness() *ReadinessReport {
+	return nar.readinessChecker.CheckReadiness()
+}
+
+// IterateAccounts provides to the handler, in address order, at most maxAccounts accounts placed after the startAfter
+// address in the state having the provided root hash. It returns the address from which the walk should be resumed
+func (nar *NodeApiResolver) IterateAccounts(
+	rootHash []byte,
+	startAfter []byte,
+	maxAccounts int,
+	handler func(account *AccountEntry) error,
+) ([]byte, error) {
+	return nar.accountsIterator.IterateAccounts(rootHash, startAfter, maxAccounts, handler)
+}
+
+// SCStorageDiff returns the storage keys of the smart contract having the provided address whose values changed between
+// the blocks with the provided nonces
+func (nar *NodeApiResolver) SCStorageDiff(address []byte, fromNonce uint64, toNonce uint64) (*SCStorageDiff, error) {
+	return nar.scStorageDiffer.StorageDiff(address, fromNonce, toNonce)
+}
+
+// ValidatorEarnings returns the rewards credited to the provided rewards address in each epoch of the provided range
+func (nar *NodeApiResolver) ValidatorEarnings(address []byte, fromEpoch uint32, toEpoch uint32) (*ValidatorEarnings, error) {
+	return nar.validatorEarnings.Earnings(address, fromEpoch, toEpoch)
+}
+
+// ExportChain calls the handler with the rows of the provided table for each block of the provided nonces range
+func (nar *NodeApiResolver) ExportChain(table string, fromNonce uint64, toNonce uint64, handler func(row []string) error) error {
+	return nar.chainExporter.Export(table, fromNonce, toNonce, handler)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *NodeApiResolver) IsInterfaceNil() bool {
 	if nar == nil {
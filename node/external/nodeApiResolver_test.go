@@ -5,13 +5,16 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/rejection"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestNewNodeApiResolver_NilScDataGetterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{})
+	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{}, &mock.StorageUnitsHandlerStub{}, &mock.PoolsDumpHandlerStub{}, &mock.ParticipationProofsHandlerStub{}, &mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{}, &mock.AccountsIteratingHandlerStub{}, &mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilScDataGetter, err)
@@ -20,16 +23,115 @@ func TestNewNodeApiResolver_NilScDataGetterShouldErr(t *testing.T) {
 func TestNewNodeApiResolver_NilStatusMetricsShouldErr(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, nil)
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, nil, &mock.StorageUnitsHandlerStub{}, &mock.PoolsDumpHandlerStub{}, &mock.ParticipationProofsHandlerStub{}, &mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{}, &mock.AccountsIteratingHandlerStub{}, &mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilStatusMetrics, err)
 }
 
+func TestNewNodeApiResolver_NilStorageUnitsQuerierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StatusMetricsStub{}, nil, &mock.PoolsDumpHandlerStub{}, &mock.ParticipationProofsHandlerStub{}, &mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{}, &mock.AccountsIteratingHandlerStub{}, &mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilStorageUnitsQuerier, err)
+}
+
+func TestNewNodeApiResolver_NilPoolsDumperShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StatusMetricsStub{}, &mock.StorageUnitsHandlerStub{}, nil, &mock.ParticipationProofsHandlerStub{}, &mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{}, &mock.AccountsIteratingHandlerStub{}, &mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilPoolsDumper, err)
+}
+
+func TestNewNodeApiResolver_NilParticipationProofsExporterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StatusMetricsStub{}, &mock.StorageUnitsHandlerStub{}, &mock.PoolsDumpHandlerStub{}, nil, &mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{}, &mock.AccountsIteratingHandlerStub{}, &mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilParticipationProofsExporter, err)
+}
+
+func TestNewNodeApiResolver_NilMessageTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StatusMetricsStub{}, &mock.StorageUnitsHandlerStub{}, &mock.PoolsDumpHandlerStub{}, &mock.ParticipationProofsHandlerStub{}, nil, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{}, &mock.AccountsIteratingHandlerStub{}, &mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilMessageTracer, err)
+}
+
+func TestNewNodeApiResolver_NilRejectionTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StatusMetricsStub{}, &mock.StorageUnitsHandlerStub{}, &mock.PoolsDumpHandlerStub{}, &mock.ParticipationProofsHandlerStub{}, &mock.MessageTracingHandlerStub{}, nil, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{}, &mock.AccountsIteratingHandlerStub{}, &mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilRejectionTracker, err)
+}
+
+func TestNewNodeApiResolver_NilDiagnosticsReporterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StatusMetricsStub{}, &mock.StorageUnitsHandlerStub{}, &mock.PoolsDumpHandlerStub{}, &mock.ParticipationProofsHandlerStub{}, &mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, nil, &mock.ReadinessHandlerStub{}, &mock.AccountsIteratingHandlerStub{}, &mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilDiagnosticsReporter, err)
+}
+
+func TestNewNodeApiResolver_NilReadinessCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StatusMetricsStub{}, &mock.StorageUnitsHandlerStub{}, &mock.PoolsDumpHandlerStub{}, &mock.ParticipationProofsHandlerStub{}, &mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, nil, &mock.AccountsIteratingHandlerStub{}, &mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilReadinessChecker, err)
+}
+
+func TestNewNodeApiResolver_NilAccountsIteratorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StatusMetricsStub{}, &mock.StorageUnitsHandlerStub{}, &mock.PoolsDumpHandlerStub{}, &mock.ParticipationProofsHandlerStub{}, &mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{}, nil, &mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilAccountsIterator, err)
+}
+
+func TestNewNodeApiResolver_NilSCStorageDifferShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StatusMetricsStub{}, &mock.StorageUnitsHandlerStub{}, &mock.PoolsDumpHandlerStub{}, &mock.ParticipationProofsHandlerStub{}, &mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{}, &mock.AccountsIteratingHandlerStub{}, nil, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilSCStorageDiffer, err)
+}
+
+func TestNewNodeApiResolver_NilValidatorEarningsReporterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StatusMetricsStub{}, &mock.StorageUnitsHandlerStub{}, &mock.PoolsDumpHandlerStub{}, &mock.ParticipationProofsHandlerStub{}, &mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{}, &mock.AccountsIteratingHandlerStub{}, &mock.SCStorageDiffHandlerStub{}, nil, &mock.ChainExportHandlerStub{})
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilValidatorEarningsReporter, err)
+}
+
+func TestNewNodeApiResolver_NilChainExporterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StatusMetricsStub{}, &mock.StorageUnitsHandlerStub{}, &mock.PoolsDumpHandlerStub{}, &mock.ParticipationProofsHandlerStub{}, &mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{}, &mock.AccountsIteratingHandlerStub{}, &mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, nil)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilChainExporter, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StatusMetricsStub{})
+	nar, err := external.NewNodeApiResolver(&mock.ScDataGetterStub{}, &mock.StatusMetricsStub{}, &mock.StorageUnitsHandlerStub{}, &mock.PoolsDumpHandlerStub{}, &mock.ParticipationProofsHandlerStub{}, &mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{}, &mock.AccountsIteratingHandlerStub{}, &mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
 
 	assert.NotNil(t, nar)
 	assert.Nil(t, err)
@@ -45,7 +147,11 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 			return make([]byte, 0), nil
 		},
 	},
-		&mock.StatusMetricsStub{})
+		&mock.StatusMetricsStub{},
+		&mock.StorageUnitsHandlerStub{},
+		&mock.PoolsDumpHandlerStub{}, &mock.ParticipationProofsHandlerStub{}, &mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{},
+		&mock.AccountsIteratingHandlerStub{},
+		&mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
 
 	_, _ = nar.GetVmValue("", "")
 
@@ -63,8 +169,346 @@ func TestNodeApiResolver_StatusMetricsMapShouldBeCalled(t *testing.T) {
 				wasCalled = true
 				return nil, nil
 			},
-		})
+		},
+		&mock.StorageUnitsHandlerStub{},
+		&mock.PoolsDumpHandlerStub{}, &mock.ParticipationProofsHandlerStub{}, &mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{},
+		&mock.AccountsIteratingHandlerStub{},
+		&mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
 	_, _ = nar.StatusMetrics().StatusMetricsMap()
 
 	assert.True(t, wasCalled)
 }
+
+func TestNodeApiResolver_GetStorageUnitEntryShouldCall(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	nar, _ := external.NewNodeApiResolver(
+		&mock.ScDataGetterStub{},
+		&mock.StatusMetricsStub{},
+		&mock.StorageUnitsHandlerStub{
+			GetEntryCalled: func(unitName string, key []byte) ([]byte, error) {
+				wasCalled = true
+				return []byte("value"), nil
+			},
+		},
+		&mock.PoolsDumpHandlerStub{}, &mock.ParticipationProofsHandlerStub{}, &mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{},
+		&mock.AccountsIteratingHandlerStub{},
+		&mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	value, err := nar.GetStorageUnitEntry("TransactionUnit", []byte("key"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+	assert.True(t, wasCalled)
+}
+
+func TestNodeApiResolver_DumpPoolsAndLoadPoolsDumpShouldCall(t *testing.T) {
+	t.Parallel()
+
+	dumpCalled := false
+	loadCalled := false
+	nar, _ := external.NewNodeApiResolver(
+		&mock.ScDataGetterStub{},
+		&mock.StatusMetricsStub{},
+		&mock.StorageUnitsHandlerStub{},
+		&mock.PoolsDumpHandlerStub{
+			DumpPoolsCalled: func(poolNames []string) ([]string, error) {
+				dumpCalled = true
+				return []string{"file"}, nil
+			},
+			LoadPoolsDumpCalled: func(fileName string) (int, error) {
+				loadCalled = true
+				return 1, nil
+			},
+		},
+		&mock.ParticipationProofsHandlerStub{}, &mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{},
+		&mock.AccountsIteratingHandlerStub{},
+		&mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	files, _ := nar.DumpPools([]string{external.TransactionsPoolName})
+	numLoaded, _ := nar.LoadPoolsDump("file")
+
+	assert.True(t, dumpCalled)
+	assert.True(t, loadCalled)
+	assert.Equal(t, []string{"file"}, files)
+	assert.Equal(t, 1, numLoaded)
+}
+
+func TestNodeApiResolver_ExportParticipationProofsShouldCall(t *testing.T) {
+	t.Parallel()
+
+	expectedProofs := &external.ParticipationProofs{Epoch: 2}
+	nar, _ := external.NewNodeApiResolver(
+		&mock.ScDataGetterStub{},
+		&mock.StatusMetricsStub{},
+		&mock.StorageUnitsHandlerStub{},
+		&mock.PoolsDumpHandlerStub{},
+		&mock.ParticipationProofsHandlerStub{
+			ExportParticipationProofsCalled: func(epoch uint32, pubKey []byte) (*external.ParticipationProofs, error) {
+				return expectedProofs, nil
+			},
+		},
+		&mock.MessageTracingHandlerStub{}, &mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{},
+		&mock.AccountsIteratingHandlerStub{},
+		&mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	proofs, err := nar.ExportParticipationProofs(2, []byte("pubKey"))
+
+	assert.Nil(t, err)
+	assert.True(t, expectedProofs == proofs)
+}
+
+func TestNodeApiResolver_MessageTracingShouldCall(t *testing.T) {
+	t.Parallel()
+
+	enabledTopic := ""
+	disabledTopic := ""
+	expectedTraces := []tracing.MessageTraceRecord{{Topic: "topic"}}
+	nar, _ := external.NewNodeApiResolver(
+		&mock.ScDataGetterStub{},
+		&mock.StatusMetricsStub{},
+		&mock.StorageUnitsHandlerStub{},
+		&mock.PoolsDumpHandlerStub{},
+		&mock.ParticipationProofsHandlerStub{},
+		&mock.MessageTracingHandlerStub{
+			EnableTopicCalled: func(topic string, sampleRate uint32) error {
+				enabledTopic = topic
+				return nil
+			},
+			DisableTopicCalled: func(topic string) {
+				disabledTopic = topic
+			},
+			TracedTopicsCalled: func() map[string]uint32 {
+				return map[string]uint32{"topic": 10}
+			},
+			TracesCalled: func() []tracing.MessageTraceRecord {
+				return expectedTraces
+			},
+		},
+		&mock.RejectionTrackingHandlerStub{}, &mock.DiagnosticsHandlerStub{}, &mock.ReadinessHandlerStub{},
+		&mock.AccountsIteratingHandlerStub{},
+		&mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	err := nar.EnableMessageTracing("topic", 10)
+	nar.DisableMessageTracing("other")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "topic", enabledTopic)
+	assert.Equal(t, "other", disabledTopic)
+	assert.Equal(t, map[string]uint32{"topic": 10}, nar.TracedTopics())
+	assert.Equal(t, expectedTraces, nar.MessageTraces())
+}
+
+func TestNodeApiResolver_RejectionsShouldCall(t *testing.T) {
+	t.Parallel()
+
+	expectedRejections := []rejection.PeerRejections{{Peer: "peer", NumRejections: 2}}
+	requestedPeer := p2p.PeerID("")
+	nar, _ := external.NewNodeApiResolver(
+		&mock.ScDataGetterStub{},
+		&mock.StatusMetricsStub{},
+		&mock.StorageUnitsHandlerStub{},
+		&mock.PoolsDumpHandlerStub{},
+		&mock.ParticipationProofsHandlerStub{},
+		&mock.MessageTracingHandlerStub{},
+		&mock.RejectionTrackingHandlerStub{
+			RejectionsCalled: func() []rejection.PeerRejections {
+				return expectedRejections
+			},
+			PeerRejectionsCalled: func(peer p2p.PeerID) rejection.PeerRejections {
+				requestedPeer = peer
+				return expectedRejections[0]
+			},
+		},
+		&mock.DiagnosticsHandlerStub{},
+		&mock.ReadinessHandlerStub{},
+		&mock.AccountsIteratingHandlerStub{},
+		&mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	assert.Equal(t, expectedRejections, nar.Rejections())
+	assert.Equal(t, expectedRejections[0], nar.PeerRejections("peer"))
+	assert.Equal(t, p2p.PeerID("peer"), requestedPeer)
+}
+
+func TestNodeApiResolver_DumpDiagnosticsShouldCall(t *testing.T) {
+	t.Parallel()
+
+	expectedReport := &external.DiagnosticsReport{Timestamp: 10}
+	nar, _ := external.NewNodeApiResolver(
+		&mock.ScDataGetterStub{},
+		&mock.StatusMetricsStub{},
+		&mock.StorageUnitsHandlerStub{},
+		&mock.PoolsDumpHandlerStub{},
+		&mock.ParticipationProofsHandlerStub{},
+		&mock.MessageTracingHandlerStub{},
+		&mock.RejectionTrackingHandlerStub{},
+		&mock.DiagnosticsHandlerStub{
+			DumpReportCalled: func() *external.DiagnosticsReport {
+				return expectedReport
+			},
+		},
+		&mock.ReadinessHandlerStub{},
+		&mock.AccountsIteratingHandlerStub{},
+		&mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	assert.Equal(t, expectedReport, nar.DumpDiagnostics())
+}
+
+func TestNodeApiResolver_CheckReadinessShouldCall(t *testing.T) {
+	t.Parallel()
+
+	expectedReport := &external.ReadinessReport{Ready: true}
+	nar, _ := external.NewNodeApiResolver(
+		&mock.ScDataGetterStub{},
+		&mock.StatusMetricsStub{},
+		&mock.StorageUnitsHandlerStub{},
+		&mock.PoolsDumpHandlerStub{},
+		&mock.ParticipationProofsHandlerStub{},
+		&mock.MessageTracingHandlerStub{},
+		&mock.RejectionTrackingHandlerStub{},
+		&mock.DiagnosticsHandlerStub{},
+		&mock.ReadinessHandlerStub{
+			CheckReadinessCalled: func() *external.ReadinessReport {
+				return expectedReport
+			},
+		},
+		&mock.AccountsIteratingHandlerStub{},
+		&mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	assert.Equal(t, expectedReport, nar.CheckReadiness())
+}
+
+func TestNodeApiResolver_IterateAccountsShouldCall(t *testing.T) {
+	t.Parallel()
+
+	expectedResume := []byte("resume")
+	wasCalled := false
+	nar, _ := external.NewNodeApiResolver(
+		&mock.ScDataGetterStub{},
+		&mock.StatusMetricsStub{},
+		&mock.StorageUnitsHandlerStub{},
+		&mock.PoolsDumpHandlerStub{},
+		&mock.ParticipationProofsHandlerStub{},
+		&mock.MessageTracingHandlerStub{},
+		&mock.RejectionTrackingHandlerStub{},
+		&mock.DiagnosticsHandlerStub{},
+		&mock.ReadinessHandlerStub{},
+		&mock.AccountsIteratingHandlerStub{
+			IterateAccountsCalled: func(rootHash []byte, startAfter []byte, maxAccounts int, handler func(account *external.AccountEntry) error) ([]byte, error) {
+				assert.Equal(t, []byte("root"), rootHash)
+				assert.Equal(t, []byte("start"), startAfter)
+				assert.Equal(t, 10, maxAccounts)
+				wasCalled = true
+				return expectedResume, nil
+			},
+		},
+		&mock.SCStorageDiffHandlerStub{}, &mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	resume, err := nar.IterateAccounts([]byte("root"), []byte("start"), 10, func(account *external.AccountEntry) error {
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.True(t, wasCalled)
+	assert.Equal(t, expectedResume, resume)
+}
+
+func TestNodeApiResolver_SCStorageDiffShouldCall(t *testing.T) {
+	t.Parallel()
+
+	expectedDiff := &external.SCStorageDiff{Address: "aabb", FromNonce: 2, ToNonce: 5}
+	nar, _ := external.NewNodeApiResolver(
+		&mock.ScDataGetterStub{},
+		&mock.StatusMetricsStub{},
+		&mock.StorageUnitsHandlerStub{},
+		&mock.PoolsDumpHandlerStub{},
+		&mock.ParticipationProofsHandlerStub{},
+		&mock.MessageTracingHandlerStub{},
+		&mock.RejectionTrackingHandlerStub{},
+		&mock.DiagnosticsHandlerStub{},
+		&mock.ReadinessHandlerStub{},
+		&mock.AccountsIteratingHandlerStub{},
+		&mock.SCStorageDiffHandlerStub{
+			StorageDiffCalled: func(address []byte, fromNonce uint64, toNonce uint64) (*external.SCStorageDiff, error) {
+				assert.Equal(t, []byte{0xaa, 0xbb}, address)
+				assert.Equal(t, uint64(2), fromNonce)
+				assert.Equal(t, uint64(5), toNonce)
+				return expectedDiff, nil
+			},
+		},
+		&mock.ValidatorEarningsHandlerStub{}, &mock.ChainExportHandlerStub{})
+
+	diff, err := nar.SCStorageDiff([]byte{0xaa, 0xbb}, 2, 5)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedDiff, diff)
+}
+
+func TestNodeApiResolver_ValidatorEarningsShouldCall(t *testing.T) {
+	t.Parallel()
+
+	expectedEarnings := &external.ValidatorEarnings{Address: "aabb", FromEpoch: 2, ToEpoch: 5}
+	nar, _ := external.NewNodeApiResolver(
+		&mock.ScDataGetterStub{},
+		&mock.StatusMetricsStub{},
+		&mock.StorageUnitsHandlerStub{},
+		&mock.PoolsDumpHandlerStub{},
+		&mock.ParticipationProofsHandlerStub{},
+		&mock.MessageTracingHandlerStub{},
+		&mock.RejectionTrackingHandlerStub{},
+		&mock.DiagnosticsHandlerStub{},
+		&mock.ReadinessHandlerStub{},
+		&mock.AccountsIteratingHandlerStub{},
+		&mock.SCStorageDiffHandlerStub{},
+		&mock.ValidatorEarningsHandlerStub{
+			EarningsCalled: func(address []byte, fromEpoch uint32, toEpoch uint32) (*external.ValidatorEarnings, error) {
+				assert.Equal(t, []byte{0xaa, 0xbb}, address)
+				assert.Equal(t, uint32(2), fromEpoch)
+				assert.Equal(t, uint32(5), toEpoch)
+				return expectedEarnings, nil
+			},
+		},
+		&mock.ChainExportHandlerStub{})
+
+	earnings, err := nar.ValidatorEarnings([]byte{0xaa, 0xbb}, 2, 5)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedEarnings, earnings)
+}
+
+func TestNodeApiResolver_ExportChainShouldCall(t *testing.T) {
+	t.Parallel()
+
+	row := []string{"1", "2"}
+	nar, _ := external.NewNodeApiResolver(
+		&mock.ScDataGetterStub{},
+		&mock.StatusMetricsStub{},
+		&mock.StorageUnitsHandlerStub{},
+		&mock.PoolsDumpHandlerStub{},
+		&mock.ParticipationProofsHandlerStub{},
+		&mock.MessageTracingHandlerStub{},
+		&mock.RejectionTrackingHandlerStub{},
+		&mock.DiagnosticsHandlerStub{},
+		&mock.ReadinessHandlerStub{},
+		&mock.AccountsIteratingHandlerStub{},
+		&mock.SCStorageDiffHandlerStub{},
+		&mock.ValidatorEarningsHandlerStub{},
+		&mock.ChainExportHandlerStub{
+			ExportCalled: func(table string, fromNonce uint64, toNonce uint64, handler func(row []string) error) error {
+				assert.Equal(t, external.ExportTableBlocks, table)
+				assert.Equal(t, uint64(2), fromNonce)
+				assert.Equal(t, uint64(5), toNonce)
+				return handler(row)
+			},
+		})
+
+	var exportedRows [][]string
+	err := nar.ExportChain(external.ExportTableBlocks, 2, 5, func(row []string) error {
+		exportedRows = append(exportedRows, row)
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{row}, exportedRows)
+}
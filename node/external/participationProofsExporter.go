@@ -0,0 +1,230 @@
+package external
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// SignedHeaderProof holds what an auditor needs to verify off-node that a validator signed a header: the signed
+// message (the hash of the header without its signature and bitmap), the ordered consensus group, the position of
+// the validator in that group, the signers bitmap and the aggregated signature. All byte values are hex encoded
+type SignedHeaderProof struct {
+	ShardID             uint32   `json:"shardID"`
+	Nonce               uint64   `json:"nonce"`
+	Round               uint64   `json:"round"`
+	HeaderHash          string   `json:"headerHash"`
+	SignedMessageHash   string   `json:"signedMessageHash"`
+	ConsensusGroup      []string `json:"consensusGroup"`
+	SignerIndex         int      `json:"signerIndex"`
+	PubKeysBitmap       string   `json:"pubKeysBitmap"`
+	AggregatedSignature string   `json:"aggregatedSignature"`
+}
+
+// ParticipationProofs is the export of the headers signed by a validator during an epoch. NumConsensusHeaders
+// counts all the headers of the epoch for which the validator was part of the consensus group, signed or not
+type ParticipationProofs struct {
+	Epoch               uint32              `json:"epoch"`
+	PubKey              string              `json:"pubKey"`
+	ShardID             uint32              `json:"shardID"`
+	NumConsensusHeaders uint32              `json:"numConsensusHeaders"`
+	SignedHeaders       []SignedHeaderProof `json:"signedHeaders"`
+}
+
+// ParticipationProofsExporter builds, from the headers found in the node's storage, the participation proofs of a
+// validator. Only the chain of the node's own shard is scanned, as it is the only one fully stored by the node
+type ParticipationProofsExporter struct {
+	blockChain       data.ChainHandler
+	store            dataRetriever.StorageService
+	nodesCoordinator sharding.NodesCoordinator
+	shardCoordinator sharding.Coordinator
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	uint64Converter  typeConverters.Uint64ByteSliceConverter
+}
+
+// NewParticipationProofsExporter creates a new ParticipationProofsExporter instance
+func NewParticipationProofsExporter(
+	blockChain data.ChainHandler,
+	store dataRetriever.StorageService,
+	nodesCoordinator sharding.NodesCoordinator,
+	shardCoordinator sharding.Coordinator,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	uint64Converter typeConverters.Uint64ByteSliceConverter,
+) (*ParticipationProofsExporter, error) {
+	if blockChain == nil || blockChain.IsInterfaceNil() {
+		return nil, ErrNilBlockChain
+	}
+	if store == nil || store.IsInterfaceNil() {
+		return nil, ErrNilStore
+	}
+	if nodesCoordinator == nil || nodesCoordinator.IsInterfaceNil() {
+		return nil, ErrNilNodesCoordinator
+	}
+	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, ErrNilHasher
+	}
+	if uint64Converter == nil || uint64Converter.IsInterfaceNil() {
+		return nil, ErrNilUint64Converter
+	}
+
+	return &ParticipationProofsExporter{
+		blockChain:       blockChain,
+		store:            store,
+		nodesCoordinator: nodesCoordinator,
+		shardCoordinator: shardCoordinator,
+		marshalizer:      marshalizer,
+		hasher:           hasher,
+		uint64Converter:  uint64Converter,
+	}, nil
+}
+
+// ExportParticipationProofs returns the proofs of all the stored headers of the provided epoch signed by the
+// validator with the provided public key
+func (ppe *ParticipationProofsExporter) ExportParticipationProofs(epoch uint32, pubKey []byte) (*ParticipationProofs, error) {
+	if len(pubKey) == 0 {
+		return nil, ErrEmptyPubKey
+	}
+
+	shardId := ppe.shardCoordinator.SelfId()
+	proofs := &ParticipationProofs{
+		Epoch:         epoch,
+		PubKey:        hex.EncodeToString(pubKey),
+		ShardID:       shardId,
+		SignedHeaders: make([]SignedHeaderProof, 0),
+	}
+
+	currentHeader := ppe.blockChain.GetCurrentBlockHeader()
+	if currentHeader == nil || currentHeader.IsInterfaceNil() {
+		return proofs, nil
+	}
+
+	for nonce := uint64(1); nonce <= currentHeader.GetNonce(); nonce++ {
+		header, headerHash, err := ppe.headerWithNonce(nonce, shardId)
+		if err != nil {
+			return nil, err
+		}
+		if header.GetEpoch() < epoch {
+			continue
+		}
+		if header.GetEpoch() > epoch {
+			break
+		}
+
+		proof, err := ppe.signedHeaderProof(header, headerHash, pubKey, shardId)
+		if err != nil {
+			return nil, err
+		}
+		if proof == nil {
+			continue
+		}
+
+		proofs.NumConsensusHeaders++
+		if isSignerInBitmap(header.GetPubKeysBitmap(), proof.SignerIndex) {
+			proofs.SignedHeaders = append(proofs.SignedHeaders, *proof)
+		}
+	}
+
+	return proofs, nil
+}
+
+func (ppe *ParticipationProofsExporter) headerWithNonce(nonce uint64, shardId uint32) (data.HeaderHandler, []byte, error) {
+	if shardId == sharding.MetachainShardId {
+		return process.GetMetaHeaderFromStorageWithNonce(nonce, ppe.store, ppe.uint64Converter, ppe.marshalizer)
+	}
+
+	return process.GetShardHeaderFromStorageWithNonce(nonce, shardId, ppe.store, ppe.uint64Converter, ppe.marshalizer)
+}
+
+// signedHeaderProof returns the proof for the provided header, or nil if the validator was not in its consensus group
+func (ppe *ParticipationProofsExporter) signedHeaderProof(
+	header data.HeaderHandler,
+	headerHash []byte,
+	pubKey []byte,
+	shardId uint32,
+) (*SignedHeaderProof, error) {
+	consensusPubKeys, err := ppe.nodesCoordinator.GetValidatorsPublicKeys(header.GetPrevRandSeed(), header.GetRound(), shardId)
+	if err != nil {
+		return nil, err
+	}
+
+	signerIndex := -1
+	consensusGroup := make([]string, len(consensusPubKeys))
+	for i, consensusPubKey := range consensusPubKeys {
+		consensusGroup[i] = hex.EncodeToString([]byte(consensusPubKey))
+		if bytes.Equal([]byte(consensusPubKey), pubKey) {
+			signerIndex = i
+		}
+	}
+	if signerIndex < 0 {
+		return nil, nil
+	}
+
+	signedMessageHash, err := ppe.signedMessageHash(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignedHeaderProof{
+		ShardID:             shardId,
+		Nonce:               header.GetNonce(),
+		Round:               header.GetRound(),
+		HeaderHash:          hex.EncodeToString(headerHash),
+		SignedMessageHash:   hex.EncodeToString(signedMessageHash),
+		ConsensusGroup:      consensusGroup,
+		SignerIndex:         signerIndex,
+		PubKeysBitmap:       hex.EncodeToString(header.GetPubKeysBitmap()),
+		AggregatedSignature: hex.EncodeToString(header.GetSignature()),
+	}, nil
+}
+
+// signedMessageHash computes the hash of the header without its signature and bitmap, as this is the message signed
+// by the consensus group
+func (ppe *ParticipationProofsExporter) signedMessageHash(header data.HeaderHandler) ([]byte, error) {
+	switch hdr := header.(type) {
+	case *block.Header:
+		headerCopy := *hdr
+		headerCopy.Signature = nil
+		headerCopy.PubKeysBitmap = nil
+		return core.CalculateHash(ppe.marshalizer, ppe.hasher, headerCopy)
+	case *block.MetaBlock:
+		headerCopy := *hdr
+		headerCopy.Signature = nil
+		headerCopy.PubKeysBitmap = nil
+		return core.CalculateHash(ppe.marshalizer, ppe.hasher, headerCopy)
+	default:
+		return nil, ErrWrongTypeAssertion
+	}
+}
+
+func isSignerInBitmap(bitmap []byte, index int) bool {
+	if index/8 >= len(bitmap) {
+		return false
+	}
+
+	return bitmap[index/8]&(1<<uint8(index%8)) != 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ppe *ParticipationProofsExporter) IsInterfaceNil() bool {
+	if ppe == nil {
+		return true
+	}
+	return false
+}
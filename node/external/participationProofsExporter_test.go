@@ -0,0 +1,207 @@
+package external_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type participationProofsTestEnv struct {
+	blockChain       *mock.BlockChainMock
+	store            dataRetriever.StorageService
+	nodesCoordinator *mock.NodesCoordinatorMock
+	marshalizer      *mock.MarshalizerFake
+	hasher           *mock.HasherFake
+}
+
+func createParticipationProofsTestEnv() *participationProofsTestEnv {
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.BlockHeaderUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit, mock.NewStorerMock())
+
+	return &participationProofsTestEnv{
+		blockChain: &mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return nil
+			},
+		},
+		store: store,
+		nodesCoordinator: &mock.NodesCoordinatorMock{
+			GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32) ([]string, error) {
+				return []string{fmt.Sprintf("leader%d", round), "validator", "other"}, nil
+			},
+		},
+		marshalizer: &mock.MarshalizerFake{},
+		hasher:      &mock.HasherFake{},
+	}
+}
+
+func (env *participationProofsTestEnv) createExporter() *external.ParticipationProofsExporter {
+	ppe, _ := external.NewParticipationProofsExporter(
+		env.blockChain,
+		env.store,
+		env.nodesCoordinator,
+		mock.NewOneShardCoordinatorMock(),
+		env.marshalizer,
+		env.hasher,
+		uint64ByteSlice.NewBigEndianConverter(),
+	)
+
+	return ppe
+}
+
+func (env *participationProofsTestEnv) storeHeaders(headers ...*block.Header) {
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	for _, hdr := range headers {
+		buff, _ := env.marshalizer.Marshal(hdr)
+		hash := env.hasher.Compute(string(buff))
+		_ = env.store.Put(dataRetriever.BlockHeaderUnit, hash, buff)
+		_ = env.store.Put(dataRetriever.ShardHdrNonceHashDataUnit, converter.ToByteSlice(hdr.Nonce), hash)
+	}
+
+	lastHeader := headers[len(headers)-1]
+	env.blockChain.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return lastHeader
+	}
+}
+
+func createSignedHeader(nonce uint64, epoch uint32, bitmap byte) *block.Header {
+	return &block.Header{
+		Nonce:         nonce,
+		Round:         nonce,
+		Epoch:         epoch,
+		PrevRandSeed:  []byte("prev rand seed"),
+		PubKeysBitmap: []byte{bitmap},
+		Signature:     []byte(fmt.Sprintf("signature%d", nonce)),
+	}
+}
+
+func TestNewParticipationProofsExporter_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	env := createParticipationProofsTestEnv()
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+
+	ppe, err := external.NewParticipationProofsExporter(nil, env.store, env.nodesCoordinator, shardCoordinator, env.marshalizer, env.hasher, converter)
+	assert.Nil(t, ppe)
+	assert.Equal(t, external.ErrNilBlockChain, err)
+
+	ppe, err = external.NewParticipationProofsExporter(env.blockChain, nil, env.nodesCoordinator, shardCoordinator, env.marshalizer, env.hasher, converter)
+	assert.Nil(t, ppe)
+	assert.Equal(t, external.ErrNilStore, err)
+
+	ppe, err = external.NewParticipationProofsExporter(env.blockChain, env.store, nil, shardCoordinator, env.marshalizer, env.hasher, converter)
+	assert.Nil(t, ppe)
+	assert.Equal(t, external.ErrNilNodesCoordinator, err)
+
+	ppe, err = external.NewParticipationProofsExporter(env.blockChain, env.store, env.nodesCoordinator, nil, env.marshalizer, env.hasher, converter)
+	assert.Nil(t, ppe)
+	assert.Equal(t, external.ErrNilShardCoordinator, err)
+
+	ppe, err = external.NewParticipationProofsExporter(env.blockChain, env.store, env.nodesCoordinator, shardCoordinator, nil, env.hasher, converter)
+	assert.Nil(t, ppe)
+	assert.Equal(t, external.ErrNilMarshalizer, err)
+
+	ppe, err = external.NewParticipationProofsExporter(env.blockChain, env.store, env.nodesCoordinator, shardCoordinator, env.marshalizer, nil, converter)
+	assert.Nil(t, ppe)
+	assert.Equal(t, external.ErrNilHasher, err)
+
+	ppe, err = external.NewParticipationProofsExporter(env.blockChain, env.store, env.nodesCoordinator, shardCoordinator, env.marshalizer, env.hasher, nil)
+	assert.Nil(t, ppe)
+	assert.Equal(t, external.ErrNilUint64Converter, err)
+}
+
+func TestParticipationProofsExporter_EmptyPubKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ppe := createParticipationProofsTestEnv().createExporter()
+
+	proofs, err := ppe.ExportParticipationProofs(0, nil)
+
+	assert.Nil(t, proofs)
+	assert.Equal(t, external.ErrEmptyPubKey, err)
+}
+
+func TestParticipationProofsExporter_NoBlocksShouldReturnEmptyProofs(t *testing.T) {
+	t.Parallel()
+
+	ppe := createParticipationProofsTestEnv().createExporter()
+
+	proofs, err := ppe.ExportParticipationProofs(0, []byte("validator"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString([]byte("validator")), proofs.PubKey)
+	assert.Equal(t, uint32(0), proofs.NumConsensusHeaders)
+	assert.Equal(t, 0, len(proofs.SignedHeaders))
+}
+
+func TestParticipationProofsExporter_ShouldExportOnlySignedHeadersOfTheEpoch(t *testing.T) {
+	t.Parallel()
+
+	env := createParticipationProofsTestEnv()
+	hdrPreviousEpoch := createSignedHeader(1, 0, 0x03)
+	hdrSigned := createSignedHeader(2, 1, 0x03)
+	hdrNotSigned := createSignedHeader(3, 1, 0x05)
+	hdrNextEpoch := createSignedHeader(4, 2, 0x03)
+	env.storeHeaders(hdrPreviousEpoch, hdrSigned, hdrNotSigned, hdrNextEpoch)
+	ppe := env.createExporter()
+
+	proofs, err := ppe.ExportParticipationProofs(1, []byte("validator"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), proofs.Epoch)
+	assert.Equal(t, uint32(2), proofs.NumConsensusHeaders)
+	assert.Equal(t, 1, len(proofs.SignedHeaders))
+
+	proof := proofs.SignedHeaders[0]
+	assert.Equal(t, uint64(2), proof.Nonce)
+	assert.Equal(t, 1, proof.SignerIndex)
+	assert.Equal(t, hex.EncodeToString([]byte("validator")), proof.ConsensusGroup[proof.SignerIndex])
+	assert.Equal(t, "03", proof.PubKeysBitmap)
+	assert.Equal(t, hex.EncodeToString([]byte("signature2")), proof.AggregatedSignature)
+
+	headerCopy := *hdrSigned
+	headerCopy.Signature = nil
+	headerCopy.PubKeysBitmap = nil
+	signedMessageHash, _ := core.CalculateHash(env.marshalizer, env.hasher, headerCopy)
+	assert.Equal(t, hex.EncodeToString(signedMessageHash), proof.SignedMessageHash)
+}
+
+func TestParticipationProofsExporter_ValidatorNotInConsensusShouldNotCount(t *testing.T) {
+	t.Parallel()
+
+	env := createParticipationProofsTestEnv()
+	env.storeHeaders(createSignedHeader(1, 0, 0xff))
+	ppe := env.createExporter()
+
+	proofs, err := ppe.ExportParticipationProofs(0, []byte("unknown"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), proofs.NumConsensusHeaders)
+	assert.Equal(t, 0, len(proofs.SignedHeaders))
+}
+
+func TestParticipationProofsExporter_MissingHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	env := createParticipationProofsTestEnv()
+	env.blockChain.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return &block.Header{Nonce: 1}
+	}
+	ppe := env.createExporter()
+
+	proofs, err := ppe.ExportParticipationProofs(0, []byte("validator"))
+
+	assert.Nil(t, proofs)
+	assert.NotNil(t, err)
+}
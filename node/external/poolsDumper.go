@@ -0,0 +1,274 @@
+package external
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// TransactionsPoolName is the name used when dumping or loading the transactions pool
+const TransactionsPoolName = "transactions"
+
+// MiniBlocksPoolName is the name used when dumping or loading the miniblocks pool
+const MiniBlocksPoolName = "miniblocks"
+
+// HeadersPoolName is the name used when dumping or loading the shard headers pool
+const HeadersPoolName = "headers"
+
+const poolDumpTimeFormat = "2006-01-02-15-04-05.000000"
+
+// PoolDump is the content of a pool dump file
+type PoolDump struct {
+	Pool    string          `json:"pool"`
+	Entries []PoolDumpEntry `json:"entries"`
+}
+
+// PoolDumpEntry holds one marshalized pool entry. The cache ID is set only for the entries of the sharded pools
+type PoolDumpEntry struct {
+	CacheId string `json:"cacheId,omitempty"`
+	Key     []byte `json:"key"`
+	Value   []byte `json:"value"`
+}
+
+// PoolsDumper writes the contents of the node's transactions, miniblocks and headers pools to files and loads such
+// files back in the pools. It is meant to be used for reproducing, on test nodes, the pools' state of another node
+type PoolsDumper struct {
+	marshalizer   marshal.Marshalizer
+	dumpFolder    string
+	transactions  dataRetriever.ShardedDataCacherNotifier
+	miniBlocks    storage.Cacher
+	headers       storage.Cacher
+	headersNonces dataRetriever.Uint64SyncMapCacher
+	txCacheIds    []string
+}
+
+// NewPoolsDumper creates a new PoolsDumper instance working on the pools of a shard node, if dataPool is provided,
+// or on the pools of a metachain node otherwise
+func NewPoolsDumper(
+	dataPool dataRetriever.PoolsHolder,
+	metaDataPool dataRetriever.MetaPoolsHolder,
+	shardCoordinator sharding.Coordinator,
+	marshalizer marshal.Marshalizer,
+	dumpFolder string,
+) (*PoolsDumper, error) {
+	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if len(dumpFolder) == 0 {
+		return nil, ErrEmptyDumpFolder
+	}
+
+	pd := &PoolsDumper{
+		marshalizer: marshalizer,
+		dumpFolder:  dumpFolder,
+	}
+
+	switch {
+	case dataPool != nil && !dataPool.IsInterfaceNil():
+		pd.transactions = dataPool.Transactions()
+		pd.miniBlocks = dataPool.MiniBlocks()
+		pd.headers = dataPool.Headers()
+		pd.headersNonces = dataPool.HeadersNonces()
+	case metaDataPool != nil && !metaDataPool.IsInterfaceNil():
+		pd.transactions = metaDataPool.Transactions()
+		pd.miniBlocks = metaDataPool.MiniBlocks()
+		pd.headers = metaDataPool.ShardHeaders()
+		pd.headersNonces = metaDataPool.HeadersNonces()
+	default:
+		return nil, ErrNilDataPool
+	}
+
+	pd.txCacheIds = make([]string, 0)
+	for senderShardId := uint32(0); senderShardId < shardCoordinator.NumberOfShards(); senderShardId++ {
+		for destShardId := uint32(0); destShardId < shardCoordinator.NumberOfShards(); destShardId++ {
+			pd.txCacheIds = append(pd.txCacheIds, process.ShardCacherIdentifier(senderShardId, destShardId))
+		}
+	}
+
+	return pd, nil
+}
+
+// DumpPools writes the contents of the named pools, one file per pool, in the dump folder and returns the paths of
+// the created files. All the pools are dumped if no pool name is provided
+func (pd *PoolsDumper) DumpPools(poolNames []string) ([]string, error) {
+	if len(poolNames) == 0 {
+		poolNames = []string{TransactionsPoolName, MiniBlocksPoolName, HeadersPoolName}
+	}
+
+	dumps := make([]*PoolDump, 0, len(poolNames))
+	for _, poolName := range poolNames {
+		dump, err := pd.createPoolDump(poolName)
+		if err != nil {
+			return nil, err
+		}
+
+		dumps = append(dumps, dump)
+	}
+
+	absPath, err := filepath.Abs(pd.dumpFolder)
+	if err != nil {
+		return nil, err
+	}
+	err = os.MkdirAll(absPath, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp := time.Now().Format(poolDumpTimeFormat)
+	files := make([]string, 0, len(dumps))
+	for _, dump := range dumps {
+		buff, err := json.Marshal(dump)
+		if err != nil {
+			return nil, err
+		}
+
+		fileName := filepath.Join(absPath, fmt.Sprintf("%s-%s.json", dump.Pool, timestamp))
+		err = ioutil.WriteFile(fileName, buff, 0644)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, fileName)
+	}
+
+	return files, nil
+}
+
+func (pd *PoolsDumper) createPoolDump(poolName string) (*PoolDump, error) {
+	dump := &PoolDump{
+		Pool:    poolName,
+		Entries: make([]PoolDumpEntry, 0),
+	}
+
+	var err error
+	switch poolName {
+	case TransactionsPoolName:
+		for _, cacheId := range pd.txCacheIds {
+			dump.Entries, err = pd.appendCacherEntries(dump.Entries, pd.transactions.ShardDataStore(cacheId), cacheId)
+			if err != nil {
+				return nil, err
+			}
+		}
+	case MiniBlocksPoolName:
+		dump.Entries, err = pd.appendCacherEntries(dump.Entries, pd.miniBlocks, "")
+	case HeadersPoolName:
+		dump.Entries, err = pd.appendCacherEntries(dump.Entries, pd.headers, "")
+	default:
+		return nil, ErrUnknownPool
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return dump, nil
+}
+
+func (pd *PoolsDumper) appendCacherEntries(entries []PoolDumpEntry, cacher storage.Cacher, cacheId string) ([]PoolDumpEntry, error) {
+	if cacher == nil || cacher.IsInterfaceNil() {
+		return entries, nil
+	}
+
+	for _, key := range cacher.Keys() {
+		value, ok := cacher.Peek(key)
+		if !ok {
+			continue
+		}
+
+		buff, err := pd.marshalizer.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, PoolDumpEntry{
+			CacheId: cacheId,
+			Key:     key,
+			Value:   buff,
+		})
+	}
+
+	return entries, nil
+}
+
+// LoadPoolsDump adds in the corresponding pool the entries found in the provided dump file and returns the number of
+// loaded entries
+func (pd *PoolsDumper) LoadPoolsDump(fileName string) (int, error) {
+	buff, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return 0, err
+	}
+
+	dump := &PoolDump{}
+	err = json.Unmarshal(buff, dump)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, entry := range dump.Entries {
+		err = pd.loadEntry(dump.Pool, entry)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(dump.Entries), nil
+}
+
+func (pd *PoolsDumper) loadEntry(poolName string, entry PoolDumpEntry) error {
+	switch poolName {
+	case TransactionsPoolName:
+		tx := &transaction.Transaction{}
+		err := pd.marshalizer.Unmarshal(tx, entry.Value)
+		if err != nil {
+			return err
+		}
+
+		pd.transactions.AddData(entry.Key, tx, entry.CacheId)
+	case MiniBlocksPoolName:
+		miniBlock := &block.MiniBlock{}
+		err := pd.marshalizer.Unmarshal(miniBlock, entry.Value)
+		if err != nil {
+			return err
+		}
+
+		pd.miniBlocks.HasOrAdd(entry.Key, miniBlock)
+	case HeadersPoolName:
+		hdr := &block.Header{}
+		err := pd.marshalizer.Unmarshal(hdr, entry.Value)
+		if err != nil {
+			return err
+		}
+
+		pd.headers.HasOrAdd(entry.Key, hdr)
+
+		syncMap := &dataPool.ShardIdHashSyncMap{}
+		syncMap.Store(hdr.ShardId, entry.Key)
+		pd.headersNonces.Merge(hdr.Nonce, syncMap)
+	default:
+		return ErrUnknownPool
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pd *PoolsDumper) IsInterfaceNil() bool {
+	if pd == nil {
+		return true
+	}
+	return false
+}
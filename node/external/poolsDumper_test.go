@@ -0,0 +1,154 @@
+package external_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+)
+
+func createPoolsHolder() *mock.PoolsHolderStub {
+	cacheConfig := storageUnit.CacheConfig{Size: 100, Type: storageUnit.LRUCache, Shards: 1}
+	txs, _ := shardedData.NewShardedData(cacheConfig)
+	miniBlocks, _ := storageUnit.NewCache(storageUnit.LRUCache, 100, 1)
+	headers, _ := storageUnit.NewCache(storageUnit.LRUCache, 100, 1)
+	noncesCacher, _ := storageUnit.NewCache(storageUnit.LRUCache, 100, 1)
+	headersNonces, _ := dataPool.NewNonceSyncMapCacher(noncesCacher, uint64ByteSlice.NewBigEndianConverter())
+
+	return &mock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return txs
+		},
+		MiniBlocksCalled: func() storage.Cacher {
+			return miniBlocks
+		},
+		HeadersCalled: func() storage.Cacher {
+			return headers
+		},
+		HeadersNoncesCalled: func() dataRetriever.Uint64SyncMapCacher {
+			return headersNonces
+		},
+	}
+}
+
+func createDumpFolder(t *testing.T) string {
+	dumpFolder, err := ioutil.TempDir("", "pools-dumps")
+	assert.Nil(t, err)
+
+	return dumpFolder
+}
+
+func TestNewPoolsDumper_NilPoolsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pd, err := external.NewPoolsDumper(nil, nil, mock.NewOneShardCoordinatorMock(), &mock.MarshalizerFake{}, "dumps")
+
+	assert.Nil(t, pd)
+	assert.Equal(t, external.ErrNilDataPool, err)
+}
+
+func TestNewPoolsDumper_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pd, err := external.NewPoolsDumper(createPoolsHolder(), nil, nil, &mock.MarshalizerFake{}, "dumps")
+
+	assert.Nil(t, pd)
+	assert.Equal(t, external.ErrNilShardCoordinator, err)
+}
+
+func TestNewPoolsDumper_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pd, err := external.NewPoolsDumper(createPoolsHolder(), nil, mock.NewOneShardCoordinatorMock(), nil, "dumps")
+
+	assert.Nil(t, pd)
+	assert.Equal(t, external.ErrNilMarshalizer, err)
+}
+
+func TestNewPoolsDumper_EmptyDumpFolderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pd, err := external.NewPoolsDumper(createPoolsHolder(), nil, mock.NewOneShardCoordinatorMock(), &mock.MarshalizerFake{}, "")
+
+	assert.Nil(t, pd)
+	assert.Equal(t, external.ErrEmptyDumpFolder, err)
+}
+
+func TestPoolsDumper_DumpUnknownPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pd, _ := external.NewPoolsDumper(createPoolsHolder(), nil, mock.NewOneShardCoordinatorMock(), &mock.MarshalizerFake{}, "dumps")
+
+	files, err := pd.DumpPools([]string{"unknown"})
+
+	assert.Nil(t, files)
+	assert.Equal(t, external.ErrUnknownPool, err)
+}
+
+func TestPoolsDumper_DumpAndLoadShouldRestoreThePools(t *testing.T) {
+	t.Parallel()
+
+	dumpFolder := createDumpFolder(t)
+	defer func() {
+		_ = os.RemoveAll(dumpFolder)
+	}()
+
+	source := createPoolsHolder()
+	cacheId := process.ShardCacherIdentifier(0, 0)
+	tx := &transaction.Transaction{Nonce: 7, Data: "data"}
+	source.Transactions().AddData([]byte("tx hash"), tx, cacheId)
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("tx hash")}}
+	source.MiniBlocks().Put([]byte("mb hash"), miniBlock)
+	hdr := &block.Header{Nonce: 3, ShardId: 0}
+	source.Headers().Put([]byte("hdr hash"), hdr)
+
+	pd, _ := external.NewPoolsDumper(source, nil, mock.NewOneShardCoordinatorMock(), &mock.MarshalizerFake{}, dumpFolder)
+	files, err := pd.DumpPools(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(files))
+
+	destination := createPoolsHolder()
+	pd, _ = external.NewPoolsDumper(destination, nil, mock.NewOneShardCoordinatorMock(), &mock.MarshalizerFake{}, dumpFolder)
+	for _, file := range files {
+		numLoaded, errLoad := pd.LoadPoolsDump(file)
+		assert.Nil(t, errLoad)
+		assert.Equal(t, 1, numLoaded)
+	}
+
+	restoredTx, ok := destination.Transactions().ShardDataStore(cacheId).Peek([]byte("tx hash"))
+	assert.True(t, ok)
+	assert.Equal(t, tx, restoredTx)
+	restoredMiniBlock, ok := destination.MiniBlocks().Peek([]byte("mb hash"))
+	assert.True(t, ok)
+	assert.Equal(t, miniBlock, restoredMiniBlock)
+	restoredHdr, ok := destination.Headers().Peek([]byte("hdr hash"))
+	assert.True(t, ok)
+	assert.Equal(t, hdr, restoredHdr)
+	syncMap, ok := destination.HeadersNonces().Get(hdr.Nonce)
+	assert.True(t, ok)
+	restoredHash, _ := syncMap.Load(hdr.ShardId)
+	assert.Equal(t, []byte("hdr hash"), restoredHash)
+}
+
+func TestPoolsDumper_LoadMissingFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pd, _ := external.NewPoolsDumper(createPoolsHolder(), nil, mock.NewOneShardCoordinatorMock(), &mock.MarshalizerFake{}, "dumps")
+
+	numLoaded, err := pd.LoadPoolsDump("missing-file.json")
+
+	assert.Equal(t, 0, numLoaded)
+	assert.NotNil(t, err)
+}
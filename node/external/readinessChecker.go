@@ -0,0 +1,218 @@
+package external
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// SyncedProbeName is the name of the probe checking that the node is close enough to the network's highest nonce
+const SyncedProbeName = "synced"
+
+// StorersProbeName is the name of the probe checking that the node's storage units are open
+const StorersProbeName = "storers"
+
+// PeersProbeName is the name of the probe checking that the node is connected to enough peers
+const PeersProbeName = "peers"
+
+// ConsensusKeyProbeName is the name of the probe checking that a validator has its consensus key loaded
+const ConsensusKeyProbeName = "consensusKey"
+
+var storerProbeKey = []byte("readinessProbe")
+
+// ProbeResult holds the outcome of one of the dependencies checked when deciding if the node is ready
+type ProbeResult struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Details string `json:"details"`
+}
+
+// ReadinessReport holds the outcome of all the readiness probes. The node is ready only if all of them passed
+type ReadinessReport struct {
+	Ready  bool          `json:"ready"`
+	Probes []ProbeResult `json:"probes"`
+}
+
+// PeersCounter defines the operation used to find out the peers the node is connected to
+type PeersCounter interface {
+	ConnectedPeers() []p2p.PeerID
+	IsInterfaceNil() bool
+}
+
+// ArgReadinessChecker holds the components needed by the readiness checker
+type ArgReadinessChecker struct {
+	BlockChain       data.ChainHandler
+	ForkDetector     process.ForkDetector
+	Store            dataRetriever.StorageService
+	ShardCoordinator sharding.Coordinator
+	Peers            PeersCounter
+	NodeType         core.NodeType
+	PrivateKey       crypto.PrivateKey
+	Config           config.ReadinessConfig
+}
+
+// ReadinessChecker decides if the node can be considered ready to serve requests or take part in consensus, by
+// probing its sync status, its storage units, its connections and, for validators, its consensus key
+type ReadinessChecker struct {
+	blockChain   data.ChainHandler
+	forkDetector process.ForkDetector
+	store        dataRetriever.StorageService
+	unitsByName  map[string]dataRetriever.UnitType
+	peers        PeersCounter
+	nodeType     core.NodeType
+	privateKey   crypto.PrivateKey
+	config       config.ReadinessConfig
+}
+
+// NewReadinessChecker creates a new ReadinessChecker instance
+func NewReadinessChecker(args ArgReadinessChecker) (*ReadinessChecker, error) {
+	if args.BlockChain == nil || args.BlockChain.IsInterfaceNil() {
+		return nil, ErrNilBlockChain
+	}
+	if args.ForkDetector == nil || args.ForkDetector.IsInterfaceNil() {
+		return nil, ErrNilForkDetector
+	}
+	if args.Store == nil || args.Store.IsInterfaceNil() {
+		return nil, ErrNilStore
+	}
+	if args.ShardCoordinator == nil || args.ShardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+	if args.Peers == nil || args.Peers.IsInterfaceNil() {
+		return nil, ErrNilPeersCounter
+	}
+
+	return &ReadinessChecker{
+		blockChain:   args.BlockChain,
+		forkDetector: args.ForkDetector,
+		store:        args.Store,
+		unitsByName:  createUnitsByName(args.ShardCoordinator),
+		peers:        args.Peers,
+		nodeType:     args.NodeType,
+		privateKey:   args.PrivateKey,
+		config:       args.Config,
+	}, nil
+}
+
+// CheckReadiness runs all the readiness probes and returns their outcome
+func (rc *ReadinessChecker) CheckReadiness() *ReadinessReport {
+	probes := []ProbeResult{
+		rc.probeSynced(),
+		rc.probeStorers(),
+		rc.probePeers(),
+		rc.probeConsensusKey(),
+	}
+
+	ready := true
+	for _, probe := range probes {
+		ready = ready && probe.Passed
+	}
+
+	return &ReadinessReport{
+		Ready:  ready,
+		Probes: probes,
+	}
+}
+
+func (rc *ReadinessChecker) probeSynced() ProbeResult {
+	currentNonce := uint64(0)
+	currentHeader := rc.blockChain.GetCurrentBlockHeader()
+	if currentHeader != nil && !currentHeader.IsInterfaceNil() {
+		currentNonce = currentHeader.GetNonce()
+	}
+
+	highestNonce := rc.forkDetector.ProbableHighestNonce()
+	noncesBehind := uint64(0)
+	if highestNonce > currentNonce {
+		noncesBehind = highestNonce - currentNonce
+	}
+
+	return ProbeResult{
+		Name:   SyncedProbeName,
+		Passed: noncesBehind <= rc.config.MaxNoncesBehind,
+		Details: fmt.Sprintf("current nonce %d, probable highest nonce %d, max nonces behind %d",
+			currentNonce, highestNonce, rc.config.MaxNoncesBehind),
+	}
+}
+
+func (rc *ReadinessChecker) probeStorers() ProbeResult {
+	unavailable := make([]string, 0)
+	for name, unitType := range rc.unitsByName {
+		storer := rc.store.GetStorer(unitType)
+		if storer == nil || storer.IsInterfaceNil() {
+			continue
+		}
+
+		err := storer.Has(storerProbeKey)
+		if err != nil && err != storage.ErrKeyNotFound {
+			unavailable = append(unavailable, fmt.Sprintf("%s: %s", name, err.Error()))
+		}
+	}
+
+	if len(unavailable) > 0 {
+		sort.Strings(unavailable)
+		return ProbeResult{
+			Name:    StorersProbeName,
+			Passed:  false,
+			Details: "unavailable storers: " + strings.Join(unavailable, ", "),
+		}
+	}
+
+	return ProbeResult{
+		Name:    StorersProbeName,
+		Passed:  true,
+		Details: "all storers are open",
+	}
+}
+
+func (rc *ReadinessChecker) probePeers() ProbeResult {
+	numPeers := len(rc.peers.ConnectedPeers())
+
+	return ProbeResult{
+		Name:    PeersProbeName,
+		Passed:  uint32(numPeers) >= rc.config.MinConnectedPeers,
+		Details: fmt.Sprintf("connected to %d peers, min connected peers %d", numPeers, rc.config.MinConnectedPeers),
+	}
+}
+
+func (rc *ReadinessChecker) probeConsensusKey() ProbeResult {
+	if rc.nodeType != core.NodeTypeValidator {
+		return ProbeResult{
+			Name:    ConsensusKeyProbeName,
+			Passed:  true,
+			Details: fmt.Sprintf("not required for %s nodes", rc.nodeType),
+		}
+	}
+
+	if rc.privateKey == nil || rc.privateKey.IsInterfaceNil() {
+		return ProbeResult{
+			Name:    ConsensusKeyProbeName,
+			Passed:  false,
+			Details: "consensus key not loaded",
+		}
+	}
+
+	return ProbeResult{
+		Name:    ConsensusKeyProbeName,
+		Passed:  true,
+		Details: "consensus key loaded",
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *ReadinessChecker) IsInterfaceNil() bool {
+	if rc == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,241 @@
+package external_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func createOpenStorer() *mock.StorerStub {
+	return &mock.StorerStub{
+		HasCalled: func(key []byte) error {
+			return storage.ErrKeyNotFound
+		},
+	}
+}
+
+func createPeersCounter(numPeers int) *mock.PeersCounterStub {
+	return &mock.PeersCounterStub{
+		ConnectedPeersCalled: func() []p2p.PeerID {
+			return make([]p2p.PeerID, numPeers)
+		},
+	}
+}
+
+func createMockArgReadinessChecker() external.ArgReadinessChecker {
+	return external.ArgReadinessChecker{
+		BlockChain: &mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: 100}
+			},
+		},
+		ForkDetector: &mock.ForkDetectorMock{
+			ProbableHighestNonceCalled: func() uint64 {
+				return 102
+			},
+		},
+		Store: createChainStorerWithUnits(map[dataRetriever.UnitType]storage.Storer{
+			dataRetriever.TransactionUnit: createOpenStorer(),
+			dataRetriever.BlockHeaderUnit: createOpenStorer(),
+		}),
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		Peers:            createPeersCounter(5),
+		NodeType:         core.NodeTypeValidator,
+		PrivateKey:       &mock.PrivateKeyStub{},
+		Config: config.ReadinessConfig{
+			MaxNoncesBehind:   5,
+			MinConnectedPeers: 3,
+		},
+	}
+}
+
+func findProbe(report *external.ReadinessReport, name string) external.ProbeResult {
+	for _, probe := range report.Probes {
+		if probe.Name == name {
+			return probe
+		}
+	}
+
+	return external.ProbeResult{}
+}
+
+func TestNewReadinessChecker_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgReadinessChecker()
+	args.BlockChain = nil
+	rc, err := external.NewReadinessChecker(args)
+
+	assert.Nil(t, rc)
+	assert.Equal(t, external.ErrNilBlockChain, err)
+}
+
+func TestNewReadinessChecker_NilForkDetectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgReadinessChecker()
+	args.ForkDetector = nil
+	rc, err := external.NewReadinessChecker(args)
+
+	assert.Nil(t, rc)
+	assert.Equal(t, external.ErrNilForkDetector, err)
+}
+
+func TestNewReadinessChecker_NilStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgReadinessChecker()
+	args.Store = nil
+	rc, err := external.NewReadinessChecker(args)
+
+	assert.Nil(t, rc)
+	assert.Equal(t, external.ErrNilStore, err)
+}
+
+func TestNewReadinessChecker_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgReadinessChecker()
+	args.ShardCoordinator = nil
+	rc, err := external.NewReadinessChecker(args)
+
+	assert.Nil(t, rc)
+	assert.Equal(t, external.ErrNilShardCoordinator, err)
+}
+
+func TestNewReadinessChecker_NilPeersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgReadinessChecker()
+	args.Peers = nil
+	rc, err := external.NewReadinessChecker(args)
+
+	assert.Nil(t, rc)
+	assert.Equal(t, external.ErrNilPeersCounter, err)
+}
+
+func TestNewReadinessChecker_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rc, err := external.NewReadinessChecker(createMockArgReadinessChecker())
+
+	assert.NotNil(t, rc)
+	assert.Nil(t, err)
+}
+
+func TestReadinessChecker_CheckReadinessAllProbesPassShouldBeReady(t *testing.T) {
+	t.Parallel()
+
+	rc, _ := external.NewReadinessChecker(createMockArgReadinessChecker())
+	report := rc.CheckReadiness()
+
+	assert.True(t, report.Ready)
+	assert.Equal(t, 4, len(report.Probes))
+	for _, probe := range report.Probes {
+		assert.True(t, probe.Passed, probe.Name)
+	}
+}
+
+func TestReadinessChecker_CheckReadinessTooFarBehindShouldNotBeReady(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgReadinessChecker()
+	args.ForkDetector = &mock.ForkDetectorMock{
+		ProbableHighestNonceCalled: func() uint64 {
+			return 106
+		},
+	}
+	rc, _ := external.NewReadinessChecker(args)
+	report := rc.CheckReadiness()
+
+	assert.False(t, report.Ready)
+	assert.False(t, findProbe(report, external.SyncedProbeName).Passed)
+	assert.True(t, findProbe(report, external.StorersProbeName).Passed)
+}
+
+func TestReadinessChecker_CheckReadinessNoCurrentHeaderShouldCompareGenesisNonce(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgReadinessChecker()
+	args.BlockChain = &mock.BlockChainMock{}
+	args.ForkDetector = &mock.ForkDetectorMock{
+		ProbableHighestNonceCalled: func() uint64 {
+			return 0
+		},
+	}
+	rc, _ := external.NewReadinessChecker(args)
+	report := rc.CheckReadiness()
+
+	assert.True(t, findProbe(report, external.SyncedProbeName).Passed)
+}
+
+func TestReadinessChecker_CheckReadinessClosedStorerShouldNotBeReady(t *testing.T) {
+	t.Parallel()
+
+	errClosed := errors.New("leveldb: closed")
+	args := createMockArgReadinessChecker()
+	args.Store = createChainStorerWithUnits(map[dataRetriever.UnitType]storage.Storer{
+		dataRetriever.TransactionUnit: createOpenStorer(),
+		dataRetriever.BlockHeaderUnit: &mock.StorerStub{
+			HasCalled: func(key []byte) error {
+				return errClosed
+			},
+		},
+	})
+	rc, _ := external.NewReadinessChecker(args)
+	report := rc.CheckReadiness()
+
+	storersProbe := findProbe(report, external.StorersProbeName)
+	assert.False(t, report.Ready)
+	assert.False(t, storersProbe.Passed)
+	assert.True(t, strings.Contains(storersProbe.Details, "BlockHeaderUnit"))
+	assert.False(t, strings.Contains(storersProbe.Details, "TransactionUnit"))
+}
+
+func TestReadinessChecker_CheckReadinessNotEnoughPeersShouldNotBeReady(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgReadinessChecker()
+	args.Peers = createPeersCounter(2)
+	rc, _ := external.NewReadinessChecker(args)
+	report := rc.CheckReadiness()
+
+	assert.False(t, report.Ready)
+	assert.False(t, findProbe(report, external.PeersProbeName).Passed)
+}
+
+func TestReadinessChecker_CheckReadinessValidatorWithoutKeyShouldNotBeReady(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgReadinessChecker()
+	args.PrivateKey = nil
+	rc, _ := external.NewReadinessChecker(args)
+	report := rc.CheckReadiness()
+
+	assert.False(t, report.Ready)
+	assert.False(t, findProbe(report, external.ConsensusKeyProbeName).Passed)
+}
+
+func TestReadinessChecker_CheckReadinessObserverWithoutKeyShouldBeReady(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgReadinessChecker()
+	args.NodeType = core.NodeTypeObserver
+	args.PrivateKey = nil
+	rc, _ := external.NewReadinessChecker(args)
+	report := rc.CheckReadiness()
+
+	assert.True(t, report.Ready)
+	assert.True(t, findProbe(report, external.ConsensusKeyProbeName).Passed)
+}
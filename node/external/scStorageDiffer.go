@@ -0,0 +1,222 @@
+package external
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// StorageKeyChange is a storage key of a smart contract whose value differs between two blocks. The key and the
+// values are hex encoded and an empty value means that the key was not set in that block
+type StorageKeyChange struct {
+	Key      string `json:"key"`
+	OldValue string `json:"oldValue"`
+	NewValue string `json:"newValue"`
+}
+
+// SCStorageDiff holds the storage keys of a smart contract whose values changed between two blocks, sorted by key.
+// The root hashes are the ones of the contract's data trie in the two blocks, empty if the contract had no storage
+type SCStorageDiff struct {
+	Address      string             `json:"address"`
+	FromNonce    uint64             `json:"fromNonce"`
+	ToNonce      uint64             `json:"toNonce"`
+	FromRootHash string             `json:"fromRootHash"`
+	ToRootHash   string             `json:"toRootHash"`
+	Changes      []StorageKeyChange `json:"changes"`
+}
+
+// SCStorageDiffer compares the storage of a smart contract as it was after two blocks of the node's shard. It relies
+// on the historical state roots, so the blocks must be found in the node's storage together with their tries
+type SCStorageDiffer struct {
+	accountsTrie     data.Trie
+	blockChain       data.ChainHandler
+	store            dataRetriever.StorageService
+	shardCoordinator sharding.Coordinator
+	marshalizer      marshal.Marshalizer
+	uint64Converter  typeConverters.Uint64ByteSliceConverter
+}
+
+// NewSCStorageDiffer creates a new SCStorageDiffer instance
+func NewSCStorageDiffer(
+	accountsTrie data.Trie,
+	blockChain data.ChainHandler,
+	store dataRetriever.StorageService,
+	shardCoordinator sharding.Coordinator,
+	marshalizer marshal.Marshalizer,
+	uint64Converter typeConverters.Uint64ByteSliceConverter,
+) (*SCStorageDiffer, error) {
+	if accountsTrie == nil || accountsTrie.IsInterfaceNil() {
+		return nil, ErrNilAccountsTrie
+	}
+	if blockChain == nil || blockChain.IsInterfaceNil() {
+		return nil, ErrNilBlockChain
+	}
+	if store == nil || store.IsInterfaceNil() {
+		return nil, ErrNilStore
+	}
+	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if uint64Converter == nil || uint64Converter.IsInterfaceNil() {
+		return nil, ErrNilUint64Converter
+	}
+
+	return &SCStorageDiffer{
+		accountsTrie:     accountsTrie,
+		blockChain:       blockChain,
+		store:            store,
+		shardCoordinator: shardCoordinator,
+		marshalizer:      marshalizer,
+		uint64Converter:  uint64Converter,
+	}, nil
+}
+
+// StorageDiff returns the storage keys of the smart contract having the provided address whose values are different
+// after the block with toNonce than after the block with fromNonce
+func (ssd *SCStorageDiffer) StorageDiff(address []byte, fromNonce uint64, toNonce uint64) (*SCStorageDiff, error) {
+	if len(address) == 0 {
+		return nil, ErrEmptyAddress
+	}
+	if fromNonce > toNonce {
+		return nil, ErrInvalidNoncesRange
+	}
+	if ssd.shardCoordinator.ComputeId(state.NewAddress(address)) != ssd.shardCoordinator.SelfId() {
+		return nil, ErrAddressFromOtherShard
+	}
+
+	fromRootHash, fromStorage, err := ssd.storageAtNonce(address, fromNonce)
+	if err != nil {
+		return nil, err
+	}
+	toRootHash, toStorage, err := ssd.storageAtNonce(address, toNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SCStorageDiff{
+		Address:      hex.EncodeToString(address),
+		FromNonce:    fromNonce,
+		ToNonce:      toNonce,
+		FromRootHash: hex.EncodeToString(fromRootHash),
+		ToRootHash:   hex.EncodeToString(toRootHash),
+		Changes:      storageChanges(fromStorage, toStorage),
+	}, nil
+}
+
+// storageAtNonce returns the data trie root hash and the storage of the account as they were after the block with
+// the provided nonce. An account missing from the state has no storage
+func (ssd *SCStorageDiffer) storageAtNonce(address []byte, nonce uint64) ([]byte, map[string][]byte, error) {
+	header, err := ssd.headerWithNonce(nonce)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accountsTrie, err := ssd.accountsTrie.Recreate(header.GetRootHash())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accountBytes, err := accountsTrie.Get(address)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(accountBytes) == 0 {
+		return nil, make(map[string][]byte), nil
+	}
+
+	account := &state.Account{}
+	err = ssd.marshalizer.Unmarshal(account, accountBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(account.RootHash) == 0 {
+		return nil, make(map[string][]byte), nil
+	}
+
+	dataTrie, err := ssd.accountsTrie.Recreate(account.RootHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := make(map[string][]byte)
+	err = dataTrie.IterateLeaves(nil, func(key []byte, value []byte) bool {
+		storage[string(key)] = value
+		return true
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return account.RootHash, storage, nil
+}
+
+func (ssd *SCStorageDiffer) headerWithNonce(nonce uint64) (data.HeaderHandler, error) {
+	if nonce == 0 {
+		genesisHeader := ssd.blockChain.GetGenesisHeader()
+		if genesisHeader == nil || genesisHeader.IsInterfaceNil() {
+			return nil, ErrNilGenesisHeader
+		}
+		return genesisHeader, nil
+	}
+
+	shardId := ssd.shardCoordinator.SelfId()
+	if shardId == sharding.MetachainShardId {
+		header, _, err := process.GetMetaHeaderFromStorageWithNonce(nonce, ssd.store, ssd.uint64Converter, ssd.marshalizer)
+		return header, err
+	}
+
+	header, _, err := process.GetShardHeaderFromStorageWithNonce(nonce, shardId, ssd.store, ssd.uint64Converter, ssd.marshalizer)
+	return header, err
+}
+
+func storageChanges(fromStorage map[string][]byte, toStorage map[string][]byte) []StorageKeyChange {
+	changes := make([]StorageKeyChange, 0)
+	for key, oldValue := range fromStorage {
+		newValue := toStorage[key]
+		if bytes.Equal(oldValue, newValue) {
+			continue
+		}
+
+		changes = append(changes, StorageKeyChange{
+			Key:      hex.EncodeToString([]byte(key)),
+			OldValue: hex.EncodeToString(oldValue),
+			NewValue: hex.EncodeToString(newValue),
+		})
+	}
+	for key, newValue := range toStorage {
+		_, existed := fromStorage[key]
+		if existed {
+			continue
+		}
+
+		changes = append(changes, StorageKeyChange{
+			Key:      hex.EncodeToString([]byte(key)),
+			NewValue: hex.EncodeToString(newValue),
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+
+	return changes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ssd *SCStorageDiffer) IsInterfaceNil() bool {
+	if ssd == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,261 @@
+package external_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+)
+
+var scAddress = []byte("sc address")
+
+type scStorageDiffTestEnv struct {
+	memDB       data.DBWriteCacher
+	store       dataRetriever.StorageService
+	blockChain  *mock.BlockChainMock
+	marshalizer *mock.MarshalizerFake
+	hasher      *mock.HasherFake
+}
+
+func createSCStorageDiffTestEnv() *scStorageDiffTestEnv {
+	memDB, _ := memorydb.New()
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.BlockHeaderUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit, mock.NewStorerMock())
+
+	return &scStorageDiffTestEnv{
+		memDB:       memDB,
+		store:       store,
+		blockChain:  &mock.BlockChainMock{},
+		marshalizer: &mock.MarshalizerFake{},
+		hasher:      &mock.HasherFake{},
+	}
+}
+
+func (env *scStorageDiffTestEnv) newTrie() data.Trie {
+	tr, _ := trie.NewTrie(env.memDB, env.marshalizer, env.hasher)
+	return tr
+}
+
+func (env *scStorageDiffTestEnv) createDiffer() *external.SCStorageDiffer {
+	ssd, _ := external.NewSCStorageDiffer(
+		env.newTrie(),
+		env.blockChain,
+		env.store,
+		mock.NewOneShardCoordinatorMock(),
+		env.marshalizer,
+		uint64ByteSlice.NewBigEndianConverter(),
+	)
+
+	return ssd
+}
+
+// commitBlock saves the state holding the smart contract with the provided storage, or no smart contract if the
+// storage is nil, and stores the header with the provided nonce pointing to that state
+func (env *scStorageDiffTestEnv) commitBlock(nonce uint64, storage map[string]string) {
+	accountsTrie := env.newTrie()
+	if storage != nil {
+		account := &state.Account{Nonce: nonce, Balance: big.NewInt(0)}
+		if len(storage) > 0 {
+			dataTrie := env.newTrie()
+			for key, value := range storage {
+				_ = dataTrie.Update([]byte(key), []byte(value))
+			}
+			_ = dataTrie.Commit()
+			account.RootHash, _ = dataTrie.Root()
+		}
+
+		accountBytes, _ := env.marshalizer.Marshal(account)
+		_ = accountsTrie.Update(scAddress, accountBytes)
+	}
+	_ = accountsTrie.Update([]byte("other address"), []byte("other account"))
+	_ = accountsTrie.Commit()
+	rootHash, _ := accountsTrie.Root()
+
+	header := &block.Header{Nonce: nonce, RootHash: rootHash}
+	if nonce == 0 {
+		env.blockChain.GetGenesisHeaderCalled = func() data.HeaderHandler {
+			return header
+		}
+		return
+	}
+
+	buff, _ := env.marshalizer.Marshal(header)
+	hash := env.hasher.Compute(string(buff))
+	_ = env.store.Put(dataRetriever.BlockHeaderUnit, hash, buff)
+	_ = env.store.Put(dataRetriever.ShardHdrNonceHashDataUnit, uint64ByteSlice.NewBigEndianConverter().ToByteSlice(nonce), hash)
+}
+
+func TestNewSCStorageDiffer_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	env := createSCStorageDiffTestEnv()
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+
+	ssd, err := external.NewSCStorageDiffer(nil, env.blockChain, env.store, shardCoordinator, env.marshalizer, converter)
+	assert.Nil(t, ssd)
+	assert.Equal(t, external.ErrNilAccountsTrie, err)
+
+	ssd, err = external.NewSCStorageDiffer(env.newTrie(), nil, env.store, shardCoordinator, env.marshalizer, converter)
+	assert.Nil(t, ssd)
+	assert.Equal(t, external.ErrNilBlockChain, err)
+
+	ssd, err = external.NewSCStorageDiffer(env.newTrie(), env.blockChain, nil, shardCoordinator, env.marshalizer, converter)
+	assert.Nil(t, ssd)
+	assert.Equal(t, external.ErrNilStore, err)
+
+	ssd, err = external.NewSCStorageDiffer(env.newTrie(), env.blockChain, env.store, nil, env.marshalizer, converter)
+	assert.Nil(t, ssd)
+	assert.Equal(t, external.ErrNilShardCoordinator, err)
+
+	ssd, err = external.NewSCStorageDiffer(env.newTrie(), env.blockChain, env.store, shardCoordinator, nil, converter)
+	assert.Nil(t, ssd)
+	assert.Equal(t, external.ErrNilMarshalizer, err)
+
+	ssd, err = external.NewSCStorageDiffer(env.newTrie(), env.blockChain, env.store, shardCoordinator, env.marshalizer, nil)
+	assert.Nil(t, ssd)
+	assert.Equal(t, external.ErrNilUint64Converter, err)
+}
+
+func TestNewSCStorageDiffer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ssd := createSCStorageDiffTestEnv().createDiffer()
+
+	assert.NotNil(t, ssd)
+	assert.False(t, ssd.IsInterfaceNil())
+}
+
+func TestSCStorageDiffer_StorageDiffEmptyAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssd := createSCStorageDiffTestEnv().createDiffer()
+
+	diff, err := ssd.StorageDiff(nil, 1, 2)
+
+	assert.Nil(t, diff)
+	assert.Equal(t, external.ErrEmptyAddress, err)
+}
+
+func TestSCStorageDiffer_StorageDiffInvalidRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ssd := createSCStorageDiffTestEnv().createDiffer()
+
+	diff, err := ssd.StorageDiff(scAddress, 3, 2)
+
+	assert.Nil(t, diff)
+	assert.Equal(t, external.ErrInvalidNoncesRange, err)
+}
+
+func TestSCStorageDiffer_StorageDiffAddressFromOtherShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	env := createSCStorageDiffTestEnv()
+	shardCoordinator := mock.NewMultipleShardsCoordinatorMock()
+	shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+		return 1
+	}
+	ssd, _ := external.NewSCStorageDiffer(
+		env.newTrie(),
+		env.blockChain,
+		env.store,
+		shardCoordinator,
+		env.marshalizer,
+		uint64ByteSlice.NewBigEndianConverter(),
+	)
+
+	diff, err := ssd.StorageDiff(scAddress, 1, 2)
+
+	assert.Nil(t, diff)
+	assert.Equal(t, external.ErrAddressFromOtherShard, err)
+}
+
+func TestSCStorageDiffer_StorageDiffMissingHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	env := createSCStorageDiffTestEnv()
+	env.commitBlock(1, map[string]string{"key": "value"})
+	ssd := env.createDiffer()
+
+	diff, err := ssd.StorageDiff(scAddress, 1, 2)
+
+	assert.Nil(t, diff)
+	assert.NotNil(t, err)
+}
+
+func TestSCStorageDiffer_StorageDiffShouldReturnChangedKeys(t *testing.T) {
+	t.Parallel()
+
+	env := createSCStorageDiffTestEnv()
+	env.commitBlock(1, map[string]string{"unchanged": "value", "changed": "old", "removed": "value"})
+	env.commitBlock(2, map[string]string{"unchanged": "value", "changed": "new", "added": "value"})
+	ssd := env.createDiffer()
+
+	diff, err := ssd.StorageDiff(scAddress, 1, 2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(scAddress), diff.Address)
+	assert.Equal(t, uint64(1), diff.FromNonce)
+	assert.Equal(t, uint64(2), diff.ToNonce)
+	assert.NotEmpty(t, diff.FromRootHash)
+	assert.NotEmpty(t, diff.ToRootHash)
+	assert.NotEqual(t, diff.FromRootHash, diff.ToRootHash)
+
+	hexOf := func(s string) string {
+		return hex.EncodeToString([]byte(s))
+	}
+	expectedChanges := []external.StorageKeyChange{
+		{Key: hexOf("added"), NewValue: hexOf("value")},
+		{Key: hexOf("changed"), OldValue: hexOf("old"), NewValue: hexOf("new")},
+		{Key: hexOf("removed"), OldValue: hexOf("value")},
+	}
+	assert.Equal(t, expectedChanges, diff.Changes)
+}
+
+func TestSCStorageDiffer_StorageDiffSameBlockShouldReturnNoChanges(t *testing.T) {
+	t.Parallel()
+
+	env := createSCStorageDiffTestEnv()
+	env.commitBlock(1, map[string]string{"key": "value"})
+	ssd := env.createDiffer()
+
+	diff, err := ssd.StorageDiff(scAddress, 1, 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(diff.Changes))
+}
+
+func TestSCStorageDiffer_StorageDiffFromGenesisWithoutContractShouldReturnAllKeys(t *testing.T) {
+	t.Parallel()
+
+	env := createSCStorageDiffTestEnv()
+	env.commitBlock(0, nil)
+	env.commitBlock(1, map[string]string{})
+	env.commitBlock(2, map[string]string{"key": "value"})
+	ssd := env.createDiffer()
+
+	diff, err := ssd.StorageDiff(scAddress, 0, 2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "", diff.FromRootHash)
+	assert.Equal(t, []external.StorageKeyChange{
+		{Key: hex.EncodeToString([]byte("key")), NewValue: hex.EncodeToString([]byte("value"))},
+	}, diff.Changes)
+
+	diff, err = ssd.StorageDiff(scAddress, 0, 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(diff.Changes))
+}
@@ -0,0 +1,126 @@
+package external
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// StorageUnitStats holds the information exposed about one of the node's storage units
+type StorageUnitStats struct {
+	Name        string `json:"name"`
+	UnitType    uint8  `json:"unitType"`
+	CachedItems int    `json:"cachedItems"`
+}
+
+// cachedItemsCounter is implemented by the storers able to report how many items are held in their cache
+type cachedItemsCounter interface {
+	CachedItems() int
+}
+
+var baseUnitNames = map[dataRetriever.UnitType]string{
+	dataRetriever.TransactionUnit:          "TransactionUnit",
+	dataRetriever.MiniBlockUnit:            "MiniBlockUnit",
+	dataRetriever.PeerChangesUnit:          "PeerChangesUnit",
+	dataRetriever.BlockHeaderUnit:          "BlockHeaderUnit",
+	dataRetriever.MetaBlockUnit:            "MetaBlockUnit",
+	dataRetriever.MetaShardDataUnit:        "MetaShardDataUnit",
+	dataRetriever.MetaPeerDataUnit:         "MetaPeerDataUnit",
+	dataRetriever.UnsignedTransactionUnit:  "UnsignedTransactionUnit",
+	dataRetriever.RewardTransactionUnit:    "RewardTransactionUnit",
+	dataRetriever.MetaHdrNonceHashDataUnit: "MetaHdrNonceHashDataUnit",
+	dataRetriever.HeartbeatUnit:            "HeartbeatUnit",
+	dataRetriever.SCStateChangesAuditUnit:  "SCStateChangesAuditUnit",
+	dataRetriever.SCDeploymentsUnit:        "SCDeploymentsUnit",
+}
+
+// StorageUnitsQuerier gives read-only access, by unit name, to the raw entries held in the node's storage units.
+// It is meant to be used by operators for debugging data availability on a running node
+type StorageUnitsQuerier struct {
+	store       dataRetriever.StorageService
+	unitsByName map[string]dataRetriever.UnitType
+}
+
+// NewStorageUnitsQuerier creates a new StorageUnitsQuerier instance
+func NewStorageUnitsQuerier(
+	store dataRetriever.StorageService,
+	shardCoordinator sharding.Coordinator,
+) (*StorageUnitsQuerier, error) {
+	if store == nil || store.IsInterfaceNil() {
+		return nil, ErrNilStore
+	}
+	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+
+	return &StorageUnitsQuerier{
+		store:       store,
+		unitsByName: createUnitsByName(shardCoordinator),
+	}, nil
+}
+
+func createUnitsByName(shardCoordinator sharding.Coordinator) map[string]dataRetriever.UnitType {
+	unitsByName := make(map[string]dataRetriever.UnitType)
+	for unitType, name := range baseUnitNames {
+		unitsByName[name] = unitType
+	}
+	for shardId := uint32(0); shardId < shardCoordinator.NumberOfShards(); shardId++ {
+		name := fmt.Sprintf("ShardHdrNonceHashDataUnit_%d", shardId)
+		unitsByName[name] = dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardId)
+	}
+
+	return unitsByName
+}
+
+// GetEntry returns the raw value stored under the provided key in the storage unit with the given name
+func (suq *StorageUnitsQuerier) GetEntry(unitName string, key []byte) ([]byte, error) {
+	unitType, ok := suq.unitsByName[unitName]
+	if !ok {
+		return nil, ErrUnknownStorageUnit
+	}
+
+	storer := suq.store.GetStorer(unitType)
+	if storer == nil || storer.IsInterfaceNil() {
+		return nil, ErrUnknownStorageUnit
+	}
+
+	return storer.Get(key)
+}
+
+// UnitsStats returns the name and stats of all the storage units created by this node, sorted by name
+func (suq *StorageUnitsQuerier) UnitsStats() []StorageUnitStats {
+	stats := make([]StorageUnitStats, 0, len(suq.unitsByName))
+	for name, unitType := range suq.unitsByName {
+		storer := suq.store.GetStorer(unitType)
+		if storer == nil || storer.IsInterfaceNil() {
+			continue
+		}
+
+		unitStats := StorageUnitStats{
+			Name:     name,
+			UnitType: uint8(unitType),
+		}
+		counter, ok := storer.(cachedItemsCounter)
+		if ok {
+			unitStats.CachedItems = counter.CachedItems()
+		}
+
+		stats = append(stats, unitStats)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Name < stats[j].Name
+	})
+
+	return stats
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (suq *StorageUnitsQuerier) IsInterfaceNil() bool {
+	if suq == nil {
+		return true
+	}
+	return false
+}
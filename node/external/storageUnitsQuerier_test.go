@@ -0,0 +1,126 @@
+package external_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+type storerWithCache struct {
+	mock.StorerStub
+	cachedItems int
+}
+
+func (swc *storerWithCache) CachedItems() int {
+	return swc.cachedItems
+}
+
+func createChainStorerWithUnits(units map[dataRetriever.UnitType]storage.Storer) *mock.ChainStorerMock {
+	return &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			storer, ok := units[unitType]
+			if !ok {
+				return nil
+			}
+			return storer
+		},
+	}
+}
+
+func TestNewStorageUnitsQuerier_NilStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	suq, err := external.NewStorageUnitsQuerier(nil, mock.NewOneShardCoordinatorMock())
+
+	assert.Nil(t, suq)
+	assert.Equal(t, external.ErrNilStore, err)
+}
+
+func TestNewStorageUnitsQuerier_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	suq, err := external.NewStorageUnitsQuerier(&mock.ChainStorerMock{}, nil)
+
+	assert.Nil(t, suq)
+	assert.Equal(t, external.ErrNilShardCoordinator, err)
+}
+
+func TestNewStorageUnitsQuerier_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	suq, err := external.NewStorageUnitsQuerier(&mock.ChainStorerMock{}, mock.NewOneShardCoordinatorMock())
+
+	assert.NotNil(t, suq)
+	assert.Nil(t, err)
+}
+
+func TestStorageUnitsQuerier_GetEntryUnknownNameShouldErr(t *testing.T) {
+	t.Parallel()
+
+	suq, _ := external.NewStorageUnitsQuerier(&mock.ChainStorerMock{}, mock.NewOneShardCoordinatorMock())
+
+	value, err := suq.GetEntry("NotAUnit", []byte("key"))
+
+	assert.Nil(t, value)
+	assert.Equal(t, external.ErrUnknownStorageUnit, err)
+}
+
+func TestStorageUnitsQuerier_GetEntryMissingUnitShouldErr(t *testing.T) {
+	t.Parallel()
+
+	store := createChainStorerWithUnits(make(map[dataRetriever.UnitType]storage.Storer))
+	suq, _ := external.NewStorageUnitsQuerier(store, mock.NewOneShardCoordinatorMock())
+
+	value, err := suq.GetEntry("MetaBlockUnit", []byte("key"))
+
+	assert.Nil(t, value)
+	assert.Equal(t, external.ErrUnknownStorageUnit, err)
+}
+
+func TestStorageUnitsQuerier_GetEntryShouldReturnStorerValue(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := errors.New("not found")
+	store := createChainStorerWithUnits(map[dataRetriever.UnitType]storage.Storer{
+		dataRetriever.ShardHdrNonceHashDataUnit: &mock.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				if string(key) == "key" {
+					return []byte("value"), nil
+				}
+				return nil, errNotFound
+			},
+		},
+	})
+	suq, _ := external.NewStorageUnitsQuerier(store, mock.NewOneShardCoordinatorMock())
+
+	value, err := suq.GetEntry("ShardHdrNonceHashDataUnit_0", []byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	value, err = suq.GetEntry("ShardHdrNonceHashDataUnit_0", []byte("missing"))
+	assert.Nil(t, value)
+	assert.Equal(t, errNotFound, err)
+}
+
+func TestStorageUnitsQuerier_UnitsStatsShouldListOnlyExistingUnitsSorted(t *testing.T) {
+	t.Parallel()
+
+	store := createChainStorerWithUnits(map[dataRetriever.UnitType]storage.Storer{
+		dataRetriever.TransactionUnit: &storerWithCache{cachedItems: 7},
+		dataRetriever.BlockHeaderUnit: &mock.StorerStub{},
+	})
+	suq, _ := external.NewStorageUnitsQuerier(store, mock.NewOneShardCoordinatorMock())
+
+	stats := suq.UnitsStats()
+
+	expected := []external.StorageUnitStats{
+		{Name: "BlockHeaderUnit", UnitType: uint8(dataRetriever.BlockHeaderUnit), CachedItems: 0},
+		{Name: "TransactionUnit", UnitType: uint8(dataRetriever.TransactionUnit), CachedItems: 7},
+	}
+	assert.Equal(t, expected, stats)
+}
@@ -0,0 +1,205 @@
+package external
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// EpochEarnings holds the rewards credited to an address in one epoch and the number of reward transactions
+// that credited them
+type EpochEarnings struct {
+	Epoch        uint32 `json:"epoch"`
+	NumRewardTxs int    `json:"numRewardTxs"`
+	Rewards      string `json:"rewards"`
+}
+
+// ValidatorEarnings holds the rewards credited to a rewards address in each epoch of a range, sorted by epoch, and
+// their totals over the whole range. Epochs without rewards are not listed
+type ValidatorEarnings struct {
+	Address      string          `json:"address"`
+	FromEpoch    uint32          `json:"fromEpoch"`
+	ToEpoch      uint32          `json:"toEpoch"`
+	Epochs       []EpochEarnings `json:"epochs"`
+	NumRewardTxs int             `json:"numRewardTxs"`
+	TotalRewards string          `json:"totalRewards"`
+}
+
+// ValidatorEarningsReporter computes the rewards credited to a rewards address by walking back the blocks of the
+// node's shard found in storage and summing the reward transactions of the rewards miniblocks they executed
+type ValidatorEarningsReporter struct {
+	blockChain       data.ChainHandler
+	store            dataRetriever.StorageService
+	shardCoordinator sharding.Coordinator
+	marshalizer      marshal.Marshalizer
+	uint64Converter  typeConverters.Uint64ByteSliceConverter
+}
+
+// NewValidatorEarningsReporter creates a new ValidatorEarningsReporter instance
+func NewValidatorEarningsReporter(
+	blockChain data.ChainHandler,
+	store dataRetriever.StorageService,
+	shardCoordinator sharding.Coordinator,
+	marshalizer marshal.Marshalizer,
+	uint64Converter typeConverters.Uint64ByteSliceConverter,
+) (*ValidatorEarningsReporter, error) {
+	if blockChain == nil || blockChain.IsInterfaceNil() {
+		return nil, ErrNilBlockChain
+	}
+	if store == nil || store.IsInterfaceNil() {
+		return nil, ErrNilStore
+	}
+	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
+		return nil, ErrNilShardCoordinator
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if uint64Converter == nil || uint64Converter.IsInterfaceNil() {
+		return nil, ErrNilUint64Converter
+	}
+
+	return &ValidatorEarningsReporter{
+		blockChain:       blockChain,
+		store:            store,
+		shardCoordinator: shardCoordinator,
+		marshalizer:      marshalizer,
+		uint64Converter:  uint64Converter,
+	}, nil
+}
+
+// Earnings returns the rewards credited to the provided rewards address in each epoch between fromEpoch and toEpoch,
+// both included. The blocks are walked back from the current one until a block older than fromEpoch is reached
+func (ver *ValidatorEarningsReporter) Earnings(address []byte, fromEpoch uint32, toEpoch uint32) (*ValidatorEarnings, error) {
+	if len(address) == 0 {
+		return nil, ErrEmptyAddress
+	}
+	if fromEpoch > toEpoch {
+		return nil, ErrInvalidEpochsRange
+	}
+	shardId := ver.shardCoordinator.SelfId()
+	if ver.shardCoordinator.ComputeId(state.NewAddress(address)) != shardId {
+		return nil, ErrAddressFromOtherShard
+	}
+
+	earningsByEpoch := make(map[uint32]*EpochEarnings)
+	rewardsByEpoch := make(map[uint32]*big.Int)
+
+	currentHeader := ver.blockChain.GetCurrentBlockHeader()
+	if currentHeader != nil && !currentHeader.IsInterfaceNil() {
+		for nonce := currentHeader.GetNonce(); nonce > 0; nonce-- {
+			header, _, err := process.GetShardHeaderFromStorageWithNonce(nonce, shardId, ver.store, ver.uint64Converter, ver.marshalizer)
+			if err != nil {
+				return nil, err
+			}
+			if header.Epoch < fromEpoch {
+				break
+			}
+
+			rewardTxs, err := ver.rewardTxsFromHeader(header)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, rtx := range rewardTxs {
+				if rtx.Epoch < fromEpoch || rtx.Epoch > toEpoch || rtx.Value == nil {
+					continue
+				}
+				if !bytes.Equal(rtx.RcvAddr, address) {
+					continue
+				}
+
+				epochEarnings, ok := earningsByEpoch[rtx.Epoch]
+				if !ok {
+					epochEarnings = &EpochEarnings{Epoch: rtx.Epoch}
+					earningsByEpoch[rtx.Epoch] = epochEarnings
+					rewardsByEpoch[rtx.Epoch] = big.NewInt(0)
+				}
+				epochEarnings.NumRewardTxs++
+				rewardsByEpoch[rtx.Epoch].Add(rewardsByEpoch[rtx.Epoch], rtx.Value)
+			}
+		}
+	}
+
+	earnings := &ValidatorEarnings{
+		Address:   hex.EncodeToString(address),
+		FromEpoch: fromEpoch,
+		ToEpoch:   toEpoch,
+		Epochs:    make([]EpochEarnings, 0, len(earningsByEpoch)),
+	}
+	totalRewards := big.NewInt(0)
+	for epoch, epochEarnings := range earningsByEpoch {
+		epochEarnings.Rewards = rewardsByEpoch[epoch].String()
+		earnings.Epochs = append(earnings.Epochs, *epochEarnings)
+		earnings.NumRewardTxs += epochEarnings.NumRewardTxs
+		totalRewards.Add(totalRewards, rewardsByEpoch[epoch])
+	}
+	earnings.TotalRewards = totalRewards.String()
+
+	sort.Slice(earnings.Epochs, func(i, j int) bool {
+		return earnings.Epochs[i].Epoch < earnings.Epochs[j].Epoch
+	})
+
+	return earnings, nil
+}
+
+// rewardTxsFromHeader returns the reward transactions of the rewards miniblocks executed by the block, meaning the
+// ones having this shard as destination. The outgoing rewards miniblocks are counted by their destination shard
+func (ver *ValidatorEarningsReporter) rewardTxsFromHeader(header *block.Header) ([]*rewardTx.RewardTx, error) {
+	rewardTxs := make([]*rewardTx.RewardTx, 0)
+	for _, miniBlockHeader := range header.MiniBlockHeaders {
+		if miniBlockHeader.Type != block.RewardsBlock {
+			continue
+		}
+		if miniBlockHeader.ReceiverShardID != ver.shardCoordinator.SelfId() {
+			continue
+		}
+
+		miniBlockBytes, err := ver.store.Get(dataRetriever.MiniBlockUnit, miniBlockHeader.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = ver.marshalizer.Unmarshal(miniBlock, miniBlockBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		rewardTxsBytes, err := ver.store.GetAll(dataRetriever.RewardTransactionUnit, miniBlock.TxHashes)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rewardTxBytes := range rewardTxsBytes {
+			rtx := &rewardTx.RewardTx{}
+			err = ver.marshalizer.Unmarshal(rtx, rewardTxBytes)
+			if err != nil {
+				return nil, err
+			}
+
+			rewardTxs = append(rewardTxs, rtx)
+		}
+	}
+
+	return rewardTxs, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ver *ValidatorEarningsReporter) IsInterfaceNil() bool {
+	if ver == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,250 @@
+package external_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+var rewardsAddress = []byte("rewards address")
+
+type validatorEarningsTestEnv struct {
+	store       dataRetriever.StorageService
+	blockChain  *mock.BlockChainMock
+	marshalizer *mock.MarshalizerFake
+	hasher      *mock.HasherFake
+}
+
+func createValidatorEarningsTestEnv() *validatorEarningsTestEnv {
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.BlockHeaderUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.MiniBlockUnit, mock.NewStorerMock())
+	store.AddStorer(dataRetriever.RewardTransactionUnit, mock.NewStorerMock())
+
+	return &validatorEarningsTestEnv{
+		store:       store,
+		blockChain:  &mock.BlockChainMock{},
+		marshalizer: &mock.MarshalizerFake{},
+		hasher:      &mock.HasherFake{},
+	}
+}
+
+func (env *validatorEarningsTestEnv) createReporter() *external.ValidatorEarningsReporter {
+	ver, _ := external.NewValidatorEarningsReporter(
+		env.blockChain,
+		env.store,
+		mock.NewOneShardCoordinatorMock(),
+		env.marshalizer,
+		uint64ByteSlice.NewBigEndianConverter(),
+	)
+
+	return ver
+}
+
+func (env *validatorEarningsTestEnv) put(unit dataRetriever.UnitType, object interface{}) []byte {
+	buff, _ := env.marshalizer.Marshal(object)
+	hash := env.hasher.Compute(string(buff))
+	_ = env.store.Put(unit, hash, buff)
+
+	return hash
+}
+
+// commitBlock stores the header with the provided nonce and epoch, each of its rewards miniblocks holding the reward
+// transactions mapped to the miniblock's destination shard. The header becomes the current block header
+func (env *validatorEarningsTestEnv) commitBlock(nonce uint64, epoch uint32, rewardTxs map[uint32][]*rewardTx.RewardTx) {
+	header := &block.Header{Nonce: nonce, Epoch: epoch}
+	for receiverShardId, txs := range rewardTxs {
+		miniBlock := &block.MiniBlock{ReceiverShardID: receiverShardId, Type: block.RewardsBlock}
+		for _, rtx := range txs {
+			miniBlock.TxHashes = append(miniBlock.TxHashes, env.put(dataRetriever.RewardTransactionUnit, rtx))
+		}
+
+		header.MiniBlockHeaders = append(header.MiniBlockHeaders, block.MiniBlockHeader{
+			Hash:            env.put(dataRetriever.MiniBlockUnit, miniBlock),
+			ReceiverShardID: receiverShardId,
+			TxCount:         uint32(len(txs)),
+			Type:            block.RewardsBlock,
+		})
+	}
+
+	hash := env.put(dataRetriever.BlockHeaderUnit, header)
+	_ = env.store.Put(dataRetriever.ShardHdrNonceHashDataUnit, uint64ByteSlice.NewBigEndianConverter().ToByteSlice(nonce), hash)
+	env.blockChain.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return header
+	}
+}
+
+func createRewardTx(epoch uint32, value int64, address []byte) *rewardTx.RewardTx {
+	return &rewardTx.RewardTx{
+		Round:   uint64(epoch),
+		Epoch:   epoch,
+		Value:   big.NewInt(value),
+		RcvAddr: address,
+	}
+}
+
+func TestNewValidatorEarningsReporter_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	env := createValidatorEarningsTestEnv()
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+
+	ver, err := external.NewValidatorEarningsReporter(nil, env.store, shardCoordinator, env.marshalizer, converter)
+	assert.Nil(t, ver)
+	assert.Equal(t, external.ErrNilBlockChain, err)
+
+	ver, err = external.NewValidatorEarningsReporter(env.blockChain, nil, shardCoordinator, env.marshalizer, converter)
+	assert.Nil(t, ver)
+	assert.Equal(t, external.ErrNilStore, err)
+
+	ver, err = external.NewValidatorEarningsReporter(env.blockChain, env.store, nil, env.marshalizer, converter)
+	assert.Nil(t, ver)
+	assert.Equal(t, external.ErrNilShardCoordinator, err)
+
+	ver, err = external.NewValidatorEarningsReporter(env.blockChain, env.store, shardCoordinator, nil, converter)
+	assert.Nil(t, ver)
+	assert.Equal(t, external.ErrNilMarshalizer, err)
+
+	ver, err = external.NewValidatorEarningsReporter(env.blockChain, env.store, shardCoordinator, env.marshalizer, nil)
+	assert.Nil(t, ver)
+	assert.Equal(t, external.ErrNilUint64Converter, err)
+}
+
+func TestNewValidatorEarningsReporter_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ver := createValidatorEarningsTestEnv().createReporter()
+
+	assert.NotNil(t, ver)
+	assert.False(t, ver.IsInterfaceNil())
+}
+
+func TestValidatorEarningsReporter_EarningsInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ver := createValidatorEarningsTestEnv().createReporter()
+
+	earnings, err := ver.Earnings(nil, 1, 2)
+	assert.Nil(t, earnings)
+	assert.Equal(t, external.ErrEmptyAddress, err)
+
+	earnings, err = ver.Earnings(rewardsAddress, 3, 2)
+	assert.Nil(t, earnings)
+	assert.Equal(t, external.ErrInvalidEpochsRange, err)
+}
+
+func TestValidatorEarningsReporter_EarningsAddressFromOtherShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	env := createValidatorEarningsTestEnv()
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+		return 1
+	}
+	ver, _ := external.NewValidatorEarningsReporter(env.blockChain, env.store, shardCoordinator, env.marshalizer, uint64ByteSlice.NewBigEndianConverter())
+
+	earnings, err := ver.Earnings(rewardsAddress, 1, 2)
+
+	assert.Nil(t, earnings)
+	assert.Equal(t, external.ErrAddressFromOtherShard, err)
+}
+
+func TestValidatorEarningsReporter_EarningsNoBlocksShouldReturnEmptyReport(t *testing.T) {
+	t.Parallel()
+
+	ver := createValidatorEarningsTestEnv().createReporter()
+
+	earnings, err := ver.Earnings(rewardsAddress, 0, 2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &external.ValidatorEarnings{
+		Address:      hex.EncodeToString(rewardsAddress),
+		FromEpoch:    0,
+		ToEpoch:      2,
+		Epochs:       make([]external.EpochEarnings, 0),
+		TotalRewards: "0",
+	}, earnings)
+}
+
+func TestValidatorEarningsReporter_EarningsShouldAggregatePerEpoch(t *testing.T) {
+	t.Parallel()
+
+	otherAddress := []byte("other address")
+	env := createValidatorEarningsTestEnv()
+	env.commitBlock(1, 0, map[uint32][]*rewardTx.RewardTx{
+		0: {createRewardTx(0, 5, rewardsAddress)},
+	})
+	env.commitBlock(2, 1, map[uint32][]*rewardTx.RewardTx{
+		0: {createRewardTx(1, 10, rewardsAddress), createRewardTx(1, 7, otherAddress)},
+		1: {createRewardTx(1, 100, rewardsAddress)},
+	})
+	env.commitBlock(3, 2, map[uint32][]*rewardTx.RewardTx{
+		0: {createRewardTx(2, 20, rewardsAddress), createRewardTx(1, 1, rewardsAddress)},
+	})
+	env.commitBlock(4, 3, map[uint32][]*rewardTx.RewardTx{
+		0: {createRewardTx(3, 40, rewardsAddress)},
+	})
+	ver := env.createReporter()
+
+	earnings, err := ver.Earnings(rewardsAddress, 1, 2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &external.ValidatorEarnings{
+		Address:   hex.EncodeToString(rewardsAddress),
+		FromEpoch: 1,
+		ToEpoch:   2,
+		Epochs: []external.EpochEarnings{
+			{Epoch: 1, NumRewardTxs: 2, Rewards: "11"},
+			{Epoch: 2, NumRewardTxs: 1, Rewards: "20"},
+		},
+		NumRewardTxs: 3,
+		TotalRewards: "31",
+	}, earnings)
+
+	earnings, err = ver.Earnings(rewardsAddress, 0, 0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []external.EpochEarnings{{Epoch: 0, NumRewardTxs: 1, Rewards: "5"}}, earnings.Epochs)
+	assert.Equal(t, "5", earnings.TotalRewards)
+}
+
+func TestValidatorEarningsReporter_EarningsMissingHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	env := createValidatorEarningsTestEnv()
+	env.commitBlock(2, 0, nil)
+	ver := env.createReporter()
+
+	earnings, err := ver.Earnings(rewardsAddress, 0, 0)
+
+	assert.Nil(t, earnings)
+	assert.NotNil(t, err)
+}
+
+func TestValidatorEarningsReporter_EarningsMissingMiniBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	env := createValidatorEarningsTestEnv()
+	env.commitBlock(1, 0, map[uint32][]*rewardTx.RewardTx{
+		0: {createRewardTx(0, 5, rewardsAddress)},
+	})
+	env.store.AddStorer(dataRetriever.MiniBlockUnit, mock.NewStorerMock())
+	ver := env.createReporter()
+
+	earnings, err := ver.Earnings(rewardsAddress, 0, 0)
+
+	assert.Nil(t, earnings)
+	assert.NotNil(t, err)
+}
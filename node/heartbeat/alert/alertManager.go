@@ -0,0 +1,118 @@
+package alert
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+)
+
+var log = logger.DefaultLogger()
+
+// alertManager filters the alerts produced by the heartbeat monitor, keeping only the ones regarding the watched
+// public keys, debounces them and dispatches them to all the configured notifiers
+type alertManager struct {
+	watchedPubKeys   map[string]struct{}
+	debounceDuration time.Duration
+	timer            heartbeat.Timer
+	notifiers        []Notifier
+
+	mutLastAlerts sync.Mutex
+	lastAlerts    map[string]time.Time
+}
+
+// NewAlertManager creates a new alert manager instance. The watched public keys should be hex encoded
+func NewAlertManager(
+	watchedPubKeys []string,
+	debounceDuration time.Duration,
+	timer heartbeat.Timer,
+	notifiers []Notifier,
+) (*alertManager, error) {
+
+	if len(watchedPubKeys) == 0 {
+		return nil, ErrEmptyWatchedPublicKeys
+	}
+	if debounceDuration < 0 {
+		return nil, ErrInvalidDebounceDuration
+	}
+	if timer == nil || timer.IsInterfaceNil() {
+		return nil, ErrNilTimer
+	}
+	if len(notifiers) == 0 {
+		return nil, ErrNoNotifiers
+	}
+	for _, notifier := range notifiers {
+		if notifier == nil || notifier.IsInterfaceNil() {
+			return nil, ErrNilNotifier
+		}
+	}
+
+	am := &alertManager{
+		watchedPubKeys:   make(map[string]struct{}, len(watchedPubKeys)),
+		debounceDuration: debounceDuration,
+		timer:            timer,
+		notifiers:        notifiers,
+		lastAlerts:       make(map[string]time.Time),
+	}
+	for _, pubKey := range watchedPubKeys {
+		am.watchedPubKeys[strings.ToLower(pubKey)] = struct{}{}
+	}
+
+	return am, nil
+}
+
+// HandleAlert dispatches the alert to all notifiers if it regards a watched public key and a similar alert has not
+// been dispatched in the last debounce duration. Notifiers are called on separate go routines
+func (am *alertManager) HandleAlert(alert *heartbeat.Alert) {
+	if alert == nil {
+		return
+	}
+
+	_, isWatched := am.watchedPubKeys[strings.ToLower(alert.HexPublicKey)]
+	if !isWatched {
+		return
+	}
+
+	if am.isDebounced(alert) {
+		log.Debug(fmt.Sprintf("heartbeat alert %s for %s debounced", alert.Type, alert.HexPublicKey))
+		return
+	}
+
+	for _, notifier := range am.notifiers {
+		go am.notify(notifier, alert)
+	}
+}
+
+func (am *alertManager) isDebounced(alert *heartbeat.Alert) bool {
+	key := alert.HexPublicKey + string(alert.Type)
+	crtTime := am.timer.Now()
+
+	am.mutLastAlerts.Lock()
+	defer am.mutLastAlerts.Unlock()
+
+	lastAlertTime, ok := am.lastAlerts[key]
+	if ok && crtTime.Sub(lastAlertTime) < am.debounceDuration {
+		return true
+	}
+
+	am.lastAlerts[key] = crtTime
+	return false
+}
+
+func (am *alertManager) notify(notifier Notifier, alert *heartbeat.Alert) {
+	err := notifier.Notify(alert)
+	if err != nil {
+		log.Error(fmt.Sprintf("heartbeat alert notifier %s failed: %s", notifier.Name(), err.Error()))
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (am *alertManager) IsInterfaceNil() bool {
+	if am == nil {
+		return true
+	}
+	return false
+}
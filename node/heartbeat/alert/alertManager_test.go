@@ -0,0 +1,132 @@
+package alert_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat/alert"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAlertManager_EmptyWatchedPublicKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	am, err := alert.NewAlertManager(nil, time.Second, &mock.MockTimer{}, []alert.Notifier{&mock.AlertNotifierStub{}})
+
+	assert.Nil(t, am)
+	assert.Equal(t, alert.ErrEmptyWatchedPublicKeys, err)
+}
+
+func TestNewAlertManager_NegativeDebounceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	am, err := alert.NewAlertManager([]string{"aa"}, -time.Second, &mock.MockTimer{}, []alert.Notifier{&mock.AlertNotifierStub{}})
+
+	assert.Nil(t, am)
+	assert.Equal(t, alert.ErrInvalidDebounceDuration, err)
+}
+
+func TestNewAlertManager_NilTimerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	am, err := alert.NewAlertManager([]string{"aa"}, time.Second, nil, []alert.Notifier{&mock.AlertNotifierStub{}})
+
+	assert.Nil(t, am)
+	assert.Equal(t, alert.ErrNilTimer, err)
+}
+
+func TestNewAlertManager_NoNotifiersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	am, err := alert.NewAlertManager([]string{"aa"}, time.Second, &mock.MockTimer{}, nil)
+
+	assert.Nil(t, am)
+	assert.Equal(t, alert.ErrNoNotifiers, err)
+}
+
+func TestNewAlertManager_NilNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	am, err := alert.NewAlertManager([]string{"aa"}, time.Second, &mock.MockTimer{}, []alert.Notifier{nil})
+
+	assert.Nil(t, am)
+	assert.Equal(t, alert.ErrNilNotifier, err)
+}
+
+func TestNewAlertManager_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	am, err := alert.NewAlertManager([]string{"aa"}, time.Second, &mock.MockTimer{}, []alert.Notifier{&mock.AlertNotifierStub{}})
+
+	assert.NotNil(t, am)
+	assert.Nil(t, err)
+}
+
+func TestAlertManager_HandleAlertNotWatchedShouldNotNotify(t *testing.T) {
+	t.Parallel()
+
+	numCalls := int32(0)
+	notifier := &mock.AlertNotifierStub{
+		NotifyCalled: func(alert *heartbeat.Alert) error {
+			atomic.AddInt32(&numCalls, 1)
+			return nil
+		},
+	}
+	am, _ := alert.NewAlertManager([]string{"aa"}, time.Second, &mock.MockTimer{}, []alert.Notifier{notifier})
+
+	am.HandleAlert(&heartbeat.Alert{Type: heartbeat.AlertPeerInactive, HexPublicKey: "bb"})
+	time.Sleep(time.Millisecond * 100)
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&numCalls))
+}
+
+func TestAlertManager_HandleAlertWatchedShouldNotifyAll(t *testing.T) {
+	t.Parallel()
+
+	numCalls := int32(0)
+	notifier := &mock.AlertNotifierStub{
+		NotifyCalled: func(alert *heartbeat.Alert) error {
+			atomic.AddInt32(&numCalls, 1)
+			return nil
+		},
+	}
+	am, _ := alert.NewAlertManager([]string{"AA"}, time.Second, &mock.MockTimer{}, []alert.Notifier{notifier, notifier})
+
+	am.HandleAlert(&heartbeat.Alert{Type: heartbeat.AlertPeerInactive, HexPublicKey: "aa"})
+	time.Sleep(time.Millisecond * 100)
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&numCalls))
+}
+
+func TestAlertManager_HandleAlertShouldDebounce(t *testing.T) {
+	t.Parallel()
+
+	numCalls := int32(0)
+	notifier := &mock.AlertNotifierStub{
+		NotifyCalled: func(alert *heartbeat.Alert) error {
+			atomic.AddInt32(&numCalls, 1)
+			return nil
+		},
+	}
+	timer := &mock.MockTimer{}
+	am, _ := alert.NewAlertManager([]string{"aa"}, time.Second*10, timer, []alert.Notifier{notifier})
+
+	am.HandleAlert(&heartbeat.Alert{Type: heartbeat.AlertPeerInactive, HexPublicKey: "aa"})
+	timer.IncrementSeconds(5)
+	am.HandleAlert(&heartbeat.Alert{Type: heartbeat.AlertPeerInactive, HexPublicKey: "aa"})
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numCalls))
+
+	// a different alert type for the same public key is not debounced
+	am.HandleAlert(&heartbeat.Alert{Type: heartbeat.AlertShardChanged, HexPublicKey: "aa"})
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&numCalls))
+
+	timer.IncrementSeconds(5)
+	am.HandleAlert(&heartbeat.Alert{Type: heartbeat.AlertPeerInactive, HexPublicKey: "aa"})
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&numCalls))
+}
@@ -0,0 +1,80 @@
+package alert
+
+import (
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+)
+
+// emailNotifier sends the alerts as plain text e-mails through a configured SMTP server
+type emailNotifier struct {
+	smtpServer string
+	from       string
+	to         []string
+	auth       smtp.Auth
+	sendMail   func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// NewEmailNotifier creates a notifier which sends the alerts as e-mails. The SMTP server address should be provided
+// as host:port. If the username is empty, no authentication will be used
+func NewEmailNotifier(
+	smtpServer string,
+	username string,
+	password string,
+	from string,
+	to []string,
+) (*emailNotifier, error) {
+
+	if len(smtpServer) == 0 {
+		return nil, ErrEmptySmtpServer
+	}
+	if len(to) == 0 {
+		return nil, ErrEmptyEmailRecipients
+	}
+
+	en := &emailNotifier{
+		smtpServer: smtpServer,
+		from:       from,
+		to:         to,
+		sendMail:   smtp.SendMail,
+	}
+
+	if len(username) > 0 {
+		host, _, err := net.SplitHostPort(smtpServer)
+		if err != nil {
+			return nil, err
+		}
+
+		en.auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return en, nil
+}
+
+// Notify sends the alert as an e-mail to all configured recipients
+func (en *emailNotifier) Notify(alert *heartbeat.Alert) error {
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s",
+		en.from,
+		strings.Join(en.to, ", "),
+		alertSubject(alert),
+		strings.Replace(alertMessage(alert), "\n", "\r\n", -1),
+	)
+
+	return en.sendMail(en.smtpServer, en.auth, en.from, en.to, []byte(msg))
+}
+
+// Name returns the notifier's name
+func (en *emailNotifier) Name() string {
+	return "email"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (en *emailNotifier) IsInterfaceNil() bool {
+	if en == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+package alert
+
+import "errors"
+
+// ErrEmptyWatchedPublicKeys signals that no public key to be watched has been provided
+var ErrEmptyWatchedPublicKeys = errors.New("empty watched public keys list")
+
+// ErrNoNotifiers signals that no notifier has been provided
+var ErrNoNotifiers = errors.New("no notifiers provided")
+
+// ErrNilNotifier signals that a nil notifier has been provided
+var ErrNilNotifier = errors.New("nil notifier")
+
+// ErrNilTimer signals that a nil timer has been provided
+var ErrNilTimer = errors.New("nil timer")
+
+// ErrInvalidDebounceDuration signals that a negative debounce duration has been provided
+var ErrInvalidDebounceDuration = errors.New("invalid debounce duration")
+
+// ErrEmptyWebhookURL signals that an empty webhook URL has been provided
+var ErrEmptyWebhookURL = errors.New("empty webhook URL")
+
+// ErrEmptySmtpServer signals that an empty SMTP server address has been provided
+var ErrEmptySmtpServer = errors.New("empty SMTP server address")
+
+// ErrEmptyEmailRecipients signals that no e-mail recipient has been provided
+var ErrEmptyEmailRecipients = errors.New("empty e-mail recipients list")
+
+// ErrEmptyCommand signals that an empty command has been provided
+var ErrEmptyCommand = errors.New("empty command")
+
+// ErrUnexpectedStatusCode signals that the webhook endpoint responded with a non-success status code
+var ErrUnexpectedStatusCode = errors.New("unexpected status code")
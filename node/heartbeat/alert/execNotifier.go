@@ -0,0 +1,69 @@
+package alert
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+)
+
+// execNotifier runs a configured command for each alert. The alert details are provided to the command as
+// environment variables
+type execNotifier struct {
+	command string
+	args    []string
+}
+
+// NewExecNotifier creates a notifier which runs the provided command, with the provided arguments, for each alert
+func NewExecNotifier(command string, args []string) (*execNotifier, error) {
+	if len(command) == 0 {
+		return nil, ErrEmptyCommand
+	}
+
+	return &execNotifier{
+		command: command,
+		args:    args,
+	}, nil
+}
+
+// Notify runs the command and waits for it to finish
+func (en *execNotifier) Notify(alert *heartbeat.Alert) error {
+	cmd := exec.Command(en.command, en.args...)
+	cmd.Env = append(os.Environ(), alertEnvironment(alert)...)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		log.Debug(fmt.Sprintf("heartbeat alert command output: %s", string(output)))
+		return err
+	}
+
+	return nil
+}
+
+func alertEnvironment(alert *heartbeat.Alert) []string {
+	return []string{
+		fmt.Sprintf("HEARTBEAT_ALERT_TYPE=%s", alert.Type),
+		fmt.Sprintf("HEARTBEAT_ALERT_PUBKEY=%s", alert.HexPublicKey),
+		fmt.Sprintf("HEARTBEAT_ALERT_NODE_NAME=%s", alert.NodeDisplayName),
+		fmt.Sprintf("HEARTBEAT_ALERT_COMPUTED_SHARD=%d", alert.ComputedShardID),
+		fmt.Sprintf("HEARTBEAT_ALERT_PREVIOUS_SHARD=%d", alert.PreviousShardID),
+		fmt.Sprintf("HEARTBEAT_ALERT_RECEIVED_SHARD=%d", alert.ReceivedShardID),
+		fmt.Sprintf("HEARTBEAT_ALERT_LAST_HEARTBEAT=%d", alert.LastHeartbeat.Unix()),
+		fmt.Sprintf("HEARTBEAT_ALERT_TIMESTAMP=%d", alert.TimeStamp.Unix()),
+		fmt.Sprintf("HEARTBEAT_ALERT_MESSAGE=%s", alertMessage(alert)),
+	}
+}
+
+// Name returns the notifier's name
+func (en *execNotifier) Name() string {
+	return "exec"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (en *execNotifier) IsInterfaceNil() bool {
+	if en == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,9 @@
+package alert
+
+import (
+	"net/smtp"
+)
+
+func (en *emailNotifier) SetSendMail(sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error) {
+	en.sendMail = sendMail
+}
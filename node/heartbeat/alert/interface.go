@@ -0,0 +1,12 @@
+package alert
+
+import (
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+)
+
+// Notifier defines a driver able to deliver a heartbeat alert to an external system
+type Notifier interface {
+	Notify(alert *heartbeat.Alert) error
+	Name() string
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,31 @@
+package alert
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+)
+
+func alertSubject(alert *heartbeat.Alert) string {
+	return fmt.Sprintf("[heartbeat] %s: %s", alert.Type, alert.HexPublicKey)
+}
+
+func alertMessage(alert *heartbeat.Alert) string {
+	return fmt.Sprintf("public key: %s\n"+
+		"node name: %s\n"+
+		"alert: %s\n"+
+		"computed shard ID: %d\n"+
+		"previous shard ID: %d\n"+
+		"received shard ID: %d\n"+
+		"last heartbeat: %s\n"+
+		"detected at: %s\n",
+		alert.HexPublicKey,
+		alert.NodeDisplayName,
+		alert.Type,
+		alert.ComputedShardID,
+		alert.PreviousShardID,
+		alert.ReceivedShardID,
+		alert.LastHeartbeat.String(),
+		alert.TimeStamp.String(),
+	)
+}
@@ -0,0 +1,119 @@
+package alert_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat/alert"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWebhookNotifier_EmptyURLShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wn, err := alert.NewWebhookNotifier("")
+
+	assert.Nil(t, wn)
+	assert.Equal(t, alert.ErrEmptyWebhookURL, err)
+}
+
+func TestWebhookNotifier_NotifyShouldPostAlert(t *testing.T) {
+	t.Parallel()
+
+	var received heartbeat.Alert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	wn, _ := alert.NewWebhookNotifier(server.URL)
+	err := wn.Notify(&heartbeat.Alert{Type: heartbeat.AlertPeerInactive, HexPublicKey: "aa"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, heartbeat.AlertPeerInactive, received.Type)
+	assert.Equal(t, "aa", received.HexPublicKey)
+}
+
+func TestWebhookNotifier_NotifyErrorStatusShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	wn, _ := alert.NewWebhookNotifier(server.URL)
+	err := wn.Notify(&heartbeat.Alert{})
+
+	assert.Equal(t, alert.ErrUnexpectedStatusCode, err)
+}
+
+func TestNewEmailNotifier_EmptySmtpServerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	en, err := alert.NewEmailNotifier("", "", "", "from@example.com", []string{"to@example.com"})
+
+	assert.Nil(t, en)
+	assert.Equal(t, alert.ErrEmptySmtpServer, err)
+}
+
+func TestNewEmailNotifier_EmptyRecipientsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	en, err := alert.NewEmailNotifier("localhost:25", "", "", "from@example.com", nil)
+
+	assert.Nil(t, en)
+	assert.Equal(t, alert.ErrEmptyEmailRecipients, err)
+}
+
+func TestNewEmailNotifier_InvalidServerWithAuthShouldErr(t *testing.T) {
+	t.Parallel()
+
+	en, err := alert.NewEmailNotifier("localhost", "user", "pass", "from@example.com", []string{"to@example.com"})
+
+	assert.Nil(t, en)
+	assert.NotNil(t, err)
+}
+
+func TestEmailNotifier_NotifyShouldSendMail(t *testing.T) {
+	t.Parallel()
+
+	en, _ := alert.NewEmailNotifier("localhost:25", "", "", "from@example.com", []string{"to@example.com"})
+	sentMsg := ""
+	en.SetSendMail(func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		sentMsg = string(msg)
+		return nil
+	})
+
+	err := en.Notify(&heartbeat.Alert{Type: heartbeat.AlertShardChanged, HexPublicKey: "aa"})
+
+	assert.Nil(t, err)
+	assert.True(t, strings.Contains(sentMsg, "Subject: [heartbeat] shard changed: aa"))
+}
+
+func TestNewExecNotifier_EmptyCommandShouldErr(t *testing.T) {
+	t.Parallel()
+
+	en, err := alert.NewExecNotifier("", nil)
+
+	assert.Nil(t, en)
+	assert.Equal(t, alert.ErrEmptyCommand, err)
+}
+
+func TestExecNotifier_NotifyShouldPassAlertAsEnvironment(t *testing.T) {
+	t.Parallel()
+
+	en, _ := alert.NewExecNotifier("sh", []string{"-c", "test \"$HEARTBEAT_ALERT_PUBKEY\" = \"aa\""})
+
+	err := en.Notify(&heartbeat.Alert{HexPublicKey: "aa"})
+	assert.Nil(t, err)
+
+	err = en.Notify(&heartbeat.Alert{HexPublicKey: "bb"})
+	assert.NotNil(t, err)
+}
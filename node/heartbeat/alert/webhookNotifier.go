@@ -0,0 +1,67 @@
+package alert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+)
+
+const webhookTimeout = time.Second * 10
+
+// webhookNotifier posts the alerts as JSON objects to a configured URL
+type webhookNotifier struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewWebhookNotifier creates a notifier which posts the alerts as JSON objects to the provided URL
+func NewWebhookNotifier(url string) (*webhookNotifier, error) {
+	if len(url) == 0 {
+		return nil, ErrEmptyWebhookURL
+	}
+
+	return &webhookNotifier{
+		url:        url,
+		httpClient: &http.Client{Timeout: webhookTimeout},
+	}, nil
+}
+
+// Notify posts the alert to the configured URL
+func (wn *webhookNotifier) Notify(alert *heartbeat.Alert) error {
+	buff, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	response, err := wn.httpClient.Post(wn.url, "application/json", bytes.NewReader(buff))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		log.Debug(fmt.Sprintf("heartbeat alert webhook responded with status code %d", response.StatusCode))
+		return ErrUnexpectedStatusCode
+	}
+
+	return nil
+}
+
+// Name returns the notifier's name
+func (wn *webhookNotifier) Name() string {
+	return "webhook"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wn *webhookNotifier) IsInterfaceNil() bool {
+	if wn == nil {
+		return true
+	}
+	return false
+}
@@ -58,3 +58,9 @@ var ErrUnmarshalGenesisTime = errors.New("monitor: can't unmarshal genesis time"
 
 // ErrMarshalGenesisTime signals that the marshaling of the genesis time didn't work
 var ErrMarshalGenesisTime = errors.New("monitor: can't marshal genesis time")
+
+// ErrNilAlertHandler signals that a nil alert handler has been provided
+var ErrNilAlertHandler = errors.New("nil alert handler")
+
+// ErrNilResourceStatsHandler signals that a nil resource stats handler has been provided
+var ErrNilResourceStatsHandler = errors.New("nil resource stats handler")
@@ -21,6 +21,7 @@ type heartbeatMessageInfo struct {
 	isValidator        bool
 	lastUptimeDowntime time.Time
 	genesisTime        time.Time
+	resourceStats      *NodeResourceStats
 }
 
 // newHeartbeatMessageInfo returns a new instance of a heartbeatMessageInfo
@@ -14,6 +14,26 @@ type Heartbeat struct {
 	NodeDisplayName string
 }
 
+// HeartbeatPayloadVersion is the version of the payload sent by this node in its heartbeat messages. Payloads
+// without a version are sent by older nodes and contain only a timestamp
+const HeartbeatPayloadVersion = uint32(1)
+
+// HeartbeatPayload represents the versioned content of the Heartbeat.Payload field
+type HeartbeatPayload struct {
+	Version       uint32
+	Timestamp     int64
+	ResourceStats *NodeResourceStats
+}
+
+// NodeResourceStats holds the optional resource data a node advertises in its heartbeat messages so that the
+// struggling machines can be spotted network-wide. Loads and free space are sent as classes, not exact values
+type NodeResourceStats struct {
+	PeakMemory    uint64 `json:"peakMemory"`
+	CpuLoadClass  string `json:"cpuLoadClass"`
+	DiskFreeClass string `json:"diskFreeClass"`
+	SyncState     string `json:"syncState"`
+}
+
 // PubKeyHeartbeat returns the heartbeat status for a public key
 type PubKeyHeartbeat struct {
 	HexPublicKey    string    `json:"hexPublicKey"`
@@ -27,6 +47,8 @@ type PubKeyHeartbeat struct {
 	VersionNumber   string    `json:"versionNumber"`
 	IsValidator     bool      `json:"isValidator"`
 	NodeDisplayName string    `json:"nodeDisplayName"`
+
+	ResourceStats *NodeResourceStats `json:"resourceStats,omitempty"`
 }
 
 // HeartbeatDTO is the struct used for handling DB operations for heartbeatMessageInfo struct
@@ -44,4 +66,26 @@ type HeartbeatDTO struct {
 	IsValidator                 bool
 	LastUptimeDowntime          time.Time
 	GenesisTime                 time.Time
+	ResourceStats               *NodeResourceStats
+}
+
+// AlertType defines the kind of event that triggered an alert
+type AlertType string
+
+// AlertPeerInactive signals that a peer which was active has not sent any heartbeat for too long
+const AlertPeerInactive AlertType = "peer inactive"
+
+// AlertShardChanged signals that a peer has started to advertise a different shard ID than before
+const AlertShardChanged AlertType = "shard changed"
+
+// Alert holds the details of a peer state transition detected by the heartbeat monitor
+type Alert struct {
+	Type            AlertType `json:"type"`
+	HexPublicKey    string    `json:"hexPublicKey"`
+	NodeDisplayName string    `json:"nodeDisplayName"`
+	ComputedShardID uint32    `json:"computedShardID"`
+	PreviousShardID uint32    `json:"previousShardID"`
+	ReceivedShardID uint32    `json:"receivedShardID"`
+	LastHeartbeat   time.Time `json:"lastHeartbeat"`
+	TimeStamp       time.Time `json:"timeStamp"`
 }
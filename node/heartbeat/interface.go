@@ -34,3 +34,16 @@ type HeartbeatStorageHandler interface {
 	SaveKeys(peersSlice [][]byte) error
 	IsInterfaceNil() bool
 }
+
+// AlertHandler defines what an alert handler should do when the heartbeat monitor detects
+// a peer state transition. Implementations should not block the caller
+type AlertHandler interface {
+	HandleAlert(alert *Alert)
+	IsInterfaceNil() bool
+}
+
+// ResourceStatsHandler defines what a provider of the resource data sent in the heartbeat messages should do
+type ResourceStatsHandler interface {
+	ResourceStats() *NodeResourceStats
+	IsInterfaceNil() bool
+}
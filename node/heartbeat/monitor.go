@@ -2,6 +2,7 @@ package heartbeat
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"sort"
@@ -28,6 +29,7 @@ type Monitor struct {
 	fullPeersSlice              [][]byte
 	mutPubKeysMap               sync.RWMutex
 	appStatusHandler            core.AppStatusHandler
+	alertHandler                AlertHandler
 	genesisTime                 time.Time
 	messageHandler              MessageHandler
 	storer                      HeartbeatStorageHandler
@@ -159,9 +161,28 @@ func (m *Monitor) SetAppStatusHandler(ash core.AppStatusHandler) error {
 	return nil
 }
 
+// SetAlertHandler will set the AlertHandler which will be notified each time a peer becomes inactive or changes
+// its shard
+func (m *Monitor) SetAlertHandler(alertHandler AlertHandler) error {
+	if alertHandler == nil || alertHandler.IsInterfaceNil() {
+		return ErrNilAlertHandler
+	}
+
+	m.mutHeartbeatMessages.Lock()
+	m.alertHandler = alertHandler
+	m.mutHeartbeatMessages.Unlock()
+
+	return nil
+}
+
 // ProcessReceivedMessage satisfies the p2p.MessageProcessor interface so it can be called
 // by the p2p subsystem each time a new heartbeat message arrives
-func (m *Monitor) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (m *Monitor) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
+	err := ctx.Err()
+	if err != nil {
+		return err
+	}
+
 	hbRecv, err := m.messageHandler.CreateHeartbeatFromP2pMessage(message)
 	if err != nil {
 		return err
@@ -196,7 +217,13 @@ func (m *Monitor) addHeartbeatMessageToMap(hb *Heartbeat) {
 	}
 
 	computedShardID := m.computeShardID(pubKeyStr)
+	previousShardID := hbmi.receivedShardID
+	hadReceivedHeartbeat := !hbmi.timeStamp.Equal(hbmi.genesisTime)
 	hbmi.HeartbeatReceived(computedShardID, hb.ShardID, hb.VersionNumber, hb.NodeDisplayName)
+	hbmi.resourceStats = m.extractResourceStats(hb.Payload)
+	if hadReceivedHeartbeat && previousShardID != hbmi.receivedShardID {
+		m.sendAlert(AlertShardChanged, pubKeyStr, hbmi, previousShardID)
+	}
 	hbDTO := m.convertToExportedStruct(hbmi)
 	err := m.storer.SavePubkeyData(hb.Pubkey, &hbDTO)
 	if err != nil {
@@ -205,6 +232,22 @@ func (m *Monitor) addHeartbeatMessageToMap(hb *Heartbeat) {
 	m.addPeerToFullPeersSlice(hb.Pubkey)
 }
 
+// extractResourceStats returns the resource data contained in a heartbeat payload or nil if the payload was sent by
+// an older node or the sender did not include any resource data
+func (m *Monitor) extractResourceStats(payload []byte) *NodeResourceStats {
+	if len(payload) == 0 {
+		return nil
+	}
+
+	hbPayload := &HeartbeatPayload{}
+	err := m.marshalizer.Unmarshal(hbPayload, payload)
+	if err != nil || hbPayload.Version < HeartbeatPayloadVersion {
+		return nil
+	}
+
+	return hbPayload.ResourceStats
+}
+
 func (m *Monitor) addPeerToFullPeersSlice(pubKey []byte) {
 	if !m.isPeerInFullPeersSlice(pubKey) {
 		m.fullPeersSlice = append(m.fullPeersSlice, pubKey)
@@ -245,8 +288,12 @@ func (m *Monitor) computeShardID(pubkey string) uint32 {
 func (m *Monitor) computeAllHeartbeatMessages() {
 	counterActiveValidators := 0
 	counterConnectedNodes := 0
-	for _, v := range m.heartbeatMessages {
+	for k, v := range m.heartbeatMessages {
+		wasActive := v.isActive
 		v.computeActive(m.timer.Now())
+		if wasActive && !v.isActive {
+			m.sendAlert(AlertPeerInactive, k, v, v.receivedShardID)
+		}
 
 		if v.isActive {
 			counterConnectedNodes++
@@ -261,6 +308,23 @@ func (m *Monitor) computeAllHeartbeatMessages() {
 	m.appStatusHandler.SetUInt64Value(core.MetricConnectedNodes, uint64(counterConnectedNodes))
 }
 
+func (m *Monitor) sendAlert(alertType AlertType, pubKey string, hbmi *heartbeatMessageInfo, previousShardID uint32) {
+	if m.alertHandler == nil {
+		return
+	}
+
+	m.alertHandler.HandleAlert(&Alert{
+		Type:            alertType,
+		HexPublicKey:    hex.EncodeToString([]byte(pubKey)),
+		NodeDisplayName: hbmi.nodeDisplayName,
+		ComputedShardID: hbmi.computedShardID,
+		PreviousShardID: previousShardID,
+		ReceivedShardID: hbmi.receivedShardID,
+		LastHeartbeat:   hbmi.timeStamp,
+		TimeStamp:       m.timer.Now(),
+	})
+}
+
 // GetHeartbeats returns the heartbeat status
 func (m *Monitor) GetHeartbeats() []PubKeyHeartbeat {
 	m.mutHeartbeatMessages.Lock()
@@ -282,6 +346,7 @@ func (m *Monitor) GetHeartbeats() []PubKeyHeartbeat {
 			VersionNumber:   v.versionNumber,
 			IsValidator:     v.isValidator,
 			NodeDisplayName: v.nodeDisplayName,
+			ResourceStats:   v.resourceStats,
 		}
 		idx++
 	}
@@ -316,6 +381,7 @@ func (m *Monitor) convertToExportedStruct(v *heartbeatMessageInfo) HeartbeatDTO
 		NodeDisplayName:    v.nodeDisplayName,
 		LastUptimeDowntime: v.lastUptimeDowntime,
 		GenesisTime:        v.genesisTime,
+		ResourceStats:      v.resourceStats,
 	}
 }
 
@@ -334,6 +400,7 @@ func (m *Monitor) convertFromExportedStruct(hbDTO HeartbeatDTO, maxDuration time
 		isValidator:                 hbDTO.IsValidator,
 		lastUptimeDowntime:          hbDTO.LastUptimeDowntime,
 		genesisTime:                 hbDTO.GenesisTime,
+		resourceStats:               hbDTO.ResourceStats,
 	}
 
 	return hbmi
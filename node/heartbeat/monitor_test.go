@@ -1,6 +1,7 @@
 package heartbeat_test
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -228,7 +229,7 @@ func TestMonitor_ProcessReceivedMessageShouldWork(t *testing.T) {
 		Pubkey: []byte(pubKey),
 	}
 	hbBytes, _ := json.Marshal(hb)
-	err := mon.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: hbBytes})
+	err := mon.ProcessReceivedMessage(context.Background(), &mock.P2PMessageStub{DataField: hbBytes})
 	assert.Nil(t, err)
 
 	//a delay is mandatory for the go routine to finish its job
@@ -286,7 +287,7 @@ func TestMonitor_ProcessReceivedMessageWithNewPublicKey(t *testing.T) {
 		Pubkey: []byte(pubKey),
 	}
 	hbBytes, _ := json.Marshal(hb)
-	err := mon.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: hbBytes})
+	err := mon.ProcessReceivedMessage(context.Background(), &mock.P2PMessageStub{DataField: hbBytes})
 	assert.Nil(t, err)
 
 	//a delay is mandatory for the go routine to finish its job
@@ -353,7 +354,7 @@ func TestMonitor_ProcessReceivedMessageWithNewShardID(t *testing.T) {
 	buffToSend, err := json.Marshal(hb)
 	assert.Nil(t, err)
 
-	err = mon.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: buffToSend})
+	err = mon.ProcessReceivedMessage(context.Background(), &mock.P2PMessageStub{DataField: buffToSend})
 	assert.Nil(t, err)
 
 	//a delay is mandatory for the go routine to finish its job
@@ -373,7 +374,7 @@ func TestMonitor_ProcessReceivedMessageWithNewShardID(t *testing.T) {
 
 	assert.Nil(t, err)
 
-	err = mon.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: buffToSend})
+	err = mon.ProcessReceivedMessage(context.Background(), &mock.P2PMessageStub{DataField: buffToSend})
 
 	time.Sleep(1 * time.Second)
 
@@ -447,11 +448,163 @@ func TestMonitor_ProcessReceivedMessageShouldSetPeerInactive(t *testing.T) {
 	assert.False(t, hbStatus[1].IsActive)
 }
 
+func createMonitorForAlerts(th *mock.MockTimer, pubKeys []string) *heartbeat.Monitor {
+	storer, _ := storage.NewHeartbeatDbStorer(mock.NewStorerMock(), &mock.MarshalizerFake{})
+	mon, _ := heartbeat.NewMonitor(
+		&mock.MarshalizerMock{},
+		time.Second*5,
+		map[uint32][]string{0: pubKeys},
+		th.Now(),
+		&mock.MessageHandlerStub{
+			CreateHeartbeatFromP2pMessageCalled: func(message p2p.MessageP2P) (*heartbeat.Heartbeat, error) {
+				var rcvHb heartbeat.Heartbeat
+				_ = json.Unmarshal(message.Data(), &rcvHb)
+				return &rcvHb, nil
+			},
+		},
+		storer,
+		th,
+	)
+
+	return mon
+}
+
+func TestMonitor_SetAlertHandlerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mon := createMonitorForAlerts(&mock.MockTimer{}, []string{"pk1"})
+
+	err := mon.SetAlertHandler(nil)
+	assert.Equal(t, heartbeat.ErrNilAlertHandler, err)
+}
+
+func TestMonitor_PeerGoingInactiveShouldAlert(t *testing.T) {
+	t.Parallel()
+
+	pubKey1 := "pk1-should-stay-online"
+	pubKey2 := "pk2-should-go-offline"
+	th := &mock.MockTimer{}
+	mon := createMonitorForAlerts(th, []string{pubKey1, pubKey2})
+
+	alerts := make([]*heartbeat.Alert, 0)
+	_ = mon.SetAlertHandler(&mock.AlertHandlerStub{
+		HandleAlertCalled: func(alert *heartbeat.Alert) {
+			alerts = append(alerts, alert)
+		},
+	})
+
+	th.IncrementSeconds(1)
+	mon.AddHeartbeatMessageToMap(&heartbeat.Heartbeat{Pubkey: []byte(pubKey1)})
+	mon.AddHeartbeatMessageToMap(&heartbeat.Heartbeat{Pubkey: []byte(pubKey2)})
+	_ = mon.GetHeartbeats()
+	assert.Equal(t, 0, len(alerts))
+
+	th.IncrementSeconds(4)
+	mon.AddHeartbeatMessageToMap(&heartbeat.Heartbeat{Pubkey: []byte(pubKey1)})
+	th.IncrementSeconds(3)
+	_ = mon.GetHeartbeats()
+	// the transition is reported only once
+	_ = mon.GetHeartbeats()
+
+	assert.Equal(t, 1, len(alerts))
+	assert.Equal(t, heartbeat.AlertPeerInactive, alerts[0].Type)
+	assert.Equal(t, hex.EncodeToString([]byte(pubKey2)), alerts[0].HexPublicKey)
+}
+
+func TestMonitor_PeerChangingShardShouldAlert(t *testing.T) {
+	t.Parallel()
+
+	pubKey := "pk1"
+	th := &mock.MockTimer{}
+	mon := createMonitorForAlerts(th, []string{pubKey})
+
+	alerts := make([]*heartbeat.Alert, 0)
+	_ = mon.SetAlertHandler(&mock.AlertHandlerStub{
+		HandleAlertCalled: func(alert *heartbeat.Alert) {
+			alerts = append(alerts, alert)
+		},
+	})
+
+	th.IncrementSeconds(1)
+	mon.AddHeartbeatMessageToMap(&heartbeat.Heartbeat{Pubkey: []byte(pubKey), ShardID: 0})
+	th.IncrementSeconds(1)
+	mon.AddHeartbeatMessageToMap(&heartbeat.Heartbeat{Pubkey: []byte(pubKey), ShardID: 0})
+	assert.Equal(t, 0, len(alerts))
+
+	th.IncrementSeconds(1)
+	mon.AddHeartbeatMessageToMap(&heartbeat.Heartbeat{Pubkey: []byte(pubKey), ShardID: 1})
+
+	assert.Equal(t, 1, len(alerts))
+	assert.Equal(t, heartbeat.AlertShardChanged, alerts[0].Type)
+	assert.Equal(t, uint32(0), alerts[0].PreviousShardID)
+	assert.Equal(t, uint32(1), alerts[0].ReceivedShardID)
+	assert.Equal(t, uint32(0), alerts[0].ComputedShardID)
+}
+
 func sendHbMessageFromPubKey(pubKey string, mon *heartbeat.Monitor) error {
 	hb := &heartbeat.Heartbeat{
 		Pubkey: []byte(pubKey),
 	}
 	buffToSend, _ := json.Marshal(hb)
-	err := mon.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: buffToSend})
+	err := mon.ProcessReceivedMessage(context.Background(), &mock.P2PMessageStub{DataField: buffToSend})
 	return err
 }
+
+func TestMonitor_HeartbeatWithResourceStatsShouldBeSurfacedInGetHeartbeats(t *testing.T) {
+	t.Parallel()
+
+	pubKey := "pk1"
+	th := &mock.MockTimer{}
+	storer, _ := storage.NewHeartbeatDbStorer(mock.NewStorerMock(), &mock.MarshalizerFake{})
+	mon, _ := heartbeat.NewMonitor(
+		&mock.MarshalizerFake{},
+		time.Second*5,
+		map[uint32][]string{0: {pubKey}},
+		th.Now(),
+		&mock.MessageHandlerStub{},
+		storer,
+		th,
+	)
+	resourceStats := &heartbeat.NodeResourceStats{
+		PeakMemory:    1024,
+		CpuLoadClass:  heartbeat.ResourceClassMedium,
+		DiskFreeClass: heartbeat.ResourceClassHigh,
+		SyncState:     heartbeat.SyncStateSynchronized,
+	}
+	payload, _ := json.Marshal(&heartbeat.HeartbeatPayload{
+		Version:       heartbeat.HeartbeatPayloadVersion,
+		ResourceStats: resourceStats,
+	})
+
+	th.IncrementSeconds(1)
+	mon.AddHeartbeatMessageToMap(&heartbeat.Heartbeat{Pubkey: []byte(pubKey), Payload: payload})
+	hbStatus := mon.GetHeartbeats()
+
+	assert.Equal(t, 1, len(hbStatus))
+	assert.Equal(t, resourceStats, hbStatus[0].ResourceStats)
+}
+
+func TestMonitor_HeartbeatWithLegacyPayloadShouldNotHaveResourceStats(t *testing.T) {
+	t.Parallel()
+
+	pubKey := "pk1"
+	th := &mock.MockTimer{}
+	storer, _ := storage.NewHeartbeatDbStorer(mock.NewStorerMock(), &mock.MarshalizerFake{})
+	mon, _ := heartbeat.NewMonitor(
+		&mock.MarshalizerFake{},
+		time.Second*5,
+		map[uint32][]string{0: {pubKey}},
+		th.Now(),
+		&mock.MessageHandlerStub{},
+		storer,
+		th,
+	)
+
+	th.IncrementSeconds(1)
+	legacyPayload := []byte(th.Now().String())
+	mon.AddHeartbeatMessageToMap(&heartbeat.Heartbeat{Pubkey: []byte(pubKey), Payload: legacyPayload})
+	hbStatus := mon.GetHeartbeats()
+
+	assert.Equal(t, 1, len(hbStatus))
+	assert.Nil(t, hbStatus[0].ResourceStats)
+}
@@ -0,0 +1,130 @@
+package heartbeat
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/shirou/gopsutil/cpu"
+	"github.com/shirou/gopsutil/disk"
+)
+
+const (
+	// ResourceClassUnknown is used when the resource usage could not be determined
+	ResourceClassUnknown = "unknown"
+	// ResourceClassLow defines a low resource usage or a low amount of free resource
+	ResourceClassLow = "low"
+	// ResourceClassMedium defines a medium resource usage or a medium amount of free resource
+	ResourceClassMedium = "medium"
+	// ResourceClassHigh defines a high resource usage or a high amount of free resource
+	ResourceClassHigh = "high"
+)
+
+const (
+	// SyncStateUnknown is used when the synchronization state of the node could not be determined
+	SyncStateUnknown = "unknown"
+	// SyncStateSyncing defines a node which is behind the network and is synchronizing
+	SyncStateSyncing = "syncing"
+	// SyncStateSynchronized defines a node which is synchronized with the network
+	SyncStateSynchronized = "synchronized"
+)
+
+const mediumCpuLoadPercent = 50
+const highCpuLoadPercent = 80
+const mediumDiskFreePercent = 10
+const highDiskFreePercent = 25
+
+// ResourceStatsCollector gathers the resource data of the current node that is sent in the heartbeat messages
+type ResourceStatsCollector struct {
+	mutStats         sync.Mutex
+	diskPath         string
+	peakMemory       uint64
+	isSyncingHandler func() bool
+}
+
+// NewResourceStatsCollector creates a new resource stats collector. The free disk space is measured for the partition
+// holding the provided path. The isSyncingHandler may be nil, in which case the sync state is reported as unknown
+func NewResourceStatsCollector(diskPath string, isSyncingHandler func() bool) *ResourceStatsCollector {
+	return &ResourceStatsCollector{
+		diskPath:         diskPath,
+		isSyncingHandler: isSyncingHandler,
+	}
+}
+
+// ResourceStats returns the resource data of the current node. The cpu load class is computed for the whole machine
+// over the time elapsed since the previous call
+func (rsc *ResourceStatsCollector) ResourceStats() *NodeResourceStats {
+	rsc.mutStats.Lock()
+	defer rsc.mutStats.Unlock()
+
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	if memStats.Sys > rsc.peakMemory {
+		rsc.peakMemory = memStats.Sys
+	}
+
+	return &NodeResourceStats{
+		PeakMemory:    rsc.peakMemory,
+		CpuLoadClass:  rsc.cpuLoadClass(),
+		DiskFreeClass: rsc.diskFreeClass(),
+		SyncState:     rsc.syncState(),
+	}
+}
+
+func (rsc *ResourceStatsCollector) cpuLoadClass() string {
+	cpuLoadPercents, err := cpu.Percent(0, false)
+	if err != nil || len(cpuLoadPercents) == 0 {
+		return ResourceClassUnknown
+	}
+
+	return computeCpuLoadClass(cpuLoadPercents[0])
+}
+
+func (rsc *ResourceStatsCollector) diskFreeClass() string {
+	usage, err := disk.Usage(rsc.diskPath)
+	if err != nil || usage.Total == 0 {
+		return ResourceClassUnknown
+	}
+
+	return computeDiskFreeClass(float64(usage.Free) * 100 / float64(usage.Total))
+}
+
+func (rsc *ResourceStatsCollector) syncState() string {
+	if rsc.isSyncingHandler == nil {
+		return SyncStateUnknown
+	}
+	if rsc.isSyncingHandler() {
+		return SyncStateSyncing
+	}
+
+	return SyncStateSynchronized
+}
+
+func computeCpuLoadClass(cpuLoadPercent float64) string {
+	if cpuLoadPercent >= highCpuLoadPercent {
+		return ResourceClassHigh
+	}
+	if cpuLoadPercent >= mediumCpuLoadPercent {
+		return ResourceClassMedium
+	}
+
+	return ResourceClassLow
+}
+
+func computeDiskFreeClass(diskFreePercent float64) string {
+	if diskFreePercent >= highDiskFreePercent {
+		return ResourceClassHigh
+	}
+	if diskFreePercent >= mediumDiskFreePercent {
+		return ResourceClassMedium
+	}
+
+	return ResourceClassLow
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rsc *ResourceStatsCollector) IsInterfaceNil() bool {
+	if rsc == nil {
+		return true
+	}
+	return false
+}
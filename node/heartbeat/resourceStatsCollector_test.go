@@ -0,0 +1,55 @@
+package heartbeat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeCpuLoadClass(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, ResourceClassLow, computeCpuLoadClass(0))
+	assert.Equal(t, ResourceClassLow, computeCpuLoadClass(mediumCpuLoadPercent-1))
+	assert.Equal(t, ResourceClassMedium, computeCpuLoadClass(mediumCpuLoadPercent))
+	assert.Equal(t, ResourceClassHigh, computeCpuLoadClass(highCpuLoadPercent))
+	assert.Equal(t, ResourceClassHigh, computeCpuLoadClass(100))
+}
+
+func TestComputeDiskFreeClass(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, ResourceClassLow, computeDiskFreeClass(0))
+	assert.Equal(t, ResourceClassLow, computeDiskFreeClass(mediumDiskFreePercent-1))
+	assert.Equal(t, ResourceClassMedium, computeDiskFreeClass(mediumDiskFreePercent))
+	assert.Equal(t, ResourceClassHigh, computeDiskFreeClass(highDiskFreePercent))
+	assert.Equal(t, ResourceClassHigh, computeDiskFreeClass(100))
+}
+
+func TestResourceStatsCollector_ResourceStatsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	isSyncing := true
+	rsc := NewResourceStatsCollector(".", func() bool {
+		return isSyncing
+	})
+
+	stats := rsc.ResourceStats()
+	assert.True(t, stats.PeakMemory > 0)
+	assert.NotEqual(t, ResourceClassUnknown, stats.DiskFreeClass)
+	assert.Equal(t, SyncStateSyncing, stats.SyncState)
+
+	isSyncing = false
+	stats = rsc.ResourceStats()
+	assert.Equal(t, SyncStateSynchronized, stats.SyncState)
+}
+
+func TestResourceStatsCollector_NilSyncHandlerShouldReportUnknownSyncState(t *testing.T) {
+	t.Parallel()
+
+	rsc := NewResourceStatsCollector("/inexistent-path", nil)
+
+	stats := rsc.ResourceStats()
+	assert.Equal(t, ResourceClassUnknown, stats.DiskFreeClass)
+	assert.Equal(t, SyncStateUnknown, stats.SyncState)
+}
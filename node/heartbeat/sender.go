@@ -1,7 +1,7 @@
 package heartbeat
 
 import (
-	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -19,6 +19,9 @@ type Sender struct {
 	shardCoordinator sharding.Coordinator
 	versionNumber    string
 	nodeDisplayName  string
+
+	mutResourceStats     sync.RWMutex
+	resourceStatsHandler ResourceStatsHandler
 }
 
 // NewSender will create a new sender instance
@@ -63,17 +66,35 @@ func NewSender(
 	return sender, nil
 }
 
+// SetResourceStatsHandler will set the ResourceStatsHandler which will provide the resource data included in each
+// heartbeat message sent from now on
+func (s *Sender) SetResourceStatsHandler(resourceStatsHandler ResourceStatsHandler) error {
+	if resourceStatsHandler == nil || resourceStatsHandler.IsInterfaceNil() {
+		return ErrNilResourceStatsHandler
+	}
+
+	s.mutResourceStats.Lock()
+	s.resourceStatsHandler = resourceStatsHandler
+	s.mutResourceStats.Unlock()
+
+	return nil
+}
+
 // SendHeartbeat broadcasts a new heartbeat message
 func (s *Sender) SendHeartbeat() error {
 
 	hb := &Heartbeat{
-		Payload:         []byte(fmt.Sprintf("%v", time.Now())),
 		ShardID:         s.shardCoordinator.SelfId(),
 		VersionNumber:   s.versionNumber,
 		NodeDisplayName: s.nodeDisplayName,
 	}
 
 	var err error
+	hb.Payload, err = s.marshalizer.Marshal(s.createPayload())
+	if err != nil {
+		return err
+	}
+
 	hb.Pubkey, err = s.privKey.GeneratePublic().ToByteArray()
 	if err != nil {
 		return err
@@ -98,3 +119,18 @@ func (s *Sender) SendHeartbeat() error {
 
 	return nil
 }
+
+func (s *Sender) createPayload() *HeartbeatPayload {
+	payload := &HeartbeatPayload{
+		Version:   HeartbeatPayloadVersion,
+		Timestamp: time.Now().Unix(),
+	}
+
+	s.mutResourceStats.RLock()
+	if s.resourceStatsHandler != nil {
+		payload.ResourceStats = s.resourceStatsHandler.ResourceStats()
+	}
+	s.mutResourceStats.RUnlock()
+
+	return payload
+}
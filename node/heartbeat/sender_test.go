@@ -2,6 +2,7 @@ package heartbeat_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -312,3 +313,86 @@ func TestSender_SendHeartbeatShouldWork(t *testing.T) {
 	assert.True(t, genPubKeyClled)
 	assert.True(t, marshalCalled)
 }
+
+func createSenderWithJsonMarshalizer(broadcastHandler func(topic string, buff []byte)) *heartbeat.Sender {
+	sender, _ := heartbeat.NewSender(
+		&mock.MessengerStub{
+			BroadcastCalled: broadcastHandler,
+		},
+		&mock.SinglesignStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) (i []byte, e error) {
+				return []byte("signature"), nil
+			},
+		},
+		&mock.PrivateKeyStub{
+			GeneratePublicHandler: func() crypto.PublicKey {
+				return &mock.PublicKeyMock{
+					ToByteArrayHandler: func() (i []byte, e error) {
+						return []byte("pub key"), nil
+					},
+				}
+			},
+		},
+		&mock.MarshalizerFake{},
+		"topic",
+		&mock.ShardCoordinatorMock{},
+		"v0.1",
+		"undefined",
+	)
+
+	return sender
+}
+
+func TestSender_SetResourceStatsHandlerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sender := createSenderWithJsonMarshalizer(nil)
+
+	err := sender.SetResourceStatsHandler(nil)
+	assert.Equal(t, heartbeat.ErrNilResourceStatsHandler, err)
+}
+
+func TestSender_SendHeartbeatWithoutResourceStatsHandlerShouldSendVersionedPayload(t *testing.T) {
+	t.Parallel()
+
+	var sentHeartbeat heartbeat.Heartbeat
+	sender := createSenderWithJsonMarshalizer(func(topic string, buff []byte) {
+		_ = json.Unmarshal(buff, &sentHeartbeat)
+	})
+
+	err := sender.SendHeartbeat()
+	assert.Nil(t, err)
+
+	var payload heartbeat.HeartbeatPayload
+	err = json.Unmarshal(sentHeartbeat.Payload, &payload)
+	assert.Nil(t, err)
+	assert.Equal(t, heartbeat.HeartbeatPayloadVersion, payload.Version)
+	assert.Nil(t, payload.ResourceStats)
+}
+
+func TestSender_SendHeartbeatShouldIncludeResourceStats(t *testing.T) {
+	t.Parallel()
+
+	var sentHeartbeat heartbeat.Heartbeat
+	sender := createSenderWithJsonMarshalizer(func(topic string, buff []byte) {
+		_ = json.Unmarshal(buff, &sentHeartbeat)
+	})
+	resourceStats := &heartbeat.NodeResourceStats{
+		PeakMemory:    1024,
+		CpuLoadClass:  heartbeat.ResourceClassHigh,
+		DiskFreeClass: heartbeat.ResourceClassLow,
+		SyncState:     heartbeat.SyncStateSyncing,
+	}
+	_ = sender.SetResourceStatsHandler(&mock.ResourceStatsHandlerStub{
+		ResourceStatsCalled: func() *heartbeat.NodeResourceStats {
+			return resourceStats
+		},
+	})
+
+	err := sender.SendHeartbeat()
+	assert.Nil(t, err)
+
+	var payload heartbeat.HeartbeatPayload
+	_ = json.Unmarshal(sentHeartbeat.Payload, &payload)
+	assert.Equal(t, resourceStats, payload.ResourceStats)
+}
@@ -20,3 +20,8 @@ type P2PMessenger interface {
 	PeerAddress(pid p2p.PeerID) string
 	IsInterfaceNil() bool
 }
+
+// stateRecoveryEnabler is implemented by the bootstrappers able to recover from a diverged accounts state
+type stateRecoveryEnabler interface {
+	EnableStateRecovery(mismatchesThreshold uint32, rollbackDepth uint32, forensicsFolder string) error
+}
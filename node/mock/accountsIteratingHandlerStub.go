@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+type AccountsIteratingHandlerStub struct {
+	IterateAccountsCalled func(rootHash []byte, startAfter []byte, maxAccounts int, handler func(account *external.AccountEntry) error) ([]byte, error)
+}
+
+func (aihs *AccountsIteratingHandlerStub) IterateAccounts(
+	rootHash []byte,
+	startAfter []byte,
+	maxAccounts int,
+	handler func(account *external.AccountEntry) error,
+) ([]byte, error) {
+	return aihs.IterateAccountsCalled(rootHash, startAfter, maxAccounts, handler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aihs *AccountsIteratingHandlerStub) IsInterfaceNil() bool {
+	if aihs == nil {
+		return true
+	}
+	return false
+}
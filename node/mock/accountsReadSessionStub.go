@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+type AccountsReadSessionStub struct {
+	RootHashCalled           func() []byte
+	HasAccountCalled         func(addressContainer state.AddressContainer) (bool, error)
+	GetExistingAccountCalled func(addressContainer state.AddressContainer) (state.AccountHandler, error)
+}
+
+func (arss *AccountsReadSessionStub) RootHash() []byte {
+	if arss.RootHashCalled != nil {
+		return arss.RootHashCalled()
+	}
+
+	return nil
+}
+
+func (arss *AccountsReadSessionStub) HasAccount(addressContainer state.AddressContainer) (bool, error) {
+	return arss.HasAccountCalled(addressContainer)
+}
+
+func (arss *AccountsReadSessionStub) GetExistingAccount(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+	return arss.GetExistingAccountCalled(addressContainer)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (arss *AccountsReadSessionStub) IsInterfaceNil() bool {
+	if arss == nil {
+		return true
+	}
+	return false
+}
@@ -19,6 +19,7 @@ type AccountsStub struct {
 	SaveDataTrieCalled          func(acountWrapper state.AccountHandler) error
 	RootHashCalled              func() ([]byte, error)
 	RecreateTrieCalled          func(rootHash []byte) error
+	NewReadSessionCalled        func() (state.AccountsReadSession, error)
 }
 
 func (aam *AccountsStub) AddJournalEntry(je state.JournalEntry) {
@@ -77,6 +78,17 @@ func (aam *AccountsStub) RecreateTrie(rootHash []byte) error {
 	return aam.RecreateTrieCalled(rootHash)
 }
 
+func (aam *AccountsStub) NewReadSession() (state.AccountsReadSession, error) {
+	if aam.NewReadSessionCalled != nil {
+		return aam.NewReadSessionCalled()
+	}
+
+	return &AccountsReadSessionStub{
+		HasAccountCalled:         aam.HasAccountStateCalled,
+		GetExistingAccountCalled: aam.GetExistingAccountCalled,
+	}, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (aam *AccountsStub) IsInterfaceNil() bool {
 	if aam == nil {
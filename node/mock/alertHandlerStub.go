@@ -0,0 +1,22 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+)
+
+type AlertHandlerStub struct {
+	HandleAlertCalled func(alert *heartbeat.Alert)
+}
+
+func (ahs *AlertHandlerStub) HandleAlert(alert *heartbeat.Alert) {
+	if ahs.HandleAlertCalled != nil {
+		ahs.HandleAlertCalled(alert)
+	}
+}
+
+func (ahs *AlertHandlerStub) IsInterfaceNil() bool {
+	if ahs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+)
+
+type AlertNotifierStub struct {
+	NotifyCalled func(alert *heartbeat.Alert) error
+}
+
+func (ans *AlertNotifierStub) Notify(alert *heartbeat.Alert) error {
+	if ans.NotifyCalled != nil {
+		return ans.NotifyCalled(alert)
+	}
+	return nil
+}
+
+func (ans *AlertNotifierStub) Name() string {
+	return "stub"
+}
+
+func (ans *AlertNotifierStub) IsInterfaceNil() bool {
+	if ans == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,17 @@
+package mock
+
+type ChainExportHandlerStub struct {
+	ExportCalled func(table string, fromNonce uint64, toNonce uint64, handler func(row []string) error) error
+}
+
+func (cehs *ChainExportHandlerStub) Export(table string, fromNonce uint64, toNonce uint64, handler func(row []string) error) error {
+	return cehs.ExportCalled(table, fromNonce, toNonce, handler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cehs *ChainExportHandlerStub) IsInterfaceNil() bool {
+	if cehs == nil {
+		return true
+	}
+	return false
+}
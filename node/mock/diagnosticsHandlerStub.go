@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+type DiagnosticsHandlerStub struct {
+	DumpReportCalled func() *external.DiagnosticsReport
+}
+
+func (dhs *DiagnosticsHandlerStub) DumpReport() *external.DiagnosticsReport {
+	return dhs.DumpReportCalled()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dhs *DiagnosticsHandlerStub) IsInterfaceNil() bool {
+	if dhs == nil {
+		return true
+	}
+	return false
+}
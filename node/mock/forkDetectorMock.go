@@ -14,6 +14,7 @@ type ForkDetectorMock struct {
 	ProbableHighestNonceCalled              func() uint64
 	ResetProbableHighestNonceIfNeededCalled func()
 	ResetProbableHighestNonceCalled         func()
+	ResetCheckpointsCalled                  func(nonce uint64, round uint64)
 }
 
 // AddHeader is a mock implementation for AddHeader
@@ -49,6 +50,12 @@ func (fdm *ForkDetectorMock) ResetProbableHighestNonce() {
 	fdm.ResetProbableHighestNonceCalled()
 }
 
+func (fdm *ForkDetectorMock) ResetCheckpoints(nonce uint64, round uint64) {
+	if fdm.ResetCheckpointsCalled != nil {
+		fdm.ResetCheckpointsCalled(nonce, round)
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (fdm *ForkDetectorMock) IsInterfaceNil() bool {
 	if fdm == nil {
@@ -9,7 +9,8 @@ import (
 
 // IndexerMock is a mock implementation fot the Indexer interface
 type IndexerMock struct {
-	SaveBlockCalled func(body block.Body, header *block.Header)
+	SaveBlockCalled          func(body block.Body, header *block.Header)
+	RevertIndexedBlockCalled func(header data.HeaderHandler)
 }
 
 func (im *IndexerMock) SaveBlock(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler, signersIndexes []uint64) {
@@ -20,6 +21,12 @@ func (im *IndexerMock) SaveMetaBlock(header data.HeaderHandler, signersIndexes [
 	panic("implement me")
 }
 
+func (im *IndexerMock) RevertIndexedBlock(header data.HeaderHandler) {
+	if im.RevertIndexedBlockCalled != nil {
+		im.RevertIndexedBlockCalled(header)
+	}
+}
+
 func (im *IndexerMock) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
 	panic("implement me")
 }
@@ -5,10 +5,14 @@ import (
 )
 
 type InterceptorsContainerStub struct {
+	GetCalled      func(key string) (process.Interceptor, error)
+	KeysCalled     func() []string
+	IterateCalled  func(handler func(key string, interceptor process.Interceptor) bool)
+	MetadataCalled func(key string) (process.ContainerElementMetadata, error)
 }
 
 func (ics *InterceptorsContainerStub) Get(key string) (process.Interceptor, error) {
-	panic("implement me")
+	return ics.GetCalled(key)
 }
 
 func (ics *InterceptorsContainerStub) Add(key string, val process.Interceptor) error {
@@ -31,6 +35,18 @@ func (ics *InterceptorsContainerStub) Len() int {
 	panic("implement me")
 }
 
+func (ics *InterceptorsContainerStub) Keys() []string {
+	return ics.KeysCalled()
+}
+
+func (ics *InterceptorsContainerStub) Iterate(handler func(key string, interceptor process.Interceptor) bool) {
+	ics.IterateCalled(handler)
+}
+
+func (ics *InterceptorsContainerStub) Metadata(key string) (process.ContainerElementMetadata, error) {
+	return ics.MetadataCalled(key)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ics *InterceptorsContainerStub) IsInterfaceNil() bool {
 	if ics == nil {
@@ -0,0 +1,34 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/process/tracing"
+
+type MessageTracingHandlerStub struct {
+	EnableTopicCalled  func(topic string, sampleRate uint32) error
+	DisableTopicCalled func(topic string)
+	TracedTopicsCalled func() map[string]uint32
+	TracesCalled       func() []tracing.MessageTraceRecord
+}
+
+func (mths *MessageTracingHandlerStub) EnableTopic(topic string, sampleRate uint32) error {
+	return mths.EnableTopicCalled(topic, sampleRate)
+}
+
+func (mths *MessageTracingHandlerStub) DisableTopic(topic string) {
+	mths.DisableTopicCalled(topic)
+}
+
+func (mths *MessageTracingHandlerStub) TracedTopics() map[string]uint32 {
+	return mths.TracedTopicsCalled()
+}
+
+func (mths *MessageTracingHandlerStub) Traces() []tracing.MessageTraceRecord {
+	return mths.TracesCalled()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mths *MessageTracingHandlerStub) IsInterfaceNil() bool {
+	if mths == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+type MessengerStatisticsStub struct {
+	TopicsStatisticsCalled  func() map[string]p2p.TopicStatistics
+	PendingBroadcastsCalled func() map[string]int
+	NumPubSubRestartsCalled func() uint64
+}
+
+func (mss *MessengerStatisticsStub) TopicsStatistics() map[string]p2p.TopicStatistics {
+	return mss.TopicsStatisticsCalled()
+}
+
+func (mss *MessengerStatisticsStub) PendingBroadcasts() map[string]int {
+	return mss.PendingBroadcastsCalled()
+}
+
+func (mss *MessengerStatisticsStub) NumPubSubRestarts() uint64 {
+	return mss.NumPubSubRestartsCalled()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mss *MessengerStatisticsStub) IsInterfaceNil() bool {
+	if mss == nil {
+		return true
+	}
+	return false
+}
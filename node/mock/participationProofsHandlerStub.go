@@ -0,0 +1,19 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/node/external"
+
+type ParticipationProofsHandlerStub struct {
+	ExportParticipationProofsCalled func(epoch uint32, pubKey []byte) (*external.ParticipationProofs, error)
+}
+
+func (pphs *ParticipationProofsHandlerStub) ExportParticipationProofs(epoch uint32, pubKey []byte) (*external.ParticipationProofs, error) {
+	return pphs.ExportParticipationProofsCalled(epoch, pubKey)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pphs *ParticipationProofsHandlerStub) IsInterfaceNil() bool {
+	if pphs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+type PeersCounterStub struct {
+	ConnectedPeersCalled func() []p2p.PeerID
+}
+
+func (pcs *PeersCounterStub) ConnectedPeers() []p2p.PeerID {
+	return pcs.ConnectedPeersCalled()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pcs *PeersCounterStub) IsInterfaceNil() bool {
+	if pcs == nil {
+		return true
+	}
+	return false
+}
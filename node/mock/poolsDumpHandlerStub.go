@@ -0,0 +1,22 @@
+package mock
+
+type PoolsDumpHandlerStub struct {
+	DumpPoolsCalled     func(poolNames []string) ([]string, error)
+	LoadPoolsDumpCalled func(fileName string) (int, error)
+}
+
+func (pdhs *PoolsDumpHandlerStub) DumpPools(poolNames []string) ([]string, error) {
+	return pdhs.DumpPoolsCalled(poolNames)
+}
+
+func (pdhs *PoolsDumpHandlerStub) LoadPoolsDump(fileName string) (int, error) {
+	return pdhs.LoadPoolsDumpCalled(fileName)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pdhs *PoolsDumpHandlerStub) IsInterfaceNil() bool {
+	if pdhs == nil {
+		return true
+	}
+	return false
+}
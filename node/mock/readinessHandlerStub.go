@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+type ReadinessHandlerStub struct {
+	CheckReadinessCalled func() *external.ReadinessReport
+}
+
+func (rhs *ReadinessHandlerStub) CheckReadiness() *external.ReadinessReport {
+	return rhs.CheckReadinessCalled()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rhs *ReadinessHandlerStub) IsInterfaceNil() bool {
+	if rhs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/rejection"
+)
+
+type RejectionTrackingHandlerStub struct {
+	RejectionsCalled     func() []rejection.PeerRejections
+	PeerRejectionsCalled func(peer p2p.PeerID) rejection.PeerRejections
+}
+
+func (rths *RejectionTrackingHandlerStub) Rejections() []rejection.PeerRejections {
+	return rths.RejectionsCalled()
+}
+
+func (rths *RejectionTrackingHandlerStub) PeerRejections(peer p2p.PeerID) rejection.PeerRejections {
+	return rths.PeerRejectionsCalled(peer)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rths *RejectionTrackingHandlerStub) IsInterfaceNil() bool {
+	if rths == nil {
+		return true
+	}
+	return false
+}
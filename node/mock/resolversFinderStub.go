@@ -13,6 +13,9 @@ type ResolversFinderStub struct {
 	IntraShardResolverCalled func(baseTopic string) (dataRetriever.Resolver, error)
 	MetaChainResolverCalled  func(baseTopic string) (dataRetriever.Resolver, error)
 	CrossShardResolverCalled func(baseTopic string, crossShard uint32) (dataRetriever.Resolver, error)
+	KeysCalled               func() []string
+	IterateCalled            func(handler func(key string, resolver dataRetriever.Resolver) bool)
+	MetadataCalled           func(key string) (dataRetriever.ContainerElementMetadata, error)
 }
 
 func (rfs *ResolversFinderStub) Get(key string) (dataRetriever.Resolver, error) {
@@ -51,6 +54,18 @@ func (rfs *ResolversFinderStub) CrossShardResolver(baseTopic string, crossShard
 	return rfs.CrossShardResolverCalled(baseTopic, crossShard)
 }
 
+func (rfs *ResolversFinderStub) Keys() []string {
+	return rfs.KeysCalled()
+}
+
+func (rfs *ResolversFinderStub) Iterate(handler func(key string, resolver dataRetriever.Resolver) bool) {
+	rfs.IterateCalled(handler)
+}
+
+func (rfs *ResolversFinderStub) Metadata(key string) (dataRetriever.ContainerElementMetadata, error) {
+	return rfs.MetadataCalled(key)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rfs *ResolversFinderStub) IsInterfaceNil() bool {
 	if rfs == nil {
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/node/heartbeat"
+
+type ResourceStatsHandlerStub struct {
+	ResourceStatsCalled func() *heartbeat.NodeResourceStats
+}
+
+func (rshs *ResourceStatsHandlerStub) ResourceStats() *heartbeat.NodeResourceStats {
+	if rshs.ResourceStatsCalled != nil {
+		return rshs.ResourceStatsCalled()
+	}
+
+	return nil
+}
+
+func (rshs *ResourceStatsHandlerStub) IsInterfaceNil() bool {
+	if rshs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+type SCStorageDiffHandlerStub struct {
+	StorageDiffCalled func(address []byte, fromNonce uint64, toNonce uint64) (*external.SCStorageDiff, error)
+}
+
+func (sdhs *SCStorageDiffHandlerStub) StorageDiff(address []byte, fromNonce uint64, toNonce uint64) (*external.SCStorageDiff, error) {
+	return sdhs.StorageDiffCalled(address, fromNonce, toNonce)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sdhs *SCStorageDiffHandlerStub) IsInterfaceNil() bool {
+	if sdhs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+type StorageUnitsHandlerStub struct {
+	GetEntryCalled   func(unitName string, key []byte) ([]byte, error)
+	UnitsStatsCalled func() []external.StorageUnitStats
+}
+
+func (suqs *StorageUnitsHandlerStub) GetEntry(unitName string, key []byte) ([]byte, error) {
+	return suqs.GetEntryCalled(unitName, key)
+}
+
+func (suqs *StorageUnitsHandlerStub) UnitsStats() []external.StorageUnitStats {
+	return suqs.UnitsStatsCalled()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (suqs *StorageUnitsHandlerStub) IsInterfaceNil() bool {
+	if suqs == nil {
+		return true
+	}
+	return false
+}
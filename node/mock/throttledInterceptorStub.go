@@ -0,0 +1,29 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type ThrottledInterceptorStub struct {
+	ProcessReceivedMessageCalled func(message p2p.MessageP2P) error
+	ThrottlerCalled              func() process.InterceptorThrottler
+}
+
+func (tis *ThrottledInterceptorStub) ProcessReceivedMessage(_ context.Context, message p2p.MessageP2P) error {
+	return tis.ProcessReceivedMessageCalled(message)
+}
+
+func (tis *ThrottledInterceptorStub) Throttler() process.InterceptorThrottler {
+	return tis.ThrottlerCalled()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tis *ThrottledInterceptorStub) IsInterfaceNil() bool {
+	if tis == nil {
+		return true
+	}
+	return false
+}
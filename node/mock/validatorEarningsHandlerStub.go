@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/node/external"
+)
+
+type ValidatorEarningsHandlerStub struct {
+	EarningsCalled func(address []byte, fromEpoch uint32, toEpoch uint32) (*external.ValidatorEarnings, error)
+}
+
+func (vehs *ValidatorEarningsHandlerStub) Earnings(address []byte, fromEpoch uint32, toEpoch uint32) (*external.ValidatorEarnings, error) {
+	return vehs.EarningsCalled(address, fromEpoch, toEpoch)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vehs *ValidatorEarningsHandlerStub) IsInterfaceNil() bool {
+	if vehs == nil {
+		return true
+	}
+	return false
+}
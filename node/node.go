@@ -7,12 +7,14 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	goSync "sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
+	"github.com/ElrondNetwork/elrond-go/consensus/metrics"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -28,6 +30,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/node/heartbeat/alert"
 	"github.com/ElrondNetwork/elrond-go/node/heartbeat/storage"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -73,6 +76,8 @@ type Node struct {
 	heartbeatMonitor         *heartbeat.Monitor
 	heartbeatSender          *heartbeat.Sender
 	appStatusHandler         core.AppStatusHandler
+	bootstrapper             process.Bootstrapper
+	mutBootstrapper          goSync.RWMutex
 
 	txSignPrivKey  crypto.PrivateKey
 	txSignPubKey   crypto.PublicKey
@@ -99,7 +104,11 @@ type Node struct {
 	currentSendingGoRoutines int32
 	bootstrapRoundIndex      uint64
 
-	indexer indexer.Indexer
+	stateRecoveryConfig  config.StateRecoveryConfig
+	stateForensicsFolder string
+
+	indexer           indexer.Indexer
+	participationGate spos.ParticipationGate
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -122,6 +131,7 @@ func NewNode(opts ...Option) (*Node, error) {
 		ctx:                      context.Background(),
 		currentSendingGoRoutines: 0,
 		appStatusHandler:         statusHandler.NewNilStatusHandler(),
+		participationGate:        spos.NewDisabledParticipationGate(),
 	}
 	for _, opt := range opts {
 		err := opt(node)
@@ -218,7 +228,12 @@ func (n *Node) StartConsensus() error {
 		return ErrGenesisBlockNotInitialized
 	}
 
-	chronologyHandler, err := n.createChronologyHandler(n.rounder, n.appStatusHandler)
+	consensusMetrics, err := n.createConsensusMetrics()
+	if err != nil {
+		return err
+	}
+
+	chronologyHandler, err := n.createChronologyHandler(n.rounder, n.appStatusHandler, consensusMetrics)
 	if err != nil {
 		return err
 	}
@@ -233,8 +248,19 @@ func (n *Node) StartConsensus() error {
 		log.Warn("cannot set app status handler for shard bootstrapper")
 	}
 
+	if n.indexer != nil && !n.indexer.IsInterfaceNil() {
+		err = bootstrapper.SetIndexer(n.indexer)
+		if err != nil {
+			log.Warn("cannot set indexer for bootstrapper")
+		}
+	}
+
 	bootstrapper.StartSync()
 
+	n.mutBootstrapper.Lock()
+	n.bootstrapper = bootstrapper
+	n.mutBootstrapper.Unlock()
+
 	consensusState, err := n.createConsensusState()
 	if err != nil {
 		return err
@@ -250,7 +276,8 @@ func (n *Node) StartConsensus() error {
 		n.messenger,
 		n.shardCoordinator,
 		n.privKey,
-		n.singleSigner)
+		n.singleSigner,
+		n.forkDetector)
 
 	if err != nil {
 		return err
@@ -274,6 +301,11 @@ func (n *Node) StartConsensus() error {
 		return err
 	}
 
+	err = worker.SetConsensusMetrics(consensusMetrics)
+	if err != nil {
+		return err
+	}
+
 	err = n.createConsensusTopic(worker, n.shardCoordinator)
 	if err != nil {
 		return err
@@ -299,7 +331,15 @@ func (n *Node) StartConsensus() error {
 		return err
 	}
 
-	fct, err := sposFactory.GetSubroundsFactory(consensusDataContainer, consensusState, worker, n.consensusType, n.appStatusHandler, n.indexer)
+	fct, err := sposFactory.GetSubroundsFactory(
+		consensusDataContainer,
+		consensusState,
+		worker,
+		n.consensusType,
+		n.appStatusHandler,
+		n.indexer,
+		n.participationGate,
+	)
 	if err != nil {
 		return err
 	}
@@ -314,7 +354,7 @@ func (n *Node) StartConsensus() error {
 	return nil
 }
 
-// GetBalance gets the balance for a specific address
+// GetBalance gets the balance for a specific address, as it was at the last committed state
 func (n *Node) GetBalance(addressHex string) (*big.Int, error) {
 	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() || n.accounts == nil || n.accounts.IsInterfaceNil() {
 		return nil, errors.New("initialize AccountsAdapter and AddressConverter first")
@@ -324,7 +364,11 @@ func (n *Node) GetBalance(addressHex string) (*big.Int, error) {
 	if err != nil {
 		return nil, errors.New("invalid address, could not decode from hex: " + err.Error())
 	}
-	accWrp, err := n.accounts.GetExistingAccount(address)
+	session, err := n.accounts.NewReadSession()
+	if err != nil {
+		return nil, err
+	}
+	accWrp, err := session.GetExistingAccount(address)
 	if err != nil {
 		return nil, errors.New("could not fetch sender address from provided param: " + err.Error())
 	}
@@ -341,8 +385,27 @@ func (n *Node) GetBalance(addressHex string) (*big.Int, error) {
 	return account.Balance, nil
 }
 
+// createConsensusMetrics method creates the collector of the per round consensus metrics
+func (n *Node) createConsensusMetrics() (consensus.ConsensusMetricsHandler, error) {
+	consensusMetrics, err := metrics.NewConsensusMetrics(n.rounder, n.syncTimer, n.blkc)
+	if err != nil {
+		return nil, err
+	}
+
+	err = consensusMetrics.SetAppStatusHandler(n.appStatusHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return consensusMetrics, nil
+}
+
 // createChronologyHandler method creates a chronology object
-func (n *Node) createChronologyHandler(rounder consensus.Rounder, appStatusHandler core.AppStatusHandler) (consensus.ChronologyHandler, error) {
+func (n *Node) createChronologyHandler(
+	rounder consensus.Rounder,
+	appStatusHandler core.AppStatusHandler,
+	consensusMetrics consensus.ConsensusMetricsHandler,
+) (consensus.ChronologyHandler, error) {
 	chr, err := chronology.NewChronology(
 		n.genesisTime,
 		rounder,
@@ -357,6 +420,11 @@ func (n *Node) createChronologyHandler(rounder consensus.Rounder, appStatusHandl
 		return nil, err
 	}
 
+	err = chr.SetConsensusMetrics(consensusMetrics)
+	if err != nil {
+		return nil, err
+	}
+
 	return chr, nil
 }
 
@@ -393,6 +461,11 @@ func (n *Node) createShardBootstrapper(rounder consensus.Rounder) (process.Boots
 		return nil, err
 	}
 
+	err = n.enableStateRecoveryIfNeeded(bootstrap)
+	if err != nil {
+		return nil, err
+	}
+
 	return bootstrap, nil
 }
 
@@ -417,9 +490,26 @@ func (n *Node) createMetaChainBootstrapper(rounder consensus.Rounder) (process.B
 		return nil, err
 	}
 
+	err = n.enableStateRecoveryIfNeeded(bootstrap)
+	if err != nil {
+		return nil, err
+	}
+
 	return bootstrap, nil
 }
 
+func (n *Node) enableStateRecoveryIfNeeded(bootstrap stateRecoveryEnabler) error {
+	if !n.stateRecoveryConfig.Enabled {
+		return nil
+	}
+
+	return bootstrap.EnableStateRecovery(
+		n.stateRecoveryConfig.MismatchesThreshold,
+		n.stateRecoveryConfig.RollbackDepth,
+		n.stateForensicsFolder,
+	)
+}
+
 // createConsensusState method creates a consensusState object
 func (n *Node) createConsensusState() (*spos.ConsensusState, error) {
 	selfId, err := n.pubKey.ToByteArray()
@@ -481,7 +571,9 @@ func (n *Node) SendTransaction(
 	gasPrice uint64,
 	gasLimit uint64,
 	transactionData string,
-	signature []byte) (string, error) {
+	signature []byte,
+	validityWindow transaction.ValidityWindow,
+) (string, error) {
 
 	if n.shardCoordinator == nil || n.shardCoordinator.IsInterfaceNil() {
 		return "", ErrNilShardCoordinator
@@ -509,6 +601,7 @@ func (n *Node) SendTransaction(
 		Data:      transactionData,
 		Signature: signature,
 	}
+	tx.SetValidityWindow(validityWindow)
 
 	txBuff, err := n.marshalizer.Marshal(&tx)
 	if err != nil {
@@ -609,6 +702,7 @@ func (n *Node) CreateTransaction(
 	data string,
 	signatureHex string,
 	challenge string,
+	validityWindow transaction.ValidityWindow,
 ) (*transaction.Transaction, error) {
 
 	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() {
@@ -639,7 +733,7 @@ func (n *Node) CreateTransaction(
 		return nil, errors.New("could not fetch challenge bytes")
 	}
 
-	return &transaction.Transaction{
+	tx := &transaction.Transaction{
 		Nonce:     nonce,
 		Value:     value,
 		RcvAddr:   receiverAddress.Bytes(),
@@ -649,7 +743,10 @@ func (n *Node) CreateTransaction(
 		Data:      data,
 		Signature: signatureBytes,
 		Challenge: challengeBytes,
-	}, nil
+	}
+	tx.SetValidityWindow(validityWindow)
+
+	return tx, nil
 }
 
 //GetTransaction gets the transaction
@@ -666,7 +763,24 @@ func (n *Node) GetCurrentPublicKey() string {
 	return ""
 }
 
-// GetAccount will return acount details for a given address
+// ComputeShardIdOfAddress returns the id of the shard the provided hex encoded address belongs to
+func (n *Node) ComputeShardIdOfAddress(address string) (uint32, error) {
+	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() {
+		return 0, ErrNilAddressConverter
+	}
+	if n.shardCoordinator == nil || n.shardCoordinator.IsInterfaceNil() {
+		return 0, ErrNilShardCoordinator
+	}
+
+	addr, err := n.addrConverter.CreateAddressFromHex(address)
+	if err != nil {
+		return 0, err
+	}
+
+	return n.shardCoordinator.ComputeId(addr), nil
+}
+
+// GetAccount will return acount details for a given address, as they were at the last committed state
 func (n *Node) GetAccount(address string) (*state.Account, error) {
 	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() {
 		return nil, ErrNilAddressConverter
@@ -680,7 +794,12 @@ func (n *Node) GetAccount(address string) (*state.Account, error) {
 		return nil, err
 	}
 
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	session, err := n.accounts.NewReadSession()
+	if err != nil {
+		return nil, err
+	}
+
+	accWrp, err := session.GetExistingAccount(addr)
 	if err != nil {
 		if err == state.ErrAccNotFound {
 			return &state.Account{
@@ -701,6 +820,36 @@ func (n *Node) GetAccount(address string) (*state.Account, error) {
 	return account, nil
 }
 
+// GetTokenBalances returns the token balances held by the account having the provided hex encoded address
+func (n *Node) GetTokenBalances(address string) (map[string]*big.Int, error) {
+	if n.addrConverter == nil || n.addrConverter.IsInterfaceNil() {
+		return nil, ErrNilAddressConverter
+	}
+	if n.accounts == nil || n.accounts.IsInterfaceNil() {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	addr, err := n.addrConverter.CreateAddressFromHex(address)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := n.accounts.NewReadSession()
+	if err != nil {
+		return nil, err
+	}
+
+	accWrp, err := session.GetExistingAccount(addr)
+	if err == state.ErrAccNotFound {
+		return make(map[string]*big.Int), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return state.GetTokenBalances(accWrp.DataTrie())
+}
+
 // StartHeartbeat starts the node's heartbeat processing/signaling module
 func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber string, nodeDisplayName string) error {
 	if !hbConfig.Enabled {
@@ -737,6 +886,13 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 		return err
 	}
 
+	if hbConfig.IncludeResourceStats {
+		err = n.heartbeatSender.SetResourceStatsHandler(heartbeat.NewResourceStatsCollector(".", n.isSyncing))
+		if err != nil {
+			return err
+		}
+	}
+
 	heartbeatStorageUnit := n.store.GetStorer(dataRetriever.HeartbeatUnit)
 	heartBeatMsgProcessor, err := heartbeat.NewMessageProcessor(
 		n.singleSigner,
@@ -766,6 +922,18 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 		return err
 	}
 
+	if hbConfig.Alerts.Enabled {
+		alertHandler, err := createHeartbeatAlertHandler(hbConfig.Alerts, timer)
+		if err != nil {
+			return err
+		}
+
+		err = n.heartbeatMonitor.SetAlertHandler(alertHandler)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = n.messenger.RegisterMessageProcessor(HeartbeatTopic, n.heartbeatMonitor)
 	if err != nil {
 		return err
@@ -776,6 +944,47 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 	return nil
 }
 
+func createHeartbeatAlertHandler(alertsConfig config.HeartbeatAlertsConfig, timer heartbeat.Timer) (heartbeat.AlertHandler, error) {
+	notifiers := make([]alert.Notifier, 0)
+
+	if len(alertsConfig.Webhook.URL) > 0 {
+		webhookNotifier, err := alert.NewWebhookNotifier(alertsConfig.Webhook.URL)
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, webhookNotifier)
+	}
+
+	if len(alertsConfig.Email.SmtpServer) > 0 {
+		emailNotifier, err := alert.NewEmailNotifier(
+			alertsConfig.Email.SmtpServer,
+			alertsConfig.Email.Username,
+			alertsConfig.Email.Password,
+			alertsConfig.Email.From,
+			alertsConfig.Email.To,
+		)
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, emailNotifier)
+	}
+
+	if len(alertsConfig.Exec.Command) > 0 {
+		execNotifier, err := alert.NewExecNotifier(alertsConfig.Exec.Command, alertsConfig.Exec.Args)
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, execNotifier)
+	}
+
+	return alert.NewAlertManager(
+		alertsConfig.WatchedPublicKeys,
+		time.Second*time.Duration(alertsConfig.DebounceInSec),
+		timer,
+		notifiers,
+	)
+}
+
 func (n *Node) checkConfigParams(config config.HeartbeatConfig) error {
 	if config.DurationInSecToConsiderUnresponsive < 1 {
 		return ErrNegativeDurationInSecToConsiderUnresponsive
@@ -812,6 +1021,19 @@ func (n *Node) startSendingHeartbeats(config config.HeartbeatConfig) {
 	}
 }
 
+// isSyncing returns true if the node is synchronizing with the network. Before the consensus is started the node is
+// considered as syncing
+func (n *Node) isSyncing() bool {
+	n.mutBootstrapper.RLock()
+	defer n.mutBootstrapper.RUnlock()
+
+	if n.bootstrapper == nil {
+		return true
+	}
+
+	return n.bootstrapper.ShouldSync()
+}
+
 // GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 func (n *Node) GetHeartbeats() []heartbeat.PubKeyHeartbeat {
 	if n.heartbeatMonitor == nil {
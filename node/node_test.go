@@ -2,6 +2,7 @@ package node_test
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
@@ -582,7 +583,7 @@ func TestCreateTransaction_NilAddrConverterShouldErr(t *testing.T) {
 	signature := "-"
 	challenge := "-"
 
-	tx, err := n.CreateTransaction(nonce, value, receiver, sender, gasPrice, gasLimit, txData, signature, challenge)
+	tx, err := n.CreateTransaction(nonce, value, receiver, sender, gasPrice, gasLimit, txData, signature, challenge, transaction.ValidityWindow{})
 
 	assert.Nil(t, tx)
 	assert.Equal(t, node.ErrNilAddressConverter, err)
@@ -612,7 +613,7 @@ func TestCreateTransaction_NilAccountsAdapterShouldErr(t *testing.T) {
 	signature := "-"
 	challenge := "-"
 
-	tx, err := n.CreateTransaction(nonce, value, receiver, sender, gasPrice, gasLimit, txData, signature, challenge)
+	tx, err := n.CreateTransaction(nonce, value, receiver, sender, gasPrice, gasLimit, txData, signature, challenge, transaction.ValidityWindow{})
 
 	assert.Nil(t, tx)
 	assert.Equal(t, node.ErrNilAccountsAdapter, err)
@@ -643,7 +644,7 @@ func TestCreateTransaction_InvalidSignatureShouldErr(t *testing.T) {
 	signature := "-"
 	challenge := "af4e5"
 
-	tx, err := n.CreateTransaction(nonce, value, receiver, sender, gasPrice, gasLimit, txData, signature, challenge)
+	tx, err := n.CreateTransaction(nonce, value, receiver, sender, gasPrice, gasLimit, txData, signature, challenge, transaction.ValidityWindow{})
 
 	assert.Nil(t, tx)
 	assert.NotNil(t, err)
@@ -674,7 +675,7 @@ func TestCreateTransaction_OkValsShouldWork(t *testing.T) {
 	signature := "617eff4f"
 	challenge := "aff64e"
 
-	tx, err := n.CreateTransaction(nonce, value, receiver, sender, gasPrice, gasLimit, txData, signature, challenge)
+	tx, err := n.CreateTransaction(nonce, value, receiver, sender, gasPrice, gasLimit, txData, signature, challenge, transaction.ValidityWindow{})
 
 	assert.NotNil(t, tx)
 	assert.Nil(t, err)
@@ -683,6 +684,36 @@ func TestCreateTransaction_OkValsShouldWork(t *testing.T) {
 	assert.True(t, bytes.Equal([]byte(receiver), tx.RcvAddr))
 }
 
+func TestCreateTransaction_ShouldSetTheValidityWindow(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithMarshalizer(getMarshalizer()),
+		node.WithHasher(getHasher()),
+		node.WithAddressConverter(&mock.AddressConverterStub{
+			CreateAddressFromHexHandler: func(hexAddress string) (container state.AddressContainer, e error) {
+				return state.NewAddress([]byte(hexAddress)), nil
+			},
+		}),
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
+		node.WithTxSignPrivKey(&mock.PrivateKeyStub{}),
+	)
+
+	validityWindow := transaction.ValidityWindow{
+		NotBeforeRound: 10,
+		NotAfterRound:  20,
+		NotBeforeEpoch: 1,
+		NotAfterEpoch:  2,
+	}
+	tx, err := n.CreateTransaction(0, big.NewInt(10), "rcv", "snd", 10, 20, "-", "617eff4f", "aff64e", validityWindow)
+
+	assert.Nil(t, err)
+	assert.Equal(t, validityWindow.NotBeforeRound, tx.NotBeforeRound)
+	assert.Equal(t, validityWindow.NotAfterRound, tx.NotAfterRound)
+	assert.Equal(t, validityWindow.NotBeforeEpoch, tx.NotBeforeEpoch)
+	assert.Equal(t, validityWindow.NotAfterEpoch, tx.NotAfterEpoch)
+}
+
 func TestSendBulkTransactions_NoTxShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -742,7 +773,9 @@ func TestSendTransaction_ShouldWork(t *testing.T) {
 		0,
 		0,
 		txData,
-		signature)
+		signature,
+		transaction.ValidityWindow{},
+	)
 
 	marshalizedTx, _ := marshalizer.Marshal(&transaction.Transaction{
 		Nonce:     nonce,
@@ -1435,7 +1468,7 @@ func TestNode_StartHeartbeatShouldWorkAndCanCallProcessMessage(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, registeredHandler)
 
-	err = registeredHandler.ProcessReceivedMessage(nil)
+	err = registeredHandler.ProcessReceivedMessage(context.Background(), nil)
 	assert.NotNil(t, err)
 	assert.Contains(t, "nil message", err.Error())
 }
@@ -1462,6 +1495,61 @@ func TestNode_StartConsensusGenesisBlockNotInitializedShouldErr(t *testing.T) {
 
 //------- GetAccount
 
+func TestNode_ComputeShardIdOfAddressNilAddressConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+	)
+
+	_, err := n.ComputeShardIdOfAddress(createDummyHexAddress(64))
+
+	assert.Equal(t, node.ErrNilAddressConverter, err)
+}
+
+func TestNode_ComputeShardIdOfAddressNilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "0x")),
+	)
+
+	_, err := n.ComputeShardIdOfAddress(createDummyHexAddress(64))
+
+	assert.Equal(t, node.ErrNilShardCoordinator, err)
+}
+
+func TestNode_ComputeShardIdOfAddressInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "0x")),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+	)
+
+	_, err := n.ComputeShardIdOfAddress("not hex")
+
+	assert.NotNil(t, err)
+}
+
+func TestNode_ComputeShardIdOfAddressShouldWork(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+		return 1
+	}
+	n, _ := node.NewNode(
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "0x")),
+		node.WithShardCoordinator(shardCoordinator),
+	)
+
+	shardId, err := n.ComputeShardIdOfAddress(createDummyHexAddress(64))
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), shardId)
+}
+
 func TestNode_GetAccountWithNilAccountsAdapterShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1564,6 +1652,59 @@ func TestNode_GetAccountAccountsAdapterFailsShouldErr(t *testing.T) {
 	assert.Contains(t, err.Error(), errExpected.Error())
 }
 
+func TestNode_GetAccountReadSessionFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	accDB := &mock.AccountsStub{
+		NewReadSessionCalled: func() (state.AccountsReadSession, error) {
+			return nil, errExpected
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+	)
+
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+
+	assert.Nil(t, recovAccnt)
+	assert.Equal(t, errExpected, err)
+}
+
+func TestNode_GetAccountShouldReadFromTheReadSession(t *testing.T) {
+	t.Parallel()
+
+	accnt := &state.Account{
+		Balance: big.NewInt(1),
+		Nonce:   2,
+	}
+	accDB := &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			assert.Fail(t, "should have not read the accounts while they are changed")
+			return nil, nil
+		},
+		NewReadSessionCalled: func() (state.AccountsReadSession, error) {
+			return &mock.AccountsReadSessionStub{
+				GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+					return accnt, nil
+				},
+			}, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+	)
+
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+
+	assert.Nil(t, err)
+	assert.Equal(t, accnt, recovAccnt)
+}
+
 func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 	t.Parallel()
 
@@ -1591,6 +1732,54 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 	assert.Equal(t, accnt, recovAccnt)
 }
 
+func TestNode_GetTokenBalancesAccountDoesNotExistsShouldRetEmpty(t *testing.T) {
+	t.Parallel()
+
+	accDB := &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			return nil, state.ErrAccNotFound
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+	)
+
+	tokenBalances, err := n.GetTokenBalances(createDummyHexAddress(64))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(tokenBalances))
+}
+
+func TestNode_GetTokenBalancesShouldReturnTheTokensFromTheDataTrie(t *testing.T) {
+	t.Parallel()
+
+	accDB := &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			acc, _ := state.NewAccount(addressContainer, &mock.AccountTrackerStub{})
+			acc.SetDataTrie(&mock.TrieStub{
+				IterateLeavesCalled: func(startAfterKey []byte, handler func(key []byte, value []byte) bool) error {
+					_ = handler(state.TokenBalanceKey("token"), big.NewInt(10).Bytes())
+					_ = handler([]byte("sc variable"), []byte("value"))
+					return nil
+				},
+			})
+			return acc, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithAddressConverter(mock.NewAddressConverterFake(32, "")),
+	)
+
+	tokenBalances, err := n.GetTokenBalances(createDummyHexAddress(64))
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]*big.Int{"token": big.NewInt(10)}, tokenBalances)
+}
+
 func TestNode_AppStatusHandlersShouldIncrement(t *testing.T) {
 	t.Parallel()
 
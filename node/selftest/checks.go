@@ -0,0 +1,216 @@
+package selftest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+const megabyte = 1024 * 1024
+const maxPort = 65535
+
+// ClockSyncCheck returns a check that fails if the offset between the local clock and the NTP server's clock,
+// as returned by the query function, is larger than the provided maximum offset. A node with a skewed clock
+// starts its rounds at the wrong moment and misses them
+func ClockSyncCheck(queryClockOffset func() (time.Duration, error), maxOffset time.Duration) CheckFunc {
+	return func() (string, error) {
+		if queryClockOffset == nil {
+			return "", ErrNilQueryFunc
+		}
+
+		offset, err := queryClockOffset()
+		if err != nil {
+			return "", err
+		}
+
+		absOffset := offset
+		if absOffset < 0 {
+			absOffset = -absOffset
+		}
+		if absOffset > maxOffset {
+			return "", fmt.Errorf("%s: %s, maximum allowed %s", ErrClockOffsetTooLarge.Error(), offset, maxOffset)
+		}
+
+		return fmt.Sprintf("clock offset %s, maximum allowed %s", offset, maxOffset), nil
+	}
+}
+
+// DiskThroughputCheck returns a check that writes and syncs a temporary file of the provided size in the provided
+// directory and fails if the measured write throughput is below the provided minimum
+func DiskThroughputCheck(directory string, fileSizeInMB int, minMBPerSecond float64) CheckFunc {
+	return func() (string, error) {
+		if fileSizeInMB <= 0 {
+			return "", ErrInvalidTestFileSize
+		}
+
+		err := os.MkdirAll(directory, os.ModePerm)
+		if err != nil {
+			return "", err
+		}
+
+		file, err := ioutil.TempFile(directory, "selftest")
+		if err != nil {
+			return "", err
+		}
+		defer func() {
+			_ = file.Close()
+			_ = os.Remove(file.Name())
+		}()
+
+		chunk := make([]byte, megabyte)
+		startTime := time.Now()
+		for i := 0; i < fileSizeInMB; i++ {
+			_, err = file.Write(chunk)
+			if err != nil {
+				return "", err
+			}
+		}
+		err = file.Sync()
+		if err != nil {
+			return "", err
+		}
+		elapsed := time.Since(startTime)
+
+		mbPerSecond := float64(fileSizeInMB) / elapsed.Seconds()
+		details := fmt.Sprintf("wrote %d MB in %s (%.1f MB/s) in %s, minimum required %.1f MB/s",
+			fileSizeInMB,
+			elapsed,
+			mbPerSecond,
+			directory,
+			minMBPerSecond,
+		)
+		if mbPerSecond < minMBPerSecond {
+			return "", fmt.Errorf("%s: %s", ErrDiskTooSlow.Error(), details)
+		}
+
+		return details, nil
+	}
+}
+
+// FileDescriptorsCheck returns a check that fails if the maximum number of file descriptors the process can open is
+// below the provided minimum. The storers and the p2p connections each hold file descriptors
+func FileDescriptorsCheck(minOpenFiles uint64) CheckFunc {
+	return func() (string, error) {
+		limit, supported, err := openFilesLimit()
+		if err != nil {
+			return "", err
+		}
+		if !supported {
+			return "open files limit can not be read on this platform, skipped", nil
+		}
+		if limit < minOpenFiles {
+			return "", fmt.Errorf("%s: %d, minimum required %d", ErrOpenFilesLimitTooLow.Error(), limit, minOpenFiles)
+		}
+
+		return fmt.Sprintf("open files limit %d, minimum required %d", limit, minOpenFiles), nil
+	}
+}
+
+// PortReachabilityCheck returns a check that listens on the provided port and asks the reachability function to
+// have the port dialed from the outside. The reachability function returns the address that was reached
+func PortReachabilityCheck(port int, checkReachability func(port int) (string, error)) CheckFunc {
+	return func() (string, error) {
+		if checkReachability == nil {
+			return "", ErrNilQueryFunc
+		}
+		if port == 0 {
+			return "", ErrRandomPort
+		}
+
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+		if err != nil {
+			return "", fmt.Errorf("port %d can not be listened on: %s", port, err.Error())
+		}
+		defer func() {
+			_ = listener.Close()
+		}()
+
+		go func() {
+			for {
+				conn, errAccept := listener.Accept()
+				if errAccept != nil {
+					return
+				}
+				_ = conn.Close()
+			}
+		}()
+
+		address, err := checkReachability(port)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("port %d reachable from the outside at %s", port, address), nil
+	}
+}
+
+// ConfigConsistencyCheck returns a check that validates the settings which are not validated when the configuration
+// files are loaded and would otherwise make the node fail later, or run without being able to connect to the network
+func ConfigConsistencyCheck(generalConfig *config.Config, p2pConfig *config.P2PConfig) CheckFunc {
+	return func() (string, error) {
+		if generalConfig == nil || p2pConfig == nil {
+			return "", fmt.Errorf("%s: missing configuration", ErrInconsistentConfig.Error())
+		}
+
+		problems := make([]string, 0)
+		addProblem := func(format string, args ...interface{}) {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+
+		if p2pConfig.Node.Port < 0 || p2pConfig.Node.Port > maxPort {
+			addProblem("p2p port %d out of range", p2pConfig.Node.Port)
+		}
+
+		kadDht := p2pConfig.KadDhtPeerDiscovery
+		if kadDht.Enabled {
+			if kadDht.RefreshIntervalInSec <= 0 {
+				addProblem("KadDhtPeerDiscovery.RefreshIntervalInSec should be positive")
+			}
+			if len(kadDht.InitialPeerList) == 0 {
+				addProblem("KadDhtPeerDiscovery.InitialPeerList is empty, the node can not find any peer")
+			}
+			for _, seeder := range kadDht.InitialPeerList {
+				err := checkSeederAddress(seeder)
+				if err != nil {
+					addProblem("invalid seeder address %s: %s", seeder, err.Error())
+				}
+			}
+		}
+
+		if generalConfig.GasPriceStats.NumBlocks == 0 {
+			addProblem("GasPriceStats.NumBlocks should be positive")
+		}
+
+		subscriptions := generalConfig.AccountsSubscriptions
+		if subscriptions.Enabled {
+			if subscriptions.MaxSubscribers == 0 ||
+				subscriptions.MaxAddressesPerSubscriber == 0 ||
+				subscriptions.BufferSize == 0 {
+				addProblem("AccountsSubscriptions limits should be positive when enabled")
+			}
+		}
+
+		if len(problems) > 0 {
+			return "", fmt.Errorf("%s: %s", ErrInconsistentConfig.Error(), strings.Join(problems, "; "))
+		}
+
+		return fmt.Sprintf("p2p port %d, %d seeders", p2pConfig.Node.Port, len(kadDht.InitialPeerList)), nil
+	}
+}
+
+func checkSeederAddress(seeder string) error {
+	address, err := multiaddr.NewMultiaddr(seeder)
+	if err != nil {
+		return err
+	}
+
+	_, err = peer.AddrInfoFromP2pAddr(address)
+	return err
+}
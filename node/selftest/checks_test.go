@@ -0,0 +1,233 @@
+package selftest_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/node/selftest"
+	"github.com/stretchr/testify/assert"
+)
+
+const validSeeder = "/ip4/127.0.0.1/tcp/10000/p2p/16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk"
+
+//------- ClockSyncCheck
+
+func TestClockSyncCheck_NilQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := selftest.ClockSyncCheck(nil, time.Second)()
+
+	assert.Equal(t, selftest.ErrNilQueryFunc, err)
+}
+
+func TestClockSyncCheck_QueryErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	_, err := selftest.ClockSyncCheck(func() (time.Duration, error) { return 0, errExpected }, time.Second)()
+
+	assert.Equal(t, errExpected, err)
+}
+
+func TestClockSyncCheck_OffsetTooLargeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := selftest.ClockSyncCheck(func() (time.Duration, error) { return -2 * time.Second, nil }, time.Second)()
+
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), selftest.ErrClockOffsetTooLarge.Error()))
+}
+
+func TestClockSyncCheck_SmallOffsetShouldPass(t *testing.T) {
+	t.Parallel()
+
+	details, err := selftest.ClockSyncCheck(func() (time.Duration, error) { return -time.Millisecond, nil }, time.Second)()
+
+	assert.Nil(t, err)
+	assert.True(t, strings.Contains(details, "-1ms"))
+}
+
+//------- DiskThroughputCheck
+
+func TestDiskThroughputCheck_InvalidFileSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := selftest.DiskThroughputCheck(os.TempDir(), 0, 1)()
+
+	assert.Equal(t, selftest.ErrInvalidTestFileSize, err)
+}
+
+func TestDiskThroughputCheck_TooSlowShouldErrAndRemoveTheTestFile(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "selftest")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	_, err := selftest.DiskThroughputCheck(dir, 1, 1e12)()
+
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), selftest.ErrDiskTooSlow.Error()))
+	files, _ := ioutil.ReadDir(dir)
+	assert.Equal(t, 0, len(files))
+}
+
+func TestDiskThroughputCheck_ShouldPass(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "selftest")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	details, err := selftest.DiskThroughputCheck(dir, 1, 0)()
+
+	assert.Nil(t, err)
+	assert.True(t, strings.Contains(details, "wrote 1 MB"))
+}
+
+//------- FileDescriptorsCheck
+
+func TestFileDescriptorsCheck_HugeMinimumShouldErr(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the open files limit is not available on windows")
+	}
+	t.Parallel()
+
+	_, err := selftest.FileDescriptorsCheck(^uint64(0))()
+
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), selftest.ErrOpenFilesLimitTooLow.Error()))
+}
+
+func TestFileDescriptorsCheck_NoMinimumShouldPass(t *testing.T) {
+	t.Parallel()
+
+	_, err := selftest.FileDescriptorsCheck(0)()
+
+	assert.Nil(t, err)
+}
+
+//------- PortReachabilityCheck
+
+func TestPortReachabilityCheck_RandomPortShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := selftest.PortReachabilityCheck(0, func(port int) (string, error) { return "", nil })()
+
+	assert.Equal(t, selftest.ErrRandomPort, err)
+}
+
+func TestPortReachabilityCheck_PortInUseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	listener, _ := net.Listen("tcp", ":0")
+	defer func() {
+		_ = listener.Close()
+	}()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	wasCalled := false
+	_, err := selftest.PortReachabilityCheck(port, func(port int) (string, error) {
+		wasCalled = true
+		return "", nil
+	})()
+
+	assert.NotNil(t, err)
+	assert.False(t, wasCalled)
+}
+
+func TestPortReachabilityCheck_ShouldListenWhileCheckingReachability(t *testing.T) {
+	t.Parallel()
+
+	listener, _ := net.Listen("tcp", ":0")
+	port := listener.Addr().(*net.TCPAddr).Port
+	_ = listener.Close()
+
+	details, err := selftest.PortReachabilityCheck(port, func(port int) (string, error) {
+		conn, errDial := net.Dial("tcp", listener.Addr().String())
+		if errDial != nil {
+			return "", errDial
+		}
+		_ = conn.Close()
+
+		return "1.2.3.4", nil
+	})()
+
+	assert.Nil(t, err)
+	assert.True(t, strings.Contains(details, "1.2.3.4"))
+}
+
+//------- ConfigConsistencyCheck
+
+func createConsistentConfigs() (*config.Config, *config.P2PConfig) {
+	generalConfig := &config.Config{
+		GasPriceStats: config.GasPriceStatsConfig{NumBlocks: 10},
+	}
+	p2pConfig := &config.P2PConfig{
+		Node: config.NodeConfig{Port: 10000},
+		KadDhtPeerDiscovery: config.KadDhtPeerDiscoveryConfig{
+			Enabled:              true,
+			RefreshIntervalInSec: 10,
+			InitialPeerList:      []string{validSeeder},
+		},
+	}
+
+	return generalConfig, p2pConfig
+}
+
+func TestConfigConsistencyCheck_ConsistentConfigsShouldPass(t *testing.T) {
+	t.Parallel()
+
+	details, err := selftest.ConfigConsistencyCheck(createConsistentConfigs())()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "p2p port 10000, 1 seeders", details)
+}
+
+func TestConfigConsistencyCheck_NilConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := selftest.ConfigConsistencyCheck(nil, nil)()
+
+	assert.NotNil(t, err)
+}
+
+func TestConfigConsistencyCheck_InconsistentConfigsShouldReportAllProblems(t *testing.T) {
+	t.Parallel()
+
+	generalConfig, p2pConfig := createConsistentConfigs()
+	p2pConfig.Node.Port = 70000
+	p2pConfig.KadDhtPeerDiscovery.InitialPeerList = []string{validSeeder, "/ip4/127.0.0.1/tcp/10000"}
+	generalConfig.GasPriceStats.NumBlocks = 0
+	generalConfig.AccountsSubscriptions.Enabled = true
+
+	_, err := selftest.ConfigConsistencyCheck(generalConfig, p2pConfig)()
+
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), selftest.ErrInconsistentConfig.Error()))
+	assert.True(t, strings.Contains(err.Error(), "p2p port 70000"))
+	assert.True(t, strings.Contains(err.Error(), "invalid seeder address /ip4/127.0.0.1/tcp/10000"))
+	assert.True(t, strings.Contains(err.Error(), "GasPriceStats.NumBlocks"))
+	assert.True(t, strings.Contains(err.Error(), "AccountsSubscriptions"))
+}
+
+func TestConfigConsistencyCheck_EmptySeedersListShouldErr(t *testing.T) {
+	t.Parallel()
+
+	generalConfig, p2pConfig := createConsistentConfigs()
+	p2pConfig.KadDhtPeerDiscovery.InitialPeerList = nil
+
+	_, err := selftest.ConfigConsistencyCheck(generalConfig, p2pConfig)()
+
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "InitialPeerList is empty"))
+}
@@ -0,0 +1,32 @@
+package selftest
+
+import (
+	"errors"
+)
+
+// ErrEmptyCheckName signals that a check was added without a name
+var ErrEmptyCheckName = errors.New("empty check name")
+
+// ErrNilCheck signals that a nil check was added
+var ErrNilCheck = errors.New("nil check")
+
+// ErrNilQueryFunc signals that a nil query function was provided
+var ErrNilQueryFunc = errors.New("nil query function")
+
+// ErrClockOffsetTooLarge signals that the local clock is too far from the NTP server's clock
+var ErrClockOffsetTooLarge = errors.New("clock offset too large")
+
+// ErrDiskTooSlow signals that the measured disk write throughput is below the required one
+var ErrDiskTooSlow = errors.New("disk write throughput too low")
+
+// ErrInvalidTestFileSize signals that an invalid size was provided for the disk throughput test file
+var ErrInvalidTestFileSize = errors.New("invalid test file size")
+
+// ErrOpenFilesLimitTooLow signals that the maximum number of open file descriptors is below the required one
+var ErrOpenFilesLimitTooLow = errors.New("open files limit too low")
+
+// ErrRandomPort signals that the node is configured to listen on a random port, which can not be checked
+var ErrRandomPort = errors.New("the node is configured to listen on a random port")
+
+// ErrInconsistentConfig signals that the configuration files hold inconsistent settings
+var ErrInconsistentConfig = errors.New("inconsistent config")
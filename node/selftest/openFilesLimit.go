@@ -0,0 +1,17 @@
+//+build !windows
+
+package selftest
+
+import (
+	"syscall"
+)
+
+func openFilesLimit() (uint64, bool, error) {
+	rLimit := syscall.Rlimit{}
+	err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rLimit)
+	if err != nil {
+		return 0, true, err
+	}
+
+	return uint64(rLimit.Cur), true, nil
+}
@@ -0,0 +1,9 @@
+//+build windows
+
+package selftest
+
+// openFilesLimit is not supported on windows, where the number of handles a process can open is not limited
+// through a configurable soft limit
+func openFilesLimit() (uint64, bool, error) {
+	return 0, false, nil
+}
@@ -0,0 +1,109 @@
+package selftest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CheckFunc runs a self-test check. It returns the details of what was checked and a non-nil error if the check
+// failed
+type CheckFunc func() (string, error)
+
+// CheckResult holds the outcome of a self-test check
+type CheckResult struct {
+	Name     string        `json:"name"`
+	Passed   bool          `json:"passed"`
+	Details  string        `json:"details"`
+	Duration time.Duration `json:"duration"`
+}
+
+// Report holds the outcome of all the self-test checks. It passes only if all the checks passed
+type Report struct {
+	Passed  bool          `json:"passed"`
+	Results []CheckResult `json:"results"`
+}
+
+type namedCheck struct {
+	name  string
+	check CheckFunc
+}
+
+// SelfTester runs, in the order they were added, the checks validating the node's environment before start
+type SelfTester struct {
+	checks []namedCheck
+}
+
+// NewSelfTester creates a new SelfTester instance without any check
+func NewSelfTester() *SelfTester {
+	return &SelfTester{
+		checks: make([]namedCheck, 0),
+	}
+}
+
+// AddCheck adds a named check to be run
+func (st *SelfTester) AddCheck(name string, check CheckFunc) error {
+	if len(name) == 0 {
+		return ErrEmptyCheckName
+	}
+	if check == nil {
+		return ErrNilCheck
+	}
+
+	st.checks = append(st.checks, namedCheck{name: name, check: check})
+	return nil
+}
+
+// Run runs all the checks, even if some of them fail, and returns the report
+func (st *SelfTester) Run() *Report {
+	report := &Report{
+		Passed:  true,
+		Results: make([]CheckResult, 0, len(st.checks)),
+	}
+
+	for _, nc := range st.checks {
+		startTime := time.Now()
+		details, err := nc.check()
+		result := CheckResult{
+			Name:     nc.name,
+			Passed:   err == nil,
+			Details:  details,
+			Duration: time.Since(startTime),
+		}
+		if err != nil {
+			result.Details = err.Error()
+		}
+
+		report.Passed = report.Passed && result.Passed
+		report.Results = append(report.Results, result)
+	}
+
+	return report
+}
+
+// String returns the report as a human readable table, one line per check
+func (r *Report) String() string {
+	nameWidth := 0
+	for _, result := range r.Results {
+		if len(result.Name) > nameWidth {
+			nameWidth = len(result.Name)
+		}
+	}
+
+	sb := strings.Builder{}
+	for _, result := range r.Results {
+		status := "PASS"
+		if !result.Passed {
+			status = "FAIL"
+		}
+		sb.WriteString(fmt.Sprintf("[%s] %-*s %s\n", status, nameWidth, result.Name, result.Details))
+	}
+
+	overall := "PASSED"
+	if !r.Passed {
+		overall = "FAILED"
+	}
+	sb.WriteString(fmt.Sprintf("self-test %s\n", overall))
+
+	return sb.String()
+}
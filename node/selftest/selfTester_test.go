@@ -0,0 +1,67 @@
+package selftest_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/node/selftest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelfTester_AddCheckInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	st := selftest.NewSelfTester()
+
+	err := st.AddCheck("", func() (string, error) { return "", nil })
+	assert.Equal(t, selftest.ErrEmptyCheckName, err)
+
+	err = st.AddCheck("check", nil)
+	assert.Equal(t, selftest.ErrNilCheck, err)
+}
+
+func TestSelfTester_RunWithoutChecksShouldPass(t *testing.T) {
+	t.Parallel()
+
+	report := selftest.NewSelfTester().Run()
+
+	assert.True(t, report.Passed)
+	assert.Equal(t, 0, len(report.Results))
+}
+
+func TestSelfTester_RunShouldRunAllChecksInOrder(t *testing.T) {
+	t.Parallel()
+
+	st := selftest.NewSelfTester()
+	_ = st.AddCheck("first", func() (string, error) { return "first details", nil })
+	_ = st.AddCheck("second", func() (string, error) { return "ignored details", errors.New("second failed") })
+	_ = st.AddCheck("third", func() (string, error) { return "third details", nil })
+
+	report := st.Run()
+
+	assert.False(t, report.Passed)
+	assert.Equal(t, 3, len(report.Results))
+	assert.Equal(t, selftest.CheckResult{Name: "first", Passed: true, Details: "first details"}, withoutDuration(report.Results[0]))
+	assert.Equal(t, selftest.CheckResult{Name: "second", Passed: false, Details: "second failed"}, withoutDuration(report.Results[1]))
+	assert.Equal(t, selftest.CheckResult{Name: "third", Passed: true, Details: "third details"}, withoutDuration(report.Results[2]))
+}
+
+func TestReport_StringShouldContainEveryCheck(t *testing.T) {
+	t.Parallel()
+
+	st := selftest.NewSelfTester()
+	_ = st.AddCheck("clock", func() (string, error) { return "clock ok", nil })
+	_ = st.AddCheck("disk", func() (string, error) { return "", errors.New("disk too slow") })
+
+	text := st.Run().String()
+
+	assert.True(t, strings.Contains(text, "[PASS] clock clock ok"))
+	assert.True(t, strings.Contains(text, "[FAIL] disk  disk too slow"))
+	assert.True(t, strings.Contains(text, "self-test FAILED"))
+}
+
+func withoutDuration(result selftest.CheckResult) selftest.CheckResult {
+	result.Duration = 0
+	return result
+}
@@ -57,6 +57,16 @@ func queryNTP(options NTPOptions) (*ntp.Response, error) {
 	return ntp.QueryWithOptions(options.Host, queryOptions)
 }
 
+// QueryClockOffset queries the configured NTP server once and returns the offset of the local clock
+func QueryClockOffset(ntpConfig config.NTPConfig) (time.Duration, error) {
+	response, err := queryNTP(NewNTPOptions(ntpConfig))
+	if err != nil {
+		return 0, err
+	}
+
+	return response.ClockOffset, nil
+}
+
 // syncTime defines an object for time synchronization
 type syncTime struct {
 	mut         sync.RWMutex
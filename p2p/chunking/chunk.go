@@ -0,0 +1,11 @@
+package chunking
+
+// Chunk is a fragment of a payload that exceeds the maximum size of a p2p message. The first chunk (index 0)
+// also announces the hashes of all the chunks so that each chunk can be authenticated as soon as it is received
+type Chunk struct {
+	PayloadHash []byte   `json:"payloadHash"`
+	Index       uint32   `json:"index"`
+	NumChunks   uint32   `json:"numChunks"`
+	ChunkHashes [][]byte `json:"chunkHashes,omitempty"`
+	Data        []byte   `json:"data"`
+}
@@ -0,0 +1,247 @@
+package chunking
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// chunksTopicSuffix is appended to a topic name to obtain the topic on which its chunks are gossiped
+const chunksTopicSuffix = "_chunks"
+
+var log = logger.DefaultLogger()
+
+// chunkingMessenger is a p2p.Messenger decorator that splits the payloads exceeding the maximum chunk size
+// into chunks, gossiped on a companion topic, and reassembles them on the receiving side before calling the
+// registered message processor. Payloads that fit into a single chunk are sent unchanged
+type chunkingMessenger struct {
+	p2p.Messenger
+	marshalizer        marshal.Marshalizer
+	hasher             hashing.Hasher
+	maxChunkSize       int
+	maxNumChunks       uint32
+	maxPendingPayloads int
+	reassemblyTimeout  time.Duration
+}
+
+// NewChunkingMessenger wraps the provided messenger so that oversized payloads will be sent as chunks
+func NewChunkingMessenger(
+	messenger p2p.Messenger,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	maxChunkSize int,
+	maxNumChunks uint32,
+	maxPendingPayloads int,
+	reassemblyTimeout time.Duration,
+) (*chunkingMessenger, error) {
+
+	if messenger == nil || messenger.IsInterfaceNil() {
+		return nil, p2p.ErrNilMessenger
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, p2p.ErrNilMarshalizer
+	}
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, p2p.ErrNilHasher
+	}
+	if maxChunkSize <= 0 {
+		return nil, p2p.ErrInvalidMaxChunkSize
+	}
+	if maxNumChunks < 2 {
+		return nil, p2p.ErrInvalidMaxNumChunks
+	}
+	if maxPendingPayloads <= 0 {
+		return nil, p2p.ErrInvalidMaxPendingPayloads
+	}
+	if reassemblyTimeout <= 0 {
+		return nil, p2p.ErrInvalidDurationProvided
+	}
+
+	return &chunkingMessenger{
+		Messenger:          messenger,
+		marshalizer:        marshalizer,
+		hasher:             hasher,
+		maxChunkSize:       maxChunkSize,
+		maxNumChunks:       maxNumChunks,
+		maxPendingPayloads: maxPendingPayloads,
+		reassemblyTimeout:  reassemblyTimeout,
+	}, nil
+}
+
+// ChunksTopicName returns the name of the topic on which the chunks of the provided topic are gossiped
+func ChunksTopicName(topic string) string {
+	return topic + chunksTopicSuffix
+}
+
+// CreateTopic creates the topic together with its chunks topic
+func (cm *chunkingMessenger) CreateTopic(name string, createChannelForTopic bool) error {
+	err := cm.Messenger.CreateTopic(name, createChannelForTopic)
+	if err != nil {
+		return err
+	}
+
+	return cm.Messenger.CreateTopic(ChunksTopicName(name), false)
+}
+
+// RegisterMessageProcessor registers the message processor on the topic and a chunks reassembler, that will
+// call the same message processor, on the chunks topic
+func (cm *chunkingMessenger) RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error {
+	err := cm.Messenger.RegisterMessageProcessor(topic, handler)
+	if err != nil {
+		return err
+	}
+
+	return cm.Messenger.RegisterMessageProcessor(ChunksTopicName(topic), newChunksReassembler(topic, handler, cm))
+}
+
+// UnregisterMessageProcessor unregisters the message processors from the topic and its chunks topic
+func (cm *chunkingMessenger) UnregisterMessageProcessor(topic string) error {
+	err := cm.Messenger.UnregisterMessageProcessor(topic)
+	if err != nil {
+		return err
+	}
+
+	return cm.Messenger.UnregisterMessageProcessor(ChunksTopicName(topic))
+}
+
+// RemoveTopic removes the topic together with its chunks topic
+func (cm *chunkingMessenger) RemoveTopic(name string) error {
+	err := cm.Messenger.RemoveTopic(name)
+	if err != nil {
+		return err
+	}
+
+	return cm.Messenger.RemoveTopic(ChunksTopicName(name))
+}
+
+// BroadcastOnChannelBlocking sends the message on the topic, or its chunks on the chunks topic, blocking until
+// sending is completed
+func (cm *chunkingMessenger) BroadcastOnChannelBlocking(channel string, topic string, buff []byte) {
+	if len(buff) <= cm.maxChunkSize {
+		cm.Messenger.BroadcastOnChannelBlocking(channel, topic, buff)
+		return
+	}
+
+	chunks, err := cm.createChunks(buff)
+	if err != nil {
+		log.Error("chunking messenger: " + err.Error())
+		return
+	}
+
+	for _, chunk := range chunks {
+		cm.Messenger.BroadcastOnChannelBlocking(channel, ChunksTopicName(topic), chunk)
+	}
+}
+
+// BroadcastOnChannel asynchronously sends the message on the topic, or its chunks on the chunks topic
+func (cm *chunkingMessenger) BroadcastOnChannel(channel string, topic string, buff []byte) {
+	if len(buff) <= cm.maxChunkSize {
+		cm.Messenger.BroadcastOnChannel(channel, topic, buff)
+		return
+	}
+
+	chunks, err := cm.createChunks(buff)
+	if err != nil {
+		log.Error("chunking messenger: " + err.Error())
+		return
+	}
+
+	for _, chunk := range chunks {
+		cm.Messenger.BroadcastOnChannel(channel, ChunksTopicName(topic), chunk)
+	}
+}
+
+// Broadcast sends the message on the topic, or its chunks on the chunks topic
+func (cm *chunkingMessenger) Broadcast(topic string, buff []byte) {
+	if len(buff) <= cm.maxChunkSize {
+		cm.Messenger.Broadcast(topic, buff)
+		return
+	}
+
+	chunks, err := cm.createChunks(buff)
+	if err != nil {
+		log.Error("chunking messenger: " + err.Error())
+		return
+	}
+
+	for _, chunk := range chunks {
+		cm.Messenger.Broadcast(ChunksTopicName(topic), chunk)
+	}
+}
+
+// SendToConnectedPeer sends the message, or its chunks, directly to a connected peer
+func (cm *chunkingMessenger) SendToConnectedPeer(topic string, buff []byte, peerID p2p.PeerID) error {
+	if len(buff) <= cm.maxChunkSize {
+		return cm.Messenger.SendToConnectedPeer(topic, buff, peerID)
+	}
+
+	chunks, err := cm.createChunks(buff)
+	if err != nil {
+		return err
+	}
+
+	for _, chunk := range chunks {
+		err = cm.Messenger.SendToConnectedPeer(ChunksTopicName(topic), chunk, peerID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createChunks splits the payload and returns the marshaled chunks. The first chunk announces the hashes of
+// all the chunks
+func (cm *chunkingMessenger) createChunks(buff []byte) ([][]byte, error) {
+	numChunks := (len(buff) + cm.maxChunkSize - 1) / cm.maxChunkSize
+	if numChunks > int(cm.maxNumChunks) {
+		return nil, p2p.ErrMessageTooLarge
+	}
+
+	payloadHash := cm.hasher.Compute(string(buff))
+	pieces := make([][]byte, numChunks)
+	chunkHashes := make([][]byte, numChunks)
+	for i := 0; i < numChunks; i++ {
+		start := i * cm.maxChunkSize
+		end := start + cm.maxChunkSize
+		if end > len(buff) {
+			end = len(buff)
+		}
+
+		pieces[i] = buff[start:end]
+		chunkHashes[i] = cm.hasher.Compute(string(pieces[i]))
+	}
+
+	chunks := make([][]byte, numChunks)
+	for i := 0; i < numChunks; i++ {
+		chunk := &Chunk{
+			PayloadHash: payloadHash,
+			Index:       uint32(i),
+			NumChunks:   uint32(numChunks),
+			Data:        pieces[i],
+		}
+		if i == 0 {
+			chunk.ChunkHashes = chunkHashes
+		}
+
+		marshaledChunk, err := cm.marshalizer.Marshal(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		chunks[i] = marshaledChunk
+	}
+
+	return chunks, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cm *chunkingMessenger) IsInterfaceNil() bool {
+	if cm == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,218 @@
+package chunking_test
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/chunking"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+const maxChunkSize = 10
+const maxNumChunks = 5
+
+func createChunkingMessenger(network *memp2p.Network) p2p.Messenger {
+	messenger, _ := memp2p.NewMessenger(network)
+	cm, _ := chunking.NewChunkingMessenger(
+		messenger,
+		&marshal.JsonMarshalizer{},
+		sha256.Sha256{},
+		maxChunkSize,
+		maxNumChunks,
+		10,
+		time.Second,
+	)
+
+	return cm
+}
+
+func createReceiverOnTopic(messenger p2p.Messenger, topic string) (*[][]byte, *sync.Mutex) {
+	mut := &sync.Mutex{}
+	received := make([][]byte, 0)
+
+	_ = messenger.CreateTopic(topic, false)
+	_ = messenger.RegisterMessageProcessor(topic, &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P) error {
+			mut.Lock()
+			received = append(received, message.Data())
+			mut.Unlock()
+
+			return nil
+		},
+	})
+
+	return &received, mut
+}
+
+func TestNewChunkingMessenger_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cm, err := chunking.NewChunkingMessenger(nil, &marshal.JsonMarshalizer{}, sha256.Sha256{}, 10, 5, 10, time.Second)
+
+	assert.Nil(t, cm)
+	assert.Equal(t, p2p.ErrNilMessenger, err)
+}
+
+func TestNewChunkingMessenger_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+
+	cm, err := chunking.NewChunkingMessenger(messenger, nil, sha256.Sha256{}, 10, 5, 10, time.Second)
+
+	assert.Nil(t, cm)
+	assert.Equal(t, p2p.ErrNilMarshalizer, err)
+}
+
+func TestNewChunkingMessenger_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+
+	cm, err := chunking.NewChunkingMessenger(messenger, &marshal.JsonMarshalizer{}, nil, 10, 5, 10, time.Second)
+
+	assert.Nil(t, cm)
+	assert.Equal(t, p2p.ErrNilHasher, err)
+}
+
+func TestNewChunkingMessenger_InvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+	marshalizer := &marshal.JsonMarshalizer{}
+	hasher := sha256.Sha256{}
+
+	_, err := chunking.NewChunkingMessenger(messenger, marshalizer, hasher, 0, 5, 10, time.Second)
+	assert.Equal(t, p2p.ErrInvalidMaxChunkSize, err)
+
+	_, err = chunking.NewChunkingMessenger(messenger, marshalizer, hasher, 10, 1, 10, time.Second)
+	assert.Equal(t, p2p.ErrInvalidMaxNumChunks, err)
+
+	_, err = chunking.NewChunkingMessenger(messenger, marshalizer, hasher, 10, 5, 0, time.Second)
+	assert.Equal(t, p2p.ErrInvalidMaxPendingPayloads, err)
+
+	_, err = chunking.NewChunkingMessenger(messenger, marshalizer, hasher, 10, 5, 10, 0)
+	assert.Equal(t, p2p.ErrInvalidDurationProvided, err)
+}
+
+func TestChunkingMessenger_CreateTopicShouldCreateChunksTopic(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+	cm, _ := chunking.NewChunkingMessenger(messenger, &marshal.JsonMarshalizer{}, sha256.Sha256{}, 10, 5, 10, time.Second)
+
+	err := cm.CreateTopic("miniblocks", false)
+
+	assert.Nil(t, err)
+	assert.True(t, messenger.HasTopic("miniblocks"))
+	assert.True(t, messenger.HasTopic(chunking.ChunksTopicName("miniblocks")))
+}
+
+func TestChunkingMessenger_RegisterAndUnregisterShouldUseChunksTopic(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+	cm, _ := chunking.NewChunkingMessenger(messenger, &marshal.JsonMarshalizer{}, sha256.Sha256{}, 10, 5, 10, time.Second)
+	_ = cm.CreateTopic("miniblocks", false)
+
+	err := cm.RegisterMessageProcessor("miniblocks", &mock.MessageProcessorStub{})
+	assert.Nil(t, err)
+	assert.True(t, messenger.HasTopicValidator(chunking.ChunksTopicName("miniblocks")))
+
+	err = cm.UnregisterMessageProcessor("miniblocks")
+	assert.Nil(t, err)
+	assert.False(t, messenger.HasTopicValidator(chunking.ChunksTopicName("miniblocks")))
+}
+
+func TestChunkingMessenger_RemoveTopicShouldRemoveChunksTopic(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+	cm, _ := chunking.NewChunkingMessenger(messenger, &marshal.JsonMarshalizer{}, sha256.Sha256{}, 10, 5, 10, time.Second)
+	_ = cm.CreateTopic("miniblocks", false)
+
+	err := cm.RemoveTopic("miniblocks")
+
+	assert.Nil(t, err)
+	assert.False(t, messenger.HasTopic("miniblocks"))
+	assert.False(t, messenger.HasTopic(chunking.ChunksTopicName("miniblocks")))
+}
+
+func TestChunkingMessenger_BroadcastSmallPayloadShouldNotChunk(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	sender := createChunkingMessenger(network)
+	receiver := createChunkingMessenger(network)
+	received, mut := createReceiverOnTopic(receiver, "miniblocks")
+	_, _ = createReceiverOnTopic(sender, "miniblocks")
+
+	sender.BroadcastOnChannelBlocking("miniblocks", "miniblocks", []byte("small"))
+
+	mut.Lock()
+	assert.Equal(t, [][]byte{[]byte("small")}, *received)
+	mut.Unlock()
+}
+
+func TestChunkingMessenger_BroadcastLargePayloadShouldReassemble(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	sender := createChunkingMessenger(network)
+	receiver := createChunkingMessenger(network)
+	received, mut := createReceiverOnTopic(receiver, "miniblocks")
+	_, _ = createReceiverOnTopic(sender, "miniblocks")
+
+	payload := []byte("a payload larger than the maximum chunk size")
+	sender.BroadcastOnChannelBlocking("miniblocks", "miniblocks", payload)
+
+	mut.Lock()
+	assert.Equal(t, [][]byte{payload}, *received)
+	mut.Unlock()
+}
+
+func TestChunkingMessenger_BroadcastTooLargePayloadShouldNotSend(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	sender := createChunkingMessenger(network)
+	receiver := createChunkingMessenger(network)
+	received, mut := createReceiverOnTopic(receiver, "miniblocks")
+	_, _ = createReceiverOnTopic(sender, "miniblocks")
+
+	sender.BroadcastOnChannelBlocking("miniblocks", "miniblocks", bytes.Repeat([]byte("a"), maxChunkSize*maxNumChunks+1))
+
+	mut.Lock()
+	assert.Equal(t, 0, len(*received))
+	mut.Unlock()
+}
+
+func TestChunkingMessenger_SendToConnectedPeerLargePayloadShouldReassemble(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	sender := createChunkingMessenger(network)
+	receiver := createChunkingMessenger(network)
+	received, mut := createReceiverOnTopic(receiver, "miniblocks")
+
+	payload := bytes.Repeat([]byte("b"), maxChunkSize*maxNumChunks)
+	err := sender.SendToConnectedPeer("miniblocks", payload, receiver.ID())
+
+	assert.Nil(t, err)
+	mut.Lock()
+	assert.Equal(t, [][]byte{payload}, *received)
+	mut.Unlock()
+}
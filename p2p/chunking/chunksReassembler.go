@@ -0,0 +1,210 @@
+package chunking
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+type pendingPayload struct {
+	numChunks   uint32
+	chunkHashes [][]byte
+	chunks      map[uint32][]byte
+	firstSeen   time.Time
+}
+
+// chunksReassembler is the message processor registered on a chunks topic. It collects the chunks of each
+// payload, authenticates them against the announced hashes and, when all the chunks have been received, calls
+// the original topic's processor with the reassembled payload
+type chunksReassembler struct {
+	topic              string
+	handler            p2p.MessageProcessor
+	marshalizer        marshal.Marshalizer
+	hasher             hashing.Hasher
+	maxChunkSize       int
+	maxNumChunks       uint32
+	maxPendingPayloads int
+	reassemblyTimeout  time.Duration
+
+	mutPending sync.Mutex
+	pending    map[string]*pendingPayload
+}
+
+func newChunksReassembler(
+	topic string,
+	handler p2p.MessageProcessor,
+	cm *chunkingMessenger,
+) *chunksReassembler {
+	return &chunksReassembler{
+		topic:              topic,
+		handler:            handler,
+		marshalizer:        cm.marshalizer,
+		hasher:             cm.hasher,
+		maxChunkSize:       cm.maxChunkSize,
+		maxNumChunks:       cm.maxNumChunks,
+		maxPendingPayloads: cm.maxPendingPayloads,
+		reassemblyTimeout:  cm.reassemblyTimeout,
+		pending:            make(map[string]*pendingPayload),
+	}
+}
+
+// ProcessReceivedMessage stores the received chunk and, if it was the last missing one, delivers the
+// reassembled payload to the original topic's processor
+func (cr *chunksReassembler) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
+	if message == nil || message.IsInterfaceNil() {
+		return p2p.ErrNilMessage
+	}
+
+	chunk := &Chunk{}
+	err := cr.marshalizer.Unmarshal(chunk, message.Data())
+	if err != nil {
+		return err
+	}
+
+	err = cr.checkChunk(chunk)
+	if err != nil {
+		return err
+	}
+
+	payload, err := cr.addChunk(message.Peer(), chunk)
+	if err != nil {
+		return err
+	}
+	if payload == nil {
+		return nil
+	}
+
+	return cr.handler.ProcessReceivedMessage(ctx, newReassembledMessage(message, cr.topic, payload))
+}
+
+func (cr *chunksReassembler) checkChunk(chunk *Chunk) error {
+	if len(chunk.PayloadHash) != cr.hasher.Size() {
+		return p2p.ErrInvalidPayloadHash
+	}
+	if chunk.NumChunks < 2 || chunk.NumChunks > cr.maxNumChunks {
+		return p2p.ErrInvalidNumChunks
+	}
+	if chunk.Index >= chunk.NumChunks {
+		return p2p.ErrInvalidChunkIndex
+	}
+	if len(chunk.Data) == 0 || len(chunk.Data) > cr.maxChunkSize {
+		return p2p.ErrInvalidChunkSize
+	}
+
+	isAnnouncingChunk := chunk.Index == 0
+	if isAnnouncingChunk && uint32(len(chunk.ChunkHashes)) != chunk.NumChunks {
+		return p2p.ErrInvalidChunkHashes
+	}
+	if !isAnnouncingChunk && len(chunk.ChunkHashes) != 0 {
+		return p2p.ErrInvalidChunkHashes
+	}
+
+	return nil
+}
+
+// addChunk returns the reassembled payload if the provided chunk was the last missing one, nil otherwise
+func (cr *chunksReassembler) addChunk(pid p2p.PeerID, chunk *Chunk) ([]byte, error) {
+	cr.mutPending.Lock()
+	defer cr.mutPending.Unlock()
+
+	cr.removeExpiredPayloads()
+
+	// payloads are identified by originator as well, so a peer can not interfere with other peers' payloads
+	key := string(pid) + string(chunk.PayloadHash)
+	pp, ok := cr.pending[key]
+	if !ok {
+		if len(cr.pending) >= cr.maxPendingPayloads {
+			return nil, p2p.ErrTooManyPendingPayloads
+		}
+
+		pp = &pendingPayload{
+			numChunks: chunk.NumChunks,
+			chunks:    make(map[uint32][]byte),
+			firstSeen: time.Now(),
+		}
+		cr.pending[key] = pp
+	}
+
+	if pp.numChunks != chunk.NumChunks {
+		return nil, p2p.ErrInvalidNumChunks
+	}
+	if _, alreadyReceived := pp.chunks[chunk.Index]; alreadyReceived {
+		return nil, nil
+	}
+
+	chunkHashes := pp.chunkHashes
+	if chunk.Index == 0 {
+		chunkHashes = chunk.ChunkHashes
+	}
+	if chunkHashes != nil && !bytes.Equal(cr.hasher.Compute(string(chunk.Data)), chunkHashes[chunk.Index]) {
+		return nil, p2p.ErrChunkHashMismatch
+	}
+	if chunk.Index == 0 {
+		pp.chunkHashes = chunk.ChunkHashes
+		cr.removeUnauthenticatedChunks(pp)
+	}
+
+	pp.chunks[chunk.Index] = chunk.Data
+	if pp.chunkHashes == nil || uint32(len(pp.chunks)) < pp.numChunks {
+		return nil, nil
+	}
+
+	delete(cr.pending, key)
+
+	payload := make([]byte, 0, len(pp.chunks)*cr.maxChunkSize)
+	for i := uint32(0); i < pp.numChunks; i++ {
+		payload = append(payload, pp.chunks[i]...)
+	}
+	if !bytes.Equal(cr.hasher.Compute(string(payload)), chunk.PayloadHash) {
+		return nil, p2p.ErrPayloadHashMismatch
+	}
+
+	return payload, nil
+}
+
+// removeUnauthenticatedChunks drops the chunks received before the announcing chunk that do not match the
+// announced hashes
+func (cr *chunksReassembler) removeUnauthenticatedChunks(pp *pendingPayload) {
+	for idx, data := range pp.chunks {
+		if !bytes.Equal(cr.hasher.Compute(string(data)), pp.chunkHashes[idx]) {
+			delete(pp.chunks, idx)
+		}
+	}
+}
+
+func (cr *chunksReassembler) removeExpiredPayloads() {
+	for key, pp := range cr.pending {
+		if time.Since(pp.firstSeen) < cr.reassemblyTimeout {
+			continue
+		}
+
+		log.Debug(fmt.Sprintf("reassembly timeout on topic %s: received %d out of %d chunks",
+			cr.topic,
+			len(pp.chunks),
+			pp.numChunks,
+		))
+		delete(cr.pending, key)
+	}
+}
+
+// NumPendingPayloads returns the number of payloads waiting for their missing chunks
+func (cr *chunksReassembler) NumPendingPayloads() int {
+	cr.mutPending.Lock()
+	defer cr.mutPending.Unlock()
+
+	return len(cr.pending)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cr *chunksReassembler) IsInterfaceNil() bool {
+	if cr == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,240 @@
+package chunking_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/chunking"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+var testPayload = []byte("a payload larger than the maximum chunk size")
+
+func createReassembler(
+	maxPendingPayloads int,
+	reassemblyTimeout time.Duration,
+) (*[][]byte, func(buff []byte) [][]byte, func(data []byte, pid p2p.PeerID) error, func() int) {
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+	marshalizer := &marshal.JsonMarshalizer{}
+	cm, _ := chunking.NewChunkingMessenger(
+		messenger,
+		marshalizer,
+		sha256.Sha256{},
+		maxChunkSize,
+		maxNumChunks,
+		maxPendingPayloads,
+		reassemblyTimeout,
+	)
+
+	received := make([][]byte, 0)
+	reassembler := cm.NewChunksReassembler("miniblocks", &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P) error {
+			received = append(received, message.Data())
+			return nil
+		},
+	})
+
+	createChunks := func(buff []byte) [][]byte {
+		chunks, _ := cm.CreateChunks(buff)
+		return chunks
+	}
+	process := func(data []byte, pid p2p.PeerID) error {
+		msg, _ := memp2p.NewMessage(chunking.ChunksTopicName("miniblocks"), data, pid)
+		return reassembler.ProcessReceivedMessage(context.Background(), msg)
+	}
+
+	return &received, createChunks, process, reassembler.NumPendingPayloads
+}
+
+func tamperChunk(t *testing.T, data []byte, tamper func(chunk *chunking.Chunk)) []byte {
+	marshalizer := &marshal.JsonMarshalizer{}
+	chunk := &chunking.Chunk{}
+	err := marshalizer.Unmarshal(chunk, data)
+	assert.Nil(t, err)
+
+	tamper(chunk)
+	buff, _ := marshalizer.Marshal(chunk)
+
+	return buff
+}
+
+func TestChunksReassembler_NilMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+	cm, _ := chunking.NewChunkingMessenger(messenger, &marshal.JsonMarshalizer{}, sha256.Sha256{}, 10, 5, 10, time.Second)
+	reassembler := cm.NewChunksReassembler("miniblocks", &mock.MessageProcessorStub{})
+
+	err := reassembler.ProcessReceivedMessage(context.Background(), nil)
+
+	assert.Equal(t, p2p.ErrNilMessage, err)
+}
+
+func TestChunksReassembler_OutOfOrderChunksShouldReassemble(t *testing.T) {
+	t.Parallel()
+
+	received, createChunks, process, numPending := createReassembler(10, time.Second)
+	chunks := createChunks(testPayload)
+
+	for i := len(chunks) - 1; i >= 0; i-- {
+		err := process(chunks[i], "peer")
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, [][]byte{testPayload}, *received)
+	assert.Equal(t, 0, numPending())
+}
+
+func TestChunksReassembler_DuplicatedChunkShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	received, createChunks, process, _ := createReassembler(10, time.Second)
+	chunks := createChunks(testPayload)
+
+	_ = process(chunks[1], "peer")
+	err := process(chunks[1], "peer")
+	assert.Nil(t, err)
+
+	for _, chunk := range chunks {
+		_ = process(chunk, "peer")
+	}
+
+	assert.Equal(t, [][]byte{testPayload}, *received)
+}
+
+func TestChunksReassembler_InvalidChunksShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, createChunks, process, _ := createReassembler(10, time.Second)
+	chunks := createChunks(testPayload)
+
+	err := process([]byte("not a chunk"), "peer")
+	assert.NotNil(t, err)
+
+	err = process(tamperChunk(t, chunks[1], func(chunk *chunking.Chunk) { chunk.PayloadHash = []byte("short") }), "peer")
+	assert.Equal(t, p2p.ErrInvalidPayloadHash, err)
+
+	err = process(tamperChunk(t, chunks[1], func(chunk *chunking.Chunk) { chunk.NumChunks = maxNumChunks + 1 }), "peer")
+	assert.Equal(t, p2p.ErrInvalidNumChunks, err)
+
+	err = process(tamperChunk(t, chunks[1], func(chunk *chunking.Chunk) { chunk.Index = chunk.NumChunks }), "peer")
+	assert.Equal(t, p2p.ErrInvalidChunkIndex, err)
+
+	err = process(tamperChunk(t, chunks[1], func(chunk *chunking.Chunk) { chunk.Data = nil }), "peer")
+	assert.Equal(t, p2p.ErrInvalidChunkSize, err)
+
+	err = process(tamperChunk(t, chunks[0], func(chunk *chunking.Chunk) { chunk.ChunkHashes = nil }), "peer")
+	assert.Equal(t, p2p.ErrInvalidChunkHashes, err)
+
+	err = process(tamperChunk(t, chunks[1], func(chunk *chunking.Chunk) { chunk.ChunkHashes = [][]byte{{1}} }), "peer")
+	assert.Equal(t, p2p.ErrInvalidChunkHashes, err)
+}
+
+func TestChunksReassembler_TamperedChunkShouldNotBeAccepted(t *testing.T) {
+	t.Parallel()
+
+	received, createChunks, process, _ := createReassembler(10, time.Second)
+	chunks := createChunks(testPayload)
+	tamperedChunk := tamperChunk(t, chunks[2], func(chunk *chunking.Chunk) { chunk.Data[0]++ })
+
+	_ = process(chunks[0], "peer")
+	err := process(tamperedChunk, "peer")
+	assert.Equal(t, p2p.ErrChunkHashMismatch, err)
+
+	for _, chunk := range chunks[1:] {
+		err = process(chunk, "peer")
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, [][]byte{testPayload}, *received)
+}
+
+func TestChunksReassembler_TamperedChunkBeforeAnnouncementShouldBeDropped(t *testing.T) {
+	t.Parallel()
+
+	received, createChunks, process, _ := createReassembler(10, time.Second)
+	chunks := createChunks(testPayload)
+	tamperedChunk := tamperChunk(t, chunks[2], func(chunk *chunking.Chunk) { chunk.Data[0]++ })
+
+	_ = process(tamperedChunk, "peer")
+	for _, chunk := range chunks {
+		_ = process(chunk, "peer")
+	}
+
+	assert.Equal(t, [][]byte{testPayload}, *received)
+}
+
+func TestChunksReassembler_PayloadHashMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	received, createChunks, process, numPending := createReassembler(10, time.Second)
+	chunks := createChunks(testPayload)
+	otherHash := sha256.Sha256{}.Compute("other payload")
+	for i := range chunks {
+		chunks[i] = tamperChunk(t, chunks[i], func(chunk *chunking.Chunk) { chunk.PayloadHash = otherHash })
+	}
+
+	var err error
+	for _, chunk := range chunks {
+		err = process(chunk, "peer")
+	}
+
+	assert.Equal(t, p2p.ErrPayloadHashMismatch, err)
+	assert.Equal(t, 0, len(*received))
+	assert.Equal(t, 0, numPending())
+}
+
+func TestChunksReassembler_TooManyPendingPayloadsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, createChunks, process, numPending := createReassembler(1, time.Second)
+
+	err := process(createChunks(testPayload)[0], "peer")
+	assert.Nil(t, err)
+
+	err = process(createChunks(append(testPayload, 'a'))[0], "peer")
+	assert.Equal(t, p2p.ErrTooManyPendingPayloads, err)
+	assert.Equal(t, 1, numPending())
+}
+
+func TestChunksReassembler_ExpiredPayloadsShouldBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	received, createChunks, process, numPending := createReassembler(1, time.Millisecond*50)
+	chunks := createChunks(testPayload)
+
+	_ = process(chunks[0], "peer")
+	time.Sleep(time.Millisecond * 100)
+
+	err := process(createChunks(append(testPayload, 'a'))[0], "peer")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numPending())
+
+	for _, chunk := range chunks[1:] {
+		_ = process(chunk, "peer")
+	}
+	assert.Equal(t, 0, len(*received))
+}
+
+func TestChunksReassembler_SamePayloadFromDifferentPeersShouldBeKeptApart(t *testing.T) {
+	t.Parallel()
+
+	received, createChunks, process, _ := createReassembler(10, time.Second)
+	chunks := createChunks(testPayload)
+
+	for _, chunk := range chunks {
+		_ = process(chunk, "peer1")
+		_ = process(chunk, "peer2")
+	}
+
+	assert.Equal(t, [][]byte{testPayload, testPayload}, *received)
+}
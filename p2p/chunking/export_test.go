@@ -0,0 +1,13 @@
+package chunking
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+func (cm *chunkingMessenger) CreateChunks(buff []byte) ([][]byte, error) {
+	return cm.createChunks(buff)
+}
+
+func (cm *chunkingMessenger) NewChunksReassembler(topic string, handler p2p.MessageProcessor) *chunksReassembler {
+	return newChunksReassembler(topic, handler, cm)
+}
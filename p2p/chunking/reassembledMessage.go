@@ -0,0 +1,64 @@
+package chunking
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// reassembledMessage is the p2p.MessageP2P delivered to the original topic's processor after all the chunks of
+// a payload have been received. The originator related fields are taken from the last received chunk
+type reassembledMessage struct {
+	lastChunk p2p.MessageP2P
+	topic     string
+	payload   []byte
+}
+
+func newReassembledMessage(lastChunk p2p.MessageP2P, topic string, payload []byte) *reassembledMessage {
+	return &reassembledMessage{
+		lastChunk: lastChunk,
+		topic:     topic,
+		payload:   payload,
+	}
+}
+
+// From returns the message originator's peer ID
+func (rm *reassembledMessage) From() []byte {
+	return rm.lastChunk.From()
+}
+
+// Data returns the reassembled payload
+func (rm *reassembledMessage) Data() []byte {
+	return rm.payload
+}
+
+// SeqNo returns the sequence number of the last received chunk
+func (rm *reassembledMessage) SeqNo() []byte {
+	return rm.lastChunk.SeqNo()
+}
+
+// TopicIDs returns the original topic of the payload
+func (rm *reassembledMessage) TopicIDs() []string {
+	return []string{rm.topic}
+}
+
+// Signature returns nil as the signatures of the chunks do not cover the reassembled payload
+func (rm *reassembledMessage) Signature() []byte {
+	return nil
+}
+
+// Key returns the public key of the message originator
+func (rm *reassembledMessage) Key() []byte {
+	return rm.lastChunk.Key()
+}
+
+// Peer returns the peer that originated the message
+func (rm *reassembledMessage) Peer() p2p.PeerID {
+	return rm.lastChunk.Peer()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rm *reassembledMessage) IsInterfaceNil() bool {
+	if rm == nil {
+		return true
+	}
+	return false
+}
@@ -81,3 +81,82 @@ var ErrNilFetchPeersOnTopicHandler = errors.New("nil fetch peers on topic handle
 
 // ErrInvalidDurationProvided signals that an invalid time.Duration has been provided
 var ErrInvalidDurationProvided = errors.New("invalid time.Duration provided")
+
+// ErrNilMessenger signals that a nil messenger has been provided
+var ErrNilMessenger = errors.New("nil messenger")
+
+// ErrEmptyNamespace signals that an empty network namespace has been provided
+var ErrEmptyNamespace = errors.New("empty network namespace")
+
+// ErrInvalidMaxConnections signals that an invalid maximum number of connections has been provided
+var ErrInvalidMaxConnections = errors.New("invalid maximum number of connections")
+
+// ErrInvalidMaxPeersInResponse signals that an invalid maximum number of peers in a peer exchange response
+// has been provided
+var ErrInvalidMaxPeersInResponse = errors.New("invalid maximum number of peers in response")
+
+// ErrPeerExchangeAlreadyEnabled signals that the peer exchange protocol has already been enabled
+var ErrPeerExchangeAlreadyEnabled = errors.New("peer exchange is already enabled")
+
+// ErrDialBackAlreadyEnabled signals that the dial back protocol has already been enabled
+var ErrDialBackAlreadyEnabled = errors.New("dial back is already enabled")
+
+// ErrNoSeeders signals that no seeder address was provided
+var ErrNoSeeders = errors.New("no seeders provided")
+
+// ErrPortNotReachable signals that the port could not be reached from the outside
+var ErrPortNotReachable = errors.New("port not reachable")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrInvalidMaxChunkSize signals that an invalid maximum chunk size has been provided
+var ErrInvalidMaxChunkSize = errors.New("invalid maximum chunk size")
+
+// ErrInvalidMaxNumChunks signals that an invalid maximum number of chunks has been provided
+var ErrInvalidMaxNumChunks = errors.New("invalid maximum number of chunks")
+
+// ErrInvalidMaxPendingPayloads signals that an invalid maximum number of pending payloads has been provided
+var ErrInvalidMaxPendingPayloads = errors.New("invalid maximum number of pending payloads")
+
+// ErrInvalidNumChunks signals that a chunk announcing an invalid number of chunks has been received
+var ErrInvalidNumChunks = errors.New("invalid number of chunks")
+
+// ErrInvalidChunkIndex signals that a chunk with an invalid index has been received
+var ErrInvalidChunkIndex = errors.New("invalid chunk index")
+
+// ErrInvalidChunkSize signals that a chunk with an empty or oversized data field has been received
+var ErrInvalidChunkSize = errors.New("invalid chunk size")
+
+// ErrInvalidPayloadHash signals that a chunk with an invalid payload hash has been received
+var ErrInvalidPayloadHash = errors.New("invalid payload hash")
+
+// ErrInvalidChunkHashes signals that a chunk with an invalid chunk hashes list has been received
+var ErrInvalidChunkHashes = errors.New("invalid chunk hashes")
+
+// ErrChunkHashMismatch signals that the hash of a received chunk does not match the announced one
+var ErrChunkHashMismatch = errors.New("chunk hash mismatch")
+
+// ErrPayloadHashMismatch signals that the hash of a reassembled payload does not match the announced one
+var ErrPayloadHashMismatch = errors.New("payload hash mismatch")
+
+// ErrTooManyPendingPayloads signals that the maximum number of payloads waiting to be reassembled has been reached
+var ErrTooManyPendingPayloads = errors.New("too many pending payloads")
+
+// ErrInvalidMaxPeersPerNetworkGroup signals that an invalid maximum number of peers per network group has been provided
+var ErrInvalidMaxPeersPerNetworkGroup = errors.New("invalid maximum number of peers per network group")
+
+// ErrInvalidNetworkPrefixLength signals that an invalid network prefix length has been provided
+var ErrInvalidNetworkPrefixLength = errors.New("invalid network prefix length")
+
+// ErrInvalidSwarmKey signals that the provided swarm key does not follow the pre-shared key format
+var ErrInvalidSwarmKey = errors.New("invalid swarm key")
+
+// ErrNilPreSharedKey signals that a nil pre-shared key has been provided
+var ErrNilPreSharedKey = errors.New("nil pre-shared key")
+
+// ErrNilNetworkProtector signals that a nil private network protector has been provided
+var ErrNilNetworkProtector = errors.New("nil private network protector")
@@ -0,0 +1,26 @@
+package forensics
+
+import (
+	"errors"
+)
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilMessageProcessor signals that a nil message processor has been provided
+var ErrNilMessageProcessor = errors.New("nil message processor")
+
+// ErrNilMessage signals that a nil message has been provided
+var ErrNilMessage = errors.New("nil message")
+
+// ErrInvalidMaxPeers signals that an invalid maximum number of tracked peers has been provided
+var ErrInvalidMaxPeers = errors.New("invalid maximum number of tracked peers")
+
+// ErrInvalidMaxMessagesPerPeer signals that an invalid maximum number of captured messages per peer has been provided
+var ErrInvalidMaxMessagesPerPeer = errors.New("invalid maximum number of captured messages per peer")
+
+// ErrInvalidMaxMessageSize signals that an invalid maximum captured message size has been provided
+var ErrInvalidMaxMessageSize = errors.New("invalid maximum captured message size")
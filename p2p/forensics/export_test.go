@@ -0,0 +1,9 @@
+package forensics
+
+import (
+	"time"
+)
+
+func (mc *messageCapture) SetCurrentTime(currentTime func() time.Time) {
+	mc.currentTime = currentTime
+}
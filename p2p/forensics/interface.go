@@ -0,0 +1,7 @@
+package forensics
+
+// ForensicStorer defines the storage the forensic records are written to
+type ForensicStorer interface {
+	Put(key, data []byte) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,199 @@
+package forensics
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+// CapturedMessage is a raw message sample kept for a peer. The data is truncated to the configured maximum size,
+// the original size being kept for reference
+type CapturedMessage struct {
+	Timestamp    int64    `json:"timestamp"`
+	Topics       []string `json:"topics"`
+	SeqNo        []byte   `json:"seqNo"`
+	Data         []byte   `json:"data"`
+	OriginalSize int      `json:"originalSize"`
+}
+
+// ForensicRecord is written in the forensic storer when a peer is blacklisted
+type ForensicRecord struct {
+	Peer          string            `json:"peer"`
+	Reason        string            `json:"reason"`
+	BlacklistedAt int64             `json:"blacklistedAt"`
+	Messages      []CapturedMessage `json:"messages"`
+}
+
+// messageCapture keeps, for the most recently active peers, a bounded sample of their last received messages. When
+// a peer gets blacklisted, its sample is written in the forensic storer for offline analysis
+type messageCapture struct {
+	mutCapture         sync.Mutex
+	storer             ForensicStorer
+	marshalizer        marshal.Marshalizer
+	peers              storage.Cacher
+	maxMessagesPerPeer int
+	maxMessageSize     int
+	currentTime        func() time.Time
+}
+
+// NewMessageCapture creates a new message capture keeping at most maxMessagesPerPeer messages, each truncated to
+// maxMessageSize bytes, for the last maxPeers active peers
+func NewMessageCapture(
+	storer ForensicStorer,
+	marshalizer marshal.Marshalizer,
+	maxPeers int,
+	maxMessagesPerPeer int,
+	maxMessageSize int,
+) (*messageCapture, error) {
+	if storer == nil || storer.IsInterfaceNil() {
+		return nil, ErrNilStorer
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if maxPeers <= 0 {
+		return nil, ErrInvalidMaxPeers
+	}
+	if maxMessagesPerPeer <= 0 {
+		return nil, ErrInvalidMaxMessagesPerPeer
+	}
+	if maxMessageSize <= 0 {
+		return nil, ErrInvalidMaxMessageSize
+	}
+
+	peers, err := lrucache.NewCache(maxPeers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &messageCapture{
+		storer:             storer,
+		marshalizer:        marshalizer,
+		peers:              peers,
+		maxMessagesPerPeer: maxMessagesPerPeer,
+		maxMessageSize:     maxMessageSize,
+		currentTime:        time.Now,
+	}, nil
+}
+
+// Record adds the provided message to its originating peer's sample. The oldest message is dropped when the
+// sample is full
+func (mc *messageCapture) Record(message p2p.MessageP2P) {
+	if message == nil || message.IsInterfaceNil() {
+		return
+	}
+
+	data := message.Data()
+	captureSize := len(data)
+	if captureSize > mc.maxMessageSize {
+		captureSize = mc.maxMessageSize
+	}
+
+	captured := CapturedMessage{
+		Timestamp:    mc.currentTime().UnixNano(),
+		Topics:       message.TopicIDs(),
+		SeqNo:        message.SeqNo(),
+		Data:         make([]byte, captureSize),
+		OriginalSize: len(data),
+	}
+	copy(captured.Data, data)
+
+	mc.mutCapture.Lock()
+	defer mc.mutCapture.Unlock()
+
+	key := message.Peer().Bytes()
+	var messages []CapturedMessage
+	value, ok := mc.peers.Get(key)
+	if ok {
+		messages, _ = value.([]CapturedMessage)
+	}
+
+	messages = append(messages, captured)
+	if len(messages) > mc.maxMessagesPerPeer {
+		messages = messages[len(messages)-mc.maxMessagesPerPeer:]
+	}
+	mc.peers.Put(key, messages)
+}
+
+// PeerBlacklisted writes the provided peer's sample, together with the blacklisting reason, in the forensic storer
+// and releases the sample. A record is written even if no message was captured for the peer
+func (mc *messageCapture) PeerBlacklisted(pid p2p.PeerID, reason string) error {
+	mc.mutCapture.Lock()
+	var messages []CapturedMessage
+	value, ok := mc.peers.Peek(pid.Bytes())
+	if ok {
+		messages, _ = value.([]CapturedMessage)
+	}
+	mc.peers.Remove(pid.Bytes())
+	mc.mutCapture.Unlock()
+
+	if messages == nil {
+		messages = make([]CapturedMessage, 0)
+	}
+
+	record := &ForensicRecord{
+		Peer:          pid.Pretty(),
+		Reason:        reason,
+		BlacklistedAt: mc.currentTime().UnixNano(),
+		Messages:      messages,
+	}
+
+	buff, err := mc.marshalizer.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	key := []byte(fmt.Sprintf("%s_%d", record.Peer, record.BlacklistedAt))
+	return mc.storer.Put(key, buff)
+}
+
+// CapturingProcessor returns a message processor that records every received message before handing it to the
+// provided processor
+func (mc *messageCapture) CapturingProcessor(processor p2p.MessageProcessor) (p2p.MessageProcessor, error) {
+	if processor == nil || processor.IsInterfaceNil() {
+		return nil, ErrNilMessageProcessor
+	}
+
+	return &capturingProcessor{
+		capture:   mc,
+		processor: processor,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mc *messageCapture) IsInterfaceNil() bool {
+	if mc == nil {
+		return true
+	}
+	return false
+}
+
+type capturingProcessor struct {
+	capture   *messageCapture
+	processor p2p.MessageProcessor
+}
+
+// ProcessReceivedMessage records the message and then processes it with the wrapped processor
+func (cp *capturingProcessor) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
+	if message == nil || message.IsInterfaceNil() {
+		return ErrNilMessage
+	}
+
+	cp.capture.Record(message)
+
+	return cp.processor.ProcessReceivedMessage(ctx, message)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cp *capturingProcessor) IsInterfaceNil() bool {
+	if cp == nil {
+		return true
+	}
+	return false
+}
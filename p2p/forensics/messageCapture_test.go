@@ -0,0 +1,198 @@
+package forensics_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/forensics"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+const testPeer = p2p.PeerID("peer")
+
+func createRecordingStorer(records map[string][]byte) *mock.ForensicStorerStub {
+	return &mock.ForensicStorerStub{
+		PutCalled: func(key, data []byte) error {
+			records[string(key)] = data
+			return nil
+		},
+	}
+}
+
+func createMessage(data string, pid p2p.PeerID) p2p.MessageP2P {
+	msg, _ := memp2p.NewMessage("topic", []byte(data), pid)
+	return msg
+}
+
+func unmarshalSingleRecord(t *testing.T, records map[string][]byte) *forensics.ForensicRecord {
+	assert.Equal(t, 1, len(records))
+
+	record := &forensics.ForensicRecord{}
+	for _, buff := range records {
+		err := json.Unmarshal(buff, record)
+		assert.Nil(t, err)
+	}
+
+	return record
+}
+
+func TestNewMessageCapture_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	storer := createRecordingStorer(make(map[string][]byte))
+	marshalizer := &marshal.JsonMarshalizer{}
+
+	mc, err := forensics.NewMessageCapture(nil, marshalizer, 1, 1, 1)
+	assert.Nil(t, mc)
+	assert.Equal(t, forensics.ErrNilStorer, err)
+
+	mc, err = forensics.NewMessageCapture(storer, nil, 1, 1, 1)
+	assert.Nil(t, mc)
+	assert.Equal(t, forensics.ErrNilMarshalizer, err)
+
+	mc, err = forensics.NewMessageCapture(storer, marshalizer, 0, 1, 1)
+	assert.Nil(t, mc)
+	assert.Equal(t, forensics.ErrInvalidMaxPeers, err)
+
+	mc, err = forensics.NewMessageCapture(storer, marshalizer, 1, 0, 1)
+	assert.Nil(t, mc)
+	assert.Equal(t, forensics.ErrInvalidMaxMessagesPerPeer, err)
+
+	mc, err = forensics.NewMessageCapture(storer, marshalizer, 1, 1, 0)
+	assert.Nil(t, mc)
+	assert.Equal(t, forensics.ErrInvalidMaxMessageSize, err)
+}
+
+func TestNewMessageCapture_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	mc, err := forensics.NewMessageCapture(createRecordingStorer(make(map[string][]byte)), &marshal.JsonMarshalizer{}, 1, 1, 1)
+
+	assert.Nil(t, err)
+	assert.False(t, mc.IsInterfaceNil())
+}
+
+func TestMessageCapture_PeerBlacklistedShouldWriteTheLastTruncatedMessages(t *testing.T) {
+	t.Parallel()
+
+	records := make(map[string][]byte)
+	mc, _ := forensics.NewMessageCapture(createRecordingStorer(records), &marshal.JsonMarshalizer{}, 10, 2, 4)
+	mc.SetCurrentTime(func() time.Time {
+		return time.Unix(0, 1000)
+	})
+
+	mc.Record(createMessage("first", testPeer))
+	mc.Record(createMessage("second", testPeer))
+	mc.Record(createMessage("abc", testPeer))
+	mc.Record(createMessage("other peer", "other"))
+
+	err := mc.PeerBlacklisted(testPeer, "flooding")
+	assert.Nil(t, err)
+
+	_, found := records[fmt.Sprintf("%s_%d", testPeer.Pretty(), 1000)]
+	assert.True(t, found)
+
+	record := unmarshalSingleRecord(t, records)
+	assert.Equal(t, testPeer.Pretty(), record.Peer)
+	assert.Equal(t, "flooding", record.Reason)
+	assert.Equal(t, int64(1000), record.BlacklistedAt)
+	assert.Equal(t, 2, len(record.Messages))
+	assert.Equal(t, []byte("seco"), record.Messages[0].Data)
+	assert.Equal(t, len("second"), record.Messages[0].OriginalSize)
+	assert.Equal(t, []byte("abc"), record.Messages[1].Data)
+	assert.Equal(t, []string{"topic"}, record.Messages[1].Topics)
+}
+
+func TestMessageCapture_PeerBlacklistedShouldReleaseTheSample(t *testing.T) {
+	t.Parallel()
+
+	records := make(map[string][]byte)
+	mc, _ := forensics.NewMessageCapture(createRecordingStorer(records), &marshal.JsonMarshalizer{}, 10, 2, 4)
+	currentTime := int64(0)
+	mc.SetCurrentTime(func() time.Time {
+		currentTime++
+		return time.Unix(0, currentTime)
+	})
+
+	mc.Record(createMessage("abc", testPeer))
+	_ = mc.PeerBlacklisted(testPeer, "flooding")
+	_ = mc.PeerBlacklisted(testPeer, "flooding")
+
+	assert.Equal(t, 2, len(records))
+	record := &forensics.ForensicRecord{}
+	_ = json.Unmarshal(records[fmt.Sprintf("%s_%d", testPeer.Pretty(), currentTime)], record)
+	assert.Equal(t, 0, len(record.Messages))
+}
+
+func TestMessageCapture_LeastRecentlyActivePeersShouldBeEvicted(t *testing.T) {
+	t.Parallel()
+
+	records := make(map[string][]byte)
+	mc, _ := forensics.NewMessageCapture(createRecordingStorer(records), &marshal.JsonMarshalizer{}, 1, 2, 4)
+
+	mc.Record(createMessage("abc", testPeer))
+	mc.Record(createMessage("abc", "other"))
+	_ = mc.PeerBlacklisted(testPeer, "flooding")
+
+	record := unmarshalSingleRecord(t, records)
+	assert.Equal(t, 0, len(record.Messages))
+}
+
+func TestMessageCapture_PeerBlacklistedStorerErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	storer := &mock.ForensicStorerStub{
+		PutCalled: func(key, data []byte) error {
+			return errExpected
+		},
+	}
+	mc, _ := forensics.NewMessageCapture(storer, &marshal.JsonMarshalizer{}, 1, 1, 1)
+
+	err := mc.PeerBlacklisted(testPeer, "flooding")
+
+	assert.Equal(t, errExpected, err)
+}
+
+func TestMessageCapture_CapturingProcessorNilProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mc, _ := forensics.NewMessageCapture(createRecordingStorer(make(map[string][]byte)), &marshal.JsonMarshalizer{}, 1, 1, 1)
+
+	processor, err := mc.CapturingProcessor(nil)
+
+	assert.Nil(t, processor)
+	assert.Equal(t, forensics.ErrNilMessageProcessor, err)
+}
+
+func TestMessageCapture_CapturingProcessorShouldRecordAndForward(t *testing.T) {
+	t.Parallel()
+
+	records := make(map[string][]byte)
+	mc, _ := forensics.NewMessageCapture(createRecordingStorer(records), &marshal.JsonMarshalizer{}, 10, 2, 10)
+	errProcess := errors.New("process error")
+	processor, _ := mc.CapturingProcessor(&mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P) error {
+			return errProcess
+		},
+	})
+
+	err := processor.ProcessReceivedMessage(context.Background(), nil)
+	assert.Equal(t, forensics.ErrNilMessage, err)
+
+	err = processor.ProcessReceivedMessage(context.Background(), createMessage("abc", testPeer))
+	assert.Equal(t, errProcess, err)
+
+	_ = mc.PeerBlacklisted(testPeer, "flooding")
+	record := unmarshalSingleRecord(t, records)
+	assert.Equal(t, 1, len(record.Messages))
+	assert.Equal(t, []byte("abc"), record.Messages[0].Data)
+}
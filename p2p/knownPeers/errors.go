@@ -0,0 +1,14 @@
+package knownPeers
+
+import (
+	"errors"
+)
+
+// ErrEmptyFilePath signals that an empty file path has been provided
+var ErrEmptyFilePath = errors.New("empty file path")
+
+// ErrInvalidMaxAge signals that an invalid maximum age has been provided
+var ErrInvalidMaxAge = errors.New("invalid maximum age")
+
+// ErrInvalidMaxPeers signals that an invalid maximum number of peers has been provided
+var ErrInvalidMaxPeers = errors.New("invalid maximum number of peers")
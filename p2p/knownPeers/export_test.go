@@ -0,0 +1,9 @@
+package knownPeers
+
+import (
+	"time"
+)
+
+func (kpp *knownPeersPersister) SetCurrentTimeHandler(currentTime func() time.Time) {
+	kpp.currentTime = currentTime
+}
@@ -0,0 +1,8 @@
+package knownPeers
+
+// PeersPersister defines the behavior of a component able to store and load the known peers list
+type PeersPersister interface {
+	Load() ([]*KnownPeer, error)
+	Save(connectedPeers []*KnownPeer) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,9 @@
+package knownPeers
+
+// KnownPeer holds a peer address that this node has been connected to, together with the shard tag of the peer
+// and the last time (unix seconds) the peer has been seen connected
+type KnownPeer struct {
+	Address  string
+	ShardTag string
+	LastSeen int64
+}
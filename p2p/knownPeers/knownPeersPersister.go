@@ -0,0 +1,164 @@
+package knownPeers
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// knownPeersPersister keeps the list of the last known healthy peers in a json file so that a restarted node can
+// reconnect to them. Entries not seen for more than the maximum age are pruned each time the list is loaded or saved
+type knownPeersPersister struct {
+	filePath    string
+	maxAge      time.Duration
+	maxPeers    int
+	currentTime func() time.Time
+	mutFile     sync.Mutex
+}
+
+// NewKnownPeersPersister creates a new known peers persister that uses the provided file
+func NewKnownPeersPersister(filePath string, maxAge time.Duration, maxPeers int) (*knownPeersPersister, error) {
+	if len(filePath) == 0 {
+		return nil, ErrEmptyFilePath
+	}
+	if maxAge <= 0 {
+		return nil, ErrInvalidMaxAge
+	}
+	if maxPeers <= 0 {
+		return nil, ErrInvalidMaxPeers
+	}
+
+	return &knownPeersPersister{
+		filePath:    filePath,
+		maxAge:      maxAge,
+		maxPeers:    maxPeers,
+		currentTime: time.Now,
+	}, nil
+}
+
+// Load returns the persisted peers that are not older than the maximum age, the most recently seen first.
+// A missing file is not an error, an empty list being returned instead
+func (kpp *knownPeersPersister) Load() ([]*KnownPeer, error) {
+	kpp.mutFile.Lock()
+	defer kpp.mutFile.Unlock()
+
+	peers, err := kpp.readFile()
+	if err != nil {
+		return nil, err
+	}
+
+	return kpp.prune(peers), nil
+}
+
+// Save merges the provided peers, marked as seen now, into the persisted list, prunes the result and writes it back
+func (kpp *knownPeersPersister) Save(connectedPeers []*KnownPeer) error {
+	kpp.mutFile.Lock()
+	defer kpp.mutFile.Unlock()
+
+	persistedPeers, err := kpp.readFile()
+	if err != nil {
+		log.Warn("known peers file could not be read, it will be overwritten: " + err.Error())
+		persistedPeers = make([]*KnownPeer, 0)
+	}
+
+	peersByAddress := make(map[string]*KnownPeer)
+	for _, kp := range persistedPeers {
+		peersByAddress[kp.Address] = kp
+	}
+
+	now := kpp.currentTime().Unix()
+	for _, kp := range connectedPeers {
+		if kp == nil || len(kp.Address) == 0 {
+			continue
+		}
+
+		peersByAddress[kp.Address] = &KnownPeer{
+			Address:  kp.Address,
+			ShardTag: kp.ShardTag,
+			LastSeen: now,
+		}
+	}
+
+	mergedPeers := make([]*KnownPeer, 0, len(peersByAddress))
+	for _, kp := range peersByAddress {
+		mergedPeers = append(mergedPeers, kp)
+	}
+
+	return kpp.writeFile(kpp.prune(mergedPeers))
+}
+
+// prune removes the entries older than the maximum age and keeps at most maxPeers entries, the most recently seen
+func (kpp *knownPeersPersister) prune(peers []*KnownPeer) []*KnownPeer {
+	oldestAccepted := kpp.currentTime().Add(-kpp.maxAge).Unix()
+
+	prunedPeers := make([]*KnownPeer, 0, len(peers))
+	for _, kp := range peers {
+		if kp.LastSeen < oldestAccepted {
+			continue
+		}
+
+		prunedPeers = append(prunedPeers, kp)
+	}
+
+	sort.SliceStable(prunedPeers, func(i, j int) bool {
+		return prunedPeers[i].LastSeen > prunedPeers[j].LastSeen
+	})
+
+	if len(prunedPeers) > kpp.maxPeers {
+		prunedPeers = prunedPeers[:kpp.maxPeers]
+	}
+
+	return prunedPeers
+}
+
+func (kpp *knownPeersPersister) readFile() ([]*KnownPeer, error) {
+	buff, err := ioutil.ReadFile(kpp.filePath)
+	if os.IsNotExist(err) {
+		return make([]*KnownPeer, 0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]*KnownPeer, 0)
+	err = json.Unmarshal(buff, &peers)
+	if err != nil {
+		return nil, err
+	}
+
+	return peers, nil
+}
+
+// writeFile writes the peers in a temporary file which then replaces the persisted one, so that an interrupted
+// write can not leave a corrupted list behind
+func (kpp *knownPeersPersister) writeFile(peers []*KnownPeer) error {
+	buff, err := json.MarshalIndent(peers, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(kpp.filePath), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	tempFilePath := kpp.filePath + ".tmp"
+	err = ioutil.WriteFile(tempFilePath, buff, 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilePath, kpp.filePath)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (kpp *knownPeersPersister) IsInterfaceNil() bool {
+	if kpp == nil {
+		return true
+	}
+	return false
+}
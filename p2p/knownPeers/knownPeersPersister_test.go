@@ -0,0 +1,151 @@
+package knownPeers_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p/knownPeers"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTempFilePath(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "knownPeers")
+	assert.Nil(t, err)
+
+	return filepath.Join(dir, "peers", "knownPeers.json"), func() {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestNewKnownPeersPersister_EmptyFilePathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	kpp, err := knownPeers.NewKnownPeersPersister("", time.Hour, 10)
+
+	assert.Nil(t, kpp)
+	assert.Equal(t, knownPeers.ErrEmptyFilePath, err)
+}
+
+func TestNewKnownPeersPersister_InvalidMaxAgeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	kpp, err := knownPeers.NewKnownPeersPersister("file.json", 0, 10)
+
+	assert.Nil(t, kpp)
+	assert.Equal(t, knownPeers.ErrInvalidMaxAge, err)
+}
+
+func TestNewKnownPeersPersister_InvalidMaxPeersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	kpp, err := knownPeers.NewKnownPeersPersister("file.json", time.Hour, 0)
+
+	assert.Nil(t, kpp)
+	assert.Equal(t, knownPeers.ErrInvalidMaxPeers, err)
+}
+
+func TestKnownPeersPersister_LoadMissingFileShouldReturnEmptyList(t *testing.T) {
+	t.Parallel()
+
+	filePath, cleanup := createTempFilePath(t)
+	defer cleanup()
+	kpp, _ := knownPeers.NewKnownPeersPersister(filePath, time.Hour, 10)
+
+	peers, err := kpp.Load()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(peers))
+}
+
+func TestKnownPeersPersister_LoadCorruptedFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	filePath, cleanup := createTempFilePath(t)
+	defer cleanup()
+	_ = os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+	_ = ioutil.WriteFile(filePath, []byte("not a json"), 0644)
+	kpp, _ := knownPeers.NewKnownPeersPersister(filePath, time.Hour, 10)
+
+	peers, err := kpp.Load()
+
+	assert.Nil(t, peers)
+	assert.NotNil(t, err)
+}
+
+func TestKnownPeersPersister_SaveAndLoadShouldWork(t *testing.T) {
+	t.Parallel()
+
+	filePath, cleanup := createTempFilePath(t)
+	defer cleanup()
+	kpp, _ := knownPeers.NewKnownPeersPersister(filePath, time.Hour, 10)
+	now := time.Unix(10000, 0)
+	kpp.SetCurrentTimeHandler(func() time.Time {
+		return now
+	})
+
+	err := kpp.Save([]*knownPeers.KnownPeer{
+		{Address: "/ip4/127.0.0.1/tcp/10000/p2p/peer1", ShardTag: "0"},
+		nil,
+		{Address: ""},
+	})
+	assert.Nil(t, err)
+
+	peers, err := kpp.Load()
+	assert.Nil(t, err)
+	assert.Equal(t, []*knownPeers.KnownPeer{
+		{Address: "/ip4/127.0.0.1/tcp/10000/p2p/peer1", ShardTag: "0", LastSeen: now.Unix()},
+	}, peers)
+}
+
+func TestKnownPeersPersister_SaveShouldMergeAndPruneOldPeers(t *testing.T) {
+	t.Parallel()
+
+	filePath, cleanup := createTempFilePath(t)
+	defer cleanup()
+	kpp, _ := knownPeers.NewKnownPeersPersister(filePath, time.Hour, 10)
+	now := time.Unix(10000, 0)
+	kpp.SetCurrentTimeHandler(func() time.Time {
+		return now
+	})
+
+	_ = kpp.Save([]*knownPeers.KnownPeer{{Address: "old"}, {Address: "recent"}})
+
+	now = now.Add(30 * time.Minute)
+	_ = kpp.Save([]*knownPeers.KnownPeer{{Address: "recent", ShardTag: "1"}, {Address: "new"}})
+
+	now = now.Add(45 * time.Minute)
+	peers, err := kpp.Load()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(peers))
+	assert.Equal(t, "recent", peers[0].Address)
+	assert.Equal(t, "1", peers[0].ShardTag)
+	assert.Equal(t, "new", peers[1].Address)
+}
+
+func TestKnownPeersPersister_SaveShouldKeepMostRecentMaxPeers(t *testing.T) {
+	t.Parallel()
+
+	filePath, cleanup := createTempFilePath(t)
+	defer cleanup()
+	kpp, _ := knownPeers.NewKnownPeersPersister(filePath, time.Hour, 2)
+	now := time.Unix(10000, 0)
+	kpp.SetCurrentTimeHandler(func() time.Time {
+		return now
+	})
+
+	_ = kpp.Save([]*knownPeers.KnownPeer{{Address: "peer1"}})
+	now = now.Add(time.Minute)
+	_ = kpp.Save([]*knownPeers.KnownPeer{{Address: "peer2"}})
+	now = now.Add(time.Minute)
+	_ = kpp.Save([]*knownPeers.KnownPeer{{Address: "peer3"}})
+
+	peers, _ := kpp.Load()
+
+	assert.Equal(t, 2, len(peers))
+	assert.Equal(t, "peer3", peers[0].Address)
+	assert.Equal(t, "peer2", peers[1].Address)
+}
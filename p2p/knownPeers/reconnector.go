@@ -0,0 +1,113 @@
+package knownPeers
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+var log = logger.DefaultLogger()
+
+const p2pAddressSeparator = "/p2p/"
+
+// CollectConnectedPeers returns the currently connected peers as known peers. The peers that are also connected on
+// the provided shard topic are tagged with the provided shard tag, the others remaining untagged
+func CollectConnectedPeers(messenger p2p.Messenger, shardTopic string, shardTag string) ([]*KnownPeer, error) {
+	if messenger == nil || messenger.IsInterfaceNil() {
+		return nil, p2p.ErrNilMessenger
+	}
+
+	sameShardPeers := make(map[p2p.PeerID]struct{})
+	for _, pid := range messenger.ConnectedPeersOnTopic(shardTopic) {
+		sameShardPeers[pid] = struct{}{}
+	}
+
+	peers := make([]*KnownPeer, 0)
+	for _, pid := range messenger.ConnectedPeers() {
+		address := messenger.PeerAddress(pid)
+		if len(address) == 0 {
+			continue
+		}
+
+		kp := &KnownPeer{
+			Address: address + p2pAddressSeparator + pid.Pretty(),
+		}
+		if _, ok := sameShardPeers[pid]; ok {
+			kp.ShardTag = shardTag
+		}
+
+		peers = append(peers, kp)
+	}
+
+	return peers, nil
+}
+
+// ReconnectToKnownPeers tries to connect to the provided peers, the ones tagged with the preferred shard tag first.
+// Each group is dialed concurrently and the method waits for at most the provided timeout for each group.
+// It returns the number of peers successfully connected
+func ReconnectToKnownPeers(
+	messenger p2p.Messenger,
+	peers []*KnownPeer,
+	preferredShardTag string,
+	timeout time.Duration,
+) (int, error) {
+	if messenger == nil || messenger.IsInterfaceNil() {
+		return 0, p2p.ErrNilMessenger
+	}
+
+	preferredPeers := make([]*KnownPeer, 0)
+	otherPeers := make([]*KnownPeer, 0)
+	for _, kp := range peers {
+		if kp.ShardTag == preferredShardTag {
+			preferredPeers = append(preferredPeers, kp)
+			continue
+		}
+
+		otherPeers = append(otherPeers, kp)
+	}
+
+	numConnected := connectConcurrently(messenger, preferredPeers, timeout)
+	numConnected += connectConcurrently(messenger, otherPeers, timeout)
+
+	return numConnected, nil
+}
+
+func connectConcurrently(messenger p2p.Messenger, peers []*KnownPeer, timeout time.Duration) int {
+	if len(peers) == 0 {
+		return 0
+	}
+
+	numConnected := int32(0)
+	wg := &sync.WaitGroup{}
+	wg.Add(len(peers))
+	for _, kp := range peers {
+		go func(address string) {
+			defer wg.Done()
+
+			err := messenger.ConnectToPeer(address)
+			if err != nil {
+				log.Debug(fmt.Sprintf("could not reconnect to known peer %s: %s", address, err.Error()))
+				return
+			}
+
+			atomic.AddInt32(&numConnected, 1)
+		}(kp.Address)
+	}
+
+	chDone := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(chDone)
+	}()
+
+	select {
+	case <-chDone:
+	case <-time.After(timeout):
+	}
+
+	return int(atomic.LoadInt32(&numConnected))
+}
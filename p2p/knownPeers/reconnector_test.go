@@ -0,0 +1,117 @@
+package knownPeers_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/knownPeers"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/stretchr/testify/assert"
+)
+
+type connectRecorderMessenger struct {
+	p2p.Messenger
+	mut              sync.Mutex
+	connectedAddress []string
+	failingAddresses map[string]struct{}
+	connectDelay     time.Duration
+}
+
+func (crm *connectRecorderMessenger) ConnectToPeer(address string) error {
+	time.Sleep(crm.connectDelay)
+
+	if _, ok := crm.failingAddresses[address]; ok {
+		return errors.New("connection refused")
+	}
+
+	crm.mut.Lock()
+	crm.connectedAddress = append(crm.connectedAddress, address)
+	crm.mut.Unlock()
+
+	return nil
+}
+
+func (crm *connectRecorderMessenger) IsInterfaceNil() bool {
+	return crm == nil
+}
+
+func TestCollectConnectedPeers_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	peers, err := knownPeers.CollectConnectedPeers(nil, "topic", "0")
+
+	assert.Nil(t, peers)
+	assert.Equal(t, p2p.ErrNilMessenger, err)
+}
+
+func TestCollectConnectedPeers_ShouldTagSameShardPeers(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	self, _ := memp2p.NewMessenger(network)
+	sameShardPeer, _ := memp2p.NewMessenger(network)
+	otherShardPeer, _ := memp2p.NewMessenger(network)
+	_ = sameShardPeer.CreateTopic("consensus_0", false)
+	_ = otherShardPeer.CreateTopic("consensus_1", false)
+
+	peers, err := knownPeers.CollectConnectedPeers(self, "consensus_0", "0")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(peers))
+	for _, kp := range peers {
+		switch kp.Address {
+		case self.PeerAddress(sameShardPeer.ID()) + "/p2p/" + sameShardPeer.ID().Pretty():
+			assert.Equal(t, "0", kp.ShardTag)
+		case self.PeerAddress(otherShardPeer.ID()) + "/p2p/" + otherShardPeer.ID().Pretty():
+			assert.Equal(t, "", kp.ShardTag)
+		default:
+			assert.Fail(t, "unexpected address "+kp.Address)
+		}
+	}
+}
+
+func TestReconnectToKnownPeers_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numConnected, err := knownPeers.ReconnectToKnownPeers(nil, make([]*knownPeers.KnownPeer, 0), "0", time.Second)
+
+	assert.Equal(t, 0, numConnected)
+	assert.Equal(t, p2p.ErrNilMessenger, err)
+}
+
+func TestReconnectToKnownPeers_ShouldConnectPreferredShardFirst(t *testing.T) {
+	t.Parallel()
+
+	messenger := &connectRecorderMessenger{
+		failingAddresses: map[string]struct{}{"failing": {}},
+	}
+	peers := []*knownPeers.KnownPeer{
+		{Address: "other", ShardTag: "1"},
+		{Address: "failing", ShardTag: "0"},
+		{Address: "preferred", ShardTag: "0"},
+	}
+
+	numConnected, err := knownPeers.ReconnectToKnownPeers(messenger, peers, "0", time.Second)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numConnected)
+	assert.Equal(t, []string{"preferred", "other"}, messenger.connectedAddress)
+}
+
+func TestReconnectToKnownPeers_ShouldNotWaitMoreThanTimeout(t *testing.T) {
+	t.Parallel()
+
+	messenger := &connectRecorderMessenger{
+		connectDelay: time.Second,
+	}
+	peers := []*knownPeers.KnownPeer{{Address: "slow", ShardTag: "0"}}
+
+	start := time.Now()
+	numConnected, _ := knownPeers.ReconnectToKnownPeers(messenger, peers, "0", 50*time.Millisecond)
+
+	assert.Equal(t, 0, numConnected)
+	assert.True(t, time.Since(start) < 500*time.Millisecond)
+}
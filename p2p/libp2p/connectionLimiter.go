@@ -0,0 +1,113 @@
+package libp2p
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/connmgr"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// connectionLimiter is a minimal connmgr.ConnManager implementation that closes the inbound connections
+// established after the maximum number of connections has been reached. Outbound connections are never closed
+// as they are requested by the host itself.
+type connectionLimiter struct {
+	maxConnections         int
+	numRejectedConnections uint64
+}
+
+// NewConnectionLimiter creates a new connection limiter that will allow at most maxConnections connections
+func NewConnectionLimiter(maxConnections int) (*connectionLimiter, error) {
+	if maxConnections <= 0 {
+		return nil, p2p.ErrInvalidMaxConnections
+	}
+
+	return &connectionLimiter{
+		maxConnections: maxConnections,
+	}, nil
+}
+
+// NumRejectedConnections returns the number of inbound connections closed because the limit was reached
+func (cl *connectionLimiter) NumRejectedConnections() uint64 {
+	return atomic.LoadUint64(&cl.numRejectedConnections)
+}
+
+// TagPeer does nothing as the connection limiter does not use tags
+func (cl *connectionLimiter) TagPeer(peer.ID, string, int) {}
+
+// UntagPeer does nothing as the connection limiter does not use tags
+func (cl *connectionLimiter) UntagPeer(peer.ID, string) {}
+
+// UpsertTag does nothing as the connection limiter does not use tags
+func (cl *connectionLimiter) UpsertTag(peer.ID, string, func(int) int) {}
+
+// GetTagInfo returns nil as the connection limiter does not use tags
+func (cl *connectionLimiter) GetTagInfo(peer.ID) *connmgr.TagInfo {
+	return nil
+}
+
+// TrimOpenConns does nothing as the exceeding connections are closed as soon as they are established
+func (cl *connectionLimiter) TrimOpenConns(context.Context) {}
+
+// Notifee returns the notifiee that will be called each time a connection is established
+func (cl *connectionLimiter) Notifee() network.Notifiee {
+	return cl
+}
+
+// Protect does nothing as the connection limiter does not use tags
+func (cl *connectionLimiter) Protect(peer.ID, string) {}
+
+// Unprotect does nothing as the connection limiter does not use tags
+func (cl *connectionLimiter) Unprotect(peer.ID, string) bool {
+	return false
+}
+
+// Close does nothing
+func (cl *connectionLimiter) Close() error {
+	return nil
+}
+
+// Listen is called when network starts listening on an addr
+func (cl *connectionLimiter) Listen(network.Network, multiaddr.Multiaddr) {}
+
+// ListenClose is called when network stops listening on an addr
+func (cl *connectionLimiter) ListenClose(network.Network, multiaddr.Multiaddr) {}
+
+// Connected is called when a connection opened. If the new connection is an inbound one and the maximum number
+// of connections has been exceeded, the connection will be closed
+func (cl *connectionLimiter) Connected(netw network.Network, conn network.Conn) {
+	if len(netw.Conns()) <= cl.maxConnections {
+		return
+	}
+	if conn.Stat().Direction != network.DirInbound {
+		return
+	}
+
+	atomic.AddUint64(&cl.numRejectedConnections, 1)
+	go func() {
+		err := conn.Close()
+		if err != nil {
+			log.Debug("error closing the exceeding connection: " + err.Error())
+		}
+	}()
+}
+
+// Disconnected is called when a connection closed
+func (cl *connectionLimiter) Disconnected(network.Network, network.Conn) {}
+
+// OpenedStream is called when a stream opened
+func (cl *connectionLimiter) OpenedStream(network.Network, network.Stream) {}
+
+// ClosedStream is called when a stream closed
+func (cl *connectionLimiter) ClosedStream(network.Network, network.Stream) {}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cl *connectionLimiter) IsInterfaceNil() bool {
+	if cl == nil {
+		return true
+	}
+	return false
+}
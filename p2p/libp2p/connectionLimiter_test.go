@@ -0,0 +1,99 @@
+package libp2p_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/stretchr/testify/assert"
+)
+
+func createNetworkWithConns(numConns int) *mock.NetworkStub {
+	return &mock.NetworkStub{
+		ConnsCalled: func() []network.Conn {
+			return make([]network.Conn, numConns)
+		},
+	}
+}
+
+func createConnWithDirection(direction network.Direction, chClosed chan struct{}) *mock.ConnStub {
+	return &mock.ConnStub{
+		StatCalled: func() network.Stat {
+			return network.Stat{Direction: direction}
+		},
+		CloseCalled: func() error {
+			chClosed <- struct{}{}
+			return nil
+		},
+	}
+}
+
+func TestNewConnectionLimiter_InvalidMaxConnectionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cl, err := libp2p.NewConnectionLimiter(0)
+
+	assert.Nil(t, cl)
+	assert.Equal(t, p2p.ErrInvalidMaxConnections, err)
+}
+
+func TestNewConnectionLimiter_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cl, err := libp2p.NewConnectionLimiter(10)
+
+	assert.NotNil(t, cl)
+	assert.Nil(t, err)
+	assert.Equal(t, cl, cl.Notifee())
+}
+
+func TestConnectionLimiter_ConnectedUnderLimitShouldNotClose(t *testing.T) {
+	t.Parallel()
+
+	chClosed := make(chan struct{}, 1)
+	cl, _ := libp2p.NewConnectionLimiter(2)
+
+	cl.Connected(createNetworkWithConns(2), createConnWithDirection(network.DirInbound, chClosed))
+
+	select {
+	case <-chClosed:
+		assert.Fail(t, "connection should have not been closed")
+	case <-time.After(time.Millisecond * 100):
+	}
+	assert.Equal(t, uint64(0), cl.NumRejectedConnections())
+}
+
+func TestConnectionLimiter_ConnectedOverLimitInboundShouldClose(t *testing.T) {
+	t.Parallel()
+
+	chClosed := make(chan struct{}, 1)
+	cl, _ := libp2p.NewConnectionLimiter(2)
+
+	cl.Connected(createNetworkWithConns(3), createConnWithDirection(network.DirInbound, chClosed))
+
+	select {
+	case <-chClosed:
+	case <-time.After(time.Second):
+		assert.Fail(t, "timeout waiting for the connection to be closed")
+	}
+	assert.Equal(t, uint64(1), cl.NumRejectedConnections())
+}
+
+func TestConnectionLimiter_ConnectedOverLimitOutboundShouldNotClose(t *testing.T) {
+	t.Parallel()
+
+	chClosed := make(chan struct{}, 1)
+	cl, _ := libp2p.NewConnectionLimiter(2)
+
+	cl.Connected(createNetworkWithConns(3), createConnWithDirection(network.DirOutbound, chClosed))
+
+	select {
+	case <-chClosed:
+		assert.Fail(t, "connection should have not been closed")
+	case <-time.After(time.Millisecond * 100):
+	}
+	assert.Equal(t, uint64(0), cl.NumRejectedConnections())
+}
@@ -0,0 +1,236 @@
+package libp2p
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p"
+	libp2pCrypto "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// DialBackID represents the protocol ID used for asking a peer to open a TCP connection back to the requester, on
+// the requested port, in order to find out if that port is reachable from the outside
+const DialBackID = protocol.ID("/dialback/1.0.0")
+
+const maxDialBackMessageSize = 1 << 10
+const dialBackTimeout = time.Second * 5
+
+// DialBackRequest is the message sent by a peer that wants its port reachability checked
+type DialBackRequest struct {
+	Port int `json:"port"`
+}
+
+// DialBackResponse is the answer of the peer that dialed back the requester
+type DialBackResponse struct {
+	Address   string `json:"address"`
+	Reachable bool   `json:"reachable"`
+	Error     string `json:"error"`
+}
+
+type dialBack struct {
+	hostP2P     host.Host
+	numRequests uint64
+}
+
+func newDialBack(h host.Host) (*dialBack, error) {
+	if h == nil {
+		return nil, p2p.ErrNilHost
+	}
+
+	db := &dialBack{
+		hostP2P: h,
+	}
+
+	//wire-up a handler for dial back requests
+	h.SetStreamHandler(DialBackID, db.dialBackStreamHandler)
+
+	return db, nil
+}
+
+// dialBackStreamHandler dials the requested port on the address the request came from. The address is taken from
+// the connection and not from the request so that the protocol can not be used to make this peer dial other hosts
+func (db *dialBack) dialBackStreamHandler(s network.Stream) {
+	atomic.AddUint64(&db.numRequests, 1)
+
+	response := db.dialRequester(s)
+	buff, err := json.Marshal(response)
+	if err != nil {
+		_ = s.Reset()
+		log.Debug("error marshaling dial back response: " + err.Error())
+		return
+	}
+
+	_ = s.SetWriteDeadline(time.Now().Add(streamTimeout))
+	_, err = s.Write(buff)
+	if err != nil {
+		_ = s.Reset()
+		log.Debug("error writing dial back response: " + err.Error())
+		return
+	}
+
+	_ = s.Close()
+}
+
+func (db *dialBack) dialRequester(s network.Stream) *DialBackResponse {
+	request := &DialBackRequest{}
+	_ = s.SetReadDeadline(time.Now().Add(streamTimeout))
+	err := json.NewDecoder(io.LimitReader(s, maxDialBackMessageSize)).Decode(request)
+	if err != nil {
+		return &DialBackResponse{Error: err.Error()}
+	}
+	if request.Port <= 0 || request.Port > 65535 {
+		return &DialBackResponse{Error: p2p.ErrInvalidPort.Error()}
+	}
+
+	remoteAddress := s.Conn().RemoteMultiaddr()
+	ip := ipFromMultiaddr(remoteAddress)
+	if len(ip) == 0 {
+		return &DialBackResponse{Error: "no IP address in " + remoteAddress.String()}
+	}
+
+	address := net.JoinHostPort(ip, strconv.Itoa(request.Port))
+	conn, err := net.DialTimeout("tcp", address, dialBackTimeout)
+	if err != nil {
+		return &DialBackResponse{Address: address, Error: err.Error()}
+	}
+	_ = conn.Close()
+
+	return &DialBackResponse{Address: address, Reachable: true}
+}
+
+// NumRequests returns the number of dial back requests served so far
+func (db *dialBack) NumRequests() uint64 {
+	return atomic.LoadUint64(&db.numRequests)
+}
+
+// RequestDialBack asks the provided peer to open a TCP connection to the provided port of this host. The peer
+// should have the dial back protocol enabled
+func RequestDialBack(ctx context.Context, h host.Host, pid peer.ID, port int) (*DialBackResponse, error) {
+	if h == nil {
+		return nil, p2p.ErrNilHost
+	}
+	if ctx == nil {
+		return nil, p2p.ErrNilContext
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, streamTimeout)
+	defer cancel()
+
+	s, err := h.NewStream(ctxTimeout, pid, DialBackID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = s.Close()
+	}()
+
+	buff, err := json.Marshal(&DialBackRequest{Port: port})
+	if err != nil {
+		return nil, err
+	}
+
+	_ = s.SetWriteDeadline(time.Now().Add(streamTimeout))
+	_, err = s.Write(buff)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DialBackResponse{}
+	_ = s.SetReadDeadline(time.Now().Add(streamTimeout + dialBackTimeout))
+	err = json.NewDecoder(io.LimitReader(s, maxDialBackMessageSize)).Decode(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// CheckPortReachability asks the provided seeders, one at a time, to dial back the provided port of this machine.
+// A temporary host listening on a random port is used for contacting the seeders, so the checked port should
+// already be listened on by the caller. It returns the address that was reached and the seeder that reached it
+func CheckPortReachability(ctx context.Context, port int, seeders []string) (string, string, error) {
+	if ctx == nil {
+		return "", "", p2p.ErrNilContext
+	}
+	if len(seeders) == 0 {
+		return "", "", p2p.ErrNoSeeders
+	}
+
+	privKey, _, err := libp2pCrypto.GenerateSecp256k1Key(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	h, err := libp2p.New(
+		ctx,
+		libp2p.ListenAddrStrings(ListenAddrWithIp4AndTcp+"0"),
+		libp2p.Identity(privKey),
+		libp2p.DefaultMuxers,
+		libp2p.DefaultSecurity,
+		libp2p.DefaultTransports,
+		libp2p.DisableRelay(),
+	)
+	if err != nil {
+		return "", "", err
+	}
+	defer func() {
+		_ = h.Close()
+	}()
+
+	var lastErr error
+	for _, seeder := range seeders {
+		response, errRequest := requestDialBackFromSeeder(ctx, h, seeder, port)
+		if errRequest != nil {
+			lastErr = fmt.Errorf("%s: %s", seeder, errRequest.Error())
+			continue
+		}
+		if !response.Reachable {
+			lastErr = fmt.Errorf("%s: %s could not dial %s: %s",
+				p2p.ErrPortNotReachable.Error(),
+				seeder,
+				response.Address,
+				response.Error,
+			)
+			continue
+		}
+
+		return response.Address, seeder, nil
+	}
+
+	return "", "", lastErr
+}
+
+func requestDialBackFromSeeder(ctx context.Context, h host.Host, seeder string, port int) (*DialBackResponse, error) {
+	seederAddress, err := multiaddr.NewMultiaddr(seeder)
+	if err != nil {
+		return nil, err
+	}
+
+	seederInfo, err := peer.AddrInfoFromP2pAddr(seederAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, streamTimeout)
+	defer cancel()
+
+	err = h.Connect(ctxTimeout, *seederInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return RequestDialBack(ctx, h, seederInfo.ID, port)
+}
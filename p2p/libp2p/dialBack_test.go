@@ -0,0 +1,157 @@
+package libp2p_test
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTcpSeeder(t *testing.T) (p2p.Messenger, string) {
+	_, sk := createLibP2PCredentialsMessenger()
+	seeder, err := libp2p.NewNetworkMessenger(
+		context.Background(),
+		0,
+		sk,
+		nil,
+		&mock.ChannelLoadBalancerStub{
+			CollectOneElementFromChannelsCalled: func() *p2p.SendableData {
+				return nil
+			},
+		},
+		discovery.NewNullDiscoverer(),
+		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+	)
+	assert.Nil(t, err)
+
+	err = seeder.EnableDialBack()
+	assert.Nil(t, err)
+
+	return seeder, getConnectableAddress(seeder)
+}
+
+func TestNetworkMessenger_EnableDialBackTwiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mes := createMemoryMessengers(mocknet.New(context.Background()), 1)[0]
+
+	err := mes.EnableDialBack()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), mes.NumDialBackRequests())
+
+	err = mes.EnableDialBack()
+	assert.Equal(t, p2p.ErrDialBackAlreadyEnabled, err)
+}
+
+func TestRequestDialBack_NilHostShouldErr(t *testing.T) {
+	t.Parallel()
+
+	response, err := libp2p.RequestDialBack(context.Background(), nil, "", 1)
+
+	assert.Nil(t, response)
+	assert.Equal(t, p2p.ErrNilHost, err)
+}
+
+func TestRequestDialBack_DialBackNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	messengers := createMemoryMessengers(mocknet.New(context.Background()), 2)
+	_ = messengers[0].ConnectToPeer(getConnectableAddress(messengers[1]) + "/p2p/" + messengers[1].ID().Pretty())
+
+	response, err := libp2p.RequestDialBack(
+		context.Background(),
+		messengers[0].Host(),
+		peer.ID(messengers[1].ID()),
+		1,
+	)
+
+	assert.Nil(t, response)
+	assert.NotNil(t, err)
+}
+
+func TestRequestDialBack_InvalidPortShouldNotBeDialed(t *testing.T) {
+	t.Parallel()
+
+	messengers := createMemoryMessengers(mocknet.New(context.Background()), 2)
+	seeder := messengers[1]
+	_ = seeder.EnableDialBack()
+	_ = messengers[0].ConnectToPeer(getConnectableAddress(seeder) + "/p2p/" + seeder.ID().Pretty())
+
+	response, err := libp2p.RequestDialBack(context.Background(), messengers[0].Host(), peer.ID(seeder.ID()), 0)
+
+	assert.Nil(t, err)
+	assert.False(t, response.Reachable)
+	assert.Equal(t, p2p.ErrInvalidPort.Error(), response.Error)
+	assert.Equal(t, uint64(1), seeder.NumDialBackRequests())
+}
+
+func TestCheckPortReachability_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := libp2p.CheckPortReachability(nil, 1, []string{"seeder"})
+	assert.Equal(t, p2p.ErrNilContext, err)
+
+	_, _, err = libp2p.CheckPortReachability(context.Background(), 1, nil)
+	assert.Equal(t, p2p.ErrNoSeeders, err)
+}
+
+func TestCheckPortReachability_InvalidSeederAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := libp2p.CheckPortReachability(context.Background(), 1, []string{"invalid address"})
+
+	assert.NotNil(t, err)
+}
+
+func TestCheckPortReachability_ListenedPortShouldBeReachable(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this test opens real TCP connections")
+	}
+
+	seeder, seederAddress := createTcpSeeder(t)
+	defer func() {
+		_ = seeder.Close()
+	}()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer func() {
+		_ = listener.Close()
+	}()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	address, reachedBy, err := libp2p.CheckPortReachability(context.Background(), port, []string{seederAddress})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "127.0.0.1:"+strconv.Itoa(port), address)
+	assert.Equal(t, seederAddress, reachedBy)
+}
+
+func TestCheckPortReachability_ClosedPortShouldNotBeReachable(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this test opens real TCP connections")
+	}
+
+	seeder, seederAddress := createTcpSeeder(t)
+	defer func() {
+		_ = seeder.Close()
+	}()
+
+	listener, _ := net.Listen("tcp", "127.0.0.1:0")
+	port := listener.Addr().(*net.TCPAddr).Port
+	_ = listener.Close()
+
+	_, _, err := libp2p.CheckPortReachability(context.Background(), port, []string{seederAddress})
+
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), p2p.ErrPortNotReachable.Error()))
+}
@@ -1,18 +1,25 @@
 package discovery
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/libp2p/go-libp2p-core/peer"
 	dht "github.com/libp2p/go-libp2p-kad-dht"
+	"github.com/multiformats/go-multiaddr"
 )
 
 var peerDiscoveryTimeout = 10 * time.Second
 var noOfQueries = 1
 
+// maxPeersFromPeerExchange is the maximum number of peers, received from a peer exchange response, to which
+// the host will try to connect
+var maxPeersFromPeerExchange = 20
+
 const kadDhtName = "kad-dht discovery"
 
 var log = logger.DefaultLogger()
@@ -142,6 +149,8 @@ func (kdd *KadDhtDiscoverer) connectToOnePeerFromInitialPeersList(
 				continue
 			}
 
+			go kdd.connectToExchangedPeers(initialPeersList[startIndex])
+
 			chanDone <- struct{}{}
 			return
 		}
@@ -150,6 +159,42 @@ func (kdd *KadDhtDiscoverer) connectToOnePeerFromInitialPeersList(
 	return chanDone
 }
 
+// connectToExchangedPeers asks the initial peer (usually a seeder) for the addresses of its connected peers
+// and tries to connect to them, speeding up the network discovery. Peers not supporting the peer exchange
+// protocol will just produce a debug message
+func (kdd *KadDhtDiscoverer) connectToExchangedPeers(initialPeerAddress string) {
+	h := kdd.contextProvider.Host()
+	ctx := kdd.contextProvider.Context()
+
+	ma, err := multiaddr.NewMultiaddr(initialPeerAddress)
+	if err != nil {
+		log.Debug("peer exchange: " + err.Error())
+		return
+	}
+	addrInfo, err := peer.AddrInfoFromP2pAddr(ma)
+	if err != nil {
+		log.Debug("peer exchange: " + err.Error())
+		return
+	}
+
+	addresses, err := libp2p.RequestPeerExchange(ctx, h, addrInfo.ID)
+	if err != nil {
+		log.Debug("peer exchange: " + err.Error())
+		return
+	}
+
+	numConnected := 0
+	for i := 0; i < len(addresses) && i < maxPeersFromPeerExchange; i++ {
+		err = h.ConnectToPeer(ctx, addresses[i])
+		if err != nil {
+			continue
+		}
+		numConnected++
+	}
+
+	log.Debug(fmt.Sprintf("peer exchange: connected to %d out of %d received peers", numConnected, len(addresses)))
+}
+
 // Name returns the name of the kad dht peer discovery implementation
 func (kdd *KadDhtDiscoverer) Name() string {
 	return kadDhtName
@@ -1,22 +1,50 @@
 package libp2p
 
 import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/libp2p/go-libp2p-core/connmgr"
+	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-pubsub/pb"
 	"github.com/whyrusleeping/timecache"
 )
 
 var MaxSendBuffSize = maxSendBuffSize
 
+var MessageProcessingTimeout = messageProcessingTimeout
+
+type MessengerWithHost interface {
+	p2p.Messenger
+	p2p.PeerExchanger
+	p2p.DialBacker
+	Host() host.Host
+}
+
+type PubSubRestarter interface {
+	RestartPubSub() error
+	IsPubSubHealthy() bool
+}
+
 func (netMes *networkMessenger) ConnManager() connmgr.ConnManager {
 	return netMes.ctxProvider.connHost.ConnManager()
 }
 
+func (netMes *networkMessenger) Host() host.Host {
+	return netMes.ctxProvider.Host()
+}
+
 func (netMes *networkMessenger) SetHost(newHost ConnectableHost) {
 	netMes.ctxProvider.connHost = newHost
 }
 
+func (netMes *networkMessenger) RestartPubSub() error {
+	return netMes.restartPubSub()
+}
+
+func (netMes *networkMessenger) IsPubSubHealthy() bool {
+	return netMes.isPubSubHealthy()
+}
+
 func (ds *directSender) ProcessReceivedDirectMessage(message *pubsub_pb.Message) error {
 	return ds.processReceivedDirectMessage(message)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core/logger"
@@ -11,8 +12,10 @@ import (
 	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p-core/connmgr"
 	libp2pCrypto "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/pnet"
 	"github.com/libp2p/go-libp2p-core/protocol"
 	"github.com/libp2p/go-libp2p-pubsub"
 )
@@ -33,6 +36,18 @@ const ttlPeersOnTopic = time.Second * 120
 
 const pubsubTimeCacheDuration = 10 * time.Minute
 
+// DurationBetweenPubSubChecks represents the time between two consecutive pubsub health checks
+var DurationBetweenPubSubChecks = time.Second * 30
+
+// MaxFailedPubSubChecks represents the number of consecutive failed health checks after which the pubsub instance
+// is re-created together with all the topic subscriptions and the registered message processors
+var MaxFailedPubSubChecks = 4
+
+// messageProcessingTimeout is the time a registered message processor has for processing a received message. After
+// it elapses, the context provided to the processor is canceled and the message is rejected. It leaves enough time for
+// verifying the signatures of a full bulk of transactions while other bulks are processed at the same time
+const messageProcessingTimeout = time.Second * 10
+
 //TODO remove the header size of the message when commit d3c5ecd3a3e884206129d9f2a9a4ddfd5e7c8951 from
 // https://github.com/libp2p/go-libp2p-pubsub/pull/189/commits will be part of a new release
 var messageHeader = 64 * 1024 //64kB
@@ -40,16 +55,40 @@ var maxSendBuffSize = (1 << 20) - messageHeader
 
 var log = logger.DefaultLogger()
 
+type topicStatistics struct {
+	numReceived uint64
+	numRejected uint64
+}
+
 type networkMessenger struct {
 	ctxProvider    *Libp2pContext
-	pb             *pubsub.PubSub
 	ds             p2p.DirectSender
 	connMonitor    *libp2pConnectionMonitor
 	peerDiscoverer p2p.PeerDiscoverer
-	mutTopics      sync.RWMutex
-	topics         map[string]p2p.MessageProcessor
+	withSigning    bool
+	cancelMonitor  context.CancelFunc
 	outgoingPLB    p2p.ChannelLoadBalancer
 	poc            *peersOnChannel
+
+	//mutTopics also protects the pubsub instance as it is replaced when the pubsub is restarted
+	mutTopics     sync.RWMutex
+	pb            *pubsub.PubSub
+	pbCtx         context.Context
+	cancelPubSub  context.CancelFunc
+	topics        map[string]p2p.MessageProcessor
+	topicsStats   map[string]*topicStatistics
+	subscriptions map[string]*pubsub.Subscription
+
+	chSubscriptionFailed chan struct{}
+	numPubSubRestarts    uint64
+
+	mutPendingBroadcasts sync.Mutex
+	pendingBroadcasts    map[string]int
+
+	mutPeerExchange sync.RWMutex
+	px              *peerExchange
+	mutDialBack     sync.RWMutex
+	db              *dialBack
 }
 
 // NewNetworkMessenger creates a libP2P messenger by opening a port on the current machine
@@ -64,6 +103,49 @@ func NewNetworkMessenger(
 	listenAddress string,
 ) (*networkMessenger, error) {
 
+	return newNetworkMessenger(ctx, port, p2pPrivKey, conMgr, outgoingPLB, peerDiscoverer, listenAddress, nil)
+}
+
+// NewPrivateNetworkMessenger creates a libP2P messenger, as NewNetworkMessenger does, that is able to connect only
+// to the peers of the private network protected by the provided protector
+func NewPrivateNetworkMessenger(
+	ctx context.Context,
+	port int,
+	p2pPrivKey libp2pCrypto.PrivKey,
+	conMgr connmgr.ConnManager,
+	outgoingPLB p2p.ChannelLoadBalancer,
+	peerDiscoverer p2p.PeerDiscoverer,
+	listenAddress string,
+	protector pnet.Protector,
+) (*networkMessenger, error) {
+
+	if protector == nil {
+		return nil, p2p.ErrNilNetworkProtector
+	}
+
+	return newNetworkMessenger(
+		ctx,
+		port,
+		p2pPrivKey,
+		conMgr,
+		outgoingPLB,
+		peerDiscoverer,
+		listenAddress,
+		[]libp2p.Option{libp2p.PrivateNetwork(protector)},
+	)
+}
+
+func newNetworkMessenger(
+	ctx context.Context,
+	port int,
+	p2pPrivKey libp2pCrypto.PrivKey,
+	conMgr connmgr.ConnManager,
+	outgoingPLB p2p.ChannelLoadBalancer,
+	peerDiscoverer p2p.PeerDiscoverer,
+	listenAddress string,
+	extraOpts []libp2p.Option,
+) (*networkMessenger, error) {
+
 	if ctx == nil {
 		return nil, p2p.ErrNilContext
 	}
@@ -92,6 +174,7 @@ func NewNetworkMessenger(
 		libp2p.DisableRelay(),
 		libp2p.NATPortMap(),
 	}
+	opts = append(opts, extraOpts...)
 
 	h, err := libp2p.New(ctx, opts...)
 	if err != nil {
@@ -120,25 +203,35 @@ func createMessenger(
 	peerDiscoverer p2p.PeerDiscoverer,
 ) (*networkMessenger, error) {
 
-	pb, err := createPubSub(lctx, withSigning)
+	pbCtx, cancelPubSub := context.WithCancel(lctx.Context())
+	pb, err := createPubSub(pbCtx, lctx.Host(), withSigning)
 	if err != nil {
+		cancelPubSub()
 		return nil, err
 	}
 
 	err = peerDiscoverer.ApplyContext(lctx)
 	if err != nil {
+		cancelPubSub()
 		return nil, err
 	}
 
 	reconnecter, _ := peerDiscoverer.(p2p.Reconnecter)
 
 	netMes := networkMessenger{
-		ctxProvider:    lctx,
-		pb:             pb,
-		topics:         make(map[string]p2p.MessageProcessor),
-		outgoingPLB:    outgoingPLB,
-		peerDiscoverer: peerDiscoverer,
-		connMonitor:    newLibp2pConnectionMonitor(reconnecter),
+		ctxProvider:          lctx,
+		pb:                   pb,
+		pbCtx:                pbCtx,
+		cancelPubSub:         cancelPubSub,
+		withSigning:          withSigning,
+		topics:               make(map[string]p2p.MessageProcessor),
+		topicsStats:          make(map[string]*topicStatistics),
+		subscriptions:        make(map[string]*pubsub.Subscription),
+		outgoingPLB:          outgoingPLB,
+		peerDiscoverer:       peerDiscoverer,
+		connMonitor:          newLibp2pConnectionMonitor(reconnecter),
+		pendingBroadcasts:    make(map[string]int),
+		chSubscriptionFailed: make(chan struct{}, 1),
 	}
 	lctx.connHost.Network().Notify(netMes.connMonitor)
 
@@ -148,14 +241,14 @@ func createMessenger(
 	}
 
 	netMes.poc, err = newPeersOnChannel(
-		pb.ListPeers,
+		netMes.listPeersOnTopic,
 		refreshPeersOnTopic,
 		ttlPeersOnTopic)
 	if err != nil {
 		return nil, err
 	}
 
-	go func(pubsub *pubsub.PubSub, plb p2p.ChannelLoadBalancer) {
+	go func(plb p2p.ChannelLoadBalancer) {
 		for {
 			sendableData := plb.CollectOneElementFromChannels()
 
@@ -163,10 +256,14 @@ func createMessenger(
 				continue
 			}
 
-			_ = pb.Publish(sendableData.Topic, sendableData.Buff)
+			netMes.publish(sendableData.Topic, sendableData.Buff)
 			time.Sleep(durationBetweenSends)
 		}
-	}(pb, netMes.outgoingPLB)
+	}(netMes.outgoingPLB)
+
+	monitorCtx, cancelMonitor := context.WithCancel(lctx.Context())
+	netMes.cancelMonitor = cancelMonitor
+	go netMes.monitorPubSub(monitorCtx)
 
 	for _, address := range netMes.ctxProvider.Host().Addrs() {
 		log.Info(address.String() + "/p2p/" + netMes.ID().Pretty())
@@ -175,14 +272,14 @@ func createMessenger(
 	return &netMes, nil
 }
 
-func createPubSub(ctxProvider *Libp2pContext, withSigning bool) (*pubsub.PubSub, error) {
+func createPubSub(ctx context.Context, h host.Host, withSigning bool) (*pubsub.PubSub, error) {
 	optsPS := []pubsub.Option{
 		pubsub.WithMessageSigning(withSigning),
 	}
 
 	pubsub.TimeCacheDuration = pubsubTimeCacheDuration
 
-	ps, err := pubsub.NewGossipSub(ctxProvider.Context(), ctxProvider.Host(), optsPS...)
+	ps, err := pubsub.NewGossipSub(ctx, h, optsPS...)
 	if err != nil {
 		return nil, err
 	}
@@ -190,8 +287,168 @@ func createPubSub(ctxProvider *Libp2pContext, withSigning bool) (*pubsub.PubSub,
 	return ps, nil
 }
 
+func (netMes *networkMessenger) publish(topic string, buff []byte) {
+	netMes.mutTopics.RLock()
+	defer netMes.mutTopics.RUnlock()
+
+	err := netMes.pb.Publish(topic, buff)
+	if err != nil {
+		log.Debug(fmt.Sprintf("publish on topic %s: %s", topic, err.Error()))
+	}
+}
+
+func (netMes *networkMessenger) listPeersOnTopic(topic string) []peer.ID {
+	netMes.mutTopics.RLock()
+	defer netMes.mutTopics.RUnlock()
+
+	return netMes.pb.ListPeers(topic)
+}
+
+// monitorPubSub periodically checks the pubsub instance and restarts it after MaxFailedPubSubChecks consecutive
+// failed checks. A subscription that fails while still in use triggers the restart right away
+func (netMes *networkMessenger) monitorPubSub(ctx context.Context) {
+	numFailedChecks := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-netMes.chSubscriptionFailed:
+			numFailedChecks = MaxFailedPubSubChecks
+		case <-time.After(DurationBetweenPubSubChecks):
+			if netMes.isPubSubHealthy() {
+				numFailedChecks = 0
+				continue
+			}
+			numFailedChecks++
+			log.Debug(fmt.Sprintf("pubsub health check failed %d time(s) in a row", numFailedChecks))
+		}
+
+		if numFailedChecks < MaxFailedPubSubChecks {
+			continue
+		}
+
+		numFailedChecks = 0
+		err := netMes.restartPubSub()
+		if err != nil {
+			log.Error("pubsub restart failed: " + err.Error())
+		}
+	}
+}
+
+// isPubSubHealthy returns false only if, while the host is connected, none of the created topics has pubsub peers.
+// An isolated host is reported as healthy, as restarting pubsub can not help it: reconnecting the host is left to
+// the connection monitor
+func (netMes *networkMessenger) isPubSubHealthy() bool {
+	if len(netMes.ctxProvider.Host().Network().Conns()) == 0 {
+		return true
+	}
+
+	netMes.mutTopics.RLock()
+	defer netMes.mutTopics.RUnlock()
+
+	if len(netMes.topics) == 0 {
+		return true
+	}
+
+	for topic := range netMes.topics {
+		if len(netMes.pb.ListPeers(topic)) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// restartPubSub creates a new pubsub instance, subscribes to all existing topics, re-registers all message
+// processors and then discards the old instance
+func (netMes *networkMessenger) restartPubSub() error {
+	h := netMes.ctxProvider.Host()
+	pbCtx, cancelPubSub := context.WithCancel(netMes.ctxProvider.Context())
+
+	netMes.mutTopics.Lock()
+	defer netMes.mutTopics.Unlock()
+
+	newPb, err := createPubSub(pbCtx, h, netMes.withSigning)
+	if err != nil {
+		cancelPubSub()
+		return err
+	}
+
+	subscriptions := make(map[string]*pubsub.Subscription, len(netMes.topics))
+	for topic, handler := range netMes.topics {
+		subscriptions[topic], err = newPb.Subscribe(topic)
+		if err != nil {
+			cancelPubSub()
+			return err
+		}
+
+		if handler == nil {
+			continue
+		}
+
+		err = netMes.registerTopicValidator(newPb, topic, handler)
+		if err != nil {
+			cancelPubSub()
+			return err
+		}
+	}
+
+	//the new pubsub instance only learns about the peers through the connection notifications, so the
+	//already opened connections are announced manually
+	for _, conn := range h.Network().Conns() {
+		(*pubsub.PubSubNotif)(newPb).Connected(h.Network(), conn)
+	}
+
+	h.Network().StopNotify((*pubsub.PubSubNotif)(netMes.pb))
+	netMes.cancelPubSub()
+
+	netMes.pb = newPb
+	netMes.pbCtx = pbCtx
+	netMes.cancelPubSub = cancelPubSub
+	netMes.subscriptions = subscriptions
+	for _, subscription := range subscriptions {
+		go netMes.consumeSubscription(pbCtx, subscription)
+	}
+
+	numRestarts := atomic.AddUint64(&netMes.numPubSubRestarts, 1)
+	log.Info(fmt.Sprintf("pubsub restarted with %d topic(s), number of restarts: %d", len(subscriptions), numRestarts))
+
+	return nil
+}
+
+// consumeSubscription is just a dummy func to consume messages received by a subscription as the messages are
+// handled by the topic validators. It stops when the subscription is canceled or when its pubsub instance is
+// discarded. A subscription that fails while still in use is signaled to the pubsub monitor
+func (netMes *networkMessenger) consumeSubscription(ctx context.Context, subscription *pubsub.Subscription) {
+	for {
+		_, err := subscription.Next(ctx)
+		if err == nil {
+			continue
+		}
+
+		if ctx.Err() == nil && netMes.isSubscriptionInUse(subscription) {
+			log.Warn(fmt.Sprintf("subscription on topic %s failed: %s", subscription.Topic(), err.Error()))
+			select {
+			case netMes.chSubscriptionFailed <- struct{}{}:
+			default:
+			}
+		}
+
+		return
+	}
+}
+
+func (netMes *networkMessenger) isSubscriptionInUse(subscription *pubsub.Subscription) bool {
+	netMes.mutTopics.RLock()
+	defer netMes.mutTopics.RUnlock()
+
+	return netMes.subscriptions[subscription.Topic()] == subscription
+}
+
 // Close closes the host, connections and streams
 func (netMes *networkMessenger) Close() error {
+	netMes.cancelMonitor()
+
 	return netMes.ctxProvider.Host().Close()
 }
 
@@ -320,8 +577,6 @@ func (netMes *networkMessenger) ConnectedPeersOnTopic(topic string) []p2p.PeerID
 
 // CreateTopic opens a new topic using pubsub infrastructure
 func (netMes *networkMessenger) CreateTopic(name string, createChannelForTopic bool) error {
-	ctx := netMes.ctxProvider.Context()
-
 	netMes.mutTopics.Lock()
 	_, found := netMes.topics[name]
 	if found {
@@ -332,22 +587,18 @@ func (netMes *networkMessenger) CreateTopic(name string, createChannelForTopic b
 	netMes.topics[name] = nil
 	subscrRequest, err := netMes.pb.Subscribe(name)
 	if err != nil {
+		delete(netMes.topics, name)
 		netMes.mutTopics.Unlock()
 		return err
 	}
+	netMes.subscriptions[name] = subscrRequest
+	go netMes.consumeSubscription(netMes.pbCtx, subscrRequest)
 	netMes.mutTopics.Unlock()
 
 	if createChannelForTopic {
 		err = netMes.outgoingPLB.AddChannel(name)
 	}
 
-	//just a dummy func to consume messages received by the newly created topic
-	go func() {
-		for {
-			_, _ = subscrRequest.Next(ctx)
-		}
-	}()
-
 	return err
 }
 
@@ -386,7 +637,20 @@ func (netMes *networkMessenger) BroadcastOnChannelBlocking(channel string, topic
 		Buff:  buff,
 		Topic: topic,
 	}
+
+	netMes.changePendingBroadcasts(channel, 1)
 	netMes.outgoingPLB.GetChannelOrDefault(channel) <- sendable
+	netMes.changePendingBroadcasts(channel, -1)
+}
+
+func (netMes *networkMessenger) changePendingBroadcasts(channel string, delta int) {
+	netMes.mutPendingBroadcasts.Lock()
+	defer netMes.mutPendingBroadcasts.Unlock()
+
+	netMes.pendingBroadcasts[channel] += delta
+	if netMes.pendingBroadcasts[channel] == 0 {
+		delete(netMes.pendingBroadcasts, channel)
+	}
 }
 
 // BroadcastOnChannel tries to send a byte buffer onto a topic using provided channel
@@ -417,7 +681,24 @@ func (netMes *networkMessenger) RegisterMessageProcessor(topic string, handler p
 		return p2p.ErrTopicValidatorOperationNotSupported
 	}
 
-	err := netMes.pb.RegisterTopicValidator(topic, func(ctx context.Context, pid peer.ID, message *pubsub.Message) bool {
+	err := netMes.registerTopicValidator(netMes.pb, topic, handler)
+	if err != nil {
+		return err
+	}
+
+	netMes.topics[topic] = handler
+	return nil
+}
+
+// registerTopicValidator registers the handler on the provided pubsub instance. Should be called under mutTopics lock
+func (netMes *networkMessenger) registerTopicValidator(pb *pubsub.PubSub, topic string, handler p2p.MessageProcessor) error {
+	stats, found := netMes.topicsStats[topic]
+	if !found {
+		stats = &topicStatistics{}
+		netMes.topicsStats[topic] = stats
+	}
+
+	return pb.RegisterTopicValidator(topic, func(ctx context.Context, pid peer.ID, message *pubsub.Message) bool {
 		broadcastCallbackHandler, ok := handler.(p2p.BroadcastCallbackHandler)
 		if ok {
 			broadcastCallbackHandler.SetBroadcastCallback(func(buffToSend []byte) {
@@ -425,19 +706,15 @@ func (netMes *networkMessenger) RegisterMessageProcessor(topic string, handler p
 			})
 		}
 
-		err := handler.ProcessReceivedMessage(NewMessage(message))
+		atomic.AddUint64(&stats.numReceived, 1)
+		err := handler.ProcessReceivedMessage(ctx, NewMessage(message))
 		if err != nil {
+			atomic.AddUint64(&stats.numRejected, 1)
 			log.Debug(err.Error())
 		}
 
 		return err == nil
-	})
-	if err != nil {
-		return err
-	}
-
-	netMes.topics[topic] = handler
-	return nil
+	}, pubsub.WithValidatorTimeout(messageProcessingTimeout))
 }
 
 // UnregisterMessageProcessor registers a message processes on a topic
@@ -463,6 +740,40 @@ func (netMes *networkMessenger) UnregisterMessageProcessor(topic string) error {
 	return nil
 }
 
+// RemoveTopic unregisters the topic's message processor, if any, cancels the topic subscription and removes the
+// topic's outgoing channel
+func (netMes *networkMessenger) RemoveTopic(name string) error {
+	netMes.mutTopics.Lock()
+	defer netMes.mutTopics.Unlock()
+	validator, found := netMes.topics[name]
+
+	if !found {
+		return p2p.ErrNilTopic
+	}
+
+	if validator != nil {
+		err := netMes.pb.UnregisterTopicValidator(name)
+		if err != nil {
+			return err
+		}
+	}
+
+	subscription := netMes.subscriptions[name]
+	if subscription != nil {
+		subscription.Cancel()
+	}
+	delete(netMes.subscriptions, name)
+	delete(netMes.topics, name)
+	delete(netMes.topicsStats, name)
+
+	err := netMes.outgoingPLB.RemoveChannel(name)
+	if err == p2p.ErrChannelDoesNotExist {
+		return nil
+	}
+
+	return err
+}
+
 // SendToConnectedPeer sends a direct message to a connected peer
 func (netMes *networkMessenger) SendToConnectedPeer(topic string, buff []byte, peerID p2p.PeerID) error {
 	return netMes.ds.Send(topic, buff, peerID)
@@ -480,7 +791,10 @@ func (netMes *networkMessenger) directMessageHandler(message p2p.MessageP2P) err
 	}
 
 	go func(msg p2p.MessageP2P) {
-		err := processor.ProcessReceivedMessage(msg)
+		ctx, cancel := context.WithTimeout(netMes.ctxProvider.Context(), messageProcessingTimeout)
+		defer cancel()
+
+		err := processor.ProcessReceivedMessage(ctx, msg)
 
 		if err != nil {
 			log.Debug(err.Error())
@@ -490,6 +804,103 @@ func (netMes *networkMessenger) directMessageHandler(message p2p.MessageP2P) err
 	return nil
 }
 
+// EnablePeerExchange will make this messenger answer the peer exchange requests with at most maxPeersInResponse
+// addresses of its connected peers
+func (netMes *networkMessenger) EnablePeerExchange(maxPeersInResponse int) error {
+	netMes.mutPeerExchange.Lock()
+	defer netMes.mutPeerExchange.Unlock()
+
+	if netMes.px != nil {
+		return p2p.ErrPeerExchangeAlreadyEnabled
+	}
+
+	px, err := newPeerExchange(netMes.ctxProvider.Host(), maxPeersInResponse)
+	if err != nil {
+		return err
+	}
+
+	netMes.px = px
+	return nil
+}
+
+// NumPeerExchangeRequests returns the number of peer exchange requests served by this messenger
+func (netMes *networkMessenger) NumPeerExchangeRequests() uint64 {
+	netMes.mutPeerExchange.RLock()
+	defer netMes.mutPeerExchange.RUnlock()
+
+	if netMes.px == nil {
+		return 0
+	}
+
+	return netMes.px.NumRequests()
+}
+
+// EnableDialBack will make this messenger answer the dial back requests by opening a TCP connection to the
+// requested port of the requester
+func (netMes *networkMessenger) EnableDialBack() error {
+	netMes.mutDialBack.Lock()
+	defer netMes.mutDialBack.Unlock()
+
+	if netMes.db != nil {
+		return p2p.ErrDialBackAlreadyEnabled
+	}
+
+	db, err := newDialBack(netMes.ctxProvider.Host())
+	if err != nil {
+		return err
+	}
+
+	netMes.db = db
+	return nil
+}
+
+// NumDialBackRequests returns the number of dial back requests served by this messenger
+func (netMes *networkMessenger) NumDialBackRequests() uint64 {
+	netMes.mutDialBack.RLock()
+	defer netMes.mutDialBack.RUnlock()
+
+	if netMes.db == nil {
+		return 0
+	}
+
+	return netMes.db.NumRequests()
+}
+
+// TopicsStatistics returns, for each topic that had a message processor registered, the number of received and
+// rejected messages
+func (netMes *networkMessenger) TopicsStatistics() map[string]p2p.TopicStatistics {
+	netMes.mutTopics.RLock()
+	defer netMes.mutTopics.RUnlock()
+
+	topicsStats := make(map[string]p2p.TopicStatistics, len(netMes.topicsStats))
+	for topic, stats := range netMes.topicsStats {
+		topicsStats[topic] = p2p.TopicStatistics{
+			NumReceived: atomic.LoadUint64(&stats.numReceived),
+			NumRejected: atomic.LoadUint64(&stats.numRejected),
+		}
+	}
+
+	return topicsStats
+}
+
+// PendingBroadcasts returns, for each outgoing channel, the number of broadcasts waiting to be sent
+func (netMes *networkMessenger) PendingBroadcasts() map[string]int {
+	netMes.mutPendingBroadcasts.Lock()
+	defer netMes.mutPendingBroadcasts.Unlock()
+
+	pendingBroadcasts := make(map[string]int, len(netMes.pendingBroadcasts))
+	for channel, numPending := range netMes.pendingBroadcasts {
+		pendingBroadcasts[channel] = numPending
+	}
+
+	return pendingBroadcasts
+}
+
+// NumPubSubRestarts returns the number of times the pubsub instance was re-created after failing
+func (netMes *networkMessenger) NumPubSubRestarts() uint64 {
+	return atomic.LoadUint64(&netMes.numPubSubRestarts)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (netMes *networkMessenger) IsInterfaceNil() bool {
 	if netMes == nil {
@@ -9,12 +9,14 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/privnet"
 	"github.com/ElrondNetwork/elrond-go/p2p/loadBalancer"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
 	"github.com/btcsuite/btcd/btcec"
@@ -388,6 +390,72 @@ func TestNewNetworkMessenger_PeerDiscovererFailsWhenApplyingContextShouldErr(t *
 	assert.Equal(t, errExpected, err)
 }
 
+func createPrivateNetworkMessenger(psk *[privnet.PreSharedKeySize]byte) (p2p.Messenger, error) {
+	_, sk := createLibP2PCredentialsMessenger()
+	protector, _ := privnet.NewProtector(psk)
+
+	return libp2p.NewPrivateNetworkMessenger(
+		context.Background(),
+		0,
+		sk,
+		nil,
+		&mock.ChannelLoadBalancerStub{
+			CollectOneElementFromChannelsCalled: func() *p2p.SendableData {
+				time.Sleep(time.Millisecond * 100)
+				return nil
+			},
+		},
+		discovery.NewNullDiscoverer(),
+		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		protector,
+	)
+}
+
+func TestNewPrivateNetworkMessenger_NilProtectorShouldErr(t *testing.T) {
+	_, sk := createLibP2PCredentialsMessenger()
+
+	mes, err := libp2p.NewPrivateNetworkMessenger(
+		context.Background(),
+		0,
+		sk,
+		nil,
+		&mock.ChannelLoadBalancerStub{},
+		discovery.NewNullDiscoverer(),
+		libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		nil,
+	)
+
+	assert.Nil(t, mes)
+	assert.Equal(t, p2p.ErrNilNetworkProtector, err)
+}
+
+func TestNewPrivateNetworkMessenger_OnlyPeersWithTheSameKeyShouldConnect(t *testing.T) {
+	psk := &[privnet.PreSharedKeySize]byte{1, 2, 3}
+	otherPsk := &[privnet.PreSharedKeySize]byte{3, 2, 1}
+
+	mes1, err := createPrivateNetworkMessenger(psk)
+	assert.Nil(t, err)
+	mes2, _ := createPrivateNetworkMessenger(psk)
+	mesOutsider, _ := createPrivateNetworkMessenger(otherPsk)
+	defer func() {
+		_ = mes1.Close()
+		_ = mes2.Close()
+		_ = mesOutsider.Close()
+	}()
+
+	err = mes2.ConnectToPeer(getConnectableAddress(mes1))
+	assert.Nil(t, err)
+	assert.True(t, mes1.IsConnected(mes2.ID()))
+
+	// the handshake with a peer holding another key never completes, so the dial only fails at its timeout
+	go func() {
+		_ = mesOutsider.ConnectToPeer(getConnectableAddress(mes1))
+	}()
+	time.Sleep(time.Second * 2)
+	assert.False(t, mes1.IsConnected(mesOutsider.ID()))
+	assert.False(t, mesOutsider.IsConnected(mes1.ID()))
+}
+
 func TestNewNetworkMessengerWithPortSweep_ShouldFindFreePort(t *testing.T) {
 	//TODO remove skip when external library is concurrent safe
 	if testing.Short() {
@@ -615,6 +683,37 @@ func TestLibp2pMessenger_UnregisterTopicValidatorShouldWork(t *testing.T) {
 	_ = mes.Close()
 }
 
+func TestLibp2pMessenger_RemoveTopicOnInexistentTopicShouldErr(t *testing.T) {
+	mes := createMockMessenger()
+
+	err := mes.RemoveTopic("test")
+
+	assert.Equal(t, p2p.ErrNilTopic, err)
+
+	_ = mes.Close()
+}
+
+func TestLibp2pMessenger_RemoveTopicShouldWork(t *testing.T) {
+	mes := createMockMessenger()
+
+	_ = mes.CreateTopic("test", true)
+	_ = mes.RegisterMessageProcessor("test", &mock.MessageProcessorStub{})
+
+	err := mes.RemoveTopic("test")
+
+	assert.Nil(t, err)
+	assert.False(t, mes.HasTopic("test"))
+	assert.False(t, mes.HasTopicValidator("test"))
+
+	//the topic can be created again, with a new message processor
+	err = mes.CreateTopic("test", true)
+	assert.Nil(t, err)
+	err = mes.RegisterMessageProcessor("test", &mock.MessageProcessorStub{})
+	assert.Nil(t, err)
+
+	_ = mes.Close()
+}
+
 func TestLibp2pMessenger_BroadcastDataLargeMessageShouldNotCallSend(t *testing.T) {
 	msg := make([]byte, libp2p.MaxSendBuffSize+1)
 
@@ -1244,6 +1343,55 @@ func TestLibp2pMessenger_SendDirectWithMockNetToConnectedPeerShouldWork(t *testi
 	_ = mes2.Close()
 }
 
+type contextMessageProcessor struct {
+	chanDeadline chan time.Time
+}
+
+func (cmp *contextMessageProcessor) ProcessReceivedMessage(ctx context.Context, _ p2p.MessageP2P) error {
+	deadline, _ := ctx.Deadline()
+	cmp.chanDeadline <- deadline
+
+	return nil
+}
+
+func (cmp *contextMessageProcessor) IsInterfaceNil() bool {
+	return cmp == nil
+}
+
+func TestLibp2pMessenger_ReceivedMessagesShouldBeProcessedWithATimeoutBoundedContext(t *testing.T) {
+	_, mes1, mes2 := createMockNetworkOf2()
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	processor := &contextMessageProcessor{
+		chanDeadline: make(chan time.Time, 2),
+	}
+	_ = mes2.CreateTopic("test", false)
+	_ = mes2.RegisterMessageProcessor("test", processor)
+
+	time.Sleep(time.Second)
+
+	checkDeadline := func() {
+		select {
+		case deadline := <-processor.chanDeadline:
+			assert.False(t, deadline.IsZero())
+			assert.True(t, time.Until(deadline) <= libp2p.MessageProcessingTimeout)
+		case <-time.After(timeoutWaitResponses):
+			assert.Fail(t, "timeout while waiting for the message to be processed")
+		}
+	}
+
+	_ = mes1.SendToConnectedPeer("test", []byte("direct message"), mes2.ID())
+	checkDeadline()
+
+	_ = mes1.CreateTopic("test", false)
+	time.Sleep(time.Second)
+	mes1.Broadcast("test", []byte("broadcast message"))
+	checkDeadline()
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
 func TestLibp2pMessenger_SendDirectWithRealNetToConnectedPeerShouldWork(t *testing.T) {
 	msg := []byte("test message")
 
@@ -1333,3 +1481,141 @@ func TestNetworkMessenger_BootstrapPeerDiscoveryShouldCallPeerBootstrapper(t *te
 
 	_ = mes.Close()
 }
+
+//------- Statistics
+
+func TestLibp2pMessenger_TopicsStatisticsShouldCountReceivedAndRejectedMessages(t *testing.T) {
+	_, mes1, mes2 := createMockNetworkOf2()
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	_ = mes1.CreateTopic("test", false)
+	_ = mes2.CreateTopic("test", false)
+	_ = mes2.RegisterMessageProcessor("test",
+		&mock.MessageProcessorStub{
+			ProcessMessageCalled: func(message p2p.MessageP2P) error {
+				if bytes.Equal(message.Data(), []byte("rejected")) {
+					return errors.New("rejected message")
+				}
+
+				return nil
+			},
+		})
+
+	fmt.Println("Delaying as to allow peers to announce themselves on the opened topic...")
+	time.Sleep(time.Second)
+
+	mes1.Broadcast("test", []byte("accepted"))
+	mes1.Broadcast("test", []byte("rejected"))
+
+	statsHandler := mes2.(p2p.StatisticsHandler)
+	expectedStats := p2p.TopicStatistics{NumReceived: 2, NumRejected: 1}
+	for start := time.Now(); time.Since(start) < timeoutWaitResponses; time.Sleep(time.Millisecond * 10) {
+		if statsHandler.TopicsStatistics()["test"] == expectedStats {
+			break
+		}
+	}
+
+	assert.Equal(t, map[string]p2p.TopicStatistics{"test": expectedStats}, statsHandler.TopicsStatistics())
+	assert.Equal(t, 0, len(mes1.(p2p.StatisticsHandler).TopicsStatistics()))
+	assert.Equal(t, 0, len(mes1.(p2p.StatisticsHandler).PendingBroadcasts()))
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
+func TestLibp2pMessenger_RemoveTopicShouldRemoveTopicStatistics(t *testing.T) {
+	mes := createMockMessenger()
+
+	_ = mes.CreateTopic("test", false)
+	_ = mes.RegisterMessageProcessor("test", &mock.MessageProcessorStub{})
+	assert.Equal(t, 1, len(mes.(p2p.StatisticsHandler).TopicsStatistics()))
+
+	_ = mes.RemoveTopic("test")
+	assert.Equal(t, 0, len(mes.(p2p.StatisticsHandler).TopicsStatistics()))
+
+	_ = mes.Close()
+}
+
+//------- PubSub restart
+
+func TestLibp2pMessenger_IsPubSubHealthyNotConnectedShouldReturnTrue(t *testing.T) {
+	mes := createMockMessenger()
+
+	_ = mes.CreateTopic("test", false)
+
+	assert.True(t, mes.(libp2p.PubSubRestarter).IsPubSubHealthy())
+
+	_ = mes.Close()
+}
+
+func TestLibp2pMessenger_IsPubSubHealthyConnectedWithoutTopicPeersShouldReturnFalse(t *testing.T) {
+	_, mes1, mes2 := createMockNetworkOf2()
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+	_ = mes1.CreateTopic("test", false)
+
+	fmt.Println("Delaying as to allow peers to announce themselves...")
+	time.Sleep(time.Second)
+
+	assert.False(t, mes1.(libp2p.PubSubRestarter).IsPubSubHealthy())
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
+func TestLibp2pMessenger_IsPubSubHealthyConnectedOnTopicShouldReturnTrue(t *testing.T) {
+	_, mes1, mes2 := createMockNetworkOf2()
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+	_ = mes1.CreateTopic("test", false)
+	_ = mes2.CreateTopic("test", false)
+
+	fmt.Println("Delaying as to allow peers to announce themselves on the opened topic...")
+	time.Sleep(time.Second)
+
+	assert.True(t, mes1.(libp2p.PubSubRestarter).IsPubSubHealthy())
+	assert.True(t, mes2.(libp2p.PubSubRestarter).IsPubSubHealthy())
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
+func TestLibp2pMessenger_RestartPubSubShouldKeepTopicsAndMessageProcessors(t *testing.T) {
+	_, mes1, mes2 := createMockNetworkOf2()
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	_ = mes1.CreateTopic("test", false)
+	_ = mes2.CreateTopic("test", false)
+	numReceived := uint32(0)
+	_ = mes2.RegisterMessageProcessor("test",
+		&mock.MessageProcessorStub{
+			ProcessMessageCalled: func(message p2p.MessageP2P) error {
+				atomic.AddUint32(&numReceived, 1)
+				return nil
+			},
+		})
+
+	err := mes2.(libp2p.PubSubRestarter).RestartPubSub()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), mes2.(p2p.StatisticsHandler).NumPubSubRestarts())
+	assert.True(t, mes2.HasTopic("test"))
+	assert.True(t, mes2.HasTopicValidator("test"))
+
+	fmt.Println("Delaying as to allow peers to announce themselves on the opened topic...")
+	time.Sleep(time.Second)
+
+	for start := time.Now(); time.Since(start) < timeoutWaitResponses; time.Sleep(time.Millisecond * 100) {
+		mes1.Broadcast("test", []byte(fmt.Sprintf("message %v", time.Now().UnixNano())))
+		if atomic.LoadUint32(&numReceived) > 0 {
+			break
+		}
+	}
+
+	assert.True(t, atomic.LoadUint32(&numReceived) > 0)
+	assert.Equal(t, uint64(0), mes1.(p2p.StatisticsHandler).NumPubSubRestarts())
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
@@ -0,0 +1,250 @@
+package libp2p
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/connmgr"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+const maxIpv4PrefixLength = 32
+const maxIpv6PrefixLength = 128
+
+var nonPublicNetworks = []string{
+	"10.0.0.0/8",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"100.64.0.0/10",
+	"169.254.0.0/16",
+	"fc00::/7",
+	"fe80::/10",
+}
+
+// peerDiversityLimiter is a connmgr.ConnManager implementation that keeps the connections diverse across networks
+// and providers. The peers are grouped by the network prefix of their IP address (e.g. /16 for ip v.4, as a cheap
+// approximation of the provider's ASN) and the connections established with a new peer from a network group which
+// already holds the maximum number of peers are closed. This makes it costly for an attacker controlling a single
+// provider's address range to surround (eclipse) a node. Loopback and private addresses are not limited.
+type peerDiversityLimiter struct {
+	maxPeersPerGroup int
+	ipv4Mask         net.IPMask
+	ipv6Mask         net.IPMask
+	nonPublicNets    []*net.IPNet
+
+	mutGroups              sync.Mutex
+	peersInGroups          map[string]map[peer.ID]int
+	acceptedConns          map[network.Conn]string
+	numRejectedConnections uint64
+}
+
+// NewPeerDiversityLimiter creates a new peer diversity limiter that will allow at most maxPeersPerGroup peers
+// connected from the same ip v.4 or ip v.6 network prefix
+func NewPeerDiversityLimiter(
+	maxPeersPerGroup int,
+	ipv4PrefixLength int,
+	ipv6PrefixLength int,
+) (*peerDiversityLimiter, error) {
+	if maxPeersPerGroup <= 0 {
+		return nil, p2p.ErrInvalidMaxPeersPerNetworkGroup
+	}
+	if ipv4PrefixLength <= 0 || ipv4PrefixLength > maxIpv4PrefixLength {
+		return nil, p2p.ErrInvalidNetworkPrefixLength
+	}
+	if ipv6PrefixLength <= 0 || ipv6PrefixLength > maxIpv6PrefixLength {
+		return nil, p2p.ErrInvalidNetworkPrefixLength
+	}
+
+	nonPublicNets := make([]*net.IPNet, 0, len(nonPublicNetworks))
+	for _, cidr := range nonPublicNetworks {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		nonPublicNets = append(nonPublicNets, ipNet)
+	}
+
+	return &peerDiversityLimiter{
+		maxPeersPerGroup: maxPeersPerGroup,
+		ipv4Mask:         net.CIDRMask(ipv4PrefixLength, maxIpv4PrefixLength),
+		ipv6Mask:         net.CIDRMask(ipv6PrefixLength, maxIpv6PrefixLength),
+		nonPublicNets:    nonPublicNets,
+		peersInGroups:    make(map[string]map[peer.ID]int),
+		acceptedConns:    make(map[network.Conn]string),
+	}, nil
+}
+
+// NumRejectedConnections returns the number of connections closed because their network group was full
+func (pdl *peerDiversityLimiter) NumRejectedConnections() uint64 {
+	return atomic.LoadUint64(&pdl.numRejectedConnections)
+}
+
+// NumPeersInGroup returns the number of peers connected from the network group of the provided address
+func (pdl *peerDiversityLimiter) NumPeersInGroup(address multiaddr.Multiaddr) int {
+	group, ok := pdl.networkGroup(address)
+	if !ok {
+		return 0
+	}
+
+	pdl.mutGroups.Lock()
+	defer pdl.mutGroups.Unlock()
+
+	return len(pdl.peersInGroups[group])
+}
+
+// networkGroup returns the network group of the provided address and false if the address should not be limited
+func (pdl *peerDiversityLimiter) networkGroup(address multiaddr.Multiaddr) (string, bool) {
+	if address == nil {
+		return "", false
+	}
+
+	ip := net.ParseIP(ipFromMultiaddr(address))
+	if ip == nil || ip.IsLoopback() || ip.IsUnspecified() {
+		return "", false
+	}
+	for _, ipNet := range pdl.nonPublicNets {
+		if ipNet.Contains(ip) {
+			return "", false
+		}
+	}
+
+	ip4 := ip.To4()
+	if ip4 != nil {
+		return ip4.Mask(pdl.ipv4Mask).String(), true
+	}
+
+	return ip.Mask(pdl.ipv6Mask).String(), true
+}
+
+func ipFromMultiaddr(address multiaddr.Multiaddr) string {
+	ip, err := address.ValueForProtocol(multiaddr.P_IP4)
+	if err == nil {
+		return ip
+	}
+
+	ip, err = address.ValueForProtocol(multiaddr.P_IP6)
+	if err == nil {
+		return ip
+	}
+
+	return ""
+}
+
+// TagPeer does nothing as the peer diversity limiter does not use tags
+func (pdl *peerDiversityLimiter) TagPeer(peer.ID, string, int) {}
+
+// UntagPeer does nothing as the peer diversity limiter does not use tags
+func (pdl *peerDiversityLimiter) UntagPeer(peer.ID, string) {}
+
+// UpsertTag does nothing as the peer diversity limiter does not use tags
+func (pdl *peerDiversityLimiter) UpsertTag(peer.ID, string, func(int) int) {}
+
+// GetTagInfo returns nil as the peer diversity limiter does not use tags
+func (pdl *peerDiversityLimiter) GetTagInfo(peer.ID) *connmgr.TagInfo {
+	return nil
+}
+
+// TrimOpenConns does nothing as the exceeding connections are closed as soon as they are established
+func (pdl *peerDiversityLimiter) TrimOpenConns(context.Context) {}
+
+// Notifee returns the notifiee that will be called each time a connection is established or closed
+func (pdl *peerDiversityLimiter) Notifee() network.Notifiee {
+	return pdl
+}
+
+// Protect does nothing as the peer diversity limiter does not use tags
+func (pdl *peerDiversityLimiter) Protect(peer.ID, string) {}
+
+// Unprotect does nothing as the peer diversity limiter does not use tags
+func (pdl *peerDiversityLimiter) Unprotect(peer.ID, string) bool {
+	return false
+}
+
+// Close does nothing
+func (pdl *peerDiversityLimiter) Close() error {
+	return nil
+}
+
+// Listen is called when network starts listening on an addr
+func (pdl *peerDiversityLimiter) Listen(network.Network, multiaddr.Multiaddr) {}
+
+// ListenClose is called when network stops listening on an addr
+func (pdl *peerDiversityLimiter) ListenClose(network.Network, multiaddr.Multiaddr) {}
+
+// Connected is called when a connection opened. If the remote peer is a new one and its network group already
+// holds the maximum number of peers, the connection will be closed, regardless of its direction
+func (pdl *peerDiversityLimiter) Connected(_ network.Network, conn network.Conn) {
+	group, ok := pdl.networkGroup(conn.RemoteMultiaddr())
+	if !ok {
+		return
+	}
+
+	pid := conn.RemotePeer()
+
+	pdl.mutGroups.Lock()
+	peers := pdl.peersInGroups[group]
+	if peers == nil {
+		peers = make(map[peer.ID]int)
+		pdl.peersInGroups[group] = peers
+	}
+	_, isKnownPeer := peers[pid]
+	if !isKnownPeer && len(peers) >= pdl.maxPeersPerGroup {
+		pdl.mutGroups.Unlock()
+		pdl.reject(conn)
+		return
+	}
+	peers[pid]++
+	pdl.acceptedConns[conn] = group
+	pdl.mutGroups.Unlock()
+}
+
+func (pdl *peerDiversityLimiter) reject(conn network.Conn) {
+	atomic.AddUint64(&pdl.numRejectedConnections, 1)
+	go func() {
+		err := conn.Close()
+		if err != nil {
+			log.Debug("error closing the connection from a full network group: " + err.Error())
+		}
+	}()
+}
+
+// Disconnected is called when a connection closed
+func (pdl *peerDiversityLimiter) Disconnected(_ network.Network, conn network.Conn) {
+	pdl.mutGroups.Lock()
+	defer pdl.mutGroups.Unlock()
+
+	group, ok := pdl.acceptedConns[conn]
+	if !ok {
+		return
+	}
+	delete(pdl.acceptedConns, conn)
+
+	peers := pdl.peersInGroups[group]
+	pid := conn.RemotePeer()
+	peers[pid]--
+	if peers[pid] <= 0 {
+		delete(peers, pid)
+	}
+	if len(peers) == 0 {
+		delete(pdl.peersInGroups, group)
+	}
+}
+
+// OpenedStream is called when a stream opened
+func (pdl *peerDiversityLimiter) OpenedStream(network.Network, network.Stream) {}
+
+// ClosedStream is called when a stream closed
+func (pdl *peerDiversityLimiter) ClosedStream(network.Network, network.Stream) {}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pdl *peerDiversityLimiter) IsInterfaceNil() bool {
+	if pdl == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,164 @@
+package libp2p_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+)
+
+func createConnFromAddress(pid string, address string, chClosed chan struct{}) *mock.ConnStub {
+	return &mock.ConnStub{
+		RemotePeerCalled: func() peer.ID {
+			return peer.ID(pid)
+		},
+		RemoteMultiaddrCalled: func() multiaddr.Multiaddr {
+			ma, _ := multiaddr.NewMultiaddr(address)
+			return ma
+		},
+		CloseCalled: func() error {
+			chClosed <- struct{}{}
+			return nil
+		},
+	}
+}
+
+func assertConnectionClosed(t *testing.T, chClosed chan struct{}, shouldBeClosed bool) {
+	select {
+	case <-chClosed:
+		assert.True(t, shouldBeClosed, "connection should have not been closed")
+	case <-time.After(time.Millisecond * 100):
+		assert.False(t, shouldBeClosed, "timeout waiting for the connection to be closed")
+	}
+}
+
+func TestNewPeerDiversityLimiter_InvalidMaxPeersPerGroupShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pdl, err := libp2p.NewPeerDiversityLimiter(0, 16, 32)
+
+	assert.Nil(t, pdl)
+	assert.Equal(t, p2p.ErrInvalidMaxPeersPerNetworkGroup, err)
+}
+
+func TestNewPeerDiversityLimiter_InvalidIpv4PrefixLengthShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pdl, err := libp2p.NewPeerDiversityLimiter(1, 33, 32)
+
+	assert.Nil(t, pdl)
+	assert.Equal(t, p2p.ErrInvalidNetworkPrefixLength, err)
+}
+
+func TestNewPeerDiversityLimiter_InvalidIpv6PrefixLengthShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pdl, err := libp2p.NewPeerDiversityLimiter(1, 16, 0)
+
+	assert.Nil(t, pdl)
+	assert.Equal(t, p2p.ErrInvalidNetworkPrefixLength, err)
+}
+
+func TestNewPeerDiversityLimiter_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pdl, err := libp2p.NewPeerDiversityLimiter(1, 16, 32)
+
+	assert.NotNil(t, pdl)
+	assert.Nil(t, err)
+	assert.Equal(t, pdl, pdl.Notifee())
+}
+
+func TestPeerDiversityLimiter_ConnectedOverLimitInSameGroupShouldClose(t *testing.T) {
+	t.Parallel()
+
+	chClosed := make(chan struct{}, 2)
+	pdl, _ := libp2p.NewPeerDiversityLimiter(1, 16, 32)
+
+	pdl.Connected(nil, createConnFromAddress("peer1", "/ip4/35.10.1.1/tcp/10000", chClosed))
+	assertConnectionClosed(t, chClosed, false)
+
+	pdl.Connected(nil, createConnFromAddress("peer2", "/ip4/35.10.200.7/tcp/10000", chClosed))
+	assertConnectionClosed(t, chClosed, true)
+	assert.Equal(t, uint64(1), pdl.NumRejectedConnections())
+
+	ma, _ := multiaddr.NewMultiaddr("/ip4/35.10.0.0/tcp/1")
+	assert.Equal(t, 1, pdl.NumPeersInGroup(ma))
+}
+
+func TestPeerDiversityLimiter_ConnectedDifferentGroupsShouldNotClose(t *testing.T) {
+	t.Parallel()
+
+	chClosed := make(chan struct{}, 2)
+	pdl, _ := libp2p.NewPeerDiversityLimiter(1, 16, 32)
+
+	pdl.Connected(nil, createConnFromAddress("peer1", "/ip4/35.10.1.1/tcp/10000", chClosed))
+	pdl.Connected(nil, createConnFromAddress("peer2", "/ip4/35.11.1.1/tcp/10000", chClosed))
+	pdl.Connected(nil, createConnFromAddress("peer3", "/ip6/2001:db8::1/tcp/10000", chClosed))
+
+	assertConnectionClosed(t, chClosed, false)
+	assert.Equal(t, uint64(0), pdl.NumRejectedConnections())
+}
+
+func TestPeerDiversityLimiter_ConnectedSamePeerShouldNotClose(t *testing.T) {
+	t.Parallel()
+
+	chClosed := make(chan struct{}, 2)
+	pdl, _ := libp2p.NewPeerDiversityLimiter(1, 16, 32)
+
+	pdl.Connected(nil, createConnFromAddress("peer1", "/ip4/35.10.1.1/tcp/10000", chClosed))
+	pdl.Connected(nil, createConnFromAddress("peer1", "/ip4/35.10.1.1/tcp/10001", chClosed))
+
+	assertConnectionClosed(t, chClosed, false)
+}
+
+func TestPeerDiversityLimiter_ConnectedPrivateAddressesShouldNotClose(t *testing.T) {
+	t.Parallel()
+
+	chClosed := make(chan struct{}, 3)
+	pdl, _ := libp2p.NewPeerDiversityLimiter(1, 16, 32)
+
+	pdl.Connected(nil, createConnFromAddress("peer1", "/ip4/127.0.0.1/tcp/10000", chClosed))
+	pdl.Connected(nil, createConnFromAddress("peer2", "/ip4/127.0.0.1/tcp/10001", chClosed))
+	pdl.Connected(nil, createConnFromAddress("peer3", "/ip4/10.0.0.1/tcp/10000", chClosed))
+	pdl.Connected(nil, createConnFromAddress("peer4", "/ip4/10.0.0.2/tcp/10000", chClosed))
+
+	assertConnectionClosed(t, chClosed, false)
+	assert.Equal(t, uint64(0), pdl.NumRejectedConnections())
+}
+
+func TestPeerDiversityLimiter_DisconnectedShouldFreeTheGroup(t *testing.T) {
+	t.Parallel()
+
+	chClosed := make(chan struct{}, 2)
+	pdl, _ := libp2p.NewPeerDiversityLimiter(1, 16, 32)
+
+	conn := createConnFromAddress("peer1", "/ip4/35.10.1.1/tcp/10000", chClosed)
+	pdl.Connected(nil, conn)
+	pdl.Disconnected(nil, conn)
+	pdl.Connected(nil, createConnFromAddress("peer2", "/ip4/35.10.200.7/tcp/10000", chClosed))
+
+	assertConnectionClosed(t, chClosed, false)
+	assert.Equal(t, uint64(0), pdl.NumRejectedConnections())
+}
+
+func TestPeerDiversityLimiter_DisconnectedRejectedConnectionShouldNotFreeTheGroup(t *testing.T) {
+	t.Parallel()
+
+	chClosed := make(chan struct{}, 2)
+	pdl, _ := libp2p.NewPeerDiversityLimiter(1, 16, 32)
+
+	pdl.Connected(nil, createConnFromAddress("peer1", "/ip4/35.10.1.1/tcp/10000", chClosed))
+	rejectedConn := createConnFromAddress("peer2", "/ip4/35.10.200.7/tcp/10000", chClosed)
+	pdl.Connected(nil, rejectedConn)
+	assertConnectionClosed(t, chClosed, true)
+	pdl.Disconnected(nil, rejectedConn)
+
+	ma, _ := multiaddr.NewMultiaddr("/ip4/35.10.0.0/tcp/1")
+	assert.Equal(t, 1, pdl.NumPeersInGroup(ma))
+}
@@ -0,0 +1,138 @@
+package libp2p
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+)
+
+// PeerExchangeID represents the protocol ID used for asking a peer about the addresses of its connected peers
+const PeerExchangeID = protocol.ID("/peerexchange/1.0.0")
+
+const maxPeerExchangeResponseSize = 1 << 20
+
+type peerExchange struct {
+	hostP2P            host.Host
+	maxPeersInResponse int
+	numRequests        uint64
+}
+
+func newPeerExchange(h host.Host, maxPeersInResponse int) (*peerExchange, error) {
+	if h == nil {
+		return nil, p2p.ErrNilHost
+	}
+	if maxPeersInResponse <= 0 {
+		return nil, p2p.ErrInvalidMaxPeersInResponse
+	}
+
+	pe := &peerExchange{
+		hostP2P:            h,
+		maxPeersInResponse: maxPeersInResponse,
+	}
+
+	//wire-up a handler for peer exchange requests
+	h.SetStreamHandler(PeerExchangeID, pe.peerExchangeStreamHandler)
+
+	return pe, nil
+}
+
+func (pe *peerExchange) peerExchangeStreamHandler(s network.Stream) {
+	atomic.AddUint64(&pe.numRequests, 1)
+
+	addresses := pe.connectedPeersAddresses(s.Conn().RemotePeer())
+	buff, err := json.Marshal(addresses)
+	if err != nil {
+		_ = s.Reset()
+		log.Debug("error marshaling peer exchange response: " + err.Error())
+		return
+	}
+
+	_ = s.SetWriteDeadline(time.Now().Add(streamTimeout))
+	_, err = s.Write(buff)
+	if err != nil {
+		_ = s.Reset()
+		log.Debug("error writing peer exchange response: " + err.Error())
+		return
+	}
+
+	_ = s.Close()
+}
+
+// connectedPeersAddresses returns the dialable addresses of a random subset of the connected peers, excluding
+// the requester. The addresses are taken from the peerstore as the connection's remote address of an inbound
+// connection is not a listening address
+func (pe *peerExchange) connectedPeersAddresses(requester peer.ID) []string {
+	peers := pe.hostP2P.Network().Peers()
+	addresses := make([]string, 0, pe.maxPeersInResponse)
+
+	for _, idx := range rand.Perm(len(peers)) {
+		if len(addresses) >= pe.maxPeersInResponse {
+			break
+		}
+
+		pid := peers[idx]
+		if pid == requester {
+			continue
+		}
+
+		peerAddresses := pe.hostP2P.Peerstore().Addrs(pid)
+		if len(peerAddresses) == 0 {
+			continue
+		}
+
+		addresses = append(addresses, peerAddresses[0].String()+"/p2p/"+pid.Pretty())
+	}
+
+	return addresses
+}
+
+// NumRequests returns the number of peer exchange requests served so far
+func (pe *peerExchange) NumRequests() uint64 {
+	return atomic.LoadUint64(&pe.numRequests)
+}
+
+// RequestPeerExchange asks the provided peer about the addresses of its connected peers. The peer should have
+// the peer exchange protocol enabled
+func RequestPeerExchange(ctx context.Context, h host.Host, pid peer.ID) ([]string, error) {
+	if h == nil {
+		return nil, p2p.ErrNilHost
+	}
+	if ctx == nil {
+		return nil, p2p.ErrNilContext
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, streamTimeout)
+	defer cancel()
+
+	s, err := h.NewStream(ctxTimeout, pid, PeerExchangeID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = s.Close()
+	}()
+
+	_ = s.SetReadDeadline(time.Now().Add(streamTimeout))
+	buff, err := ioutil.ReadAll(io.LimitReader(s, maxPeerExchangeResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]string, 0)
+	err = json.Unmarshal(buff, &addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	return addresses, nil
+}
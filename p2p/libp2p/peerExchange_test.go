@@ -0,0 +1,101 @@
+package libp2p_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMemoryMessengers(netw mocknet.Mocknet, numMessengers int) []libp2p.MessengerWithHost {
+	messengers := make([]libp2p.MessengerWithHost, numMessengers)
+	for i := 0; i < numMessengers; i++ {
+		mes, _ := libp2p.NewMemoryMessenger(context.Background(), netw, discovery.NewNullDiscoverer())
+		messengers[i] = mes
+	}
+	_ = netw.LinkAll()
+
+	return messengers
+}
+
+func TestNetworkMessenger_EnablePeerExchangeInvalidMaxPeersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mes := createMemoryMessengers(mocknet.New(context.Background()), 1)[0]
+
+	err := mes.EnablePeerExchange(0)
+
+	assert.Equal(t, p2p.ErrInvalidMaxPeersInResponse, err)
+}
+
+func TestNetworkMessenger_EnablePeerExchangeTwiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mes := createMemoryMessengers(mocknet.New(context.Background()), 1)[0]
+
+	err := mes.EnablePeerExchange(10)
+	assert.Nil(t, err)
+
+	err = mes.EnablePeerExchange(10)
+	assert.Equal(t, p2p.ErrPeerExchangeAlreadyEnabled, err)
+}
+
+func TestRequestPeerExchange_NilHostShouldErr(t *testing.T) {
+	t.Parallel()
+
+	addresses, err := libp2p.RequestPeerExchange(context.Background(), nil, "")
+
+	assert.Nil(t, addresses)
+	assert.Equal(t, p2p.ErrNilHost, err)
+}
+
+func TestRequestPeerExchange_PeerExchangeNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	messengers := createMemoryMessengers(mocknet.New(context.Background()), 2)
+	_ = messengers[0].ConnectToPeer(getConnectableAddress(messengers[1]) + "/p2p/" + messengers[1].ID().Pretty())
+
+	addresses, err := libp2p.RequestPeerExchange(
+		context.Background(),
+		messengers[0].Host(),
+		peer.ID(messengers[1].ID()),
+	)
+
+	assert.Nil(t, addresses)
+	assert.NotNil(t, err)
+}
+
+func TestRequestPeerExchange_ShouldReturnOtherConnectedPeers(t *testing.T) {
+	t.Parallel()
+
+	messengers := createMemoryMessengers(mocknet.New(context.Background()), 4)
+	seeder := messengers[0]
+	_ = seeder.EnablePeerExchange(2)
+	seederAddress := getConnectableAddress(seeder) + "/p2p/" + seeder.ID().Pretty()
+	for _, mes := range messengers[1:] {
+		_ = mes.ConnectToPeer(seederAddress)
+	}
+	time.Sleep(time.Second)
+
+	addresses, err := libp2p.RequestPeerExchange(
+		context.Background(),
+		messengers[1].Host(),
+		peer.ID(seeder.ID()),
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(addresses))
+	assert.Equal(t, uint64(1), seeder.NumPeerExchangeRequests())
+	for _, address := range addresses {
+		assert.NotContains(t, address, messengers[1].ID().Pretty())
+
+		err = messengers[1].ConnectToPeer(address)
+		assert.Nil(t, err)
+	}
+}
@@ -0,0 +1,5 @@
+package privnet
+
+func NewXSalsa20Stream(key *[PreSharedKeySize]byte, nonce []byte) *xsalsa20Stream {
+	return newXSalsa20Stream(key, nonce)
+}
@@ -0,0 +1,120 @@
+package privnet
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+var fingerprintNonce = []byte("elrond-privnet-fingerprint")
+
+// protector wraps the connections of a node in a private network so that only the peers holding the same
+// pre-shared key can understand each other. Each side of a connection sends a random nonce before its first
+// encrypted byte and then encrypts everything it writes with XSalsa20 keyed by the pre-shared key and its nonce, the
+// same way the libp2p private networks do
+type protector struct {
+	psk         *[PreSharedKeySize]byte
+	fingerprint []byte
+}
+
+// NewProtector creates a libp2p private network protector using the provided pre-shared key
+func NewProtector(psk *[PreSharedKeySize]byte) (*protector, error) {
+	if psk == nil {
+		return nil, p2p.ErrNilPreSharedKey
+	}
+
+	return &protector{
+		psk:         psk,
+		fingerprint: computeFingerprint(psk),
+	}, nil
+}
+
+// Protect wraps the provided connection so that all the data it carries is encrypted with the pre-shared key
+func (p *protector) Protect(conn net.Conn) (net.Conn, error) {
+	return &pskConn{
+		Conn: conn,
+		psk:  p.psk,
+	}, nil
+}
+
+// Fingerprint returns a value identifying the pre-shared key that is safe to expose, for example in logs
+func (p *protector) Fingerprint() []byte {
+	return p.fingerprint
+}
+
+// computeFingerprint hashes the key stream generated with the pre-shared key, so the fingerprint does not reveal
+// the key itself
+func computeFingerprint(psk *[PreSharedKeySize]byte) []byte {
+	nonce := sha256.Sum256(fingerprintNonce)
+	stream := newXSalsa20Stream(psk, nonce[:NonceSize])
+
+	keyStream := make([]byte, blockSize)
+	stream.XORKeyStream(keyStream, keyStream)
+	fingerprint := sha256.Sum256(keyStream)
+
+	return fingerprint[:]
+}
+
+// pskConn is a connection encrypted with the pre-shared key of the private network
+type pskConn struct {
+	net.Conn
+	psk *[PreSharedKeySize]byte
+
+	mutRead     sync.Mutex
+	readStream  *xsalsa20Stream
+	mutWrite    sync.Mutex
+	writeStream *xsalsa20Stream
+}
+
+// Read reads and decrypts data from the connection. The first read also receives the nonce of the remote side
+func (pc *pskConn) Read(out []byte) (int, error) {
+	pc.mutRead.Lock()
+	defer pc.mutRead.Unlock()
+
+	if pc.readStream == nil {
+		nonce := make([]byte, NonceSize)
+		_, err := io.ReadFull(pc.Conn, nonce)
+		if err != nil {
+			return 0, err
+		}
+
+		pc.readStream = newXSalsa20Stream(pc.psk, nonce)
+	}
+
+	n, err := pc.Conn.Read(out)
+	if n > 0 {
+		pc.readStream.XORKeyStream(out[:n], out[:n])
+	}
+
+	return n, err
+}
+
+// Write encrypts and writes data to the connection. The first write also sends the nonce of this side
+func (pc *pskConn) Write(in []byte) (int, error) {
+	pc.mutWrite.Lock()
+	defer pc.mutWrite.Unlock()
+
+	if pc.writeStream == nil {
+		nonce := make([]byte, NonceSize)
+		_, err := rand.Read(nonce)
+		if err != nil {
+			return 0, err
+		}
+
+		_, err = pc.Conn.Write(nonce)
+		if err != nil {
+			return 0, err
+		}
+
+		pc.writeStream = newXSalsa20Stream(pc.psk, nonce)
+	}
+
+	out := make([]byte, len(in))
+	pc.writeStream.XORKeyStream(out, in)
+
+	return pc.Conn.Write(out)
+}
@@ -0,0 +1,129 @@
+package privnet_test
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/privnet"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/salsa20"
+)
+
+// exchange writes data on the first connection, in the provided chunk sizes, and reads it from the second one
+func exchange(t *testing.T, writer net.Conn, reader net.Conn, data []byte, chunkSize int) []byte {
+	go func() {
+		for i := 0; i < len(data); i += chunkSize {
+			end := i + chunkSize
+			if end > len(data) {
+				end = len(data)
+			}
+			_, err := writer.Write(data[i:end])
+			assert.Nil(t, err)
+		}
+	}()
+
+	received := make([]byte, len(data))
+	_, err := io.ReadFull(reader, received)
+	assert.Nil(t, err)
+
+	return received
+}
+
+func createProtectedPipe(pskA *[privnet.PreSharedKeySize]byte, pskB *[privnet.PreSharedKeySize]byte) (net.Conn, net.Conn) {
+	connA, connB := net.Pipe()
+	protectorA, _ := privnet.NewProtector(pskA)
+	protectorB, _ := privnet.NewProtector(pskB)
+
+	protectedA, _ := protectorA.Protect(connA)
+	protectedB, _ := protectorB.Protect(connB)
+
+	return protectedA, protectedB
+}
+
+func TestNewProtector_NilKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	p, err := privnet.NewProtector(nil)
+
+	assert.Nil(t, p)
+	assert.Equal(t, p2p.ErrNilPreSharedKey, err)
+}
+
+func TestNewProtector_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	p, err := privnet.NewProtector(createKey(1))
+
+	assert.NotNil(t, p)
+	assert.Nil(t, err)
+}
+
+func TestProtector_FingerprintShouldDependOnlyOnTheKey(t *testing.T) {
+	t.Parallel()
+
+	p1, _ := privnet.NewProtector(createKey(1))
+	p2, _ := privnet.NewProtector(createKey(1))
+	p3, _ := privnet.NewProtector(createKey(2))
+
+	assert.Equal(t, p1.Fingerprint(), p2.Fingerprint())
+	assert.NotEqual(t, p1.Fingerprint(), p3.Fingerprint())
+	assert.False(t, bytes.Contains(p1.Fingerprint(), createKey(1)[:]))
+}
+
+func TestProtector_SameKeyShouldExchangeData(t *testing.T) {
+	t.Parallel()
+
+	connA, connB := createProtectedPipe(createKey(1), createKey(1))
+	defer func() {
+		_ = connA.Close()
+		_ = connB.Close()
+	}()
+
+	data := bytes.Repeat([]byte("private network data "), 50)
+
+	assert.Equal(t, data, exchange(t, connA, connB, data, 37))
+	assert.Equal(t, data, exchange(t, connB, connA, data, 100))
+}
+
+func TestProtector_DifferentKeysShouldNotUnderstandEachOther(t *testing.T) {
+	t.Parallel()
+
+	connA, connB := createProtectedPipe(createKey(1), createKey(2))
+	defer func() {
+		_ = connA.Close()
+		_ = connB.Close()
+	}()
+
+	data := bytes.Repeat([]byte("private network data "), 10)
+
+	assert.NotEqual(t, data, exchange(t, connA, connB, data, len(data)))
+}
+
+func TestXSalsa20Stream_ChunkedEncryptionShouldMatchOneShotEncryption(t *testing.T) {
+	t.Parallel()
+
+	key := createKey(1)
+	nonce := bytes.Repeat([]byte{9}, privnet.NonceSize)
+	data := bytes.Repeat([]byte("0123456789"), 100)
+
+	expected := make([]byte, len(data))
+	salsa20.XORKeyStream(expected, data, nonce, key)
+
+	stream := privnet.NewXSalsa20Stream(key, nonce)
+	encrypted := make([]byte, len(data))
+	chunkSizes := []int{1, 63, 64, 65, 7, 200}
+	offset := 0
+	for i := 0; offset < len(data); i++ {
+		end := offset + chunkSizes[i%len(chunkSizes)]
+		if end > len(data) {
+			end = len(data)
+		}
+		stream.XORKeyStream(encrypted[offset:end], data[offset:end])
+		offset = end
+	}
+
+	assert.Equal(t, expected, encrypted)
+}
@@ -0,0 +1,104 @@
+package privnet
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// PreSharedKeySize is the size in bytes of the pre-shared key of a private network
+const PreSharedKeySize = 32
+
+const swarmKeyHeader = "/key/swarm/psk/1.0.0/"
+const base16Encoding = "/base16/"
+const base64Encoding = "/base64/"
+const binaryEncoding = "/bin/"
+
+// DecodeSwarmKey reads a swarm key in the format used by the libp2p private networks: the
+// "/key/swarm/psk/1.0.0/" header line, the encoding line (/base16/, /base64/ or /bin/) and the encoded 32 bytes key
+func DecodeSwarmKey(reader io.Reader) (*[PreSharedKeySize]byte, error) {
+	bufReader := bufio.NewReader(reader)
+
+	header, err := readLine(bufReader)
+	if err != nil {
+		return nil, err
+	}
+	if header != swarmKeyHeader {
+		return nil, p2p.ErrInvalidSwarmKey
+	}
+
+	encoding, err := readLine(bufReader)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyBytes []byte
+	switch encoding {
+	case base16Encoding:
+		encodedKey, errRead := readLine(bufReader)
+		if errRead != nil {
+			return nil, errRead
+		}
+		keyBytes, err = hex.DecodeString(encodedKey)
+	case base64Encoding:
+		encodedKey, errRead := readLine(bufReader)
+		if errRead != nil {
+			return nil, errRead
+		}
+		keyBytes, err = base64.StdEncoding.DecodeString(encodedKey)
+	case binaryEncoding:
+		keyBytes = make([]byte, PreSharedKeySize)
+		_, err = io.ReadFull(bufReader, keyBytes)
+	default:
+		return nil, p2p.ErrInvalidSwarmKey
+	}
+	if err != nil {
+		return nil, p2p.ErrInvalidSwarmKey
+	}
+	if len(keyBytes) != PreSharedKeySize {
+		return nil, p2p.ErrInvalidSwarmKey
+	}
+
+	psk := [PreSharedKeySize]byte{}
+	copy(psk[:], keyBytes)
+
+	return &psk, nil
+}
+
+// LoadSwarmKey reads the swarm key from the provided file
+func LoadSwarmKey(filePath string) (*[PreSharedKeySize]byte, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return DecodeSwarmKey(file)
+}
+
+// EncodeSwarmKey returns the provided pre-shared key in the base16 swarm key format
+func EncodeSwarmKey(psk *[PreSharedKeySize]byte) []byte {
+	buff := bytes.Buffer{}
+	buff.WriteString(swarmKeyHeader + "\n")
+	buff.WriteString(base16Encoding + "\n")
+	buff.WriteString(hex.EncodeToString(psk[:]))
+
+	return buff.Bytes()
+}
+
+func readLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil && (err != io.EOF || len(line) == 0) {
+		return "", p2p.ErrInvalidSwarmKey
+	}
+
+	return strings.TrimSpace(line), nil
+}
@@ -0,0 +1,140 @@
+package privnet_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/privnet"
+	"github.com/stretchr/testify/assert"
+)
+
+func createKey(value byte) *[privnet.PreSharedKeySize]byte {
+	psk := [privnet.PreSharedKeySize]byte{}
+	for i := 0; i < privnet.PreSharedKeySize; i++ {
+		psk[i] = value + byte(i)
+	}
+
+	return &psk
+}
+
+func TestDecodeSwarmKey_EncodedKeyShouldDecode(t *testing.T) {
+	t.Parallel()
+
+	psk := createKey(1)
+
+	decoded, err := privnet.DecodeSwarmKey(bytes.NewReader(privnet.EncodeSwarmKey(psk)))
+
+	assert.Nil(t, err)
+	assert.Equal(t, psk, decoded)
+}
+
+func TestDecodeSwarmKey_Base64ShouldDecode(t *testing.T) {
+	t.Parallel()
+
+	psk := createKey(2)
+	content := "/key/swarm/psk/1.0.0/\n/base64/\n" + base64.StdEncoding.EncodeToString(psk[:]) + "\n"
+
+	decoded, err := privnet.DecodeSwarmKey(strings.NewReader(content))
+
+	assert.Nil(t, err)
+	assert.Equal(t, psk, decoded)
+}
+
+func TestDecodeSwarmKey_BinaryShouldDecode(t *testing.T) {
+	t.Parallel()
+
+	psk := createKey(3)
+	content := "/key/swarm/psk/1.0.0/\n/bin/\n" + string(psk[:])
+
+	decoded, err := privnet.DecodeSwarmKey(strings.NewReader(content))
+
+	assert.Nil(t, err)
+	assert.Equal(t, psk, decoded)
+}
+
+func TestDecodeSwarmKey_InvalidHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	content := "/key/swarm/psk/2.0.0/\n/base16/\n" + hex.EncodeToString(createKey(4)[:])
+
+	decoded, err := privnet.DecodeSwarmKey(strings.NewReader(content))
+
+	assert.Nil(t, decoded)
+	assert.Equal(t, p2p.ErrInvalidSwarmKey, err)
+}
+
+func TestDecodeSwarmKey_UnknownEncodingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	content := "/key/swarm/psk/1.0.0/\n/base32/\n" + hex.EncodeToString(createKey(5)[:])
+
+	decoded, err := privnet.DecodeSwarmKey(strings.NewReader(content))
+
+	assert.Nil(t, decoded)
+	assert.Equal(t, p2p.ErrInvalidSwarmKey, err)
+}
+
+func TestDecodeSwarmKey_InvalidKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	content := "/key/swarm/psk/1.0.0/\n/base16/\nnot a hex key"
+
+	decoded, err := privnet.DecodeSwarmKey(strings.NewReader(content))
+
+	assert.Nil(t, decoded)
+	assert.Equal(t, p2p.ErrInvalidSwarmKey, err)
+}
+
+func TestDecodeSwarmKey_ShortKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	content := "/key/swarm/psk/1.0.0/\n/base16/\n" + hex.EncodeToString(createKey(6)[:16])
+
+	decoded, err := privnet.DecodeSwarmKey(strings.NewReader(content))
+
+	assert.Nil(t, decoded)
+	assert.Equal(t, p2p.ErrInvalidSwarmKey, err)
+}
+
+func TestDecodeSwarmKey_MissingLinesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	decoded, err := privnet.DecodeSwarmKey(strings.NewReader("/key/swarm/psk/1.0.0/\n"))
+
+	assert.Nil(t, decoded)
+	assert.Equal(t, p2p.ErrInvalidSwarmKey, err)
+}
+
+func TestLoadSwarmKey_MissingFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	decoded, err := privnet.LoadSwarmKey("missing_swarm.key")
+
+	assert.Nil(t, decoded)
+	assert.NotNil(t, err)
+}
+
+func TestLoadSwarmKey_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "swarmKey")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	psk := createKey(7)
+	filePath := filepath.Join(dir, "swarm.key")
+	_ = ioutil.WriteFile(filePath, privnet.EncodeSwarmKey(psk), 0644)
+
+	decoded, err := privnet.LoadSwarmKey(filePath)
+
+	assert.Nil(t, err)
+	assert.Equal(t, psk, decoded)
+}
@@ -0,0 +1,67 @@
+package privnet
+
+import (
+	"golang.org/x/crypto/salsa20/salsa"
+)
+
+// NonceSize is the size in bytes of the nonce each side of a connection sends before its first encrypted byte
+const NonceSize = 24
+
+const blockSize = 64
+
+// xsalsa20Stream is a stateful XSalsa20 key stream: consecutive calls continue the key stream where the previous
+// call stopped, as needed when encrypting a connection chunk by chunk
+type xsalsa20Stream struct {
+	subKey    [32]byte
+	counter   [16]byte
+	keyStream [blockSize]byte
+	offset    int
+}
+
+func newXSalsa20Stream(key *[PreSharedKeySize]byte, nonce []byte) *xsalsa20Stream {
+	stream := &xsalsa20Stream{
+		offset: blockSize,
+	}
+
+	hNonce := [16]byte{}
+	copy(hNonce[:], nonce[:16])
+	salsa.HSalsa20(&stream.subKey, &hNonce, key, &salsa.Sigma)
+	copy(stream.counter[:8], nonce[16:NonceSize])
+
+	return stream
+}
+
+// XORKeyStream xors src with the next len(src) bytes of the key stream and writes the result in dst
+func (xs *xsalsa20Stream) XORKeyStream(dst []byte, src []byte) {
+	for len(src) > 0 {
+		if xs.offset == blockSize {
+			xs.nextBlock()
+		}
+
+		n := len(src)
+		if n > blockSize-xs.offset {
+			n = blockSize - xs.offset
+		}
+		for i := 0; i < n; i++ {
+			dst[i] = src[i] ^ xs.keyStream[xs.offset+i]
+		}
+
+		xs.offset += n
+		dst = dst[n:]
+		src = src[n:]
+	}
+}
+
+func (xs *xsalsa20Stream) nextBlock() {
+	zeros := [blockSize]byte{}
+	salsa.XORKeyStream(xs.keyStream[:], zeros[:], &xs.counter, &xs.subKey)
+	xs.offset = 0
+
+	// the block counter is kept little endian in the last 8 bytes of the counter
+	carry := uint32(1)
+	for i := 8; i < 16; i++ {
+		carry += uint32(xs.counter[i])
+		xs.counter[i] = byte(carry)
+		carry >>= 8
+	}
+}
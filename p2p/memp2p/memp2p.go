@@ -1,6 +1,7 @@
 package memp2p
 
 import (
+	"context"
 	"fmt"
 	"sync"
 
@@ -244,6 +245,21 @@ func (messenger *Messenger) UnregisterMessageProcessor(topic string) error {
 	return nil
 }
 
+// RemoveTopic removes the topic, together with its message processor, from
+// the list of topics of interest for this Messenger.
+func (messenger *Messenger) RemoveTopic(name string) error {
+	messenger.TopicsMutex.Lock()
+	defer messenger.TopicsMutex.Unlock()
+
+	_, found := messenger.Topics[name]
+	if !found {
+		return p2p.ErrNilTopic
+	}
+
+	delete(messenger.Topics, name)
+	return nil
+}
+
 // OutgoingChannelLoadBalancer does nothing, as it is not applicable to the in-memory network.
 func (messenger *Messenger) OutgoingChannelLoadBalancer() p2p.ChannelLoadBalancer {
 	return nil
@@ -351,7 +367,7 @@ func (messenger *Messenger) ReceiveMessage(topic string, message p2p.MessageP2P)
 		messenger.Network.LogMessage(message)
 	}
 
-	err := validator.ProcessReceivedMessage(message)
+	err := validator.ProcessReceivedMessage(context.Background(), message)
 
 	return err
 }
@@ -104,6 +104,17 @@ func TestRegisteringTopics(t *testing.T) {
 	assert.True(t, messenger.HasTopic("more_rockets"))
 	err = messenger.CreateTopic("more_rockets", false)
 	assert.NotNil(t, err)
+
+	// Remove a topic together with its MessageProcessor.
+	err = messenger.RegisterMessageProcessor("more_rockets", processor)
+	assert.Nil(t, err)
+	err = messenger.RemoveTopic("more_rockets")
+	assert.Nil(t, err)
+	assert.False(t, messenger.HasTopic("more_rockets"))
+
+	// Cannot remove a topic that doesn't exist.
+	err = messenger.RemoveTopic("more_rockets")
+	assert.Equal(t, p2p.ErrNilTopic, err)
 }
 
 func TestBroadcastingMessages(t *testing.T) {
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -16,7 +17,7 @@ func NewMockMessageProcessor(peer p2p.PeerID) *MockMessageProcessor {
 	return &processor
 }
 
-func (processor *MockMessageProcessor) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (processor *MockMessageProcessor) ProcessReceivedMessage(_ context.Context, message p2p.MessageP2P) error {
 	fmt.Printf("Message received by %s from %s: %s\n", string(processor.Peer), string(message.Peer()), string(message.Data()))
 	return nil
 }
@@ -0,0 +1,17 @@
+package mock
+
+type ForensicStorerStub struct {
+	PutCalled func(key, data []byte) error
+}
+
+func (fss *ForensicStorerStub) Put(key, data []byte) error {
+	return fss.PutCalled(key, data)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fss *ForensicStorerStub) IsInterfaceNil() bool {
+	if fss == nil {
+		return true
+	}
+	return false
+}
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
@@ -8,7 +10,7 @@ type MessageProcessorStub struct {
 	ProcessMessageCalled func(message p2p.MessageP2P) error
 }
 
-func (mps *MessageProcessorStub) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (mps *MessageProcessorStub) ProcessReceivedMessage(_ context.Context, message p2p.MessageP2P) error {
 	return mps.ProcessMessageCalled(message)
 }
 
@@ -0,0 +1,101 @@
+package namespace
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// topicSeparator is placed between the network namespace and the topic name
+const topicSeparator = "/"
+
+// namespacedMessenger is a p2p.Messenger decorator that prefixes all topic names with a network namespace
+// (e.g. the chain ID) so that multiple networks built from the same code can not cross-pollinate gossip,
+// even if their peers are connected to each other
+type namespacedMessenger struct {
+	p2p.Messenger
+	namespace string
+}
+
+// NewNamespacedMessenger wraps the provided messenger so that all topics will be created, registered and used
+// under the provided network namespace
+func NewNamespacedMessenger(messenger p2p.Messenger, namespace string) (*namespacedMessenger, error) {
+	if messenger == nil || messenger.IsInterfaceNil() {
+		return nil, p2p.ErrNilMessenger
+	}
+	if len(namespace) == 0 {
+		return nil, p2p.ErrEmptyNamespace
+	}
+
+	return &namespacedMessenger{
+		Messenger: messenger,
+		namespace: namespace,
+	}, nil
+}
+
+// TopicName returns the topic name, as seen by the underlying messenger, for the provided topic
+func (nm *namespacedMessenger) TopicName(topic string) string {
+	return nm.namespace + topicSeparator + topic
+}
+
+// ConnectedPeersOnTopic returns the IDs of the connected peers registered to the namespaced topic
+func (nm *namespacedMessenger) ConnectedPeersOnTopic(topic string) []p2p.PeerID {
+	return nm.Messenger.ConnectedPeersOnTopic(nm.TopicName(topic))
+}
+
+// CreateTopic creates the namespaced topic
+func (nm *namespacedMessenger) CreateTopic(name string, createChannelForTopic bool) error {
+	return nm.Messenger.CreateTopic(nm.TopicName(name), createChannelForTopic)
+}
+
+// HasTopic returns true if the namespaced topic has been created
+func (nm *namespacedMessenger) HasTopic(name string) bool {
+	return nm.Messenger.HasTopic(nm.TopicName(name))
+}
+
+// HasTopicValidator returns true if a validator has been registered on the namespaced topic
+func (nm *namespacedMessenger) HasTopicValidator(name string) bool {
+	return nm.Messenger.HasTopicValidator(nm.TopicName(name))
+}
+
+// RegisterMessageProcessor registers the message processor on the namespaced topic
+func (nm *namespacedMessenger) RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error {
+	return nm.Messenger.RegisterMessageProcessor(nm.TopicName(topic), handler)
+}
+
+// UnregisterMessageProcessor unregisters the message processor from the namespaced topic
+func (nm *namespacedMessenger) UnregisterMessageProcessor(topic string) error {
+	return nm.Messenger.UnregisterMessageProcessor(nm.TopicName(topic))
+}
+
+// RemoveTopic removes the namespaced topic
+func (nm *namespacedMessenger) RemoveTopic(name string) error {
+	return nm.Messenger.RemoveTopic(nm.TopicName(name))
+}
+
+// BroadcastOnChannelBlocking sends the message on the namespaced topic, blocking until sending is completed
+func (nm *namespacedMessenger) BroadcastOnChannelBlocking(channel string, topic string, buff []byte) {
+	nm.Messenger.BroadcastOnChannelBlocking(channel, nm.TopicName(topic), buff)
+}
+
+// BroadcastOnChannel asynchronously sends the message on the namespaced topic
+func (nm *namespacedMessenger) BroadcastOnChannel(channel string, topic string, buff []byte) {
+	nm.Messenger.BroadcastOnChannel(channel, nm.TopicName(topic), buff)
+}
+
+// Broadcast sends the message on the namespaced topic. The channel will be the namespaced topic as well,
+// matching the channel created by CreateTopic
+func (nm *namespacedMessenger) Broadcast(topic string, buff []byte) {
+	nm.Messenger.Broadcast(nm.TopicName(topic), buff)
+}
+
+// SendToConnectedPeer sends the message directly to a connected peer, on the namespaced topic
+func (nm *namespacedMessenger) SendToConnectedPeer(topic string, buff []byte, peerID p2p.PeerID) error {
+	return nm.Messenger.SendToConnectedPeer(nm.TopicName(topic), buff, peerID)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nm *namespacedMessenger) IsInterfaceNil() bool {
+	if nm == nil {
+		return true
+	}
+	return false
+}
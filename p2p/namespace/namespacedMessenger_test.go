@@ -0,0 +1,144 @@
+package namespace_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p/namespace"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNamespacedMessenger_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nm, err := namespace.NewNamespacedMessenger(nil, "testnet")
+
+	assert.Nil(t, nm)
+	assert.Equal(t, p2p.ErrNilMessenger, err)
+}
+
+func TestNewNamespacedMessenger_EmptyNamespaceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+
+	nm, err := namespace.NewNamespacedMessenger(messenger, "")
+
+	assert.Nil(t, nm)
+	assert.Equal(t, p2p.ErrEmptyNamespace, err)
+}
+
+func TestNewNamespacedMessenger_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+
+	nm, err := namespace.NewNamespacedMessenger(messenger, "testnet")
+
+	assert.NotNil(t, nm)
+	assert.Nil(t, err)
+	assert.Equal(t, messenger.ID(), nm.ID())
+}
+
+func TestNamespacedMessenger_CreateTopicShouldPrefixTopic(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+	nm, _ := namespace.NewNamespacedMessenger(messenger, "testnet")
+
+	err := nm.CreateTopic("transactions_0", true)
+
+	assert.Nil(t, err)
+	assert.True(t, nm.HasTopic("transactions_0"))
+	assert.True(t, messenger.HasTopic("testnet/transactions_0"))
+	assert.False(t, messenger.HasTopic("transactions_0"))
+}
+
+func TestNamespacedMessenger_RegisterMessageProcessorShouldUsePrefixedTopic(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+	nm, _ := namespace.NewNamespacedMessenger(messenger, "testnet")
+	_ = nm.CreateTopic("headers", false)
+
+	err := nm.RegisterMessageProcessor("headers", &mock.MessageProcessorStub{})
+
+	assert.Nil(t, err)
+	assert.True(t, nm.HasTopicValidator("headers"))
+	assert.True(t, messenger.HasTopicValidator("testnet/headers"))
+
+	err = nm.UnregisterMessageProcessor("headers")
+
+	assert.Nil(t, err)
+	assert.False(t, messenger.HasTopicValidator("testnet/headers"))
+}
+
+func TestNamespacedMessenger_RemoveTopicShouldRemovePrefixedTopic(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+	nm, _ := namespace.NewNamespacedMessenger(messenger, "testnet")
+	_ = nm.CreateTopic("headers", false)
+
+	err := nm.RemoveTopic("headers")
+
+	assert.Nil(t, err)
+	assert.False(t, messenger.HasTopic("testnet/headers"))
+}
+
+func TestNamespacedMessenger_SameNamespaceShouldDeliver(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger1, _ := memp2p.NewMessenger(network)
+	messenger2, _ := memp2p.NewMessenger(network)
+	nm1, _ := namespace.NewNamespacedMessenger(messenger1, "testnet")
+	nm2, _ := namespace.NewNamespacedMessenger(messenger2, "testnet")
+
+	wasReceived := false
+	_ = nm2.CreateTopic("headers", false)
+	_ = nm2.RegisterMessageProcessor("headers", &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P) error {
+			wasReceived = true
+			return nil
+		},
+	})
+
+	err := nm1.SendToConnectedPeer("headers", []byte("buff"), nm2.ID())
+
+	assert.Nil(t, err)
+	assert.True(t, wasReceived)
+	assert.Equal(t, 1, len(nm1.ConnectedPeersOnTopic("headers")))
+}
+
+func TestNamespacedMessenger_DifferentNamespacesShouldNotDeliver(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger1, _ := memp2p.NewMessenger(network)
+	messenger2, _ := memp2p.NewMessenger(network)
+	nm1, _ := namespace.NewNamespacedMessenger(messenger1, "testnet")
+	nm2, _ := namespace.NewNamespacedMessenger(messenger2, "mainnet")
+
+	wasReceived := false
+	_ = nm2.CreateTopic("headers", false)
+	_ = nm2.RegisterMessageProcessor("headers", &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P) error {
+			wasReceived = true
+			return nil
+		},
+	})
+
+	err := nm1.SendToConnectedPeer("headers", []byte("buff"), nm2.ID())
+
+	assert.Equal(t, p2p.ErrNilTopic, err)
+	assert.False(t, wasReceived)
+	assert.Equal(t, 0, len(nm1.ConnectedPeersOnTopic("headers")))
+}
@@ -10,8 +10,10 @@ import (
 // MessageProcessor is the interface used to describe what a receive message processor should do
 // All implementations that will be called from Messenger implementation will need to satisfy this interface
 // If the function returns a non nil value, the received message will not be propagated to its connected peers
+// The provided context is canceled when the time allowed for processing the message elapses, after which the
+// implementations should stop the remaining work and return
 type MessageProcessor interface {
-	ProcessReceivedMessage(message MessageP2P) error
+	ProcessReceivedMessage(ctx context.Context, message MessageP2P) error
 	IsInterfaceNil() bool
 }
 
@@ -133,6 +135,11 @@ type Messenger interface {
 	// given topic.
 	UnregisterMessageProcessor(topic string) error
 
+	// RemoveTopic unregisters the MessageProcessor of the given topic, if any,
+	// cancels the Messenger's subscription to the topic and removes the topic's
+	// outgoing channel, freeing all the resources allocated by CreateTopic.
+	RemoveTopic(name string) error
+
 	// OutgoingChannelLoadBalancer returns the ChannelLoadBalancer instance
 	// through which the Messenger is sending messages to the network.
 	OutgoingChannelLoadBalancer() ChannelLoadBalancer
@@ -192,3 +199,37 @@ type PeerDiscoveryFactory interface {
 	CreatePeerDiscoverer() (PeerDiscoverer, error)
 	IsInterfaceNil() bool
 }
+
+// PeerExchanger defines a messenger able to answer the peer exchange requests
+type PeerExchanger interface {
+	EnablePeerExchange(maxPeersInResponse int) error
+	NumPeerExchangeRequests() uint64
+}
+
+// DialBacker defines a messenger able to answer the dial back requests, used by the peers for checking if their
+// listening port is reachable from the outside
+type DialBacker interface {
+	EnableDialBack() error
+	NumDialBackRequests() uint64
+}
+
+// TopicStatistics holds the number of messages received on a topic and how many of them were rejected by the
+// message processor registered on that topic
+type TopicStatistics struct {
+	NumReceived uint64
+	NumRejected uint64
+}
+
+// StatisticsHandler defines a messenger able to report the traffic seen on its topics
+type StatisticsHandler interface {
+	TopicsStatistics() map[string]TopicStatistics
+	PendingBroadcasts() map[string]int
+	NumPubSubRestarts() uint64
+	IsInterfaceNil() bool
+}
+
+// ConnectionsLimiter defines a component that limits the number of connections opened by a host
+type ConnectionsLimiter interface {
+	NumRejectedConnections() uint64
+	IsInterfaceNil() bool
+}
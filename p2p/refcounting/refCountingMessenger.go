@@ -0,0 +1,84 @@
+package refcounting
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// refCountingMessenger is a p2p.Messenger decorator that counts, for each topic, the components using it. A topic
+// is created by its first user and is removed from the underlying messenger, together with its message processor
+// and subscription, only when its last user releases it. This way, topics left without users after the
+// interceptors or resolvers using them are removed (reconfiguration, shard change) do not leak subscriptions
+// for the whole node's lifetime
+type refCountingMessenger struct {
+	p2p.Messenger
+	mutTopicRefs sync.Mutex
+	topicRefs    map[string]int
+}
+
+// NewRefCountingMessenger wraps the provided messenger so that topics will be reference counted
+func NewRefCountingMessenger(messenger p2p.Messenger) (*refCountingMessenger, error) {
+	if messenger == nil || messenger.IsInterfaceNil() {
+		return nil, p2p.ErrNilMessenger
+	}
+
+	return &refCountingMessenger{
+		Messenger: messenger,
+		topicRefs: make(map[string]int),
+	}, nil
+}
+
+// CreateTopic creates the topic on its first use and only adds a reference to it on the next uses
+func (rcm *refCountingMessenger) CreateTopic(name string, createChannelForTopic bool) error {
+	rcm.mutTopicRefs.Lock()
+	defer rcm.mutTopicRefs.Unlock()
+
+	if rcm.topicRefs[name] > 0 {
+		rcm.topicRefs[name]++
+		return nil
+	}
+
+	err := rcm.Messenger.CreateTopic(name, createChannelForTopic)
+	if err != nil {
+		return err
+	}
+
+	rcm.topicRefs[name] = 1
+	return nil
+}
+
+// RemoveTopic releases a reference to the topic. The topic is removed from the underlying messenger when no
+// references are left
+func (rcm *refCountingMessenger) RemoveTopic(name string) error {
+	rcm.mutTopicRefs.Lock()
+	defer rcm.mutTopicRefs.Unlock()
+
+	refs := rcm.topicRefs[name]
+	if refs == 0 {
+		return p2p.ErrNilTopic
+	}
+	if refs > 1 {
+		rcm.topicRefs[name] = refs - 1
+		return nil
+	}
+
+	delete(rcm.topicRefs, name)
+	return rcm.Messenger.RemoveTopic(name)
+}
+
+// TopicReferences returns the number of components using the provided topic
+func (rcm *refCountingMessenger) TopicReferences(name string) int {
+	rcm.mutTopicRefs.Lock()
+	defer rcm.mutTopicRefs.Unlock()
+
+	return rcm.topicRefs[name]
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rcm *refCountingMessenger) IsInterfaceNil() bool {
+	if rcm == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package refcounting_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p/refcounting"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRefCountingMessenger_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rcm, err := refcounting.NewRefCountingMessenger(nil)
+
+	assert.Nil(t, rcm)
+	assert.Equal(t, p2p.ErrNilMessenger, err)
+}
+
+func TestNewRefCountingMessenger_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+
+	rcm, err := refcounting.NewRefCountingMessenger(messenger)
+
+	assert.NotNil(t, rcm)
+	assert.Nil(t, err)
+	assert.Equal(t, messenger.ID(), rcm.ID())
+}
+
+func TestRefCountingMessenger_CreateTopicTwiceShouldAddReference(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+	rcm, _ := refcounting.NewRefCountingMessenger(messenger)
+
+	err := rcm.CreateTopic("headers", false)
+	assert.Nil(t, err)
+	err = rcm.CreateTopic("headers", false)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 2, rcm.TopicReferences("headers"))
+	assert.True(t, messenger.HasTopic("headers"))
+}
+
+func TestRefCountingMessenger_CreateTopicErrorShouldNotAddReference(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+	_ = messenger.CreateTopic("headers", false)
+	rcm, _ := refcounting.NewRefCountingMessenger(messenger)
+
+	err := rcm.CreateTopic("headers", false)
+
+	assert.Equal(t, p2p.ErrTopicAlreadyExists, err)
+	assert.Equal(t, 0, rcm.TopicReferences("headers"))
+}
+
+func TestRefCountingMessenger_RemoveTopicNotCreatedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+	rcm, _ := refcounting.NewRefCountingMessenger(messenger)
+
+	err := rcm.RemoveTopic("headers")
+
+	assert.Equal(t, p2p.ErrNilTopic, err)
+}
+
+func TestRefCountingMessenger_RemoveTopicShouldKeepTopicWhileReferenced(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+	rcm, _ := refcounting.NewRefCountingMessenger(messenger)
+	_ = rcm.CreateTopic("headers", false)
+	_ = rcm.CreateTopic("headers", false)
+	_ = rcm.RegisterMessageProcessor("headers", &mock.MessageProcessorStub{})
+
+	err := rcm.RemoveTopic("headers")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, rcm.TopicReferences("headers"))
+	assert.True(t, messenger.HasTopicValidator("headers"))
+
+	err = rcm.RemoveTopic("headers")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, rcm.TopicReferences("headers"))
+	assert.False(t, messenger.HasTopic("headers"))
+}
+
+func TestRefCountingMessenger_TopicCanBeCreatedAgainAfterRemoval(t *testing.T) {
+	t.Parallel()
+
+	network, _ := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+	rcm, _ := refcounting.NewRefCountingMessenger(messenger)
+	_ = rcm.CreateTopic("headers", false)
+	_ = rcm.RemoveTopic("headers")
+
+	err := rcm.CreateTopic("headers", false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, rcm.TopicReferences("headers"))
+	assert.True(t, messenger.HasTopic("headers"))
+}
@@ -26,6 +26,7 @@ type ArgBaseProcessor struct {
 	Uint64Converter       typeConverters.Uint64ByteSliceConverter
 	StartHeaders          map[uint32]data.HeaderHandler
 	RequestHandler        process.RequestHandler
+	HeadersPoolsCleaner   process.HeadersPoolsCleaner
 	Core                  serviceContainer.Core
 }
 
@@ -36,6 +37,7 @@ type ArgShardProcessor struct {
 	DataPool        dataRetriever.PoolsHolder
 	TxCoordinator   process.TransactionCoordinator
 	TxsPoolsCleaner process.PoolsCleaner
+	BlockEconomics  process.BlockEconomicsHandler
 }
 
 // ArgMetaProcessor holds all dependencies required by the process data factory in order to create
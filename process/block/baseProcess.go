@@ -58,6 +58,7 @@ type baseProcessor struct {
 	store                 dataRetriever.StorageService
 	uint64Converter       typeConverters.Uint64ByteSliceConverter
 	blockSizeThrottler    process.BlockSizeThrottler
+	headersPoolsCleaner   process.HeadersPoolsCleaner
 
 	hdrsForCurrBlock hdrForBlock
 
@@ -188,6 +189,39 @@ func (bp *baseProcessor) checkBlockValidity(
 	return nil
 }
 
+// checkHeaderNotCommitted quickly rejects, without touching the state, a header whose nonce was already committed.
+// Such headers are common during gossip floods after a restart, so each rejection is counted in the status metrics
+func (bp *baseProcessor) checkHeaderNotCommitted(chainHandler data.ChainHandler, headerHandler data.HeaderHandler) error {
+	if chainHandler == nil || chainHandler.IsInterfaceNil() {
+		return nil
+	}
+	if headerHandler == nil || headerHandler.IsInterfaceNil() {
+		return nil
+	}
+
+	currentBlockHeader := chainHandler.GetCurrentBlockHeader()
+	if currentBlockHeader == nil || currentBlockHeader.IsInterfaceNil() {
+		return nil
+	}
+	if headerHandler.GetNonce() > currentBlockHeader.GetNonce() {
+		return nil
+	}
+
+	bp.appStatusHandler.Increment(core.MetricNumStaleHeadersRejected)
+
+	if headerHandler.GetNonce() == currentBlockHeader.GetNonce() {
+		headerHash, err := core.CalculateHash(bp.marshalizer, bp.hasher, headerHandler)
+		if err == nil && bytes.Equal(headerHash, chainHandler.GetCurrentBlockHeaderHash()) {
+			return process.ErrHeaderAlreadyCommitted
+		}
+	}
+
+	log.Debug(fmt.Sprintf("stale header rejected: local block nonce is %d and node received block with nonce %d\n",
+		currentBlockHeader.GetNonce(), headerHandler.GetNonce()))
+
+	return process.ErrHeaderNonceAlreadyCommitted
+}
+
 // verifyStateRoot verifies the state root hash given as parameter against the
 // Merkle trie root hash stored for accounts and returns if equal or not
 func (bp *baseProcessor) verifyStateRoot(rootHash []byte) bool {
@@ -297,6 +331,31 @@ func (bp *baseProcessor) removeNotarizedHdrsBehindPreviousFinal(hdrsToPreservedB
 	bp.mutNotarizedHdrs.Unlock()
 }
 
+// finalNoncesForHeadersPools returns, for each shard, the nonce below which the headers from pools are no longer
+// needed. The own shard's final nonce is the provided one while, for the other shards, the oldest notarized header
+// kept, which is the previous final one, is used
+func (bp *baseProcessor) finalNoncesForHeadersPools(highestFinalBlockNonce uint64) map[uint32]uint64 {
+	finalNonces := make(map[uint32]uint64)
+
+	bp.mutNotarizedHdrs.RLock()
+	for shardId, hdrs := range bp.notarizedHdrs {
+		if len(hdrs) > 0 {
+			finalNonces[shardId] = hdrs[0].GetNonce()
+		}
+	}
+	bp.mutNotarizedHdrs.RUnlock()
+
+	finalNonces[bp.shardCoordinator.SelfId()] = highestFinalBlockNonce
+
+	return finalNonces
+}
+
+func (bp *baseProcessor) cleanHeadersPools(finalNonces map[uint32]uint64) {
+	_, err := bp.headersPoolsCleaner.Clean(finalNonces, maxCleanTime)
+	log.LogIfError(err)
+	log.Debug(fmt.Sprintf("%d headers have been removed from pools after cleaning\n", bp.headersPoolsCleaner.NumRemovedHeaders()))
+}
+
 func (bp *baseProcessor) removeLastNotarized() {
 	bp.mutNotarizedHdrs.Lock()
 	for shardId := range bp.notarizedHdrs {
@@ -544,6 +603,9 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if arguments.RequestHandler == nil || arguments.RequestHandler.IsInterfaceNil() {
 		return process.ErrNilRequestHandler
 	}
+	if arguments.HeadersPoolsCleaner == nil || arguments.HeadersPoolsCleaner.IsInterfaceNil() {
+		return process.ErrNilHeadersPoolsCleaner
+	}
 
 	return nil
 }
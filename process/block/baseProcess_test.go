@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
@@ -337,11 +338,13 @@ func CreateMockArguments() blproc.ArgShardProcessor {
 			Uint64Converter:       &mock.Uint64ByteSliceConverterMock{},
 			StartHeaders:          createGenesisBlocks(mock.NewOneShardCoordinatorMock()),
 			RequestHandler:        &mock.RequestHandlerMock{},
+			HeadersPoolsCleaner:   &mock.HeadersPoolsCleanerMock{},
 			Core:                  &mock.ServiceContainerMock{},
 		},
 		DataPool:        initDataPool([]byte("")),
 		TxCoordinator:   &mock.TransactionCoordinatorMock{},
 		TxsPoolsCleaner: &mock.TxPoolsCleanerMock{},
+		BlockEconomics:  &mock.BlockEconomicsHandlerStub{},
 	}
 
 	return arguments
@@ -402,6 +405,66 @@ func TestBlockProcessor_CheckBlockValidity(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestBlockProcessor_CheckHeaderNotCommittedNoCurrentHeaderShouldPass(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := blproc.NewShardProcessor(CreateMockArguments())
+
+	err := bp.CheckHeaderNotCommitted(createTestBlockchain(), &block.Header{Nonce: 0})
+	assert.Nil(t, err)
+}
+
+func TestBlockProcessor_CheckHeaderNotCommittedHigherNonceShouldPass(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := blproc.NewShardProcessor(CreateMockArguments())
+	blkc := createTestBlockchain()
+	blkc.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return &block.Header{Round: 5, Nonce: 5}
+	}
+
+	err := bp.CheckHeaderNotCommitted(blkc, &block.Header{Round: 6, Nonce: 6})
+	assert.Nil(t, err)
+}
+
+func TestBlockProcessor_CheckHeaderNotCommittedSameHeaderShouldErrAndCount(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.Hasher = &mock.HasherMock{}
+	arguments.Marshalizer = &mock.MarshalizerMock{}
+	bp, _ := blproc.NewShardProcessor(arguments)
+	numIncrements := 0
+	_ = bp.SetAppStatusHandler(&mock.AppStatusHandlerStub{
+		IncrementHandler: func(key string) {
+			if key == core.MetricNumStaleHeadersRejected {
+				numIncrements++
+			}
+		},
+	})
+
+	committedHeader := &block.Header{Round: 5, Nonce: 5, RootHash: []byte("root hash")}
+	committedHash, _ := core.CalculateHash(arguments.Marshalizer, arguments.Hasher, committedHeader)
+	blkc := createTestBlockchain()
+	blkc.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return committedHeader
+	}
+	blkc.GetCurrentBlockHeaderHashCalled = func() []byte {
+		return committedHash
+	}
+
+	err := bp.CheckHeaderNotCommitted(blkc, &block.Header{Round: 5, Nonce: 5, RootHash: []byte("root hash")})
+	assert.Equal(t, process.ErrHeaderAlreadyCommitted, err)
+
+	err = bp.CheckHeaderNotCommitted(blkc, &block.Header{Round: 6, Nonce: 5, RootHash: []byte("other root hash")})
+	assert.Equal(t, process.ErrHeaderNonceAlreadyCommitted, err)
+
+	err = bp.CheckHeaderNotCommitted(blkc, &block.Header{Round: 3, Nonce: 3})
+	assert.Equal(t, process.ErrHeaderNonceAlreadyCommitted, err)
+
+	assert.Equal(t, 3, numIncrements)
+}
+
 func TestVerifyStateRoot_ShouldWork(t *testing.T) {
 	t.Parallel()
 	rootHash := []byte("root hash to be tested")
@@ -833,3 +896,24 @@ func TestBaseProcessor_RemoveLastNotarizedShouldNotDeleteTheLastRecord(t *testin
 		assert.Equal(t, genesisBlcks[i], hdr)
 	}
 }
+
+func TestBaseProcessor_FinalNoncesForHeadersPoolsShouldUseOldestNotarizedAndOwnFinalNonce(t *testing.T) {
+	t.Parallel()
+
+	nrShards := uint32(3)
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(nrShards)
+	base := blproc.NewBaseProcessor(shardCoordinator)
+	_ = base.SetLastNotarizedHeadersSlice(createGenesisBlocks(shardCoordinator))
+
+	for nonce := uint64(1); nonce <= 4; nonce++ {
+		base.AddLastNotarizedHdr(1, &block.Header{Nonce: nonce, ShardId: 1})
+	}
+	base.RemoveNotarizedHdrsBehindPreviousFinal(2)
+
+	finalNonces := base.FinalNoncesForHeadersPools(7)
+
+	assert.Equal(t, uint64(7), finalNonces[shardCoordinator.SelfId()])
+	assert.Equal(t, uint64(2), finalNonces[1])
+	assert.Equal(t, uint64(0), finalNonces[2])
+	assert.Equal(t, uint64(0), finalNonces[sharding.MetachainShardId])
+}
@@ -32,6 +32,10 @@ func (bp *baseProcessor) CheckBlockValidity(
 	return bp.checkBlockValidity(chainHandler, headerHandler, bodyHandler)
 }
 
+func (bp *baseProcessor) CheckHeaderNotCommitted(chainHandler data.ChainHandler, headerHandler data.HeaderHandler) error {
+	return bp.checkHeaderNotCommitted(chainHandler, headerHandler)
+}
+
 func DisplayHeader(headerHandler data.HeaderHandler) []*display.LineData {
 	return displayHeader(headerHandler)
 }
@@ -73,11 +77,13 @@ func NewShardProcessorEmptyWith3shards(tdp dataRetriever.PoolsHolder, genesisBlo
 			Uint64Converter:       &mock.Uint64ByteSliceConverterMock{},
 			StartHeaders:          genesisBlocks,
 			RequestHandler:        &mock.RequestHandlerMock{},
+			HeadersPoolsCleaner:   &mock.HeadersPoolsCleanerMock{},
 			Core:                  &mock.ServiceContainerMock{},
 		},
 		DataPool:        tdp,
 		TxCoordinator:   &mock.TransactionCoordinatorMock{},
 		TxsPoolsCleaner: &mock.TxPoolsCleanerMock{},
+		BlockEconomics:  &mock.BlockEconomicsHandlerStub{},
 	}
 	shardProcessor, err := NewShardProcessor(arguments)
 	return shardProcessor, err
@@ -150,6 +156,14 @@ func (bp *baseProcessor) RemoveLastNotarized() {
 	bp.removeLastNotarized()
 }
 
+func (bp *baseProcessor) RemoveNotarizedHdrsBehindPreviousFinal(hdrsToPreservedBehindFinal uint32) {
+	bp.removeNotarizedHdrsBehindPreviousFinal(hdrsToPreservedBehindFinal)
+}
+
+func (bp *baseProcessor) FinalNoncesForHeadersPools(highestFinalBlockNonce uint64) map[uint32]uint64 {
+	return bp.finalNoncesForHeadersPools(highestFinalBlockNonce)
+}
+
 func (bp *baseProcessor) SetMarshalizer(marshal marshal.Marshalizer) {
 	bp.marshalizer = marshal
 }
@@ -0,0 +1,107 @@
+package block
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// InterceptedFinalityProof represents the wrapper over the FinalityProof struct
+type InterceptedFinalityProof struct {
+	*block.FinalityProof
+	multiSigVerifier crypto.MultiSigVerifier
+	nodesCoordinator sharding.NodesCoordinator
+}
+
+// NewInterceptedFinalityProof creates a new instance of InterceptedFinalityProof struct
+func NewInterceptedFinalityProof(
+	multiSigVerifier crypto.MultiSigVerifier,
+	nodesCoordinator sharding.NodesCoordinator,
+) *InterceptedFinalityProof {
+
+	return &InterceptedFinalityProof{
+		FinalityProof:    &block.FinalityProof{},
+		multiSigVerifier: multiSigVerifier,
+		nodesCoordinator: nodesCoordinator,
+	}
+}
+
+// Hash gets the hash of the header this proof was created for. The hash will also be the ID of this object
+func (inFp *InterceptedFinalityProof) Hash() []byte {
+	return inFp.HeaderHash
+}
+
+// GetFinalityProof returns the FinalityProof pointer that holds the data
+func (inFp *InterceptedFinalityProof) GetFinalityProof() *block.FinalityProof {
+	return inFp.FinalityProof
+}
+
+// Integrity checks the integrity of the finality proof
+func (inFp *InterceptedFinalityProof) Integrity(coordinator sharding.Coordinator) error {
+	if coordinator == nil || coordinator.IsInterfaceNil() {
+		return process.ErrNilShardCoordinator
+	}
+	if inFp.FinalityProof == nil {
+		return process.ErrNilFinalityProof
+	}
+	if inFp.HeaderHash == nil {
+		return process.ErrNilHeaderHash
+	}
+	if inFp.SignedMessageHash == nil {
+		return process.ErrNilSignedMessageHash
+	}
+	if inFp.ShardId >= coordinator.NumberOfShards() && inFp.ShardId != sharding.MetachainShardId {
+		return process.ErrInvalidShardId
+	}
+	if inFp.PrevRandSeed == nil {
+		return process.ErrNilPrevRandSeed
+	}
+	if inFp.PubKeysBitmap == nil {
+		return process.ErrNilPubKeysBitmap
+	}
+	if inFp.AggregatedSignature == nil {
+		return process.ErrNilSignature
+	}
+
+	return nil
+}
+
+// VerifySig verifies the aggregated signature of the consensus group over the signed message hash. It does not
+// check that the signed message hash belongs to the header hash: this can only be done by those knowing the header
+func (inFp *InterceptedFinalityProof) VerifySig() error {
+	bitmap := inFp.PubKeysBitmap
+
+	if len(bitmap) == 0 {
+		return process.ErrNilPubKeysBitmap
+	}
+
+	if bitmap[0]&1 == 0 {
+		return process.ErrBlockProposerSignatureMissing
+	}
+
+	consensusPubKeys, err := inFp.nodesCoordinator.GetValidatorsPublicKeys(inFp.PrevRandSeed, inFp.Round, inFp.ShardId)
+	if err != nil {
+		return err
+	}
+
+	verifier, err := inFp.multiSigVerifier.Create(consensusPubKeys, 0)
+	if err != nil {
+		return err
+	}
+
+	err = verifier.SetAggregatedSig(inFp.AggregatedSignature)
+	if err != nil {
+		return err
+	}
+
+	return verifier.Verify(inFp.SignedMessageHash, bitmap)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (inFp *InterceptedFinalityProof) IsInterfaceNil() bool {
+	if inFp == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+package block_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestInterceptedFinalityProof() *block.InterceptedFinalityProof {
+	proof := block.NewInterceptedFinalityProof(
+		mock.NewMultiSigner(),
+		&mock.NodesCoordinatorMock{},
+	)
+	proof.HeaderHash = []byte("header hash")
+	proof.SignedMessageHash = []byte("signed message hash")
+	proof.PrevRandSeed = make([]byte, 0)
+	proof.PubKeysBitmap = []byte{1, 0, 0}
+	proof.AggregatedSignature = make([]byte, 0)
+
+	return proof
+}
+
+func TestInterceptedFinalityProof_NewShouldNotCreateNilProof(t *testing.T) {
+	t.Parallel()
+
+	proof := createTestInterceptedFinalityProof()
+
+	assert.NotNil(t, proof.FinalityProof)
+	assert.True(t, proof.GetFinalityProof() == proof.FinalityProof)
+}
+
+func TestInterceptedFinalityProof_HashShouldReturnHeaderHash(t *testing.T) {
+	t.Parallel()
+
+	proof := createTestInterceptedFinalityProof()
+
+	assert.Equal(t, []byte("header hash"), proof.Hash())
+}
+
+func TestInterceptedFinalityProof_IntegrityNilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proof := createTestInterceptedFinalityProof()
+
+	assert.Equal(t, process.ErrNilShardCoordinator, proof.Integrity(nil))
+}
+
+func TestInterceptedFinalityProof_IntegrityNilHeaderHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proof := createTestInterceptedFinalityProof()
+	proof.HeaderHash = nil
+
+	assert.Equal(t, process.ErrNilHeaderHash, proof.Integrity(mock.NewOneShardCoordinatorMock()))
+}
+
+func TestInterceptedFinalityProof_IntegrityNilSignedMessageHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proof := createTestInterceptedFinalityProof()
+	proof.SignedMessageHash = nil
+
+	assert.Equal(t, process.ErrNilSignedMessageHash, proof.Integrity(mock.NewOneShardCoordinatorMock()))
+}
+
+func TestInterceptedFinalityProof_IntegrityInvalidShardIdShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proof := createTestInterceptedFinalityProof()
+	proof.ShardId = 2
+
+	assert.Equal(t, process.ErrInvalidShardId, proof.Integrity(mock.NewOneShardCoordinatorMock()))
+}
+
+func TestInterceptedFinalityProof_IntegrityNilPubKeysBitmapShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proof := createTestInterceptedFinalityProof()
+	proof.PubKeysBitmap = nil
+
+	assert.Equal(t, process.ErrNilPubKeysBitmap, proof.Integrity(mock.NewOneShardCoordinatorMock()))
+}
+
+func TestInterceptedFinalityProof_IntegrityNilSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proof := createTestInterceptedFinalityProof()
+	proof.AggregatedSignature = nil
+
+	assert.Equal(t, process.ErrNilSignature, proof.Integrity(mock.NewOneShardCoordinatorMock()))
+}
+
+func TestInterceptedFinalityProof_IntegrityMetachainProofShouldWork(t *testing.T) {
+	t.Parallel()
+
+	proof := createTestInterceptedFinalityProof()
+	proof.ShardId = sharding.MetachainShardId
+
+	assert.Nil(t, proof.Integrity(mock.NewOneShardCoordinatorMock()))
+}
+
+func TestInterceptedFinalityProof_VerifySigLeaderNotSignedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proof := createTestInterceptedFinalityProof()
+	proof.PubKeysBitmap = []byte{0, 1, 1}
+
+	assert.Equal(t, process.ErrBlockProposerSignatureMissing, proof.VerifySig())
+}
+
+func TestInterceptedFinalityProof_VerifySigShouldWork(t *testing.T) {
+	t.Parallel()
+
+	proof := createTestInterceptedFinalityProof()
+
+	assert.Nil(t, proof.VerifySig())
+}
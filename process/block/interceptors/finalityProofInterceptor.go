@@ -0,0 +1,109 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// FinalityProofInterceptor represents an interceptor used for the finality proofs of the shards and metachain headers
+type FinalityProofInterceptor struct {
+	*messageChecker
+	*messageTracing
+	marshalizer      marshal.Marshalizer
+	finalityProofs   storage.Cacher
+	multiSigVerifier crypto.MultiSigVerifier
+	shardCoordinator sharding.Coordinator
+	nodesCoordinator sharding.NodesCoordinator
+}
+
+// NewFinalityProofInterceptor hooks a new interceptor for finality proofs
+// Fetched finality proofs will be placed in a data pool, keyed by the hash of the header they were created for
+func NewFinalityProofInterceptor(
+	marshalizer marshal.Marshalizer,
+	finalityProofs storage.Cacher,
+	multiSigVerifier crypto.MultiSigVerifier,
+	shardCoordinator sharding.Coordinator,
+	nodesCoordinator sharding.NodesCoordinator,
+) (*FinalityProofInterceptor, error) {
+
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+	if finalityProofs == nil || finalityProofs.IsInterfaceNil() {
+		return nil, process.ErrNilFinalityProofsDataPool
+	}
+	if multiSigVerifier == nil || multiSigVerifier.IsInterfaceNil() {
+		return nil, process.ErrNilMultiSigVerifier
+	}
+	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if nodesCoordinator == nil || nodesCoordinator.IsInterfaceNil() {
+		return nil, process.ErrNilNodesCoordinator
+	}
+
+	return &FinalityProofInterceptor{
+		messageChecker:   &messageChecker{},
+		messageTracing:   newMessageTracing(),
+		marshalizer:      marshalizer,
+		finalityProofs:   finalityProofs,
+		multiSigVerifier: multiSigVerifier,
+		shardCoordinator: shardCoordinator,
+		nodesCoordinator: nodesCoordinator,
+	}, nil
+}
+
+// ProcessReceivedMessage will be the callback func from the p2p.Messenger and will be called each time a new message was received
+// (for the topic this validator was registered to)
+func (fpi *FinalityProofInterceptor) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
+	err := fpi.checkMessage(message)
+	if err != nil {
+		return err
+	}
+
+	trace := fpi.startTrace(message)
+
+	proofIntercepted := block.NewInterceptedFinalityProof(fpi.multiSigVerifier, fpi.nodesCoordinator)
+	err = fpi.marshalizer.Unmarshal(proofIntercepted, message.Data())
+	if err != nil {
+		return err
+	}
+	trace.MarkStage(tracing.StageUnmarshaled)
+
+	err = proofIntercepted.Integrity(fpi.shardCoordinator)
+	if err != nil {
+		return err
+	}
+
+	err = ctx.Err()
+	if err != nil {
+		return err
+	}
+
+	err = proofIntercepted.VerifySig()
+	if err != nil {
+		return err
+	}
+	trace.MarkStage(tracing.StageVerified)
+
+	fpi.finalityProofs.HasOrAdd(proofIntercepted.Hash(), proofIntercepted.GetFinalityProof())
+	trace.MarkStage(tracing.StageAddedToPool)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fpi *FinalityProofInterceptor) IsInterfaceNil() bool {
+	if fpi == nil {
+		return true
+	}
+	return false
+}
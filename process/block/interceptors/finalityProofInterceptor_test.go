@@ -0,0 +1,213 @@
+package interceptors_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/interceptors"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestFinalityProof() *dataBlock.FinalityProof {
+	return &dataBlock.FinalityProof{
+		HeaderHash:          []byte("header hash"),
+		SignedMessageHash:   []byte("signed message hash"),
+		Nonce:               67,
+		PrevRandSeed:        make([]byte, 0),
+		PubKeysBitmap:       []byte{1, 0, 0},
+		AggregatedSignature: make([]byte, 0),
+	}
+}
+
+//------- NewFinalityProofInterceptor
+
+func TestNewFinalityProofInterceptor_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fpi, err := interceptors.NewFinalityProofInterceptor(
+		nil,
+		&mock.CacherStub{},
+		mock.NewMultiSigner(),
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+	)
+
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.Nil(t, fpi)
+}
+
+func TestNewFinalityProofInterceptor_NilFinalityProofsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fpi, err := interceptors.NewFinalityProofInterceptor(
+		&mock.MarshalizerMock{},
+		nil,
+		mock.NewMultiSigner(),
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+	)
+
+	assert.Equal(t, process.ErrNilFinalityProofsDataPool, err)
+	assert.Nil(t, fpi)
+}
+
+func TestNewFinalityProofInterceptor_NilMultiSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fpi, err := interceptors.NewFinalityProofInterceptor(
+		&mock.MarshalizerMock{},
+		&mock.CacherStub{},
+		nil,
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+	)
+
+	assert.Equal(t, process.ErrNilMultiSigVerifier, err)
+	assert.Nil(t, fpi)
+}
+
+func TestNewFinalityProofInterceptor_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fpi, err := interceptors.NewFinalityProofInterceptor(
+		&mock.MarshalizerMock{},
+		&mock.CacherStub{},
+		mock.NewMultiSigner(),
+		nil,
+		mock.NewNodesCoordinatorMock(),
+	)
+
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+	assert.Nil(t, fpi)
+}
+
+func TestNewFinalityProofInterceptor_NilNodesCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fpi, err := interceptors.NewFinalityProofInterceptor(
+		&mock.MarshalizerMock{},
+		&mock.CacherStub{},
+		mock.NewMultiSigner(),
+		mock.NewOneShardCoordinatorMock(),
+		nil,
+	)
+
+	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+	assert.Nil(t, fpi)
+}
+
+func TestNewFinalityProofInterceptor_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	fpi, err := interceptors.NewFinalityProofInterceptor(
+		&mock.MarshalizerMock{},
+		&mock.CacherStub{},
+		mock.NewMultiSigner(),
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+	)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, fpi)
+}
+
+//------- ProcessReceivedMessage
+
+func TestFinalityProofInterceptor_ProcessReceivedMessageNilMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fpi, _ := interceptors.NewFinalityProofInterceptor(
+		&mock.MarshalizerMock{},
+		&mock.CacherStub{},
+		mock.NewMultiSigner(),
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+	)
+
+	assert.Equal(t, process.ErrNilMessage, fpi.ProcessReceivedMessage(context.Background(), nil))
+}
+
+func TestFinalityProofInterceptor_ProcessReceivedMessageSanityCheckFailedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	fpi, _ := interceptors.NewFinalityProofInterceptor(
+		marshalizer,
+		&mock.CacherStub{},
+		mock.NewMultiSigner(),
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+	)
+
+	proof := createTestFinalityProof()
+	proof.HeaderHash = nil
+	buff, _ := marshalizer.Marshal(proof)
+	msg := &mock.P2PMessageMock{
+		DataField: buff,
+	}
+
+	assert.Equal(t, process.ErrNilHeaderHash, fpi.ProcessReceivedMessage(context.Background(), msg))
+}
+
+func TestFinalityProofInterceptor_ProcessReceivedMessageLeaderNotSignedShouldNotAdd(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	finalityProofs := &mock.CacherStub{
+		HasOrAddCalled: func(key []byte, value interface{}) (ok, evicted bool) {
+			assert.Fail(t, "should have not added the proof")
+			return
+		},
+	}
+	fpi, _ := interceptors.NewFinalityProofInterceptor(
+		marshalizer,
+		finalityProofs,
+		mock.NewMultiSigner(),
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+	)
+
+	proof := createTestFinalityProof()
+	proof.PubKeysBitmap = []byte{0, 1, 1}
+	buff, _ := marshalizer.Marshal(proof)
+	msg := &mock.P2PMessageMock{
+		DataField: buff,
+	}
+
+	assert.Equal(t, process.ErrBlockProposerSignatureMissing, fpi.ProcessReceivedMessage(context.Background(), msg))
+}
+
+func TestFinalityProofInterceptor_ProcessReceivedMessageValsOkShouldAdd(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	var addedProof *dataBlock.FinalityProof
+	finalityProofs := &mock.CacherStub{
+		HasOrAddCalled: func(key []byte, value interface{}) (ok, evicted bool) {
+			if bytes.Equal(key, []byte("header hash")) {
+				addedProof = value.(*dataBlock.FinalityProof)
+			}
+			return
+		},
+	}
+	fpi, _ := interceptors.NewFinalityProofInterceptor(
+		marshalizer,
+		finalityProofs,
+		mock.NewMultiSigner(),
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+	)
+
+	proof := createTestFinalityProof()
+	buff, _ := marshalizer.Marshal(proof)
+	msg := &mock.P2PMessageMock{
+		DataField: buff,
+	}
+
+	assert.Nil(t, fpi.ProcessReceivedMessage(context.Background(), msg))
+	assert.Equal(t, proof, addedProof)
+}
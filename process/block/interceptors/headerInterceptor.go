@@ -1,6 +1,8 @@
 package interceptors
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
@@ -9,12 +11,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // HeaderInterceptor represents an interceptor used for block headers
 type HeaderInterceptor struct {
+	*messageTracing
 	marshalizer      marshal.Marshalizer
 	storer           storage.Storer
 	multiSigVerifier crypto.MultiSigVerifier
@@ -65,6 +69,7 @@ func NewHeaderInterceptor(
 	}
 
 	hdrInterceptor := &HeaderInterceptor{
+		messageTracing:   newMessageTracing(),
 		marshalizer:      marshalizer,
 		multiSigVerifier: multiSigVerifier,
 		hasher:           hasher,
@@ -80,7 +85,15 @@ func NewHeaderInterceptor(
 
 // ParseReceivedMessage will transform the received p2p.Message in an InterceptedHeader.
 // If the header hash is present in storage it will output an error
-func (hi *HeaderInterceptor) ParseReceivedMessage(message p2p.MessageP2P) (*block.InterceptedHeader, error) {
+func (hi *HeaderInterceptor) ParseReceivedMessage(ctx context.Context, message p2p.MessageP2P) (*block.InterceptedHeader, error) {
+	return hi.parseReceivedMessage(ctx, message, tracing.NewDisabledMessageTrace())
+}
+
+func (hi *HeaderInterceptor) parseReceivedMessage(
+	ctx context.Context,
+	message p2p.MessageP2P,
+	trace process.MessageTrace,
+) (*block.InterceptedHeader, error) {
 	if message == nil || message.IsInterfaceNil() {
 		return nil, process.ErrNilMessage
 	}
@@ -93,6 +106,7 @@ func (hi *HeaderInterceptor) ParseReceivedMessage(message p2p.MessageP2P) (*bloc
 	if err != nil {
 		return nil, err
 	}
+	trace.MarkStage(tracing.StageUnmarshaled)
 
 	hashWithSig := hi.hasher.Compute(string(message.Data()))
 	hdrIntercepted.SetHash(hashWithSig)
@@ -102,23 +116,31 @@ func (hi *HeaderInterceptor) ParseReceivedMessage(message p2p.MessageP2P) (*bloc
 		return nil, err
 	}
 
+	err = ctx.Err()
+	if err != nil {
+		return nil, err
+	}
+
 	err = hdrIntercepted.VerifySig()
 	if err != nil {
 		return nil, err
 	}
+	trace.MarkStage(tracing.StageVerified)
 
 	return hdrIntercepted, nil
 }
 
 // ProcessReceivedMessage will be the callback func from the p2p.Messenger and will be called each time a new message was received
 // (for the topic this validator was registered to)
-func (hi *HeaderInterceptor) ProcessReceivedMessage(message p2p.MessageP2P) error {
-	hdrIntercepted, err := hi.ParseReceivedMessage(message)
+func (hi *HeaderInterceptor) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
+	trace := hi.startTrace(message)
+
+	hdrIntercepted, err := hi.parseReceivedMessage(ctx, message, trace)
 	if err != nil {
 		return err
 	}
 
-	go hi.processHeader(hdrIntercepted)
+	go hi.processHeader(hdrIntercepted, trace)
 
 	return nil
 }
@@ -131,7 +153,7 @@ func (hi *HeaderInterceptor) checkHeaderForCurrentShard(interceptedHdr *block.In
 	return isHeaderForCurrentShard || isMetachainShardCoordinator
 }
 
-func (hi *HeaderInterceptor) processHeader(hdrIntercepted *block.InterceptedHeader) {
+func (hi *HeaderInterceptor) processHeader(hdrIntercepted *block.InterceptedHeader, trace process.MessageTrace) {
 	if !hi.checkHeaderForCurrentShard(hdrIntercepted) {
 		return
 	}
@@ -141,12 +163,14 @@ func (hi *HeaderInterceptor) processHeader(hdrIntercepted *block.InterceptedHead
 		log.Debug("intercepted block header can not be processed")
 		return
 	}
+	trace.MarkStage(tracing.StageValidated)
 
 	hi.headers.HasOrAdd(hdrIntercepted.Hash(), hdrIntercepted.GetHeader())
 
 	syncMap := &dataPool.ShardIdHashSyncMap{}
 	syncMap.Store(hdrIntercepted.ShardId, hdrIntercepted.Hash())
 	hi.headersNonces.Merge(hdrIntercepted.Nonce, syncMap)
+	trace.MarkStage(tracing.StageAddedToPool)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
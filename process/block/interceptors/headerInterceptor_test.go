@@ -2,6 +2,7 @@ package interceptors_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -12,10 +13,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block"
 	"github.com/ElrondNetwork/elrond-go/process/block/interceptors"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/stretchr/testify/assert"
 )
@@ -266,7 +269,7 @@ func TestHeaderInterceptor_ParseReceivedMessageNilMessageShouldErr(t *testing.T)
 		&mock.NodesCoordinatorMock{},
 	)
 
-	hdr, err := hi.ParseReceivedMessage(nil)
+	hdr, err := hi.ParseReceivedMessage(context.Background(), nil)
 
 	assert.Nil(t, hdr)
 	assert.Equal(t, process.ErrNilMessage, err)
@@ -291,7 +294,7 @@ func TestHeaderInterceptor_ParseReceivedMessageNilDataToProcessShouldErr(t *test
 	)
 
 	msg := &mock.P2PMessageMock{}
-	hdr, err := hi.ParseReceivedMessage(msg)
+	hdr, err := hi.ParseReceivedMessage(context.Background(), msg)
 
 	assert.Nil(t, hdr)
 	assert.Equal(t, process.ErrNilDataToProcess, err)
@@ -324,7 +327,7 @@ func TestHeaderInterceptor_ParseReceivedMessageMarshalizerErrorsAtUnmarshalingSh
 	msg := &mock.P2PMessageMock{
 		DataField: make([]byte, 0),
 	}
-	hdr, err := hi.ParseReceivedMessage(msg)
+	hdr, err := hi.ParseReceivedMessage(context.Background(), msg)
 
 	assert.Nil(t, hdr)
 	assert.Equal(t, errMarshalizer, err)
@@ -358,7 +361,7 @@ func TestHeaderInterceptor_ParseReceivedMessageSanityCheckFailedShouldErr(t *tes
 	msg := &mock.P2PMessageMock{
 		DataField: buff,
 	}
-	hdr, err := hi.ParseReceivedMessage(msg)
+	hdr, err := hi.ParseReceivedMessage(context.Background(), msg)
 
 	assert.Nil(t, hdr)
 	assert.Equal(t, process.ErrNilPubKeysBitmap, err)
@@ -412,7 +415,7 @@ func TestHeaderInterceptor_ParseReceivedMessageValsOkShouldWork(t *testing.T) {
 		DataField: buff,
 	}
 
-	hdrIntercepted, err := hi.ParseReceivedMessage(msg)
+	hdrIntercepted, err := hi.ParseReceivedMessage(context.Background(), msg)
 	if hdrIntercepted != nil {
 		//hdrIntercepted will have a "real" hash computed
 		hdrIntercepted.SetHash(hdr.Hash())
@@ -442,7 +445,7 @@ func TestHeaderInterceptor_ProcessReceivedMessageNilMessageShouldErr(t *testing.
 		mock.NewNodesCoordinatorMock(),
 	)
 
-	assert.Equal(t, process.ErrNilMessage, hi.ProcessReceivedMessage(nil))
+	assert.Equal(t, process.ErrNilMessage, hi.ProcessReceivedMessage(context.Background(), nil))
 }
 
 func TestHeaderInterceptor_ProcessReceivedMessageValsOkShouldWork(t *testing.T) {
@@ -516,7 +519,7 @@ func TestHeaderInterceptor_ProcessReceivedMessageValsOkShouldWork(t *testing.T)
 		chanDone <- struct{}{}
 	}()
 
-	assert.Nil(t, hi.ProcessReceivedMessage(msg))
+	assert.Nil(t, hi.ProcessReceivedMessage(context.Background(), msg))
 	select {
 	case <-chanDone:
 	case <-time.After(durTimeout):
@@ -582,7 +585,7 @@ func TestHeaderInterceptor_ProcessReceivedMessageTestHdrNonces(t *testing.T) {
 		}
 	}
 
-	assert.Nil(t, hi.ProcessReceivedMessage(msg))
+	assert.Nil(t, hi.ProcessReceivedMessage(context.Background(), msg))
 	select {
 	case <-chanDone:
 	case <-time.After(durTimeout):
@@ -654,7 +657,7 @@ func TestHeaderInterceptor_ProcessReceivedMessageIsNotValidShouldNotAdd(t *testi
 		return false, false
 	}
 
-	assert.Nil(t, hi.ProcessReceivedMessage(msg))
+	assert.Nil(t, hi.ProcessReceivedMessage(context.Background(), msg))
 	select {
 	case <-chanDone:
 		assert.Fail(t, "should have not add block in pool")
@@ -735,5 +738,154 @@ func TestHeaderInterceptor_ProcessReceivedMessageNotForCurrentShardShouldNotAdd(
 		return false, false
 	}
 
-	assert.Nil(t, hi.ProcessReceivedMessage(msg))
+	assert.Nil(t, hi.ProcessReceivedMessage(context.Background(), msg))
+}
+
+func TestHeaderInterceptor_SetMessageTracerNilTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hi, _ := interceptors.NewHeaderInterceptor(
+		&mock.MarshalizerMock{},
+		&mock.CacherStub{},
+		&mock.Uint64SyncMapCacherStub{},
+		&mock.HeaderValidatorStub{},
+		mock.NewMultiSigner(),
+		mock.HasherMock{},
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+	)
+
+	err := hi.SetMessageTracer(nil)
+
+	assert.Equal(t, process.ErrNilMessageTracer, err)
+}
+
+func TestHeaderInterceptor_ProcessReceivedMessageShouldMarkTraceStages(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	multisigner := mock.NewMultiSigner()
+	headerValidator := &mock.HeaderValidatorStub{
+		IsHeaderValidForProcessingCalled: func(headerHandler data.HeaderHandler) bool {
+			return true
+		},
+	}
+
+	nodesCoordinator := mock.NewNodesCoordinatorMock()
+	nodes := generateValidatorsMap(3, 3, 1)
+	_ = nodesCoordinator.SetNodesPerShards(nodes)
+
+	hi, _ := interceptors.NewHeaderInterceptor(
+		marshalizer,
+		&mock.CacherStub{
+			HasOrAddCalled: func(key []byte, value interface{}) (ok, evicted bool) {
+				return false, false
+			},
+		},
+		&mock.Uint64SyncMapCacherStub{
+			MergeCalled: func(nonce uint64, src dataRetriever.ShardIdHashMap) {},
+		},
+		headerValidator,
+		multisigner,
+		mock.HasherMock{},
+		mock.NewOneShardCoordinatorMock(),
+		nodesCoordinator,
+	)
+
+	chanStages := make(chan string, 10)
+	trace := &mock.MessageTraceStub{
+		MarkStageCalled: func(stage string) {
+			chanStages <- stage
+		},
+	}
+	_ = hi.SetMessageTracer(&mock.MessageTracerStub{
+		StartTraceCalled: func(message p2p.MessageP2P) process.MessageTrace {
+			return trace
+		},
+	})
+
+	hdr := block.NewInterceptedHeader(multisigner, nodesCoordinator, marshalizer, mock.HasherMock{})
+	hdr.Nonce = 67
+	hdr.ShardId = 0
+	hdr.PrevHash = make([]byte, 0)
+	hdr.PubKeysBitmap = []byte{1}
+	hdr.BlockBodyType = dataBlock.TxBlock
+	hdr.Signature = make([]byte, 0)
+	hdr.RootHash = make([]byte, 0)
+	hdr.PrevRandSeed = make([]byte, 0)
+	hdr.RandSeed = make([]byte, 0)
+	hdr.MiniBlockHeaders = make([]dataBlock.MiniBlockHeader, 0)
+	buff, _ := marshalizer.Marshal(hdr)
+
+	assert.Nil(t, hi.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: buff}))
+
+	expectedStages := []string{
+		tracing.StageUnmarshaled,
+		tracing.StageVerified,
+		tracing.StageValidated,
+		tracing.StageAddedToPool,
+	}
+	for _, expectedStage := range expectedStages {
+		select {
+		case stage := <-chanStages:
+			assert.Equal(t, expectedStage, stage)
+		case <-time.After(durTimeout):
+			assert.Fail(t, "timeout while waiting for stage "+expectedStage)
+			return
+		}
+	}
+}
+
+func TestHeaderInterceptor_ProcessReceivedMessageCanceledContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	multisigner := mock.NewMultiSigner()
+	nodesCoordinator := mock.NewNodesCoordinatorMock()
+	nodes := generateValidatorsMap(3, 3, 1)
+	_ = nodesCoordinator.SetNodesPerShards(nodes)
+
+	wasAdded := false
+	hi, _ := interceptors.NewHeaderInterceptor(
+		marshalizer,
+		&mock.CacherStub{
+			HasOrAddCalled: func(key []byte, value interface{}) (ok, evicted bool) {
+				wasAdded = true
+				return false, false
+			},
+		},
+		&mock.Uint64SyncMapCacherStub{
+			MergeCalled: func(nonce uint64, src dataRetriever.ShardIdHashMap) {},
+		},
+		&mock.HeaderValidatorStub{
+			IsHeaderValidForProcessingCalled: func(headerHandler data.HeaderHandler) bool {
+				return true
+			},
+		},
+		multisigner,
+		mock.HasherMock{},
+		mock.NewOneShardCoordinatorMock(),
+		nodesCoordinator,
+	)
+
+	hdr := block.NewInterceptedHeader(multisigner, nodesCoordinator, marshalizer, mock.HasherMock{})
+	hdr.Nonce = 67
+	hdr.ShardId = 0
+	hdr.PrevHash = make([]byte, 0)
+	hdr.PubKeysBitmap = []byte{1}
+	hdr.BlockBodyType = dataBlock.TxBlock
+	hdr.Signature = make([]byte, 0)
+	hdr.RootHash = make([]byte, 0)
+	hdr.PrevRandSeed = make([]byte, 0)
+	hdr.RandSeed = make([]byte, 0)
+	hdr.MiniBlockHeaders = make([]dataBlock.MiniBlockHeader, 0)
+	buff, _ := marshalizer.Marshal(hdr)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := hi.ProcessReceivedMessage(ctx, &mock.P2PMessageMock{DataField: buff})
+
+	assert.Equal(t, context.Canceled, err)
+	time.Sleep(time.Millisecond * 100)
+	assert.False(t, wasAdded)
 }
@@ -0,0 +1,40 @@
+package interceptors
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
+)
+
+type messageTracing struct {
+	mutTracer sync.RWMutex
+	tracer    process.MessageTracer
+}
+
+func newMessageTracing() *messageTracing {
+	return &messageTracing{
+		tracer: tracing.NewDisabledMessageTracer(),
+	}
+}
+
+// SetMessageTracer sets the tracer reporting the processing stages of the sampled received messages
+func (mt *messageTracing) SetMessageTracer(tracer process.MessageTracer) error {
+	if tracer == nil || tracer.IsInterfaceNil() {
+		return process.ErrNilMessageTracer
+	}
+
+	mt.mutTracer.Lock()
+	mt.tracer = tracer
+	mt.mutTracer.Unlock()
+
+	return nil
+}
+
+func (mt *messageTracing) startTrace(message p2p.MessageP2P) process.MessageTrace {
+	mt.mutTracer.RLock()
+	defer mt.mutTracer.RUnlock()
+
+	return mt.tracer.StartTrace(message)
+}
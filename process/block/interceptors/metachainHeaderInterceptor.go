@@ -1,6 +1,8 @@
 package interceptors
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
@@ -9,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
@@ -16,6 +19,7 @@ import (
 // MetachainHeaderInterceptor represents an interceptor used for metachain block headers
 type MetachainHeaderInterceptor struct {
 	*messageChecker
+	*messageTracing
 	marshalizer            marshal.Marshalizer
 	metachainHeaders       storage.Cacher
 	metachainHeadersNonces dataRetriever.Uint64SyncMapCacher
@@ -66,6 +70,7 @@ func NewMetachainHeaderInterceptor(
 
 	return &MetachainHeaderInterceptor{
 		messageChecker:         &messageChecker{},
+		messageTracing:         newMessageTracing(),
 		marshalizer:            marshalizer,
 		metachainHeaders:       metachainHeaders,
 		headerValidator:        headerValidator,
@@ -79,12 +84,14 @@ func NewMetachainHeaderInterceptor(
 
 // ProcessReceivedMessage will be the callback func from the p2p.Messenger and will be called each time a new message was received
 // (for the topic this validator was registered to)
-func (mhi *MetachainHeaderInterceptor) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (mhi *MetachainHeaderInterceptor) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
 	err := mhi.checkMessage(message)
 	if err != nil {
 		return err
 	}
 
+	trace := mhi.startTrace(message)
+
 	metaHdrIntercepted := block.NewInterceptedMetaHeader(
 		mhi.multiSigVerifier,
 		mhi.nodesCoordinator,
@@ -95,6 +102,7 @@ func (mhi *MetachainHeaderInterceptor) ProcessReceivedMessage(message p2p.Messag
 	if err != nil {
 		return err
 	}
+	trace.MarkStage(tracing.StageUnmarshaled)
 
 	hashWithSig := mhi.hasher.Compute(string(message.Data()))
 	metaHdrIntercepted.SetHash(hashWithSig)
@@ -104,28 +112,39 @@ func (mhi *MetachainHeaderInterceptor) ProcessReceivedMessage(message p2p.Messag
 		return err
 	}
 
+	err = ctx.Err()
+	if err != nil {
+		return err
+	}
+
 	err = metaHdrIntercepted.VerifySig()
 	if err != nil {
 		return err
 	}
+	trace.MarkStage(tracing.StageVerified)
 
-	go mhi.processMetaHeader(metaHdrIntercepted)
+	go mhi.processMetaHeader(metaHdrIntercepted, trace)
 
 	return nil
 }
 
-func (mhi *MetachainHeaderInterceptor) processMetaHeader(metaHdrIntercepted *block.InterceptedMetaHeader) {
+func (mhi *MetachainHeaderInterceptor) processMetaHeader(
+	metaHdrIntercepted *block.InterceptedMetaHeader,
+	trace process.MessageTrace,
+) {
 	isHeaderOkForProcessing := mhi.headerValidator.IsHeaderValidForProcessing(metaHdrIntercepted.MetaBlock)
 	if !isHeaderOkForProcessing {
 		log.Debug("intercepted meta block header already processed")
 		return
 	}
+	trace.MarkStage(tracing.StageValidated)
 
 	mhi.metachainHeaders.HasOrAdd(metaHdrIntercepted.Hash(), metaHdrIntercepted.GetMetaHeader())
 
 	syncMap := &dataPool.ShardIdHashSyncMap{}
 	syncMap.Store(sharding.MetachainShardId, metaHdrIntercepted.Hash())
 	mhi.metachainHeadersNonces.Merge(metaHdrIntercepted.Nonce, syncMap)
+	trace.MarkStage(tracing.StageAddedToPool)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
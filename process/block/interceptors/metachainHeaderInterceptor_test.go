@@ -2,6 +2,7 @@ package interceptors_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"sync"
 	"testing"
@@ -223,7 +224,7 @@ func TestMetachainHeaderInterceptor_ProcessReceivedMessageNilMessageShouldErr(t
 		mock.NewNodesCoordinatorMock(),
 	)
 
-	assert.Equal(t, process.ErrNilMessage, mhi.ProcessReceivedMessage(nil))
+	assert.Equal(t, process.ErrNilMessage, mhi.ProcessReceivedMessage(context.Background(), nil))
 }
 
 func TestMetachainHeaderInterceptor_ProcessReceivedMessageNilDataToProcessShouldErr(t *testing.T) {
@@ -245,7 +246,7 @@ func TestMetachainHeaderInterceptor_ProcessReceivedMessageNilDataToProcessShould
 
 	msg := &mock.P2PMessageMock{}
 
-	assert.Equal(t, process.ErrNilDataToProcess, mhi.ProcessReceivedMessage(msg))
+	assert.Equal(t, process.ErrNilDataToProcess, mhi.ProcessReceivedMessage(context.Background(), msg))
 }
 
 func TestMetachainHeaderInterceptor_ProcessReceivedMessageMarshalizerErrorsAtUnmarshalingShouldErr(t *testing.T) {
@@ -274,7 +275,7 @@ func TestMetachainHeaderInterceptor_ProcessReceivedMessageMarshalizerErrorsAtUnm
 		DataField: make([]byte, 0),
 	}
 
-	assert.Equal(t, errMarshalizer, mhi.ProcessReceivedMessage(msg))
+	assert.Equal(t, errMarshalizer, mhi.ProcessReceivedMessage(context.Background(), msg))
 }
 
 func TestMetachainHeaderInterceptor_ProcessReceivedMessageSanityCheckFailedShouldErr(t *testing.T) {
@@ -304,7 +305,7 @@ func TestMetachainHeaderInterceptor_ProcessReceivedMessageSanityCheckFailedShoul
 		DataField: buff,
 	}
 
-	assert.Equal(t, process.ErrNilPubKeysBitmap, mhi.ProcessReceivedMessage(msg))
+	assert.Equal(t, process.ErrNilPubKeysBitmap, mhi.ProcessReceivedMessage(context.Background(), msg))
 }
 
 func TestMetachainHeaderInterceptor_ProcessReceivedMessageValsOkShouldWork(t *testing.T) {
@@ -382,7 +383,7 @@ func TestMetachainHeaderInterceptor_ProcessReceivedMessageValsOkShouldWork(t *te
 		chanDone <- struct{}{}
 	}()
 
-	assert.Nil(t, mhi.ProcessReceivedMessage(msg))
+	assert.Nil(t, mhi.ProcessReceivedMessage(context.Background(), msg))
 	select {
 	case <-chanDone:
 	case <-time.After(durTimeout):
@@ -458,7 +459,7 @@ func TestMetachainHeaderInterceptor_ProcessReceivedMessageIsNotValidShouldNotAdd
 		})
 	}
 
-	assert.Nil(t, mhi.ProcessReceivedMessage(msg))
+	assert.Nil(t, mhi.ProcessReceivedMessage(context.Background(), msg))
 	select {
 	case <-chanDone:
 		assert.Fail(t, "should have not add block in pool")
@@ -1,6 +1,8 @@
 package interceptors
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -57,7 +59,7 @@ func NewPeerBlockBodyInterceptor(
 
 // ProcessReceivedMessage will be the callback func from the p2p.Messenger and will be called each time a new message was received
 // (for the topic this validator was registered to)
-func (pbbi *PeerBlockBodyInterceptor) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (pbbi *PeerBlockBodyInterceptor) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
 	err := pbbi.checkMessage(message)
 	if err != nil {
 		return err
@@ -69,6 +71,11 @@ func (pbbi *PeerBlockBodyInterceptor) ProcessReceivedMessage(message p2p.Message
 		return err
 	}
 
+	err = ctx.Err()
+	if err != nil {
+		return err
+	}
+
 	err = peerChBlockBody.IntegrityAndValidity(pbbi.shardCoordinator)
 	if err != nil {
 		return err
@@ -1,6 +1,7 @@
 package interceptors_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -64,7 +65,7 @@ func TestPeerBlockBodyInterceptor_ProcessReceivedMessageNilMessageShouldErr(t *t
 		mock.HasherMock{},
 		mock.NewOneShardCoordinatorMock())
 
-	assert.Equal(t, process.ErrNilMessage, pbbi.ProcessReceivedMessage(nil))
+	assert.Equal(t, process.ErrNilMessage, pbbi.ProcessReceivedMessage(context.Background(), nil))
 }
 
 func TestPeerBlockBodyInterceptor_ProcessReceivedMessageNilMessageDataShouldErr(t *testing.T) {
@@ -82,7 +83,7 @@ func TestPeerBlockBodyInterceptor_ProcessReceivedMessageNilMessageDataShouldErr(
 
 	msg := &mock.P2PMessageMock{}
 
-	assert.Equal(t, process.ErrNilDataToProcess, pbbi.ProcessReceivedMessage(msg))
+	assert.Equal(t, process.ErrNilDataToProcess, pbbi.ProcessReceivedMessage(context.Background(), msg))
 }
 
 func TestPeerBlockBodyInterceptor_ValidateMarshalizerErrorsAtUnmarshalingShouldErr(t *testing.T) {
@@ -108,7 +109,7 @@ func TestPeerBlockBodyInterceptor_ValidateMarshalizerErrorsAtUnmarshalingShouldE
 		DataField: make([]byte, 0),
 	}
 
-	assert.Equal(t, errMarshalizer, pbbi.ProcessReceivedMessage(msg))
+	assert.Equal(t, errMarshalizer, pbbi.ProcessReceivedMessage(context.Background(), msg))
 }
 
 func TestPeerBlockBodyInterceptor_ProcessReceivedMessageBlockShouldWork(t *testing.T) {
@@ -147,7 +148,7 @@ func TestPeerBlockBodyInterceptor_ProcessReceivedMessageBlockShouldWork(t *testi
 		return true, false
 	}
 
-	assert.Nil(t, pbbi.ProcessReceivedMessage(msg))
+	assert.Nil(t, pbbi.ProcessReceivedMessage(context.Background(), msg))
 	select {
 	case <-chanDone:
 	case <-time.After(durTimeout):
@@ -191,7 +192,7 @@ func TestPeerBlockBodyInterceptor_ProcessReceivedMessageIsInStorageShouldNotAdd(
 		return true, false
 	}
 
-	assert.Nil(t, pbbi.ProcessReceivedMessage(msg))
+	assert.Nil(t, pbbi.ProcessReceivedMessage(context.Background(), msg))
 	select {
 	case <-chanDone:
 		assert.Fail(t, "should have not add block in pool")
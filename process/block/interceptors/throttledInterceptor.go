@@ -0,0 +1,76 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// ThrottledInterceptor wraps an interceptor so that it processes a limited number of messages at the same time.
+// It is used for the custom interceptors registered on the interceptors container factories, giving them the same
+// throttling, message tracing and throttler saturation reporting as the built-in interceptors
+type ThrottledInterceptor struct {
+	interceptor process.Interceptor
+	throttler   process.InterceptorThrottler
+}
+
+// NewThrottledInterceptor creates a new throttled interceptor wrapping the provided one
+func NewThrottledInterceptor(
+	interceptor process.Interceptor,
+	throttler process.InterceptorThrottler,
+) (*ThrottledInterceptor, error) {
+
+	if interceptor == nil || interceptor.IsInterfaceNil() {
+		return nil, process.ErrNilInterceptor
+	}
+	if throttler == nil || throttler.IsInterfaceNil() {
+		return nil, process.ErrNilThrottler
+	}
+
+	return &ThrottledInterceptor{
+		interceptor: interceptor,
+		throttler:   throttler,
+	}, nil
+}
+
+// ProcessReceivedMessage will be the callback func from the p2p.Messenger and will be called each time a new message was received
+// (for the topic this validator was registered to)
+func (ti *ThrottledInterceptor) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
+	canProcess := ti.throttler.CanProcess()
+	if !canProcess {
+		return process.ErrSystemBusy
+	}
+
+	ti.throttler.StartProcessing()
+	defer ti.throttler.EndProcessing()
+
+	return ti.interceptor.ProcessReceivedMessage(ctx, message)
+}
+
+// SetMessageTracer sets the tracer on the wrapped interceptor, if it is able to report its processing stages
+func (ti *ThrottledInterceptor) SetMessageTracer(tracer process.MessageTracer) error {
+	if tracer == nil || tracer.IsInterfaceNil() {
+		return process.ErrNilMessageTracer
+	}
+
+	traceableInterceptor, ok := ti.interceptor.(process.TraceableInterceptor)
+	if !ok {
+		return nil
+	}
+
+	return traceableInterceptor.SetMessageTracer(tracer)
+}
+
+// Throttler returns the throttler limiting the number of messages processed at the same time
+func (ti *ThrottledInterceptor) Throttler() process.InterceptorThrottler {
+	return ti.throttler
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ti *ThrottledInterceptor) IsInterfaceNil() bool {
+	if ti == nil {
+		return true
+	}
+	return false
+}
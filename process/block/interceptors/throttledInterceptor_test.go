@@ -0,0 +1,156 @@
+package interceptors_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/interceptors"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+//------- NewThrottledInterceptor
+
+func TestNewThrottledInterceptor_NilInterceptorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ti, err := interceptors.NewThrottledInterceptor(nil, &mock.InterceptorThrottlerStub{})
+
+	assert.Equal(t, process.ErrNilInterceptor, err)
+	assert.Nil(t, ti)
+}
+
+func TestNewThrottledInterceptor_NilThrottlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ti, err := interceptors.NewThrottledInterceptor(&mock.InterceptorStub{}, nil)
+
+	assert.Equal(t, process.ErrNilThrottler, err)
+	assert.Nil(t, ti)
+}
+
+func TestNewThrottledInterceptor_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	throttler := &mock.InterceptorThrottlerStub{}
+	ti, err := interceptors.NewThrottledInterceptor(&mock.InterceptorStub{}, throttler)
+
+	assert.Nil(t, err)
+	assert.False(t, ti.IsInterfaceNil())
+	assert.True(t, throttler == ti.Throttler())
+}
+
+//------- ProcessReceivedMessage
+
+func TestThrottledInterceptor_ProcessReceivedMessageSystemBusyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	throttler := &mock.InterceptorThrottlerStub{
+		CanProcessCalled: func() bool {
+			return false
+		},
+	}
+	ti, _ := interceptors.NewThrottledInterceptor(
+		&mock.InterceptorStub{
+			ProcessReceivedMessageCalled: func(message p2p.MessageP2P) error {
+				wasCalled = true
+				return nil
+			},
+		},
+		throttler,
+	)
+
+	err := ti.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: []byte("data")})
+
+	assert.Equal(t, process.ErrSystemBusy, err)
+	assert.False(t, wasCalled)
+	assert.Equal(t, int32(0), throttler.StartProcessingCount())
+}
+
+func TestThrottledInterceptor_ProcessReceivedMessageShouldCallTheWrappedInterceptor(t *testing.T) {
+	t.Parallel()
+
+	msg := &mock.P2PMessageMock{DataField: []byte("data")}
+	var processedMessage p2p.MessageP2P
+	throttler := &mock.InterceptorThrottlerStub{
+		CanProcessCalled: func() bool {
+			return true
+		},
+	}
+	ti, _ := interceptors.NewThrottledInterceptor(
+		&mock.InterceptorStub{
+			ProcessReceivedMessageCalled: func(message p2p.MessageP2P) error {
+				processedMessage = message
+				return process.ErrNilDataToProcess
+			},
+		},
+		throttler,
+	)
+
+	err := ti.ProcessReceivedMessage(context.Background(), msg)
+
+	assert.Equal(t, process.ErrNilDataToProcess, err)
+	assert.True(t, msg == processedMessage)
+	assert.Equal(t, int32(1), throttler.StartProcessingCount())
+	assert.Equal(t, int32(1), throttler.EndProcessingCount())
+}
+
+//------- SetMessageTracer
+
+func TestThrottledInterceptor_SetMessageTracerNilTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ti, _ := interceptors.NewThrottledInterceptor(&mock.InterceptorStub{}, &mock.InterceptorThrottlerStub{})
+
+	err := ti.SetMessageTracer(nil)
+
+	assert.Equal(t, process.ErrNilMessageTracer, err)
+}
+
+func TestThrottledInterceptor_SetMessageTracerShouldSetItOnTheWrappedInterceptor(t *testing.T) {
+	t.Parallel()
+
+	wrapped, _ := interceptors.NewFinalityProofInterceptor(
+		&mock.MarshalizerMock{},
+		&mock.CacherStub{},
+		mock.NewMultiSigner(),
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+	)
+	ti, _ := interceptors.NewThrottledInterceptor(
+		wrapped,
+		&mock.InterceptorThrottlerStub{
+			CanProcessCalled: func() bool {
+				return true
+			},
+		},
+	)
+	wasCalled := false
+	tracer := &mock.MessageTracerStub{
+		StartTraceCalled: func(message p2p.MessageP2P) process.MessageTrace {
+			wasCalled = true
+			return &mock.MessageTraceStub{
+				MarkStageCalled: func(stage string) {},
+			}
+		},
+	}
+
+	err := ti.SetMessageTracer(tracer)
+	assert.Nil(t, err)
+
+	_ = ti.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: []byte("data")})
+	assert.True(t, wasCalled)
+}
+
+func TestThrottledInterceptor_SetMessageTracerNotTraceableInterceptorShouldNotErr(t *testing.T) {
+	t.Parallel()
+
+	ti, _ := interceptors.NewThrottledInterceptor(&mock.InterceptorStub{}, &mock.InterceptorThrottlerStub{})
+
+	err := ti.SetMessageTracer(&mock.MessageTracerStub{})
+
+	assert.Nil(t, err)
+}
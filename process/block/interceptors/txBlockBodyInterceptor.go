@@ -1,12 +1,15 @@
 package interceptors
 
 import (
+	"context"
+
 	blockData "github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
@@ -14,6 +17,7 @@ import (
 // TxBlockBodyInterceptor represents an interceptor used for transaction block bodies
 type TxBlockBodyInterceptor struct {
 	*messageChecker
+	*messageTracing
 	marshalizer      marshal.Marshalizer
 	cache            storage.Cacher
 	hasher           hashing.Hasher
@@ -48,6 +52,7 @@ func NewTxBlockBodyInterceptor(
 
 	return &TxBlockBodyInterceptor{
 		messageChecker:   &messageChecker{},
+		messageTracing:   newMessageTracing(),
 		marshalizer:      marshalizer,
 		cache:            cache,
 		storer:           storer,
@@ -58,12 +63,14 @@ func NewTxBlockBodyInterceptor(
 
 // ProcessReceivedMessage will be the callback func from the p2p.Messenger and will be called each time a new message was received
 // (for the topic this validator was registered to)
-func (tbbi *TxBlockBodyInterceptor) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (tbbi *TxBlockBodyInterceptor) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
 	err := tbbi.checkMessage(message)
 	if err != nil {
 		return err
 	}
 
+	trace := tbbi.startTrace(message)
+
 	txBlockBody := block.NewInterceptedTxBlockBody()
 	miniBlocks := make([]*blockData.MiniBlock, 0)
 	x := message.Data()
@@ -71,8 +78,14 @@ func (tbbi *TxBlockBodyInterceptor) ProcessReceivedMessage(message p2p.MessageP2
 	if err != nil {
 		return err
 	}
+	trace.MarkStage(tracing.StageUnmarshaled)
 	txBlockBody.TxBlockBody = miniBlocks
 
+	err = ctx.Err()
+	if err != nil {
+		return err
+	}
+
 	hash := tbbi.hasher.Compute(string(message.Data()))
 	txBlockBody.SetHash(hash)
 
@@ -80,24 +93,30 @@ func (tbbi *TxBlockBodyInterceptor) ProcessReceivedMessage(message p2p.MessageP2
 	if err != nil {
 		return err
 	}
+	trace.MarkStage(tracing.StageVerified)
 
 	blockBody, ok := txBlockBody.GetUnderlyingObject().(blockData.Body)
 	if !ok {
 		return process.ErrCouldNotDecodeUnderlyingBody
 	}
 
-	go tbbi.processBlockBody(txBlockBody, blockBody)
+	go tbbi.processBlockBody(txBlockBody, blockBody, trace)
 
 	return nil
 }
 
-func (tbbi *TxBlockBodyInterceptor) processBlockBody(txBlockBody *block.InterceptedTxBlockBody, blockBody blockData.Body) {
+func (tbbi *TxBlockBodyInterceptor) processBlockBody(
+	txBlockBody *block.InterceptedTxBlockBody,
+	blockBody blockData.Body,
+	trace process.MessageTrace,
+) {
 	err := tbbi.storer.Has(txBlockBody.Hash())
 	isBlockInStorage := err == nil
 	if isBlockInStorage {
 		log.Debug("intercepted block body already processed")
 		return
 	}
+	trace.MarkStage(tracing.StageValidated)
 
 	for _, miniblock := range blockBody {
 		mbBytes, err := tbbi.marshalizer.Marshal(miniblock)
@@ -108,6 +127,7 @@ func (tbbi *TxBlockBodyInterceptor) processBlockBody(txBlockBody *block.Intercep
 
 		tbbi.cache.HasOrAdd(tbbi.hasher.Compute(string(mbBytes)), miniblock)
 	}
+	trace.MarkStage(tracing.StageAddedToPool)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
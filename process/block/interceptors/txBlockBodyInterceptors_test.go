@@ -2,6 +2,7 @@ package interceptors_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -130,7 +131,7 @@ func TestTxBlockBodyInterceptor_ProcessReceivedMessageNilMessageShouldErr(t *tes
 		mock.HasherMock{},
 		mock.NewOneShardCoordinatorMock())
 
-	assert.Equal(t, process.ErrNilMessage, tbbi.ProcessReceivedMessage(nil))
+	assert.Equal(t, process.ErrNilMessage, tbbi.ProcessReceivedMessage(context.Background(), nil))
 }
 
 func TestTxBlockBodyInterceptor_ProcessReceivedMessageNilMessageDataShouldErr(t *testing.T) {
@@ -148,7 +149,7 @@ func TestTxBlockBodyInterceptor_ProcessReceivedMessageNilMessageDataShouldErr(t
 
 	msg := &mock.P2PMessageMock{}
 
-	assert.Equal(t, process.ErrNilDataToProcess, tbbi.ProcessReceivedMessage(msg))
+	assert.Equal(t, process.ErrNilDataToProcess, tbbi.ProcessReceivedMessage(context.Background(), msg))
 }
 
 func TestTxBlockBodyInterceptor_ProcessReceivedMessageMarshalizerErrorsAtUnmarshalingShouldErr(t *testing.T) {
@@ -174,7 +175,7 @@ func TestTxBlockBodyInterceptor_ProcessReceivedMessageMarshalizerErrorsAtUnmarsh
 		DataField: make([]byte, 0),
 	}
 
-	assert.Equal(t, errMarshalizer, tbbi.ProcessReceivedMessage(msg))
+	assert.Equal(t, errMarshalizer, tbbi.ProcessReceivedMessage(context.Background(), msg))
 }
 
 func TestTxBlockBodyInterceptor_ProcessReceivedMessageBlockShouldWork(t *testing.T) {
@@ -219,7 +220,7 @@ func TestTxBlockBodyInterceptor_ProcessReceivedMessageBlockShouldWork(t *testing
 		return false, false
 	}
 
-	assert.Nil(t, tbbi.ProcessReceivedMessage(msg))
+	assert.Nil(t, tbbi.ProcessReceivedMessage(context.Background(), msg))
 	select {
 	case <-chanDone:
 	case <-time.After(durTimeout):
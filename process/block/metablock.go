@@ -61,6 +61,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		nodesCoordinator:              arguments.NodesCoordinator,
 		specialAddressHandler:         arguments.SpecialAddressHandler,
 		uint64Converter:               arguments.Uint64Converter,
+		headersPoolsCleaner:           arguments.HeadersPoolsCleaner,
 		onRequestHeaderHandler:        arguments.RequestHandler.RequestHeader,
 		onRequestHeaderHandlerByNonce: arguments.RequestHandler.RequestHeaderByNonce,
 		appStatusHandler:              statusHandler.NewNilStatusHandler(),
@@ -105,7 +106,12 @@ func (mp *metaProcessor) ProcessBlock(
 		return process.ErrNilHaveTimeHandler
 	}
 
-	err := mp.checkBlockValidity(chainHandler, headerHandler, bodyHandler)
+	err := mp.checkHeaderNotCommitted(chainHandler, headerHandler)
+	if err != nil {
+		return err
+	}
+
+	err = mp.checkBlockValidity(chainHandler, headerHandler, bodyHandler)
 	if err != nil {
 		if err == process.ErrBlockHashDoesNotMatch {
 			log.Info(fmt.Sprintf("requested missing meta header with hash %s for shard %d\n",
@@ -525,8 +531,9 @@ func (mp *metaProcessor) CommitBlock(
 		log.Debug(errNotCritical.Error())
 	}
 
+	highestFinalBlockNonce := mp.forkDetector.GetHighestFinalBlockNonce()
 	log.Info(fmt.Sprintf("meta block with nonce %d is the highest final block in shard %d\n",
-		mp.forkDetector.GetHighestFinalBlockNonce(),
+		highestFinalBlockNonce,
 		mp.shardCoordinator.SelfId()))
 
 	hdrsToAttestPreviousFinal := mp.shardBlockFinality + 1
@@ -560,6 +567,8 @@ func (mp *metaProcessor) CommitBlock(
 		mp.dataPool.ShardHeaders().Len(),
 	)
 
+	go mp.cleanHeadersPools(mp.finalNoncesForHeadersPools(highestFinalBlockNonce))
+
 	mp.blockSizeThrottler.Succeed(header.Round)
 
 	return nil
@@ -36,6 +36,7 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 			Uint64Converter:       &mock.Uint64ByteSliceConverterMock{},
 			StartHeaders:          createGenesisBlocks(shardCoordinator),
 			RequestHandler:        &mock.RequestHandlerMock{},
+			HeadersPoolsCleaner:   &mock.HeadersPoolsCleanerMock{},
 			Core:                  &mock.ServiceContainerMock{},
 		},
 		DataPool: mdp,
@@ -232,6 +233,17 @@ func TestNewMetaProcessor_NilRequestHeaderHandlerShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilHeadersPoolsCleanerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.HeadersPoolsCleaner = nil
+	be, err := blproc.NewMetaProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilHeadersPoolsCleaner, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
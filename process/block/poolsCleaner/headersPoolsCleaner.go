@@ -0,0 +1,139 @@
+package poolsCleaner
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// HeadersPoolsCleaner removes from the shard headers pool, the metablocks pool and the headers nonces pool all the
+// entries whose nonces are below the final nonce of their shard. Such entries can no longer be used and would
+// otherwise accumulate on long running nodes receiving old headers through gossip
+type HeadersPoolsCleaner struct {
+	headers           storage.Cacher
+	metaBlocks        storage.Cacher
+	headersNonces     dataRetriever.Uint64SyncMapCacher
+	numRemovedHeaders uint64
+	canDoClean        chan struct{}
+}
+
+// NewHeadersPoolsCleaner will return a new headers pools cleaner
+func NewHeadersPoolsCleaner(
+	headers storage.Cacher,
+	metaBlocks storage.Cacher,
+	headersNonces dataRetriever.Uint64SyncMapCacher,
+) (*HeadersPoolsCleaner, error) {
+	if headers == nil || headers.IsInterfaceNil() {
+		return nil, process.ErrNilHeadersDataPool
+	}
+	if metaBlocks == nil || metaBlocks.IsInterfaceNil() {
+		return nil, process.ErrNilMetaBlockPool
+	}
+	if headersNonces == nil || headersNonces.IsInterfaceNil() {
+		return nil, process.ErrNilHeadersNoncesDataPool
+	}
+
+	return &HeadersPoolsCleaner{
+		headers:       headers,
+		metaBlocks:    metaBlocks,
+		headersNonces: headersNonces,
+		canDoClean:    make(chan struct{}, 1),
+	}, nil
+}
+
+// Clean removes the entries whose nonces are below the provided final nonces, indexed by shard id. The entries of
+// the shards missing from finalNonces are left untouched. Only one cleaning can run at a time, the method returning
+// false if another cleaning was already in progress
+func (hpc *HeadersPoolsCleaner) Clean(finalNonces map[uint32]uint64, duration time.Duration) (bool, error) {
+	if duration == 0 {
+		return false, process.ErrZeroMaxCleanTime
+	}
+
+	select {
+	case hpc.canDoClean <- struct{}{}:
+		startTime := time.Now()
+		haveTime := func() bool {
+			return time.Now().Sub(startTime) < duration
+		}
+
+		hpc.cleanHeaders(hpc.headers, finalNonces, haveTime)
+		hpc.cleanHeaders(hpc.metaBlocks, finalNonces, haveTime)
+		hpc.cleanHeadersNonces(finalNonces, haveTime)
+		<-hpc.canDoClean
+
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+func (hpc *HeadersPoolsCleaner) cleanHeaders(headers storage.Cacher, finalNonces map[uint32]uint64, haveTime func() bool) {
+	for _, key := range headers.Keys() {
+		if !haveTime() {
+			return
+		}
+
+		obj, ok := headers.Peek(key)
+		if !ok {
+			continue
+		}
+
+		hdr, ok := obj.(data.HeaderHandler)
+		if !ok {
+			headers.Remove(key)
+			atomic.AddUint64(&hpc.numRemovedHeaders, 1)
+			continue
+		}
+
+		finalNonce, ok := finalNonces[hdr.GetShardID()]
+		if !ok || hdr.GetNonce() >= finalNonce {
+			continue
+		}
+
+		headers.Remove(key)
+		atomic.AddUint64(&hpc.numRemovedHeaders, 1)
+	}
+}
+
+func (hpc *HeadersPoolsCleaner) cleanHeadersNonces(finalNonces map[uint32]uint64, haveTime func() bool) {
+	for _, nonce := range hpc.headersNonces.Keys() {
+		if !haveTime() {
+			return
+		}
+
+		syncMap, ok := hpc.headersNonces.Get(nonce)
+		if !ok {
+			continue
+		}
+
+		shardsToRemove := make([]uint32, 0)
+		syncMap.Range(func(shardId uint32, hash []byte) bool {
+			finalNonce, isShardTracked := finalNonces[shardId]
+			if isShardTracked && nonce < finalNonce {
+				shardsToRemove = append(shardsToRemove, shardId)
+			}
+			return true
+		})
+
+		for _, shardId := range shardsToRemove {
+			hpc.headersNonces.Remove(nonce, shardId)
+		}
+	}
+}
+
+// NumRemovedHeaders will return the number of headers removed from the headers and metablocks pools
+func (hpc *HeadersPoolsCleaner) NumRemovedHeaders() uint64 {
+	return atomic.LoadUint64(&hpc.numRemovedHeaders)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hpc *HeadersPoolsCleaner) IsInterfaceNil() bool {
+	if hpc == nil {
+		return true
+	}
+	return false
+}
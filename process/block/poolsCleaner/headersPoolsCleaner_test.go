@@ -0,0 +1,133 @@
+package poolsCleaner_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/poolsCleaner"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/stretchr/testify/assert"
+)
+
+func createHeadersPools() (storage.Cacher, storage.Cacher, dataRetriever.Uint64SyncMapCacher) {
+	headers, _ := lrucache.NewCache(100)
+	metaBlocks, _ := lrucache.NewCache(100)
+	noncesCacher, _ := lrucache.NewCache(100)
+	headersNonces, _ := dataPool.NewNonceSyncMapCacher(noncesCacher, uint64ByteSlice.NewBigEndianConverter())
+
+	return headers, metaBlocks, headersNonces
+}
+
+func addHeaderInPools(
+	headers storage.Cacher,
+	headersNonces dataRetriever.Uint64SyncMapCacher,
+	hash string,
+	hdr data.HeaderHandler,
+	shardId uint32,
+) {
+	headers.Put([]byte(hash), hdr)
+
+	syncMap := &dataPool.ShardIdHashSyncMap{}
+	syncMap.Store(shardId, []byte(hash))
+	headersNonces.Merge(hdr.GetNonce(), syncMap)
+}
+
+func TestNewHeadersPoolsCleaner_NilPoolsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	headers, metaBlocks, headersNonces := createHeadersPools()
+
+	hpc, err := poolsCleaner.NewHeadersPoolsCleaner(nil, metaBlocks, headersNonces)
+	assert.Nil(t, hpc)
+	assert.Equal(t, process.ErrNilHeadersDataPool, err)
+
+	hpc, err = poolsCleaner.NewHeadersPoolsCleaner(headers, nil, headersNonces)
+	assert.Nil(t, hpc)
+	assert.Equal(t, process.ErrNilMetaBlockPool, err)
+
+	hpc, err = poolsCleaner.NewHeadersPoolsCleaner(headers, metaBlocks, nil)
+	assert.Nil(t, hpc)
+	assert.Equal(t, process.ErrNilHeadersNoncesDataPool, err)
+}
+
+func TestNewHeadersPoolsCleaner_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	headers, metaBlocks, headersNonces := createHeadersPools()
+
+	hpc, err := poolsCleaner.NewHeadersPoolsCleaner(headers, metaBlocks, headersNonces)
+
+	assert.Nil(t, err)
+	assert.False(t, hpc.IsInterfaceNil())
+}
+
+func TestHeadersPoolsCleaner_CleanZeroDurationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	headers, metaBlocks, headersNonces := createHeadersPools()
+	hpc, _ := poolsCleaner.NewHeadersPoolsCleaner(headers, metaBlocks, headersNonces)
+
+	cleaned, err := hpc.Clean(make(map[uint32]uint64), 0)
+
+	assert.False(t, cleaned)
+	assert.Equal(t, process.ErrZeroMaxCleanTime, err)
+}
+
+func TestHeadersPoolsCleaner_CleanShouldRemoveOnlyEntriesBelowFinalNonces(t *testing.T) {
+	t.Parallel()
+
+	headers, metaBlocks, headersNonces := createHeadersPools()
+	addHeaderInPools(headers, headersNonces, "shard0_nonce4", &block.Header{Nonce: 4, ShardId: 0}, 0)
+	addHeaderInPools(headers, headersNonces, "shard0_nonce5", &block.Header{Nonce: 5, ShardId: 0}, 0)
+	addHeaderInPools(headers, headersNonces, "shard1_nonce4", &block.Header{Nonce: 4, ShardId: 1}, 1)
+	addHeaderInPools(metaBlocks, headersNonces, "meta_nonce2", &block.MetaBlock{Nonce: 2}, sharding.MetachainShardId)
+	addHeaderInPools(metaBlocks, headersNonces, "meta_nonce3", &block.MetaBlock{Nonce: 3}, sharding.MetachainShardId)
+	headers.Put([]byte("wrong type"), "not a header")
+
+	hpc, _ := poolsCleaner.NewHeadersPoolsCleaner(headers, metaBlocks, headersNonces)
+	finalNonces := map[uint32]uint64{
+		0:                         5,
+		sharding.MetachainShardId: 3,
+	}
+	cleaned, err := hpc.Clean(finalNonces, time.Second)
+
+	assert.Nil(t, err)
+	assert.True(t, cleaned)
+	assert.Equal(t, uint64(3), hpc.NumRemovedHeaders())
+
+	assert.False(t, headers.Has([]byte("shard0_nonce4")))
+	assert.False(t, headers.Has([]byte("wrong type")))
+	assert.True(t, headers.Has([]byte("shard0_nonce5")))
+	assert.True(t, headers.Has([]byte("shard1_nonce4")))
+	assert.False(t, metaBlocks.Has([]byte("meta_nonce2")))
+	assert.True(t, metaBlocks.Has([]byte("meta_nonce3")))
+
+	assert.False(t, headersNonces.Has(4, 0))
+	assert.True(t, headersNonces.Has(5, 0))
+	assert.True(t, headersNonces.Has(4, 1))
+	assert.False(t, headersNonces.Has(2, sharding.MetachainShardId))
+	assert.True(t, headersNonces.Has(3, sharding.MetachainShardId))
+}
+
+func TestHeadersPoolsCleaner_CleanShouldRemoveNoncesWithoutHeadersInPools(t *testing.T) {
+	t.Parallel()
+
+	headers, metaBlocks, headersNonces := createHeadersPools()
+	syncMap := &dataPool.ShardIdHashSyncMap{}
+	syncMap.Store(0, []byte("evicted header"))
+	headersNonces.Merge(1, syncMap)
+
+	hpc, _ := poolsCleaner.NewHeadersPoolsCleaner(headers, metaBlocks, headersNonces)
+	_, _ = hpc.Clean(map[uint32]uint64{0: 2}, time.Second)
+
+	assert.False(t, headersNonces.Has(1, 0))
+	assert.Equal(t, 0, len(headersNonces.Keys()))
+}
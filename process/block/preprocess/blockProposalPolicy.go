@@ -0,0 +1,184 @@
+package preprocess
+
+import (
+	"encoding/hex"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const maxPercentage = 100
+
+type txRounds struct {
+	firstSeen uint64
+	lastSeen  uint64
+}
+
+// blockProposalPolicy filters and orders the transactions a leader may include in a miniblock, following the rules
+// from the configuration. The transactions of a sender are always kept in nonce order and once one of them is dropped,
+// all the following ones are dropped too, as they could not be executed anyway
+type blockProposalPolicy struct {
+	excludedSenders        map[string]struct{}
+	maxTransfersPercentage uint32
+	maxSCCallsPercentage   uint32
+	agedTxRounds           uint64
+
+	mutTxRounds sync.Mutex
+	txRounds    map[string]*txRounds
+}
+
+// NewBlockProposalPolicy creates a new block proposal policy object. A zero value configuration selects all the
+// candidates, in the provided order
+func NewBlockProposalPolicy(cfg config.BlockProposalPolicyConfig) (*blockProposalPolicy, error) {
+	if cfg.MaxTransfersPercentage > maxPercentage || cfg.MaxSCCallsPercentage > maxPercentage {
+		return nil, process.ErrInvalidBlockProposalPercentage
+	}
+
+	excludedSenders := make(map[string]struct{}, len(cfg.ExcludedSenders))
+	for _, hexSender := range cfg.ExcludedSenders {
+		sender, err := hex.DecodeString(hexSender)
+		if err != nil {
+			return nil, err
+		}
+		excludedSenders[string(sender)] = struct{}{}
+	}
+
+	return &blockProposalPolicy{
+		excludedSenders:        excludedSenders,
+		maxTransfersPercentage: cfg.MaxTransfersPercentage,
+		maxSCCallsPercentage:   cfg.MaxSCCallsPercentage,
+		agedTxRounds:           cfg.AgedTxRounds,
+		txRounds:               make(map[string]*txRounds),
+	}, nil
+}
+
+// SelectTransactions returns the indexes of the candidates to be tried for the miniblock, in the order they should
+// be tried
+func (bpp *blockProposalPolicy) SelectTransactions(
+	candidates []*transaction.Transaction,
+	candidatesHashes [][]byte,
+	maxTxs int,
+	round uint64,
+) []int {
+	if len(candidates) != len(candidatesHashes) {
+		return make([]int, 0)
+	}
+
+	maxTransfers := computeMaxTxs(maxTxs, bpp.maxTransfersPercentage)
+	maxSCCalls := computeMaxTxs(maxTxs, bpp.maxSCCallsPercentage)
+	numTransfers := 0
+	numSCCalls := 0
+
+	droppedSenders := make(map[string]struct{})
+	selected := make([]int, 0, len(candidates))
+	for index, tx := range candidates {
+		if tx == nil {
+			continue
+		}
+
+		sender := string(tx.SndAddr)
+		_, isExcluded := bpp.excludedSenders[sender]
+		_, isDropped := droppedSenders[sender]
+		if isExcluded || isDropped {
+			continue
+		}
+
+		if isSmartContractAddress(tx.RcvAddr) {
+			if maxSCCalls > 0 && numSCCalls >= maxSCCalls {
+				droppedSenders[sender] = struct{}{}
+				continue
+			}
+			numSCCalls++
+		} else {
+			if maxTransfers > 0 && numTransfers >= maxTransfers {
+				droppedSenders[sender] = struct{}{}
+				continue
+			}
+			numTransfers++
+		}
+
+		selected = append(selected, index)
+	}
+
+	if bpp.agedTxRounds == 0 {
+		return selected
+	}
+
+	return bpp.prioritizeAgedSenders(candidates, candidatesHashes, selected, round)
+}
+
+// prioritizeAgedSenders moves in front the transactions of the senders whose first selected transaction waits for
+// at least agedTxRounds rounds, keeping the relative order of the transactions otherwise
+func (bpp *blockProposalPolicy) prioritizeAgedSenders(
+	candidates []*transaction.Transaction,
+	candidatesHashes [][]byte,
+	selected []int,
+	round uint64,
+) []int {
+	bpp.mutTxRounds.Lock()
+	defer bpp.mutTxRounds.Unlock()
+
+	agedSenders := make(map[string]bool)
+	for _, index := range selected {
+		txHash := string(candidatesHashes[index])
+		rounds, ok := bpp.txRounds[txHash]
+		if !ok {
+			rounds = &txRounds{firstSeen: round}
+			bpp.txRounds[txHash] = rounds
+		}
+		rounds.lastSeen = round
+
+		sender := string(candidates[index].SndAddr)
+		_, ok = agedSenders[sender]
+		if !ok {
+			agedSenders[sender] = round >= rounds.firstSeen+bpp.agedTxRounds
+		}
+	}
+
+	bpp.removeStaleTxRounds(round)
+
+	prioritized := make([]int, 0, len(selected))
+	others := make([]int, 0, len(selected))
+	for _, index := range selected {
+		if agedSenders[string(candidates[index].SndAddr)] {
+			prioritized = append(prioritized, index)
+			continue
+		}
+		others = append(others, index)
+	}
+
+	return append(prioritized, others...)
+}
+
+// removeStaleTxRounds forgets the transactions not seen for more than agedTxRounds rounds, as they have most likely
+// been included in blocks or removed from the pool
+func (bpp *blockProposalPolicy) removeStaleTxRounds(round uint64) {
+	for txHash, rounds := range bpp.txRounds {
+		if rounds.lastSeen+bpp.agedTxRounds < round {
+			delete(bpp.txRounds, txHash)
+		}
+	}
+}
+
+func computeMaxTxs(maxTxs int, percentage uint32) int {
+	if percentage == 0 || maxTxs <= 0 {
+		return 0
+	}
+
+	max := maxTxs * int(percentage) / maxPercentage
+	if max == 0 {
+		return 1
+	}
+
+	return max
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bpp *blockProposalPolicy) IsInterfaceNil() bool {
+	if bpp == nil {
+		return true
+	}
+	return false
+}
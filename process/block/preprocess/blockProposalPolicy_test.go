@@ -0,0 +1,177 @@
+package preprocess
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+var transferAddress = []byte("receiver address of 32 bytes....")
+var scAddress = make([]byte, 32)
+
+func createCandidates(txs ...*transaction.Transaction) ([]*transaction.Transaction, [][]byte) {
+	hashes := make([][]byte, len(txs))
+	for i, tx := range txs {
+		hashes[i] = []byte(fmt.Sprintf("%s-%d", tx.SndAddr, tx.Nonce))
+	}
+
+	return txs, hashes
+}
+
+func TestNewBlockProposalPolicy_InvalidPercentageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bpp, err := NewBlockProposalPolicy(config.BlockProposalPolicyConfig{MaxTransfersPercentage: 101})
+	assert.Nil(t, bpp)
+	assert.Equal(t, process.ErrInvalidBlockProposalPercentage, err)
+
+	bpp, err = NewBlockProposalPolicy(config.BlockProposalPolicyConfig{MaxSCCallsPercentage: 101})
+	assert.Nil(t, bpp)
+	assert.Equal(t, process.ErrInvalidBlockProposalPercentage, err)
+}
+
+func TestNewBlockProposalPolicy_InvalidExcludedSenderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bpp, err := NewBlockProposalPolicy(config.BlockProposalPolicyConfig{ExcludedSenders: []string{"not hex"}})
+
+	assert.Nil(t, bpp)
+	assert.NotNil(t, err)
+}
+
+func TestNewBlockProposalPolicy_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	bpp, err := NewBlockProposalPolicy(config.BlockProposalPolicyConfig{})
+
+	assert.Nil(t, err)
+	assert.False(t, bpp.IsInterfaceNil())
+}
+
+func TestBlockProposalPolicy_SelectTransactionsEmptyConfigShouldSelectAllInOrder(t *testing.T) {
+	t.Parallel()
+
+	bpp, _ := NewBlockProposalPolicy(config.BlockProposalPolicyConfig{})
+	candidates, hashes := createCandidates(
+		&transaction.Transaction{SndAddr: []byte("a"), Nonce: 0, RcvAddr: transferAddress},
+		&transaction.Transaction{SndAddr: []byte("a"), Nonce: 1, RcvAddr: scAddress},
+		&transaction.Transaction{SndAddr: []byte("b"), Nonce: 0, RcvAddr: transferAddress},
+	)
+
+	selected := bpp.SelectTransactions(candidates, hashes, 10, 1)
+
+	assert.Equal(t, []int{0, 1, 2}, selected)
+}
+
+func TestBlockProposalPolicy_SelectTransactionsMismatchedHashesShouldSelectNothing(t *testing.T) {
+	t.Parallel()
+
+	bpp, _ := NewBlockProposalPolicy(config.BlockProposalPolicyConfig{})
+	candidates, hashes := createCandidates(
+		&transaction.Transaction{SndAddr: []byte("a"), RcvAddr: transferAddress},
+	)
+
+	selected := bpp.SelectTransactions(candidates, hashes[:0], 10, 1)
+
+	assert.Equal(t, 0, len(selected))
+}
+
+func TestBlockProposalPolicy_SelectTransactionsShouldSkipExcludedSenders(t *testing.T) {
+	t.Parallel()
+
+	bpp, _ := NewBlockProposalPolicy(config.BlockProposalPolicyConfig{
+		ExcludedSenders: []string{hex.EncodeToString([]byte("b"))},
+	})
+	candidates, hashes := createCandidates(
+		&transaction.Transaction{SndAddr: []byte("a"), Nonce: 0, RcvAddr: transferAddress},
+		&transaction.Transaction{SndAddr: []byte("b"), Nonce: 0, RcvAddr: transferAddress},
+		&transaction.Transaction{SndAddr: []byte("b"), Nonce: 1, RcvAddr: transferAddress},
+		&transaction.Transaction{SndAddr: []byte("c"), Nonce: 0, RcvAddr: transferAddress},
+	)
+
+	selected := bpp.SelectTransactions(candidates, hashes, 10, 1)
+
+	assert.Equal(t, []int{0, 3}, selected)
+}
+
+func TestBlockProposalPolicy_SelectTransactionsShouldCapTransfersAndDropTheFollowingNonces(t *testing.T) {
+	t.Parallel()
+
+	bpp, _ := NewBlockProposalPolicy(config.BlockProposalPolicyConfig{MaxTransfersPercentage: 20})
+	candidates, hashes := createCandidates(
+		&transaction.Transaction{SndAddr: []byte("a"), Nonce: 0, RcvAddr: transferAddress},
+		&transaction.Transaction{SndAddr: []byte("a"), Nonce: 1, RcvAddr: transferAddress},
+		&transaction.Transaction{SndAddr: []byte("a"), Nonce: 2, RcvAddr: scAddress},
+		&transaction.Transaction{SndAddr: []byte("b"), Nonce: 0, RcvAddr: scAddress},
+		&transaction.Transaction{SndAddr: []byte("c"), Nonce: 0, RcvAddr: transferAddress},
+	)
+
+	selected := bpp.SelectTransactions(candidates, hashes, 5, 1)
+
+	assert.Equal(t, []int{0, 3}, selected)
+}
+
+func TestBlockProposalPolicy_SelectTransactionsShouldCapSCCalls(t *testing.T) {
+	t.Parallel()
+
+	bpp, _ := NewBlockProposalPolicy(config.BlockProposalPolicyConfig{MaxSCCallsPercentage: 50})
+	candidates, hashes := createCandidates(
+		&transaction.Transaction{SndAddr: []byte("a"), Nonce: 0, RcvAddr: scAddress},
+		&transaction.Transaction{SndAddr: []byte("b"), Nonce: 0, RcvAddr: scAddress},
+		&transaction.Transaction{SndAddr: []byte("c"), Nonce: 0, RcvAddr: scAddress},
+		&transaction.Transaction{SndAddr: []byte("d"), Nonce: 0, RcvAddr: transferAddress},
+	)
+
+	selected := bpp.SelectTransactions(candidates, hashes, 4, 1)
+
+	assert.Equal(t, []int{0, 1, 3}, selected)
+}
+
+func TestBlockProposalPolicy_SelectTransactionsShouldPrioritizeAgedSenders(t *testing.T) {
+	t.Parallel()
+
+	bpp, _ := NewBlockProposalPolicy(config.BlockProposalPolicyConfig{AgedTxRounds: 2})
+	oldTx := &transaction.Transaction{SndAddr: []byte("b"), Nonce: 0, RcvAddr: transferAddress}
+	candidates, hashes := createCandidates(oldTx)
+	selected := bpp.SelectTransactions(candidates, hashes, 10, 1)
+	assert.Equal(t, []int{0}, selected)
+
+	candidates, hashes = createCandidates(
+		&transaction.Transaction{SndAddr: []byte("a"), Nonce: 0, RcvAddr: transferAddress},
+		oldTx,
+		&transaction.Transaction{SndAddr: []byte("b"), Nonce: 1, RcvAddr: transferAddress},
+		&transaction.Transaction{SndAddr: []byte("c"), Nonce: 0, RcvAddr: transferAddress},
+	)
+
+	selected = bpp.SelectTransactions(candidates, hashes, 10, 2)
+	assert.Equal(t, []int{0, 1, 2, 3}, selected)
+
+	selected = bpp.SelectTransactions(candidates, hashes, 10, 3)
+	assert.Equal(t, []int{1, 2, 0, 3}, selected)
+}
+
+func TestBlockProposalPolicy_SelectTransactionsShouldForgetStaleTxs(t *testing.T) {
+	t.Parallel()
+
+	bpp, _ := NewBlockProposalPolicy(config.BlockProposalPolicyConfig{AgedTxRounds: 2})
+	candidates, hashes := createCandidates(
+		&transaction.Transaction{SndAddr: []byte("a"), Nonce: 0, RcvAddr: transferAddress},
+	)
+	_ = bpp.SelectTransactions(candidates, hashes, 10, 1)
+	assert.Equal(t, 1, len(bpp.txRounds))
+
+	otherCandidates, otherHashes := createCandidates(
+		&transaction.Transaction{SndAddr: []byte("b"), Nonce: 0, RcvAddr: transferAddress},
+	)
+	_ = bpp.SelectTransactions(otherCandidates, otherHashes, 10, 4)
+	assert.Equal(t, 1, len(bpp.txRounds))
+
+	selected := bpp.SelectTransactions(candidates, hashes, 10, 4)
+	assert.Equal(t, []int{0}, selected)
+	assert.Equal(t, uint64(4), bpp.txRounds[string(hashes[0])].firstSeen)
+}
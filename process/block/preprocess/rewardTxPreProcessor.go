@@ -193,6 +193,11 @@ func (rtp *rewardTxPreprocessor) ProcessBlockTransactions(body block.Body, round
 			continue
 		}
 
+		err := rtp.checkRewardTxsOrderInMiniBlock(miniBlock)
+		if err != nil {
+			return err
+		}
+
 		for j := 0; j < len(miniBlock.TxHashes); j++ {
 			if !haveTime() {
 				return process.ErrTimeIsOut
@@ -226,6 +231,26 @@ func (rtp *rewardTxPreprocessor) ProcessBlockTransactions(body block.Body, round
 	return nil
 }
 
+// checkRewardTxsOrderInMiniBlock verifies that the reward transactions of a block miniblock are ordered by receiver
+// address and hash
+func (rtp *rewardTxPreprocessor) checkRewardTxsOrderInMiniBlock(miniBlock *block.MiniBlock) error {
+	rewardTxs := make([]data.TransactionHandler, 0, len(miniBlock.TxHashes))
+
+	rtp.rewardTxsForBlock.mutTxsForBlock.RLock()
+	for _, txHash := range miniBlock.TxHashes {
+		txData := rtp.rewardTxsForBlock.txHashAndInfo[string(txHash)]
+		if txData == nil || txData.tx == nil {
+			rtp.rewardTxsForBlock.mutTxsForBlock.RUnlock()
+			return process.ErrMissingTransaction
+		}
+
+		rewardTxs = append(rewardTxs, txData.tx)
+	}
+	rtp.rewardTxsForBlock.mutTxsForBlock.RUnlock()
+
+	return process.CheckRewardTxsOrder(rewardTxs, miniBlock.TxHashes)
+}
+
 // AddComputedRewardMiniBlocks adds to the local cache the reward transactions from the given miniblocks
 func (rtp *rewardTxPreprocessor) AddComputedRewardMiniBlocks(computedRewardMiniblocks block.MiniBlockSlice) {
 	for _, rewardMb := range computedRewardMiniblocks {
@@ -493,6 +518,15 @@ func (rtp *rewardTxPreprocessor) ProcessMiniBlock(miniBlock *block.MiniBlock, ha
 		return err
 	}
 
+	rewardTxs := make([]data.TransactionHandler, 0, len(miniBlockRewardTxs))
+	for _, rTx := range miniBlockRewardTxs {
+		rewardTxs = append(rewardTxs, rTx)
+	}
+	err = process.CheckRewardTxsOrder(rewardTxs, miniBlockTxHashes)
+	if err != nil {
+		return err
+	}
+
 	for index := range miniBlockRewardTxs {
 		if !haveTime() {
 			return process.ErrTimeIsOut
@@ -1,10 +1,12 @@
 package preprocess
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -348,6 +350,59 @@ func TestRewardTxPreprocessor_ProcessMiniBlockShouldWork(t *testing.T) {
 	}
 }
 
+func TestRewardTxPreprocessor_ProcessMiniBlockUnorderedRewardTxsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rewardTxs := map[string]*rewardTx.RewardTx{
+		"tx1_hash": {Value: big.NewInt(100), RcvAddr: []byte("b")},
+		"tx2_hash": {Value: big.NewInt(100), RcvAddr: []byte("a")},
+	}
+	rewardTxPool := &mock.ShardedDataStub{
+		RegisterHandlerCalled: func(i func(key []byte)) {},
+		ShardDataStoreCalled: func(id string) (c storage.Cacher) {
+			return &mock.CacherStub{
+				PeekCalled: func(key []byte) (value interface{}, ok bool) {
+					rTx, ok := rewardTxs[string(key)]
+					return rTx, ok
+				},
+			}
+		},
+	}
+	processedRewardTxs := 0
+	rtp, _ := NewRewardTxPreprocessor(
+		rewardTxPool,
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.RewardTxProcessorMock{
+			ProcessRewardTransactionCalled: func(rTx *rewardTx.RewardTx) error {
+				processedRewardTxs++
+				return nil
+			},
+		},
+		&mock.IntermediateTransactionHandlerMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		func(shardID uint32, txHashes [][]byte) {},
+	)
+
+	mb := block.MiniBlock{
+		TxHashes:        [][]byte{[]byte("tx1_hash"), []byte("tx2_hash")},
+		ReceiverShardID: 1,
+		SenderShardID:   0,
+		Type:            block.RewardsBlock,
+	}
+
+	err := rtp.ProcessMiniBlock(&mb, haveTimeTrue, 0)
+	assert.Equal(t, process.ErrRewardTxsNotOrdered, err)
+	assert.Equal(t, 0, processedRewardTxs)
+
+	mb.TxHashes = [][]byte{[]byte("tx2_hash"), []byte("tx1_hash")}
+	err = rtp.ProcessMiniBlock(&mb, haveTimeTrue, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, processedRewardTxs)
+}
+
 func TestRewardTxPreprocessor_SaveTxBlockToStorageShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -29,6 +29,9 @@ type rewardsHandler struct {
 	protocolRewards     []data.TransactionHandler
 	protocolRewardsMeta []data.TransactionHandler
 	feeRewards          []data.TransactionHandler
+	leaderFees          *big.Int
+	communityFees       *big.Int
+	burnedFees          *big.Int
 
 	mut               sync.Mutex
 	accumulatedFees   *big.Int
@@ -89,6 +92,9 @@ func NewRewardTxHandler(
 
 	rtxh.accumulatedFees = big.NewInt(0)
 	rtxh.rewardTxsForBlock = make(map[string]*rewardTx.RewardTx)
+	rtxh.leaderFees = big.NewInt(0)
+	rtxh.communityFees = big.NewInt(0)
+	rtxh.burnedFees = big.NewInt(0)
 
 	return rtxh, nil
 }
@@ -182,19 +188,35 @@ func (rtxh *rewardsHandler) addTransactionsToPool(rewardTxs []data.TransactionHa
 	}
 }
 
+// miniblocksFromRewardTxs groups the reward transactions in miniblocks by destination shard. Inside each miniblock
+// the reward transactions are ordered by receiver address and hash, as required by the processing nodes
 func (rtxh *rewardsHandler) miniblocksFromRewardTxs(
 	rewardTxs []data.TransactionHandler,
 ) map[uint32]*block.MiniBlock {
 	miniBlocks := make(map[uint32]*block.MiniBlock, 0)
 
+	hashedRewardTxs := make([]data.TransactionHandler, 0, len(rewardTxs))
+	txHashes := make([][]byte, 0, len(rewardTxs))
 	for _, rTx := range rewardTxs {
-		dstShId, err := rtxh.address.ShardIdForAddress(rTx.GetRecvAddress())
+		txHash, err := core.CalculateHash(rtxh.marshalizer, rtxh.hasher, rTx)
 		if err != nil {
 			log.Debug(err.Error())
 			continue
 		}
 
-		txHash, err := core.CalculateHash(rtxh.marshalizer, rtxh.hasher, rTx)
+		hashedRewardTxs = append(hashedRewardTxs, rTx)
+		txHashes = append(txHashes, txHash)
+	}
+
+	err := process.SortRewardTxsByReceiver(hashedRewardTxs, txHashes)
+	if err != nil {
+		log.Debug(err.Error())
+		return miniBlocks
+	}
+
+	for i, rTx := range hashedRewardTxs {
+		txHash := txHashes[i]
+		dstShId, err := rtxh.address.ShardIdForAddress(rTx.GetRecvAddress())
 		if err != nil {
 			log.Debug(err.Error())
 			continue
@@ -262,6 +284,53 @@ func (rtxh *rewardsHandler) ProcessTransactionFee(cost *big.Int) {
 	rtxh.mut.Unlock()
 }
 
+// AccumulatedFees returns the fees accumulated from the transactions processed in the current block
+func (rtxh *rewardsHandler) AccumulatedFees() *big.Int {
+	rtxh.mut.Lock()
+	defer rtxh.mut.Unlock()
+
+	return big.NewInt(0).Set(rtxh.accumulatedFees)
+}
+
+// TotalRewards returns the sum of all reward transactions generated for the current block
+func (rtxh *rewardsHandler) TotalRewards() *big.Int {
+	rtxh.mutGenRewardTxs.RLock()
+	defer rtxh.mutGenRewardTxs.RUnlock()
+
+	totalRewards := big.NewInt(0)
+	for _, rewardTxs := range [][]data.TransactionHandler{rtxh.protocolRewards, rtxh.protocolRewardsMeta, rtxh.feeRewards} {
+		for _, rTx := range rewardTxs {
+			totalRewards.Add(totalRewards, rTx.GetValue())
+		}
+	}
+
+	return totalRewards
+}
+
+// LeaderFees returns the part of the accumulated fees of the current block going to the leader
+func (rtxh *rewardsHandler) LeaderFees() *big.Int {
+	rtxh.mutGenRewardTxs.RLock()
+	defer rtxh.mutGenRewardTxs.RUnlock()
+
+	return big.NewInt(0).Set(rtxh.leaderFees)
+}
+
+// CommunityFees returns the part of the accumulated fees of the current block going to the community fund
+func (rtxh *rewardsHandler) CommunityFees() *big.Int {
+	rtxh.mutGenRewardTxs.RLock()
+	defer rtxh.mutGenRewardTxs.RUnlock()
+
+	return big.NewInt(0).Set(rtxh.communityFees)
+}
+
+// BurnedFees returns the part of the accumulated fees of the current block sent to the burn address
+func (rtxh *rewardsHandler) BurnedFees() *big.Int {
+	rtxh.mutGenRewardTxs.RLock()
+	defer rtxh.mutGenRewardTxs.RUnlock()
+
+	return big.NewInt(0).Set(rtxh.burnedFees)
+}
+
 // cleanCachedData deletes the cached data
 func (rtxh *rewardsHandler) cleanCachedData() {
 	rtxh.mut.Lock()
@@ -273,6 +342,9 @@ func (rtxh *rewardsHandler) cleanCachedData() {
 	rtxh.feeRewards = make([]data.TransactionHandler, 0)
 	rtxh.protocolRewards = make([]data.TransactionHandler, 0)
 	rtxh.protocolRewardsMeta = make([]data.TransactionHandler, 0)
+	rtxh.leaderFees = big.NewInt(0)
+	rtxh.communityFees = big.NewInt(0)
+	rtxh.burnedFees = big.NewInt(0)
 	rtxh.mutGenRewardTxs.Unlock()
 }
 
@@ -327,10 +399,15 @@ func (rtxh *rewardsHandler) createCommunityTx() *rewardTx.RewardTx {
 // createRewardFromFees creates the reward transactions from accumulated fees
 // According to economic paper, out of the block fees 40% are burned, 50% go to the
 // leader and 10% go to Elrond community fund.
+// It also records the value of each share, so it must be called with mutGenRewardTxs locked
 func (rtxh *rewardsHandler) createRewardFromFees() []data.TransactionHandler {
 	rtxh.mut.Lock()
 	defer rtxh.mut.Unlock()
 
+	rtxh.leaderFees = big.NewInt(0)
+	rtxh.communityFees = big.NewInt(0)
+	rtxh.burnedFees = big.NewInt(0)
+
 	if rtxh.accumulatedFees.Cmp(big.NewInt(1)) < 0 {
 		rtxh.accumulatedFees = big.NewInt(0)
 		return nil
@@ -340,6 +417,10 @@ func (rtxh *rewardsHandler) createRewardFromFees() []data.TransactionHandler {
 	communityTx := rtxh.createCommunityTx()
 	burnTx := rtxh.createBurnTx()
 
+	rtxh.leaderFees = big.NewInt(0).Set(leaderTx.Value)
+	rtxh.communityFees = big.NewInt(0).Set(communityTx.Value)
+	rtxh.burnedFees = big.NewInt(0).Set(burnTx.Value)
+
 	currFeeTxs := make([]data.TransactionHandler, 0)
 	currFeeTxs = append(currFeeTxs, leaderTx, communityTx, burnTx)
 
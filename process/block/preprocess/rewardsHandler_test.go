@@ -433,6 +433,123 @@ func TestRewardsHandler_CreateAllInterMiniBlocksOK(t *testing.T) {
 	assert.Equal(t, 1, len(mbs))
 }
 
+func TestRewardsHandler_CreateAllInterMiniBlocksShouldOrderRewardTxsByReceiver(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(1)
+	nodesCoordinator := mock.NewNodesCoordinatorMock()
+	addedRewardTxs := make(map[string]data.TransactionHandler)
+	th, _ := NewRewardTxHandler(
+		mock.NewSpecialAddressHandlerMock(
+			&mock.AddressConverterMock{},
+			shardCoordinator,
+			nodesCoordinator,
+		),
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		shardCoordinator,
+		&mock.AddressConverterMock{},
+		&mock.ChainStorerMock{},
+		&mock.ShardedDataStub{
+			AddDataCalled: func(key []byte, data interface{}, cacheId string) {
+				addedRewardTxs[string(key)] = data.(*rewardTx.RewardTx)
+			},
+		},
+		RewandsHandlerMock(),
+	)
+
+	th.ProcessTransactionFee(big.NewInt(50))
+	mbs := th.CreateAllInterMiniBlocks()
+	assert.Equal(t, 1, len(mbs))
+
+	for _, mb := range mbs {
+		assert.Equal(t, 3, len(mb.TxHashes))
+
+		rewardTxs := make([]data.TransactionHandler, 0)
+		for _, txHash := range mb.TxHashes {
+			rewardTxs = append(rewardTxs, addedRewardTxs[string(txHash)])
+		}
+
+		assert.Nil(t, process.CheckRewardTxsOrder(rewardTxs, mb.TxHashes))
+	}
+}
+
+func TestRewardsHandler_AccumulatedFeesAndTotalRewards(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(1)
+	nodesCoordinator := mock.NewNodesCoordinatorMock()
+	tdp := initDataPool()
+	th, _ := NewRewardTxHandler(
+		mock.NewSpecialAddressHandlerMock(
+			&mock.AddressConverterMock{},
+			shardCoordinator,
+			nodesCoordinator,
+		),
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		shardCoordinator,
+		&mock.AddressConverterMock{},
+		&mock.ChainStorerMock{},
+		tdp.RewardTransactions(),
+		RewandsHandlerMock(),
+	)
+
+	assert.Equal(t, big.NewInt(0), th.AccumulatedFees())
+	assert.Equal(t, big.NewInt(0), th.TotalRewards())
+
+	th.ProcessTransactionFee(big.NewInt(50))
+	_ = th.CreateAllInterMiniBlocks()
+
+	assert.Equal(t, big.NewInt(50), th.AccumulatedFees())
+	// leader, community and burn parts of the fees
+	assert.Equal(t, big.NewInt(50), th.TotalRewards())
+
+	th.CreateBlockStarted()
+
+	assert.Equal(t, big.NewInt(0), th.AccumulatedFees())
+	assert.Equal(t, big.NewInt(0), th.TotalRewards())
+}
+
+func TestRewardsHandler_FeesShares(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(1)
+	nodesCoordinator := mock.NewNodesCoordinatorMock()
+	tdp := initDataPool()
+	th, _ := NewRewardTxHandler(
+		mock.NewSpecialAddressHandlerMock(
+			&mock.AddressConverterMock{},
+			shardCoordinator,
+			nodesCoordinator,
+		),
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		shardCoordinator,
+		&mock.AddressConverterMock{},
+		&mock.ChainStorerMock{},
+		tdp.RewardTransactions(),
+		RewandsHandlerMock(),
+	)
+
+	assert.Equal(t, big.NewInt(0), th.LeaderFees())
+	assert.Equal(t, big.NewInt(0), th.CommunityFees())
+	assert.Equal(t, big.NewInt(0), th.BurnedFees())
+
+	th.ProcessTransactionFee(big.NewInt(100))
+	_ = th.CreateAllInterMiniBlocks()
+
+	assert.Equal(t, big.NewInt(50), th.LeaderFees())
+	assert.Equal(t, big.NewInt(10), th.CommunityFees())
+	assert.Equal(t, big.NewInt(40), th.BurnedFees())
+
+	th.CreateBlockStarted()
+
+	assert.Equal(t, big.NewInt(0), th.LeaderFees())
+	assert.Equal(t, big.NewInt(0), th.CommunityFees())
+	assert.Equal(t, big.NewInt(0), th.BurnedFees())
+}
+
 func TestRewardsHandler_GetAllCurrentFinishedTxs(t *testing.T) {
 	t.Parallel()
 
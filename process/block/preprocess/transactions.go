@@ -39,6 +39,7 @@ type transactions struct {
 	orderedTxHashes      map[string][][]byte
 	mutOrderedTxs        sync.RWMutex
 	economicsFee         process.FeeHandler
+	proposalPolicy       process.BlockProposalPolicy
 }
 
 // NewTransactionPreprocessor creates a new transaction preprocessor object
@@ -52,6 +53,7 @@ func NewTransactionPreprocessor(
 	accounts state.AccountsAdapter,
 	onRequestTransaction func(shardID uint32, txHashes [][]byte),
 	economicsFee process.FeeHandler,
+	proposalPolicy process.BlockProposalPolicy,
 ) (*transactions, error) {
 
 	if hasher == nil || hasher.IsInterfaceNil() {
@@ -78,6 +80,9 @@ func NewTransactionPreprocessor(
 	if onRequestTransaction == nil {
 		return nil, process.ErrNilRequestHandler
 	}
+	if proposalPolicy == nil || proposalPolicy.IsInterfaceNil() {
+		return nil, process.ErrNilBlockProposalPolicy
+	}
 
 	bpp := basePreProcess{
 		hasher:           hasher,
@@ -93,6 +98,7 @@ func NewTransactionPreprocessor(
 		txProcessor:          txProcessor,
 		accounts:             accounts,
 		economicsFee:         economicsFee,
+		proposalPolicy:       proposalPolicy,
 	}
 
 	txs.chRcvAllTxs = make(chan bool)
@@ -329,7 +335,9 @@ func (txs *transactions) processAndRemoveBadTransaction(
 
 	err := txs.txProcessor.ProcessTransaction(transaction, round)
 	if err == process.ErrLowerNonceInTransaction ||
-		err == process.ErrInsufficientFunds {
+		err == process.ErrInsufficientFunds ||
+		err == process.ErrTxExpired ||
+		err == process.ErrTxValidityWindowNotEnabled {
 		strCache := process.ShardCacherIdentifier(sndShardId, dstShardId)
 		txs.txPool.RemoveData(transactionHash, strCache)
 	}
@@ -508,16 +516,35 @@ func (txs *transactions) CreateAndProcessMiniBlock(
 	miniBlock.TxHashes = make([][]byte, 0)
 	miniBlock.Type = block.TxBlock
 
+	candidates := make([]*transaction.Transaction, 0, len(orderedTxs))
+	candidatesHashes := make([][]byte, 0, len(orderedTxHashes))
+	for index := range orderedTxs {
+		if txs.isTxAlreadyProcessed(orderedTxHashes[index], &txs.txsForCurrBlock) {
+			continue
+		}
+
+		candidates = append(candidates, orderedTxs[index])
+		candidatesHashes = append(candidatesHashes, orderedTxHashes[index])
+	}
+	orderedTxs = candidates
+	orderedTxHashes = candidatesHashes
+
+	// the policy only filters and orders the candidates, each selected transaction is processed as before
+	selectedIndexes := txs.proposalPolicy.SelectTransactions(orderedTxs, orderedTxHashes, spaceRemained, round)
+	triedIndexes := make(map[int]struct{}, len(selectedIndexes))
+
 	addedTxs := 0
 	addedGasLimitPerCrossShardMiniblock := uint64(0)
-	for index := range orderedTxs {
+	for _, index := range selectedIndexes {
 		if !haveTime() {
 			break
 		}
 
-		if txs.isTxAlreadyProcessed(orderedTxHashes[index], &txs.txsForCurrBlock) {
+		_, alreadyTried := triedIndexes[index]
+		if index < 0 || index >= len(orderedTxs) || alreadyTried {
 			continue
 		}
+		triedIndexes[index] = struct{}{}
 
 		currTxGasLimit := txs.economicsFee.ComputeGasLimit(orderedTxs[index])
 		if isSmartContractAddress(orderedTxs[index].RcvAddr) {
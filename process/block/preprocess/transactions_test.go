@@ -196,6 +196,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilPool(t *testing.T) {
 		&mock.AccountsStub{},
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -217,6 +218,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilStore(t *testing.T) {
 		&mock.AccountsStub{},
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -238,6 +240,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilHasher(t *testing.T) {
 		&mock.AccountsStub{},
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -259,6 +262,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilMarsalizer(t *testing.T) {
 		&mock.AccountsStub{},
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -280,6 +284,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilTxProce(t *testing.T) {
 		&mock.AccountsStub{},
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -301,6 +306,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilShardCoord(t *testing.T) {
 		&mock.AccountsStub{},
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -322,6 +328,7 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilAccounts(t *testing.T) {
 		nil,
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 
 	assert.Nil(t, txs)
@@ -342,12 +349,35 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilRequestFunc(t *testing.T)
 		&mock.AccountsStub{},
 		nil,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 
 	assert.Nil(t, txs)
 	assert.Equal(t, process.ErrNilRequestHandler, err)
 }
 
+func TestTxsPreprocessor_NewTransactionPreprocessorNilProposalPolicy(t *testing.T) {
+	t.Parallel()
+
+	tdp := initDataPool()
+	requestTransaction := func(shardID uint32, txHashes [][]byte) {}
+	txs, err := NewTransactionPreprocessor(
+		tdp.Transactions(),
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.TxProcessorMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		requestTransaction,
+		feeHandlerMock(),
+		nil,
+	)
+
+	assert.Nil(t, txs)
+	assert.Equal(t, process.ErrNilBlockProposalPolicy, err)
+}
+
 func TestTxsPreProcessor_GetTransactionFromPool(t *testing.T) {
 	t.Parallel()
 	tdp := initDataPool()
@@ -362,6 +392,7 @@ func TestTxsPreProcessor_GetTransactionFromPool(t *testing.T) {
 		&mock.AccountsStub{},
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 	txHash := []byte("tx1_hash")
 	tx, _ := process.GetTransactionHandlerFromPool(1, 1, txHash, tdp.Transactions())
@@ -384,6 +415,7 @@ func TestTransactionPreprocessor_RequestTransactionFromNetwork(t *testing.T) {
 		&mock.AccountsStub{},
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 	shardId := uint32(1)
 	txHash1 := []byte("tx_hash1")
@@ -412,6 +444,7 @@ func TestTransactionPreprocessor_RequestBlockTransactionFromMiniBlockFromNetwork
 		&mock.AccountsStub{},
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 
 	shardId := uint32(1)
@@ -455,6 +488,7 @@ func TestTransactionPreprocessor_ReceivedTransactionShouldEraseRequested(t *test
 		&mock.AccountsStub{},
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 
 	//add 3 tx hashes on requested list
@@ -529,6 +563,7 @@ func TestTransactionPreprocessor_GetAllTxsFromMiniBlockShouldWork(t *testing.T)
 		&mock.AccountsStub{},
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 
 	mb := &block.MiniBlock{
@@ -564,6 +599,7 @@ func TestTransactionPreprocessor_RemoveBlockTxsFromPoolNilBlockShouldErr(t *test
 		&mock.AccountsStub{},
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 	err := txs.RemoveTxBlockFromPools(nil, tdp.MiniBlocks())
 	assert.NotNil(t, err)
@@ -584,6 +620,7 @@ func TestTransactionPreprocessor_RemoveBlockTxsFromPoolOK(t *testing.T) {
 		&mock.AccountsStub{},
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 	body := make(block.Body, 0)
 	txHash := []byte("txHash")
@@ -619,6 +656,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddAll(t *testi
 		&mock.AccountsStub{},
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 	assert.NotNil(t, txs)
 
@@ -662,6 +700,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddAllAsNoSCCal
 		&mock.AccountsStub{},
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 	assert.NotNil(t, txs)
 
@@ -707,6 +746,7 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddOnly5asSCCal
 		&mock.AccountsStub{},
 		requestTransaction,
 		feeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 	assert.NotNil(t, txs)
 
@@ -917,3 +957,51 @@ func BenchmarkSortTxByNonce1(b *testing.B) {
 		_, _, _ = SortTxByNonce(cache)
 	}
 }
+
+func TestTransactions_CreateAndProcessMiniBlockShouldProcessOnlyTheSelectedTxs(t *testing.T) {
+	t.Parallel()
+
+	txPool, _ := shardedData.NewShardedData(storageUnit.CacheConfig{Size: 100000, Type: storageUnit.LRUCache})
+	requestTransaction := func(shardID uint32, txHashes [][]byte) {}
+	hasher := &mock.HasherMock{}
+	marshalizer := &mock.MarshalizerMock{}
+
+	processedTxs := make([]*transaction.Transaction, 0)
+	var selectedHashes [][]byte
+	txs, _ := NewTransactionPreprocessor(
+		txPool,
+		&mock.ChainStorerMock{},
+		hasher,
+		marshalizer,
+		&mock.TxProcessorMock{ProcessTransactionCalled: func(transaction *transaction.Transaction, round uint64) error {
+			processedTxs = append(processedTxs, transaction)
+			return nil
+		}},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		requestTransaction,
+		feeHandlerMock(),
+		&mock.BlockProposalPolicyStub{
+			SelectTransactionsCalled: func(candidates []*transaction.Transaction, candidatesHashes [][]byte, maxTxs int, round uint64) []int {
+				selectedHashes = [][]byte{candidatesHashes[2], candidatesHashes[0]}
+				return []int{2, 0, 2, len(candidates), -1}
+			},
+		},
+	)
+	assert.NotNil(t, txs)
+
+	sndShardId := uint32(0)
+	dstShardId := uint32(1)
+	strCache := process.ShardCacherIdentifier(sndShardId, dstShardId)
+	for i := 0; i < 5; i++ {
+		newTx := &transaction.Transaction{Nonce: uint64(i), SndAddr: []byte("sender")}
+		txHash, _ := core.CalculateHash(marshalizer, hasher, newTx)
+		txPool.AddData(txHash, newTx, strCache)
+	}
+
+	mb, err := txs.CreateAndProcessMiniBlock(sndShardId, dstShardId, process.MaxItemsInBlock, haveTimeTrue, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, selectedHashes, mb.TxHashes)
+	assert.Equal(t, 2, len(processedTxs))
+}
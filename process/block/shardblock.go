@@ -2,6 +2,7 @@ package block
 
 import (
 	"fmt"
+	"math/big"
 	"sort"
 	"sync"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/serviceContainer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -32,6 +34,7 @@ type shardProcessor struct {
 	txCoordinator          process.TransactionCoordinator
 	txCounter              *transactionCounter
 	txsPoolsCleaner        process.PoolsCleaner
+	blockEconomics         process.BlockEconomicsHandler
 }
 
 // NewShardProcessor creates a new shardProcessor object
@@ -64,6 +67,7 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		nodesCoordinator:              arguments.NodesCoordinator,
 		specialAddressHandler:         arguments.SpecialAddressHandler,
 		uint64Converter:               arguments.Uint64Converter,
+		headersPoolsCleaner:           arguments.HeadersPoolsCleaner,
 		onRequestHeaderHandlerByNonce: arguments.RequestHandler.RequestHeaderByNonce,
 		appStatusHandler:              statusHandler.NewNilStatusHandler(),
 	}
@@ -75,6 +79,9 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 	if arguments.TxsPoolsCleaner == nil || arguments.TxsPoolsCleaner.IsInterfaceNil() {
 		return nil, process.ErrNilTxsPoolsCleaner
 	}
+	if arguments.BlockEconomics == nil || arguments.BlockEconomics.IsInterfaceNil() {
+		return nil, process.ErrNilBlockEconomicsHandler
+	}
 
 	sp := shardProcessor{
 		core:            arguments.Core,
@@ -83,6 +90,7 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		txCoordinator:   arguments.TxCoordinator,
 		txCounter:       NewTransactionCounter(),
 		txsPoolsCleaner: arguments.TxsPoolsCleaner,
+		blockEconomics:  arguments.BlockEconomics,
 	}
 	sp.chRcvAllMetaHdrs = make(chan bool)
 
@@ -119,7 +127,12 @@ func (sp *shardProcessor) ProcessBlock(
 		return process.ErrNilHaveTimeHandler
 	}
 
-	err := sp.checkBlockValidity(chainHandler, headerHandler, bodyHandler)
+	err := sp.checkHeaderNotCommitted(chainHandler, headerHandler)
+	if err != nil {
+		return err
+	}
+
+	err = sp.checkBlockValidity(chainHandler, headerHandler, bodyHandler)
 	if err != nil {
 		if err == process.ErrBlockHashDoesNotMatch {
 			log.Info(fmt.Sprintf("requested missing shard header with hash %s for shard %d\n",
@@ -255,9 +268,48 @@ func (sp *shardProcessor) ProcessBlock(
 		return err
 	}
 
+	err = sp.verifyEconomicsFields(header)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// verifyEconomicsFields checks that the accumulated fees, their leader, community and burned shares and the rewards
+// proposed in the header match the values computed locally while executing the block, so that a block with wrong
+// economics is never signed
+func (sp *shardProcessor) verifyEconomicsFields(header *block.Header) error {
+	if !isBigIntEqual(header.AccumulatedFees, sp.blockEconomics.AccumulatedFees()) {
+		return process.ErrAccumulatedFeesDoNotMatch
+	}
+	if !isBigIntEqual(header.Rewards, sp.blockEconomics.TotalRewards()) {
+		return process.ErrRewardsDoNotMatch
+	}
+	if !isBigIntEqual(header.LeaderFees, sp.blockEconomics.LeaderFees()) {
+		return process.ErrLeaderFeesDoNotMatch
+	}
+	if !isBigIntEqual(header.CommunityFees, sp.blockEconomics.CommunityFees()) {
+		return process.ErrCommunityFeesDoNotMatch
+	}
+	if !isBigIntEqual(header.BurnedFees, sp.blockEconomics.BurnedFees()) {
+		return process.ErrBurnedFeesDoNotMatch
+	}
+
 	return nil
 }
 
+func isBigIntEqual(proposed *big.Int, computed *big.Int) bool {
+	if proposed == nil {
+		proposed = big.NewInt(0)
+	}
+	if computed == nil {
+		computed = big.NewInt(0)
+	}
+
+	return proposed.Cmp(computed) == 0
+}
+
 func (sp *shardProcessor) setMetaConsensusData(finalizedMetaBlocks []data.HeaderHandler) error {
 	sp.specialAddressHandler.ClearMetaConsensusData()
 
@@ -445,6 +497,47 @@ func (sp *shardProcessor) indexBlockIfNeeded(
 	saveRoundInfoInElastic(sp.core.Indexer(), sp.nodesCoordinator, shardId, header, lastBlockHeader, signersIndexes)
 }
 
+// updateGasPriceStats records, for each sender shard, the gas prices of the transactions included in the committed
+// block. The own shard is always recorded, so that empty blocks are also taken into account
+func (sp *shardProcessor) updateGasPriceStats(body block.Body) {
+	if sp.core == nil || sp.core.GasPriceStats() == nil || sp.core.GasPriceStats().IsInterfaceNil() {
+		return
+	}
+
+	txPool := sp.txCoordinator.GetAllCurrentUsedTxs(block.TxBlock)
+	gasPrices := make(map[uint32][]uint64)
+	gasPrices[sp.shardCoordinator.SelfId()] = make([]uint64, 0)
+
+	for _, miniBlock := range body {
+		if miniBlock.Type != block.TxBlock {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			tx, ok := txPool[string(txHash)].(*transaction.Transaction)
+			if !ok {
+				continue
+			}
+
+			gasPrices[miniBlock.SenderShardID] = append(gasPrices[miniBlock.SenderShardID], tx.GasPrice)
+		}
+	}
+
+	for shardId, prices := range gasPrices {
+		sp.core.GasPriceStats().AddBlock(shardId, prices)
+	}
+}
+
+// notifyAccountsChanges lets the accounts notifier inform its subscribers about the watched accounts changed by the
+// committed block
+func (sp *shardProcessor) notifyAccountsChanges(header data.HeaderHandler, headerHash []byte) {
+	if sp.core == nil || sp.core.AccountsNotifier() == nil || sp.core.AccountsNotifier().IsInterfaceNil() {
+		return
+	}
+
+	sp.core.AccountsNotifier().BlockCommitted(header, headerHash)
+}
+
 // RestoreBlockIntoPools restores the TxBlock and MetaBlock into associated pools
 func (sp *shardProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler, bodyHandler data.BodyHandler) error {
 	sp.removeLastNotarized()
@@ -701,6 +794,8 @@ func (sp *shardProcessor) CommitBlock(
 
 	chainHandler.SetCurrentBlockHeaderHash(headerHash)
 	sp.indexBlockIfNeeded(bodyHandler, headerHandler, lastBlockHeader)
+	sp.updateGasPriceStats(body)
+	sp.notifyAccountsChanges(headerHandler, headerHash)
 
 	headerMeta, err := sp.getLastNotarizedHdr(sharding.MetachainShardId)
 	if err != nil {
@@ -715,6 +810,7 @@ func (sp *shardProcessor) CommitBlock(
 	)
 
 	go sp.cleanTxsPools()
+	go sp.cleanHeadersPools(sp.finalNoncesForHeadersPools(highestFinalBlockNonce))
 
 	// write data to log
 	go sp.txCounter.displayLogInfo(
@@ -1523,6 +1619,11 @@ func (sp *shardProcessor) CreateBlockHeader(bodyHandler data.BodyHandler, round
 
 	header.MiniBlockHeaders = miniBlockHeaders
 	header.TxCount = uint32(totalTxCount)
+	header.AccumulatedFees = sp.blockEconomics.AccumulatedFees()
+	header.Rewards = sp.blockEconomics.TotalRewards()
+	header.LeaderFees = sp.blockEconomics.LeaderFees()
+	header.CommunityFees = sp.blockEconomics.CommunityFees()
+	header.BurnedFees = sp.blockEconomics.BurnedFees()
 	metaBlockHashes := sp.sortHeaderHashesForCurrentBlockByNonce(true)
 	header.MetaBlockHashes = metaBlockHashes[sharding.MetachainShardId]
 
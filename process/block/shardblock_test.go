@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"math/big"
 	"reflect"
 	"sync"
 	"sync/atomic"
@@ -12,6 +13,8 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
@@ -261,6 +264,28 @@ func TestNewShardProcessor_NilUint64Converter(t *testing.T) {
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilHeadersPoolsCleanerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.HeadersPoolsCleaner = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilHeadersPoolsCleaner, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_NilBlockEconomicsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.BlockEconomics = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilBlockEconomicsHandler, err)
+	assert.Nil(t, sp)
+}
+
 func TestNewShardProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -465,6 +490,7 @@ func TestShardProcessor_ProcessBlockWithInvalidTransactionShouldErr(t *testing.T
 		&mock.RewardTxProcessorMock{},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.FeeHandlerStub{},
+		&mock.BlockProposalPolicyMock{},
 	)
 	container, _ := factory.Create()
 
@@ -513,6 +539,34 @@ func TestShardProcessor_ProcessWithHeaderNotFirstShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrWrongNonceInBlock, err)
 }
 
+func TestShardProcessor_ProcessBlockWithCommittedNonceShouldErrWithoutTouchingState(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.Accounts = &mock.AccountsStub{
+		JournalLenCalled: func() int {
+			assert.Fail(t, "the state should not be touched")
+			return 0
+		},
+	}
+	sp, _ := blproc.NewShardProcessor(arguments)
+	blkc := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Round: 10, Nonce: 10}
+		},
+	}
+	hdr := &block.Header{
+		Nonce:        9,
+		Round:        11,
+		PrevRandSeed: []byte("rand seed"),
+		RootHash:     []byte("root hash"),
+	}
+	body := make(block.Body, 0)
+	err := sp.ProcessBlock(blkc, hdr, body, haveTime)
+
+	assert.Equal(t, process.ErrHeaderNonceAlreadyCommitted, err)
+}
+
 func TestShardProcessor_ProcessWithHeaderNotCorrectNonceShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -654,6 +708,7 @@ func TestShardProcessor_ProcessBlockWithErrOnProcessBlockTransactionsCallShouldR
 		&mock.RewardTxProcessorMock{},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.FeeHandlerStub{},
+		&mock.BlockProposalPolicyMock{},
 	)
 	container, _ := factory.Create()
 
@@ -843,6 +898,158 @@ func TestShardProcessor_ProcessBlockOnlyIntraShardShouldPass(t *testing.T) {
 	assert.False(t, wasCalled)
 }
 
+func createIntraShardBlockForEconomicsChecks(accumulatedFees *big.Int, rewards *big.Int) (*block.Header, block.Body) {
+	miniblock := block.MiniBlock{
+		ReceiverShardID: 0,
+		SenderShardID:   0,
+		TxHashes:        [][]byte{[]byte("tx_hash1")},
+	}
+	body := block.Body{&miniblock}
+
+	mbbytes, _ := (&mock.MarshalizerMock{}).Marshal(miniblock)
+	mbHdr := block.MiniBlockHeader{
+		SenderShardID:   0,
+		ReceiverShardID: 0,
+		TxCount:         1,
+		Hash:            (&mock.HasherStub{}).Compute(string(mbbytes)),
+	}
+
+	hdr := &block.Header{
+		Round:            1,
+		Nonce:            1,
+		PrevHash:         []byte(""),
+		PrevRandSeed:     []byte("rand seed"),
+		Signature:        []byte("signature"),
+		PubKeysBitmap:    []byte("00110"),
+		ShardId:          0,
+		RootHash:         []byte("rootHash"),
+		MiniBlockHeaders: []block.MiniBlockHeader{mbHdr},
+		AccumulatedFees:  accumulatedFees,
+		Rewards:          rewards,
+	}
+
+	return hdr, body
+}
+
+func createArgumentsForEconomicsChecks(wasReverted *bool) blproc.ArgShardProcessor {
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.DataPool = initDataPool([]byte("tx_hash1"))
+	arguments.Accounts = &mock.AccountsStub{
+		JournalLenCalled: func() int {
+			return 0
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			*wasReverted = true
+			return nil
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("rootHash"), nil
+		},
+	}
+	arguments.BlockEconomics = &mock.BlockEconomicsHandlerStub{
+		AccumulatedFeesCalled: func() *big.Int {
+			return big.NewInt(100)
+		},
+		TotalRewardsCalled: func() *big.Int {
+			return big.NewInt(1100)
+		},
+	}
+
+	return arguments
+}
+
+func TestShardProcessor_ProcessBlockWrongAccumulatedFeesShouldErrAndRevertState(t *testing.T) {
+	t.Parallel()
+
+	blkc := &blockchain.BlockChain{
+		CurrentBlockHeader: &block.Header{
+			Nonce:    0,
+			RandSeed: []byte("rand seed"),
+		},
+	}
+	wasReverted := false
+	sp, _ := blproc.NewShardProcessor(createArgumentsForEconomicsChecks(&wasReverted))
+	hdr, body := createIntraShardBlockForEconomicsChecks(big.NewInt(101), big.NewInt(1100))
+
+	err := sp.ProcessBlock(blkc, hdr, body, haveTime)
+
+	assert.Equal(t, process.ErrAccumulatedFeesDoNotMatch, err)
+	assert.True(t, wasReverted)
+}
+
+func TestShardProcessor_ProcessBlockWrongRewardsShouldErrAndRevertState(t *testing.T) {
+	t.Parallel()
+
+	blkc := &blockchain.BlockChain{
+		CurrentBlockHeader: &block.Header{
+			Nonce:    0,
+			RandSeed: []byte("rand seed"),
+		},
+	}
+	wasReverted := false
+	sp, _ := blproc.NewShardProcessor(createArgumentsForEconomicsChecks(&wasReverted))
+	hdr, body := createIntraShardBlockForEconomicsChecks(big.NewInt(100), nil)
+
+	err := sp.ProcessBlock(blkc, hdr, body, haveTime)
+
+	assert.Equal(t, process.ErrRewardsDoNotMatch, err)
+	assert.True(t, wasReverted)
+}
+
+func TestShardProcessor_ProcessBlockWrongFeesSharesShouldErrAndRevertState(t *testing.T) {
+	t.Parallel()
+
+	blkc := &blockchain.BlockChain{
+		CurrentBlockHeader: &block.Header{
+			Nonce:    0,
+			RandSeed: []byte("rand seed"),
+		},
+	}
+
+	wasReverted := false
+	sp, _ := blproc.NewShardProcessor(createArgumentsForEconomicsChecks(&wasReverted))
+	hdr, body := createIntraShardBlockForEconomicsChecks(big.NewInt(100), big.NewInt(1100))
+	hdr.LeaderFees = big.NewInt(1)
+	err := sp.ProcessBlock(blkc, hdr, body, haveTime)
+	assert.Equal(t, process.ErrLeaderFeesDoNotMatch, err)
+	assert.True(t, wasReverted)
+
+	wasReverted = false
+	sp, _ = blproc.NewShardProcessor(createArgumentsForEconomicsChecks(&wasReverted))
+	hdr, body = createIntraShardBlockForEconomicsChecks(big.NewInt(100), big.NewInt(1100))
+	hdr.CommunityFees = big.NewInt(1)
+	err = sp.ProcessBlock(blkc, hdr, body, haveTime)
+	assert.Equal(t, process.ErrCommunityFeesDoNotMatch, err)
+	assert.True(t, wasReverted)
+
+	wasReverted = false
+	sp, _ = blproc.NewShardProcessor(createArgumentsForEconomicsChecks(&wasReverted))
+	hdr, body = createIntraShardBlockForEconomicsChecks(big.NewInt(100), big.NewInt(1100))
+	hdr.BurnedFees = big.NewInt(1)
+	err = sp.ProcessBlock(blkc, hdr, body, haveTime)
+	assert.Equal(t, process.ErrBurnedFeesDoNotMatch, err)
+	assert.True(t, wasReverted)
+}
+
+func TestShardProcessor_ProcessBlockMatchingEconomicsShouldPass(t *testing.T) {
+	t.Parallel()
+
+	blkc := &blockchain.BlockChain{
+		CurrentBlockHeader: &block.Header{
+			Nonce:    0,
+			RandSeed: []byte("rand seed"),
+		},
+	}
+	wasReverted := false
+	sp, _ := blproc.NewShardProcessor(createArgumentsForEconomicsChecks(&wasReverted))
+	hdr, body := createIntraShardBlockForEconomicsChecks(big.NewInt(100), big.NewInt(1100))
+
+	err := sp.ProcessBlock(blkc, hdr, body, haveTime)
+
+	assert.Nil(t, err)
+	assert.False(t, wasReverted)
+}
+
 func TestShardProcessor_ProcessBlockCrossShardWithoutMetaShouldFail(t *testing.T) {
 	t.Parallel()
 
@@ -1808,6 +2015,7 @@ func TestShardProcessor_CommitBlockNoTxInPoolShouldErr(t *testing.T) {
 		&mock.RewardTxProcessorMock{},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.FeeHandlerStub{},
+		&mock.BlockProposalPolicyMock{},
 	)
 	container, _ := factory.Create()
 
@@ -2055,6 +2263,230 @@ func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 	assert.Equal(t, 4, len(wasCalled))
 }
 
+func TestShardProcessor_CommitBlockShouldUpdateGasPriceStats(t *testing.T) {
+	t.Parallel()
+	tdp := initDataPool([]byte("tx_hash1"))
+
+	rootHash := []byte("root hash")
+	hdrHash := []byte("header hash")
+	randSeed := []byte("rand seed")
+
+	prevHdr := &block.Header{
+		Nonce:         0,
+		Round:         0,
+		PubKeysBitmap: rootHash,
+		PrevHash:      hdrHash,
+		Signature:     rootHash,
+		RootHash:      rootHash,
+		RandSeed:      randSeed,
+	}
+
+	hdr := &block.Header{
+		Nonce:         1,
+		Round:         1,
+		PubKeysBitmap: rootHash,
+		PrevHash:      hdrHash,
+		Signature:     rootHash,
+		RootHash:      rootHash,
+		PrevRandSeed:  randSeed,
+	}
+	mb := block.MiniBlock{
+		TxHashes:        [][]byte{[]byte("tx_1"), []byte("tx_2"), []byte("tx_missing")},
+		ReceiverShardID: 1,
+		Type:            block.TxBlock,
+	}
+	body := block.Body{&mb}
+
+	mbHdr := block.MiniBlockHeader{
+		TxCount:         uint32(len(mb.TxHashes)),
+		Hash:            hdrHash,
+		ReceiverShardID: 1,
+		Type:            block.TxBlock,
+	}
+	mbHdrs := make([]block.MiniBlockHeader, 0)
+	mbHdrs = append(mbHdrs, mbHdr)
+	hdr.MiniBlockHeaders = mbHdrs
+
+	accounts := &mock.AccountsStub{
+		CommitCalled: func() (i []byte, e error) {
+			return rootHash, nil
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return rootHash, nil
+		},
+	}
+	fd := &mock.ForkDetectorMock{
+		AddHeaderCalled: func(header data.HeaderHandler, hash []byte, state process.BlockHeaderState, finalHeaders []data.HeaderHandler, finalHeadersHashes [][]byte) error {
+			return nil
+		},
+		GetHighestFinalBlockNonceCalled: func() uint64 {
+			return 0
+		},
+	}
+	hasher := &mock.HasherStub{}
+	hasher.ComputeCalled = func(s string) []byte {
+		return hdrHash
+	}
+	store := initStore()
+
+	gasPriceStats, _ := statistics.NewGasPriceStats(10)
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.Core = &mock.ServiceContainerMock{
+		GasPriceStatsCalled: func() statistics.GasPriceStatsHandler {
+			return gasPriceStats
+		},
+	}
+	arguments.DataPool = tdp
+	arguments.Store = store
+	arguments.Hasher = hasher
+	arguments.Accounts = accounts
+	arguments.ForkDetector = fd
+	arguments.TxCoordinator = &mock.TransactionCoordinatorMock{
+		GetAllCurrentUsedTxsCalled: func(blockType block.Type) map[string]data.TransactionHandler {
+			switch blockType {
+			case block.TxBlock:
+				return map[string]data.TransactionHandler{
+					"tx_1": &transaction.Transaction{Nonce: 1, GasPrice: 10},
+					"tx_2": &transaction.Transaction{Nonce: 2, GasPrice: 20},
+				}
+			case block.SmartContractResultBlock:
+				return map[string]data.TransactionHandler{
+					"utx_1": &smartContractResult.SmartContractResult{Nonce: 1},
+					"utx_2": &smartContractResult.SmartContractResult{Nonce: 2},
+				}
+			default:
+				return nil
+			}
+		},
+	}
+
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	blkc := createTestBlockchain()
+	blkc.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return prevHdr
+	}
+	blkc.GetCurrentBlockHeaderHashCalled = func() []byte {
+		return hdrHash
+	}
+	err := sp.ProcessBlock(blkc, hdr, body, haveTime)
+	assert.Nil(t, err)
+	err = sp.CommitBlock(blkc, hdr, body)
+	assert.Nil(t, err)
+
+	senderShardStat, ok := gasPriceStats.ShardGasPriceStat(0)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(1), senderShardStat.NumBlocks)
+	assert.Equal(t, uint32(2), senderShardStat.NumTxs)
+	assert.Equal(t, uint64(10), senderShardStat.Min)
+	assert.Equal(t, uint64(20), senderShardStat.Max)
+
+	_, ok = gasPriceStats.ShardGasPriceStat(1)
+	assert.False(t, ok)
+}
+
+func TestShardProcessor_CommitBlockShouldNotifyTheAccountsNotifier(t *testing.T) {
+	t.Parallel()
+	tdp := initDataPool([]byte("tx_hash1"))
+
+	rootHash := []byte("root hash")
+	hdrHash := []byte("header hash")
+	randSeed := []byte("rand seed")
+
+	prevHdr := &block.Header{
+		Nonce:         0,
+		Round:         0,
+		PubKeysBitmap: rootHash,
+		PrevHash:      hdrHash,
+		Signature:     rootHash,
+		RootHash:      rootHash,
+		RandSeed:      randSeed,
+	}
+
+	hdr := &block.Header{
+		Nonce:         1,
+		Round:         1,
+		PubKeysBitmap: rootHash,
+		PrevHash:      hdrHash,
+		Signature:     rootHash,
+		RootHash:      rootHash,
+		PrevRandSeed:  randSeed,
+	}
+	mb := block.MiniBlock{
+		TxHashes:        [][]byte{[]byte("tx_1"), []byte("tx_2"), []byte("tx_missing")},
+		ReceiverShardID: 1,
+		Type:            block.TxBlock,
+	}
+	body := block.Body{&mb}
+
+	mbHdr := block.MiniBlockHeader{
+		TxCount:         uint32(len(mb.TxHashes)),
+		Hash:            hdrHash,
+		ReceiverShardID: 1,
+		Type:            block.TxBlock,
+	}
+	mbHdrs := make([]block.MiniBlockHeader, 0)
+	mbHdrs = append(mbHdrs, mbHdr)
+	hdr.MiniBlockHeaders = mbHdrs
+
+	accounts := &mock.AccountsStub{
+		CommitCalled: func() (i []byte, e error) {
+			return rootHash, nil
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return rootHash, nil
+		},
+	}
+	fd := &mock.ForkDetectorMock{
+		AddHeaderCalled: func(header data.HeaderHandler, hash []byte, state process.BlockHeaderState, finalHeaders []data.HeaderHandler, finalHeadersHashes [][]byte) error {
+			return nil
+		},
+		GetHighestFinalBlockNonceCalled: func() uint64 {
+			return 0
+		},
+	}
+	hasher := &mock.HasherStub{}
+	hasher.ComputeCalled = func(s string) []byte {
+		return hdrHash
+	}
+	store := initStore()
+
+	var notifiedHeader data.HeaderHandler
+	var notifiedHeaderHash []byte
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.Core = &mock.ServiceContainerMock{
+		AccountsNotifierCalled: func() subscription.AccountsNotifierHandler {
+			return &mock.AccountsNotifierStub{
+				BlockCommittedCalled: func(header data.HeaderHandler, headerHash []byte) {
+					notifiedHeader = header
+					notifiedHeaderHash = headerHash
+				},
+			}
+		},
+	}
+	arguments.DataPool = tdp
+	arguments.Store = store
+	arguments.Hasher = hasher
+	arguments.Accounts = accounts
+	arguments.ForkDetector = fd
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	blkc := createTestBlockchain()
+	blkc.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return prevHdr
+	}
+	blkc.GetCurrentBlockHeaderHashCalled = func() []byte {
+		return hdrHash
+	}
+	err := sp.ProcessBlock(blkc, hdr, body, haveTime)
+	assert.Nil(t, err)
+	err = sp.CommitBlock(blkc, hdr, body)
+	assert.Nil(t, err)
+
+	assert.True(t, hdr == notifiedHeader)
+	assert.Equal(t, hdrHash, notifiedHeaderHash)
+}
+
 func TestShardProcessor_CreateTxBlockBodyWithDirtyAccStateShouldErr(t *testing.T) {
 	t.Parallel()
 	tdp := initDataPool([]byte("tx_hash1"))
@@ -2300,6 +2732,48 @@ func TestShardProcessor_CreateBlockHeaderReturnsOK(t *testing.T) {
 	assert.Equal(t, len(body), len(mbHeaders.(*block.Header).MiniBlockHeaders))
 }
 
+func TestShardProcessor_CreateBlockHeaderShouldSetEconomicsFields(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.BlockEconomics = &mock.BlockEconomicsHandlerStub{
+		AccumulatedFeesCalled: func() *big.Int {
+			return big.NewInt(37)
+		},
+		TotalRewardsCalled: func() *big.Int {
+			return big.NewInt(2037)
+		},
+		LeaderFeesCalled: func() *big.Int {
+			return big.NewInt(18)
+		},
+		CommunityFeesCalled: func() *big.Int {
+			return big.NewInt(3)
+		},
+		BurnedFeesCalled: func() *big.Int {
+			return big.NewInt(14)
+		},
+	}
+	bp, _ := blproc.NewShardProcessor(arguments)
+	body := block.Body{
+		{
+			ReceiverShardID: 0,
+			SenderShardID:   0,
+			TxHashes:        make([][]byte, 0),
+		},
+	}
+
+	hdr, err := bp.CreateBlockHeader(body, 0, func() bool {
+		return true
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(37), hdr.(*block.Header).AccumulatedFees)
+	assert.Equal(t, big.NewInt(2037), hdr.(*block.Header).Rewards)
+	assert.Equal(t, big.NewInt(18), hdr.(*block.Header).LeaderFees)
+	assert.Equal(t, big.NewInt(3), hdr.(*block.Header).CommunityFees)
+	assert.Equal(t, big.NewInt(14), hdr.(*block.Header).BurnedFees)
+}
+
 func TestShardProcessor_CommitBlockShouldRevertAccountStateWhenErr(t *testing.T) {
 	t.Parallel()
 	// set accounts dirty
@@ -2358,6 +2832,7 @@ func TestShardProcessor_MarshalizedDataToBroadcastShouldWork(t *testing.T) {
 		&mock.RewardTxProcessorMock{},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.FeeHandlerStub{},
+		&mock.BlockProposalPolicyMock{},
 	)
 	container, _ := factory.Create()
 
@@ -2461,6 +2936,7 @@ func TestShardProcessor_MarshalizedDataMarshalWithoutSuccess(t *testing.T) {
 		&mock.RewardTxProcessorMock{},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.FeeHandlerStub{},
+		&mock.BlockProposalPolicyMock{},
 	)
 	container, _ := factory.Create()
 
@@ -2893,6 +3369,7 @@ func TestShardProcessor_CreateMiniBlocksShouldWorkWithIntraShardTxs(t *testing.T
 				return 0
 			},
 		},
+		&mock.BlockProposalPolicyMock{},
 	)
 	container, _ := factory.Create()
 
@@ -3086,6 +3563,7 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 		&mock.RewardTxProcessorMock{},
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.FeeHandlerStub{},
+		&mock.BlockProposalPolicyMock{},
 	)
 	container, _ := factory.Create()
 
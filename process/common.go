@@ -1,6 +1,7 @@
 package process
 
 import (
+	"bytes"
 	"sort"
 
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -563,3 +564,61 @@ func SortHeadersByNonce(headers []data.HeaderHandler) {
 		})
 	}
 }
+
+// rewardTxsByReceiver sorts reward transactions, together with their hashes, in the order required inside a rewards
+// miniblock
+type rewardTxsByReceiver struct {
+	rewardTxs []data.TransactionHandler
+	txHashes  [][]byte
+}
+
+func (r *rewardTxsByReceiver) Len() int {
+	return len(r.rewardTxs)
+}
+
+func (r *rewardTxsByReceiver) Less(i, j int) bool {
+	return compareRewardTxs(r.rewardTxs[i], r.txHashes[i], r.rewardTxs[j], r.txHashes[j]) < 0
+}
+
+func (r *rewardTxsByReceiver) Swap(i, j int) {
+	r.rewardTxs[i], r.rewardTxs[j] = r.rewardTxs[j], r.rewardTxs[i]
+	r.txHashes[i], r.txHashes[j] = r.txHashes[j], r.txHashes[i]
+}
+
+// compareRewardTxs orders the reward transactions by receiver address and, for the same receiver, by hash
+func compareRewardTxs(rTxA data.TransactionHandler, hashA []byte, rTxB data.TransactionHandler, hashB []byte) int {
+	result := bytes.Compare(rTxA.GetRecvAddress(), rTxB.GetRecvAddress())
+	if result != 0 {
+		return result
+	}
+
+	return bytes.Compare(hashA, hashB)
+}
+
+// SortRewardTxsByReceiver sorts the reward transactions and their hashes in the order they must have inside a
+// rewards miniblock: ascending by receiver address and, for the same receiver, ascending by hash
+func SortRewardTxsByReceiver(rewardTxs []data.TransactionHandler, txHashes [][]byte) error {
+	if len(rewardTxs) != len(txHashes) {
+		return ErrRewardTxsAndHashesLengthMismatch
+	}
+
+	sort.Stable(&rewardTxsByReceiver{rewardTxs: rewardTxs, txHashes: txHashes})
+
+	return nil
+}
+
+// CheckRewardTxsOrder verifies that the reward transactions, given with their hashes, are in the order they must
+// have inside a rewards miniblock
+func CheckRewardTxsOrder(rewardTxs []data.TransactionHandler, txHashes [][]byte) error {
+	if len(rewardTxs) != len(txHashes) {
+		return ErrRewardTxsAndHashesLengthMismatch
+	}
+
+	for i := 1; i < len(rewardTxs); i++ {
+		if compareRewardTxs(rewardTxs[i-1], txHashes[i-1], rewardTxs[i], txHashes[i]) > 0 {
+			return ErrRewardTxsNotOrdered
+		}
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -2129,3 +2130,63 @@ func TestSortHeadersByNonceShouldWork(t *testing.T) {
 	assert.Equal(t, uint64(2), headers[1].GetNonce())
 	assert.Equal(t, uint64(3), headers[2].GetNonce())
 }
+
+func TestSortRewardTxsByReceiverLengthMismatchShouldErr(t *testing.T) {
+	rewardTxs := []data.TransactionHandler{&rewardTx.RewardTx{RcvAddr: []byte("b")}}
+
+	err := process.SortRewardTxsByReceiver(rewardTxs, make([][]byte, 0))
+
+	assert.Equal(t, process.ErrRewardTxsAndHashesLengthMismatch, err)
+}
+
+func TestSortRewardTxsByReceiverShouldOrderByReceiverThenHash(t *testing.T) {
+	rewardTxs := []data.TransactionHandler{
+		&rewardTx.RewardTx{RcvAddr: []byte("c")},
+		&rewardTx.RewardTx{RcvAddr: []byte("a"), Round: 2},
+		&rewardTx.RewardTx{RcvAddr: []byte("b")},
+		&rewardTx.RewardTx{RcvAddr: []byte("a"), Round: 1},
+	}
+	txHashes := [][]byte{[]byte("hash1"), []byte("hash3"), []byte("hash4"), []byte("hash2")}
+
+	err := process.SortRewardTxsByReceiver(rewardTxs, txHashes)
+
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("hash2"), []byte("hash3"), []byte("hash4"), []byte("hash1")}, txHashes)
+	assert.Equal(t, uint64(1), rewardTxs[0].(*rewardTx.RewardTx).Round)
+	assert.Equal(t, uint64(2), rewardTxs[1].(*rewardTx.RewardTx).Round)
+	assert.Equal(t, []byte("b"), rewardTxs[2].GetRecvAddress())
+	assert.Equal(t, []byte("c"), rewardTxs[3].GetRecvAddress())
+	assert.Nil(t, process.CheckRewardTxsOrder(rewardTxs, txHashes))
+}
+
+func TestCheckRewardTxsOrderLengthMismatchShouldErr(t *testing.T) {
+	rewardTxs := []data.TransactionHandler{&rewardTx.RewardTx{RcvAddr: []byte("b")}}
+
+	err := process.CheckRewardTxsOrder(rewardTxs, make([][]byte, 0))
+
+	assert.Equal(t, process.ErrRewardTxsAndHashesLengthMismatch, err)
+}
+
+func TestCheckRewardTxsOrderUnorderedReceiversShouldErr(t *testing.T) {
+	rewardTxs := []data.TransactionHandler{
+		&rewardTx.RewardTx{RcvAddr: []byte("b")},
+		&rewardTx.RewardTx{RcvAddr: []byte("a")},
+	}
+	txHashes := [][]byte{[]byte("hash1"), []byte("hash2")}
+
+	err := process.CheckRewardTxsOrder(rewardTxs, txHashes)
+
+	assert.Equal(t, process.ErrRewardTxsNotOrdered, err)
+}
+
+func TestCheckRewardTxsOrderSameReceiverUnorderedHashesShouldErr(t *testing.T) {
+	rewardTxs := []data.TransactionHandler{
+		&rewardTx.RewardTx{RcvAddr: []byte("a")},
+		&rewardTx.RewardTx{RcvAddr: []byte("a")},
+	}
+	txHashes := [][]byte{[]byte("hash2"), []byte("hash1")}
+
+	err := process.CheckRewardTxsOrder(rewardTxs, txHashes)
+
+	assert.Equal(t, process.ErrRewardTxsNotOrdered, err)
+}
@@ -43,3 +43,17 @@ const MaxRequestsWithTimeoutAllowed = 3
 
 // MaxHeadersToRequestInAdvance defines the maximum number of headers which will be requested in advance if they are missing
 const MaxHeadersToRequestInAdvance = 10
+
+// StateChangeType specifies the kind of state change applied from a smart contract execution
+type StateChangeType uint8
+
+const (
+	// BalanceChange defines ID of an account balance modification
+	BalanceChange StateChangeType = iota
+	// StorageWrite defines ID of a write in an account's data trie
+	StorageWrite
+	// CodeChange defines ID of an account code modification
+	CodeChange
+	// CodeUpgrade defines ID of the replacement of a smart contract code by its owner
+	CodeUpgrade
+)
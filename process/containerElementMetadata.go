@@ -0,0 +1,13 @@
+package process
+
+import (
+	"time"
+)
+
+// ContainerElementMetadata holds the information recorded by a container about one of its elements when the element
+// was added or replaced: the topic it serves, its concrete type and the moment it was stored
+type ContainerElementMetadata struct {
+	Topic     string
+	Type      string
+	CreatedAt time.Time
+}
@@ -379,6 +379,7 @@ func createPreProcessorContainer() process.PreProcessorsContainer {
 		&mock.RewardTxProcessorMock{},
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -422,6 +423,7 @@ func createPreProcessorContainerWithDataPool(dataPool dataRetriever.PoolsHolder)
 		&mock.RewardTxProcessorMock{},
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -694,6 +696,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessCrossShardTransactions(t *tes
 		&mock.RewardTxProcessorMock{},
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -785,6 +788,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessTransactionsFromMeNothingToPr
 		&mock.RewardTxProcessorMock{},
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -1150,6 +1154,7 @@ func TestTransactionCoordinator_receivedMiniBlockRequestTxs(t *testing.T) {
 		&mock.RewardTxProcessorMock{},
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -1305,6 +1310,7 @@ func TestTransactionCoordinator_ProcessBlockTransactionProcessTxError(t *testing
 		&mock.RewardTxProcessorMock{},
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -1426,6 +1432,7 @@ func TestTransactionCoordinator_RequestMiniblocks(t *testing.T) {
 		&mock.RewardTxProcessorMock{},
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -1542,6 +1549,7 @@ func TestShardProcessor_ProcessMiniBlockCompleteWithOkTxsShouldExecuteThemAndNot
 		&mock.RewardTxProcessorMock{},
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -1649,6 +1657,7 @@ func TestShardProcessor_ProcessMiniBlockCompleteWithErrorWhileProcessShouldCallR
 		&mock.RewardTxProcessorMock{},
 		&mock.IntermediateTransactionHandlerMock{},
 		FeeHandlerMock(),
+		&mock.BlockProposalPolicyMock{},
 	)
 	container, _ := preFactory.Create()
 
@@ -0,0 +1,69 @@
+package dataValidators
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// epochHeaderValidator represents a header handler validator that accepts only the headers that belong to the
+// current epoch, plus or minus a configurable grace window
+type epochHeaderValidator struct {
+	blockChain       data.ChainHandler
+	epochGraceWindow uint32
+}
+
+// NewEpochHeaderValidator creates a new epoch header handler validator instance
+func NewEpochHeaderValidator(blockChain data.ChainHandler, epochGraceWindow uint32) (*epochHeaderValidator, error) {
+	if blockChain == nil || blockChain.IsInterfaceNil() {
+		return nil, process.ErrNilBlockChain
+	}
+
+	return &epochHeaderValidator{
+		blockChain:       blockChain,
+		epochGraceWindow: epochGraceWindow,
+	}, nil
+}
+
+// IsHeaderValidForProcessing will return false if the header's epoch is outside the accepted epochs window
+// computed from the current block's epoch
+func (ehv *epochHeaderValidator) IsHeaderValidForProcessing(headerHandler data.HeaderHandler) bool {
+	if headerHandler == nil || headerHandler.IsInterfaceNil() {
+		return false
+	}
+
+	currentEpoch := ehv.currentEpoch()
+	headerEpoch := headerHandler.GetEpoch()
+
+	isHeaderObsolete := uint64(headerEpoch)+uint64(ehv.epochGraceWindow) < uint64(currentEpoch)
+	isHeaderTooFarInFuture := uint64(headerEpoch) > uint64(currentEpoch)+uint64(ehv.epochGraceWindow)
+	if isHeaderObsolete || isHeaderTooFarInFuture {
+		log.Debug(fmt.Sprintf("header with nonce %d from epoch %d rejected, current epoch is %d, grace window is %d",
+			headerHandler.GetNonce(),
+			headerEpoch,
+			currentEpoch,
+			ehv.epochGraceWindow,
+		))
+		return false
+	}
+
+	return true
+}
+
+func (ehv *epochHeaderValidator) currentEpoch() uint32 {
+	currentHeader := ehv.blockChain.GetCurrentBlockHeader()
+	if currentHeader == nil || currentHeader.IsInterfaceNil() {
+		return 0
+	}
+
+	return currentHeader.GetEpoch()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ehv *epochHeaderValidator) IsInterfaceNil() bool {
+	if ehv == nil {
+		return true
+	}
+	return false
+}
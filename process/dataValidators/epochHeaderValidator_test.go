@@ -0,0 +1,83 @@
+package dataValidators_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createBlockChainWithEpoch(epoch uint32) *mock.BlockChainMock {
+	return &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Epoch: epoch}
+		},
+	}
+}
+
+func TestNewEpochHeaderValidator_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ehv, err := dataValidators.NewEpochHeaderValidator(nil, 1)
+
+	assert.Nil(t, ehv)
+	assert.Equal(t, process.ErrNilBlockChain, err)
+}
+
+func TestNewEpochHeaderValidator_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ehv, err := dataValidators.NewEpochHeaderValidator(createBlockChainWithEpoch(0), 1)
+
+	assert.NotNil(t, ehv)
+	assert.Nil(t, err)
+}
+
+func TestEpochHeaderValidator_IsHeaderValidForProcessingNilHeaderShouldRetFalse(t *testing.T) {
+	t.Parallel()
+
+	ehv, _ := dataValidators.NewEpochHeaderValidator(createBlockChainWithEpoch(0), 1)
+
+	assert.False(t, ehv.IsHeaderValidForProcessing(nil))
+}
+
+func TestEpochHeaderValidator_IsHeaderValidForProcessingNoCurrentHeaderShouldUseEpochZero(t *testing.T) {
+	t.Parallel()
+
+	ehv, _ := dataValidators.NewEpochHeaderValidator(&mock.BlockChainMock{}, 1)
+
+	assert.True(t, ehv.IsHeaderValidForProcessing(&block.Header{Epoch: 0}))
+	assert.True(t, ehv.IsHeaderValidForProcessing(&block.Header{Epoch: 1}))
+	assert.False(t, ehv.IsHeaderValidForProcessing(&block.Header{Epoch: 2}))
+}
+
+func TestEpochHeaderValidator_IsHeaderValidForProcessingInsideGraceWindowShouldRetTrue(t *testing.T) {
+	t.Parallel()
+
+	ehv, _ := dataValidators.NewEpochHeaderValidator(createBlockChainWithEpoch(5), 2)
+
+	assert.True(t, ehv.IsHeaderValidForProcessing(&block.Header{Epoch: 3}))
+	assert.True(t, ehv.IsHeaderValidForProcessing(&block.Header{Epoch: 5}))
+	assert.True(t, ehv.IsHeaderValidForProcessing(&block.MetaBlock{Epoch: 7}))
+}
+
+func TestEpochHeaderValidator_IsHeaderValidForProcessingObsoleteHeaderShouldRetFalse(t *testing.T) {
+	t.Parallel()
+
+	ehv, _ := dataValidators.NewEpochHeaderValidator(createBlockChainWithEpoch(5), 2)
+
+	assert.False(t, ehv.IsHeaderValidForProcessing(&block.Header{Epoch: 2}))
+	assert.False(t, ehv.IsHeaderValidForProcessing(&block.MetaBlock{Epoch: 0}))
+}
+
+func TestEpochHeaderValidator_IsHeaderValidForProcessingFutureHeaderShouldRetFalse(t *testing.T) {
+	t.Parallel()
+
+	ehv, _ := dataValidators.NewEpochHeaderValidator(createBlockChainWithEpoch(5), 2)
+
+	assert.False(t, ehv.IsHeaderValidForProcessing(&block.Header{Epoch: 8}))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -16,6 +17,8 @@ var log = logger.DefaultLogger()
 type TxValidator struct {
 	accounts             state.AccountsAdapter
 	shardCoordinator     sharding.Coordinator
+	blockChain           data.ChainHandler
+	validityWindow       process.TxValidityWindowChecker
 	rejectedTxs          uint64
 	maxNonceDeltaAllowed int
 }
@@ -24,6 +27,8 @@ type TxValidator struct {
 func NewTxValidator(
 	accounts state.AccountsAdapter,
 	shardCoordinator sharding.Coordinator,
+	blockChain data.ChainHandler,
+	validityWindow process.TxValidityWindowChecker,
 	maxNonceDeltaAllowed int,
 ) (*TxValidator, error) {
 
@@ -33,10 +38,18 @@ func NewTxValidator(
 	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
 		return nil, process.ErrNilShardCoordinator
 	}
+	if blockChain == nil || blockChain.IsInterfaceNil() {
+		return nil, process.ErrNilBlockChain
+	}
+	if validityWindow == nil || validityWindow.IsInterfaceNil() {
+		return nil, process.ErrNilTxValidityWindowChecker
+	}
 
 	return &TxValidator{
 		accounts:             accounts,
 		shardCoordinator:     shardCoordinator,
+		blockChain:           blockChain,
+		validityWindow:       validityWindow,
 		rejectedTxs:          uint64(0),
 		maxNonceDeltaAllowed: maxNonceDeltaAllowed,
 	}, nil
@@ -51,6 +64,11 @@ func (tv *TxValidator) IsTxValidForProcessing(interceptedTx process.TxValidatorH
 		return true
 	}
 
+	if !tv.isInValidityWindow(interceptedTx) {
+		tv.rejectedTxs++
+		return false
+	}
+
 	sndAddr := interceptedTx.SenderAddress()
 	accountHandler, err := tv.accounts.GetExistingAccount(sndAddr)
 	if err != nil {
@@ -86,6 +104,26 @@ func (tv *TxValidator) IsTxValidForProcessing(interceptedTx process.TxValidatorH
 	return true
 }
 
+// isInValidityWindow returns false if the transaction can no longer be included in a block. The transactions whose
+// validity window has not yet started are kept, waiting in the pool for their window to start
+func (tv *TxValidator) isInValidityWindow(interceptedTx process.TxValidatorHandler) bool {
+	round := uint64(0)
+	epoch := uint32(0)
+	currentHeader := tv.blockChain.GetCurrentBlockHeader()
+	if currentHeader != nil && !currentHeader.IsInterfaceNil() {
+		round = currentHeader.GetRound()
+		epoch = currentHeader.GetEpoch()
+	}
+
+	err := tv.validityWindow.CheckValidityWindow(interceptedTx.Transaction(), round, epoch)
+	if err == nil || err == process.ErrTxNotYetValid {
+		return true
+	}
+
+	log.Debug(fmt.Sprintf("Transaction rejected, validity window check failed: %s", err.Error()))
+	return false
+}
+
 // NumRejectedTxs will return number of rejected transaction
 func (tv *TxValidator) NumRejectedTxs() uint64 {
 	return tv.rejectedTxs
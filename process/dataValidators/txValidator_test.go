@@ -6,7 +6,10 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -60,7 +63,7 @@ func TestTxValidator_NewValidatorNilAccountsShouldErr(t *testing.T) {
 
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, err := dataValidators.NewTxValidator(nil, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(nil, shardCoordinator, &mock.BlockChainMock{}, &mock.TxValidityWindowCheckerStub{}, maxNonceDeltaAllowed)
 
 	assert.Nil(t, txValidator)
 	assert.Equal(t, process.ErrNilAccountsAdapter, err)
@@ -71,19 +74,43 @@ func TestTxValidator_NewValidatorNilShardCoordinatorShouldErr(t *testing.T) {
 
 	accounts := getAccAdapter(0, big.NewInt(0))
 	maxNonceDeltaAllowed := 100
-	txValidator, err := dataValidators.NewTxValidator(accounts, nil, maxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(accounts, nil, &mock.BlockChainMock{}, &mock.TxValidityWindowCheckerStub{}, maxNonceDeltaAllowed)
 
 	assert.Nil(t, txValidator)
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
 }
 
+func TestTxValidator_NewValidatorNilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := getAccAdapter(0, big.NewInt(0))
+	shardCoordinator := createMockCoordinator("_", 0)
+	maxNonceDeltaAllowed := 100
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, nil, &mock.TxValidityWindowCheckerStub{}, maxNonceDeltaAllowed)
+
+	assert.Nil(t, txValidator)
+	assert.Equal(t, process.ErrNilBlockChain, err)
+}
+
+func TestTxValidator_NewValidatorNilValidityWindowCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := getAccAdapter(0, big.NewInt(0))
+	shardCoordinator := createMockCoordinator("_", 0)
+	maxNonceDeltaAllowed := 100
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, &mock.BlockChainMock{}, nil, maxNonceDeltaAllowed)
+
+	assert.Nil(t, txValidator)
+	assert.Equal(t, process.ErrNilTxValidityWindowChecker, err)
+}
+
 func TestTxValidator_NewValidatorShouldWork(t *testing.T) {
 	t.Parallel()
 
 	accounts := getAccAdapter(0, big.NewInt(0))
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, &mock.BlockChainMock{}, &mock.TxValidityWindowCheckerStub{}, maxNonceDeltaAllowed)
 
 	assert.Nil(t, err)
 	assert.NotNil(t, txValidator)
@@ -98,7 +125,7 @@ func TestTxValidator_IsTxValidForProcessingTxIsCrossShardShouldReturnTrue(t *tes
 	accounts := getAccAdapter(1, big.NewInt(0))
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, &mock.BlockChainMock{}, &mock.TxValidityWindowCheckerStub{}, maxNonceDeltaAllowed)
 	assert.Nil(t, err)
 
 	addressMock := mock.NewAddressMock([]byte("address"))
@@ -117,7 +144,7 @@ func TestTxValidator_IsTxValidForProcessingAccountNonceIsGreaterThanTxNonceShoul
 	accounts := getAccAdapter(accountNonce, big.NewInt(0))
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, &mock.BlockChainMock{}, &mock.TxValidityWindowCheckerStub{}, maxNonceDeltaAllowed)
 	assert.Nil(t, err)
 
 	addressMock := mock.NewAddressMock([]byte("address"))
@@ -136,7 +163,7 @@ func TestTxValidator_IsTxValidForProcessingTxNonceIsTooHigh(t *testing.T) {
 
 	accounts := getAccAdapter(accountNonce, big.NewInt(0))
 	shardCoordinator := createMockCoordinator("_", 0)
-	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, &mock.BlockChainMock{}, &mock.TxValidityWindowCheckerStub{}, maxNonceDeltaAllowed)
 	assert.Nil(t, err)
 
 	addressMock := mock.NewAddressMock([]byte("address"))
@@ -157,7 +184,7 @@ func TestTxValidator_IsTxValidForProcessingAccountBalanceIsLessThanTxTotalValueS
 	accounts := getAccAdapter(accountNonce, accountBalance)
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, &mock.BlockChainMock{}, &mock.TxValidityWindowCheckerStub{}, maxNonceDeltaAllowed)
 	assert.Nil(t, err)
 
 	addressMock := mock.NewAddressMock([]byte("address"))
@@ -178,7 +205,7 @@ func TestTxValidator_IsTxValidForProcessingNumOfRejectedTxShouldIncreaseShouldRe
 	accounts := getAccAdapter(accountNonce, accountBalance)
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(accounts, shardCoordinator, &mock.BlockChainMock{}, &mock.TxValidityWindowCheckerStub{}, maxNonceDeltaAllowed)
 	assert.Nil(t, err)
 
 	addressMock := mock.NewAddressMock([]byte("address"))
@@ -200,7 +227,7 @@ func TestTxValidator_IsTxValidForProcessingAccountNotExitsShouldReturnFalse(t *t
 	}
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, _ := dataValidators.NewTxValidator(accDB, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, _ := dataValidators.NewTxValidator(accDB, shardCoordinator, &mock.BlockChainMock{}, &mock.TxValidityWindowCheckerStub{}, maxNonceDeltaAllowed)
 
 	addressMock := mock.NewAddressMock([]byte("address"))
 	txValidatorHandler := getTxValidatorHandler(0, 1, addressMock, big.NewInt(0))
@@ -218,7 +245,7 @@ func TestTxValidator_IsTxValidForProcessingWrongAccountTypeShouldReturnFalse(t *
 	}
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, _ := dataValidators.NewTxValidator(accDB, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, _ := dataValidators.NewTxValidator(accDB, shardCoordinator, &mock.BlockChainMock{}, &mock.TxValidityWindowCheckerStub{}, maxNonceDeltaAllowed)
 
 	addressMock := mock.NewAddressMock([]byte("address"))
 	txValidatorHandler := getTxValidatorHandler(0, 1, addressMock, big.NewInt(0))
@@ -235,11 +262,63 @@ func TestTxValidator_IsTxValidForProcessingTxIsOkShouldReturnTrue(t *testing.T)
 	accounts := getAccAdapter(accountNonce, accountBalance)
 	shardCoordinator := createMockCoordinator("_", 0)
 	maxNonceDeltaAllowed := 100
-	txValidator, _ := dataValidators.NewTxValidator(accounts, shardCoordinator, maxNonceDeltaAllowed)
+	txValidator, _ := dataValidators.NewTxValidator(accounts, shardCoordinator, &mock.BlockChainMock{}, &mock.TxValidityWindowCheckerStub{}, maxNonceDeltaAllowed)
+
+	addressMock := mock.NewAddressMock([]byte("address"))
+	txValidatorHandler := getTxValidatorHandler(0, 1, addressMock, big.NewInt(0))
+
+	result := txValidator.IsTxValidForProcessing(txValidatorHandler)
+	assert.Equal(t, true, result)
+}
+
+func createValidityWindowTxValidator(checkErr error, checkedRound *uint64, checkedEpoch *uint32) *dataValidators.TxValidator {
+	accounts := getAccAdapter(0, big.NewInt(10))
+	shardCoordinator := createMockCoordinator("_", 0)
+	blockChain := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Round: 7, Epoch: 2}
+		},
+	}
+	validityWindow := &mock.TxValidityWindowCheckerStub{
+		CheckValidityWindowCalled: func(tx *transaction.Transaction, round uint64, epoch uint32) error {
+			*checkedRound = round
+			*checkedEpoch = epoch
+			return checkErr
+		},
+	}
+	txValidator, _ := dataValidators.NewTxValidator(accounts, shardCoordinator, blockChain, validityWindow, 100)
+
+	return txValidator
+}
+
+func TestTxValidator_IsTxValidForProcessingTxExpiredShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	checkedRound := uint64(0)
+	checkedEpoch := uint32(0)
+	txValidator := createValidityWindowTxValidator(process.ErrTxExpired, &checkedRound, &checkedEpoch)
+
+	addressMock := mock.NewAddressMock([]byte("address"))
+	txValidatorHandler := getTxValidatorHandler(0, 1, addressMock, big.NewInt(0))
+
+	result := txValidator.IsTxValidForProcessing(txValidatorHandler)
+	assert.Equal(t, false, result)
+	assert.Equal(t, uint64(1), txValidator.NumRejectedTxs())
+	assert.Equal(t, uint64(7), checkedRound)
+	assert.Equal(t, uint32(2), checkedEpoch)
+}
+
+func TestTxValidator_IsTxValidForProcessingTxNotYetValidShouldReturnTrue(t *testing.T) {
+	t.Parallel()
+
+	checkedRound := uint64(0)
+	checkedEpoch := uint32(0)
+	txValidator := createValidityWindowTxValidator(process.ErrTxNotYetValid, &checkedRound, &checkedEpoch)
 
 	addressMock := mock.NewAddressMock([]byte("address"))
 	txValidatorHandler := getTxValidatorHandler(0, 1, addressMock, big.NewInt(0))
 
 	result := txValidator.IsTxValidForProcessing(txValidatorHandler)
 	assert.Equal(t, true, result)
+	assert.Equal(t, uint64(0), txValidator.NumRejectedTxs())
 }
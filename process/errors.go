@@ -301,6 +301,13 @@ var ErrNilRequestHeaderHandlerByNonce = errors.New("nil request header handler b
 // ErrLowerRoundInBlock signals that a header round is too low for processing it
 var ErrLowerRoundInBlock = errors.New("header round is lower than last committed")
 
+// ErrHeaderAlreadyCommitted signals that the header was already committed
+var ErrHeaderAlreadyCommitted = errors.New("header already committed")
+
+// ErrHeaderNonceAlreadyCommitted signals that another header with the same nonce, or a higher one, was already
+// committed
+var ErrHeaderNonceAlreadyCommitted = errors.New("header nonce already committed")
+
 // ErrRandSeedDoesNotMatch signals that random seed does not match with the previous one
 var ErrRandSeedDoesNotMatch = errors.New("random seed do not match")
 
@@ -451,6 +458,12 @@ var ErrRewardTxNotFound = errors.New("reward transaction not found")
 // ErrRewardTxsMismatchCreatedReceived signals a mismatch between the nb of created and received reward transactions
 var ErrRewardTxsMismatchCreatedReceived = errors.New("mismatch between created and received reward transactions")
 
+// ErrRewardTxsNotOrdered signals that the reward transactions are not ordered by receiver address and hash
+var ErrRewardTxsNotOrdered = errors.New("reward transactions are not ordered by receiver address and hash")
+
+// ErrRewardTxsAndHashesLengthMismatch signals that the number of reward transactions differs from the number of hashes
+var ErrRewardTxsAndHashesLengthMismatch = errors.New("mismatch between the number of reward transactions and hashes")
+
 // ErrNilTxTypeHandler signals that tx type handler is nil
 var ErrNilTxTypeHandler = errors.New("nil tx type handler")
 
@@ -469,6 +482,9 @@ var ErrOverallBalanceChangeFromSC = errors.New("SC output balance updates are wr
 // ErrNilTxsPoolsCleaner signals that a nil transactions pools cleaner has been provided
 var ErrNilTxsPoolsCleaner = errors.New("nil transactions pools cleaner")
 
+// ErrNilHeadersPoolsCleaner signals that a nil headers pools cleaner has been provided
+var ErrNilHeadersPoolsCleaner = errors.New("nil headers pools cleaner")
+
 // ErrZeroMaxCleanTime signals that cleaning time for pools is less or equal with 0
 var ErrZeroMaxCleanTime = errors.New("cleaning time is equal or less than zero")
 
@@ -501,3 +517,108 @@ var ErrInvalidRewardsValue = errors.New("invalid rewards value")
 
 // ErrInvalidRewardsPercentages signals that rewards percentages are not correct
 var ErrInvalidRewardsPercentages = errors.New("invalid rewards percentages")
+
+// ErrNilStateChangesAuditor signals that a nil smart contract state changes auditor has been provided
+var ErrNilStateChangesAuditor = errors.New("nil state changes auditor")
+
+// ErrStateChangesAuditDisabled signals that the smart contract state changes audit mode is not enabled
+var ErrStateChangesAuditDisabled = errors.New("smart contract state changes audit is disabled")
+
+// ErrNilBlockEconomicsHandler signals that a nil block economics handler has been provided
+var ErrNilBlockEconomicsHandler = errors.New("nil block economics handler")
+
+// ErrAccumulatedFeesDoNotMatch signals that the accumulated fees from the header do not match the computed ones
+var ErrAccumulatedFeesDoNotMatch = errors.New("accumulated fees do not match")
+
+// ErrRewardsDoNotMatch signals that the rewards from the header do not match the computed ones
+var ErrRewardsDoNotMatch = errors.New("rewards do not match")
+
+// ErrReplayNotSupportedOnMetachain signals that blocks can be replayed only on shard nodes
+var ErrReplayNotSupportedOnMetachain = errors.New("block replay is not supported on metachain")
+
+// ErrGenesisBlockCanNotBeReplayed signals that the genesis block, having no parent, can not be replayed
+var ErrGenesisBlockCanNotBeReplayed = errors.New("genesis block can not be replayed")
+
+// ErrNilMessageTracer signals that a nil message tracer has been provided
+var ErrNilMessageTracer = errors.New("nil message tracer")
+
+// ErrUpgradeNotAllowed signals that the code of a smart contract not deployed as upgradeable was about to be replaced
+var ErrUpgradeNotAllowed = errors.New("smart contract is not upgradeable")
+
+// ErrAccountNotPayable signals that value was sent to a smart contract not deployed as payable
+var ErrAccountNotPayable = errors.New("smart contract is not payable")
+
+// ErrAccountNotReadable signals that a smart contract not deployed as readable was queried
+var ErrAccountNotReadable = errors.New("smart contract is not readable")
+
+// ErrNotContractOwner signals that a smart contract was about to be upgraded by an account other than its owner
+var ErrNotContractOwner = errors.New("only the owner can upgrade the smart contract")
+
+// ErrNotEnoughArgumentsToUpgrade signals that the code metadata is missing from an upgrade transaction
+var ErrNotEnoughArgumentsToUpgrade = errors.New("not enough arguments to upgrade the smart contract")
+
+// ErrNilFinalityProof signals that an operation has been attempted to or with a nil finality proof
+var ErrNilFinalityProof = errors.New("nil finality proof")
+
+// ErrNilHeaderHash signals that a nil header hash has been provided
+var ErrNilHeaderHash = errors.New("nil header hash")
+
+// ErrNilSignedMessageHash signals that a nil signed message hash has been provided
+var ErrNilSignedMessageHash = errors.New("nil signed message hash")
+
+// ErrNilFinalityProofsDataPool signals that a nil finality proofs pool has been provided
+var ErrNilFinalityProofsDataPool = errors.New("nil finality proofs data pool")
+
+// ErrNilIndexer signals that a nil indexer has been provided
+var ErrNilIndexer = errors.New("nil indexer")
+
+// ErrNilSCDeploymentsIndexer signals that a nil smart contract deployments indexer has been provided
+var ErrNilSCDeploymentsIndexer = errors.New("nil smart contract deployments indexer")
+
+// ErrSCDeploymentsIndexDisabled signals that the smart contract deployments index is not enabled
+var ErrSCDeploymentsIndexDisabled = errors.New("smart contract deployments index is disabled")
+
+// ErrNilSCAddress signals that an operation has been attempted with a nil smart contract address
+var ErrNilSCAddress = errors.New("nil smart contract address")
+
+// ErrProtectedStorageKey signals that a smart contract tried to write a storage key reserved for the protocol
+var ErrProtectedStorageKey = errors.New("storage key is protected")
+
+// ErrNilInterceptor signals that a nil interceptor has been provided
+var ErrNilInterceptor = errors.New("nil interceptor")
+
+// ErrEmptyTopic signals that an empty topic has been provided
+var ErrEmptyTopic = errors.New("empty topic")
+
+// ErrTopicAlreadyRegistered signals that an interceptor has already been registered on the provided topic
+var ErrTopicAlreadyRegistered = errors.New("topic already registered")
+
+// ErrLeaderFeesDoNotMatch signals that the leader fees from the header do not match the computed ones
+var ErrLeaderFeesDoNotMatch = errors.New("leader fees do not match")
+
+// ErrCommunityFeesDoNotMatch signals that the community fees from the header do not match the computed ones
+var ErrCommunityFeesDoNotMatch = errors.New("community fees do not match")
+
+// ErrBurnedFeesDoNotMatch signals that the burned fees from the header do not match the computed ones
+var ErrBurnedFeesDoNotMatch = errors.New("burned fees do not match")
+
+// ErrNilBlockProposalPolicy signals that a nil block proposal policy has been provided
+var ErrNilBlockProposalPolicy = errors.New("nil block proposal policy")
+
+// ErrInvalidBlockProposalPercentage signals that a block proposal policy percentage is greater than 100
+var ErrInvalidBlockProposalPercentage = errors.New("invalid block proposal percentage")
+
+// ErrNilTxValidityWindowChecker signals that a nil transaction validity window checker has been provided
+var ErrNilTxValidityWindowChecker = errors.New("nil transaction validity window checker")
+
+// ErrNilEpochHandler signals that a nil epoch handler has been provided
+var ErrNilEpochHandler = errors.New("nil epoch handler")
+
+// ErrTxValidityWindowNotEnabled signals that a transaction sets a validity window before the epoch enabling them
+var ErrTxValidityWindowNotEnabled = errors.New("transaction validity windows are not enabled yet")
+
+// ErrTxNotYetValid signals that a transaction can not be executed before its validity window starts
+var ErrTxNotYetValid = errors.New("transaction is not yet valid")
+
+// ErrTxExpired signals that a transaction can not be executed after its validity window ended
+var ErrTxExpired = errors.New("transaction validity window has ended")
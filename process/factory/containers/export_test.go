@@ -3,7 +3,16 @@ package containers
 import "github.com/ElrondNetwork/elrond-go/data/block"
 
 func (ic *interceptorsContainer) Insert(key string, value interface{}) bool {
-	return ic.objects.Insert(key, value)
+	ic.mut.Lock()
+	defer ic.mut.Unlock()
+
+	_, found := ic.objects[key]
+	if found {
+		return false
+	}
+
+	ic.objects[key] = newInterceptorEntry(key, value)
+	return true
 }
 
 func (ppc *preProcessorsContainer) Insert(key block.Type, value interface{}) bool {
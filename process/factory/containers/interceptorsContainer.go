@@ -1,31 +1,53 @@
 package containers
 
 import (
+	"fmt"
+	"sync"
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/process"
-	"github.com/cornelk/hashmap"
 )
 
+type interceptorEntry struct {
+	interceptor interface{}
+	metadata    process.ContainerElementMetadata
+}
+
 // interceptorsContainer is an interceptors holder organized by type
 type interceptorsContainer struct {
-	objects *hashmap.HashMap
+	mut     sync.RWMutex
+	objects map[string]*interceptorEntry
 }
 
 // NewInterceptorsContainer will create a new instance of a container
 func NewInterceptorsContainer() *interceptorsContainer {
 	return &interceptorsContainer{
-		objects: &hashmap.HashMap{},
+		objects: make(map[string]*interceptorEntry),
+	}
+}
+
+func newInterceptorEntry(key string, interceptor interface{}) *interceptorEntry {
+	return &interceptorEntry{
+		interceptor: interceptor,
+		metadata: process.ContainerElementMetadata{
+			Topic:     key,
+			Type:      fmt.Sprintf("%T", interceptor),
+			CreatedAt: time.Now(),
+		},
 	}
 }
 
 // Get returns the object stored at a certain key.
 // Returns an error if the element does not exist
 func (ic *interceptorsContainer) Get(key string) (process.Interceptor, error) {
-	value, ok := ic.objects.Get(key)
+	ic.mut.RLock()
+	entry, ok := ic.objects[key]
+	ic.mut.RUnlock()
 	if !ok {
 		return nil, process.ErrInvalidContainerKey
 	}
 
-	interceptor, ok := value.(process.Interceptor)
+	interceptor, ok := entry.interceptor.(process.Interceptor)
 	if !ok {
 		return nil, process.ErrWrongTypeInContainer
 	}
@@ -36,29 +58,43 @@ func (ic *interceptorsContainer) Get(key string) (process.Interceptor, error) {
 // Add will add an object at a given key. Returns
 // an error if the element already exists
 func (ic *interceptorsContainer) Add(key string, interceptor process.Interceptor) error {
+	ic.mut.Lock()
+	defer ic.mut.Unlock()
+
+	return ic.addUnprotected(key, interceptor)
+}
+
+func (ic *interceptorsContainer) addUnprotected(key string, interceptor process.Interceptor) error {
 	if interceptor == nil || interceptor.IsInterfaceNil() {
 		return process.ErrNilContainerElement
 	}
 
-	ok := ic.objects.Insert(key, interceptor)
-
-	if !ok {
+	_, found := ic.objects[key]
+	if found {
 		return process.ErrContainerKeyAlreadyExists
 	}
 
+	ic.objects[key] = newInterceptorEntry(key, interceptor)
 	return nil
 }
 
 // AddMultiple will add objects with given keys. Returns
-// an error if one element already exists, lengths mismatch or an interceptor is nil
+// an error if one element already exists, lengths mismatch or an interceptor is nil.
+// The objects are added all at once, on error none of them is added
 func (ic *interceptorsContainer) AddMultiple(keys []string, interceptors []process.Interceptor) error {
 	if len(keys) != len(interceptors) {
 		return process.ErrLenMismatch
 	}
 
+	ic.mut.Lock()
+	defer ic.mut.Unlock()
+
 	for idx, key := range keys {
-		err := ic.Add(key, interceptors[idx])
+		err := ic.addUnprotected(key, interceptors[idx])
 		if err != nil {
+			for _, addedKey := range keys[:idx] {
+				delete(ic.objects, addedKey)
+			}
 			return err
 		}
 	}
@@ -66,24 +102,86 @@ func (ic *interceptorsContainer) AddMultiple(keys []string, interceptors []proce
 	return nil
 }
 
-// Replace will add (or replace if it already exists) an object at a given key
+// Replace will add (or replace if it already exists) an object at a given key. The readers of the container see
+// either the old or the new object, never a missing one
 func (ic *interceptorsContainer) Replace(key string, interceptor process.Interceptor) error {
 	if interceptor == nil || interceptor.IsInterfaceNil() {
 		return process.ErrNilContainerElement
 	}
 
-	ic.objects.Set(key, interceptor)
+	ic.mut.Lock()
+	ic.objects[key] = newInterceptorEntry(key, interceptor)
+	ic.mut.Unlock()
+
 	return nil
 }
 
 // Remove will remove an object at a given key
 func (ic *interceptorsContainer) Remove(key string) {
-	ic.objects.Del(key)
+	ic.mut.Lock()
+	delete(ic.objects, key)
+	ic.mut.Unlock()
 }
 
 // Len returns the length of the added objects
 func (ic *interceptorsContainer) Len() int {
-	return ic.objects.Len()
+	ic.mut.RLock()
+	defer ic.mut.RUnlock()
+
+	return len(ic.objects)
+}
+
+// Keys returns all the keys from the container
+func (ic *interceptorsContainer) Keys() []string {
+	ic.mut.RLock()
+	defer ic.mut.RUnlock()
+
+	keys := make([]string, 0, len(ic.objects))
+	for key := range ic.objects {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Iterate calls the handler for each object of the container until the handler returns false. The objects are
+// taken from a snapshot of the container, so the handler is free to modify the container
+func (ic *interceptorsContainer) Iterate(handler func(key string, interceptor process.Interceptor) bool) {
+	if handler == nil {
+		return
+	}
+
+	ic.mut.RLock()
+	snapshot := make(map[string]process.Interceptor, len(ic.objects))
+	for key, entry := range ic.objects {
+		interceptor, ok := entry.interceptor.(process.Interceptor)
+		if !ok {
+			continue
+		}
+
+		snapshot[key] = interceptor
+	}
+	ic.mut.RUnlock()
+
+	for key, interceptor := range snapshot {
+		shouldContinue := handler(key, interceptor)
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
+// Metadata returns the information recorded when the object stored at a certain key was added or replaced.
+// Returns an error if the element does not exist
+func (ic *interceptorsContainer) Metadata(key string) (process.ContainerElementMetadata, error) {
+	ic.mut.RLock()
+	defer ic.mut.RUnlock()
+
+	entry, ok := ic.objects[key]
+	if !ok {
+		return process.ContainerElementMetadata{}, process.ErrInvalidContainerKey
+	}
+
+	return entry.metadata, nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -1,7 +1,10 @@
 package containers_test
 
 import (
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory/containers"
@@ -212,3 +215,191 @@ func TestInterceptorsContainer_LenShouldWork(t *testing.T) {
 	c.Remove("key1")
 	assert.Equal(t, 1, c.Len())
 }
+
+//------- Keys
+
+func TestInterceptorsContainer_KeysShouldWork(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewInterceptorsContainer()
+
+	_ = c.Add("key1", &mock.InterceptorStub{})
+	_ = c.Add("key2", &mock.InterceptorStub{})
+
+	keys := c.Keys()
+
+	assert.Equal(t, 2, len(keys))
+	assert.Contains(t, keys, "key1")
+	assert.Contains(t, keys, "key2")
+}
+
+func TestInterceptorsContainer_AddMultipleAlreadyExistingShouldNotAddAny(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewInterceptorsContainer()
+	_ = c.Add("key2", &mock.InterceptorStub{})
+
+	keys := []string{"key1", "key2"}
+	interceptors := []process.Interceptor{&mock.InterceptorStub{}, &mock.InterceptorStub{}}
+
+	err := c.AddMultiple(keys, interceptors)
+
+	assert.Equal(t, process.ErrContainerKeyAlreadyExists, err)
+	assert.Equal(t, 1, c.Len())
+	_, err = c.Get("key1")
+	assert.Equal(t, process.ErrInvalidContainerKey, err)
+}
+
+//------- Iterate
+
+func TestInterceptorsContainer_IterateNilHandlerShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		assert.Nil(t, r)
+	}()
+
+	c := containers.NewInterceptorsContainer()
+	_ = c.Add("key", &mock.InterceptorStub{})
+
+	c.Iterate(nil)
+}
+
+func TestInterceptorsContainer_IterateShouldVisitAll(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewInterceptorsContainer()
+	val1 := &mock.InterceptorStub{}
+	val2 := &mock.InterceptorStub{}
+	_ = c.Add("key1", val1)
+	_ = c.Add("key2", val2)
+
+	visited := make(map[string]process.Interceptor)
+	c.Iterate(func(key string, interceptor process.Interceptor) bool {
+		visited[key] = interceptor
+		return true
+	})
+
+	assert.Equal(t, 2, len(visited))
+	assert.True(t, visited["key1"] == val1)
+	assert.True(t, visited["key2"] == val2)
+}
+
+func TestInterceptorsContainer_IterateHandlerReturnsFalseShouldStop(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewInterceptorsContainer()
+	_ = c.Add("key1", &mock.InterceptorStub{})
+	_ = c.Add("key2", &mock.InterceptorStub{})
+
+	numVisited := 0
+	c.Iterate(func(key string, interceptor process.Interceptor) bool {
+		numVisited++
+		return false
+	})
+
+	assert.Equal(t, 1, numVisited)
+}
+
+func TestInterceptorsContainer_IterateShouldAllowModifyingTheContainer(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewInterceptorsContainer()
+	_ = c.Add("key1", &mock.InterceptorStub{})
+	_ = c.Add("key2", &mock.InterceptorStub{})
+
+	numVisited := 0
+	c.Iterate(func(key string, interceptor process.Interceptor) bool {
+		numVisited++
+		c.Remove(key)
+		_ = c.Add(key+"_new", &mock.InterceptorStub{})
+		return true
+	})
+
+	assert.Equal(t, 2, numVisited)
+	assert.Equal(t, 2, c.Len())
+	assert.Contains(t, c.Keys(), "key1_new")
+	assert.Contains(t, c.Keys(), "key2_new")
+}
+
+//------- Metadata
+
+func TestInterceptorsContainer_MetadataNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewInterceptorsContainer()
+
+	_, err := c.Metadata("key")
+
+	assert.Equal(t, process.ErrInvalidContainerKey, err)
+}
+
+func TestInterceptorsContainer_MetadataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewInterceptorsContainer()
+	before := time.Now()
+	_ = c.Add("key", &mock.InterceptorStub{})
+
+	metadata, err := c.Metadata("key")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "key", metadata.Topic)
+	assert.Equal(t, "*mock.InterceptorStub", metadata.Type)
+	assert.False(t, metadata.CreatedAt.Before(before))
+}
+
+func TestInterceptorsContainer_ReplaceShouldRecordNewMetadata(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewInterceptorsContainer()
+	_ = c.Add("key", &mock.InterceptorStub{})
+	oldMetadata, _ := c.Metadata("key")
+
+	time.Sleep(time.Millisecond)
+	_ = c.Replace("key", &mock.InterceptorStub{})
+	newMetadata, err := c.Metadata("key")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "key", newMetadata.Topic)
+	assert.True(t, newMetadata.CreatedAt.After(oldMetadata.CreatedAt))
+}
+
+//------- Concurrency
+
+func TestInterceptorsContainer_ConcurrentAccessShouldWork(t *testing.T) {
+	t.Parallel()
+
+	c := containers.NewInterceptorsContainer()
+	numGoRoutines := 50
+	wg := &sync.WaitGroup{}
+	wg.Add(numGoRoutines)
+	for i := 0; i < numGoRoutines; i++ {
+		go func(idx int) {
+			key := fmt.Sprintf("key%d", idx%5)
+			switch idx % 6 {
+			case 0:
+				_ = c.Add(key, &mock.InterceptorStub{})
+			case 1:
+				_ = c.Replace(key, &mock.InterceptorStub{})
+			case 2:
+				c.Remove(key)
+			case 3:
+				_, _ = c.Get(key)
+				_, _ = c.Metadata(key)
+			case 4:
+				c.Iterate(func(key string, interceptor process.Interceptor) bool {
+					return true
+				})
+			case 5:
+				_ = c.Keys()
+				_ = c.Len()
+			}
+			wg.Done()
+		}(i)
+	}
+
+	wg.Wait()
+	assert.True(t, c.Len() <= 5)
+}
@@ -17,6 +17,8 @@ const (
 	MetachainBlocksTopic = "metachainBlocks"
 	// ShardHeadersForMetachainTopic is used for sharing shards block headers to the metachain nodes
 	ShardHeadersForMetachainTopic = "shardHeadersForMetachain"
+	// FinalityProofsTopic is used for sharing the finality proofs of the shards and metachain headers with all nodes
+	FinalityProofsTopic = "finalityProofs"
 )
 
 // SystemVirtualMachine is a byte array identifier for the smart contract address created for system VM
@@ -4,6 +4,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -18,6 +19,7 @@ import (
 )
 
 const maxGoRoutineTxInterceptor = 100
+const maxGoRoutineCustomInterceptor = 100
 
 type interceptorsContainerFactory struct {
 	accounts               state.AccountsAdapter
@@ -26,6 +28,9 @@ type interceptorsContainerFactory struct {
 	keyGen                 crypto.KeyGenerator
 	maxTxNonceDeltaAllowed int
 	txFeeHandler           process.FeeHandler
+	headerValidator        process.HeaderValidator
+	blockChain             data.ChainHandler
+	validityWindow         process.TxValidityWindowChecker
 	txInterceptorThrottler process.InterceptorThrottler
 	marshalizer            marshal.Marshalizer
 	hasher                 hashing.Hasher
@@ -36,6 +41,8 @@ type interceptorsContainerFactory struct {
 	messenger              process.TopicHandler
 	multiSigner            crypto.MultiSigner
 	tpsBenchmark           *statistics.TpsBenchmark
+	customTopics           []string
+	customInterceptors     map[string]process.Interceptor
 }
 
 // NewInterceptorsContainerFactory is responsible for creating a new interceptors factory object
@@ -54,6 +61,9 @@ func NewInterceptorsContainerFactory(
 	keyGen crypto.KeyGenerator,
 	maxTxNonceDeltaAllowed int,
 	txFeeHandler process.FeeHandler,
+	headerValidator process.HeaderValidator,
+	blockChain data.ChainHandler,
+	validityWindow process.TxValidityWindowChecker,
 ) (*interceptorsContainerFactory, error) {
 
 	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
@@ -95,6 +105,15 @@ func NewInterceptorsContainerFactory(
 	if txFeeHandler == nil || txFeeHandler.IsInterfaceNil() {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if headerValidator == nil || headerValidator.IsInterfaceNil() {
+		return nil, process.ErrNilHeaderHandlerValidator
+	}
+	if blockChain == nil || blockChain.IsInterfaceNil() {
+		return nil, process.ErrNilBlockChain
+	}
+	if validityWindow == nil || validityWindow.IsInterfaceNil() {
+		return nil, process.ErrNilTxValidityWindowChecker
+	}
 
 	txInterceptorThrottler, err := throttler.NewNumGoRoutineThrottler(maxGoRoutineTxInterceptor)
 	if err != nil {
@@ -108,6 +127,9 @@ func NewInterceptorsContainerFactory(
 		keyGen:                 keyGen,
 		maxTxNonceDeltaAllowed: maxTxNonceDeltaAllowed,
 		txFeeHandler:           txFeeHandler,
+		headerValidator:        headerValidator,
+		blockChain:             blockChain,
+		validityWindow:         validityWindow,
 		txInterceptorThrottler: txInterceptorThrottler,
 		shardCoordinator:       shardCoordinator,
 		nodesCoordinator:       nodesCoordinator,
@@ -117,6 +139,8 @@ func NewInterceptorsContainerFactory(
 		hasher:                 hasher,
 		multiSigner:            multiSigner,
 		dataPool:               dataPool,
+		customTopics:           make([]string, 0),
+		customInterceptors:     make(map[string]process.Interceptor),
 	}, nil
 }
 
@@ -160,9 +184,40 @@ func (icf *interceptorsContainerFactory) Create() (process.InterceptorsContainer
 		return nil, err
 	}
 
+	keys, interceptorSlice, err = icf.generateCustomInterceptors()
+	if err != nil {
+		return nil, err
+	}
+	err = container.AddMultiple(keys, interceptorSlice)
+	if err != nil {
+		return nil, err
+	}
+
 	return container, nil
 }
 
+// RegisterCustomInterceptor registers an interceptor for a custom topic, so that applications embedding the node can
+// intercept their own message types. The custom interceptors are created along the built-in ones when Create is called,
+// each of them being throttled on its own and exposed for message tracing and throttler saturation reporting
+func (icf *interceptorsContainerFactory) RegisterCustomInterceptor(topic string, interceptor process.Interceptor) error {
+	if len(topic) == 0 {
+		return process.ErrEmptyTopic
+	}
+	if interceptor == nil || interceptor.IsInterfaceNil() {
+		return process.ErrNilInterceptor
+	}
+
+	_, ok := icf.customInterceptors[topic]
+	if ok {
+		return process.ErrTopicAlreadyRegistered
+	}
+
+	icf.customTopics = append(icf.customTopics, topic)
+	icf.customInterceptors[topic] = interceptor
+
+	return nil
+}
+
 func (icf *interceptorsContainerFactory) createTopicAndAssignHandler(
 	topic string,
 	interceptor process.Interceptor,
@@ -182,18 +237,11 @@ func (icf *interceptorsContainerFactory) createTopicAndAssignHandler(
 func (icf *interceptorsContainerFactory) generateMetablockInterceptor() ([]string, []process.Interceptor, error) {
 	identifierHdr := factory.MetachainBlocksTopic
 
-	//TODO implement other HeaderHandlerProcessValidator that will check the header's nonce
-	// against blockchain's latest nonce - k finality
-	hdrValidator, err := dataValidators.NewNilHeaderValidator()
-	if err != nil {
-		return nil, nil, err
-	}
-
 	interceptor, err := interceptors.NewMetachainHeaderInterceptor(
 		icf.marshalizer,
 		icf.dataPool.MetaBlocks(),
 		icf.dataPool.HeadersNonces(),
-		hdrValidator,
+		icf.headerValidator,
 		icf.multiSigner,
 		icf.hasher,
 		icf.shardCoordinator,
@@ -235,18 +283,11 @@ func (icf *interceptorsContainerFactory) generateShardHeaderInterceptors() ([]st
 }
 
 func (icf *interceptorsContainerFactory) createOneShardHeaderInterceptor(identifier string) (process.Interceptor, error) {
-	//TODO implement other HeaderHandlerProcessValidator that will check the header's nonce
-	// against blockchain's latest nonce - k finality
-	hdrValidator, err := dataValidators.NewNilHeaderValidator()
-	if err != nil {
-		return nil, err
-	}
-
 	interceptor, err := interceptors.NewHeaderInterceptor(
 		icf.marshalizer,
 		icf.dataPool.ShardHeaders(),
 		icf.dataPool.HeadersNonces(),
-		hdrValidator,
+		icf.headerValidator,
 		icf.multiSigner,
 		icf.hasher,
 		icf.shardCoordinator,
@@ -295,7 +336,13 @@ func (icf *interceptorsContainerFactory) generateTxInterceptors() ([]string, []p
 }
 
 func (icf *interceptorsContainerFactory) createOneTxInterceptor(identifier string) (process.Interceptor, error) {
-	txValidator, err := dataValidators.NewTxValidator(icf.accounts, icf.shardCoordinator, icf.maxTxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(
+		icf.accounts,
+		icf.shardCoordinator,
+		icf.blockChain,
+		icf.validityWindow,
+		icf.maxTxNonceDeltaAllowed,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -371,6 +418,35 @@ func (icf *interceptorsContainerFactory) createOneMiniBlocksInterceptor(identifi
 	return icf.createTopicAndAssignHandler(identifier, interceptor, true)
 }
 
+//------- Custom interceptors
+
+func (icf *interceptorsContainerFactory) generateCustomInterceptors() ([]string, []process.Interceptor, error) {
+	keys := make([]string, len(icf.customTopics))
+	interceptorSlice := make([]process.Interceptor, len(icf.customTopics))
+
+	for idx, topic := range icf.customTopics {
+		customThrottler, err := throttler.NewNumGoRoutineThrottler(maxGoRoutineCustomInterceptor)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		interceptor, err := interceptors.NewThrottledInterceptor(icf.customInterceptors[topic], customThrottler)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		_, err = icf.createTopicAndAssignHandler(topic, interceptor, true)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		keys[idx] = topic
+		interceptorSlice[idx] = interceptor
+	}
+
+	return keys, interceptorSlice, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (icf *interceptorsContainerFactory) IsInterfaceNil() bool {
 	if icf == nil {
@@ -1,6 +1,7 @@
 package metachain_test
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -99,6 +100,9 @@ func TestNewInterceptorsContainerFactory_NilShardCoordinatorShouldErr(t *testing
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -123,6 +127,9 @@ func TestNewInterceptorsContainerFactory_NilNodesCoordinatorShouldErr(t *testing
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -147,6 +154,9 @@ func TestNewInterceptorsContainerFactory_NilTopicHandlerShouldErr(t *testing.T)
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -171,6 +181,9 @@ func TestNewInterceptorsContainerFactory_NilBlockchainShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -195,6 +208,9 @@ func TestNewInterceptorsContainerFactory_NilMarshalizerShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -219,6 +235,9 @@ func TestNewInterceptorsContainerFactory_NilHasherShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -243,6 +262,9 @@ func TestNewInterceptorsContainerFactory_NilMultiSignerShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -267,6 +289,9 @@ func TestNewInterceptorsContainerFactory_NilDataPoolShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -291,6 +316,9 @@ func TestNewInterceptorsContainerFactory_NilAccountsShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -315,6 +343,9 @@ func TestNewInterceptorsContainerFactory_NilAddrConvShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -339,6 +370,9 @@ func TestNewInterceptorsContainerFactory_NilSingleSignerShouldErr(t *testing.T)
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -363,6 +397,9 @@ func TestNewInterceptorsContainerFactory_NilKeyGenShouldErr(t *testing.T) {
 		nil,
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -387,12 +424,96 @@ func TestNewInterceptorsContainerFactory_NilFeeHandlerShouldErr(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		nil,
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
+func TestNewInterceptorsContainerFactory_NilHeaderValidatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := metachain.NewInterceptorsContainerFactory(
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		mock.NewMultiSigner(),
+		createDataPools(),
+		&mock.AccountsStub{},
+		&mock.AddressConverterMock{},
+		&mock.SignerMock{},
+		&mock.SingleSignKeyGenMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		nil,
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilHeaderHandlerValidator, err)
+}
+
+func TestNewInterceptorsContainerFactory_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := metachain.NewInterceptorsContainerFactory(
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		mock.NewMultiSigner(),
+		createDataPools(),
+		&mock.AccountsStub{},
+		&mock.AddressConverterMock{},
+		&mock.SignerMock{},
+		&mock.SingleSignKeyGenMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		nil,
+		&mock.TxValidityWindowCheckerStub{},
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilBlockChain, err)
+}
+
+func TestNewInterceptorsContainerFactory_NilTxValidityWindowCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := metachain.NewInterceptorsContainerFactory(
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		mock.NewMultiSigner(),
+		createDataPools(),
+		&mock.AccountsStub{},
+		&mock.AddressConverterMock{},
+		&mock.SignerMock{},
+		&mock.SingleSignKeyGenMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		nil,
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilTxValidityWindowChecker, err)
+}
+
 func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -411,6 +532,9 @@ func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.NotNil(t, icf)
@@ -437,6 +561,9 @@ func TestInterceptorsContainerFactory_CreateTopicMetablocksFailsShouldErr(t *tes
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, err := icf.Create()
@@ -463,6 +590,9 @@ func TestInterceptorsContainerFactory_CreateTopicShardHeadersForMetachainFailsSh
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, err := icf.Create()
@@ -489,6 +619,9 @@ func TestInterceptorsContainerFactory_CreateRegisterForMetablocksFailsShouldErr(
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, err := icf.Create()
@@ -515,6 +648,9 @@ func TestInterceptorsContainerFactory_CreateRegisterShardHeadersForMetachainFail
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, err := icf.Create()
@@ -548,6 +684,9 @@ func TestInterceptorsContainerFactory_CreateShouldWork(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, err := icf.Create()
@@ -594,6 +733,9 @@ func TestInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 		&mock.SingleSignKeyGenMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, _ := icf.Create()
@@ -607,3 +749,114 @@ func TestInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 
 	assert.Equal(t, totalInterceptors, container.Len())
 }
+
+//------- RegisterCustomInterceptor
+
+const customTopic = "customTopic"
+
+func createInterceptorsContainerFactoryWithTopicHandler(topicHandler process.TopicHandler) process.InterceptorsContainerFactory {
+	icf, _ := metachain.NewInterceptorsContainerFactory(
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		topicHandler,
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		mock.NewMultiSigner(),
+		createDataPools(),
+		&mock.AccountsStub{},
+		&mock.AddressConverterMock{},
+		&mock.SignerMock{},
+		&mock.SingleSignKeyGenMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
+	)
+
+	return icf
+}
+
+func TestInterceptorsContainerFactory_RegisterCustomInterceptorEmptyTopicShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf := createInterceptorsContainerFactoryWithTopicHandler(createStubTopicHandler("", ""))
+
+	err := icf.RegisterCustomInterceptor("", &mock.InterceptorStub{})
+
+	assert.Equal(t, process.ErrEmptyTopic, err)
+}
+
+func TestInterceptorsContainerFactory_RegisterCustomInterceptorNilInterceptorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf := createInterceptorsContainerFactoryWithTopicHandler(createStubTopicHandler("", ""))
+
+	err := icf.RegisterCustomInterceptor(customTopic, nil)
+
+	assert.Equal(t, process.ErrNilInterceptor, err)
+}
+
+func TestInterceptorsContainerFactory_RegisterCustomInterceptorTwiceOnTheSameTopicShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf := createInterceptorsContainerFactoryWithTopicHandler(createStubTopicHandler("", ""))
+
+	err := icf.RegisterCustomInterceptor(customTopic, &mock.InterceptorStub{})
+	assert.Nil(t, err)
+
+	err = icf.RegisterCustomInterceptor(customTopic, &mock.InterceptorStub{})
+	assert.Equal(t, process.ErrTopicAlreadyRegistered, err)
+}
+
+func TestInterceptorsContainerFactory_CreateTopicCreationCustomInterceptorFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf := createInterceptorsContainerFactoryWithTopicHandler(createStubTopicHandler(customTopic, ""))
+	_ = icf.RegisterCustomInterceptor(customTopic, &mock.InterceptorStub{})
+
+	container, err := icf.Create()
+
+	assert.Nil(t, container)
+	assert.Equal(t, errExpected, err)
+}
+
+func TestInterceptorsContainerFactory_CreateRegisterCustomInterceptorFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf := createInterceptorsContainerFactoryWithTopicHandler(createStubTopicHandler("", customTopic))
+	_ = icf.RegisterCustomInterceptor(customTopic, &mock.InterceptorStub{})
+
+	container, err := icf.Create()
+
+	assert.Nil(t, container)
+	assert.Equal(t, errExpected, err)
+}
+
+func TestInterceptorsContainerFactory_CreateWithCustomInterceptorShouldAddItThrottled(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	icf := createInterceptorsContainerFactoryWithTopicHandler(createStubTopicHandler("", ""))
+	_ = icf.RegisterCustomInterceptor(customTopic, &mock.InterceptorStub{
+		ProcessReceivedMessageCalled: func(message p2p.MessageP2P) error {
+			wasCalled = true
+			return nil
+		},
+	})
+
+	container, err := icf.Create()
+	assert.Nil(t, err)
+
+	interceptor, err := container.Get(customTopic)
+	assert.Nil(t, err)
+
+	throttledInterceptor, ok := interceptor.(process.ThrottledInterceptor)
+	assert.True(t, ok)
+	assert.NotNil(t, throttledInterceptor.Throttler())
+
+	err = interceptor.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{})
+	assert.Nil(t, err)
+	assert.True(t, wasCalled)
+}
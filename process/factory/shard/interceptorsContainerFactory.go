@@ -3,6 +3,7 @@ package shard
 import (
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -19,6 +20,7 @@ import (
 )
 
 const maxGoRoutineTxInterceptor = 100
+const maxGoRoutineCustomInterceptor = 100
 
 type interceptorsContainerFactory struct {
 	accounts               state.AccountsAdapter
@@ -36,6 +38,11 @@ type interceptorsContainerFactory struct {
 	txInterceptorThrottler process.InterceptorThrottler
 	maxTxNonceDeltaAllowed int
 	txFeeHandler           process.FeeHandler
+	headerValidator        process.HeaderValidator
+	blockChain             data.ChainHandler
+	validityWindow         process.TxValidityWindowChecker
+	customTopics           []string
+	customInterceptors     map[string]process.Interceptor
 }
 
 // NewInterceptorsContainerFactory is responsible for creating a new interceptors factory object
@@ -54,6 +61,9 @@ func NewInterceptorsContainerFactory(
 	addrConverter state.AddressConverter,
 	maxTxNonceDeltaAllowed int,
 	txFeeHandler process.FeeHandler,
+	headerValidator process.HeaderValidator,
+	blockChain data.ChainHandler,
+	validityWindow process.TxValidityWindowChecker,
 ) (*interceptorsContainerFactory, error) {
 	if accounts == nil || accounts.IsInterfaceNil() {
 		return nil, process.ErrNilAccountsAdapter
@@ -94,6 +104,15 @@ func NewInterceptorsContainerFactory(
 	if txFeeHandler == nil || txFeeHandler.IsInterfaceNil() {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if headerValidator == nil || headerValidator.IsInterfaceNil() {
+		return nil, process.ErrNilHeaderHandlerValidator
+	}
+	if blockChain == nil || blockChain.IsInterfaceNil() {
+		return nil, process.ErrNilBlockChain
+	}
+	if validityWindow == nil || validityWindow.IsInterfaceNil() {
+		return nil, process.ErrNilTxValidityWindowChecker
+	}
 
 	txInterceptorThrottler, err := throttler.NewNumGoRoutineThrottler(maxGoRoutineTxInterceptor)
 	if err != nil {
@@ -116,6 +135,11 @@ func NewInterceptorsContainerFactory(
 		txInterceptorThrottler: txInterceptorThrottler,
 		maxTxNonceDeltaAllowed: maxTxNonceDeltaAllowed,
 		txFeeHandler:           txFeeHandler,
+		headerValidator:        headerValidator,
+		blockChain:             blockChain,
+		validityWindow:         validityWindow,
+		customTopics:           make([]string, 0),
+		customInterceptors:     make(map[string]process.Interceptor),
 	}, nil
 }
 
@@ -193,9 +217,41 @@ func (icf *interceptorsContainerFactory) Create() (process.InterceptorsContainer
 		return nil, err
 	}
 
+	keys, interceptorSlice, err = icf.generateCustomInterceptors()
+	if err != nil {
+		return nil, err
+	}
+
+	err = container.AddMultiple(keys, interceptorSlice)
+	if err != nil {
+		return nil, err
+	}
+
 	return container, nil
 }
 
+// RegisterCustomInterceptor registers an interceptor for a custom topic, so that applications embedding the node can
+// intercept their own message types. The custom interceptors are created along the built-in ones when Create is called,
+// each of them being throttled on its own and exposed for message tracing and throttler saturation reporting
+func (icf *interceptorsContainerFactory) RegisterCustomInterceptor(topic string, interceptor process.Interceptor) error {
+	if len(topic) == 0 {
+		return process.ErrEmptyTopic
+	}
+	if interceptor == nil || interceptor.IsInterfaceNil() {
+		return process.ErrNilInterceptor
+	}
+
+	_, ok := icf.customInterceptors[topic]
+	if ok {
+		return process.ErrTopicAlreadyRegistered
+	}
+
+	icf.customTopics = append(icf.customTopics, topic)
+	icf.customInterceptors[topic] = interceptor
+
+	return nil
+}
+
 func (icf *interceptorsContainerFactory) createTopicAndAssignHandler(
 	topic string,
 	interceptor process.Interceptor,
@@ -246,7 +302,13 @@ func (icf *interceptorsContainerFactory) generateTxInterceptors() ([]string, []p
 }
 
 func (icf *interceptorsContainerFactory) createOneTxInterceptor(identifier string) (process.Interceptor, error) {
-	txValidator, err := dataValidators.NewTxValidator(icf.accounts, icf.shardCoordinator, icf.maxTxNonceDeltaAllowed)
+	txValidator, err := dataValidators.NewTxValidator(
+		icf.accounts,
+		icf.shardCoordinator,
+		icf.blockChain,
+		icf.validityWindow,
+		icf.maxTxNonceDeltaAllowed,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -381,12 +443,6 @@ func (icf *interceptorsContainerFactory) createOneUnsignedTxInterceptor(identifi
 
 func (icf *interceptorsContainerFactory) generateHdrInterceptor() ([]string, []process.Interceptor, error) {
 	shardC := icf.shardCoordinator
-	//TODO implement other HeaderHandlerProcessValidator that will check the header's nonce
-	// against blockchain's latest nonce - k finality
-	hdrValidator, err := dataValidators.NewNilHeaderValidator()
-	if err != nil {
-		return nil, nil, err
-	}
 
 	//only one intrashard header topic
 	identifierHdr := factory.HeadersTopic + shardC.CommunicationIdentifier(shardC.SelfId())
@@ -394,7 +450,7 @@ func (icf *interceptorsContainerFactory) generateHdrInterceptor() ([]string, []p
 		icf.marshalizer,
 		icf.dataPool.Headers(),
 		icf.dataPool.HeadersNonces(),
-		hdrValidator,
+		icf.headerValidator,
 		icf.multiSigner,
 		icf.hasher,
 		icf.shardCoordinator,
@@ -493,18 +549,11 @@ func (icf *interceptorsContainerFactory) generatePeerChBlockBodyInterceptor() ([
 
 func (icf *interceptorsContainerFactory) generateMetachainHeaderInterceptor() ([]string, []process.Interceptor, error) {
 	identifierHdr := factory.MetachainBlocksTopic
-	//TODO implement other HeaderHandlerProcessValidator that will check the header's nonce
-	// against blockchain's latest nonce - k finality
-	hdrValidator, err := dataValidators.NewNilHeaderValidator()
-	if err != nil {
-		return nil, nil, err
-	}
-
 	interceptor, err := interceptors.NewMetachainHeaderInterceptor(
 		icf.marshalizer,
 		icf.dataPool.MetaBlocks(),
 		icf.dataPool.HeadersNonces(),
-		hdrValidator,
+		icf.headerValidator,
 		icf.multiSigner,
 		icf.hasher,
 		icf.shardCoordinator,
@@ -521,6 +570,35 @@ func (icf *interceptorsContainerFactory) generateMetachainHeaderInterceptor() ([
 	return []string{identifierHdr}, []process.Interceptor{interceptor}, nil
 }
 
+//------- Custom interceptors
+
+func (icf *interceptorsContainerFactory) generateCustomInterceptors() ([]string, []process.Interceptor, error) {
+	keys := make([]string, len(icf.customTopics))
+	interceptorSlice := make([]process.Interceptor, len(icf.customTopics))
+
+	for idx, topic := range icf.customTopics {
+		customThrottler, err := throttler.NewNumGoRoutineThrottler(maxGoRoutineCustomInterceptor)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		interceptor, err := interceptors.NewThrottledInterceptor(icf.customInterceptors[topic], customThrottler)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		_, err = icf.createTopicAndAssignHandler(topic, interceptor, true)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		keys[idx] = topic
+		interceptorSlice[idx] = interceptor
+	}
+
+	return keys, interceptorSlice, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (icf *interceptorsContainerFactory) IsInterfaceNil() bool {
 	if icf == nil {
@@ -1,6 +1,7 @@
 package shard_test
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -100,6 +101,9 @@ func TestNewInterceptorsContainerFactory_NilAccountsAdapter(t *testing.T) {
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -124,6 +128,9 @@ func TestNewInterceptorsContainerFactory_NilShardCoordinatorShouldErr(t *testing
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -148,6 +155,9 @@ func TestNewInterceptorsContainerFactory_NilNodesCoordinatorShouldErr(t *testing
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -172,6 +182,9 @@ func TestNewInterceptorsContainerFactory_NilTopicHandlerShouldErr(t *testing.T)
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -196,6 +209,9 @@ func TestNewInterceptorsContainerFactory_NilBlockchainShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -220,6 +236,9 @@ func TestNewInterceptorsContainerFactory_NilMarshalizerShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -244,6 +263,9 @@ func TestNewInterceptorsContainerFactory_NilHasherShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -268,6 +290,9 @@ func TestNewInterceptorsContainerFactory_NilKeyGenShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -292,6 +317,9 @@ func TestNewInterceptorsContainerFactory_NilSingleSignerShouldErr(t *testing.T)
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -316,6 +344,9 @@ func TestNewInterceptorsContainerFactory_NilMultiSignerShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -340,6 +371,9 @@ func TestNewInterceptorsContainerFactory_NilDataPoolShouldErr(t *testing.T) {
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -364,6 +398,9 @@ func TestNewInterceptorsContainerFactory_NilAddrConverterShouldErr(t *testing.T)
 		nil,
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
@@ -388,12 +425,96 @@ func TestNewInterceptorsContainerFactory_NilTxFeeHandlerShouldErr(t *testing.T)
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		nil,
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.Nil(t, icf)
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
+func TestNewInterceptorsContainerFactory_NilHeaderValidatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := shard.NewInterceptorsContainerFactory(
+		&mock.AccountsStub{},
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		mock.NewMultiSigner(),
+		createDataPools(),
+		&mock.AddressConverterMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		nil,
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilHeaderHandlerValidator, err)
+}
+
+func TestNewInterceptorsContainerFactory_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := shard.NewInterceptorsContainerFactory(
+		&mock.AccountsStub{},
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		mock.NewMultiSigner(),
+		createDataPools(),
+		&mock.AddressConverterMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		nil,
+		&mock.TxValidityWindowCheckerStub{},
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilBlockChain, err)
+}
+
+func TestNewInterceptorsContainerFactory_NilTxValidityWindowCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf, err := shard.NewInterceptorsContainerFactory(
+		&mock.AccountsStub{},
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		&mock.TopicHandlerStub{},
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		mock.NewMultiSigner(),
+		createDataPools(),
+		&mock.AddressConverterMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		nil,
+	)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilTxValidityWindowChecker, err)
+}
+
 func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -412,6 +533,9 @@ func TestNewInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	assert.NotNil(t, icf)
@@ -438,6 +562,9 @@ func TestInterceptorsContainerFactory_CreateTopicCreationTxFailsShouldErr(t *tes
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, err := icf.Create()
@@ -464,6 +591,9 @@ func TestInterceptorsContainerFactory_CreateTopicCreationHdrFailsShouldErr(t *te
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, err := icf.Create()
@@ -490,6 +620,9 @@ func TestInterceptorsContainerFactory_CreateTopicCreationMiniBlocksFailsShouldEr
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, err := icf.Create()
@@ -516,6 +649,9 @@ func TestInterceptorsContainerFactory_CreateTopicCreationPeerChBlocksFailsShould
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, err := icf.Create()
@@ -542,6 +678,9 @@ func TestInterceptorsContainerFactory_CreateTopicCreationMetachainHeadersFailsSh
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, err := icf.Create()
@@ -568,6 +707,9 @@ func TestInterceptorsContainerFactory_CreateRegisterTxFailsShouldErr(t *testing.
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, err := icf.Create()
@@ -594,6 +736,9 @@ func TestInterceptorsContainerFactory_CreateRegisterHdrFailsShouldErr(t *testing
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, err := icf.Create()
@@ -620,6 +765,9 @@ func TestInterceptorsContainerFactory_CreateRegisterMiniBlocksFailsShouldErr(t *
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, err := icf.Create()
@@ -646,6 +794,9 @@ func TestInterceptorsContainerFactory_CreateRegisterPeerChBlocksFailsShouldErr(t
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, err := icf.Create()
@@ -672,6 +823,9 @@ func TestInterceptorsContainerFactory_CreateRegisterMetachainHeadersShouldErr(t
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, err := icf.Create()
@@ -705,6 +859,9 @@ func TestInterceptorsContainerFactory_CreateShouldWork(t *testing.T) {
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, err := icf.Create()
@@ -751,6 +908,9 @@ func TestInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 		&mock.AddressConverterMock{},
 		maxTxNonceDeltaAllowed,
 		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
 	)
 
 	container, _ := icf.Create()
@@ -768,3 +928,114 @@ func TestInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 
 	assert.Equal(t, totalInterceptors, container.Len())
 }
+
+//------- RegisterCustomInterceptor
+
+const customTopic = "customTopic"
+
+func createInterceptorsContainerFactoryWithTopicHandler(topicHandler process.TopicHandler) process.InterceptorsContainerFactory {
+	icf, _ := shard.NewInterceptorsContainerFactory(
+		&mock.AccountsStub{},
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+		topicHandler,
+		createStore(),
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		mock.NewMultiSigner(),
+		createDataPools(),
+		&mock.AddressConverterMock{},
+		maxTxNonceDeltaAllowed,
+		&mock.FeeHandlerStub{},
+		&mock.HeaderValidatorStub{},
+		&mock.BlockChainMock{},
+		&mock.TxValidityWindowCheckerStub{},
+	)
+
+	return icf
+}
+
+func TestInterceptorsContainerFactory_RegisterCustomInterceptorEmptyTopicShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf := createInterceptorsContainerFactoryWithTopicHandler(createStubTopicHandler("", ""))
+
+	err := icf.RegisterCustomInterceptor("", &mock.InterceptorStub{})
+
+	assert.Equal(t, process.ErrEmptyTopic, err)
+}
+
+func TestInterceptorsContainerFactory_RegisterCustomInterceptorNilInterceptorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf := createInterceptorsContainerFactoryWithTopicHandler(createStubTopicHandler("", ""))
+
+	err := icf.RegisterCustomInterceptor(customTopic, nil)
+
+	assert.Equal(t, process.ErrNilInterceptor, err)
+}
+
+func TestInterceptorsContainerFactory_RegisterCustomInterceptorTwiceOnTheSameTopicShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf := createInterceptorsContainerFactoryWithTopicHandler(createStubTopicHandler("", ""))
+
+	err := icf.RegisterCustomInterceptor(customTopic, &mock.InterceptorStub{})
+	assert.Nil(t, err)
+
+	err = icf.RegisterCustomInterceptor(customTopic, &mock.InterceptorStub{})
+	assert.Equal(t, process.ErrTopicAlreadyRegistered, err)
+}
+
+func TestInterceptorsContainerFactory_CreateTopicCreationCustomInterceptorFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf := createInterceptorsContainerFactoryWithTopicHandler(createStubTopicHandler(customTopic, ""))
+	_ = icf.RegisterCustomInterceptor(customTopic, &mock.InterceptorStub{})
+
+	container, err := icf.Create()
+
+	assert.Nil(t, container)
+	assert.Equal(t, errExpected, err)
+}
+
+func TestInterceptorsContainerFactory_CreateRegisterCustomInterceptorFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	icf := createInterceptorsContainerFactoryWithTopicHandler(createStubTopicHandler("", customTopic))
+	_ = icf.RegisterCustomInterceptor(customTopic, &mock.InterceptorStub{})
+
+	container, err := icf.Create()
+
+	assert.Nil(t, container)
+	assert.Equal(t, errExpected, err)
+}
+
+func TestInterceptorsContainerFactory_CreateWithCustomInterceptorShouldAddItThrottled(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	icf := createInterceptorsContainerFactoryWithTopicHandler(createStubTopicHandler("", ""))
+	_ = icf.RegisterCustomInterceptor(customTopic, &mock.InterceptorStub{
+		ProcessReceivedMessageCalled: func(message p2p.MessageP2P) error {
+			wasCalled = true
+			return nil
+		},
+	})
+
+	container, err := icf.Create()
+	assert.Nil(t, err)
+
+	interceptor, err := container.Get(customTopic)
+	assert.Nil(t, err)
+
+	throttledInterceptor, ok := interceptor.(process.ThrottledInterceptor)
+	assert.True(t, ok)
+	assert.NotNil(t, throttledInterceptor.Throttler())
+
+	err = interceptor.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{})
+	assert.Nil(t, err)
+	assert.True(t, wasCalled)
+}
@@ -27,6 +27,7 @@ type preProcessorsContainerFactory struct {
 	requestHandler     process.RequestHandler
 	rewardsProducer    process.InternalTransactionProducer
 	economicsFee       process.FeeHandler
+	proposalPolicy     process.BlockProposalPolicy
 }
 
 // NewPreProcessorsContainerFactory is responsible for creating a new preProcessors factory object
@@ -45,6 +46,7 @@ func NewPreProcessorsContainerFactory(
 	rewardsTxProcessor process.RewardTransactionProcessor,
 	rewardsProducer process.InternalTransactionProducer,
 	economicsFee process.FeeHandler,
+	proposalPolicy process.BlockProposalPolicy,
 ) (*preProcessorsContainerFactory, error) {
 
 	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
@@ -89,6 +91,9 @@ func NewPreProcessorsContainerFactory(
 	if economicsFee == nil || economicsFee.IsInterfaceNil() {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if proposalPolicy == nil || proposalPolicy.IsInterfaceNil() {
+		return nil, process.ErrNilBlockProposalPolicy
+	}
 
 	return &preProcessorsContainerFactory{
 		shardCoordinator:   shardCoordinator,
@@ -105,6 +110,7 @@ func NewPreProcessorsContainerFactory(
 		requestHandler:     requestHandler,
 		rewardsProducer:    rewardsProducer,
 		economicsFee:       economicsFee,
+		proposalPolicy:     proposalPolicy,
 	}, nil
 }
 
@@ -156,6 +162,7 @@ func (ppcm *preProcessorsContainerFactory) createTxPreProcessor() (process.PrePr
 		ppcm.accounts,
 		ppcm.requestHandler.RequestTransaction,
 		ppcm.economicsFee,
+		ppcm.proposalPolicy,
 	)
 
 	return txPreprocessor, err
@@ -1,10 +1,12 @@
 package process
 
 import (
+	"context"
 	"math/big"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
@@ -55,6 +57,18 @@ type TxValidator interface {
 	IsInterfaceNil() bool
 }
 
+// TxValidityWindowChecker can determine if a transaction may be executed in the provided round and epoch
+type TxValidityWindowChecker interface {
+	CheckValidityWindow(tx *transaction.Transaction, round uint64, epoch uint32) error
+	IsInterfaceNil() bool
+}
+
+// EpochHandler defines the operation used to read the epoch of the block being created or processed
+type EpochHandler interface {
+	Epoch() uint32
+	IsInterfaceNil() bool
+}
+
 // HeaderValidator can determine if a provided header handler is valid or not from the process point of view
 type HeaderValidator interface {
 	IsHeaderValidForProcessing(headerHandler data.HeaderHandler) bool
@@ -122,6 +136,16 @@ type TransactionFeeHandler interface {
 	IsInterfaceNil() bool
 }
 
+// BlockEconomicsHandler returns the economics values computed locally while processing the current block
+type BlockEconomicsHandler interface {
+	AccumulatedFees() *big.Int
+	TotalRewards() *big.Int
+	LeaderFees() *big.Int
+	CommunityFees() *big.Int
+	BurnedFees() *big.Int
+	IsInterfaceNil() bool
+}
+
 // SpecialAddressHandler responds with needed special addresses
 type SpecialAddressHandler interface {
 	SetShardConsensusData(randomness []byte, round uint64, epoch uint32, shardID uint32) error
@@ -221,6 +245,7 @@ type Bootstrapper interface {
 	StopSync()
 	StartSync()
 	SetStatusHandler(handler core.AppStatusHandler) error
+	SetIndexer(indexer indexer.Indexer) error
 	IsInterfaceNil() bool
 }
 
@@ -234,6 +259,7 @@ type ForkDetector interface {
 	ProbableHighestNonce() uint64
 	ResetProbableHighestNonceIfNeeded()
 	ResetProbableHighestNonce()
+	ResetCheckpoints(nonce uint64, round uint64)
 	IsInterfaceNil() bool
 }
 
@@ -245,12 +271,16 @@ type InterceptorsContainer interface {
 	Replace(key string, val Interceptor) error
 	Remove(key string)
 	Len() int
+	Keys() []string
+	Iterate(handler func(key string, interceptor Interceptor) bool)
+	Metadata(key string) (ContainerElementMetadata, error)
 	IsInterfaceNil() bool
 }
 
 // InterceptorsContainerFactory defines the functionality to create an interceptors container
 type InterceptorsContainerFactory interface {
 	Create() (InterceptorsContainer, error)
+	RegisterCustomInterceptor(topic string, interceptor Interceptor) error
 	IsInterfaceNil() bool
 }
 
@@ -312,7 +342,24 @@ type VirtualMachinesContainerFactory interface {
 // Interceptor defines what a data interceptor should do
 // It should also adhere to the p2p.MessageProcessor interface so it can wire to a p2p.Messenger
 type Interceptor interface {
-	ProcessReceivedMessage(message p2p.MessageP2P) error
+	ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error
+	IsInterfaceNil() bool
+}
+
+// MessageTracer defines what a sampled tracer of the received messages should do
+type MessageTracer interface {
+	StartTrace(message p2p.MessageP2P) MessageTrace
+	IsInterfaceNil() bool
+}
+
+// MessageTrace records the moments a traced message reaches the interceptor processing stages
+type MessageTrace interface {
+	MarkStage(stage string)
+}
+
+// TraceableInterceptor is implemented by the interceptors able to report the processing stages of the traced messages
+type TraceableInterceptor interface {
+	SetMessageTracer(tracer MessageTracer) error
 	IsInterfaceNil() bool
 }
 
@@ -328,6 +375,7 @@ type TopicHandler interface {
 	HasTopic(name string) bool
 	CreateTopic(name string, createChannelForTopic bool) error
 	RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error
+	RemoveTopic(name string) error
 }
 
 // TopicMessageHandler defines the functionality needed by structs to manage topics, message processors and to send data
@@ -376,6 +424,23 @@ type TemporaryAccountsHandler interface {
 	IsInterfaceNil() bool
 }
 
+// SCStateChangesAuditor defines the functionality to keep a record of all the state changes applied from smart
+// contract executions, keyed by the hash of the transaction that produced them, including through its smart contract
+// results
+type SCStateChangesAuditor interface {
+	SaveStateChanges(txHash []byte, changes []*StateChange) error
+	GetStateChanges(txHash []byte) ([]*StateChange, error)
+	IsInterfaceNil() bool
+}
+
+// SCDeploymentsIndexer defines the functionality to keep, for each smart contract deployed in the current shard, the
+// deployer address, the deploy transaction hash and the deploy epoch, keyed by the smart contract address
+type SCDeploymentsIndexer interface {
+	SaveDeployment(scAddress []byte, deployer []byte, deployTxHash []byte) error
+	GetDeployment(scAddress []byte) (*SCDeploymentInfo, error)
+	IsInterfaceNil() bool
+}
+
 // BlockSizeThrottler defines the functionality of adapting the node to the network speed/latency when it should send a
 // block to its peers which should be received in a limited time frame
 type BlockSizeThrottler interface {
@@ -392,6 +457,7 @@ type TxValidatorHandler interface {
 	Nonce() uint64
 	SenderAddress() state.AddressContainer
 	TotalValue() *big.Int
+	Transaction() *transaction.Transaction
 }
 
 // PoolsCleaner define the functionality that is needed for a pools cleaner
@@ -401,6 +467,13 @@ type PoolsCleaner interface {
 	IsInterfaceNil() bool
 }
 
+// HeadersPoolsCleaner defines the functionality to remove from the pools the headers behind the final ones
+type HeadersPoolsCleaner interface {
+	Clean(finalNonces map[uint32]uint64, duration time.Duration) (bool, error)
+	NumRemovedHeaders() uint64
+	IsInterfaceNil() bool
+}
+
 // InterceptorThrottler can determine if a new go routine can start
 type InterceptorThrottler interface {
 	CanProcess() bool
@@ -409,6 +482,18 @@ type InterceptorThrottler interface {
 	IsInterfaceNil() bool
 }
 
+// ThrottledInterceptor is implemented by the interceptors limiting the number of messages processed at the same time
+type ThrottledInterceptor interface {
+	Throttler() InterceptorThrottler
+	IsInterfaceNil() bool
+}
+
+// ThrottlerSaturationHandler can report how many go routines a throttler allows and how many of them are running
+type ThrottlerSaturationHandler interface {
+	NumProcessing() int32
+	MaxNumProcessing() int32
+}
+
 // RewardsHandler will return information about rewards
 type RewardsHandler interface {
 	RewardsValue() *big.Int
@@ -426,6 +511,15 @@ type FeeHandler interface {
 	IsInterfaceNil() bool
 }
 
+// BlockProposalPolicy selects, out of the transactions a leader may include in a miniblock, the ones to be tried and
+// their order. The candidates are sorted by sender and nonce and the returned values are indexes in the candidates
+// slice. The selected transactions are still processed one by one, so a policy can only restrict or reorder the
+// proposal, never make it invalid
+type BlockProposalPolicy interface {
+	SelectTransactions(candidates []*transaction.Transaction, candidatesHashes [][]byte, maxTxs int, round uint64) []int
+	IsInterfaceNil() bool
+}
+
 // TransactionWithFeeHandler represents a transaction structure that has economics variables defined
 type TransactionWithFeeHandler interface {
 	GetGasLimit() uint64
@@ -0,0 +1,194 @@
+package invariants
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var log = logger.DefaultLogger()
+
+// accountsBalanceChecker verifies, periodically, that the accounts trie holds no negative balance and that the sum
+// of all balances does not exceed the maximum total supply that could have been reached by that round: the sum of
+// this shard's genesis balances plus the maximum protocol rewards that could have been minted since genesis
+type accountsBalanceChecker struct {
+	accountsTrie       data.Trie
+	marshalizer        marshal.Marshalizer
+	blockChain         data.ChainHandler
+	rewardsHandler     process.RewardsHandler
+	genesisTotalSupply *big.Int
+	maxRewardsPerRound uint64
+
+	mutCheck         sync.Mutex
+	isBlockChecked   bool
+	lastCheckedNonce uint64
+}
+
+// NewAccountsBalanceChecker creates a new accounts balance invariant checker. The genesisTotalSupply should be the
+// sum of the genesis balances of the accounts placed in this shard. The maxRewardsPerRound should be the
+// maximum number of protocol reward transactions that can be created in a round (e.g. the sum of the shard and
+// metachain consensus group sizes)
+func NewAccountsBalanceChecker(
+	accountsTrie data.Trie,
+	marshalizer marshal.Marshalizer,
+	blockChain data.ChainHandler,
+	rewardsHandler process.RewardsHandler,
+	genesisTotalSupply *big.Int,
+	maxRewardsPerRound uint64,
+) (*accountsBalanceChecker, error) {
+
+	if accountsTrie == nil || accountsTrie.IsInterfaceNil() {
+		return nil, ErrNilAccountsTrie
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, ErrNilMarshalizer
+	}
+	if blockChain == nil || blockChain.IsInterfaceNil() {
+		return nil, ErrNilBlockChain
+	}
+	if rewardsHandler == nil || rewardsHandler.IsInterfaceNil() {
+		return nil, ErrNilRewardsHandler
+	}
+	if genesisTotalSupply == nil || genesisTotalSupply.Sign() < 0 {
+		return nil, ErrInvalidGenesisTotalSupply
+	}
+
+	return &accountsBalanceChecker{
+		accountsTrie:       accountsTrie,
+		marshalizer:        marshalizer,
+		blockChain:         blockChain,
+		rewardsHandler:     rewardsHandler,
+		genesisTotalSupply: genesisTotalSupply,
+		maxRewardsPerRound: maxRewardsPerRound,
+	}, nil
+}
+
+// StartChecking starts a go routine which, each checkInterval, runs the invariant check against the current block
+// header, if a new block was committed since the last check. The go routine ends when the provided context is done
+func (abc *accountsBalanceChecker) StartChecking(ctx context.Context, checkInterval time.Duration) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(checkInterval):
+				abc.checkIfNewBlock()
+			}
+		}
+	}()
+}
+
+func (abc *accountsBalanceChecker) checkIfNewBlock() {
+	header := abc.blockChain.GetCurrentBlockHeader()
+	if header == nil || header.IsInterfaceNil() {
+		return
+	}
+
+	abc.mutCheck.Lock()
+	isNewBlock := !abc.isBlockChecked || header.GetNonce() != abc.lastCheckedNonce
+	if isNewBlock {
+		abc.isBlockChecked = true
+		abc.lastCheckedNonce = header.GetNonce()
+	}
+	abc.mutCheck.Unlock()
+
+	if !isNewBlock {
+		return
+	}
+
+	err := abc.CheckInvariant(header)
+	if err != nil {
+		msg := fmt.Sprintf("ACCOUNTS INVARIANT BROKEN at block with nonce %d, round %d, epoch %d: %s",
+			header.GetNonce(), header.GetRound(), header.GetEpoch(), err.Error())
+		log.Error(log.Headline(msg, "", "!"))
+	}
+}
+
+// CheckInvariant iterates the accounts trie, as it was committed by the provided header, and verifies that
+// no balance is negative and that the sum of all balances does not exceed the maximum possible total supply
+func (abc *accountsBalanceChecker) CheckInvariant(header data.HeaderHandler) error {
+	if header == nil || header.IsInterfaceNil() {
+		return ErrNilHeader
+	}
+
+	accountsTrie, err := abc.accountsTrie.Recreate(header.GetRootHash())
+	if err != nil {
+		return err
+	}
+
+	sumBalances := big.NewInt(0)
+	numAccounts := 0
+	var errBalance error
+	err = accountsTrie.IterateLeaves(nil, func(address []byte, value []byte) bool {
+		account, isAccount := abc.decodeAccount(value)
+		if !isAccount {
+			return true
+		}
+
+		if account.Balance.Sign() < 0 {
+			log.Error(fmt.Sprintf("account %s has negative balance %s",
+				hex.EncodeToString(address), account.Balance.String()))
+			errBalance = ErrNegativeBalance
+			return false
+		}
+
+		numAccounts++
+		sumBalances.Add(sumBalances, account.Balance)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if errBalance != nil {
+		return errBalance
+	}
+
+	maxTotalSupply := abc.maxTotalSupply(header.GetRound())
+	if sumBalances.Cmp(maxTotalSupply) > 0 {
+		log.Error(fmt.Sprintf("sum of balances %s is greater than maximum total supply %s",
+			sumBalances.String(), maxTotalSupply.String()))
+		return ErrTotalSupplyExceeded
+	}
+
+	log.Info(fmt.Sprintf("accounts invariant checked for %d accounts at block with nonce %d: sum of balances %s, "+
+		"maximum total supply %s", numAccounts, header.GetNonce(), sumBalances.String(), maxTotalSupply.String()))
+
+	return nil
+}
+
+// decodeAccount returns false for the leaves that do not hold accounts, as the smart contracts code is kept in the
+// same trie, under the code hash
+func (abc *accountsBalanceChecker) decodeAccount(value []byte) (*state.Account, bool) {
+	account := &state.Account{}
+	err := abc.marshalizer.Unmarshal(account, value)
+	if err != nil || account.Balance == nil {
+		return nil, false
+	}
+
+	return account, true
+}
+
+func (abc *accountsBalanceChecker) maxTotalSupply(round uint64) *big.Int {
+	maxRewards := big.NewInt(0).SetUint64(abc.maxRewardsPerRound)
+	maxRewards.Mul(maxRewards, big.NewInt(0).SetUint64(round))
+	maxRewards.Mul(maxRewards, abc.rewardsHandler.RewardsValue())
+
+	return maxRewards.Add(maxRewards, abc.genesisTotalSupply)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (abc *accountsBalanceChecker) IsInterfaceNil() bool {
+	if abc == nil {
+		return true
+	}
+	return false
+}
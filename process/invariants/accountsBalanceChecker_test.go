@@ -0,0 +1,305 @@
+package invariants_test
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/process/invariants"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+)
+
+func createRewardsHandler(rewardsValue int64) *mock.RewardsHandlerMock {
+	return &mock.RewardsHandlerMock{
+		RewardsValueCalled: func() *big.Int {
+			return big.NewInt(rewardsValue)
+		},
+	}
+}
+
+func createAccountsTrie(balances ...*big.Int) (data.Trie, []byte) {
+	memDB, _ := memorydb.New()
+	marshalizer := &mock.MarshalizerMock{}
+	tr, _ := trie.NewTrie(memDB, marshalizer, &mock.HasherMock{})
+
+	for i, balance := range balances {
+		buff, _ := marshalizer.Marshal(&state.Account{Balance: balance})
+		_ = tr.Update([]byte{byte(i + 1)}, buff)
+	}
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	return tr, rootHash
+}
+
+func TestNewAccountsBalanceChecker_NilTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	abc, err := invariants.NewAccountsBalanceChecker(
+		nil,
+		&mock.MarshalizerMock{},
+		&mock.BlockChainMock{},
+		createRewardsHandler(10),
+		big.NewInt(100),
+		1,
+	)
+
+	assert.Nil(t, abc)
+	assert.Equal(t, invariants.ErrNilAccountsTrie, err)
+}
+
+func TestNewAccountsBalanceChecker_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := createAccountsTrie()
+	abc, err := invariants.NewAccountsBalanceChecker(
+		tr,
+		nil,
+		&mock.BlockChainMock{},
+		createRewardsHandler(10),
+		big.NewInt(100),
+		1,
+	)
+
+	assert.Nil(t, abc)
+	assert.Equal(t, invariants.ErrNilMarshalizer, err)
+}
+
+func TestNewAccountsBalanceChecker_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := createAccountsTrie()
+	abc, err := invariants.NewAccountsBalanceChecker(
+		tr,
+		&mock.MarshalizerMock{},
+		nil,
+		createRewardsHandler(10),
+		big.NewInt(100),
+		1,
+	)
+
+	assert.Nil(t, abc)
+	assert.Equal(t, invariants.ErrNilBlockChain, err)
+}
+
+func TestNewAccountsBalanceChecker_NilRewardsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := createAccountsTrie()
+	abc, err := invariants.NewAccountsBalanceChecker(
+		tr,
+		&mock.MarshalizerMock{},
+		&mock.BlockChainMock{},
+		nil,
+		big.NewInt(100),
+		1,
+	)
+
+	assert.Nil(t, abc)
+	assert.Equal(t, invariants.ErrNilRewardsHandler, err)
+}
+
+func TestNewAccountsBalanceChecker_InvalidGenesisTotalSupplyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := createAccountsTrie()
+	abc, err := invariants.NewAccountsBalanceChecker(
+		tr,
+		&mock.MarshalizerMock{},
+		&mock.BlockChainMock{},
+		createRewardsHandler(10),
+		big.NewInt(-1),
+		1,
+	)
+
+	assert.Nil(t, abc)
+	assert.Equal(t, invariants.ErrInvalidGenesisTotalSupply, err)
+}
+
+func TestAccountsBalanceChecker_CheckInvariantNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := createAccountsTrie()
+	abc, _ := invariants.NewAccountsBalanceChecker(
+		tr,
+		&mock.MarshalizerMock{},
+		&mock.BlockChainMock{},
+		createRewardsHandler(10),
+		big.NewInt(100),
+		1,
+	)
+
+	err := abc.CheckInvariant(nil)
+
+	assert.Equal(t, invariants.ErrNilHeader, err)
+}
+
+func TestAccountsBalanceChecker_CheckInvariantShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tr, rootHash := createAccountsTrie(big.NewInt(40), big.NewInt(60), big.NewInt(20))
+	abc, _ := invariants.NewAccountsBalanceChecker(
+		tr,
+		&mock.MarshalizerMock{},
+		&mock.BlockChainMock{},
+		createRewardsHandler(10),
+		big.NewInt(100),
+		2,
+	)
+
+	// maximum total supply at round 1 is 100 + 1 * 2 * 10 = 120
+	err := abc.CheckInvariant(&block.Header{RootHash: rootHash, Round: 1})
+
+	assert.Nil(t, err)
+}
+
+func TestAccountsBalanceChecker_CheckInvariantWithSmartContractCodeShouldWork(t *testing.T) {
+	t.Parallel()
+
+	memDB, _ := memorydb.New()
+	marshalizer := &mock.MarshalizerMock{}
+	tr, _ := trie.NewTrie(memDB, marshalizer, &mock.HasherMock{})
+	code := []byte("smart contract code")
+	codeHash := (&mock.HasherMock{}).Compute(string(code))
+	buff, _ := marshalizer.Marshal(&state.Account{Balance: big.NewInt(40), CodeHash: codeHash})
+	_ = tr.Update([]byte("sc address"), buff)
+	_ = tr.Update(codeHash, code)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	abc, _ := invariants.NewAccountsBalanceChecker(
+		tr,
+		marshalizer,
+		&mock.BlockChainMock{},
+		createRewardsHandler(10),
+		big.NewInt(100),
+		2,
+	)
+
+	err := abc.CheckInvariant(&block.Header{RootHash: rootHash, Round: 1})
+
+	assert.Nil(t, err)
+}
+
+func TestAccountsBalanceChecker_CheckInvariantExceededSupplyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr, rootHash := createAccountsTrie(big.NewInt(40), big.NewInt(60), big.NewInt(21))
+	abc, _ := invariants.NewAccountsBalanceChecker(
+		tr,
+		&mock.MarshalizerMock{},
+		&mock.BlockChainMock{},
+		createRewardsHandler(10),
+		big.NewInt(100),
+		2,
+	)
+
+	err := abc.CheckInvariant(&block.Header{RootHash: rootHash, Round: 1})
+
+	assert.Equal(t, invariants.ErrTotalSupplyExceeded, err)
+}
+
+func TestAccountsBalanceChecker_CheckInvariantNegativeBalanceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr, rootHash := createAccountsTrie(big.NewInt(40), big.NewInt(-1))
+	abc, _ := invariants.NewAccountsBalanceChecker(
+		tr,
+		&mock.MarshalizerMock{},
+		&mock.BlockChainMock{},
+		createRewardsHandler(10),
+		big.NewInt(100),
+		2,
+	)
+
+	err := abc.CheckInvariant(&block.Header{RootHash: rootHash, Round: 1})
+
+	assert.Equal(t, invariants.ErrNegativeBalance, err)
+}
+
+func TestAccountsBalanceChecker_StartCheckingShouldCheckOnlyTheNewBlocks(t *testing.T) {
+	t.Parallel()
+
+	tr, rootHash := createAccountsTrie(big.NewInt(40), big.NewInt(60))
+	numChecks := int32(0)
+	rewardsHandler := &mock.RewardsHandlerMock{
+		RewardsValueCalled: func() *big.Int {
+			atomic.AddInt32(&numChecks, 1)
+			return big.NewInt(10)
+		},
+	}
+	nonce := uint64(1)
+	mutHeader := sync.Mutex{}
+	blockChain := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			mutHeader.Lock()
+			defer mutHeader.Unlock()
+
+			return &block.Header{RootHash: rootHash, Nonce: nonce, Round: nonce}
+		},
+	}
+	abc, _ := invariants.NewAccountsBalanceChecker(
+		tr,
+		&mock.MarshalizerMock{},
+		blockChain,
+		rewardsHandler,
+		big.NewInt(100),
+		2,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	abc.StartChecking(ctx, time.Millisecond*10)
+
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numChecks))
+
+	mutHeader.Lock()
+	nonce = 2
+	mutHeader.Unlock()
+
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&numChecks))
+}
+
+func TestAccountsBalanceChecker_StartCheckingShouldStopWhenContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	numPolls := int32(0)
+	blockChain := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			atomic.AddInt32(&numPolls, 1)
+			return nil
+		},
+	}
+	tr, _ := createAccountsTrie()
+	abc, _ := invariants.NewAccountsBalanceChecker(
+		tr,
+		&mock.MarshalizerMock{},
+		blockChain,
+		createRewardsHandler(10),
+		big.NewInt(100),
+		2,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	abc.StartChecking(ctx, time.Millisecond*10)
+
+	time.Sleep(time.Millisecond * 100)
+	cancel()
+	time.Sleep(time.Millisecond * 50)
+	numPollsAfterCancel := atomic.LoadInt32(&numPolls)
+	time.Sleep(time.Millisecond * 100)
+
+	assert.True(t, numPollsAfterCancel > 0)
+	assert.Equal(t, numPollsAfterCancel, atomic.LoadInt32(&numPolls))
+}
@@ -0,0 +1,27 @@
+package invariants
+
+import "errors"
+
+// ErrNilAccountsTrie signals that a nil accounts trie has been provided
+var ErrNilAccountsTrie = errors.New("nil accounts trie")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilBlockChain signals that a nil block chain has been provided
+var ErrNilBlockChain = errors.New("nil block chain")
+
+// ErrNilRewardsHandler signals that a nil rewards handler has been provided
+var ErrNilRewardsHandler = errors.New("nil rewards handler")
+
+// ErrInvalidGenesisTotalSupply signals that a nil or negative genesis total supply has been provided
+var ErrInvalidGenesisTotalSupply = errors.New("invalid genesis total supply")
+
+// ErrNilHeader signals that a nil header has been provided
+var ErrNilHeader = errors.New("nil header")
+
+// ErrNegativeBalance signals that an account with a negative balance has been found in the accounts trie
+var ErrNegativeBalance = errors.New("account with negative balance found")
+
+// ErrTotalSupplyExceeded signals that the sum of all balances is greater than the maximum possible total supply
+var ErrTotalSupplyExceeded = errors.New("sum of all balances exceeds the maximum possible total supply")
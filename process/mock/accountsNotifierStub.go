@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+type AccountsNotifierStub struct {
+	SubscribeCalled      func(addresses []string) (uint64, <-chan *subscription.AccountChange, error)
+	UnsubscribeCalled    func(subscriberId uint64)
+	BlockCommittedCalled func(header data.HeaderHandler, headerHash []byte)
+}
+
+func (ans *AccountsNotifierStub) Subscribe(addresses []string) (uint64, <-chan *subscription.AccountChange, error) {
+	return ans.SubscribeCalled(addresses)
+}
+
+func (ans *AccountsNotifierStub) Unsubscribe(subscriberId uint64) {
+	if ans.UnsubscribeCalled != nil {
+		ans.UnsubscribeCalled(subscriberId)
+	}
+}
+
+func (ans *AccountsNotifierStub) BlockCommitted(header data.HeaderHandler, headerHash []byte) {
+	if ans.BlockCommittedCalled != nil {
+		ans.BlockCommittedCalled(header, headerHash)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ans *AccountsNotifierStub) IsInterfaceNil() bool {
+	if ans == nil {
+		return true
+	}
+	return false
+}
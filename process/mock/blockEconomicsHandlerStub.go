@@ -0,0 +1,56 @@
+package mock
+
+import (
+	"math/big"
+)
+
+type BlockEconomicsHandlerStub struct {
+	AccumulatedFeesCalled func() *big.Int
+	TotalRewardsCalled    func() *big.Int
+	LeaderFeesCalled      func() *big.Int
+	CommunityFeesCalled   func() *big.Int
+	BurnedFeesCalled      func() *big.Int
+}
+
+func (behs *BlockEconomicsHandlerStub) AccumulatedFees() *big.Int {
+	if behs.AccumulatedFeesCalled != nil {
+		return behs.AccumulatedFeesCalled()
+	}
+	return big.NewInt(0)
+}
+
+func (behs *BlockEconomicsHandlerStub) TotalRewards() *big.Int {
+	if behs.TotalRewardsCalled != nil {
+		return behs.TotalRewardsCalled()
+	}
+	return big.NewInt(0)
+}
+
+func (behs *BlockEconomicsHandlerStub) LeaderFees() *big.Int {
+	if behs.LeaderFeesCalled != nil {
+		return behs.LeaderFeesCalled()
+	}
+	return big.NewInt(0)
+}
+
+func (behs *BlockEconomicsHandlerStub) CommunityFees() *big.Int {
+	if behs.CommunityFeesCalled != nil {
+		return behs.CommunityFeesCalled()
+	}
+	return big.NewInt(0)
+}
+
+func (behs *BlockEconomicsHandlerStub) BurnedFees() *big.Int {
+	if behs.BurnedFeesCalled != nil {
+		return behs.BurnedFeesCalled()
+	}
+	return big.NewInt(0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (behs *BlockEconomicsHandlerStub) IsInterfaceNil() bool {
+	if behs == nil {
+		return true
+	}
+	return false
+}
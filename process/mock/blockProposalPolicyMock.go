@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+type BlockProposalPolicyMock struct {
+}
+
+func (bppm *BlockProposalPolicyMock) SelectTransactions(
+	candidates []*transaction.Transaction,
+	_ [][]byte,
+	_ int,
+	_ uint64,
+) []int {
+	selected := make([]int, len(candidates))
+	for i := range candidates {
+		selected[i] = i
+	}
+
+	return selected
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bppm *BlockProposalPolicyMock) IsInterfaceNil() bool {
+	if bppm == nil {
+		return true
+	}
+	return false
+}
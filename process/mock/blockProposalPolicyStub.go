@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+type BlockProposalPolicyStub struct {
+	SelectTransactionsCalled func(candidates []*transaction.Transaction, candidatesHashes [][]byte, maxTxs int, round uint64) []int
+}
+
+func (bpps *BlockProposalPolicyStub) SelectTransactions(
+	candidates []*transaction.Transaction,
+	candidatesHashes [][]byte,
+	maxTxs int,
+	round uint64,
+) []int {
+	return bpps.SelectTransactionsCalled(candidates, candidatesHashes, maxTxs, round)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bpps *BlockProposalPolicyStub) IsInterfaceNil() bool {
+	if bpps == nil {
+		return true
+	}
+	return false
+}
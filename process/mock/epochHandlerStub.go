@@ -0,0 +1,21 @@
+package mock
+
+type EpochHandlerStub struct {
+	EpochCalled func() uint32
+}
+
+func (ehs *EpochHandlerStub) Epoch() uint32 {
+	if ehs.EpochCalled == nil {
+		return 0
+	}
+
+	return ehs.EpochCalled()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ehs *EpochHandlerStub) IsInterfaceNil() bool {
+	if ehs == nil {
+		return true
+	}
+	return false
+}
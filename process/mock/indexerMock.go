@@ -8,7 +8,8 @@ import (
 
 // IndexerMock is a mock implementation fot the Indexer interface
 type IndexerMock struct {
-	SaveBlockCalled func(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler)
+	SaveBlockCalled          func(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler)
+	RevertIndexedBlockCalled func(header data.HeaderHandler)
 }
 
 func (im *IndexerMock) SaveBlock(body data.BodyHandler, header data.HeaderHandler, txPool map[string]data.TransactionHandler, signersIndexes []uint64) {
@@ -21,6 +22,12 @@ func (im *IndexerMock) SaveMetaBlock(header data.HeaderHandler, signersIndexes [
 	return
 }
 
+func (im *IndexerMock) RevertIndexedBlock(header data.HeaderHandler) {
+	if im.RevertIndexedBlockCalled != nil {
+		im.RevertIndexedBlockCalled(header)
+	}
+}
+
 func (im *IndexerMock) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
 	panic("implement me")
 }
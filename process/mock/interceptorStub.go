@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
@@ -8,7 +10,7 @@ type InterceptorStub struct {
 	ProcessReceivedMessageCalled func(message p2p.MessageP2P) error
 }
 
-func (is *InterceptorStub) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (is *InterceptorStub) ProcessReceivedMessage(_ context.Context, message p2p.MessageP2P) error {
 	return is.ProcessReceivedMessageCalled(message)
 }
 
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type MessageTracerStub struct {
+	StartTraceCalled func(message p2p.MessageP2P) process.MessageTrace
+}
+
+func (mts *MessageTracerStub) StartTrace(message p2p.MessageP2P) process.MessageTrace {
+	return mts.StartTraceCalled(message)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mts *MessageTracerStub) IsInterfaceNil() bool {
+	if mts == nil {
+		return true
+	}
+	return false
+}
+
+type MessageTraceStub struct {
+	MarkStageCalled func(stage string)
+}
+
+func (mts *MessageTraceStub) MarkStage(stage string) {
+	mts.MarkStageCalled(stage)
+}
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
@@ -13,7 +15,7 @@ func (rs *ResolverStub) RequestDataFromHash(hash []byte) error {
 	return rs.RequestDataFromHashCalled(hash)
 }
 
-func (rs *ResolverStub) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (rs *ResolverStub) ProcessReceivedMessage(_ context.Context, message p2p.MessageP2P) error {
 	return rs.ProcessReceivedMessageCalled(message)
 }
 
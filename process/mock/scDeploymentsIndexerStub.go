@@ -0,0 +1,32 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type SCDeploymentsIndexerStub struct {
+	SaveDeploymentCalled func(scAddress []byte, deployer []byte, deployTxHash []byte) error
+	GetDeploymentCalled  func(scAddress []byte) (*process.SCDeploymentInfo, error)
+}
+
+func (sdis *SCDeploymentsIndexerStub) SaveDeployment(scAddress []byte, deployer []byte, deployTxHash []byte) error {
+	if sdis.SaveDeploymentCalled != nil {
+		return sdis.SaveDeploymentCalled(scAddress, deployer, deployTxHash)
+	}
+	return nil
+}
+
+func (sdis *SCDeploymentsIndexerStub) GetDeployment(scAddress []byte) (*process.SCDeploymentInfo, error) {
+	if sdis.GetDeploymentCalled != nil {
+		return sdis.GetDeploymentCalled(scAddress)
+	}
+	return nil, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sdis *SCDeploymentsIndexerStub) IsInterfaceNil() bool {
+	if sdis == nil {
+		return true
+	}
+	return false
+}
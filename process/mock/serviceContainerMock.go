@@ -3,12 +3,15 @@ package mock
 import (
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 )
 
 // ServiceContainerMock is a mock implementation of the Core interface
 type ServiceContainerMock struct {
-	IndexerCalled      func() indexer.Indexer
-	TPSBenchmarkCalled func() statistics.TPSBenchmark
+	IndexerCalled          func() indexer.Indexer
+	TPSBenchmarkCalled     func() statistics.TPSBenchmark
+	GasPriceStatsCalled    func() statistics.GasPriceStatsHandler
+	AccountsNotifierCalled func() subscription.AccountsNotifierHandler
 }
 
 // Indexer returns a mock implementation for core.Indexer
@@ -27,6 +30,22 @@ func (scm *ServiceContainerMock) TPSBenchmark() statistics.TPSBenchmark {
 	return nil
 }
 
+// GasPriceStats returns a mock implementation for core.GasPriceStats
+func (scm *ServiceContainerMock) GasPriceStats() statistics.GasPriceStatsHandler {
+	if scm.GasPriceStatsCalled != nil {
+		return scm.GasPriceStatsCalled()
+	}
+	return nil
+}
+
+// AccountsNotifier returns a mock implementation for core.AccountsNotifier
+func (scm *ServiceContainerMock) AccountsNotifier() subscription.AccountsNotifierHandler {
+	if scm.AccountsNotifierCalled != nil {
+		return scm.AccountsNotifierCalled()
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (scm *ServiceContainerMock) IsInterfaceNil() bool {
 	if scm == nil {
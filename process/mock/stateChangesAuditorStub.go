@@ -0,0 +1,32 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type StateChangesAuditorStub struct {
+	SaveStateChangesCalled func(txHash []byte, changes []*process.StateChange) error
+	GetStateChangesCalled  func(txHash []byte) ([]*process.StateChange, error)
+}
+
+func (scas *StateChangesAuditorStub) SaveStateChanges(txHash []byte, changes []*process.StateChange) error {
+	if scas.SaveStateChangesCalled != nil {
+		return scas.SaveStateChangesCalled(txHash, changes)
+	}
+	return nil
+}
+
+func (scas *StateChangesAuditorStub) GetStateChanges(txHash []byte) ([]*process.StateChange, error) {
+	if scas.GetStateChangesCalled != nil {
+		return scas.GetStateChangesCalled(txHash)
+	}
+	return nil, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (scas *StateChangesAuditorStub) IsInterfaceNil() bool {
+	if scas == nil {
+		return true
+	}
+	return false
+}
@@ -8,6 +8,7 @@ type TopicHandlerStub struct {
 	HasTopicCalled                 func(name string) bool
 	CreateTopicCalled              func(name string, createChannelForTopic bool) error
 	RegisterMessageProcessorCalled func(topic string, handler p2p.MessageProcessor) error
+	RemoveTopicCalled              func(name string) error
 }
 
 func (ths *TopicHandlerStub) HasTopic(name string) bool {
@@ -22,6 +23,10 @@ func (ths *TopicHandlerStub) RegisterMessageProcessor(topic string, handler p2p.
 	return ths.RegisterMessageProcessorCalled(topic, handler)
 }
 
+func (ths *TopicHandlerStub) RemoveTopic(name string) error {
+	return ths.RemoveTopicCalled(name)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ths *TopicHandlerStub) IsInterfaceNil() bool {
 	if ths == nil {
@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 )
 
 type TxValidatorHandlerStub struct {
@@ -11,6 +12,7 @@ type TxValidatorHandlerStub struct {
 	NonceCalled         func() uint64
 	SenderAddressCalled func() state.AddressContainer
 	TotalValueCalled    func() *big.Int
+	TransactionCalled   func() *transaction.Transaction
 }
 
 func (tvhs *TxValidatorHandlerStub) SenderShardId() uint32 {
@@ -28,3 +30,11 @@ func (tvhs *TxValidatorHandlerStub) SenderAddress() state.AddressContainer {
 func (tvhs *TxValidatorHandlerStub) TotalValue() *big.Int {
 	return tvhs.TotalValueCalled()
 }
+
+func (tvhs *TxValidatorHandlerStub) Transaction() *transaction.Transaction {
+	if tvhs.TransactionCalled == nil {
+		return &transaction.Transaction{}
+	}
+
+	return tvhs.TransactionCalled()
+}
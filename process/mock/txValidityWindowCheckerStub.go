@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+type TxValidityWindowCheckerStub struct {
+	CheckValidityWindowCalled func(tx *transaction.Transaction, round uint64, epoch uint32) error
+}
+
+func (tvwcs *TxValidityWindowCheckerStub) CheckValidityWindow(tx *transaction.Transaction, round uint64, epoch uint32) error {
+	if tvwcs.CheckValidityWindowCalled == nil {
+		return nil
+	}
+
+	return tvwcs.CheckValidityWindowCalled(tx, round, epoch)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tvwcs *TxValidityWindowCheckerStub) IsInterfaceNil() bool {
+	if tvwcs == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,8 @@
+package preload
+
+import (
+	"errors"
+)
+
+// ErrNilTrieStorage signals that a nil trie storage has been provided
+var ErrNilTrieStorage = errors.New("nil trie storage")
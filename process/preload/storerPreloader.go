@@ -0,0 +1,302 @@
+package preload
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.DefaultLogger()
+
+// Stats holds how many entries were loaded in the storers caches by a preload run
+type Stats struct {
+	HighestNonce  uint64
+	NumHeaders    int
+	NumMiniBlocks int
+	NumTrieNodes  int
+	NumMissing    int
+	Interrupted   bool
+	Duration      time.Duration
+}
+
+// ArgStorerPreloader holds the components needed by the storer preloader
+type ArgStorerPreloader struct {
+	Store            dataRetriever.StorageService
+	TrieStorage      storage.Storer
+	Marshalizer      marshal.Marshalizer
+	Uint64Converter  typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator sharding.Coordinator
+	Config           config.StorerPreloaderConfig
+}
+
+// StorerPreloader warms the storers caches after a restart, before the node joins consensus, so that the first
+// rounds do not pay for the disk reads. It reads the most recent headers, the miniblocks they reference and the top
+// levels of the accounts trie of the most recent header. The reads are throttled to MaxReadsPerSecond and the whole
+// run is bounded by MaxDurationInSec, if set, the preload being only a best effort
+type StorerPreloader struct {
+	store                dataRetriever.StorageService
+	trieStorage          storage.Storer
+	marshalizer          marshal.Marshalizer
+	uint64Converter      typeConverters.Uint64ByteSliceConverter
+	isMetachain          bool
+	headerUnit           dataRetriever.UnitType
+	hdrNonceHashDataUnit dataRetriever.UnitType
+	config               config.StorerPreloaderConfig
+
+	readInterval time.Duration
+	startTime    time.Time
+	deadline     time.Time
+	numReads     int64
+}
+
+// NewStorerPreloader creates a new StorerPreloader instance
+func NewStorerPreloader(args ArgStorerPreloader) (*StorerPreloader, error) {
+	if args.Store == nil || args.Store.IsInterfaceNil() {
+		return nil, process.ErrNilStore
+	}
+	if args.TrieStorage == nil || args.TrieStorage.IsInterfaceNil() {
+		return nil, ErrNilTrieStorage
+	}
+	if args.Marshalizer == nil || args.Marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+	if args.Uint64Converter == nil || args.Uint64Converter.IsInterfaceNil() {
+		return nil, process.ErrNilUint64Converter
+	}
+	if args.ShardCoordinator == nil || args.ShardCoordinator.IsInterfaceNil() {
+		return nil, process.ErrNilShardCoordinator
+	}
+
+	sp := &StorerPreloader{
+		store:           args.Store,
+		trieStorage:     args.TrieStorage,
+		marshalizer:     args.Marshalizer,
+		uint64Converter: args.Uint64Converter,
+		config:          args.Config,
+	}
+
+	shardId := args.ShardCoordinator.SelfId()
+	sp.isMetachain = shardId == sharding.MetachainShardId
+	if sp.isMetachain {
+		sp.headerUnit = dataRetriever.MetaBlockUnit
+		sp.hdrNonceHashDataUnit = dataRetriever.MetaHdrNonceHashDataUnit
+	} else {
+		sp.headerUnit = dataRetriever.BlockHeaderUnit
+		sp.hdrNonceHashDataUnit = dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardId)
+	}
+
+	if args.Config.MaxReadsPerSecond > 0 {
+		sp.readInterval = time.Second / time.Duration(args.Config.MaxReadsPerSecond)
+	}
+
+	return sp, nil
+}
+
+// Preload warms the storers caches and returns how many entries were loaded. It blocks until all the configured
+// entries were read or until the configured duration elapsed
+func (sp *StorerPreloader) Preload() *Stats {
+	sp.startTime = time.Now()
+	sp.deadline = sp.startTime.Add(time.Duration(sp.config.MaxDurationInSec) * time.Second)
+	sp.numReads = 0
+
+	stats := &Stats{}
+	defer func() {
+		stats.Duration = time.Since(sp.startTime)
+	}()
+
+	stats.HighestNonce = sp.computeHighestNonce()
+	if stats.HighestNonce == 0 {
+		return stats
+	}
+
+	lowestNonce := uint64(1)
+	if sp.config.NumHeaders < stats.HighestNonce {
+		lowestNonce = stats.HighestNonce - sp.config.NumHeaders + 1
+	}
+
+	var latestRootHash []byte
+	for nonce := stats.HighestNonce; nonce >= lowestNonce; nonce-- {
+		if sp.isTimeOut() {
+			stats.Interrupted = true
+			return stats
+		}
+
+		rootHash, miniBlockHashes, err := sp.preloadHeader(nonce)
+		if err != nil {
+			log.Debug(fmt.Sprintf("preload header with nonce %d: %s\n", nonce, err.Error()))
+			stats.NumMissing++
+			continue
+		}
+		stats.NumHeaders++
+		if latestRootHash == nil {
+			latestRootHash = rootHash
+		}
+
+		for _, miniBlockHash := range miniBlockHashes {
+			_, err = sp.read(sp.store.GetStorer(dataRetriever.MiniBlockUnit), miniBlockHash)
+			if err != nil {
+				stats.NumMissing++
+				continue
+			}
+			stats.NumMiniBlocks++
+		}
+	}
+
+	if len(latestRootHash) > 0 {
+		sp.preloadTrieNodes(latestRootHash, stats)
+	}
+
+	return stats
+}
+
+// computeHighestNonce finds the highest nonce committed in the storer. As the committed nonces are contiguous, it
+// doubles the probed nonce until a missing one is found and then searches the highest one between the last two probes
+func (sp *StorerPreloader) computeHighestNonce() uint64 {
+	if !sp.hasNonce(1) {
+		return 0
+	}
+
+	low := uint64(1)
+	high := uint64(2)
+	for sp.hasNonce(high) {
+		low = high
+		high *= 2
+	}
+
+	for high-low > 1 {
+		middle := low + (high-low)/2
+		if sp.hasNonce(middle) {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+
+	return low
+}
+
+func (sp *StorerPreloader) hasNonce(nonce uint64) bool {
+	sp.waitReadSlot()
+	err := sp.store.Has(sp.hdrNonceHashDataUnit, sp.uint64Converter.ToByteSlice(nonce))
+
+	return err == nil
+}
+
+func (sp *StorerPreloader) preloadHeader(nonce uint64) ([]byte, [][]byte, error) {
+	headerHash, err := sp.read(sp.store.GetStorer(sp.hdrNonceHashDataUnit), sp.uint64Converter.ToByteSlice(nonce))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	buff, err := sp.read(sp.store.GetStorer(sp.headerUnit), headerHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if sp.isMetachain {
+		header := &block.MetaBlock{}
+		err = sp.marshalizer.Unmarshal(header, buff)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return header.RootHash, nil, nil
+	}
+
+	header := &block.Header{}
+	err = sp.marshalizer.Unmarshal(header, buff)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	miniBlockHashes := make([][]byte, len(header.MiniBlockHeaders))
+	for i := 0; i < len(header.MiniBlockHeaders); i++ {
+		miniBlockHashes[i] = header.MiniBlockHeaders[i].Hash
+	}
+
+	return header.RootHash, miniBlockHashes, nil
+}
+
+// preloadTrieNodes reads the accounts trie nodes breadth first, level by level, down to the configured depth
+func (sp *StorerPreloader) preloadTrieNodes(rootHash []byte, stats *Stats) {
+	currentLevel := [][]byte{rootHash}
+	for depth := uint32(0); depth < sp.config.TrieDepth && len(currentLevel) > 0; depth++ {
+		nextLevel := make([][]byte, 0)
+		for _, nodeHash := range currentLevel {
+			if sp.isTimeOut() {
+				stats.Interrupted = true
+				return
+			}
+
+			encodedNode, err := sp.read(sp.trieStorage, nodeHash)
+			if err != nil {
+				stats.NumMissing++
+				continue
+			}
+			stats.NumTrieNodes++
+
+			childrenHashes, err := trie.ChildrenHashes(encodedNode, sp.marshalizer)
+			if err != nil {
+				log.Debug(fmt.Sprintf("preload trie node: %s\n", err.Error()))
+				continue
+			}
+			nextLevel = append(nextLevel, childrenHashes...)
+		}
+
+		currentLevel = nextLevel
+	}
+}
+
+func (sp *StorerPreloader) read(storer storage.Storer, key []byte) ([]byte, error) {
+	if storer == nil || storer.IsInterfaceNil() {
+		return nil, process.ErrNilStorage
+	}
+
+	sp.waitReadSlot()
+
+	return storer.Get(key)
+}
+
+// waitReadSlot sleeps, if needed, so that the reads done since the start of the run do not exceed the configured
+// number of reads per second
+func (sp *StorerPreloader) waitReadSlot() {
+	defer func() {
+		sp.numReads++
+	}()
+
+	if sp.readInterval == 0 {
+		return
+	}
+
+	earliestReadTime := sp.startTime.Add(time.Duration(sp.numReads) * sp.readInterval)
+	waitTime := time.Until(earliestReadTime)
+	if waitTime > 0 {
+		time.Sleep(waitTime)
+	}
+}
+
+func (sp *StorerPreloader) isTimeOut() bool {
+	if sp.config.MaxDurationInSec == 0 {
+		return false
+	}
+
+	return time.Now().After(sp.deadline)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *StorerPreloader) IsInterfaceNil() bool {
+	if sp == nil {
+		return true
+	}
+	return false
+}
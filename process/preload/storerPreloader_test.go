@@ -0,0 +1,256 @@
+package preload_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/preload"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+)
+
+type countingStorer struct {
+	storage.Storer
+	numGets int
+}
+
+func (cs *countingStorer) Get(key []byte) ([]byte, error) {
+	cs.numGets++
+	return cs.Storer.Get(key)
+}
+
+func createMemUnit() *countingStorer {
+	cache, _ := storageUnit.NewCache(storageUnit.LRUCache, 1000, 1)
+	persister, _ := memorydb.New()
+	unit, _ := storageUnit.NewStorageUnit(cache, persister)
+
+	return &countingStorer{Storer: unit}
+}
+
+type testStorers struct {
+	store       *dataRetriever.ChainStorer
+	headers     *countingStorer
+	nonceHashes *countingStorer
+	miniBlocks  *countingStorer
+	trieStorage *countingStorer
+}
+
+func createTestStorers() *testStorers {
+	ts := &testStorers{
+		store:       dataRetriever.NewChainStorer(),
+		headers:     createMemUnit(),
+		nonceHashes: createMemUnit(),
+		miniBlocks:  createMemUnit(),
+		trieStorage: createMemUnit(),
+	}
+	ts.store.AddStorer(dataRetriever.BlockHeaderUnit, ts.headers)
+	ts.store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit, ts.nonceHashes)
+	ts.store.AddStorer(dataRetriever.MiniBlockUnit, ts.miniBlocks)
+
+	return ts
+}
+
+// commitBlocks stores numBlocks shard headers, each referencing one miniblock, the last one having as root hash the
+// root of a trie holding a few accounts
+func commitBlocks(t *testing.T, ts *testStorers, numBlocks uint64) {
+	marshalizer := &marshal.JsonMarshalizer{}
+	hasher := sha256.Sha256{}
+	converter := uint64ByteSlice.NewBigEndianConverter()
+
+	tr, _ := trie.NewTrie(ts.trieStorage.Storer, marshalizer, hasher)
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Update([]byte("dogglesworth"), []byte("cat"))
+	err := tr.Commit()
+	assert.Nil(t, err)
+	rootHash, _ := tr.Root()
+
+	for nonce := uint64(1); nonce <= numBlocks; nonce++ {
+		miniBlock := &block.MiniBlock{TxHashes: [][]byte{converter.ToByteSlice(nonce)}}
+		miniBlockHash, _ := core.CalculateHash(marshalizer, hasher, miniBlock)
+		miniBlockBuff, _ := marshalizer.Marshal(miniBlock)
+		_ = ts.miniBlocks.Put(miniBlockHash, miniBlockBuff)
+
+		header := &block.Header{
+			Nonce:            nonce,
+			Round:            nonce,
+			MiniBlockHeaders: []block.MiniBlockHeader{{Hash: miniBlockHash}},
+		}
+		if nonce == numBlocks {
+			header.RootHash = rootHash
+		}
+		headerHash, _ := core.CalculateHash(marshalizer, hasher, header)
+		headerBuff, _ := marshalizer.Marshal(header)
+		_ = ts.headers.Put(headerHash, headerBuff)
+		_ = ts.nonceHashes.Put(converter.ToByteSlice(nonce), headerHash)
+	}
+}
+
+func createArgStorerPreloader(ts *testStorers) preload.ArgStorerPreloader {
+	return preload.ArgStorerPreloader{
+		Store:            ts.store,
+		TrieStorage:      ts.trieStorage,
+		Marshalizer:      &marshal.JsonMarshalizer{},
+		Uint64Converter:  uint64ByteSlice.NewBigEndianConverter(),
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		Config: config.StorerPreloaderConfig{
+			Enabled:    true,
+			NumHeaders: 5,
+			TrieDepth:  10,
+		},
+	}
+}
+
+func TestNewStorerPreloader_NilStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgStorerPreloader(createTestStorers())
+	args.Store = nil
+	sp, err := preload.NewStorerPreloader(args)
+
+	assert.Nil(t, sp)
+	assert.Equal(t, process.ErrNilStore, err)
+}
+
+func TestNewStorerPreloader_NilTrieStorageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgStorerPreloader(createTestStorers())
+	args.TrieStorage = nil
+	sp, err := preload.NewStorerPreloader(args)
+
+	assert.Nil(t, sp)
+	assert.Equal(t, preload.ErrNilTrieStorage, err)
+}
+
+func TestNewStorerPreloader_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgStorerPreloader(createTestStorers())
+	args.Marshalizer = nil
+	sp, err := preload.NewStorerPreloader(args)
+
+	assert.Nil(t, sp)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewStorerPreloader_NilUint64ConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgStorerPreloader(createTestStorers())
+	args.Uint64Converter = nil
+	sp, err := preload.NewStorerPreloader(args)
+
+	assert.Nil(t, sp)
+	assert.Equal(t, process.ErrNilUint64Converter, err)
+}
+
+func TestNewStorerPreloader_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgStorerPreloader(createTestStorers())
+	args.ShardCoordinator = nil
+	sp, err := preload.NewStorerPreloader(args)
+
+	assert.Nil(t, sp)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+}
+
+func TestNewStorerPreloader_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sp, err := preload.NewStorerPreloader(createArgStorerPreloader(createTestStorers()))
+
+	assert.NotNil(t, sp)
+	assert.Nil(t, err)
+}
+
+func TestStorerPreloader_PreloadEmptyStorageShouldLoadNothing(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorers()
+	sp, _ := preload.NewStorerPreloader(createArgStorerPreloader(ts))
+
+	stats := sp.Preload()
+
+	assert.Equal(t, uint64(0), stats.HighestNonce)
+	assert.Equal(t, 0, stats.NumHeaders)
+	assert.Equal(t, 0, ts.headers.numGets)
+}
+
+func TestStorerPreloader_PreloadShouldLoadTheMostRecentHeaders(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorers()
+	commitBlocks(t, ts, 37)
+	sp, _ := preload.NewStorerPreloader(createArgStorerPreloader(ts))
+
+	stats := sp.Preload()
+
+	assert.Equal(t, uint64(37), stats.HighestNonce)
+	assert.Equal(t, 5, stats.NumHeaders)
+	assert.Equal(t, 5, stats.NumMiniBlocks)
+	assert.Equal(t, 0, stats.NumMissing)
+	assert.False(t, stats.Interrupted)
+	assert.Equal(t, 5, ts.headers.numGets)
+	assert.Equal(t, 5, ts.miniBlocks.numGets)
+	assert.True(t, stats.NumTrieNodes > 1)
+	assert.Equal(t, stats.NumTrieNodes, ts.trieStorage.numGets)
+}
+
+func TestStorerPreloader_PreloadFewerHeadersThanConfiguredShouldLoadAll(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorers()
+	commitBlocks(t, ts, 3)
+	sp, _ := preload.NewStorerPreloader(createArgStorerPreloader(ts))
+
+	stats := sp.Preload()
+
+	assert.Equal(t, uint64(3), stats.HighestNonce)
+	assert.Equal(t, 3, stats.NumHeaders)
+	assert.Equal(t, 3, stats.NumMiniBlocks)
+}
+
+func TestStorerPreloader_PreloadShouldStopAtTheConfiguredTrieDepth(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorers()
+	commitBlocks(t, ts, 2)
+	args := createArgStorerPreloader(ts)
+	args.Config.TrieDepth = 1
+	sp, _ := preload.NewStorerPreloader(args)
+
+	stats := sp.Preload()
+
+	assert.Equal(t, 1, stats.NumTrieNodes)
+}
+
+func TestStorerPreloader_PreloadShouldThrottleTheReads(t *testing.T) {
+	t.Parallel()
+
+	ts := createTestStorers()
+	commitBlocks(t, ts, 2)
+	args := createArgStorerPreloader(ts)
+	args.Config.TrieDepth = 0
+	args.Config.MaxReadsPerSecond = 50
+	sp, _ := preload.NewStorerPreloader(args)
+
+	stats := sp.Preload()
+
+	// 4 nonce probes and 3 reads for each of the 2 headers, the 10th read being allowed after 9 * 20ms
+	assert.Equal(t, 2, stats.NumHeaders)
+	assert.True(t, stats.Duration >= 9*20*time.Millisecond)
+}
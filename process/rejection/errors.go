@@ -0,0 +1,20 @@
+package rejection
+
+import (
+	"errors"
+)
+
+// ErrInvalidWindow signals that an invalid rolling window duration has been provided
+var ErrInvalidWindow = errors.New("invalid rolling window duration")
+
+// ErrInvalidNumBuckets signals that an invalid number of rolling window buckets has been provided
+var ErrInvalidNumBuckets = errors.New("invalid number of rolling window buckets")
+
+// ErrInvalidMaxEntriesPerBucket signals that an invalid maximum number of entries per bucket has been provided
+var ErrInvalidMaxEntriesPerBucket = errors.New("invalid maximum number of entries per bucket")
+
+// ErrNilRejectionTracker signals that a nil rejection tracker has been provided
+var ErrNilRejectionTracker = errors.New("nil rejection tracker")
+
+// ErrNilTopicHandler signals that a nil topic handler has been provided
+var ErrNilTopicHandler = errors.New("nil topic handler")
@@ -0,0 +1,9 @@
+package rejection
+
+import (
+	"time"
+)
+
+func (rt *RejectionTracker) SetCurrentTime(currentTime func() time.Time) {
+	rt.currentTime = currentTime
+}
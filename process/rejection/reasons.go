@@ -0,0 +1,91 @@
+package rejection
+
+import (
+	"encoding/json"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// ReasonDecode is the reason of the messages whose payload could not be unmarshaled
+const ReasonDecode = "decode"
+
+// ReasonSignature is the reason of the messages carrying missing or invalid signatures
+const ReasonSignature = "signature"
+
+// ReasonStale is the reason of the messages carrying data already committed or too old to be processed
+const ReasonStale = "stale"
+
+// ReasonFlood is the reason of the messages dropped because the node was too busy to process them
+const ReasonFlood = "flood"
+
+// ReasonSize is the reason of the messages larger than the allowed size
+const ReasonSize = "size"
+
+// ReasonShardMismatch is the reason of the messages carrying data that does not belong to the expected shard
+const ReasonShardMismatch = "shardMismatch"
+
+// ReasonOther is the reason of the rejected messages not fitting any other reason
+const ReasonOther = "other"
+
+var reasonsByError = map[error]string{
+	process.ErrUnmarshalWithoutSuccess:        ReasonDecode,
+	process.ErrCouldNotDecodeUnderlyingBody:   ReasonDecode,
+	process.ErrNilDataToProcess:               ReasonDecode,
+	process.ErrNilBuffer:                      ReasonDecode,
+	process.ErrNoTransactionInMessage:         ReasonDecode,
+	process.ErrNoUnsignedTransactionInMessage: ReasonDecode,
+	process.ErrNoRewardTransactionInMessage:   ReasonDecode,
+
+	process.ErrNilSignature:                  ReasonSignature,
+	process.ErrNilPubKeysBitmap:              ReasonSignature,
+	process.ErrBlockProposerSignatureMissing: ReasonSignature,
+	crypto.ErrNilSignature:                   ReasonSignature,
+	crypto.ErrSigNotValid:                    ReasonSignature,
+	crypto.ErrAggSigNotValid:                 ReasonSignature,
+	crypto.ErrBitmapMismatch:                 ReasonSignature,
+	crypto.ErrInvalidSigner:                  ReasonSignature,
+
+	process.ErrHeaderAlreadyCommitted:      ReasonStale,
+	process.ErrHeaderNonceAlreadyCommitted: ReasonStale,
+	process.ErrLowerRoundInBlock:           ReasonStale,
+	process.ErrLowerNonceInTransaction:     ReasonStale,
+
+	process.ErrSystemBusy: ReasonFlood,
+
+	p2p.ErrMessageTooLarge: ReasonSize,
+
+	process.ErrInvalidShardId:            ReasonShardMismatch,
+	process.ErrShardIdMissmatch:          ReasonShardMismatch,
+	process.ErrMintAddressNotInThisShard: ReasonShardMismatch,
+}
+
+// AllReasons returns all the rejection reasons
+func AllReasons() []string {
+	return []string{
+		ReasonDecode,
+		ReasonSignature,
+		ReasonStale,
+		ReasonFlood,
+		ReasonSize,
+		ReasonShardMismatch,
+		ReasonOther,
+	}
+}
+
+// ClassifyError returns the rejection reason of the error returned by a message processor. The unmarshal errors of
+// the JSON marshalizer are classified as decode errors, as they are not sentinel errors
+func ClassifyError(err error) string {
+	reason, ok := reasonsByError[err]
+	if ok {
+		return reason
+	}
+
+	switch err.(type) {
+	case *json.SyntaxError, *json.UnmarshalTypeError:
+		return ReasonDecode
+	default:
+		return ReasonOther
+	}
+}
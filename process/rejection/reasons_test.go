@@ -0,0 +1,40 @@
+package rejection_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/rejection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyError(t *testing.T) {
+	t.Parallel()
+
+	var unmarshaled interface{}
+	jsonErr := json.Unmarshal([]byte("{not json"), &unmarshaled)
+
+	assert.Equal(t, rejection.ReasonDecode, rejection.ClassifyError(process.ErrUnmarshalWithoutSuccess))
+	assert.Equal(t, rejection.ReasonDecode, rejection.ClassifyError(jsonErr))
+	assert.Equal(t, rejection.ReasonSignature, rejection.ClassifyError(crypto.ErrSigNotValid))
+	assert.Equal(t, rejection.ReasonSignature, rejection.ClassifyError(process.ErrBlockProposerSignatureMissing))
+	assert.Equal(t, rejection.ReasonStale, rejection.ClassifyError(process.ErrHeaderNonceAlreadyCommitted))
+	assert.Equal(t, rejection.ReasonFlood, rejection.ClassifyError(process.ErrSystemBusy))
+	assert.Equal(t, rejection.ReasonSize, rejection.ClassifyError(p2p.ErrMessageTooLarge))
+	assert.Equal(t, rejection.ReasonShardMismatch, rejection.ClassifyError(process.ErrShardIdMissmatch))
+	assert.Equal(t, rejection.ReasonOther, rejection.ClassifyError(errors.New("unknown error")))
+}
+
+func TestAllReasons(t *testing.T) {
+	t.Parallel()
+
+	reasons := rejection.AllReasons()
+
+	assert.Equal(t, 7, len(reasons))
+	assert.Contains(t, reasons, rejection.ReasonShardMismatch)
+	assert.Contains(t, reasons, rejection.ReasonOther)
+}
@@ -0,0 +1,111 @@
+package rejection
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// RejectionRecorder defines what the component counting the rejected messages should do
+type RejectionRecorder interface {
+	AddRejection(peer p2p.PeerID, topic string, reason string)
+	IsInterfaceNil() bool
+}
+
+// recordingTopicHandler is a topic handler registering, instead of the provided message processors, decorators
+// recording the messages they reject
+type recordingTopicHandler struct {
+	process.TopicHandler
+	recorder       RejectionRecorder
+	maxMessageSize int
+}
+
+// NewRecordingTopicHandler creates a topic handler wrapping the message processors registered through it so that
+// their rejections are recorded. The messages larger than maxMessageSize are rejected before reaching the wrapped
+// processors, a 0 value meaning that the size is not checked
+func NewRecordingTopicHandler(
+	topicHandler process.TopicHandler,
+	recorder RejectionRecorder,
+	maxMessageSize int,
+) (*recordingTopicHandler, error) {
+	if topicHandler == nil {
+		return nil, ErrNilTopicHandler
+	}
+	if recorder == nil || recorder.IsInterfaceNil() {
+		return nil, ErrNilRejectionTracker
+	}
+
+	return &recordingTopicHandler{
+		TopicHandler:   topicHandler,
+		recorder:       recorder,
+		maxMessageSize: maxMessageSize,
+	}, nil
+}
+
+// RegisterMessageProcessor registers on the provided topic the processor wrapped in a rejection recording decorator
+func (rth *recordingTopicHandler) RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error {
+	if handler == nil || handler.IsInterfaceNil() {
+		return rth.TopicHandler.RegisterMessageProcessor(topic, handler)
+	}
+
+	return rth.TopicHandler.RegisterMessageProcessor(topic, &recordingProcessor{
+		topic:          topic,
+		processor:      handler,
+		recorder:       rth.recorder,
+		maxMessageSize: rth.maxMessageSize,
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rth *recordingTopicHandler) IsInterfaceNil() bool {
+	if rth == nil {
+		return true
+	}
+	return false
+}
+
+type recordingProcessor struct {
+	topic          string
+	processor      p2p.MessageProcessor
+	recorder       RejectionRecorder
+	maxMessageSize int
+}
+
+// ProcessReceivedMessage forwards the message to the wrapped processor and records it if rejected. The messages
+// dropped because the processing context expired are not recorded, as this is not the sender's fault
+func (rp *recordingProcessor) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
+	if message == nil || message.IsInterfaceNil() {
+		return rp.processor.ProcessReceivedMessage(ctx, message)
+	}
+
+	if rp.maxMessageSize > 0 && len(message.Data()) > rp.maxMessageSize {
+		rp.recorder.AddRejection(message.Peer(), rp.topic, ReasonSize)
+		return p2p.ErrMessageTooLarge
+	}
+
+	err := rp.processor.ProcessReceivedMessage(ctx, message)
+	if err == nil || err == context.DeadlineExceeded || err == context.Canceled {
+		return err
+	}
+
+	rp.recorder.AddRejection(message.Peer(), rp.topic, ClassifyError(err))
+
+	return err
+}
+
+// SetBroadcastCallback forwards the broadcast callback to the wrapped processor, if it needs one
+func (rp *recordingProcessor) SetBroadcastCallback(callback func(buffToSend []byte)) {
+	broadcastCallbackHandler, ok := rp.processor.(p2p.BroadcastCallbackHandler)
+	if ok {
+		broadcastCallbackHandler.SetBroadcastCallback(callback)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rp *recordingProcessor) IsInterfaceNil() bool {
+	if rp == nil {
+		return true
+	}
+	return false
+}
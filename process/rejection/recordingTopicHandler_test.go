@@ -0,0 +1,131 @@
+package rejection_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/rejection"
+	"github.com/stretchr/testify/assert"
+)
+
+type broadcastingInterceptorStub struct {
+	mock.InterceptorStub
+	callback func(buffToSend []byte)
+}
+
+func (bis *broadcastingInterceptorStub) SetBroadcastCallback(callback func(buffToSend []byte)) {
+	bis.callback = callback
+}
+
+func registerProcessor(
+	t *testing.T,
+	rt *rejection.RejectionTracker,
+	maxMessageSize int,
+	handler p2p.MessageProcessor,
+) p2p.MessageProcessor {
+	var registered p2p.MessageProcessor
+	topicHandler := &mock.TopicHandlerStub{
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			registered = handler
+			return nil
+		},
+	}
+
+	rth, err := rejection.NewRecordingTopicHandler(topicHandler, rt, maxMessageSize)
+	assert.Nil(t, err)
+	assert.False(t, rth.IsInterfaceNil())
+
+	err = rth.RegisterMessageProcessor("topic", handler)
+	assert.Nil(t, err)
+
+	return registered
+}
+
+func createMessage(data []byte) *mock.P2PMessageMock {
+	return &mock.P2PMessageMock{
+		DataField: data,
+		PeerField: "peer",
+	}
+}
+
+func TestNewRecordingTopicHandler_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rt, _ := rejection.NewRejectionTracker(time.Minute, 6, 100, nil)
+
+	rth, err := rejection.NewRecordingTopicHandler(nil, rt, 0)
+	assert.Nil(t, rth)
+	assert.Equal(t, rejection.ErrNilTopicHandler, err)
+
+	rth, err = rejection.NewRecordingTopicHandler(&mock.TopicHandlerStub{}, nil, 0)
+	assert.Nil(t, rth)
+	assert.Equal(t, rejection.ErrNilRejectionTracker, err)
+}
+
+func TestRecordingTopicHandler_ProcessorShouldRecordRejections(t *testing.T) {
+	t.Parallel()
+
+	rt, _ := rejection.NewRejectionTracker(time.Minute, 6, 100, createWeights())
+	processErr := process.ErrSystemBusy
+	processor := registerProcessor(t, rt, 0, &mock.InterceptorStub{
+		ProcessReceivedMessageCalled: func(message p2p.MessageP2P) error {
+			return processErr
+		},
+	})
+
+	err := processor.ProcessReceivedMessage(context.Background(), createMessage([]byte("data")))
+	assert.Equal(t, process.ErrSystemBusy, err)
+
+	processErr = nil
+	err = processor.ProcessReceivedMessage(context.Background(), createMessage([]byte("data")))
+	assert.Nil(t, err)
+
+	processErr = context.DeadlineExceeded
+	_ = processor.ProcessReceivedMessage(context.Background(), createMessage([]byte("data")))
+
+	processErr = errors.New("unknown error")
+	_ = processor.ProcessReceivedMessage(context.Background(), createMessage([]byte("data")))
+
+	peerRejections := rt.PeerRejections("peer")
+	assert.Equal(t, uint64(2), peerRejections.NumRejections)
+	assert.Equal(t, uint64(1), peerRejections.Topics["topic"][rejection.ReasonFlood])
+	assert.Equal(t, uint64(1), peerRejections.Topics["topic"][rejection.ReasonOther])
+}
+
+func TestRecordingTopicHandler_ProcessorShouldRejectTooLargeMessages(t *testing.T) {
+	t.Parallel()
+
+	rt, _ := rejection.NewRejectionTracker(time.Minute, 6, 100, createWeights())
+	wasCalled := false
+	processor := registerProcessor(t, rt, 4, &mock.InterceptorStub{
+		ProcessReceivedMessageCalled: func(message p2p.MessageP2P) error {
+			wasCalled = true
+			return nil
+		},
+	})
+
+	err := processor.ProcessReceivedMessage(context.Background(), createMessage([]byte("too large")))
+
+	assert.Equal(t, p2p.ErrMessageTooLarge, err)
+	assert.False(t, wasCalled)
+	assert.Equal(t, uint64(1), rt.PeerRejections("peer").Reasons[rejection.ReasonSize])
+}
+
+func TestRecordingTopicHandler_ProcessorShouldForwardTheBroadcastCallback(t *testing.T) {
+	t.Parallel()
+
+	rt, _ := rejection.NewRejectionTracker(time.Minute, 6, 100, createWeights())
+	interceptor := &broadcastingInterceptorStub{}
+	processor := registerProcessor(t, rt, 0, interceptor)
+
+	broadcastCallbackHandler, ok := processor.(p2p.BroadcastCallbackHandler)
+	assert.True(t, ok)
+	broadcastCallbackHandler.SetBroadcastCallback(func(buffToSend []byte) {})
+
+	assert.NotNil(t, interceptor.callback)
+}
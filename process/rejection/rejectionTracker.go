@@ -0,0 +1,200 @@
+package rejection
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// PeerRejections holds the messages of a peer rejected during the rolling window, counted per reason and per topic
+// and reason. The reputation penalty is the sum of the rejections weighted by their reason: the higher it is, the
+// less the peer should be trusted
+type PeerRejections struct {
+	Peer              string                       `json:"peer"`
+	ReputationPenalty uint64                       `json:"reputationPenalty"`
+	NumRejections     uint64                       `json:"numRejections"`
+	Reasons           map[string]uint64            `json:"reasons"`
+	Topics            map[string]map[string]uint64 `json:"topics"`
+}
+
+type rejectionKey struct {
+	peer   p2p.PeerID
+	topic  string
+	reason string
+}
+
+type rejectionBucket struct {
+	slot   int64
+	counts map[rejectionKey]uint64
+}
+
+// RejectionTracker counts, per peer, topic and reason, the messages rejected by the interceptors during a rolling
+// window. The window is split in buckets, the oldest one being reset when a new one starts. A bucket keeps at most
+// maxEntriesPerBucket distinct (peer, topic, reason) entries, so that a flood coming from many peers can not grow
+// the tracker without bound
+type RejectionTracker struct {
+	bucketDuration      time.Duration
+	maxEntriesPerBucket int
+	weights             map[string]uint64
+	currentTime         func() time.Time
+
+	mutBuckets sync.Mutex
+	buckets    []*rejectionBucket
+}
+
+// NewRejectionTracker creates a new rejection tracker for the provided rolling window. The weights give the
+// reputation penalty of a rejection for each reason, a missing reason weighting 0
+func NewRejectionTracker(
+	window time.Duration,
+	numBuckets int,
+	maxEntriesPerBucket int,
+	weights map[string]uint64,
+) (*RejectionTracker, error) {
+	if numBuckets <= 0 {
+		return nil, ErrInvalidNumBuckets
+	}
+	if window < time.Duration(numBuckets) {
+		return nil, ErrInvalidWindow
+	}
+	if maxEntriesPerBucket <= 0 {
+		return nil, ErrInvalidMaxEntriesPerBucket
+	}
+
+	rt := &RejectionTracker{
+		bucketDuration:      window / time.Duration(numBuckets),
+		maxEntriesPerBucket: maxEntriesPerBucket,
+		weights:             make(map[string]uint64, len(weights)),
+		currentTime:         time.Now,
+		buckets:             make([]*rejectionBucket, numBuckets),
+	}
+	for reason, weight := range weights {
+		rt.weights[reason] = weight
+	}
+	for i := range rt.buckets {
+		rt.buckets[i] = &rejectionBucket{
+			slot:   -1,
+			counts: make(map[rejectionKey]uint64),
+		}
+	}
+
+	return rt, nil
+}
+
+// AddRejection records a message of the provided peer rejected on the provided topic for the provided reason
+func (rt *RejectionTracker) AddRejection(peer p2p.PeerID, topic string, reason string) {
+	slot := rt.currentSlot()
+	key := rejectionKey{peer: peer, topic: topic, reason: reason}
+
+	rt.mutBuckets.Lock()
+	defer rt.mutBuckets.Unlock()
+
+	bucket := rt.buckets[slot%int64(len(rt.buckets))]
+	if bucket.slot != slot {
+		bucket.slot = slot
+		bucket.counts = make(map[rejectionKey]uint64)
+	}
+
+	_, exists := bucket.counts[key]
+	if !exists && len(bucket.counts) >= rt.maxEntriesPerBucket {
+		return
+	}
+	bucket.counts[key]++
+}
+
+// Rejections returns the rejections of all the peers during the rolling window, the most penalized peers first
+func (rt *RejectionTracker) Rejections() []PeerRejections {
+	peers := rt.aggregate(nil)
+
+	rejections := make([]PeerRejections, 0, len(peers))
+	for _, peerRejections := range peers {
+		rejections = append(rejections, *peerRejections)
+	}
+
+	sort.Slice(rejections, func(i, j int) bool {
+		if rejections[i].ReputationPenalty != rejections[j].ReputationPenalty {
+			return rejections[i].ReputationPenalty > rejections[j].ReputationPenalty
+		}
+		return rejections[i].Peer < rejections[j].Peer
+	})
+
+	return rejections
+}
+
+// PeerRejections returns the rejections of the provided peer during the rolling window
+func (rt *RejectionTracker) PeerRejections(peer p2p.PeerID) PeerRejections {
+	peers := rt.aggregate(&peer)
+
+	peerRejections, ok := peers[peer]
+	if !ok {
+		return *newPeerRejections(peer)
+	}
+
+	return *peerRejections
+}
+
+// ReputationPenalty returns the sum of the rejections of the provided peer during the rolling window, weighted by
+// their reason
+func (rt *RejectionTracker) ReputationPenalty(peer p2p.PeerID) uint64 {
+	return rt.PeerRejections(peer).ReputationPenalty
+}
+
+func (rt *RejectionTracker) aggregate(onlyPeer *p2p.PeerID) map[p2p.PeerID]*PeerRejections {
+	oldestSlot := rt.currentSlot() - int64(len(rt.buckets)) + 1
+	peers := make(map[p2p.PeerID]*PeerRejections)
+
+	rt.mutBuckets.Lock()
+	defer rt.mutBuckets.Unlock()
+
+	for _, bucket := range rt.buckets {
+		if bucket.slot < oldestSlot {
+			continue
+		}
+
+		for key, count := range bucket.counts {
+			if onlyPeer != nil && key.peer != *onlyPeer {
+				continue
+			}
+
+			peerRejections, ok := peers[key.peer]
+			if !ok {
+				peerRejections = newPeerRejections(key.peer)
+				peers[key.peer] = peerRejections
+			}
+
+			topicReasons, ok := peerRejections.Topics[key.topic]
+			if !ok {
+				topicReasons = make(map[string]uint64)
+				peerRejections.Topics[key.topic] = topicReasons
+			}
+
+			topicReasons[key.reason] += count
+			peerRejections.Reasons[key.reason] += count
+			peerRejections.NumRejections += count
+			peerRejections.ReputationPenalty += count * rt.weights[key.reason]
+		}
+	}
+
+	return peers
+}
+
+func newPeerRejections(peer p2p.PeerID) *PeerRejections {
+	return &PeerRejections{
+		Peer:    peer.Pretty(),
+		Reasons: make(map[string]uint64),
+		Topics:  make(map[string]map[string]uint64),
+	}
+}
+
+func (rt *RejectionTracker) currentSlot() int64 {
+	return rt.currentTime().UnixNano() / int64(rt.bucketDuration)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rt *RejectionTracker) IsInterfaceNil() bool {
+	if rt == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+package rejection_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/rejection"
+	"github.com/stretchr/testify/assert"
+)
+
+func createWeights() map[string]uint64 {
+	return map[string]uint64{
+		rejection.ReasonDecode:    5,
+		rejection.ReasonSignature: 10,
+		rejection.ReasonFlood:     1,
+	}
+}
+
+func createRejectionTracker(currentTime *time.Time) *rejection.RejectionTracker {
+	rt, _ := rejection.NewRejectionTracker(time.Minute, 6, 100, createWeights())
+	rt.SetCurrentTime(func() time.Time {
+		return *currentTime
+	})
+
+	return rt
+}
+
+//------- NewRejectionTracker
+
+func TestNewRejectionTracker_InvalidNumBucketsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rt, err := rejection.NewRejectionTracker(time.Minute, 0, 100, nil)
+
+	assert.Nil(t, rt)
+	assert.Equal(t, rejection.ErrInvalidNumBuckets, err)
+}
+
+func TestNewRejectionTracker_InvalidWindowShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rt, err := rejection.NewRejectionTracker(0, 6, 100, nil)
+
+	assert.Nil(t, rt)
+	assert.Equal(t, rejection.ErrInvalidWindow, err)
+}
+
+func TestNewRejectionTracker_InvalidMaxEntriesPerBucketShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rt, err := rejection.NewRejectionTracker(time.Minute, 6, 0, nil)
+
+	assert.Nil(t, rt)
+	assert.Equal(t, rejection.ErrInvalidMaxEntriesPerBucket, err)
+}
+
+func TestNewRejectionTracker_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rt, err := rejection.NewRejectionTracker(time.Minute, 6, 100, nil)
+
+	assert.Nil(t, err)
+	assert.False(t, rt.IsInterfaceNil())
+	assert.Equal(t, 0, len(rt.Rejections()))
+}
+
+//------- AddRejection
+
+func TestRejectionTracker_AddRejectionShouldCountPerTopicAndReason(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	rt := createRejectionTracker(&currentTime)
+
+	rt.AddRejection("peer", "transactions", rejection.ReasonDecode)
+	rt.AddRejection("peer", "transactions", rejection.ReasonDecode)
+	rt.AddRejection("peer", "headers", rejection.ReasonSignature)
+	rt.AddRejection("peer", "headers", rejection.ReasonStale)
+
+	peerRejections := rt.PeerRejections("peer")
+	assert.Equal(t, p2p.PeerID("peer").Pretty(), peerRejections.Peer)
+	assert.Equal(t, uint64(4), peerRejections.NumRejections)
+	assert.Equal(t, uint64(2), peerRejections.Reasons[rejection.ReasonDecode])
+	assert.Equal(t, uint64(2), peerRejections.Topics["transactions"][rejection.ReasonDecode])
+	assert.Equal(t, uint64(1), peerRejections.Topics["headers"][rejection.ReasonSignature])
+	assert.Equal(t, uint64(1), peerRejections.Topics["headers"][rejection.ReasonStale])
+	assert.Equal(t, uint64(2*5+10), peerRejections.ReputationPenalty)
+	assert.Equal(t, uint64(20), rt.ReputationPenalty("peer"))
+}
+
+func TestRejectionTracker_RejectionsShouldSortByPenalty(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	rt := createRejectionTracker(&currentTime)
+
+	rt.AddRejection("flooder", "transactions", rejection.ReasonFlood)
+	rt.AddRejection("flooder", "transactions", rejection.ReasonFlood)
+	rt.AddRejection("forger", "headers", rejection.ReasonSignature)
+
+	rejections := rt.Rejections()
+
+	assert.Equal(t, 2, len(rejections))
+	assert.Equal(t, p2p.PeerID("forger").Pretty(), rejections[0].Peer)
+	assert.Equal(t, uint64(10), rejections[0].ReputationPenalty)
+	assert.Equal(t, uint64(2), rejections[1].ReputationPenalty)
+}
+
+func TestRejectionTracker_RejectionsOutsideTheWindowShouldBeForgotten(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	rt := createRejectionTracker(&currentTime)
+
+	rt.AddRejection("peer", "headers", rejection.ReasonSignature)
+	currentTime = currentTime.Add(30 * time.Second)
+	rt.AddRejection("peer", "headers", rejection.ReasonDecode)
+	assert.Equal(t, uint64(2), rt.PeerRejections("peer").NumRejections)
+
+	currentTime = currentTime.Add(40 * time.Second)
+	peerRejections := rt.PeerRejections("peer")
+	assert.Equal(t, uint64(1), peerRejections.NumRejections)
+	assert.Equal(t, uint64(5), peerRejections.ReputationPenalty)
+
+	currentTime = currentTime.Add(time.Minute)
+	assert.Equal(t, uint64(0), rt.PeerRejections("peer").NumRejections)
+	assert.Equal(t, 0, len(rt.Rejections()))
+}
+
+func TestRejectionTracker_AddRejectionShouldNotExceedMaxEntriesPerBucket(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	rt, _ := rejection.NewRejectionTracker(time.Minute, 6, 2, createWeights())
+	rt.SetCurrentTime(func() time.Time {
+		return currentTime
+	})
+
+	rt.AddRejection("peer1", "topic", rejection.ReasonDecode)
+	rt.AddRejection("peer2", "topic", rejection.ReasonDecode)
+	rt.AddRejection("peer3", "topic", rejection.ReasonDecode)
+	rt.AddRejection("peer1", "topic", rejection.ReasonDecode)
+
+	assert.Equal(t, 2, len(rt.Rejections()))
+	assert.Equal(t, uint64(2), rt.PeerRejections("peer1").NumRejections)
+	assert.Equal(t, uint64(0), rt.PeerRejections("peer3").NumRejections)
+}
@@ -0,0 +1,249 @@
+package replay
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.DefaultLogger()
+
+// TxCheckpoint holds the accounts state reached after executing one transaction of the replayed block
+type TxCheckpoint struct {
+	TxHash   string `json:"txHash"`
+	RootHash string `json:"rootHash"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Report is the outcome of a block replay. DivergentTxIndex is -1 if no divergent transaction was found
+type Report struct {
+	Nonce             uint64         `json:"nonce"`
+	Round             uint64         `json:"round"`
+	HeaderHash        string         `json:"headerHash"`
+	ParentRootHash    string         `json:"parentRootHash"`
+	ExpectedRootHash  string         `json:"expectedRootHash"`
+	ComputedRootHash  string         `json:"computedRootHash"`
+	RootHashMatches   bool           `json:"rootHashMatches"`
+	SkippedMiniBlocks int            `json:"skippedMiniBlocks"`
+	Checkpoints       []TxCheckpoint `json:"checkpoints"`
+	DivergentTxIndex  int            `json:"divergentTxIndex"`
+	DivergentTxHash   string         `json:"divergentTxHash,omitempty"`
+}
+
+// BlockReplayer re-executes the transactions of a stored shard block starting from its parent's state root,
+// recording a checkpoint after each transaction. Only the transactions miniblocks are executed, the other
+// miniblocks (smart contract results, rewards) being counted as skipped, so the computed root hash is expected to
+// match the header's root hash only for blocks without skipped miniblocks.
+// The replay uses the node's accounts and transactions processor, so it must not run while the node is processing
+// or syncing blocks
+type BlockReplayer struct {
+	store            dataRetriever.StorageService
+	marshalizer      marshal.Marshalizer
+	uint64Converter  typeConverters.Uint64ByteSliceConverter
+	accounts         state.AccountsAdapter
+	txProcessor      process.TransactionProcessor
+	shardCoordinator sharding.Coordinator
+}
+
+// NewBlockReplayer creates a new BlockReplayer instance
+func NewBlockReplayer(
+	store dataRetriever.StorageService,
+	marshalizer marshal.Marshalizer,
+	uint64Converter typeConverters.Uint64ByteSliceConverter,
+	accounts state.AccountsAdapter,
+	txProcessor process.TransactionProcessor,
+	shardCoordinator sharding.Coordinator,
+) (*BlockReplayer, error) {
+
+	if store == nil || store.IsInterfaceNil() {
+		return nil, process.ErrNilStore
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+	if uint64Converter == nil || uint64Converter.IsInterfaceNil() {
+		return nil, process.ErrNilUint64Converter
+	}
+	if accounts == nil || accounts.IsInterfaceNil() {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if txProcessor == nil || txProcessor.IsInterfaceNil() {
+		return nil, process.ErrNilTxProcessor
+	}
+	if shardCoordinator == nil || shardCoordinator.IsInterfaceNil() {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if shardCoordinator.SelfId() >= shardCoordinator.NumberOfShards() {
+		return nil, process.ErrReplayNotSupportedOnMetachain
+	}
+
+	return &BlockReplayer{
+		store:            store,
+		marshalizer:      marshalizer,
+		uint64Converter:  uint64Converter,
+		accounts:         accounts,
+		txProcessor:      txProcessor,
+		shardCoordinator: shardCoordinator,
+	}, nil
+}
+
+// ReplayBlock re-executes the stored block with the provided nonce. If reference checkpoints are provided, usually
+// taken from the replay report of another node, the first transaction whose checkpoint differs is reported as
+// divergent. Otherwise, the first transaction failing to execute is reported as divergent. The accounts state is
+// restored at the end of the replay
+func (br *BlockReplayer) ReplayBlock(nonce uint64, reference []TxCheckpoint) (*Report, error) {
+	if nonce == 0 {
+		return nil, process.ErrGenesisBlockCanNotBeReplayed
+	}
+	if br.accounts.JournalLen() != 0 {
+		return nil, process.ErrAccountStateDirty
+	}
+
+	header, headerHash, err := process.GetShardHeaderFromStorageWithNonce(
+		nonce,
+		br.shardCoordinator.SelfId(),
+		br.store,
+		br.uint64Converter,
+		br.marshalizer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parent, err := process.GetShardHeaderFromStorage(header.PrevHash, br.marshalizer, br.store)
+	if err != nil {
+		return nil, err
+	}
+
+	currentRootHash, err := br.accounts.RootHash()
+	if err != nil {
+		return nil, err
+	}
+	defer br.restoreAccounts(currentRootHash)
+
+	err = br.accounts.RecreateTrie(parent.RootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Nonce:            header.Nonce,
+		Round:            header.Round,
+		HeaderHash:       hex.EncodeToString(headerHash),
+		ParentRootHash:   hex.EncodeToString(parent.RootHash),
+		ExpectedRootHash: hex.EncodeToString(header.RootHash),
+		Checkpoints:      make([]TxCheckpoint, 0),
+		DivergentTxIndex: -1,
+	}
+
+	err = br.executeMiniBlocks(header, report)
+	if err != nil {
+		return nil, err
+	}
+
+	computedRootHash, err := br.accounts.RootHash()
+	if err != nil {
+		return nil, err
+	}
+	report.ComputedRootHash = hex.EncodeToString(computedRootHash)
+	report.RootHashMatches = report.ComputedRootHash == report.ExpectedRootHash
+
+	setDivergentTx(report, reference)
+
+	return report, nil
+}
+
+func (br *BlockReplayer) executeMiniBlocks(header *block.Header, report *Report) error {
+	for _, miniBlockHeader := range header.MiniBlockHeaders {
+		if miniBlockHeader.Type != block.TxBlock {
+			report.SkippedMiniBlocks++
+			continue
+		}
+
+		buffMiniBlock, err := br.store.Get(dataRetriever.MiniBlockUnit, miniBlockHeader.Hash)
+		if err != nil {
+			return err
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = br.marshalizer.Unmarshal(miniBlock, buffMiniBlock)
+		if err != nil {
+			return err
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			txHandler, err := process.GetTransactionHandlerFromStorage(txHash, br.store, br.marshalizer)
+			if err != nil {
+				return err
+			}
+
+			tx, ok := txHandler.(*transaction.Transaction)
+			if !ok {
+				return process.ErrWrongTypeAssertion
+			}
+
+			checkpoint := TxCheckpoint{TxHash: hex.EncodeToString(txHash)}
+			errProcess := br.txProcessor.ProcessTransaction(tx, header.Round)
+			if errProcess != nil {
+				checkpoint.Error = errProcess.Error()
+			}
+
+			rootHash, err := br.accounts.RootHash()
+			if err != nil {
+				return err
+			}
+			checkpoint.RootHash = hex.EncodeToString(rootHash)
+			report.Checkpoints = append(report.Checkpoints, checkpoint)
+
+			if errProcess != nil {
+				//the state after a failed transaction is not the one the original execution continued from
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+func (br *BlockReplayer) restoreAccounts(rootHash []byte) {
+	err := br.accounts.RevertToSnapshot(0)
+	if err != nil {
+		log.Error("block replay: could not revert the accounts journal: " + err.Error())
+	}
+
+	err = br.accounts.RecreateTrie(rootHash)
+	if err != nil {
+		log.Error("block replay: could not restore the accounts state: " + err.Error())
+	}
+}
+
+func setDivergentTx(report *Report, reference []TxCheckpoint) {
+	for idx, checkpoint := range report.Checkpoints {
+		divergent := len(checkpoint.Error) > 0
+		if len(reference) > 0 {
+			divergent = idx >= len(reference) || reference[idx] != checkpoint
+		}
+
+		if divergent {
+			report.DivergentTxIndex = idx
+			report.DivergentTxHash = checkpoint.TxHash
+			return
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (br *BlockReplayer) IsInterfaceNil() bool {
+	if br == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,240 @@
+package replay_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/replay"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+)
+
+var currentRootHash = []byte("current root")
+var parentRootHash = []byte("parent root")
+
+func generateTestUnit() storage.Storer {
+	cache, _ := storageUnit.NewCache(storageUnit.LRUCache, 1000, 1)
+	memDB, _ := memorydb.New()
+	storer, _ := storageUnit.NewStorageUnit(cache, memDB)
+	return storer
+}
+
+// createStore saves a block with nonce 1, holding a transactions miniblock with two transactions and a rewards
+// miniblock, and its parent
+func createStore(marshalizer marshal.Marshalizer) dataRetriever.StorageService {
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.TransactionUnit, generateTestUnit())
+	store.AddStorer(dataRetriever.MiniBlockUnit, generateTestUnit())
+	store.AddStorer(dataRetriever.BlockHeaderUnit, generateTestUnit())
+	store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit, generateTestUnit())
+
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		buff, _ := marshalizer.Marshal(&transaction.Transaction{Nonce: nonce})
+		_ = store.Put(dataRetriever.TransactionUnit, []byte(fmt.Sprintf("tx%d", nonce)), buff)
+	}
+
+	buff, _ := marshalizer.Marshal(&block.MiniBlock{TxHashes: [][]byte{[]byte("tx1"), []byte("tx2")}, Type: block.TxBlock})
+	_ = store.Put(dataRetriever.MiniBlockUnit, []byte("mb"), buff)
+
+	buff, _ = marshalizer.Marshal(&block.Header{Nonce: 0, RootHash: parentRootHash})
+	_ = store.Put(dataRetriever.BlockHeaderUnit, []byte("hdr0"), buff)
+
+	buff, _ = marshalizer.Marshal(&block.Header{
+		Nonce:    1,
+		Round:    3,
+		PrevHash: []byte("hdr0"),
+		RootHash: []byte("parent root tx1 tx2"),
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: []byte("mb"), Type: block.TxBlock},
+			{Hash: []byte("rewards mb"), Type: block.RewardsBlock},
+		},
+	})
+	_ = store.Put(dataRetriever.BlockHeaderUnit, []byte("hdr1"), buff)
+	_ = store.Put(dataRetriever.ShardHdrNonceHashDataUnit, uint64ByteSlice.NewBigEndianConverter().ToByteSlice(1), []byte("hdr1"))
+
+	return store
+}
+
+// createAccounts simulates an accounts state whose root hash is the recreated root hash followed by the hashes
+// of the transactions executed since
+func createAccounts(rootHash *[]byte) *mock.AccountsStub {
+	return &mock.AccountsStub{
+		JournalLenCalled: func() int {
+			return 0
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return *rootHash, nil
+		},
+		RecreateTrieCalled: func(newRootHash []byte) error {
+			*rootHash = newRootHash
+			return nil
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			return nil
+		},
+	}
+}
+
+func createTxProcessor(rootHash *[]byte, errOnNonce uint64) *mock.TxProcessorMock {
+	return &mock.TxProcessorMock{
+		ProcessTransactionCalled: func(tx *transaction.Transaction, round uint64) error {
+			if tx.Nonce == errOnNonce {
+				return errors.New("insufficient funds")
+			}
+
+			*rootHash = append(append([]byte{}, *rootHash...), []byte(fmt.Sprintf(" tx%d", tx.Nonce))...)
+			return nil
+		},
+	}
+}
+
+func createReplayer(rootHash *[]byte, errOnNonce uint64) *replay.BlockReplayer {
+	marshalizer := &mock.MarshalizerMock{}
+	br, _ := replay.NewBlockReplayer(
+		createStore(marshalizer),
+		marshalizer,
+		uint64ByteSlice.NewBigEndianConverter(),
+		createAccounts(rootHash),
+		createTxProcessor(rootHash, errOnNonce),
+		mock.NewOneShardCoordinatorMock(),
+	)
+
+	return br
+}
+
+func TestNewBlockReplayer_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	store := createStore(marshalizer)
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	accounts := &mock.AccountsStub{}
+	txProcessor := &mock.TxProcessorMock{}
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+
+	_, err := replay.NewBlockReplayer(nil, marshalizer, converter, accounts, txProcessor, shardCoordinator)
+	assert.Equal(t, process.ErrNilStore, err)
+
+	_, err = replay.NewBlockReplayer(store, nil, converter, accounts, txProcessor, shardCoordinator)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	_, err = replay.NewBlockReplayer(store, marshalizer, nil, accounts, txProcessor, shardCoordinator)
+	assert.Equal(t, process.ErrNilUint64Converter, err)
+
+	_, err = replay.NewBlockReplayer(store, marshalizer, converter, nil, txProcessor, shardCoordinator)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	_, err = replay.NewBlockReplayer(store, marshalizer, converter, accounts, nil, shardCoordinator)
+	assert.Equal(t, process.ErrNilTxProcessor, err)
+
+	_, err = replay.NewBlockReplayer(store, marshalizer, converter, accounts, txProcessor, nil)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+}
+
+func TestNewBlockReplayer_MetachainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.CurrentShard = 2
+
+	br, err := replay.NewBlockReplayer(
+		createStore(marshalizer),
+		marshalizer,
+		uint64ByteSlice.NewBigEndianConverter(),
+		&mock.AccountsStub{},
+		&mock.TxProcessorMock{},
+		shardCoordinator,
+	)
+
+	assert.Nil(t, br)
+	assert.Equal(t, process.ErrReplayNotSupportedOnMetachain, err)
+}
+
+func TestBlockReplayer_ReplayGenesisShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rootHash := currentRootHash
+	br := createReplayer(&rootHash, 0)
+
+	report, err := br.ReplayBlock(0, nil)
+
+	assert.Nil(t, report)
+	assert.Equal(t, process.ErrGenesisBlockCanNotBeReplayed, err)
+}
+
+func TestBlockReplayer_ReplayMissingBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rootHash := currentRootHash
+	br := createReplayer(&rootHash, 0)
+
+	report, err := br.ReplayBlock(7, nil)
+
+	assert.Nil(t, report)
+	assert.NotNil(t, err)
+	assert.Equal(t, currentRootHash, rootHash)
+}
+
+func TestBlockReplayer_ReplayShouldMatchAndRestoreState(t *testing.T) {
+	t.Parallel()
+
+	rootHash := currentRootHash
+	br := createReplayer(&rootHash, 0)
+
+	report, err := br.ReplayBlock(1, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), report.Round)
+	assert.Equal(t, hex.EncodeToString(parentRootHash), report.ParentRootHash)
+	assert.True(t, report.RootHashMatches)
+	assert.Equal(t, 1, report.SkippedMiniBlocks)
+	assert.Equal(t, 2, len(report.Checkpoints))
+	assert.Equal(t, hex.EncodeToString([]byte("parent root tx1")), report.Checkpoints[0].RootHash)
+	assert.Equal(t, -1, report.DivergentTxIndex)
+	assert.Equal(t, currentRootHash, rootHash)
+}
+
+func TestBlockReplayer_ReplayFailingTransactionShouldBeDivergent(t *testing.T) {
+	t.Parallel()
+
+	rootHash := currentRootHash
+	br := createReplayer(&rootHash, 2)
+
+	report, err := br.ReplayBlock(1, nil)
+
+	assert.Nil(t, err)
+	assert.False(t, report.RootHashMatches)
+	assert.Equal(t, 2, len(report.Checkpoints))
+	assert.Equal(t, 1, report.DivergentTxIndex)
+	assert.Equal(t, hex.EncodeToString([]byte("tx2")), report.DivergentTxHash)
+	assert.Equal(t, "insufficient funds", report.Checkpoints[1].Error)
+}
+
+func TestBlockReplayer_ReplayWithReferenceShouldReportFirstDifferentCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	rootHash := currentRootHash
+	br := createReplayer(&rootHash, 0)
+
+	reference := []replay.TxCheckpoint{
+		{TxHash: hex.EncodeToString([]byte("tx1")), RootHash: hex.EncodeToString([]byte("parent root tx1"))},
+		{TxHash: hex.EncodeToString([]byte("tx2")), RootHash: hex.EncodeToString([]byte("other root"))},
+	}
+	report, err := br.ReplayBlock(1, reference)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, report.DivergentTxIndex)
+	assert.Equal(t, hex.EncodeToString([]byte("tx2")), report.DivergentTxHash)
+}
@@ -1,7 +1,10 @@
 package rewardTransaction
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/core/logger"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -68,7 +71,7 @@ func NewRewardTxInterceptor(
 
 // ProcessReceivedMessage will be the callback func from the p2p.Messenger and will be called each time a new message was received
 // (for the topic this validator was registered to)
-func (rti *RewardTxInterceptor) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (rti *RewardTxInterceptor) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
 	if message == nil || message.IsInterfaceNil() {
 		return process.ErrNilMessage
 	}
@@ -87,8 +90,13 @@ func (rti *RewardTxInterceptor) ProcessReceivedMessage(message p2p.MessageP2P) e
 	}
 
 	filteredRTxBuffs := make([][]byte, 0)
+	interceptedRewardTxs := make([]*InterceptedRewardTransaction, 0, len(rewardTxsBuff))
 	lastErrEncountered := error(nil)
 	for _, rewardTxBuff := range rewardTxsBuff {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
 		rewardTxIntercepted, err := NewInterceptedRewardTransaction(
 			rewardTxBuff,
 			rti.marshalizer,
@@ -103,6 +111,16 @@ func (rti *RewardTxInterceptor) ProcessReceivedMessage(message p2p.MessageP2P) e
 
 		//reward tx is validated, add it to filtered out reward txs
 		filteredRTxBuffs = append(filteredRTxBuffs, rewardTxBuff)
+		interceptedRewardTxs = append(interceptedRewardTxs, rewardTxIntercepted)
+	}
+
+	// the reward transactions are sent in the order of their miniblock, which is by receiver address and hash
+	err = checkInterceptedRewardTxsOrder(interceptedRewardTxs)
+	if err != nil {
+		return err
+	}
+
+	for _, rewardTxIntercepted := range interceptedRewardTxs {
 		if rewardTxIntercepted.IsAddressedToOtherShards() {
 			log.Debug("intercepted reward transaction is for other shards")
 
@@ -128,6 +146,17 @@ func (rti *RewardTxInterceptor) ProcessReceivedMessage(message p2p.MessageP2P) e
 	return lastErrEncountered
 }
 
+func checkInterceptedRewardTxsOrder(interceptedRewardTxs []*InterceptedRewardTransaction) error {
+	rewardTxs := make([]data.TransactionHandler, 0, len(interceptedRewardTxs))
+	txHashes := make([][]byte, 0, len(interceptedRewardTxs))
+	for _, rewardTxIntercepted := range interceptedRewardTxs {
+		rewardTxs = append(rewardTxs, rewardTxIntercepted.RewardTransaction())
+		txHashes = append(txHashes, rewardTxIntercepted.Hash())
+	}
+
+	return process.CheckRewardTxsOrder(rewardTxs, txHashes)
+}
+
 // SetBroadcastCallback sets the callback method to send filtered out message
 func (rti *RewardTxInterceptor) SetBroadcastCallback(callback func(buffToSend []byte)) {
 	rti.broadcastCallbackHandler = callback
@@ -1,6 +1,7 @@
 package rewardTransaction_test
 
 import (
+	"context"
 	"encoding/json"
 	"math/big"
 	"sync/atomic"
@@ -132,7 +133,7 @@ func TestRewardTxInterceptor_ProcessReceivedMessageNilMessageShouldErr(t *testin
 		&mock.HasherMock{},
 		mock.NewMultiShardsCoordinatorMock(3))
 
-	err := rti.ProcessReceivedMessage(nil)
+	err := rti.ProcessReceivedMessage(context.Background(), nil)
 	assert.Equal(t, process.ErrNilMessage, err)
 }
 
@@ -151,7 +152,7 @@ func TestRewardTxInterceptor_ProcessReceivedMessageNilDataShouldErr(t *testing.T
 		DataField: nil,
 	}
 
-	err := rti.ProcessReceivedMessage(message)
+	err := rti.ProcessReceivedMessage(context.Background(), message)
 	assert.Equal(t, process.ErrNilDataToProcess, err)
 }
 
@@ -197,13 +198,62 @@ func TestRewardTxInterceptor_ProcessReceivedMessageIntraShardShouldWork(t *testi
 		DataField: rewardTxsBuff,
 	}
 
-	err := rti.ProcessReceivedMessage(message)
+	err := rti.ProcessReceivedMessage(context.Background(), message)
 	time.Sleep(20 * time.Millisecond)
 
 	assert.Nil(t, err)
 	assert.Equal(t, int32(1), atomic.LoadInt32(&wasCalled))
 }
 
+func TestRewardTxInterceptor_ProcessReceivedMessageUnorderedRewardTxsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := int32(0)
+	rti, _ := rewardTransaction.NewRewardTxInterceptor(
+		&mock.MarshalizerMock{},
+		&mock.ShardedDataStub{
+			AddDataCalled: func(key []byte, data interface{}, cacheId string) {
+				atomic.StoreInt32(&wasCalled, 1)
+			},
+		},
+		&mock.StorerStub{},
+		&mock.AddressConverterMock{},
+		&mock.HasherMock{},
+		mock.NewMultiShardsCoordinatorMock(3))
+
+	rewardTx1 := rewardTx.RewardTx{
+		Round:   1,
+		Epoch:   0,
+		Value:   new(big.Int).SetInt64(157),
+		RcvAddr: []byte("rcvr2"),
+		ShardId: 0,
+	}
+	rewardTxBytes1, _ := rti.Marshalizer().Marshal(rewardTx1)
+
+	rewardTx2 := rewardTx.RewardTx{
+		Round:   1,
+		Epoch:   0,
+		Value:   new(big.Int).SetInt64(157),
+		RcvAddr: []byte("rcvr1"),
+		ShardId: 0,
+	}
+	rewardTxBytes2, _ := rti.Marshalizer().Marshal(rewardTx2)
+
+	var rewardTxsSlice [][]byte
+	rewardTxsSlice = append(rewardTxsSlice, rewardTxBytes1, rewardTxBytes2)
+	rewardTxsBuff, _ := json.Marshal(rewardTxsSlice)
+
+	message := &mock.P2PMessageMock{
+		DataField: rewardTxsBuff,
+	}
+
+	err := rti.ProcessReceivedMessage(context.Background(), message)
+	time.Sleep(20 * time.Millisecond)
+
+	assert.Equal(t, process.ErrRewardTxsNotOrdered, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&wasCalled))
+}
+
 func TestRewardTxInterceptor_ProcessReceivedMessageCrossShardShouldNotAdd(t *testing.T) {
 	t.Parallel()
 
@@ -250,7 +300,7 @@ func TestRewardTxInterceptor_ProcessReceivedMessageCrossShardShouldNotAdd(t *tes
 		DataField: rewardTxsBuff,
 	}
 
-	err := rti.ProcessReceivedMessage(message)
+	err := rti.ProcessReceivedMessage(context.Background(), message)
 	time.Sleep(20 * time.Millisecond)
 	assert.Nil(t, err)
 	// check that AddData was not called, as tx is cross shard
@@ -0,0 +1,9 @@
+package process
+
+// SCDeploymentInfo holds the provenance of a smart contract: the address that deployed it, the hash of the deploy
+// transaction and the epoch of the block in which the deploy transaction was processed
+type SCDeploymentInfo struct {
+	Deployer     []byte
+	DeployTxHash []byte
+	Epoch        uint32
+}
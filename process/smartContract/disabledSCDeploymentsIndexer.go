@@ -0,0 +1,32 @@
+package smartContract
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// disabledSCDeploymentsIndexer is the smart contract deployments indexer used when the index is off. It records nothing
+type disabledSCDeploymentsIndexer struct {
+}
+
+// NewDisabledSCDeploymentsIndexer creates a smart contract deployments indexer that discards all the deployments
+func NewDisabledSCDeploymentsIndexer() *disabledSCDeploymentsIndexer {
+	return &disabledSCDeploymentsIndexer{}
+}
+
+// SaveDeployment does nothing
+func (dsdi *disabledSCDeploymentsIndexer) SaveDeployment(_ []byte, _ []byte, _ []byte) error {
+	return nil
+}
+
+// GetDeployment returns ErrSCDeploymentsIndexDisabled as nothing is recorded
+func (dsdi *disabledSCDeploymentsIndexer) GetDeployment(_ []byte) (*process.SCDeploymentInfo, error) {
+	return nil, process.ErrSCDeploymentsIndexDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsdi *disabledSCDeploymentsIndexer) IsInterfaceNil() bool {
+	if dsdi == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+package smartContract
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// disabledStateChangesAuditor is the state changes auditor used when the audit mode is off. It records nothing
+type disabledStateChangesAuditor struct {
+}
+
+// NewDisabledStateChangesAuditor creates a state changes auditor that discards all the provided changes
+func NewDisabledStateChangesAuditor() *disabledStateChangesAuditor {
+	return &disabledStateChangesAuditor{}
+}
+
+// SaveStateChanges does nothing
+func (dsca *disabledStateChangesAuditor) SaveStateChanges(_ []byte, _ []*process.StateChange) error {
+	return nil
+}
+
+// GetStateChanges returns ErrStateChangesAuditDisabled as nothing is recorded
+func (dsca *disabledStateChangesAuditor) GetStateChanges(_ []byte) ([]*process.StateChange, error) {
+	return nil, process.ErrStateChangesAuditDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsca *disabledStateChangesAuditor) IsInterfaceNil() bool {
+	if dsca == nil {
+		return true
+	}
+	return false
+}
@@ -14,7 +14,7 @@ func (sc *scProcessor) CreateVMCallInput(tx *transaction.Transaction) (*vmcommon
 	return sc.createVMCallInput(tx)
 }
 
-func (sc *scProcessor) CreateVMDeployInput(tx *transaction.Transaction) (*vmcommon.ContractCreateInput, []byte, error) {
+func (sc *scProcessor) CreateVMDeployInput(tx *transaction.Transaction) (*vmcommon.ContractCreateInput, []byte, []byte, error) {
 	return sc.createVMDeployInput(tx)
 }
 
@@ -40,8 +40,12 @@ func (sc *scProcessor) RefundGasToSender(
 	return sc.refundGasToSender(gasRefund, tx, txHash, acntSnd)
 }
 
-func (sc *scProcessor) ProcessSCOutputAccounts(outputAccounts []*vmcommon.OutputAccount, tx *transaction.Transaction) error {
-	return sc.processSCOutputAccounts(outputAccounts, tx)
+func (sc *scProcessor) ProcessSCOutputAccounts(
+	outputAccounts []*vmcommon.OutputAccount,
+	tx *transaction.Transaction,
+	txHash []byte,
+) error {
+	return sc.processSCOutputAccounts(outputAccounts, tx, txHash)
 }
 
 func (sc *scProcessor) DeleteAccounts(deletedAccounts [][]byte) error {
@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
@@ -42,6 +43,9 @@ type scProcessor struct {
 
 	scrForwarder process.IntermediateTransactionHandler
 	txFeeHandler process.TransactionFeeHandler
+
+	stateChangesAuditor process.SCStateChangesAuditor
+	deploymentsIndexer  process.SCDeploymentsIndexer
 }
 
 var log = logger.DefaultLogger()
@@ -58,6 +62,8 @@ func NewSmartContractProcessor(
 	coordinator sharding.Coordinator,
 	scrForwarder process.IntermediateTransactionHandler,
 	txFeeHandler process.TransactionFeeHandler,
+	stateChangesAuditor process.SCStateChangesAuditor,
+	deploymentsIndexer process.SCDeploymentsIndexer,
 ) (*scProcessor, error) {
 	if vmContainer == nil || vmContainer.IsInterfaceNil() {
 		return nil, process.ErrNoVM
@@ -89,6 +95,12 @@ func NewSmartContractProcessor(
 	if txFeeHandler == nil {
 		return nil, process.ErrNilUnsignedTxHandler
 	}
+	if stateChangesAuditor == nil || stateChangesAuditor.IsInterfaceNil() {
+		return nil, process.ErrNilStateChangesAuditor
+	}
+	if deploymentsIndexer == nil || deploymentsIndexer.IsInterfaceNil() {
+		return nil, process.ErrNilSCDeploymentsIndexer
+	}
 
 	return &scProcessor{
 		vmContainer:      vmContainer,
@@ -101,7 +113,11 @@ func NewSmartContractProcessor(
 		shardCoordinator: coordinator,
 		scrForwarder:     scrForwarder,
 		txFeeHandler:     txFeeHandler,
-		mapExecState:     make(map[uint64]scExecutionState)}, nil
+		mapExecState:     make(map[uint64]scExecutionState),
+
+		stateChangesAuditor: stateChangesAuditor,
+		deploymentsIndexer:  deploymentsIndexer,
+	}, nil
 }
 
 // ComputeTransactionType calculates the type of the transaction
@@ -171,7 +187,16 @@ func (sc *scProcessor) ExecuteSmartContractTransaction(
 		return process.ErrNilSCDestAccount
 	}
 
-	err := sc.prepareSmartContractCall(tx, acntSnd)
+	err := sc.checkCallValue(tx, acntDst)
+	if err != nil {
+		return err
+	}
+
+	if sc.isUpgradeTransaction(tx) {
+		return sc.upgradeSmartContract(tx, acntSnd, acntDst, round)
+	}
+
+	err = sc.prepareSmartContractCall(tx, acntSnd)
 	if err != nil {
 		return err
 	}
@@ -207,6 +232,32 @@ func (sc *scProcessor) ExecuteSmartContractTransaction(
 	return nil
 }
 
+// checkCallValue rejects the calls transferring value to a smart contract not deployed as payable
+func (sc *scProcessor) checkCallValue(tx *transaction.Transaction, acntDst state.AccountHandler) error {
+	if tx.Value == nil || tx.Value.Cmp(big.NewInt(0)) == 0 {
+		return nil
+	}
+
+	codeMetadata, err := getCodeMetadata(acntDst)
+	if err != nil {
+		return err
+	}
+	if !codeMetadata.Payable {
+		return process.ErrAccountNotPayable
+	}
+
+	return nil
+}
+
+func getCodeMetadata(acnt state.AccountHandler) (state.CodeMetadata, error) {
+	stAcc, ok := acnt.(*state.Account)
+	if !ok {
+		return state.CodeMetadata{}, process.ErrWrongTypeAssertion
+	}
+
+	return state.CodeMetadataFromBytes(stAcc.GetCodeMetadata())
+}
+
 func (sc *scProcessor) prepareSmartContractCall(tx *transaction.Transaction, acntSnd state.AccountHandler) error {
 	err := sc.argsParser.ParseData(tx.Data)
 	if err != nil {
@@ -242,6 +293,16 @@ func (sc *scProcessor) getVMTypeFromArguments(arg *big.Int) ([]byte, error) {
 	return vmAppendedType, nil
 }
 
+func (sc *scProcessor) getCodeMetadataFromArguments(arg *big.Int) ([]byte, error) {
+	// second parsed argument after the code in case of vmDeploy holds the code metadata flags
+	codeMetadata, err := state.CodeMetadataFromBytes(arg.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return codeMetadata.ToBytes(), nil
+}
+
 func (sc *scProcessor) getVMFromRecvAddress(tx *transaction.Transaction) (vmcommon.VMExecutionHandler, error) {
 	vmType := tx.RcvAddr[hooks.NumInitCharactersForScAddress-hooks.VMTypeLen : hooks.NumInitCharactersForScAddress]
 	vm, err := sc.vmContainer.Get(vmType)
@@ -274,7 +335,7 @@ func (sc *scProcessor) DeploySmartContract(
 		return err
 	}
 
-	vmInput, vmType, err := sc.createVMDeployInput(tx)
+	vmInput, vmType, codeMetadata, err := sc.createVMDeployInput(tx)
 	if err != nil {
 		return err
 	}
@@ -294,6 +355,15 @@ func (sc *scProcessor) DeploySmartContract(
 		return err
 	}
 
+	if vmOutput.ReturnCode == vmcommon.Ok {
+		err = sc.saveContractMetadata(vmOutput.OutputAccounts, tx.SndAddr, codeMetadata)
+		if err != nil {
+			return err
+		}
+
+		sc.indexDeployments(vmOutput.OutputAccounts, tx)
+	}
+
 	err = sc.scrForwarder.AddIntermediateTransactions(crossTxs)
 	if err != nil {
 		return err
@@ -304,6 +374,45 @@ func (sc *scProcessor) DeploySmartContract(
 	return nil
 }
 
+// saveContractMetadata sets the owner and the code metadata on the accounts that received code when the smart
+// contract was deployed
+func (sc *scProcessor) saveContractMetadata(
+	outputAccounts []*vmcommon.OutputAccount,
+	ownerAddress []byte,
+	codeMetadata []byte,
+) error {
+	for _, outAcc := range outputAccounts {
+		if len(outAcc.Code) == 0 {
+			continue
+		}
+
+		acc, err := sc.getAccountFromAddress(outAcc.Address)
+		if err != nil {
+			return err
+		}
+		if acc == nil || acc.IsInterfaceNil() {
+			continue
+		}
+
+		stAcc, ok := acc.(*state.Account)
+		if !ok {
+			return process.ErrWrongTypeAssertion
+		}
+
+		err = stAcc.SetCodeMetadataWithJournal(codeMetadata)
+		if err != nil {
+			return err
+		}
+
+		err = stAcc.SetOwnerAddressWithJournal(ownerAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (sc *scProcessor) createVMCallInput(tx *transaction.Transaction) (*vmcommon.ContractCallInput, error) {
 	vmInput, err := sc.createVMInput(tx)
 	if err != nil {
@@ -324,37 +433,41 @@ func (sc *scProcessor) createVMCallInput(tx *transaction.Transaction) (*vmcommon
 
 func (sc *scProcessor) createVMDeployInput(
 	tx *transaction.Transaction,
-) (*vmcommon.ContractCreateInput, []byte, error) {
+) (*vmcommon.ContractCreateInput, []byte, []byte, error) {
 	vmInput, err := sc.createVMInput(tx)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	if len(vmInput.Arguments) < 1 {
-		return nil, nil, process.ErrNotEnoughArgumentsToDeploy
+	if len(vmInput.Arguments) < 2 {
+		return nil, nil, nil, process.ErrNotEnoughArgumentsToDeploy
 	}
 
 	vmType, err := sc.getVMTypeFromArguments(vmInput.Arguments[0])
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
-	// delete the first argument as it is the vmType
-	vmInput.Arguments = vmInput.Arguments[1:]
+	codeMetadata, err := sc.getCodeMetadataFromArguments(vmInput.Arguments[1])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// delete the first two arguments as they are the vmType and the code metadata
+	vmInput.Arguments = vmInput.Arguments[2:]
 
 	vmCreateInput := &vmcommon.ContractCreateInput{}
 	hexCode, err := sc.argsParser.GetCode()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	vmCreateInput.ContractCode, err = hex.DecodeString(string(hexCode))
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	vmCreateInput.VMInput = *vmInput
 
-	return vmCreateInput, vmType, nil
+	return vmCreateInput, vmType, codeMetadata, nil
 }
 
 func (sc *scProcessor) createVMInput(tx *transaction.Transaction) (*vmcommon.VMInput, error) {
@@ -465,7 +578,7 @@ func (sc *scProcessor) processVMOutput(
 		return nil, nil, nil
 	}
 
-	err = sc.processSCOutputAccounts(vmOutput.OutputAccounts, tx)
+	err = sc.processSCOutputAccounts(vmOutput.OutputAccounts, tx, txHash)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -596,7 +709,12 @@ func (sc *scProcessor) refundGasToSender(
 }
 
 // save account changes in state from vmOutput - protected by VM - every output can be treated as is.
-func (sc *scProcessor) processSCOutputAccounts(outputAccounts []*vmcommon.OutputAccount, tx *transaction.Transaction) error {
+func (sc *scProcessor) processSCOutputAccounts(
+	outputAccounts []*vmcommon.OutputAccount,
+	tx *transaction.Transaction,
+	txHash []byte,
+) error {
+	stateChanges := make([]*process.StateChange, 0)
 	sumOfAllDiff := big.NewInt(0)
 	sumOfAllDiff = sumOfAllDiff.Sub(sumOfAllDiff, tx.Value)
 
@@ -617,7 +735,11 @@ func (sc *scProcessor) processSCOutputAccounts(outputAccounts []*vmcommon.Output
 
 		for j := 0; j < len(outAcc.StorageUpdates); j++ {
 			storeUpdate := outAcc.StorageUpdates[j]
-			acc.DataTrieTracker().SaveKeyValue(storeUpdate.Offset, storeUpdate.Data)
+			err = sc.saveStorageUpdate(acc, storeUpdate)
+			if err != nil {
+				return err
+			}
+			stateChanges = append(stateChanges, createStorageWriteChange(outAcc.Address, storeUpdate.Offset, storeUpdate.Data))
 		}
 
 		if len(outAcc.StorageUpdates) > 0 {
@@ -630,11 +752,18 @@ func (sc *scProcessor) processSCOutputAccounts(outputAccounts []*vmcommon.Output
 
 		// change code if there is a change
 		if len(outAcc.Code) > 0 {
+			err = checkCodeUpgrade(acc)
+			if err != nil {
+				return err
+			}
+
 			err = sc.accounts.PutCode(acc, outAcc.Code)
 			if err != nil {
 				return err
 			}
 
+			stateChanges = append(stateChanges, createCodeChange(outAcc.Address, outAcc.Code))
+
 			fmt.Printf("Created SC address %s \n", hex.EncodeToString(outAcc.Address))
 		}
 
@@ -669,15 +798,127 @@ func (sc *scProcessor) processSCOutputAccounts(outputAccounts []*vmcommon.Output
 		if err != nil {
 			return err
 		}
+
+		stateChanges = append(stateChanges, createBalanceChange(outAcc.Address, outAcc.BalanceDelta))
 	}
 
 	if sumOfAllDiff.Cmp(zero) != 0 {
 		return process.ErrOverallBalanceChangeFromSC
 	}
 
+	sc.auditStateChanges(txHash, stateChanges)
+
 	return nil
 }
 
+// saveStorageUpdate writes the storage update in the data trie of the account. The keys having the Elrond protected
+// prefix can not be written by the smart contracts
+func (sc *scProcessor) saveStorageUpdate(acc state.AccountHandler, storageUpdate *vmcommon.StorageUpdate) error {
+	if bytes.HasPrefix(storageUpdate.Offset, []byte(core.ElrondProtectedKeyPrefix)) {
+		return process.ErrProtectedStorageKey
+	}
+
+	acc.DataTrieTracker().SaveKeyValue(storageUpdate.Offset, storageUpdate.Data)
+	return nil
+}
+
+// checkCodeUpgrade rejects the replacement of the code of a smart contract not deployed as upgradeable
+func checkCodeUpgrade(acc state.AccountHandler) error {
+	if len(acc.GetCodeHash()) == 0 {
+		return nil
+	}
+
+	codeMetadata, err := getCodeMetadata(acc)
+	if err != nil {
+		return err
+	}
+	if !codeMetadata.Upgradeable {
+		return process.ErrUpgradeNotAllowed
+	}
+
+	return nil
+}
+
+// auditStateChanges records the applied state changes. A failure here must not alter the processing outcome,
+// so it is only logged
+func (sc *scProcessor) auditStateChanges(txHash []byte, stateChanges []*process.StateChange) {
+	if len(stateChanges) == 0 {
+		return
+	}
+
+	err := sc.stateChangesAuditor.SaveStateChanges(txHash, stateChanges)
+	if err != nil {
+		log.Debug(fmt.Sprintf("error auditing state changes for tx %s: %s",
+			hex.EncodeToString(txHash),
+			err.Error()))
+	}
+}
+
+// indexDeployments records the provenance of the smart contracts deployed in the current shard. A failure here must
+// not alter the processing outcome, so it is only logged
+func (sc *scProcessor) indexDeployments(outputAccounts []*vmcommon.OutputAccount, tx *transaction.Transaction) {
+	txHash, err := core.CalculateHash(sc.marshalizer, sc.hasher, tx)
+	if err != nil {
+		log.Debug(fmt.Sprintf("error computing the deploy tx hash: %s", err.Error()))
+		return
+	}
+
+	for _, outAcc := range outputAccounts {
+		if len(outAcc.Code) == 0 {
+			continue
+		}
+
+		scAddress, err := sc.adrConv.CreateAddressFromPublicKeyBytes(outAcc.Address)
+		if err != nil {
+			continue
+		}
+		if sc.shardCoordinator.ComputeId(scAddress) != sc.shardCoordinator.SelfId() {
+			continue
+		}
+
+		err = sc.deploymentsIndexer.SaveDeployment(outAcc.Address, tx.SndAddr, txHash)
+		if err != nil {
+			log.Debug(fmt.Sprintf("error indexing the deployment of smart contract %s: %s",
+				hex.EncodeToString(outAcc.Address),
+				err.Error()))
+		}
+	}
+}
+
+func createBalanceChange(address []byte, delta *big.Int) *process.StateChange {
+	return &process.StateChange{
+		Type:         process.BalanceChange,
+		Address:      address,
+		BalanceDelta: big.NewInt(0).Set(delta),
+	}
+}
+
+func createStorageWriteChange(address []byte, key []byte, value []byte) *process.StateChange {
+	return &process.StateChange{
+		Type:    process.StorageWrite,
+		Address: address,
+		Key:     key,
+		Value:   value,
+	}
+}
+
+func createCodeChange(address []byte, code []byte) *process.StateChange {
+	return &process.StateChange{
+		Type:    process.CodeChange,
+		Address: address,
+		Code:    code,
+	}
+}
+
+func createCodeUpgradeChange(address []byte, code []byte, oldCodeHash []byte) *process.StateChange {
+	return &process.StateChange{
+		Type:        process.CodeUpgrade,
+		Address:     address,
+		Code:        code,
+		OldCodeHash: oldCodeHash,
+	}
+}
+
 // delete accounts - only suicide by current SC or another SC called by current SC - protected by VM
 func (sc *scProcessor) deleteAccounts(deletedAccounts [][]byte) error {
 	for _, value := range deletedAccounts {
@@ -805,9 +1046,14 @@ func (sc *scProcessor) ProcessSmartContractResult(scr *smartContractResult.Smart
 		return process.ErrWrongTypeAssertion
 	}
 
+	stateChanges := make([]*process.StateChange, 0)
 	storageUpdates, err := sc.argsParser.GetStorageUpdates(scr.Data)
 	for i := 0; i < len(storageUpdates); i++ {
-		stAcc.DataTrieTracker().SaveKeyValue(storageUpdates[i].Offset, storageUpdates[i].Data)
+		err = sc.saveStorageUpdate(stAcc, storageUpdates[i])
+		if err != nil {
+			return err
+		}
+		stateChanges = append(stateChanges, createStorageWriteChange(scr.RcvAddr, storageUpdates[i].Offset, storageUpdates[i].Data))
 	}
 
 	if len(scr.Data) > 0 {
@@ -819,10 +1065,17 @@ func (sc *scProcessor) ProcessSmartContractResult(scr *smartContractResult.Smart
 	}
 
 	if len(scr.Code) > 0 {
+		err = checkCodeUpgrade(stAcc)
+		if err != nil {
+			return err
+		}
+
 		err = sc.accounts.PutCode(stAcc, scr.Code)
 		if err != nil {
 			return err
 		}
+
+		stateChanges = append(stateChanges, createCodeChange(scr.RcvAddr, scr.Code))
 	}
 
 	if scr.Value == nil {
@@ -836,6 +1089,10 @@ func (sc *scProcessor) ProcessSmartContractResult(scr *smartContractResult.Smart
 		return err
 	}
 
+	stateChanges = append(stateChanges, createBalanceChange(scr.RcvAddr, scr.Value))
+
+	sc.auditStateChanges(scr.TxHash, stateChanges)
+
 	return nil
 }
 
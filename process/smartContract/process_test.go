@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -68,6 +69,8 @@ func TestNewSmartContractProcessorNilVM(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -88,6 +91,8 @@ func TestNewSmartContractProcessorNilArgsParser(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -108,6 +113,8 @@ func TestNewSmartContractProcessorNilHasher(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -128,6 +135,8 @@ func TestNewSmartContractProcessorNilMarshalizer(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -148,6 +157,8 @@ func TestNewSmartContractProcessorNilAccountsDB(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -168,6 +179,8 @@ func TestNewSmartContractProcessorNilAdrConv(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -188,6 +201,8 @@ func TestNewSmartContractProcessorNilShardCoordinator(t *testing.T) {
 		nil,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -208,6 +223,8 @@ func TestNewSmartContractProcessorNilFakeAccountsHandler(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.Nil(t, sc)
@@ -228,12 +245,58 @@ func TestNewSmartContractProcessor_NilIntermediateMock(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		nil,
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.Nil(t, sc)
 	assert.Equal(t, process.ErrNilIntermediateTransactionHandler, err)
 }
 
+func TestNewSmartContractProcessor_NilStateChangesAuditorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, err := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.AccountsStub{},
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		nil,
+		&mock.SCDeploymentsIndexerStub{},
+	)
+
+	assert.Nil(t, sc)
+	assert.Equal(t, process.ErrNilStateChangesAuditor, err)
+}
+
+func TestNewSmartContractProcessor_NilSCDeploymentsIndexerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, err := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.AccountsStub{},
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		nil,
+	)
+
+	assert.Nil(t, sc)
+	assert.Equal(t, process.ErrNilSCDeploymentsIndexer, err)
+}
+
 func TestNewSmartContractProcessor(t *testing.T) {
 	t.Parallel()
 
@@ -248,6 +311,8 @@ func TestNewSmartContractProcessor(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -268,6 +333,8 @@ func TestScProcessor_ComputeTransactionTypeNil(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -291,6 +358,8 @@ func TestScProcessor_ComputeTransactionTypeNilTx(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -321,6 +390,8 @@ func TestScProcessor_ComputeTransactionTypeErrWrongTransaction(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -446,6 +517,8 @@ func TestScProcessor_DeploySmartContractBadParse(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -483,6 +556,8 @@ func TestScProcessor_DeploySmartContractRunError(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -509,6 +584,7 @@ func TestScProcessor_DeploySmartContractRunError(t *testing.T) {
 	argParser.GetArgumentsCalled = func() ([]*big.Int, error) {
 		args := make([]*big.Int, 0)
 		args = append(args, big.NewInt(0).SetBytes(vmArg))
+		args = append(args, big.NewInt(int64(state.MetadataUpgradeable)))
 		return args, nil
 	}
 
@@ -532,6 +608,8 @@ func TestScProcessor_DeploySmartContractWrongTx(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -566,6 +644,8 @@ func TestScProcessor_DeploySmartContract(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -586,6 +666,7 @@ func TestScProcessor_DeploySmartContract(t *testing.T) {
 	argParser.GetArgumentsCalled = func() ([]*big.Int, error) {
 		args := make([]*big.Int, 0)
 		args = append(args, big.NewInt(0).SetBytes(vmArg))
+		args = append(args, big.NewInt(int64(state.MetadataUpgradeable)))
 		return args, nil
 	}
 
@@ -609,6 +690,8 @@ func TestScProcessor_ExecuteSmartContractTransactionNilTx(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -641,6 +724,8 @@ func TestScProcessor_ExecuteSmartContractTransactionNilAccount(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -681,6 +766,8 @@ func TestScProcessor_ExecuteSmartContractTransactionBadParser(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -694,6 +781,7 @@ func TestScProcessor_ExecuteSmartContractTransactionBadParser(t *testing.T) {
 	acntSrc, acntDst := createAccounts(tx)
 
 	acntDst.SetCode([]byte("code"))
+	acntDst.(*state.Account).CodeMetadata = []byte{state.MetadataPayable}
 	tmpError := errors.New("error")
 	argParser.ParseDataCalled = func(data string) error {
 		return tmpError
@@ -718,6 +806,8 @@ func TestScProcessor_ExecuteSmartContractTransactionVMRunError(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -731,6 +821,7 @@ func TestScProcessor_ExecuteSmartContractTransactionVMRunError(t *testing.T) {
 	acntSrc, acntDst := createAccounts(tx)
 
 	acntDst.SetCode([]byte("code"))
+	acntDst.(*state.Account).CodeMetadata = []byte{state.MetadataPayable}
 	tmpError := errors.New("error")
 	vm := &mock.VMExecutionHandlerStub{}
 	vm.RunSmartContractCallCalled = func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
@@ -761,6 +852,8 @@ func TestScProcessor_ExecuteSmartContractTransaction(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -782,6 +875,43 @@ func TestScProcessor_ExecuteSmartContractTransaction(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestScProcessor_ExecuteSmartContractTransactionWithValueNotPayableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	argParser := &mock.ArgumentParserMock{}
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		argParser,
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.AccountsStub{},
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
+	)
+
+	tx := &transaction.Transaction{}
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST0000000")
+	tx.Data = "data"
+	tx.Value = big.NewInt(45)
+	acntSrc, acntDst := createAccounts(tx)
+
+	acntDst.SetCode([]byte("code"))
+	acntDst.(*state.Account).CodeMetadata = []byte{state.MetadataUpgradeable | state.MetadataReadable}
+	argParser.ParseDataCalled = func(data string) error {
+		assert.Fail(t, "should have not parsed the data")
+		return nil
+	}
+
+	err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst, 10)
+	assert.Equal(t, process.ErrAccountNotPayable, err)
+}
+
 func TestScProcessor_CreateVMCallInputWrongCode(t *testing.T) {
 	t.Parallel()
 
@@ -798,6 +928,8 @@ func TestScProcessor_CreateVMCallInputWrongCode(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -834,6 +966,8 @@ func TestScProcessor_CreateVMCallInput(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -866,6 +1000,8 @@ func TestScProcessor_CreateVMDeployInputBadFunction(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -885,13 +1021,15 @@ func TestScProcessor_CreateVMDeployInputBadFunction(t *testing.T) {
 	argParser.GetArgumentsCalled = func() ([]*big.Int, error) {
 		args := make([]*big.Int, 0)
 		args = append(args, big.NewInt(0).SetBytes(vmArg))
+		args = append(args, big.NewInt(0))
 		return args, nil
 	}
 
-	vmInput, vmType, err := sc.CreateVMDeployInput(tx)
+	vmInput, vmType, codeMetadata, err := sc.CreateVMDeployInput(tx)
 	assert.Nil(t, vmInput)
 	assert.Equal(t, tmpError, err)
 	assert.Nil(t, vmType)
+	assert.Nil(t, codeMetadata)
 }
 
 func TestScProcessor_CreateVMDeployInput(t *testing.T) {
@@ -910,6 +1048,8 @@ func TestScProcessor_CreateVMDeployInput(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -922,18 +1062,58 @@ func TestScProcessor_CreateVMDeployInput(t *testing.T) {
 	tx.Value = big.NewInt(45)
 
 	vmArg := []byte("00")
+	metadataArg := []byte{state.MetadataUpgradeable | state.MetadataPayable}
 	argParser.GetArgumentsCalled = func() ([]*big.Int, error) {
 		args := make([]*big.Int, 0)
 		args = append(args, big.NewInt(0).SetBytes(vmArg))
+		args = append(args, big.NewInt(0).SetBytes(metadataArg))
+		args = append(args, big.NewInt(7))
 		return args, nil
 	}
 
-	vmInput, vmType, err := sc.CreateVMDeployInput(tx)
+	vmInput, vmType, codeMetadata, err := sc.CreateVMDeployInput(tx)
 	assert.NotNil(t, vmInput)
 	assert.True(t, bytes.Equal(vmArg, vmType))
+	assert.Equal(t, metadataArg, codeMetadata)
+	assert.Equal(t, []*big.Int{big.NewInt(7)}, vmInput.Arguments)
 	assert.Nil(t, err)
 }
 
+func TestScProcessor_CreateVMDeployInputInvalidCodeMetadataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	argParser := &mock.ArgumentParserMock{}
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		argParser,
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.AccountsStub{},
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
+	)
+
+	tx := &transaction.Transaction{}
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST")
+	tx.Data = "data@0000@ff"
+	tx.Value = big.NewInt(45)
+
+	argParser.GetArgumentsCalled = func() ([]*big.Int, error) {
+		return []*big.Int{big.NewInt(0), big.NewInt(0xff)}, nil
+	}
+
+	vmInput, _, codeMetadata, err := sc.CreateVMDeployInput(tx)
+	assert.Nil(t, vmInput)
+	assert.Nil(t, codeMetadata)
+	assert.Equal(t, state.ErrInvalidCodeMetadata, err)
+}
+
 func TestScProcessor_CreateVMDeployInputNotEnoughArguments(t *testing.T) {
 	t.Parallel()
 
@@ -950,6 +1130,8 @@ func TestScProcessor_CreateVMDeployInputNotEnoughArguments(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -961,9 +1143,10 @@ func TestScProcessor_CreateVMDeployInputNotEnoughArguments(t *testing.T) {
 	tx.Data = "data@0000"
 	tx.Value = big.NewInt(45)
 
-	vmInput, vmType, err := sc.CreateVMDeployInput(tx)
+	vmInput, vmType, codeMetadata, err := sc.CreateVMDeployInput(tx)
 	assert.Nil(t, vmInput)
 	assert.Nil(t, vmType)
+	assert.Nil(t, codeMetadata)
 	assert.Equal(t, process.ErrNotEnoughArgumentsToDeploy, err)
 }
 
@@ -983,6 +1166,8 @@ func TestScProcessor_CreateVMInputWrongArgument(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1019,6 +1204,8 @@ func TestScProcessor_CreateVMInput(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1064,6 +1251,8 @@ func TestScProcessor_processVMOutputNilVMOutput(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1090,6 +1279,8 @@ func TestScProcessor_processVMOutputNilTx(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1117,6 +1308,8 @@ func TestScProcessor_processVMOutputNilSndAcc(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1148,6 +1341,8 @@ func TestScProcessor_processVMOutputNilDstAcc(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1195,6 +1390,8 @@ func TestScProcessor_GetAccountFromAddressAccNotFound(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1235,6 +1432,8 @@ func TestScProcessor_GetAccountFromAddrFaildAddressConv(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1275,6 +1474,8 @@ func TestScProcessor_GetAccountFromAddrFailedGetExistingAccount(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1315,6 +1516,8 @@ func TestScProcessor_GetAccountFromAddrAccNotInShard(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1356,6 +1559,8 @@ func TestScProcessor_GetAccountFromAddr(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1398,6 +1603,8 @@ func TestScProcessor_DeleteAccountsFailedAtRemove(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1440,6 +1647,8 @@ func TestScProcessor_DeleteAccountsNotInShard(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1487,6 +1696,8 @@ func TestScProcessor_DeleteAccountsInShard(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1513,6 +1724,8 @@ func TestScProcessor_ProcessSCPaymentAccNotInShardShouldNotReturnError(t *testin
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1545,6 +1758,8 @@ func TestScProcessor_ProcessSCPaymentWrongTypeAssertion(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1581,6 +1796,8 @@ func TestScProcessor_ProcessSCPaymentNotEnoughBalance(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1620,6 +1837,8 @@ func TestScProcessor_ProcessSCPayment(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1657,6 +1876,8 @@ func TestScProcessor_RefundGasToSenderNilAndZeroRefund(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1699,6 +1920,8 @@ func TestScProcessor_RefundGasToSenderAccNotInShard(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1746,6 +1969,8 @@ func TestScProcessor_RefundGasToSender(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 
 	assert.NotNil(t, sc)
@@ -1788,6 +2013,8 @@ func TestScProcessor_processVMOutputNilOutput(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1814,6 +2041,8 @@ func TestScProcessor_processVMOutputNilTransaction(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1842,6 +2071,8 @@ func TestScProcessor_processVMOutput(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -1878,13 +2109,15 @@ func TestScProcessor_processSCOutputAccounts(t *testing.T) {
 		mock.NewMultiShardsCoordinatorMock(5),
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
 
 	tx := &transaction.Transaction{Value: big.NewInt(0)}
 	outputAccounts := make([]*vmcommon.OutputAccount, 0)
-	err = sc.ProcessSCOutputAccounts(outputAccounts, tx)
+	err = sc.ProcessSCOutputAccounts(outputAccounts, tx, []byte("txHash"))
 	assert.Nil(t, err)
 
 	outaddress := []byte("newsmartcontract")
@@ -1917,13 +2150,13 @@ func TestScProcessor_processSCOutputAccounts(t *testing.T) {
 	}
 
 	tx.Value = big.NewInt(int64(5))
-	err = sc.ProcessSCOutputAccounts(outputAccounts, tx)
+	err = sc.ProcessSCOutputAccounts(outputAccounts, tx, []byte("txHash"))
 	assert.Nil(t, err)
 
 	outacc1.BalanceDelta = nil
 	outacc1.Nonce = outacc1.Nonce + 1
 	tx.Value = big.NewInt(0)
-	err = sc.processSCOutputAccounts(outputAccounts, tx)
+	err = sc.processSCOutputAccounts(outputAccounts, tx, []byte("txHash"))
 	assert.Nil(t, err)
 
 	outacc1.Nonce = outacc1.Nonce + 1
@@ -1937,11 +2170,131 @@ func TestScProcessor_processSCOutputAccounts(t *testing.T) {
 
 	currentBalance := testAcc.Balance.Uint64()
 	vmOutBalance := outacc1.BalanceDelta.Uint64()
-	err = sc.processSCOutputAccounts(outputAccounts, tx)
+	err = sc.processSCOutputAccounts(outputAccounts, tx, []byte("txHash"))
 	assert.Nil(t, err)
 	assert.Equal(t, currentBalance+vmOutBalance, testAcc.Balance.Uint64())
 }
 
+func TestScProcessor_processSCOutputAccountsShouldAuditAppliedChanges(t *testing.T) {
+	t.Parallel()
+
+	accountsDB := &mock.AccountsStub{}
+	accTracker := &mock.AccountTrackerStub{}
+	txHash := []byte("txHash")
+	var auditedHash []byte
+	var auditedChanges []*process.StateChange
+	auditor := &mock.StateChangesAuditorStub{
+		SaveStateChangesCalled: func(txHash []byte, changes []*process.StateChange) error {
+			auditedHash = txHash
+			auditedChanges = changes
+			return nil
+		},
+	}
+
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accountsDB,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		auditor,
+		&mock.SCDeploymentsIndexerStub{},
+	)
+
+	outaddress := []byte("newsmartcontract")
+	outacc1 := &vmcommon.OutputAccount{
+		Address:        outaddress,
+		Code:           []byte("contract-code"),
+		BalanceDelta:   big.NewInt(5),
+		StorageUpdates: []*vmcommon.StorageUpdate{{Offset: []byte("key"), Data: []byte("value")}},
+	}
+
+	testAcc, _ := state.NewAccount(mock.NewAddressMock(outaddress), accTracker)
+	accountsDB.GetAccountWithJournalCalled = func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+		return testAcc, nil
+	}
+	accountsDB.PutCodeCalled = func(accountHandler state.AccountHandler, code []byte) error {
+		return nil
+	}
+	accountsDB.SaveDataTrieCalled = func(acountWrapper state.AccountHandler) error {
+		return nil
+	}
+	accTracker.JournalizeCalled = func(entry state.JournalEntry) {
+	}
+	accTracker.SaveAccountCalled = func(accountHandler state.AccountHandler) error {
+		return nil
+	}
+
+	tx := &transaction.Transaction{Value: big.NewInt(5)}
+	err := sc.processSCOutputAccounts([]*vmcommon.OutputAccount{outacc1}, tx, txHash)
+
+	assert.Nil(t, err)
+	assert.Equal(t, txHash, auditedHash)
+	assert.Equal(t, 3, len(auditedChanges))
+	assert.Equal(t, process.StorageWrite, auditedChanges[0].Type)
+	assert.Equal(t, []byte("key"), auditedChanges[0].Key)
+	assert.Equal(t, []byte("value"), auditedChanges[0].Value)
+	assert.Equal(t, process.CodeChange, auditedChanges[1].Type)
+	assert.Equal(t, outacc1.Code, auditedChanges[1].Code)
+	assert.Equal(t, process.BalanceChange, auditedChanges[2].Type)
+	assert.Equal(t, big.NewInt(5), auditedChanges[2].BalanceDelta)
+	assert.Equal(t, outaddress, auditedChanges[2].Address)
+}
+
+func TestScProcessor_processSCOutputAccountsAuditErrorShouldNotFailProcessing(t *testing.T) {
+	t.Parallel()
+
+	accountsDB := &mock.AccountsStub{}
+	accTracker := &mock.AccountTrackerStub{}
+	auditor := &mock.StateChangesAuditorStub{
+		SaveStateChangesCalled: func(txHash []byte, changes []*process.StateChange) error {
+			return errors.New("audit error")
+		},
+	}
+
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accountsDB,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		auditor,
+		&mock.SCDeploymentsIndexerStub{},
+	)
+
+	outaddress := []byte("newsmartcontract")
+	outacc1 := &vmcommon.OutputAccount{
+		Address:      outaddress,
+		BalanceDelta: big.NewInt(5),
+	}
+
+	testAcc, _ := state.NewAccount(mock.NewAddressMock(outaddress), accTracker)
+	accountsDB.GetAccountWithJournalCalled = func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+		return testAcc, nil
+	}
+	accTracker.JournalizeCalled = func(entry state.JournalEntry) {
+	}
+	accTracker.SaveAccountCalled = func(accountHandler state.AccountHandler) error {
+		return nil
+	}
+
+	tx := &transaction.Transaction{Value: big.NewInt(5)}
+	err := sc.processSCOutputAccounts([]*vmcommon.OutputAccount{outacc1}, tx, []byte("txHash"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(5), testAcc.Balance)
+}
+
 func TestScProcessor_processSCOutputAccountsNotInShard(t *testing.T) {
 	t.Parallel()
 
@@ -1959,13 +2312,15 @@ func TestScProcessor_processSCOutputAccountsNotInShard(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
 
 	tx := &transaction.Transaction{Value: big.NewInt(0)}
 	outputAccounts := make([]*vmcommon.OutputAccount, 0)
-	err = sc.ProcessSCOutputAccounts(outputAccounts, tx)
+	err = sc.ProcessSCOutputAccounts(outputAccounts, tx, []byte("txHash"))
 	assert.Nil(t, err)
 
 	outaddress := []byte("newsmartcontract")
@@ -1979,7 +2334,7 @@ func TestScProcessor_processSCOutputAccountsNotInShard(t *testing.T) {
 		return shardCoordinator.SelfId() + 1
 	}
 
-	err = sc.ProcessSCOutputAccounts(outputAccounts, tx)
+	err = sc.ProcessSCOutputAccounts(outputAccounts, tx, []byte("txHash"))
 	assert.Nil(t, err)
 }
 
@@ -2000,6 +2355,8 @@ func TestScProcessor_CreateCrossShardTransactions(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2045,6 +2402,8 @@ func TestScProcessor_ProcessSmartContractResultNilScr(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2073,6 +2432,8 @@ func TestScProcessor_ProcessSmartContractResultErrGetAccount(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2099,6 +2460,8 @@ func TestScProcessor_ProcessSmartContractResultAccNotInShard(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2130,6 +2493,8 @@ func TestScProcessor_ProcessSmartContractResultBadAccType(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2164,6 +2529,8 @@ func TestScProcessor_ProcessSmartContractResultOutputBalanceNil(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2204,6 +2571,8 @@ func TestScProcessor_ProcessSmartContractResultWithCode(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2218,6 +2587,133 @@ func TestScProcessor_ProcessSmartContractResultWithCode(t *testing.T) {
 	assert.Equal(t, 1, putCodeCalled)
 }
 
+func TestScProcessor_ProcessSmartContractResultWithCodeNotUpgradeableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	putCodeCalled := 0
+	accountsDB := &mock.AccountsStub{
+		GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			acc, _ := state.NewAccount(addressContainer, &mock.AccountTrackerStub{})
+			acc.CodeHash = []byte("code hash")
+			acc.CodeMetadata = []byte{state.MetadataReadable | state.MetadataPayable}
+			return acc, nil
+		},
+		PutCodeCalled: func(accountHandler state.AccountHandler, code []byte) error {
+			putCodeCalled++
+			return nil
+		},
+	}
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accountsDB,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
+	)
+
+	scr := smartContractResult.SmartContractResult{
+		RcvAddr: []byte("recv address"),
+		Code:    []byte("code"),
+		Value:   big.NewInt(15),
+	}
+	err := sc.ProcessSmartContractResult(&scr)
+	assert.Equal(t, process.ErrUpgradeNotAllowed, err)
+	assert.Equal(t, 0, putCodeCalled)
+}
+
+func TestScProcessor_ProcessSCOutputAccountsUpgradeableShouldPutCode(t *testing.T) {
+	t.Parallel()
+
+	putCodeCalled := 0
+	accountsDB := &mock.AccountsStub{
+		GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			acc, _ := state.NewAccount(addressContainer, &mock.AccountTrackerStub{})
+			acc.CodeHash = []byte("code hash")
+			acc.CodeMetadata = []byte{state.MetadataUpgradeable}
+			return acc, nil
+		},
+		PutCodeCalled: func(accountHandler state.AccountHandler, code []byte) error {
+			putCodeCalled++
+			return nil
+		},
+	}
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accountsDB,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
+	)
+
+	tx := &transaction.Transaction{Value: big.NewInt(0)}
+	outputAccounts := []*vmcommon.OutputAccount{
+		{Address: []byte("sc address"), Code: []byte("new code")},
+	}
+	err := sc.ProcessSCOutputAccounts(outputAccounts, tx, []byte("tx hash"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, putCodeCalled)
+}
+
+func createScProcessorWithAccount(acc state.AccountHandler) *scProcessor {
+	accountsDB := &mock.AccountsStub{
+		GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			return acc, nil
+		},
+		SaveDataTrieCalled: func(acountWrapper state.AccountHandler) error {
+			return nil
+		},
+	}
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accountsDB,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
+	)
+
+	return sc
+}
+
+func TestScProcessor_ProcessSCOutputAccountsProtectedKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	acc, _ := state.NewAccount(mock.NewAddressMock([]byte("caller")), &mock.AccountTrackerStub{})
+	sc := createScProcessorWithAccount(acc)
+
+	tx := &transaction.Transaction{Value: big.NewInt(0), RcvAddr: []byte("sc address")}
+	outputAccounts := []*vmcommon.OutputAccount{
+		{
+			Address: []byte("sc address"),
+			StorageUpdates: []*vmcommon.StorageUpdate{
+				{Offset: []byte(core.ElrondTokenKeyPrefix + "token"), Data: big.NewInt(100).Bytes()},
+			},
+		},
+	}
+	err := sc.ProcessSCOutputAccounts(outputAccounts, tx, []byte("tx hash"))
+	assert.Equal(t, process.ErrProtectedStorageKey, err)
+}
+
 func TestScProcessor_ProcessSmartContractResultWithData(t *testing.T) {
 	t.Parallel()
 
@@ -2248,6 +2744,8 @@ func TestScProcessor_ProcessSmartContractResultWithData(t *testing.T) {
 		shardCoordinator,
 		&mock.IntermediateTransactionHandlerMock{},
 		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		&mock.SCDeploymentsIndexerStub{},
 	)
 	assert.NotNil(t, sc)
 	assert.Nil(t, err)
@@ -2276,3 +2774,104 @@ func TestScProcessor_ProcessSmartContractResultWithData(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 1, saveTrieCalled)
 }
+
+func TestScProcessor_ProcessSmartContractResultShouldAuditUnderTheOriginalTxHash(t *testing.T) {
+	t.Parallel()
+
+	accountsDB := &mock.AccountsStub{
+		GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (handler state.AccountHandler, e error) {
+			return state.NewAccount(addressContainer,
+				&mock.AccountTrackerStub{JournalizeCalled: func(entry state.JournalEntry) {},
+					SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+						return nil
+					}})
+		},
+	}
+	var auditedTxHash []byte
+	var auditedChanges []*process.StateChange
+	auditor := &mock.StateChangesAuditorStub{
+		SaveStateChangesCalled: func(txHash []byte, changes []*process.StateChange) error {
+			auditedTxHash = txHash
+			auditedChanges = changes
+			return nil
+		},
+	}
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accountsDB,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		auditor,
+		&mock.SCDeploymentsIndexerStub{},
+	)
+
+	scr := smartContractResult.SmartContractResult{
+		RcvAddr: []byte("recv address"),
+		Value:   big.NewInt(15),
+		TxHash:  []byte("original tx hash"),
+	}
+	err := sc.ProcessSmartContractResult(&scr)
+
+	assert.Nil(t, err)
+	assert.Equal(t, scr.TxHash, auditedTxHash)
+	assert.Equal(t, 1, len(auditedChanges))
+	assert.Equal(t, process.BalanceChange, auditedChanges[0].Type)
+	assert.Equal(t, big.NewInt(15), auditedChanges[0].BalanceDelta)
+}
+
+func TestScProcessor_IndexDeploymentsShouldIndexOnlyTheContractsFromSelfShard(t *testing.T) {
+	t.Parallel()
+
+	indexed := make(map[string][]byte)
+	var indexedTxHash []byte
+	indexer := &mock.SCDeploymentsIndexerStub{
+		SaveDeploymentCalled: func(scAddress []byte, deployer []byte, deployTxHash []byte) error {
+			indexed[string(scAddress)] = deployer
+			indexedTxHash = deployTxHash
+			return nil
+		},
+	}
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(5)
+	shardCoordinator.ComputeIdCalled = func(address state.AddressContainer) uint32 {
+		if bytes.Equal(address.Bytes(), []byte("otherShardContract")) {
+			return 1
+		}
+		return 0
+	}
+	marshalizer := &mock.MarshalizerMock{}
+	hasher := &mock.HasherMock{}
+
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{},
+		&mock.ArgumentParserMock{},
+		hasher,
+		marshalizer,
+		&mock.AccountsStub{},
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		shardCoordinator,
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.StateChangesAuditorStub{},
+		indexer,
+	)
+
+	tx := &transaction.Transaction{SndAddr: []byte("deployer"), Value: big.NewInt(0)}
+	outputAccounts := []*vmcommon.OutputAccount{
+		{Address: []byte("selfShardContract"), Code: []byte("code")},
+		{Address: []byte("otherShardContract"), Code: []byte("code")},
+		{Address: []byte("deployer"), BalanceDelta: big.NewInt(-1)},
+	}
+
+	sc.indexDeployments(outputAccounts, tx)
+
+	expectedTxHash, _ := core.CalculateHash(marshalizer, hasher, tx)
+	assert.Equal(t, map[string][]byte{"selfShardContract": []byte("deployer")}, indexed)
+	assert.Equal(t, expectedTxHash, indexedTxHash)
+}
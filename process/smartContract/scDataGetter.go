@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/pkg/errors"
@@ -16,20 +17,26 @@ var maxGasValue = big.NewInt(math.MaxInt64)
 // scDataGetter can execute Get functions over SC to fetch stored values
 type scDataGetter struct {
 	vm       vmcommon.VMExecutionHandler
+	accounts state.AccountsAdapter
 	mutRunSc sync.Mutex
 }
 
 // NewSCDataGetter returns a new instance of scDataGetter
 func NewSCDataGetter(
 	vm vmcommon.VMExecutionHandler,
+	accounts state.AccountsAdapter,
 ) (*scDataGetter, error) {
 
 	if vm == nil {
 		return nil, process.ErrNoVM
 	}
+	if accounts == nil || accounts.IsInterfaceNil() {
+		return nil, process.ErrNilAccountsAdapter
+	}
 
 	return &scDataGetter{
-		vm: vm,
+		vm:       vm,
+		accounts: accounts,
 	}, nil
 }
 
@@ -42,6 +49,11 @@ func (scdg *scDataGetter) Get(scAddress []byte, funcName string, args ...[]byte)
 		return nil, process.ErrEmptyFunctionName
 	}
 
+	err := scdg.checkReadable(scAddress)
+	if err != nil {
+		return nil, err
+	}
+
 	scdg.mutRunSc.Lock()
 	defer scdg.mutRunSc.Unlock()
 
@@ -55,6 +67,24 @@ func (scdg *scDataGetter) Get(scAddress []byte, funcName string, args ...[]byte)
 	return scdg.checkVMOutput(vmOutput)
 }
 
+// checkReadable rejects the queries on a smart contract not deployed as readable
+func (scdg *scDataGetter) checkReadable(scAddress []byte) error {
+	acc, err := scdg.accounts.GetExistingAccount(state.NewAddress(scAddress))
+	if err != nil {
+		return err
+	}
+
+	codeMetadata, err := getCodeMetadata(acc)
+	if err != nil {
+		return err
+	}
+	if !codeMetadata.Readable {
+		return process.ErrAccountNotReadable
+	}
+
+	return nil
+}
+
 func (scdg *scDataGetter) createVMCallInput(
 	scAddress []byte,
 	funcName string,
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
@@ -14,22 +15,46 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func createReadableAccounts() *mock.AccountsStub {
+	return &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+			acc, _ := state.NewAccount(addressContainer, &mock.AccountTrackerStub{})
+			acc.CodeMetadata = []byte{state.MetadataReadable}
+			return acc, nil
+		},
+	}
+}
+
 func TestNewSCDataGetter_NilVmShouldErr(t *testing.T) {
 	t.Parallel()
 
 	scdg, err := smartContract.NewSCDataGetter(
 		nil,
+		createReadableAccounts(),
 	)
 
 	assert.Nil(t, scdg)
 	assert.Equal(t, process.ErrNoVM, err)
 }
 
+func TestNewSCDataGetter_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	scdg, err := smartContract.NewSCDataGetter(
+		&mock.VMExecutionHandlerStub{},
+		nil,
+	)
+
+	assert.Nil(t, scdg)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
 func TestNewSCDataGetter_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	scdg, err := smartContract.NewSCDataGetter(
 		&mock.VMExecutionHandlerStub{},
+		createReadableAccounts(),
 	)
 
 	assert.NotNil(t, scdg)
@@ -43,6 +68,7 @@ func TestScDataGetter_GetNilAddressShouldErr(t *testing.T) {
 
 	scdg, _ := smartContract.NewSCDataGetter(
 		&mock.VMExecutionHandlerStub{},
+		createReadableAccounts(),
 	)
 
 	output, err := scdg.Get(nil, "function")
@@ -56,6 +82,7 @@ func TestScDataGetter_GetEmptyFunctionShouldErr(t *testing.T) {
 
 	scdg, _ := smartContract.NewSCDataGetter(
 		&mock.VMExecutionHandlerStub{},
+		createReadableAccounts(),
 	)
 
 	output, err := scdg.Get([]byte("sc address"), "")
@@ -64,6 +91,31 @@ func TestScDataGetter_GetEmptyFunctionShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrEmptyFunctionName, err)
 }
 
+func TestScDataGetter_GetNotReadableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	scdg, _ := smartContract.NewSCDataGetter(
+		&mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+				assert.Fail(t, "should have not called the VM")
+				return nil, nil
+			},
+		},
+		&mock.AccountsStub{
+			GetExistingAccountCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+				acc, _ := state.NewAccount(addressContainer, &mock.AccountTrackerStub{})
+				acc.CodeMetadata = []byte{state.MetadataUpgradeable | state.MetadataPayable}
+				return acc, nil
+			},
+		},
+	)
+
+	output, err := scdg.Get([]byte("sc address"), "function")
+
+	assert.Nil(t, output)
+	assert.Equal(t, process.ErrAccountNotReadable, err)
+}
+
 func TestScDataGetter_GetShouldReceiveAddrFuncAndArgs(t *testing.T) {
 	t.Parallel()
 
@@ -88,6 +140,7 @@ func TestScDataGetter_GetShouldReceiveAddrFuncAndArgs(t *testing.T) {
 				}, nil
 			},
 		},
+		createReadableAccounts(),
 	)
 
 	_, _ = scdg.Get(addressBytes, funcName, args...)
@@ -107,6 +160,7 @@ func TestScDataGetter_GetReturnsDataShouldRet(t *testing.T) {
 				}, nil
 			},
 		},
+		createReadableAccounts(),
 	)
 
 	returnedData, err := scdg.Get([]byte("sc address"), "function")
@@ -126,6 +180,7 @@ func TestScDataGetter_GetReturnsNotOkCodeShouldErr(t *testing.T) {
 				}, nil
 			},
 		},
+		createReadableAccounts(),
 	)
 
 	returnedData, err := scdg.Get([]byte("sc address"), "function")
@@ -156,6 +211,7 @@ func TestScDataGetter_GetShouldCallRunScSequentially(t *testing.T) {
 				}, nil
 			},
 		},
+		createReadableAccounts(),
 	)
 
 	noOfGoRoutines := 1000
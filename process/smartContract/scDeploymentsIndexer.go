@@ -0,0 +1,88 @@
+package smartContract
+
+import (
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// scDeploymentsIndexer persists, in a dedicated storer, the provenance of the smart contracts deployed in the current
+// shard, keyed by the smart contract address. The epoch of a deployment is the epoch of the block being processed.
+// The index is local to the node, it is not part of the consensus state and its records are not removed if the block
+// containing the deploy transaction is later reverted
+type scDeploymentsIndexer struct {
+	storer       storage.Storer
+	marshalizer  marshal.Marshalizer
+	epochHandler process.SpecialAddressHandler
+}
+
+// NewSCDeploymentsIndexer creates a new storer backed smart contract deployments indexer
+func NewSCDeploymentsIndexer(
+	storer storage.Storer,
+	marshalizer marshal.Marshalizer,
+	epochHandler process.SpecialAddressHandler,
+) (*scDeploymentsIndexer, error) {
+	if storer == nil || storer.IsInterfaceNil() {
+		return nil, process.ErrNilStorage
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+	if epochHandler == nil || epochHandler.IsInterfaceNil() {
+		return nil, process.ErrNilSpecialAddressHandler
+	}
+
+	return &scDeploymentsIndexer{
+		storer:       storer,
+		marshalizer:  marshalizer,
+		epochHandler: epochHandler,
+	}, nil
+}
+
+// SaveDeployment records the deployer and the deploy transaction hash of the provided smart contract address,
+// together with the current epoch
+func (sdi *scDeploymentsIndexer) SaveDeployment(scAddress []byte, deployer []byte, deployTxHash []byte) error {
+	if len(scAddress) == 0 {
+		return process.ErrNilSCAddress
+	}
+	if len(deployTxHash) == 0 {
+		return process.ErrNilTxHash
+	}
+
+	deployment := &process.SCDeploymentInfo{
+		Deployer:     deployer,
+		DeployTxHash: deployTxHash,
+		Epoch:        sdi.epochHandler.Epoch(),
+	}
+
+	buff, err := sdi.marshalizer.Marshal(deployment)
+	if err != nil {
+		return err
+	}
+
+	return sdi.storer.Put(scAddress, buff)
+}
+
+// GetDeployment returns the deployment recorded for the provided smart contract address
+func (sdi *scDeploymentsIndexer) GetDeployment(scAddress []byte) (*process.SCDeploymentInfo, error) {
+	buff, err := sdi.storer.Get(scAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment := &process.SCDeploymentInfo{}
+	err = sdi.marshalizer.Unmarshal(deployment, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return deployment, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sdi *scDeploymentsIndexer) IsInterfaceNil() bool {
+	if sdi == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+package smartContract_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	"github.com/stretchr/testify/assert"
+)
+
+func createEpochHandler(epoch uint32) *mock.SpecialAddressHandlerMock {
+	epochHandler := mock.NewSpecialAddressHandlerMock(
+		&mock.AddressConverterMock{},
+		mock.NewOneShardCoordinatorMock(),
+		mock.NewNodesCoordinatorMock(),
+	)
+	_ = epochHandler.SetShardConsensusData([]byte("randomness"), 1, epoch, 0)
+
+	return epochHandler
+}
+
+func TestNewSCDeploymentsIndexer_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sdi, err := smartContract.NewSCDeploymentsIndexer(nil, &mock.MarshalizerMock{}, createEpochHandler(0))
+
+	assert.Nil(t, sdi)
+	assert.Equal(t, process.ErrNilStorage, err)
+}
+
+func TestNewSCDeploymentsIndexer_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sdi, err := smartContract.NewSCDeploymentsIndexer(createMapStorerStub(), nil, createEpochHandler(0))
+
+	assert.Nil(t, sdi)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewSCDeploymentsIndexer_NilEpochHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sdi, err := smartContract.NewSCDeploymentsIndexer(createMapStorerStub(), &mock.MarshalizerMock{}, nil)
+
+	assert.Nil(t, sdi)
+	assert.Equal(t, process.ErrNilSpecialAddressHandler, err)
+}
+
+func TestNewSCDeploymentsIndexer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sdi, err := smartContract.NewSCDeploymentsIndexer(createMapStorerStub(), &mock.MarshalizerMock{}, createEpochHandler(0))
+
+	assert.NotNil(t, sdi)
+	assert.Nil(t, err)
+}
+
+func TestSCDeploymentsIndexer_SaveDeploymentEmptyAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sdi, _ := smartContract.NewSCDeploymentsIndexer(createMapStorerStub(), &mock.MarshalizerMock{}, createEpochHandler(0))
+
+	err := sdi.SaveDeployment(nil, []byte("deployer"), []byte("tx hash"))
+
+	assert.Equal(t, process.ErrNilSCAddress, err)
+}
+
+func TestSCDeploymentsIndexer_SaveDeploymentEmptyTxHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sdi, _ := smartContract.NewSCDeploymentsIndexer(createMapStorerStub(), &mock.MarshalizerMock{}, createEpochHandler(0))
+
+	err := sdi.SaveDeployment([]byte("sc address"), []byte("deployer"), nil)
+
+	assert.Equal(t, process.ErrNilTxHash, err)
+}
+
+func TestSCDeploymentsIndexer_SaveAndGetDeploymentShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sdi, _ := smartContract.NewSCDeploymentsIndexer(createMapStorerStub(), &mock.MarshalizerMock{}, createEpochHandler(7))
+
+	err := sdi.SaveDeployment([]byte("sc address"), []byte("deployer"), []byte("tx hash"))
+	assert.Nil(t, err)
+
+	deployment, err := sdi.GetDeployment([]byte("sc address"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, &process.SCDeploymentInfo{
+		Deployer:     []byte("deployer"),
+		DeployTxHash: []byte("tx hash"),
+		Epoch:        7,
+	}, deployment)
+}
+
+func TestSCDeploymentsIndexer_GetDeploymentMissingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sdi, _ := smartContract.NewSCDeploymentsIndexer(createMapStorerStub(), &mock.MarshalizerMock{}, createEpochHandler(0))
+
+	deployment, err := sdi.GetDeployment([]byte("sc address"))
+
+	assert.Nil(t, deployment)
+	assert.NotNil(t, err)
+}
+
+func TestDisabledSCDeploymentsIndexer_ShouldRecordNothing(t *testing.T) {
+	t.Parallel()
+
+	dsdi := smartContract.NewDisabledSCDeploymentsIndexer()
+
+	err := dsdi.SaveDeployment([]byte("sc address"), []byte("deployer"), []byte("tx hash"))
+	assert.Nil(t, err)
+
+	deployment, err := dsdi.GetDeployment([]byte("sc address"))
+	assert.Nil(t, deployment)
+	assert.Equal(t, process.ErrSCDeploymentsIndexDisabled, err)
+}
@@ -0,0 +1,75 @@
+package smartContract
+
+import (
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// stateChangesAuditor persists the state changes applied from smart contract executions in a dedicated storer,
+// keyed by the hash of the transaction that produced them. The changes applied from the smart contract results are
+// added to the record of their originating transaction.
+// The records are meant only for post-mortem debugging: they are not part of the consensus state and are not
+// removed if the block containing the transaction is later reverted
+type stateChangesAuditor struct {
+	storer      storage.Storer
+	marshalizer marshal.Marshalizer
+}
+
+// NewStateChangesAuditor creates a new storer backed state changes auditor
+func NewStateChangesAuditor(storer storage.Storer, marshalizer marshal.Marshalizer) (*stateChangesAuditor, error) {
+	if storer == nil || storer.IsInterfaceNil() {
+		return nil, process.ErrNilStorage
+	}
+	if marshalizer == nil || marshalizer.IsInterfaceNil() {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	return &stateChangesAuditor{
+		storer:      storer,
+		marshalizer: marshalizer,
+	}, nil
+}
+
+// SaveStateChanges appends the provided state changes to the record of the provided transaction hash
+func (sca *stateChangesAuditor) SaveStateChanges(txHash []byte, changes []*process.StateChange) error {
+	if len(txHash) == 0 {
+		return process.ErrNilTxHash
+	}
+
+	recordedChanges, err := sca.GetStateChanges(txHash)
+	if err == nil {
+		changes = append(recordedChanges, changes...)
+	}
+
+	buff, err := sca.marshalizer.Marshal(changes)
+	if err != nil {
+		return err
+	}
+
+	return sca.storer.Put(txHash, buff)
+}
+
+// GetStateChanges returns the state changes recorded for the provided transaction hash
+func (sca *stateChangesAuditor) GetStateChanges(txHash []byte) ([]*process.StateChange, error) {
+	buff, err := sca.storer.Get(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*process.StateChange, 0)
+	err = sca.marshalizer.Unmarshal(&changes, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sca *stateChangesAuditor) IsInterfaceNil() bool {
+	if sca == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package smartContract_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMapStorerStub() *mock.StorerStub {
+	records := make(map[string][]byte)
+	return &mock.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			records[string(key)] = data
+			return nil
+		},
+		GetCalled: func(key []byte) ([]byte, error) {
+			data, ok := records[string(key)]
+			if !ok {
+				return nil, errors.New("key not found")
+			}
+			return data, nil
+		},
+	}
+}
+
+func TestNewStateChangesAuditor_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sca, err := smartContract.NewStateChangesAuditor(nil, &mock.MarshalizerMock{})
+
+	assert.Nil(t, sca)
+	assert.Equal(t, process.ErrNilStorage, err)
+}
+
+func TestNewStateChangesAuditor_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sca, err := smartContract.NewStateChangesAuditor(createMapStorerStub(), nil)
+
+	assert.Nil(t, sca)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewStateChangesAuditor_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sca, err := smartContract.NewStateChangesAuditor(createMapStorerStub(), &mock.MarshalizerMock{})
+
+	assert.NotNil(t, sca)
+	assert.Nil(t, err)
+}
+
+func TestStateChangesAuditor_SaveStateChangesEmptyHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sca, _ := smartContract.NewStateChangesAuditor(createMapStorerStub(), &mock.MarshalizerMock{})
+
+	err := sca.SaveStateChanges(nil, make([]*process.StateChange, 0))
+
+	assert.Equal(t, process.ErrNilTxHash, err)
+}
+
+func TestStateChangesAuditor_SaveAndGetStateChangesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sca, _ := smartContract.NewStateChangesAuditor(createMapStorerStub(), &mock.MarshalizerMock{})
+	txHash := []byte("txHash")
+	changes := []*process.StateChange{
+		{Type: process.StorageWrite, Address: []byte("sc"), Key: []byte("key"), Value: []byte("value")},
+		{Type: process.CodeChange, Address: []byte("sc"), Code: []byte("code")},
+		{Type: process.BalanceChange, Address: []byte("sc"), BalanceDelta: big.NewInt(-7)},
+	}
+
+	err := sca.SaveStateChanges(txHash, changes)
+	assert.Nil(t, err)
+
+	recovered, err := sca.GetStateChanges(txHash)
+	assert.Nil(t, err)
+	assert.Equal(t, changes, recovered)
+}
+
+func TestStateChangesAuditor_SaveStateChangesShouldAppendToTheExistingRecord(t *testing.T) {
+	t.Parallel()
+
+	sca, _ := smartContract.NewStateChangesAuditor(createMapStorerStub(), &mock.MarshalizerMock{})
+	txHash := []byte("txHash")
+	txChanges := []*process.StateChange{
+		{Type: process.StorageWrite, Address: []byte("sc"), Key: []byte("key"), Value: []byte("value")},
+	}
+	scrChanges := []*process.StateChange{
+		{Type: process.BalanceChange, Address: []byte("receiver"), BalanceDelta: big.NewInt(7)},
+	}
+
+	_ = sca.SaveStateChanges(txHash, txChanges)
+	err := sca.SaveStateChanges(txHash, scrChanges)
+	assert.Nil(t, err)
+
+	recovered, err := sca.GetStateChanges(txHash)
+	assert.Nil(t, err)
+	assert.Equal(t, append(txChanges, scrChanges...), recovered)
+}
+
+func TestStateChangesAuditor_GetStateChangesMissingRecordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sca, _ := smartContract.NewStateChangesAuditor(createMapStorerStub(), &mock.MarshalizerMock{})
+
+	recovered, err := sca.GetStateChanges([]byte("missing"))
+
+	assert.Nil(t, recovered)
+	assert.NotNil(t, err)
+}
+
+func TestDisabledStateChangesAuditor_ShouldNotRecord(t *testing.T) {
+	t.Parallel()
+
+	dsca := smartContract.NewDisabledStateChangesAuditor()
+	txHash := []byte("txHash")
+
+	err := dsca.SaveStateChanges(txHash, []*process.StateChange{{Type: process.CodeChange}})
+	assert.Nil(t, err)
+
+	recovered, err := dsca.GetStateChanges(txHash)
+	assert.Nil(t, recovered)
+	assert.Equal(t, process.ErrStateChangesAuditDisabled, err)
+}
@@ -0,0 +1,187 @@
+package smartContract
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// UpgradeContractFunction is the function name marking a smart contract call as the upgrade of the called contract
+const UpgradeContractFunction = "upgradeContract"
+
+// UpgradeHookFunction is the function of the new code executed right after a smart contract was upgraded
+const UpgradeHookFunction = "upgrade"
+
+func (sc *scProcessor) isUpgradeTransaction(tx *transaction.Transaction) bool {
+	return strings.HasPrefix(tx.Data, UpgradeContractFunction+atSep)
+}
+
+// upgradeSmartContract replaces the code of the called contract with the one provided in the transaction data,
+// formatted as upgradeContract@code@codeMetadata@args. Only the owner can upgrade a contract deployed as
+// upgradeable. The upgrade hook of the new code is then executed with the provided arguments: if it fails, the
+// previous code and code metadata are restored. A contract without an upgrade hook is upgraded and the call value
+// is returned to the sender
+func (sc *scProcessor) upgradeSmartContract(
+	tx *transaction.Transaction,
+	acntSnd, acntDst state.AccountHandler,
+	round uint64,
+) error {
+	stAccDst, ok := acntDst.(*state.Account)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+	if !bytes.Equal(stAccDst.GetOwnerAddress(), tx.SndAddr) {
+		return process.ErrNotContractOwner
+	}
+
+	err := checkCodeUpgrade(stAccDst)
+	if err != nil {
+		return err
+	}
+
+	err = sc.prepareSmartContractCall(tx, acntSnd)
+	if err != nil {
+		return err
+	}
+
+	code, codeMetadata, arguments, err := sc.parseUpgradeData(tx.Data)
+	if err != nil {
+		return err
+	}
+
+	txHash, err := core.CalculateHash(sc.marshalizer, sc.hasher, tx)
+	if err != nil {
+		return err
+	}
+
+	snapshot := sc.accounts.JournalLen()
+	oldCodeHash := stAccDst.GetCodeHash()
+	err = sc.replaceCode(stAccDst, code, codeMetadata)
+	if err != nil {
+		return err
+	}
+
+	vmOutput, err := sc.runUpgradeHook(tx, arguments)
+	if err != nil {
+		return err
+	}
+
+	isUpgraded := vmOutput.ReturnCode == vmcommon.Ok || vmOutput.ReturnCode == vmcommon.FunctionNotFound
+	if isUpgraded {
+		vmOutput.Logs = append(vmOutput.Logs, createUpgradeLogEntry(tx.RcvAddr, stAccDst.GetCodeHash()))
+	} else {
+		err = sc.accounts.RevertToSnapshot(snapshot)
+		if err != nil {
+			return err
+		}
+	}
+
+	crossTxs, consumedFee, err := sc.processVMOutput(vmOutput, tx, acntSnd, round)
+	if err != nil {
+		return err
+	}
+
+	if isUpgraded {
+		log.Info(fmt.Sprintf("smart contract %s upgraded, old code hash %s, new code hash %s",
+			hex.EncodeToString(tx.RcvAddr),
+			hex.EncodeToString(oldCodeHash),
+			hex.EncodeToString(stAccDst.GetCodeHash()),
+		))
+		sc.auditCodeUpgrade(txHash, createCodeUpgradeChange(tx.RcvAddr, code, oldCodeHash))
+	}
+
+	err = sc.scrForwarder.AddIntermediateTransactions(crossTxs)
+	if err != nil {
+		return err
+	}
+
+	sc.txFeeHandler.ProcessTransactionFee(consumedFee)
+
+	return nil
+}
+
+func (sc *scProcessor) parseUpgradeData(data string) ([]byte, []byte, []*big.Int, error) {
+	err := sc.argsParser.ParseData(strings.TrimPrefix(data, UpgradeContractFunction+atSep))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	hexCode, err := sc.argsParser.GetCode()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	code, err := hex.DecodeString(string(hexCode))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	arguments, err := sc.argsParser.GetArguments()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(arguments) < 1 {
+		return nil, nil, nil, process.ErrNotEnoughArgumentsToUpgrade
+	}
+
+	codeMetadata, err := sc.getCodeMetadataFromArguments(arguments[0])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return code, codeMetadata, arguments[1:], nil
+}
+
+func (sc *scProcessor) replaceCode(stAcc *state.Account, code []byte, codeMetadata []byte) error {
+	err := sc.accounts.PutCode(stAcc, code)
+	if err != nil {
+		return err
+	}
+
+	return stAcc.SetCodeMetadataWithJournal(codeMetadata)
+}
+
+func (sc *scProcessor) runUpgradeHook(tx *transaction.Transaction, arguments []*big.Int) (*vmcommon.VMOutput, error) {
+	vmInput, err := sc.createVMInput(tx)
+	if err != nil {
+		return nil, err
+	}
+	vmInput.Arguments = arguments
+
+	vm, err := sc.getVMFromRecvAddress(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return vm.RunSmartContractCall(&vmcommon.ContractCallInput{
+		VMInput:       *vmInput,
+		RecipientAddr: tx.RcvAddr,
+		Function:      UpgradeHookFunction,
+	})
+}
+
+func createUpgradeLogEntry(scAddress []byte, codeHash []byte) *vmcommon.LogEntry {
+	return &vmcommon.LogEntry{
+		Address: scAddress,
+		Topics:  []*big.Int{big.NewInt(0).SetBytes([]byte(UpgradeContractFunction))},
+		Data:    codeHash,
+	}
+}
+
+// auditCodeUpgrade records the code upgrade, together with the replaced code hash, before the state changes applied
+// by the upgrade hook
+func (sc *scProcessor) auditCodeUpgrade(txHash []byte, upgradeChange *process.StateChange) {
+	changes, err := sc.stateChangesAuditor.GetStateChanges(txHash)
+	if err != nil {
+		changes = make([]*process.StateChange, 0)
+	}
+
+	sc.auditStateChanges(txHash, append([]*process.StateChange{upgradeChange}, changes...))
+}
@@ -0,0 +1,181 @@
+package smartContract
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func createUpgradeTransaction(owner []byte) *transaction.Transaction {
+	return &transaction.Transaction{
+		SndAddr: owner,
+		RcvAddr: []byte("0000000000000000050000000000000000000000000000000000000000000000"),
+		Data:    UpgradeContractFunction + "@aabb@01@05",
+		Value:   big.NewInt(0),
+	}
+}
+
+func createScProcessorForUpgrade(
+	vm vmcommon.VMExecutionHandler,
+	accounts state.AccountsAdapter,
+	auditor process.SCStateChangesAuditor,
+) *scProcessor {
+	argParser, _ := NewAtArgumentParser()
+	sc, _ := NewSmartContractProcessor(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+				return vm, nil
+			},
+		},
+		argParser,
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		accounts,
+		&mock.TemporaryAccountsHandlerMock{},
+		&mock.AddressConverterMock{},
+		mock.NewMultiShardsCoordinatorMock(5),
+		&mock.IntermediateTransactionHandlerMock{},
+		&mock.UnsignedTxHandlerMock{},
+		auditor,
+		&mock.SCDeploymentsIndexerStub{},
+	)
+
+	return sc
+}
+
+func createContractAccount(tx *transaction.Transaction, owner []byte, codeMetadata byte) (*state.Account, *state.Account) {
+	acntSrc, acntDst := createAccounts(tx)
+	stAccDst := acntDst.(*state.Account)
+	stAccDst.SetCode([]byte("old code"))
+	stAccDst.CodeHash = []byte("old code hash")
+	stAccDst.CodeMetadata = []byte{codeMetadata}
+	stAccDst.OwnerAddress = owner
+
+	return acntSrc.(*state.Account), stAccDst
+}
+
+func TestScProcessor_UpgradeSmartContractNotOwnerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc := createScProcessorForUpgrade(&mock.VMExecutionHandlerStub{}, &mock.AccountsStub{}, &mock.StateChangesAuditorStub{})
+	tx := createUpgradeTransaction([]byte("other"))
+	acntSrc, acntDst := createContractAccount(tx, []byte("owner"), state.MetadataUpgradeable)
+
+	err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst, 10)
+
+	assert.Equal(t, process.ErrNotContractOwner, err)
+}
+
+func TestScProcessor_UpgradeSmartContractNotUpgradeableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc := createScProcessorForUpgrade(&mock.VMExecutionHandlerStub{}, &mock.AccountsStub{}, &mock.StateChangesAuditorStub{})
+	owner := []byte("owner")
+	tx := createUpgradeTransaction(owner)
+	acntSrc, acntDst := createContractAccount(tx, owner, state.MetadataReadable)
+
+	err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst, 10)
+
+	assert.Equal(t, process.ErrUpgradeNotAllowed, err)
+}
+
+func TestScProcessor_UpgradeSmartContractShouldReplaceCodeRunHookAndAudit(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tx := createUpgradeTransaction(owner)
+	acntSrc, acntDst := createContractAccount(tx, owner, state.MetadataUpgradeable)
+
+	var putCode []byte
+	accounts := &mock.AccountsStub{
+		GetAccountWithJournalCalled: func(addressContainer state.AddressContainer) (state.AccountHandler, error) {
+			return acntSrc, nil
+		},
+		PutCodeCalled: func(accountHandler state.AccountHandler, code []byte) error {
+			putCode = code
+			return accountHandler.SetCodeHashWithJournal([]byte("new code hash"))
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			assert.Fail(t, "should have not reverted the upgrade")
+			return nil
+		},
+	}
+	var hookInput *vmcommon.ContractCallInput
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			hookInput = input
+			return &vmcommon.VMOutput{
+				ReturnCode:   vmcommon.Ok,
+				GasRefund:    big.NewInt(0),
+				GasRemaining: big.NewInt(0),
+			}, nil
+		},
+	}
+	var auditedChanges []*process.StateChange
+	auditor := &mock.StateChangesAuditorStub{
+		SaveStateChangesCalled: func(txHash []byte, changes []*process.StateChange) error {
+			auditedChanges = changes
+			return nil
+		},
+	}
+	sc := createScProcessorForUpgrade(vm, accounts, auditor)
+
+	err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst, 10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0xaa, 0xbb}, putCode)
+	assert.Equal(t, []byte{state.MetadataUpgradeable}, acntDst.CodeMetadata)
+	assert.Equal(t, UpgradeHookFunction, hookInput.Function)
+	assert.Equal(t, []*big.Int{big.NewInt(5)}, hookInput.Arguments)
+	assert.Equal(t, []*process.StateChange{
+		createCodeUpgradeChange(tx.RcvAddr, []byte{0xaa, 0xbb}, []byte("old code hash")),
+	}, auditedChanges)
+
+	logs := sc.mapExecState[10].allLogs
+	assert.Equal(t, 1, len(logs))
+	for _, txLogs := range logs {
+		assert.Equal(t, []*vmcommon.LogEntry{createUpgradeLogEntry(tx.RcvAddr, []byte("new code hash"))}, txLogs)
+	}
+}
+
+func TestScProcessor_UpgradeSmartContractHookFailsShouldRevertUpgrade(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tx := createUpgradeTransaction(owner)
+	acntSrc, acntDst := createContractAccount(tx, owner, state.MetadataUpgradeable)
+
+	revertCalled := false
+	accounts := &mock.AccountsStub{
+		PutCodeCalled: func(accountHandler state.AccountHandler, code []byte) error {
+			return nil
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			revertCalled = true
+			return nil
+		},
+	}
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+		},
+	}
+	auditor := &mock.StateChangesAuditorStub{
+		SaveStateChangesCalled: func(txHash []byte, changes []*process.StateChange) error {
+			assert.Fail(t, "should have not audited the upgrade")
+			return nil
+		},
+	}
+	sc := createScProcessorForUpgrade(vm, accounts, auditor)
+
+	err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst, 10)
+
+	assert.Nil(t, err)
+	assert.True(t, revertCalled)
+}
@@ -0,0 +1,17 @@
+package process
+
+import (
+	"math/big"
+)
+
+// StateChange holds one state modification applied to an account as a result of a smart contract execution.
+// Only the fields relevant for the change type are populated
+type StateChange struct {
+	Type         StateChangeType
+	Address      []byte
+	BalanceDelta *big.Int
+	Key          []byte
+	Value        []byte
+	Code         []byte
+	OldCodeHash  []byte
+}
@@ -272,6 +272,18 @@ func (bfd *baseForkDetector) ResetProbableHighestNonce() {
 	}
 }
 
+// ResetCheckpoints sets the final checkpoint and the last checkpoint to the block with the provided nonce and round.
+// It is used when the node rolls back blocks which were already final, so that the blocks above the provided one
+// can be processed again
+func (bfd *baseForkDetector) ResetCheckpoints(nonce uint64, round uint64) {
+	checkpoint := &checkpointInfo{nonce: nonce, round: round}
+
+	bfd.mutFork.Lock()
+	bfd.fork.checkpoint = []*checkpointInfo{checkpoint}
+	bfd.fork.finalCheckpoint = checkpoint
+	bfd.mutFork.Unlock()
+}
+
 func (bfd *baseForkDetector) addCheckpoint(checkpoint *checkpointInfo) {
 	bfd.mutFork.Lock()
 	bfd.fork.checkpoint = append(bfd.fork.checkpoint, checkpoint)
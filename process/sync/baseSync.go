@@ -2,12 +2,14 @@ package sync
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -69,13 +71,14 @@ type baseBootstrap struct {
 
 	mutHeader     sync.RWMutex
 	headerNonce   *uint64
-	headerhash    []byte
 	chRcvHdrNonce chan bool
-	chRcvHdrHash  chan bool
+
+	poolRequester dataRetriever.PoolRequester
 
 	requestedHashes process.RequiredDataPool
 
 	statusHandler core.AppStatusHandler
+	indexer       indexer.Indexer
 
 	chStopSync chan bool
 	waitTime   time.Duration
@@ -90,14 +93,17 @@ type baseBootstrap struct {
 	forkHash       []byte
 
 	mutRcvHdrNonce        sync.RWMutex
-	mutRcvHdrHash         sync.RWMutex
 	syncStateListeners    []func(bool)
 	mutSyncStateListeners sync.RWMutex
 	uint64Converter       typeConverters.Uint64ByteSliceConverter
 	bootstrapRoundIndex   uint64
 	requestsWithTimeout   uint32
 
-	requestMiniBlocks func(uint32, uint64)
+	requestMiniBlocks    func(uint32, uint64)
+	rollbackCurrentBlock func() error
+
+	stateRecovery       *stateRecoveryConfig
+	rootStateMismatches uint32
 }
 
 func (boot *baseBootstrap) loadBlocks(
@@ -318,13 +324,6 @@ func (boot *baseBootstrap) setRequestedHeaderNonce(nonce *uint64) {
 	boot.mutHeader.Unlock()
 }
 
-// setRequestedHeaderHash method sets the header hash requested by the sync mechanism
-func (boot *baseBootstrap) setRequestedHeaderHash(hash []byte) {
-	boot.mutHeader.Lock()
-	boot.headerhash = hash
-	boot.mutHeader.Unlock()
-}
-
 // requestedHeaderNonce method gets the header nonce requested by the sync mechanism
 func (boot *baseBootstrap) requestedHeaderNonce() *uint64 {
 	boot.mutHeader.RLock()
@@ -332,13 +331,6 @@ func (boot *baseBootstrap) requestedHeaderNonce() *uint64 {
 	return boot.headerNonce
 }
 
-// requestedHeaderHash method gets the header hash requested by the sync mechanism
-func (boot *baseBootstrap) requestedHeaderHash() []byte {
-	boot.mutHeader.RLock()
-	defer boot.mutHeader.RUnlock()
-	return boot.headerhash
-}
-
 func (boot *baseBootstrap) processReceivedHeader(headerHandler data.HeaderHandler, headerHash []byte) {
 	log.Debug(fmt.Sprintf("received header with hash %s and nonce %d from network\n",
 		core.ToB64(headerHash),
@@ -348,24 +340,6 @@ func (boot *baseBootstrap) processReceivedHeader(headerHandler data.HeaderHandle
 	if err != nil {
 		log.Debug(err.Error())
 	}
-
-	boot.mutRcvHdrHash.Lock()
-	hash := boot.requestedHeaderHash()
-	if hash == nil {
-		boot.mutRcvHdrHash.Unlock()
-		return
-	}
-
-	if bytes.Equal(hash, headerHash) {
-		log.Info(fmt.Sprintf("received requested header with hash %s and nonce %d from network\n",
-			core.ToB64(hash),
-			headerHandler.GetNonce()))
-		boot.setRequestedHeaderHash(nil)
-		boot.mutRcvHdrHash.Unlock()
-		boot.chRcvHdrHash <- true
-	} else {
-		boot.mutRcvHdrHash.Unlock()
-	}
 }
 
 // receivedHeaderNonce method is a call back function which is called when a new header is added
@@ -415,6 +389,16 @@ func (boot *baseBootstrap) SetStatusHandler(handler core.AppStatusHandler) error
 	return nil
 }
 
+// SetIndexer sets the indexer notified about the blocks undone on rollback, so that it can correct its data
+func (boot *baseBootstrap) SetIndexer(indexer indexer.Indexer) error {
+	if indexer == nil || indexer.IsInterfaceNil() {
+		return process.ErrNilIndexer
+	}
+	boot.indexer = indexer
+
+	return nil
+}
+
 func (boot *baseBootstrap) notifySyncStateListeners(isNodeSynchronized bool) {
 	boot.mutSyncStateListeners.RLock()
 	for i := 0; i < len(boot.syncStateListeners); i++ {
@@ -442,14 +426,23 @@ func (boot *baseBootstrap) waitForHeaderNonce() error {
 	}
 }
 
-// waitForHeaderHash method wait for header with the requested hash to be received
-func (boot *baseBootstrap) waitForHeaderHash() error {
-	select {
-	case <-boot.chRcvHdrHash:
-		return nil
-	case <-time.After(boot.waitTime):
-		return process.ErrTimeIsOut
+// getHeaderWithHashFromPoolRequester method gets the header with a given hash from pool or storer. If it is found
+// in neither, it will be requested from network and waited for, at most the bootstrap's wait time
+func (boot *baseBootstrap) getHeaderWithHashFromPoolRequester(
+	hash []byte,
+	unit dataRetriever.UnitType,
+	resolver dataRetriever.Resolver,
+	newEmptyHeader func() interface{},
+) (interface{}, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), boot.waitTime)
+	defer cancel()
+
+	header, err := boot.poolRequester.GetOrRequest(ctx, hash, boot.headers, boot.store.GetStorer(unit), resolver, newEmptyHeader)
+	if err == context.DeadlineExceeded {
+		return nil, process.ErrTimeIsOut
 	}
+
+	return header, err
 }
 
 // ShouldSync method returns the synch state of the node. If it returns 'true', this means that the node
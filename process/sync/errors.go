@@ -42,5 +42,14 @@ var ErrRollBackBehindFinalHeader = errors.New("roll back behind final header is
 // ErrRandomSeedNotValid signals that the random seed is not valid
 var ErrRandomSeedNotValid = errors.New("random seed is not valid")
 
+// ErrInvalidMismatchesThreshold signals that an invalid state root mismatches threshold has been provided
+var ErrInvalidMismatchesThreshold = errors.New("invalid state root mismatches threshold")
+
+// ErrInvalidRollbackDepth signals that an invalid rollback depth has been provided
+var ErrInvalidRollbackDepth = errors.New("invalid rollback depth")
+
+// ErrEmptyForensicsFolder signals that an empty folder name was provided for the state forensic records
+var ErrEmptyForensicsFolder = errors.New("empty state forensics folder")
+
 // ErrInvalidShardId signals that an invalid shard id has been provided
 var ErrInvalidShardId = errors.New("invalid shard id")
@@ -15,6 +15,10 @@ func (boot *ShardBootstrap) GetMiniBlocks(hashes [][]byte) (block.MiniBlockSlice
 	return boot.miniBlockResolver.GetMiniBlocks(hashes)
 }
 
+func (boot *ShardBootstrap) GetHeaderWithHashRequestingIfMissing(hash []byte) (*block.Header, error) {
+	return boot.getHeaderWithHashRequestingIfMissing(hash)
+}
+
 func (boot *MetaBootstrap) ReceivedHeaders(key []byte) {
 	boot.receivedHeader(key)
 }
@@ -280,3 +284,15 @@ func (bfd *baseForkDetector) SetProbableHighestNonce(nonce uint64) {
 func (sfd *shardForkDetector) AddFinalHeaders(finalHeaders []data.HeaderHandler, finalHeadersHashes [][]byte) {
 	sfd.addFinalHeaders(finalHeaders, finalHeadersHashes)
 }
+
+func (boot *baseBootstrap) RecoverStateIfNeeded(rejectedHeader data.HeaderHandler, err error) bool {
+	return boot.recoverStateIfNeeded(rejectedHeader, err)
+}
+
+func (boot *baseBootstrap) RootStateMismatches() uint32 {
+	return boot.rootStateMismatches
+}
+
+func (boot *baseBootstrap) SetRollbackCurrentBlock(rollbackCurrentBlock func() error) {
+	boot.rollbackCurrentBlock = rollbackCurrentBlock
+}
@@ -6,11 +6,13 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -71,6 +73,11 @@ func NewMetaBootstrap(
 		return nil, err
 	}
 
+	poolRequester, err := requestHandlers.NewPoolRequester(marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
 	base := &baseBootstrap{
 		blkc:                blkc,
 		blkExecutor:         blkExecutor,
@@ -85,6 +92,7 @@ func NewMetaBootstrap(
 		shardCoordinator:    shardCoordinator,
 		accounts:            accounts,
 		bootstrapRoundIndex: bootstrapRoundIndex,
+		poolRequester:       poolRequester,
 	}
 
 	boot := MetaBootstrap{
@@ -92,6 +100,7 @@ func NewMetaBootstrap(
 	}
 
 	base.storageBootstrapper = &boot
+	base.rollbackCurrentBlock = boot.rollbackCurrentBlock
 
 	//there is one header topic so it is ok to save it
 	hdrResolver, err := resolversFinder.MetaChainResolver(factory.MetachainBlocksTopic)
@@ -107,16 +116,15 @@ func NewMetaBootstrap(
 	boot.hdrRes = hdrRes
 
 	boot.chRcvHdrNonce = make(chan bool)
-	boot.chRcvHdrHash = make(chan bool)
 
 	boot.setRequestedHeaderNonce(nil)
-	boot.setRequestedHeaderHash(nil)
 	boot.headersNonces.RegisterHandler(boot.receivedHeaderNonce)
 	boot.headers.RegisterHandler(boot.receivedHeader)
 
 	boot.chStopSync = make(chan bool)
 
 	boot.statusHandler = statusHandler.NewNilStatusHandler()
+	boot.indexer = indexer.NewNilIndexer()
 
 	boot.syncStateListeners = make([]func(bool), 0)
 	boot.requestedHashes = process.RequiredDataPool{}
@@ -382,6 +390,10 @@ func (boot *MetaBootstrap) syncBlocks() {
 }
 
 func (boot *MetaBootstrap) doJobOnSyncBlockFail(hdr *block.MetaBlock, err error) {
+	if boot.recoverStateIfNeeded(hdr, err) {
+		return
+	}
+
 	if err == process.ErrTimeIsOut {
 		boot.requestsWithTimeout++
 	}
@@ -431,7 +443,6 @@ func (boot *MetaBootstrap) SyncBlock() error {
 	}
 
 	boot.setRequestedHeaderNonce(nil)
-	boot.setRequestedHeaderHash(nil)
 
 	nonce := boot.getNonceForNextBlock()
 
@@ -480,6 +491,7 @@ func (boot *MetaBootstrap) SyncBlock() error {
 
 	log.Info(fmt.Sprintf("block with nonce %d has been synced successfully\n", hdr.Nonce))
 	boot.requestsWithTimeout = 0
+	boot.rootStateMismatches = 0
 
 	return nil
 }
@@ -498,18 +510,6 @@ func (boot *MetaBootstrap) requestHeaderWithNonce(nonce uint64) {
 	}
 }
 
-// requestHeaderWithHash method requests a block header from network when it is not found in the pool
-func (boot *MetaBootstrap) requestHeaderWithHash(hash []byte) {
-	boot.setRequestedHeaderHash(hash)
-	err := boot.hdrRes.RequestDataFromHash(hash)
-
-	log.Info(fmt.Sprintf("requested header with hash %s from network\n", core.ToB64(hash)))
-
-	if err != nil {
-		log.Error(err.Error())
-	}
-}
-
 // getHeaderWithNonceRequestingIfMissing method gets the header with a given nonce from pool. If it is not found there, it will
 // be requested from network
 func (boot *MetaBootstrap) getHeaderWithNonceRequestingIfMissing(nonce uint64) (*block.MetaBlock, error) {
@@ -540,19 +540,21 @@ func (boot *MetaBootstrap) getHeaderWithNonceRequestingIfMissing(nonce uint64) (
 // getHeaderWithHashRequestingIfMissing method gets the header with a given hash from pool. If it is not found there,
 // it will be requested from network
 func (boot *MetaBootstrap) getHeaderWithHashRequestingIfMissing(hash []byte) (*block.MetaBlock, error) {
-	hdr, err := process.GetMetaHeader(hash, boot.headers, boot.marshalizer, boot.store)
+	value, err := boot.getHeaderWithHashFromPoolRequester(
+		hash,
+		dataRetriever.MetaBlockUnit,
+		boot.hdrRes,
+		func() interface{} {
+			return &block.MetaBlock{}
+		},
+	)
 	if err != nil {
-		_ = process.EmptyChannel(boot.chRcvHdrHash)
-		boot.requestHeaderWithHash(hash)
-		err := boot.waitForHeaderHash()
-		if err != nil {
-			return nil, err
-		}
+		return nil, err
+	}
 
-		hdr, err = process.GetMetaHeaderFromPool(hash, boot.headers)
-		if err != nil {
-			return nil, err
-		}
+	hdr, ok := value.(*block.MetaBlock)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
 	}
 
 	return hdr, nil
@@ -637,6 +639,7 @@ func (boot *MetaBootstrap) rollback(header *block.MetaBlock) error {
 	}
 
 	boot.cleanCachesAndStorageOnRollback(header, headerStore, headerNonceHashStore)
+	boot.indexer.RevertIndexedBlock(header)
 	errNotCritical := boot.blkExecutor.RestoreBlockIntoPools(header, nil)
 	if errNotCritical != nil {
 		log.Info(errNotCritical.Error())
@@ -645,6 +648,15 @@ func (boot *MetaBootstrap) rollback(header *block.MetaBlock) error {
 	return nil
 }
 
+func (boot *MetaBootstrap) rollbackCurrentBlock() error {
+	header, err := boot.getCurrentHeader()
+	if err != nil {
+		return err
+	}
+
+	return boot.rollback(header)
+}
+
 func (boot *MetaBootstrap) getPrevHeader(headerStore storage.Storer, header *block.MetaBlock) (*block.MetaBlock, error) {
 	prevHash := header.GetPrevHash()
 	buffHeader, err := headerStore.Get(prevHash)
@@ -1883,6 +1883,12 @@ func TestMetaBootstrap_ForkChoiceIsEmptyCallRollBackOkValsShouldWork(t *testing.
 		math.MaxUint32,
 	)
 
+	var revertedHeader data.HeaderHandler
+	_ = bs.SetIndexer(&mock.IndexerMock{
+		RevertIndexedBlockCalled: func(header data.HeaderHandler) {
+			revertedHeader = header
+		},
+	})
 	bs.SetForkNonce(currentHdrNonce)
 
 	hdr := &block.MetaBlock{
@@ -1924,6 +1930,7 @@ func TestMetaBootstrap_ForkChoiceIsEmptyCallRollBackOkValsShouldWork(t *testing.
 	assert.Equal(t, blkc.GetCurrentBlockHeader(), prevHdr)
 	assert.Equal(t, blkc.GetCurrentBlockBody(), prevTxBlockBody)
 	assert.Equal(t, blkc.GetCurrentBlockHeaderHash(), prevHdrHash)
+	assert.Equal(t, currentHdrNonce, revertedHeader.GetNonce())
 }
 
 func TestMetaBootstrap_ForkChoiceIsEmptyCallRollBackToGenesisShouldWork(t *testing.T) {
@@ -2605,3 +2612,55 @@ func TestMetaBootstrap_SetStatusHandlerNilHandlerShouldErr(t *testing.T) {
 	err := bs.SetStatusHandler(nil)
 	assert.Equal(t, process.ErrNilAppStatusHandler, err)
 }
+
+func TestMetaBootstrap_SetIndexerNilIndexerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pools := &mock.MetaPoolsHolderStub{}
+	pools.MetaBlocksCalled = func() storage.Cacher {
+		sds := &mock.CacherStub{}
+
+		sds.HasOrAddCalled = func(key []byte, value interface{}) (ok, evicted bool) {
+			return false, false
+		}
+
+		sds.RegisterHandlerCalled = func(func(key []byte)) {
+		}
+
+		return sds
+	}
+	pools.HeadersNoncesCalled = func() dataRetriever.Uint64SyncMapCacher {
+		hnc := &mock.Uint64SyncMapCacherStub{}
+		hnc.RegisterHandlerCalled = func(handler func(nonce uint64, shardId uint32, hash []byte)) {}
+
+		return hnc
+	}
+
+	blkc := initBlockchain()
+	rnd := &mock.RounderMock{}
+	blkExec := &mock.BlockProcessorMock{}
+	hasher := &mock.HasherMock{}
+	marshalizer := &mock.MarshalizerMock{}
+	forkDetector := &mock.ForkDetectorMock{}
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	account := &mock.AccountsStub{}
+
+	bs, _ := sync.NewMetaBootstrap(
+		pools,
+		createStore(),
+		blkc,
+		rnd,
+		blkExec,
+		waitTime,
+		hasher,
+		marshalizer,
+		forkDetector,
+		createMockResolversFinderMeta(),
+		shardCoordinator,
+		account,
+		math.MaxUint32,
+	)
+
+	err := bs.SetIndexer(nil)
+	assert.Equal(t, process.ErrNilIndexer, err)
+}
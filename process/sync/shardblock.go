@@ -8,11 +8,13 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -82,6 +84,11 @@ func NewShardBootstrap(
 		return nil, err
 	}
 
+	poolRequester, err := requestHandlers.NewPoolRequester(marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
 	base := &baseBootstrap{
 		blkc:                blkc,
 		blkExecutor:         blkExecutor,
@@ -96,6 +103,7 @@ func NewShardBootstrap(
 		shardCoordinator:    shardCoordinator,
 		accounts:            accounts,
 		bootstrapRoundIndex: bootstrapRoundIndex,
+		poolRequester:       poolRequester,
 	}
 
 	boot := ShardBootstrap{
@@ -105,6 +113,7 @@ func NewShardBootstrap(
 
 	base.storageBootstrapper = &boot
 	base.requestMiniBlocks = boot.requestMiniBlocksFromHeaderWithNonceIfMissing
+	base.rollbackCurrentBlock = boot.rollbackCurrentBlock
 
 	//there is one header topic so it is ok to save it
 	hdrResolver, err := resolversFinder.IntraShardResolver(factory.HeadersTopic)
@@ -123,11 +132,9 @@ func NewShardBootstrap(
 	boot.miniBlockResolver = miniBlocksResolver.(dataRetriever.MiniBlocksResolver)
 
 	boot.chRcvHdrNonce = make(chan bool)
-	boot.chRcvHdrHash = make(chan bool)
 	boot.chRcvMiniBlocks = make(chan bool)
 
 	boot.setRequestedHeaderNonce(nil)
-	boot.setRequestedHeaderHash(nil)
 	boot.setRequestedMiniBlocks(nil)
 
 	boot.headersNonces.RegisterHandler(boot.receivedHeaderNonce)
@@ -137,6 +144,7 @@ func NewShardBootstrap(
 	boot.chStopSync = make(chan bool)
 
 	boot.statusHandler = statusHandler.NewNilStatusHandler()
+	boot.indexer = indexer.NewNilIndexer()
 
 	boot.syncStateListeners = make([]func(bool), 0)
 	boot.requestedHashes = process.RequiredDataPool{}
@@ -613,6 +621,10 @@ func (boot *ShardBootstrap) syncBlocks() {
 }
 
 func (boot *ShardBootstrap) doJobOnSyncBlockFail(hdr *block.Header, err error) {
+	if boot.recoverStateIfNeeded(hdr, err) {
+		return
+	}
+
 	if err == process.ErrTimeIsOut {
 		boot.requestsWithTimeout++
 	}
@@ -662,7 +674,6 @@ func (boot *ShardBootstrap) SyncBlock() error {
 	}
 
 	boot.setRequestedHeaderNonce(nil)
-	boot.setRequestedHeaderHash(nil)
 	boot.setRequestedMiniBlocks(nil)
 
 	nonce := boot.getNonceForNextBlock()
@@ -722,6 +733,7 @@ func (boot *ShardBootstrap) SyncBlock() error {
 
 	log.Info(fmt.Sprintf("block with nonce %d has been synced successfully\n", hdr.Nonce))
 	boot.requestsWithTimeout = 0
+	boot.rootStateMismatches = 0
 
 	return nil
 }
@@ -740,18 +752,6 @@ func (boot *ShardBootstrap) requestHeaderWithNonce(nonce uint64) {
 	}
 }
 
-// requestHeaderWithHash method requests a block header from network when it is not found in the pool
-func (boot *ShardBootstrap) requestHeaderWithHash(hash []byte) {
-	boot.setRequestedHeaderHash(hash)
-	err := boot.hdrRes.RequestDataFromHash(hash)
-
-	log.Info(fmt.Sprintf("requested header with hash %s from network\n", core.ToB64(hash)))
-
-	if err != nil {
-		log.Error(err.Error())
-	}
-}
-
 // getHeaderWithNonceRequestingIfMissing method gets the header with a given nonce from pool. If it is not found there, it will
 // be requested from network
 func (boot *ShardBootstrap) getHeaderWithNonceRequestingIfMissing(nonce uint64) (*block.Header, error) {
@@ -784,19 +784,21 @@ func (boot *ShardBootstrap) getHeaderWithNonceRequestingIfMissing(nonce uint64)
 // getHeaderWithHashRequestingIfMissing method gets the header with a given hash from pool. If it is not found there,
 // it will be requested from network
 func (boot *ShardBootstrap) getHeaderWithHashRequestingIfMissing(hash []byte) (*block.Header, error) {
-	hdr, err := process.GetShardHeader(hash, boot.headers, boot.marshalizer, boot.store)
+	value, err := boot.getHeaderWithHashFromPoolRequester(
+		hash,
+		dataRetriever.BlockHeaderUnit,
+		boot.hdrRes,
+		func() interface{} {
+			return &block.Header{}
+		},
+	)
 	if err != nil {
-		_ = process.EmptyChannel(boot.chRcvHdrHash)
-		boot.requestHeaderWithHash(hash)
-		err := boot.waitForHeaderHash()
-		if err != nil {
-			return nil, err
-		}
+		return nil, err
+	}
 
-		hdr, err = process.GetShardHeaderFromPool(hash, boot.headers)
-		if err != nil {
-			return nil, err
-		}
+	hdr, ok := value.(*block.Header)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
 	}
 
 	return hdr, nil
@@ -946,6 +948,7 @@ func (boot *ShardBootstrap) rollback(header *block.Header) error {
 	}
 
 	boot.cleanCachesAndStorageOnRollback(header, headerStore, headerNonceHashStore)
+	boot.indexer.RevertIndexedBlock(header)
 	errNotCritical := boot.blkExecutor.RestoreBlockIntoPools(header, body)
 	if errNotCritical != nil {
 		log.Info(errNotCritical.Error())
@@ -954,6 +957,15 @@ func (boot *ShardBootstrap) rollback(header *block.Header) error {
 	return nil
 }
 
+func (boot *ShardBootstrap) rollbackCurrentBlock() error {
+	header, err := boot.getCurrentHeader()
+	if err != nil {
+		return err
+	}
+
+	return boot.rollback(header)
+}
+
 func (boot *ShardBootstrap) getPrevHeader(headerStore storage.Storer, header *block.Header) (*block.Header, error) {
 	prevHash := header.PrevHash
 	buffHeader, err := headerStore.Get(prevHash)
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"strings"
 	goSync "sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -918,6 +919,7 @@ func TestBootstrap_SyncBlockShouldCallForkChoice(t *testing.T) {
 
 	store := dataRetriever.NewChainStorer()
 	store.AddStorer(dataRetriever.MiniBlockUnit, blockBodyUnit)
+	store.AddStorer(dataRetriever.BlockHeaderUnit, generateTestUnit())
 
 	blkc, _ := blockchain.NewBlockChain(
 		&mock.CacherStub{},
@@ -1817,6 +1819,96 @@ func TestBootstrap_ShouldSyncShouldReturnFalseWhenForkIsDetectedAndItReceivesThe
 	assert.False(t, bs.IsForkDetected())
 }
 
+func createResolversFinderWithHeaderRequestsCounter(numRequests *int32) *mock.ResolversFinderStub {
+	resolversFinder := createMockResolversFinder()
+	resolversFinder.IntraShardResolverCalled = func(baseTopic string) (resolver dataRetriever.Resolver, e error) {
+		if strings.Contains(baseTopic, factory.HeadersTopic) {
+			return &mock.HeaderResolverMock{
+				RequestDataFromHashCalled: func(hash []byte) error {
+					atomic.AddInt32(numRequests, 1)
+					return nil
+				},
+			}, nil
+		}
+
+		return createMockResolversFinder().IntraShardResolver(baseTopic)
+	}
+
+	return resolversFinder
+}
+
+func TestBootstrap_GetHeaderWithHashRequestingIfMissingFoundInStorerShouldNotRequest(t *testing.T) {
+	t.Parallel()
+
+	hdr := &block.Header{Nonce: 5}
+	marshalizer := &mock.MarshalizerMock{}
+	store := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &mock.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					if unitType == dataRetriever.BlockHeaderUnit {
+						return marshalizer.Marshal(hdr)
+					}
+					return nil, process.ErrMissingHeader
+				},
+			}
+		},
+	}
+	numRequests := int32(0)
+	rnd, _ := round.NewRound(time.Now(), time.Now(), time.Duration(100*time.Millisecond), &mock.SyncTimerMock{})
+
+	bs, _ := sync.NewShardBootstrap(
+		createMockPools(),
+		store,
+		initBlockchain(),
+		rnd,
+		&mock.BlockProcessorMock{},
+		waitTime,
+		&mock.HasherMock{},
+		marshalizer,
+		&mock.ForkDetectorMock{},
+		createResolversFinderWithHeaderRequestsCounter(&numRequests),
+		mock.NewOneShardCoordinatorMock(),
+		&mock.AccountsStub{},
+		math.MaxUint32,
+	)
+
+	header, err := bs.GetHeaderWithHashRequestingIfMissing([]byte("hash"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, hdr, header)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&numRequests))
+}
+
+func TestBootstrap_GetHeaderWithHashRequestingIfMissingNotFoundShouldRequestAndTimeOut(t *testing.T) {
+	t.Parallel()
+
+	numRequests := int32(0)
+	rnd, _ := round.NewRound(time.Now(), time.Now(), time.Duration(100*time.Millisecond), &mock.SyncTimerMock{})
+
+	bs, _ := sync.NewShardBootstrap(
+		createMockPools(),
+		createStore(),
+		initBlockchain(),
+		rnd,
+		&mock.BlockProcessorMock{},
+		waitTime,
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.ForkDetectorMock{},
+		createResolversFinderWithHeaderRequestsCounter(&numRequests),
+		mock.NewOneShardCoordinatorMock(),
+		&mock.AccountsStub{},
+		math.MaxUint32,
+	)
+
+	header, err := bs.GetHeaderWithHashRequestingIfMissing([]byte("hash"))
+
+	assert.Nil(t, header)
+	assert.Equal(t, process.ErrTimeIsOut, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numRequests))
+}
+
 func TestBootstrap_GetHeaderFromPoolShouldReturnNil(t *testing.T) {
 	t.Parallel()
 
@@ -2372,6 +2464,12 @@ func TestBootstrap_ForkChoiceIsEmptyCallRollBackOkValsShouldWork(t *testing.T) {
 		math.MaxUint32,
 	)
 
+	var revertedHeader data.HeaderHandler
+	_ = bs.SetIndexer(&mock.IndexerMock{
+		RevertIndexedBlockCalled: func(header data.HeaderHandler) {
+			revertedHeader = header
+		},
+	})
 	bs.SetForkNonce(currentHdrNonce)
 
 	hdr := &block.Header{
@@ -2413,6 +2511,7 @@ func TestBootstrap_ForkChoiceIsEmptyCallRollBackOkValsShouldWork(t *testing.T) {
 	assert.Equal(t, blkc.GetCurrentBlockHeader(), prevHdr)
 	assert.Equal(t, blkc.GetCurrentBlockBody(), prevTxBlockBody)
 	assert.Equal(t, blkc.GetCurrentBlockHeaderHash(), prevHdrHash)
+	assert.Equal(t, currentHdrNonce, revertedHeader.GetNonce())
 }
 
 func TestBootstrap_ForkChoiceIsEmptyCallRollBackToGenesisShouldWork(t *testing.T) {
@@ -4971,3 +5070,62 @@ func TestShardBootstrap_SetStatusHandlerNilHandlerShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilAppStatusHandler, err)
 
 }
+
+func TestShardBootstrap_SetIndexerNilIndexerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pools := &mock.PoolsHolderStub{}
+	pools.HeadersCalled = func() storage.Cacher {
+		sds := &mock.CacherStub{}
+
+		sds.HasOrAddCalled = func(key []byte, value interface{}) (ok, evicted bool) {
+			assert.Fail(t, "should have not reached this point")
+			return false, false
+		}
+
+		sds.RegisterHandlerCalled = func(func(key []byte)) {
+		}
+
+		return sds
+	}
+	pools.HeadersNoncesCalled = func() dataRetriever.Uint64SyncMapCacher {
+		hnc := &mock.Uint64SyncMapCacherStub{}
+		hnc.RegisterHandlerCalled = func(handler func(nonce uint64, shardId uint32, hash []byte)) {}
+
+		return hnc
+	}
+	pools.MiniBlocksCalled = func() storage.Cacher {
+		cs := &mock.CacherStub{}
+		cs.RegisterHandlerCalled = func(i func(key []byte)) {}
+
+		return cs
+	}
+
+	blkc := initBlockchain()
+	rnd := &mock.RounderMock{}
+	blkExec := &mock.BlockProcessorMock{}
+	hasher := &mock.HasherMock{}
+	marshalizer := &mock.MarshalizerMock{}
+	forkDetector := &mock.ForkDetectorMock{}
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+	account := &mock.AccountsStub{}
+
+	bs, _ := sync.NewShardBootstrap(
+		pools,
+		createStore(),
+		blkc,
+		rnd,
+		blkExec,
+		waitTime,
+		hasher,
+		marshalizer,
+		forkDetector,
+		createMockResolversFinder(),
+		shardCoordinator,
+		account,
+		math.MaxUint32,
+	)
+
+	err := bs.SetIndexer(nil)
+	assert.Equal(t, process.ErrNilIndexer, err)
+}
@@ -0,0 +1,176 @@
+package sync
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// StateMismatchRecord is written for forensics before a node rolls back its diverged state. The diverged accounts
+// state can be inspected offline by recreating the accounts trie from CurrentRootHash, as the trie nodes are kept in
+// the accounts storage after the rollback
+type StateMismatchRecord struct {
+	Timestamp        int64  `json:"timestamp"`
+	NumMismatches    uint32 `json:"numMismatches"`
+	CurrentNonce     uint64 `json:"currentNonce"`
+	CurrentHash      string `json:"currentHash"`
+	CurrentRootHash  string `json:"currentRootHash"`
+	RejectedNonce    uint64 `json:"rejectedNonce"`
+	RejectedHash     string `json:"rejectedHash"`
+	RejectedRootHash string `json:"rejectedRootHash"`
+	RollbackDepth    uint32 `json:"rollbackDepth"`
+}
+
+type stateRecoveryConfig struct {
+	mismatchesThreshold uint32
+	rollbackDepth       uint32
+	forensicsFolder     string
+}
+
+// EnableStateRecovery enables the automatic recovery from a diverged accounts state. After mismatchesThreshold
+// consecutive rejections of the next block because of a state root mismatch, the diverged state is recorded in the
+// forensics folder, the last rollbackDepth blocks are rolled back, even if they are final, and the node resyncs
+// from the network starting with the last remaining block, whose state root was verified when it was committed
+func (boot *baseBootstrap) EnableStateRecovery(mismatchesThreshold uint32, rollbackDepth uint32, forensicsFolder string) error {
+	if mismatchesThreshold == 0 {
+		return ErrInvalidMismatchesThreshold
+	}
+	if rollbackDepth == 0 {
+		return ErrInvalidRollbackDepth
+	}
+	if len(forensicsFolder) == 0 {
+		return ErrEmptyForensicsFolder
+	}
+
+	boot.stateRecovery = &stateRecoveryConfig{
+		mismatchesThreshold: mismatchesThreshold,
+		rollbackDepth:       rollbackDepth,
+		forensicsFolder:     forensicsFolder,
+	}
+
+	return nil
+}
+
+// recoverStateIfNeeded counts the consecutive state root mismatches and recovers the state once the configured
+// threshold is reached. It returns true if the recovery was triggered, in which case the usual fork choice must
+// not be done
+func (boot *baseBootstrap) recoverStateIfNeeded(rejectedHeader data.HeaderHandler, err error) bool {
+	if err != process.ErrRootStateDoesNotMatch {
+		return false
+	}
+
+	boot.rootStateMismatches++
+	if boot.stateRecovery == nil || boot.rootStateMismatches < boot.stateRecovery.mismatchesThreshold {
+		return false
+	}
+
+	errRecover := boot.recoverState(rejectedHeader)
+	if errRecover != nil {
+		log.Error(fmt.Sprintf("state recovery failed: %s", errRecover.Error()))
+	}
+	boot.rootStateMismatches = 0
+
+	return true
+}
+
+func (boot *baseBootstrap) recoverState(rejectedHeader data.HeaderHandler) error {
+	currentHeader := boot.blkc.GetCurrentBlockHeader()
+	if currentHeader == nil || currentHeader.IsInterfaceNil() {
+		return process.ErrNilBlockHeader
+	}
+
+	record := boot.createStateMismatchRecord(currentHeader, rejectedHeader)
+	fileName, err := boot.writeStateMismatchRecord(record)
+	if err != nil {
+		log.Error(fmt.Sprintf("cannot write the state mismatch record: %s", err.Error()))
+	} else {
+		log.Info(fmt.Sprintf("state mismatch record written in %s", fileName))
+	}
+
+	for i := uint32(0); i < boot.stateRecovery.rollbackDepth; i++ {
+		currentHeader = boot.blkc.GetCurrentBlockHeader()
+		if currentHeader == nil || currentHeader.IsInterfaceNil() {
+			break
+		}
+
+		err = boot.rollbackCurrentBlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	nonce, round := uint64(0), uint64(0)
+	currentHeader = boot.blkc.GetCurrentBlockHeader()
+	if currentHeader != nil && !currentHeader.IsInterfaceNil() {
+		nonce, round = currentHeader.GetNonce(), currentHeader.GetRound()
+	}
+	boot.forkDetector.ResetCheckpoints(nonce, round)
+	boot.forkDetector.ResetProbableHighestNonce()
+	boot.statusHandler.Increment(core.MetricNumStateRecoveries)
+
+	log.Info(fmt.Sprintf("state rolled back to block with nonce %d after %d state root mismatches, resyncing\n",
+		boot.getNonceForNextBlock()-1,
+		record.NumMismatches))
+
+	return nil
+}
+
+func (boot *baseBootstrap) createStateMismatchRecord(
+	currentHeader data.HeaderHandler,
+	rejectedHeader data.HeaderHandler,
+) *StateMismatchRecord {
+	record := &StateMismatchRecord{
+		Timestamp:     time.Now().UnixNano(),
+		NumMismatches: boot.rootStateMismatches,
+		CurrentNonce:  currentHeader.GetNonce(),
+		CurrentHash:   hex.EncodeToString(boot.blkc.GetCurrentBlockHeaderHash()),
+		RollbackDepth: boot.stateRecovery.rollbackDepth,
+	}
+
+	currentRootHash, err := boot.accounts.RootHash()
+	if err != nil {
+		log.Debug(err.Error())
+	}
+	record.CurrentRootHash = hex.EncodeToString(currentRootHash)
+
+	if rejectedHeader == nil || rejectedHeader.IsInterfaceNil() {
+		return record
+	}
+
+	record.RejectedNonce = rejectedHeader.GetNonce()
+	record.RejectedRootHash = hex.EncodeToString(rejectedHeader.GetRootHash())
+	rejectedHash, err := core.CalculateHash(boot.marshalizer, boot.hasher, rejectedHeader)
+	if err != nil {
+		log.Debug(err.Error())
+	}
+	record.RejectedHash = hex.EncodeToString(rejectedHash)
+
+	return record
+}
+
+func (boot *baseBootstrap) writeStateMismatchRecord(record *StateMismatchRecord) (string, error) {
+	err := os.MkdirAll(boot.stateRecovery.forensicsFolder, os.ModePerm)
+	if err != nil {
+		return "", err
+	}
+
+	buff, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	fileName := filepath.Join(
+		boot.stateRecovery.forensicsFolder,
+		fmt.Sprintf("state-mismatch-%d-%d.json", record.CurrentNonce, record.Timestamp),
+	)
+
+	return fileName, ioutil.WriteFile(fileName, buff, 0644)
+}
@@ -0,0 +1,240 @@
+package sync_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/sync"
+	"github.com/stretchr/testify/assert"
+)
+
+func createShardBootstrapForStateRecovery(
+	blkc *mock.BlockChainMock,
+	forkDetector process.ForkDetector,
+) *sync.ShardBootstrap {
+	bs, _ := sync.NewShardBootstrap(
+		createMockPools(),
+		createStore(),
+		blkc,
+		&mock.RounderMock{},
+		createBlockProcessor(),
+		waitTime,
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		forkDetector,
+		createMockResolversFinder(),
+		mock.NewOneShardCoordinatorMock(),
+		&mock.AccountsStub{
+			RootHashCalled: func() ([]byte, error) {
+				return []byte("diverged root hash"), nil
+			},
+		},
+		math.MaxUint32,
+	)
+
+	return bs
+}
+
+func createForensicsFolder(t *testing.T) string {
+	forensicsFolder, err := ioutil.TempDir("", "state-forensics")
+	assert.Nil(t, err)
+
+	return forensicsFolder
+}
+
+func TestShardBootstrap_EnableStateRecoveryInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bs := createShardBootstrapForStateRecovery(&mock.BlockChainMock{}, &mock.ForkDetectorMock{})
+
+	err := bs.EnableStateRecovery(0, 1, "folder")
+	assert.Equal(t, sync.ErrInvalidMismatchesThreshold, err)
+
+	err = bs.EnableStateRecovery(1, 0, "folder")
+	assert.Equal(t, sync.ErrInvalidRollbackDepth, err)
+
+	err = bs.EnableStateRecovery(1, 1, "")
+	assert.Equal(t, sync.ErrEmptyForensicsFolder, err)
+
+	err = bs.EnableStateRecovery(1, 1, "folder")
+	assert.Nil(t, err)
+}
+
+func TestShardBootstrap_RecoverStateIfNeededOtherErrorShouldNotCount(t *testing.T) {
+	t.Parallel()
+
+	bs := createShardBootstrapForStateRecovery(&mock.BlockChainMock{}, &mock.ForkDetectorMock{})
+	_ = bs.EnableStateRecovery(1, 1, "folder")
+
+	recovered := bs.RecoverStateIfNeeded(&block.Header{Nonce: 2}, process.ErrTimeIsOut)
+
+	assert.False(t, recovered)
+	assert.Equal(t, uint32(0), bs.RootStateMismatches())
+}
+
+func TestShardBootstrap_RecoverStateIfNeededDisabledShouldNotRecover(t *testing.T) {
+	t.Parallel()
+
+	bs := createShardBootstrapForStateRecovery(&mock.BlockChainMock{}, &mock.ForkDetectorMock{})
+	bs.SetRollbackCurrentBlock(func() error {
+		assert.Fail(t, "rollback should not have been called")
+		return nil
+	})
+
+	for i := 0; i < 5; i++ {
+		recovered := bs.RecoverStateIfNeeded(&block.Header{Nonce: 2}, process.ErrRootStateDoesNotMatch)
+		assert.False(t, recovered)
+	}
+	assert.Equal(t, uint32(5), bs.RootStateMismatches())
+}
+
+func TestShardBootstrap_RecoverStateIfNeededShouldRecordAndRollbackWhenThresholdIsReached(t *testing.T) {
+	t.Parallel()
+
+	forensicsFolder := createForensicsFolder(t)
+	defer func() {
+		_ = os.RemoveAll(forensicsFolder)
+	}()
+
+	currentHeader := &block.Header{Nonce: 5, Round: 7}
+	blkc := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return currentHeader
+		},
+	}
+	resetCalled := false
+	var checkpointNonce, checkpointRound uint64
+	forkDetector := &mock.ForkDetectorMock{
+		ResetProbableHighestNonceCalled: func() {
+			resetCalled = true
+		},
+		ResetCheckpointsCalled: func(nonce uint64, round uint64) {
+			checkpointNonce = nonce
+			checkpointRound = round
+		},
+	}
+	bs := createShardBootstrapForStateRecovery(blkc, forkDetector)
+	_ = bs.EnableStateRecovery(2, 3, forensicsFolder)
+
+	numRollbacks := 0
+	bs.SetRollbackCurrentBlock(func() error {
+		numRollbacks++
+		currentHeader = &block.Header{Nonce: currentHeader.Nonce - 1, Round: currentHeader.Round - 1}
+		return nil
+	})
+	rejectedHeader := &block.Header{Nonce: 6, RootHash: []byte("network root hash")}
+
+	recovered := bs.RecoverStateIfNeeded(rejectedHeader, process.ErrRootStateDoesNotMatch)
+	assert.False(t, recovered)
+	assert.Equal(t, 0, numRollbacks)
+
+	recovered = bs.RecoverStateIfNeeded(rejectedHeader, process.ErrRootStateDoesNotMatch)
+	assert.True(t, recovered)
+	assert.Equal(t, 3, numRollbacks)
+	assert.Equal(t, uint64(2), currentHeader.Nonce)
+	assert.True(t, resetCalled)
+	assert.Equal(t, uint64(2), checkpointNonce)
+	assert.Equal(t, uint64(4), checkpointRound)
+	assert.Equal(t, uint32(0), bs.RootStateMismatches())
+
+	files, _ := filepath.Glob(filepath.Join(forensicsFolder, "state-mismatch-5-*.json"))
+	assert.Equal(t, 1, len(files))
+
+	buff, _ := ioutil.ReadFile(files[0])
+	record := &sync.StateMismatchRecord{}
+	_ = json.Unmarshal(buff, record)
+	assert.Equal(t, uint32(2), record.NumMismatches)
+	assert.Equal(t, uint64(5), record.CurrentNonce)
+	assert.Equal(t, "646976657267656420726f6f742068617368", record.CurrentRootHash)
+	assert.Equal(t, uint64(6), record.RejectedNonce)
+	assert.Equal(t, "6e6574776f726b20726f6f742068617368", record.RejectedRootHash)
+	assert.Equal(t, uint32(3), record.RollbackDepth)
+}
+
+func TestShardBootstrap_RecoverStateIfNeededShouldStopRollbackAtGenesis(t *testing.T) {
+	t.Parallel()
+
+	forensicsFolder := createForensicsFolder(t)
+	defer func() {
+		_ = os.RemoveAll(forensicsFolder)
+	}()
+
+	var currentHeader data.HeaderHandler = &block.Header{Nonce: 1}
+	blkc := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return currentHeader
+		},
+	}
+	forkDetector := &mock.ForkDetectorMock{
+		ResetProbableHighestNonceCalled: func() {},
+	}
+	bs := createShardBootstrapForStateRecovery(blkc, forkDetector)
+	_ = bs.EnableStateRecovery(1, 10, forensicsFolder)
+
+	numRollbacks := 0
+	bs.SetRollbackCurrentBlock(func() error {
+		numRollbacks++
+		currentHeader = nil
+		return nil
+	})
+
+	recovered := bs.RecoverStateIfNeeded(&block.Header{Nonce: 2}, process.ErrRootStateDoesNotMatch)
+
+	assert.True(t, recovered)
+	assert.Equal(t, 1, numRollbacks)
+}
+
+func TestShardBootstrap_RecoverStateShouldAllowSyncingAfterRollingBackPastTheFinalNonce(t *testing.T) {
+	t.Parallel()
+
+	forensicsFolder := createForensicsFolder(t)
+	defer func() {
+		_ = os.RemoveAll(forensicsFolder)
+	}()
+
+	forkDetector, _ := sync.NewShardForkDetector(&mock.RounderMock{RoundIndex: 10})
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		hdr := &block.Header{Nonce: nonce, Round: nonce, PubKeysBitmap: []byte("X")}
+		finalHdr := &block.Header{Nonce: nonce - 1, Round: nonce - 1, PubKeysBitmap: []byte("X")}
+		_ = forkDetector.AddHeader(
+			hdr,
+			[]byte(fmt.Sprintf("hash%d", nonce)),
+			process.BHProcessed,
+			[]data.HeaderHandler{finalHdr},
+			[][]byte{[]byte(fmt.Sprintf("hash%d", nonce-1))},
+		)
+	}
+	assert.Equal(t, uint64(4), forkDetector.GetHighestFinalBlockNonce())
+
+	currentHeader := &block.Header{Nonce: 5, Round: 5}
+	blkc := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return currentHeader
+		},
+	}
+	bs := createShardBootstrapForStateRecovery(blkc, forkDetector)
+	_ = bs.EnableStateRecovery(1, 3, forensicsFolder)
+	bs.SetRollbackCurrentBlock(func() error {
+		forkDetector.RemoveHeaders(currentHeader.Nonce, []byte(fmt.Sprintf("hash%d", currentHeader.Nonce)))
+		currentHeader = &block.Header{Nonce: currentHeader.Nonce - 1, Round: currentHeader.Round - 1}
+		return nil
+	})
+
+	recovered := bs.RecoverStateIfNeeded(&block.Header{Nonce: 6}, process.ErrRootStateDoesNotMatch)
+	assert.True(t, recovered)
+	assert.Equal(t, uint64(2), currentHeader.Nonce)
+	assert.Equal(t, uint64(2), forkDetector.GetHighestFinalBlockNonce())
+
+	syncedHeader := &block.Header{Nonce: 3, Round: 3, PubKeysBitmap: []byte("X")}
+	err := forkDetector.AddHeader(syncedHeader, []byte("synced hash3"), process.BHProcessed, nil, nil)
+	assert.Nil(t, err)
+}
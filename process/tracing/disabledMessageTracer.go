@@ -0,0 +1,41 @@
+package tracing
+
+import (
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// disabledMessageTracer is the message tracer used by the interceptors until a real one is set. It traces nothing
+type disabledMessageTracer struct {
+}
+
+// NewDisabledMessageTracer creates a message tracer that traces nothing
+func NewDisabledMessageTracer() *disabledMessageTracer {
+	return &disabledMessageTracer{}
+}
+
+// StartTrace returns a trace that records nothing
+func (dmt *disabledMessageTracer) StartTrace(_ p2p.MessageP2P) process.MessageTrace {
+	return NewDisabledMessageTrace()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dmt *disabledMessageTracer) IsInterfaceNil() bool {
+	if dmt == nil {
+		return true
+	}
+	return false
+}
+
+// disabledMessageTrace is the trace of a message that is not traced. It records nothing
+type disabledMessageTrace struct {
+}
+
+// NewDisabledMessageTrace creates a message trace that records nothing
+func NewDisabledMessageTrace() *disabledMessageTrace {
+	return &disabledMessageTrace{}
+}
+
+// MarkStage does nothing
+func (dmt *disabledMessageTrace) MarkStage(_ string) {
+}
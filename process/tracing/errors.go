@@ -0,0 +1,17 @@
+package tracing
+
+import (
+	"errors"
+)
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrInvalidMaxTraces signals that an invalid maximum number of kept traces has been provided
+var ErrInvalidMaxTraces = errors.New("invalid maximum number of kept traces")
+
+// ErrEmptyTopic signals that an empty topic has been provided
+var ErrEmptyTopic = errors.New("empty topic")
+
+// ErrInvalidSampleRate signals that an invalid sample rate has been provided
+var ErrInvalidSampleRate = errors.New("invalid sample rate")
@@ -0,0 +1,9 @@
+package tracing
+
+import (
+	"time"
+)
+
+func (mt *MessageTracer) SetCurrentTime(currentTime func() time.Time) {
+	mt.currentTime = currentTime
+}
@@ -0,0 +1,233 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// StageUnmarshaled is reached when the message payload was unmarshaled
+const StageUnmarshaled = "unmarshaled"
+
+// StageVerified is reached when an intercepted item passed its integrity and signature checks
+const StageVerified = "verified"
+
+// StageValidated is reached when an intercepted item was found valid for processing
+const StageValidated = "validated"
+
+// StageAddedToPool is reached when an intercepted item was added to its data pool
+const StageAddedToPool = "addedToPool"
+
+// StageTiming holds the time elapsed between the reception of a traced message and the moment it reached a stage
+type StageTiming struct {
+	Stage       string `json:"stage"`
+	ElapsedNano int64  `json:"elapsedNano"`
+}
+
+// MessageTraceRecord holds the envelope metadata of a traced message, the hash of its payload and the timing of its
+// processing stages. As a message can carry several items (e.g. a batch of transactions), a stage reached by more
+// than one of them is recorded each time
+type MessageTraceRecord struct {
+	Topic       string        `json:"topic"`
+	Peer        string        `json:"peer"`
+	SeqNo       string        `json:"seqNo"`
+	PayloadHash string        `json:"payloadHash"`
+	PayloadSize int           `json:"payloadSize"`
+	ReceivedAt  int64         `json:"receivedAt"`
+	Stages      []StageTiming `json:"stages"`
+}
+
+type messageTrace struct {
+	mutTrace    sync.Mutex
+	record      MessageTraceRecord
+	receivedAt  time.Time
+	currentTime func() time.Time
+}
+
+// MarkStage records the time elapsed since the message was received until it reached the provided stage
+func (mt *messageTrace) MarkStage(stage string) {
+	elapsed := mt.currentTime().Sub(mt.receivedAt)
+
+	mt.mutTrace.Lock()
+	mt.record.Stages = append(mt.record.Stages, StageTiming{
+		Stage:       stage,
+		ElapsedNano: elapsed.Nanoseconds(),
+	})
+	mt.mutTrace.Unlock()
+}
+
+func (mt *messageTrace) copyRecord() MessageTraceRecord {
+	mt.mutTrace.Lock()
+	defer mt.mutTrace.Unlock()
+
+	record := mt.record
+	record.Stages = make([]StageTiming, len(mt.record.Stages))
+	copy(record.Stages, mt.record.Stages)
+
+	return record
+}
+
+type topicSampler struct {
+	numMessages uint64
+	sampleRate  uint32
+}
+
+// MessageTracer samples 1 in N of the messages received on the traced topics and keeps, for the last traced
+// messages, their envelope metadata, payload hash and the timing of their interceptor processing stages. It is a
+// debug facility meant to measure where the time between the reception of a message and the moment its content
+// reaches the data pools is spent
+type MessageTracer struct {
+	hasher      hashing.Hasher
+	currentTime func() time.Time
+
+	mutTopics sync.RWMutex
+	topics    map[string]*topicSampler
+
+	mutTraces sync.RWMutex
+	traces    []*messageTrace
+	maxTraces int
+	nextTrace int
+}
+
+// NewMessageTracer creates a new message tracer keeping at most maxTraces traces. No topic is traced until enabled
+func NewMessageTracer(hasher hashing.Hasher, maxTraces int) (*MessageTracer, error) {
+	if hasher == nil || hasher.IsInterfaceNil() {
+		return nil, ErrNilHasher
+	}
+	if maxTraces <= 0 {
+		return nil, ErrInvalidMaxTraces
+	}
+
+	return &MessageTracer{
+		hasher:      hasher,
+		currentTime: time.Now,
+		topics:      make(map[string]*topicSampler),
+		traces:      make([]*messageTrace, 0, maxTraces),
+		maxTraces:   maxTraces,
+	}, nil
+}
+
+// EnableTopic starts tracing 1 in sampleRate of the messages received on the provided topic. Enabling an already
+// traced topic changes its sample rate
+func (mt *MessageTracer) EnableTopic(topic string, sampleRate uint32) error {
+	if len(topic) == 0 {
+		return ErrEmptyTopic
+	}
+	if sampleRate == 0 {
+		return ErrInvalidSampleRate
+	}
+
+	mt.mutTopics.Lock()
+	mt.topics[topic] = &topicSampler{sampleRate: sampleRate}
+	mt.mutTopics.Unlock()
+
+	return nil
+}
+
+// DisableTopic stops tracing the provided topic. The traces already kept are not removed
+func (mt *MessageTracer) DisableTopic(topic string) {
+	mt.mutTopics.Lock()
+	delete(mt.topics, topic)
+	mt.mutTopics.Unlock()
+}
+
+// TracedTopics returns the traced topics together with their sample rates
+func (mt *MessageTracer) TracedTopics() map[string]uint32 {
+	mt.mutTopics.RLock()
+	defer mt.mutTopics.RUnlock()
+
+	topics := make(map[string]uint32, len(mt.topics))
+	for topic, sampler := range mt.topics {
+		topics[topic] = sampler.sampleRate
+	}
+
+	return topics
+}
+
+// StartTrace starts the trace of the provided message if it was received on a traced topic and it is sampled. A
+// trace recording nothing is returned otherwise
+func (mt *MessageTracer) StartTrace(message p2p.MessageP2P) process.MessageTrace {
+	if message == nil || message.IsInterfaceNil() {
+		return NewDisabledMessageTrace()
+	}
+
+	topic, ok := mt.sampledTopic(message.TopicIDs())
+	if !ok {
+		return NewDisabledMessageTrace()
+	}
+
+	receivedAt := mt.currentTime()
+	trace := &messageTrace{
+		record: MessageTraceRecord{
+			Topic:       topic,
+			Peer:        message.Peer().Pretty(),
+			SeqNo:       hex.EncodeToString(message.SeqNo()),
+			PayloadHash: hex.EncodeToString(mt.hasher.Compute(string(message.Data()))),
+			PayloadSize: len(message.Data()),
+			ReceivedAt:  receivedAt.UnixNano(),
+			Stages:      make([]StageTiming, 0),
+		},
+		receivedAt:  receivedAt,
+		currentTime: mt.currentTime,
+	}
+	mt.addTrace(trace)
+
+	return trace
+}
+
+func (mt *MessageTracer) sampledTopic(topics []string) (string, bool) {
+	mt.mutTopics.RLock()
+	defer mt.mutTopics.RUnlock()
+
+	for _, topic := range topics {
+		sampler, ok := mt.topics[topic]
+		if !ok {
+			continue
+		}
+
+		numMessages := atomic.AddUint64(&sampler.numMessages, 1)
+		return topic, (numMessages-1)%uint64(sampler.sampleRate) == 0
+	}
+
+	return "", false
+}
+
+func (mt *MessageTracer) addTrace(trace *messageTrace) {
+	mt.mutTraces.Lock()
+	defer mt.mutTraces.Unlock()
+
+	if len(mt.traces) < mt.maxTraces {
+		mt.traces = append(mt.traces, trace)
+		return
+	}
+
+	mt.traces[mt.nextTrace] = trace
+	mt.nextTrace = (mt.nextTrace + 1) % mt.maxTraces
+}
+
+// Traces returns the kept traces, oldest first
+func (mt *MessageTracer) Traces() []MessageTraceRecord {
+	mt.mutTraces.RLock()
+	defer mt.mutTraces.RUnlock()
+
+	records := make([]MessageTraceRecord, 0, len(mt.traces))
+	for i := 0; i < len(mt.traces); i++ {
+		trace := mt.traces[(mt.nextTrace+i)%len(mt.traces)]
+		records = append(records, trace.copyRecord())
+	}
+
+	return records
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mt *MessageTracer) IsInterfaceNil() bool {
+	if mt == nil {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package tracing_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTracedMessage(topic string, data string) *mock.P2PMessageMock {
+	return &mock.P2PMessageMock{
+		DataField:     []byte(data),
+		SeqNoField:    []byte{1, 2},
+		TopicIDsField: []string{topic},
+		PeerField:     p2p.PeerID("peer"),
+	}
+}
+
+func TestNewMessageTracer_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mt, err := tracing.NewMessageTracer(nil, 10)
+	assert.Nil(t, mt)
+	assert.Equal(t, tracing.ErrNilHasher, err)
+
+	mt, err = tracing.NewMessageTracer(&mock.HasherMock{}, 0)
+	assert.Nil(t, mt)
+	assert.Equal(t, tracing.ErrInvalidMaxTraces, err)
+}
+
+func TestMessageTracer_EnableTopicInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mt, _ := tracing.NewMessageTracer(&mock.HasherMock{}, 10)
+
+	err := mt.EnableTopic("", 1)
+	assert.Equal(t, tracing.ErrEmptyTopic, err)
+
+	err = mt.EnableTopic("topic", 0)
+	assert.Equal(t, tracing.ErrInvalidSampleRate, err)
+
+	assert.Equal(t, 0, len(mt.TracedTopics()))
+}
+
+func TestMessageTracer_StartTraceNotTracedTopicShouldNotKeepTrace(t *testing.T) {
+	t.Parallel()
+
+	mt, _ := tracing.NewMessageTracer(&mock.HasherMock{}, 10)
+	_ = mt.EnableTopic("traced", 1)
+
+	trace := mt.StartTrace(createTracedMessage("other", "data"))
+	trace.MarkStage(tracing.StageUnmarshaled)
+	_ = mt.StartTrace(nil)
+
+	assert.Equal(t, 0, len(mt.Traces()))
+}
+
+func TestMessageTracer_StartTraceShouldSampleOneInN(t *testing.T) {
+	t.Parallel()
+
+	mt, _ := tracing.NewMessageTracer(&mock.HasherMock{}, 10)
+	_ = mt.EnableTopic("traced", 3)
+
+	for i := 0; i < 7; i++ {
+		_ = mt.StartTrace(createTracedMessage("traced", "data"))
+	}
+
+	assert.Equal(t, 3, len(mt.Traces()))
+	assert.Equal(t, map[string]uint32{"traced": 3}, mt.TracedTopics())
+}
+
+func TestMessageTracer_ShouldRecordEnvelopeAndStages(t *testing.T) {
+	t.Parallel()
+
+	hasher := &mock.HasherMock{}
+	mt, _ := tracing.NewMessageTracer(hasher, 10)
+	_ = mt.EnableTopic("traced", 1)
+
+	now := time.Unix(0, 1000)
+	mt.SetCurrentTime(func() time.Time {
+		return now
+	})
+
+	trace := mt.StartTrace(createTracedMessage("traced", "data"))
+	now = now.Add(5 * time.Nanosecond)
+	trace.MarkStage(tracing.StageUnmarshaled)
+	now = now.Add(10 * time.Nanosecond)
+	trace.MarkStage(tracing.StageAddedToPool)
+
+	traces := mt.Traces()
+	assert.Equal(t, 1, len(traces))
+
+	record := traces[0]
+	assert.Equal(t, "traced", record.Topic)
+	assert.Equal(t, p2p.PeerID("peer").Pretty(), record.Peer)
+	assert.Equal(t, "0102", record.SeqNo)
+	assert.Equal(t, hex.EncodeToString(hasher.Compute("data")), record.PayloadHash)
+	assert.Equal(t, 4, record.PayloadSize)
+	assert.Equal(t, int64(1000), record.ReceivedAt)
+	assert.Equal(t, []tracing.StageTiming{
+		{Stage: tracing.StageUnmarshaled, ElapsedNano: 5},
+		{Stage: tracing.StageAddedToPool, ElapsedNano: 15},
+	}, record.Stages)
+}
+
+func TestMessageTracer_ShouldKeepOnlyTheLastTracesOldestFirst(t *testing.T) {
+	t.Parallel()
+
+	mt, _ := tracing.NewMessageTracer(&mock.HasherMock{}, 2)
+	_ = mt.EnableTopic("traced", 1)
+
+	_ = mt.StartTrace(createTracedMessage("traced", "a"))
+	_ = mt.StartTrace(createTracedMessage("traced", "bb"))
+	_ = mt.StartTrace(createTracedMessage("traced", "ccc"))
+
+	traces := mt.Traces()
+	assert.Equal(t, 2, len(traces))
+	assert.Equal(t, 2, traces[0].PayloadSize)
+	assert.Equal(t, 3, traces[1].PayloadSize)
+}
+
+func TestMessageTracer_DisableTopicShouldStopTracing(t *testing.T) {
+	t.Parallel()
+
+	mt, _ := tracing.NewMessageTracer(&mock.HasherMock{}, 10)
+	_ = mt.EnableTopic("traced", 1)
+	_ = mt.StartTrace(createTracedMessage("traced", "data"))
+
+	mt.DisableTopic("traced")
+	_ = mt.StartTrace(createTracedMessage("traced", "data"))
+
+	assert.Equal(t, 1, len(mt.Traces()))
+	assert.Equal(t, 0, len(mt.TracedTopics()))
+}
@@ -1,8 +1,10 @@
 package transaction
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -11,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
@@ -27,6 +30,8 @@ type TxInterceptor struct {
 	broadcastCallbackHandler func(buffToSend []byte)
 	throttler                process.InterceptorThrottler
 	feeHandler               process.FeeHandler
+	mutTracer                sync.RWMutex
+	tracer                   process.MessageTracer
 }
 
 // NewTxInterceptor hooks a new interceptor for transactions
@@ -85,6 +90,7 @@ func NewTxInterceptor(
 		shardCoordinator: shardCoordinator,
 		throttler:        throttler,
 		feeHandler:       feeHandler,
+		tracer:           tracing.NewDisabledMessageTracer(),
 	}
 
 	return txIntercept, nil
@@ -92,7 +98,7 @@ func NewTxInterceptor(
 
 // ProcessReceivedMessage will be the callback func from the p2p.Messenger and will be called each time a new message was received
 // (for the topic this validator was registered to)
-func (txi *TxInterceptor) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (txi *TxInterceptor) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
 	canProcess := txi.throttler.CanProcess()
 	if !canProcess {
 		return process.ErrSystemBusy
@@ -108,11 +114,14 @@ func (txi *TxInterceptor) ProcessReceivedMessage(message p2p.MessageP2P) error {
 		return process.ErrNilDataToProcess
 	}
 
+	trace := txi.startTrace(message)
+
 	txsBuff := make([][]byte, 0)
 	err := txi.marshalizer.Unmarshal(&txsBuff, message.Data())
 	if err != nil {
 		return err
 	}
+	trace.MarkStage(tracing.StageUnmarshaled)
 	if len(txsBuff) == 0 {
 		return process.ErrNoTransactionInMessage
 	}
@@ -120,6 +129,10 @@ func (txi *TxInterceptor) ProcessReceivedMessage(message p2p.MessageP2P) error {
 	filteredTxsBuffs := make([][]byte, 0)
 	lastErrEncountered := error(nil)
 	for _, txBuff := range txsBuff {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
 		txIntercepted, err := NewInterceptedTransaction(
 			txBuff,
 			txi.marshalizer,
@@ -137,6 +150,7 @@ func (txi *TxInterceptor) ProcessReceivedMessage(message p2p.MessageP2P) error {
 		}
 
 		//tx is validated, add it to filtered out txs
+		trace.MarkStage(tracing.StageVerified)
 		filteredTxsBuffs = append(filteredTxsBuffs, txBuff)
 		if txIntercepted.IsAddressedToOtherShards() {
 			log.Debug("intercepted tx is for other shards")
@@ -145,7 +159,7 @@ func (txi *TxInterceptor) ProcessReceivedMessage(message p2p.MessageP2P) error {
 		}
 
 		//TODO: check if throttler needs to be applied also on the following go routine.
-		go txi.processTransaction(txIntercepted)
+		go txi.processTransaction(txIntercepted, trace)
 	}
 
 	var buffToSend []byte
@@ -169,12 +183,38 @@ func (txi *TxInterceptor) SetBroadcastCallback(callback func(buffToSend []byte))
 	txi.broadcastCallbackHandler = callback
 }
 
-func (txi *TxInterceptor) processTransaction(tx *InterceptedTransaction) {
+// SetMessageTracer sets the tracer reporting the processing stages of the sampled received messages
+func (txi *TxInterceptor) SetMessageTracer(tracer process.MessageTracer) error {
+	if tracer == nil || tracer.IsInterfaceNil() {
+		return process.ErrNilMessageTracer
+	}
+
+	txi.mutTracer.Lock()
+	txi.tracer = tracer
+	txi.mutTracer.Unlock()
+
+	return nil
+}
+
+// Throttler returns the throttler limiting the number of messages processed at the same time
+func (txi *TxInterceptor) Throttler() process.InterceptorThrottler {
+	return txi.throttler
+}
+
+func (txi *TxInterceptor) startTrace(message p2p.MessageP2P) process.MessageTrace {
+	txi.mutTracer.RLock()
+	defer txi.mutTracer.RUnlock()
+
+	return txi.tracer.StartTrace(message)
+}
+
+func (txi *TxInterceptor) processTransaction(tx *InterceptedTransaction, trace process.MessageTrace) {
 	isTxValid := txi.txValidator.IsTxValidForProcessing(tx)
 	if !isTxValid {
 		log.Debug(fmt.Sprintf("intercepted tx with hash %s is not valid, total rejected txs %d", hex.EncodeToString(tx.hash), txi.txValidator.NumRejectedTxs()))
 		return
 	}
+	trace.MarkStage(tracing.StageValidated)
 
 	cacherIdentifier := process.ShardCacherIdentifier(tx.SndShard(), tx.RcvShard())
 	txi.txPool.AddData(
@@ -182,6 +222,7 @@ func (txi *TxInterceptor) processTransaction(tx *InterceptedTransaction) {
 		tx.Transaction(),
 		cacherIdentifier,
 	)
+	trace.MarkStage(tracing.StageAddedToPool)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -2,6 +2,7 @@ package transaction_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"math/big"
 	"testing"
@@ -10,8 +11,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	dataTransaction "github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/tracing"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/stretchr/testify/assert"
 )
@@ -327,6 +330,7 @@ func TestNewTxInterceptor_OkValsShouldWork(t *testing.T) {
 
 	assert.Nil(t, err)
 	assert.NotNil(t, txi)
+	assert.True(t, txi.Throttler() == throttler)
 }
 
 //------- ProcessReceivedMessage
@@ -359,7 +363,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageSystemBusyShouldErr(t *tes
 		&mock.FeeHandlerStub{},
 	)
 
-	err := txi.ProcessReceivedMessage(nil)
+	err := txi.ProcessReceivedMessage(context.Background(), nil)
 
 	assert.Equal(t, process.ErrSystemBusy, err)
 	assert.Equal(t, int32(0), throttler.StartProcessingCount())
@@ -394,7 +398,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageNilMesssageShouldErr(t *te
 		&mock.FeeHandlerStub{},
 	)
 
-	err := txi.ProcessReceivedMessage(nil)
+	err := txi.ProcessReceivedMessage(context.Background(), nil)
 
 	assert.Equal(t, process.ErrNilMessage, err)
 	assert.Equal(t, int32(1), throttler.EndProcessingCount())
@@ -431,7 +435,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageMilMessageDataShouldErr(t
 
 	msg := &mock.P2PMessageMock{}
 
-	err := txi.ProcessReceivedMessage(msg)
+	err := txi.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Equal(t, process.ErrNilDataToProcess, err)
 	assert.Equal(t, int32(1), throttler.EndProcessingCount())
@@ -476,7 +480,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageMarshalizerFailsAtUnmarsha
 		DataField: make([]byte, 0),
 	}
 
-	err := txi.ProcessReceivedMessage(msg)
+	err := txi.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Equal(t, errMarshalizer, err)
 	assert.Equal(t, int32(1), throttler.EndProcessingCount())
@@ -522,7 +526,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageNoTransactionInMessageShou
 		DataField: make([]byte, 0),
 	}
 
-	err := txi.ProcessReceivedMessage(msg)
+	err := txi.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Equal(t, process.ErrNoTransactionInMessage, err)
 	assert.Equal(t, int32(1), throttler.EndProcessingCount())
@@ -576,7 +580,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageIntegrityFailedShouldErr(t
 		DataField: buff,
 	}
 
-	err := txi.ProcessReceivedMessage(msg)
+	err := txi.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Equal(t, process.ErrNilSignature, err)
 	assert.Equal(t, int32(1), throttler.EndProcessingCount())
@@ -659,7 +663,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageIntegrityFailedWithTwoTxsS
 	txi.SetBroadcastCallback(func(buffToSend []byte) {
 		buff = buffToSend
 	})
-	err := txi.ProcessReceivedMessage(msg)
+	err := txi.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Equal(t, process.ErrNilSignature, err)
 	//unmarshal data and check there is only tx2 inside
@@ -731,7 +735,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageVerifySigFailsShouldErr(t
 		DataField: buff,
 	}
 
-	err := txi.ProcessReceivedMessage(msg)
+	err := txi.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Equal(t, errExpected, err)
 	assert.Equal(t, int32(1), throttler.EndProcessingCount())
@@ -806,7 +810,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageOkValsSameShardShouldWork(
 		}
 	}
 
-	err := txi.ProcessReceivedMessage(msg)
+	err := txi.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Nil(t, err)
 	select {
@@ -884,7 +888,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageOkValsOtherShardsShouldWor
 		}
 	}
 
-	err := txi.ProcessReceivedMessage(msg)
+	err := txi.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Nil(t, err)
 	select {
@@ -974,7 +978,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageTxNotValidShouldNotAdd(t *
 		}
 	}
 
-	err := txi.ProcessReceivedMessage(msg)
+	err := txi.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Nil(t, err)
 	select {
@@ -985,3 +989,180 @@ func TestTransactionInterceptor_ProcessReceivedMessageTxNotValidShouldNotAdd(t *
 	assert.Equal(t, int32(1), throttler.EndProcessingCount())
 	assert.Equal(t, int32(1), throttler.StartProcessingCount())
 }
+
+func TestTransactionInterceptor_SetMessageTracerNilTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txi, _ := transaction.NewTxInterceptor(
+		&mock.MarshalizerMock{},
+		&mock.ShardedDataStub{},
+		createMockedTxValidator(),
+		&mock.AddressConverterMock{},
+		mock.HasherMock{},
+		&mock.SignerMock{},
+		&mock.SingleSignKeyGenMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.InterceptorThrottlerStub{},
+		createFreeTxFeeHandler(),
+	)
+
+	err := txi.SetMessageTracer(nil)
+
+	assert.Equal(t, process.ErrNilMessageTracer, err)
+}
+
+func TestTransactionInterceptor_ProcessReceivedMessageShouldMarkTraceStages(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	keyGen := &mock.SingleSignKeyGenMock{
+		PublicKeyFromByteArrayCalled: func(b []byte) (key crypto.PublicKey, e error) {
+			return &mock.SingleSignPublicKey{}, nil
+		},
+	}
+	txValidator := &mock.TxValidatorStub{
+		IsTxValidForProcessingCalled: func(txHandler process.TxValidatorHandler) bool {
+			return true
+		},
+	}
+	signer := &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			return nil
+		},
+	}
+	throttler := &mock.InterceptorThrottlerStub{
+		CanProcessCalled: func() bool {
+			return true
+		},
+	}
+
+	txi, _ := transaction.NewTxInterceptor(
+		marshalizer,
+		&mock.ShardedDataStub{
+			AddDataCalled: func(key []byte, data interface{}, cacheId string) {},
+		},
+		txValidator,
+		&mock.AddressConverterMock{},
+		mock.HasherMock{},
+		signer,
+		keyGen,
+		mock.NewOneShardCoordinatorMock(),
+		throttler,
+		createFreeTxFeeHandler(),
+	)
+
+	chanStages := make(chan string, 10)
+	trace := &mock.MessageTraceStub{
+		MarkStageCalled: func(stage string) {
+			chanStages <- stage
+		},
+	}
+	_ = txi.SetMessageTracer(&mock.MessageTracerStub{
+		StartTraceCalled: func(message p2p.MessageP2P) process.MessageTrace {
+			return trace
+		},
+	})
+
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      "data",
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+	}
+	txBuff, _ := marshalizer.Marshal(tx)
+	buff, _ := marshalizer.Marshal([][]byte{txBuff})
+
+	err := txi.ProcessReceivedMessage(context.Background(), &mock.P2PMessageMock{DataField: buff})
+	assert.Nil(t, err)
+
+	expectedStages := []string{
+		tracing.StageUnmarshaled,
+		tracing.StageVerified,
+		tracing.StageValidated,
+		tracing.StageAddedToPool,
+	}
+	for _, expectedStage := range expectedStages {
+		select {
+		case stage := <-chanStages:
+			assert.Equal(t, expectedStage, stage)
+		case <-time.After(durTimeout):
+			assert.Fail(t, "timeout while waiting for stage "+expectedStage)
+			return
+		}
+	}
+}
+
+func TestTransactionInterceptor_ProcessReceivedMessageCanceledContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	wasAdded := false
+	txPool := &mock.ShardedDataStub{
+		AddDataCalled: func(key []byte, data interface{}, cacheId string) {
+			wasAdded = true
+		},
+	}
+
+	pubKey := &mock.SingleSignPublicKey{}
+	keyGen := &mock.SingleSignKeyGenMock{}
+	keyGen.PublicKeyFromByteArrayCalled = func(b []byte) (key crypto.PublicKey, e error) {
+		return pubKey, nil
+	}
+
+	wasVerified := false
+	signer := &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			wasVerified = true
+			return nil
+		},
+	}
+	throttler := &mock.InterceptorThrottlerStub{
+		CanProcessCalled: func() bool {
+			return true
+		},
+	}
+
+	txi, _ := transaction.NewTxInterceptor(
+		marshalizer,
+		txPool,
+		&mock.TxValidatorStub{
+			IsTxValidForProcessingCalled: func(txHandler process.TxValidatorHandler) bool {
+				return true
+			},
+		},
+		&mock.AddressConverterMock{},
+		mock.HasherMock{},
+		signer,
+		keyGen,
+		mock.NewOneShardCoordinatorMock(),
+		throttler,
+		createFreeTxFeeHandler(),
+	)
+
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      "data",
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+	}
+	txBuff, _ := marshalizer.Marshal(tx)
+	buff, _ := marshalizer.Marshal([][]byte{txBuff})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := txi.ProcessReceivedMessage(ctx, &mock.P2PMessageMock{DataField: buff})
+
+	assert.Equal(t, context.Canceled, err)
+	assert.False(t, wasVerified)
+	time.Sleep(time.Millisecond * 100)
+	assert.False(t, wasAdded)
+	assert.Equal(t, int32(1), throttler.EndProcessingCount())
+}
@@ -24,6 +24,8 @@ type txProcessor struct {
 	txTypeHandler    process.TxTypeHandler
 	shardCoordinator sharding.Coordinator
 	economicsFee     process.FeeHandler
+	validityWindow   process.TxValidityWindowChecker
+	epochHandler     process.EpochHandler
 }
 
 // NewTxProcessor creates a new txProcessor engine
@@ -37,6 +39,8 @@ func NewTxProcessor(
 	txFeeHandler process.TransactionFeeHandler,
 	txTypeHandler process.TxTypeHandler,
 	economicsFee process.FeeHandler,
+	validityWindow process.TxValidityWindowChecker,
+	epochHandler process.EpochHandler,
 ) (*txProcessor, error) {
 
 	if accounts == nil || accounts.IsInterfaceNil() {
@@ -66,6 +70,12 @@ func NewTxProcessor(
 	if economicsFee == nil || economicsFee.IsInterfaceNil() {
 		return nil, process.ErrNilEconomicsFeeHandler
 	}
+	if validityWindow == nil || validityWindow.IsInterfaceNil() {
+		return nil, process.ErrNilTxValidityWindowChecker
+	}
+	if epochHandler == nil || epochHandler.IsInterfaceNil() {
+		return nil, process.ErrNilEpochHandler
+	}
 
 	baseTxProcess := &baseTxProcessor{
 		accounts:         accounts,
@@ -81,6 +91,8 @@ func NewTxProcessor(
 		txFeeHandler:    txFeeHandler,
 		txTypeHandler:   txTypeHandler,
 		economicsFee:    economicsFee,
+		validityWindow:  validityWindow,
+		epochHandler:    epochHandler,
 	}, nil
 }
 
@@ -100,6 +112,15 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction, round
 		return err
 	}
 
+	// the validity window is only checked in the sender's shard, the destination shard executing the transaction
+	// once the sender's shard included it
+	if acntSnd != nil {
+		err = txProc.validityWindow.CheckValidityWindow(tx, roundIndex, txProc.epochHandler.Epoch())
+		if err != nil {
+			return err
+		}
+	}
+
 	err = txProc.checkTxValues(tx, acntSnd)
 	if err != nil {
 		return err
@@ -66,6 +66,8 @@ func createTxProcessor() txproc.TxProcessor {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	return txProc
@@ -86,6 +88,8 @@ func TestNewTxProcessor_NilAccountsShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	assert.Equal(t, process.ErrNilAccountsAdapter, err)
@@ -105,6 +109,8 @@ func TestNewTxProcessor_NilHasherShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	assert.Equal(t, process.ErrNilHasher, err)
@@ -124,6 +130,8 @@ func TestNewTxProcessor_NilAddressConverterMockShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	assert.Equal(t, process.ErrNilAddressConverter, err)
@@ -143,6 +151,8 @@ func TestNewTxProcessor_NilMarshalizerMockShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	assert.Equal(t, process.ErrNilMarshalizer, err)
@@ -162,6 +172,8 @@ func TestNewTxProcessor_NilShardCoordinatorMockShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
@@ -181,6 +193,8 @@ func TestNewTxProcessor_NilSCProcessorShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	assert.Equal(t, process.ErrNilSmartContractProcessor, err)
@@ -200,12 +214,56 @@ func TestNewTxProcessor_NilTxFeeHandlerShouldErr(t *testing.T) {
 		nil,
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	assert.Equal(t, process.ErrNilUnsignedTxHandler, err)
 	assert.Nil(t, txProc)
 }
 
+func TestNewTxProcessor_NilTxValidityWindowCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txProc, err := txproc.NewTxProcessor(
+		&mock.AccountsStub{},
+		mock.HasherMock{},
+		&mock.AddressConverterMock{},
+		&mock.MarshalizerMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.TxTypeHandlerMock{},
+		feeHandlerMock(),
+		nil,
+		&mock.EpochHandlerStub{},
+	)
+
+	assert.Equal(t, process.ErrNilTxValidityWindowChecker, err)
+	assert.Nil(t, txProc)
+}
+
+func TestNewTxProcessor_NilEpochHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txProc, err := txproc.NewTxProcessor(
+		&mock.AccountsStub{},
+		mock.HasherMock{},
+		&mock.AddressConverterMock{},
+		&mock.MarshalizerMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.TxTypeHandlerMock{},
+		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		nil,
+	)
+
+	assert.Equal(t, process.ErrNilEpochHandler, err)
+	assert.Nil(t, txProc)
+}
+
 func TestNewTxProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -219,6 +277,8 @@ func TestNewTxProcessor_OkValsShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	assert.Nil(t, err)
@@ -242,6 +302,8 @@ func TestTxProcessor_GetAddressErrAddressConvShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	addressConv.Fail = true
@@ -284,6 +346,8 @@ func TestTxProcessor_GetAccountsShouldErrNilAddressContainer(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	adr1 := mock.NewAddressMock([]byte{65})
@@ -311,6 +375,8 @@ func TestTxProcessor_GetAccountsMalfunctionAccountsShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	adr1 := mock.NewAddressMock([]byte{65})
@@ -355,6 +421,8 @@ func TestTxProcessor_GetAccountsOkValsSrcShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
@@ -408,6 +476,8 @@ func TestTxProcessor_GetAccountsOkValsDsthouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
@@ -446,6 +516,8 @@ func TestTxProcessor_GetAccountsOkValsShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	a1, a2, err := execTx.GetAccounts(adr1, adr2)
@@ -475,6 +547,8 @@ func TestTxProcessor_GetSameAccountShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	a1, a2, err := execTx.GetAccounts(adr1, adr1)
@@ -718,6 +792,8 @@ func TestTxProcessor_ProcessTransactionErrAddressConvShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	addressConv.Fail = true
@@ -741,6 +817,8 @@ func TestTxProcessor_ProcessTransactionMalfunctionAccountsShouldErr(t *testing.T
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	tx := transaction.Transaction{}
@@ -780,12 +858,116 @@ func TestTxProcessor_ProcessCheckNotPassShouldErr(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
 	assert.Equal(t, process.ErrHigherNonceInTransaction, err)
 }
 
+func TestTxProcessor_ProcessValidityWindowNotPassShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST")
+	tx.Value = big.NewInt(0)
+	tx.NotBeforeRound = 10
+
+	acntSrc, err := state.NewAccount(mock.NewAddressMock(tx.SndAddr), &mock.AccountTrackerStub{})
+	assert.Nil(t, err)
+	acntDst, err := state.NewAccount(mock.NewAddressMock(tx.RcvAddr), &mock.AccountTrackerStub{})
+	assert.Nil(t, err)
+
+	accounts := createAccountStub(tx.SndAddr, tx.RcvAddr, acntSrc, acntDst)
+
+	execTx, _ := txproc.NewTxProcessor(
+		accounts,
+		mock.HasherMock{},
+		&mock.AddressConverterMock{},
+		&mock.MarshalizerMock{},
+		mock.NewOneShardCoordinatorMock(),
+		&mock.SCProcessorMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.TxTypeHandlerMock{},
+		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{
+			CheckValidityWindowCalled: func(tx *transaction.Transaction, round uint64, epoch uint32) error {
+				assert.Equal(t, uint64(4), round)
+				assert.Equal(t, uint32(2), epoch)
+				return process.ErrTxNotYetValid
+			},
+		},
+		&mock.EpochHandlerStub{
+			EpochCalled: func() uint32 {
+				return 2
+			},
+		},
+	)
+
+	err = execTx.ProcessTransaction(&tx, 4)
+	assert.Equal(t, process.ErrTxNotYetValid, err)
+}
+
+func TestTxProcessor_ProcessValidityWindowShouldNotBeCheckedWhenAdrSrcIsNotInNodeShard(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewOneShardCoordinatorMock()
+
+	tx := transaction.Transaction{}
+	tx.Nonce = 1
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST")
+	tx.Value = big.NewInt(45)
+	tx.NotAfterRound = 2
+
+	shardCoordinator.ComputeIdCalled = func(container state.AddressContainer) uint32 {
+		if bytes.Equal(container.Bytes(), tx.SndAddr) {
+			return 1
+		}
+
+		return 0
+	}
+
+	tracker := &mock.AccountTrackerStub{
+		JournalizeCalled: func(entry state.JournalEntry) {
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			return nil
+		},
+	}
+	acntSrc, err := state.NewAccount(mock.NewAddressMock(tx.SndAddr), tracker)
+	assert.Nil(t, err)
+	acntDst, err := state.NewAccount(mock.NewAddressMock(tx.RcvAddr), tracker)
+	assert.Nil(t, err)
+
+	accounts := createAccountStub(tx.SndAddr, tx.RcvAddr, acntSrc, acntDst)
+
+	execTx, _ := txproc.NewTxProcessor(
+		accounts,
+		mock.HasherMock{},
+		&mock.AddressConverterMock{},
+		&mock.MarshalizerMock{},
+		shardCoordinator,
+		&mock.SCProcessorMock{},
+		&mock.UnsignedTxHandlerMock{},
+		&mock.TxTypeHandlerMock{},
+		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{
+			CheckValidityWindowCalled: func(tx *transaction.Transaction, round uint64, epoch uint32) error {
+				assert.Fail(t, "validity window should have not been checked")
+				return process.ErrTxExpired
+			},
+		},
+		&mock.EpochHandlerStub{},
+	)
+
+	err = execTx.ProcessTransaction(&tx, 4)
+	assert.Nil(t, err)
+}
+
 func TestTxProcessor_ProcessCheckShouldPassWhenAdrSrcIsNotInNodeShard(t *testing.T) {
 	t.Parallel()
 
@@ -834,6 +1016,8 @@ func TestTxProcessor_ProcessCheckShouldPassWhenAdrSrcIsNotInNodeShard(t *testing
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -880,6 +1064,8 @@ func TestTxProcessor_ProcessMoveBalancesShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -936,6 +1122,8 @@ func TestTxProcessor_ProcessMoveBalancesShouldPassWhenAdrSrcIsNotInNodeShard(t *
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -992,6 +1180,8 @@ func TestTxProcessor_ProcessIncreaseNonceShouldPassWhenAdrSrcIsNotInNodeShard(t
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1042,6 +1232,8 @@ func TestTxProcessor_ProcessOkValsShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1105,6 +1297,8 @@ func TestTxProcessor_MoveBalanceWithFeesShouldWork(t *testing.T) {
 		&mock.UnsignedTxHandlerMock{},
 		&mock.TxTypeHandlerMock{},
 		feeHandler,
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1173,6 +1367,8 @@ func TestTxProcessor_ProcessTransactionScTxShouldWork(t *testing.T) {
 			},
 		},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1234,6 +1430,8 @@ func TestTxProcessor_ProcessTransactionScTxShouldReturnErrWhenExecutionFails(t *
 			return process.SCInvoking, nil
 		}},
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
@@ -1307,6 +1505,8 @@ func TestTxProcessor_ProcessTransactionScTxShouldNotBeCalledWhenAdrDstIsNotInNod
 		&mock.UnsignedTxHandlerMock{},
 		computeType,
 		feeHandlerMock(),
+		&mock.TxValidityWindowCheckerStub{},
+		&mock.EpochHandlerStub{},
 	)
 
 	err = execTx.ProcessTransaction(&tx, 4)
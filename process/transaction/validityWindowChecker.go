@@ -0,0 +1,54 @@
+package transaction
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// validityWindowChecker checks the rounds and epochs window in which a transaction may be executed. The windows are
+// only accepted starting with the enable epoch
+type validityWindowChecker struct {
+	enableEpoch uint32
+}
+
+// NewValidityWindowChecker creates a new transaction validity window checker, enabling the windows from enableEpoch
+func NewValidityWindowChecker(enableEpoch uint32) *validityWindowChecker {
+	return &validityWindowChecker{
+		enableEpoch: enableEpoch,
+	}
+}
+
+// CheckValidityWindow returns nil if the transaction may be executed in the provided round and epoch. A transaction
+// without a validity window may always be executed
+func (vwc *validityWindowChecker) CheckValidityWindow(tx *transaction.Transaction, round uint64, epoch uint32) error {
+	if tx == nil || tx.IsInterfaceNil() {
+		return process.ErrNilTransaction
+	}
+	if !tx.HasValidityWindow() {
+		return nil
+	}
+	if epoch < vwc.enableEpoch {
+		return process.ErrTxValidityWindowNotEnabled
+	}
+
+	isExpired := (tx.NotAfterRound > 0 && round > tx.NotAfterRound) ||
+		(tx.NotAfterEpoch > 0 && epoch > tx.NotAfterEpoch)
+	if isExpired {
+		return process.ErrTxExpired
+	}
+
+	isNotYetValid := round < tx.NotBeforeRound || epoch < tx.NotBeforeEpoch
+	if isNotYetValid {
+		return process.ErrTxNotYetValid
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vwc *validityWindowChecker) IsInterfaceNil() bool {
+	if vwc == nil {
+		return true
+	}
+	return false
+}
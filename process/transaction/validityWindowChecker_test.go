@@ -0,0 +1,75 @@
+package transaction_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	txproc "github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidityWindowChecker_CheckNilTransactionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vwc := txproc.NewValidityWindowChecker(0)
+
+	assert.Equal(t, process.ErrNilTransaction, vwc.CheckValidityWindow(nil, 1, 1))
+	assert.False(t, vwc.IsInterfaceNil())
+}
+
+func TestValidityWindowChecker_CheckTxWithoutWindowShouldWork(t *testing.T) {
+	t.Parallel()
+
+	vwc := txproc.NewValidityWindowChecker(10)
+
+	assert.Nil(t, vwc.CheckValidityWindow(&transaction.Transaction{Nonce: 1}, 5, 2))
+}
+
+func TestValidityWindowChecker_CheckBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vwc := txproc.NewValidityWindowChecker(10)
+	tx := &transaction.Transaction{NotAfterRound: 100}
+
+	assert.Equal(t, process.ErrTxValidityWindowNotEnabled, vwc.CheckValidityWindow(tx, 5, 9))
+	assert.Nil(t, vwc.CheckValidityWindow(tx, 5, 10))
+}
+
+func TestValidityWindowChecker_CheckRoundsWindow(t *testing.T) {
+	t.Parallel()
+
+	vwc := txproc.NewValidityWindowChecker(0)
+	tx := &transaction.Transaction{NotBeforeRound: 10, NotAfterRound: 20}
+
+	assert.Equal(t, process.ErrTxNotYetValid, vwc.CheckValidityWindow(tx, 9, 0))
+	assert.Nil(t, vwc.CheckValidityWindow(tx, 10, 0))
+	assert.Nil(t, vwc.CheckValidityWindow(tx, 20, 0))
+	assert.Equal(t, process.ErrTxExpired, vwc.CheckValidityWindow(tx, 21, 0))
+}
+
+func TestValidityWindowChecker_CheckEpochsWindow(t *testing.T) {
+	t.Parallel()
+
+	vwc := txproc.NewValidityWindowChecker(0)
+	tx := &transaction.Transaction{NotBeforeEpoch: 2, NotAfterEpoch: 3}
+
+	assert.Equal(t, process.ErrTxNotYetValid, vwc.CheckValidityWindow(tx, 100, 1))
+	assert.Nil(t, vwc.CheckValidityWindow(tx, 100, 2))
+	assert.Nil(t, vwc.CheckValidityWindow(tx, 100, 3))
+	assert.Equal(t, process.ErrTxExpired, vwc.CheckValidityWindow(tx, 100, 4))
+}
+
+func TestValidityWindowChecker_CheckOpenEndedWindows(t *testing.T) {
+	t.Parallel()
+
+	vwc := txproc.NewValidityWindowChecker(0)
+
+	notBefore := &transaction.Transaction{NotBeforeRound: 10}
+	assert.Equal(t, process.ErrTxNotYetValid, vwc.CheckValidityWindow(notBefore, 9, 5))
+	assert.Nil(t, vwc.CheckValidityWindow(notBefore, 1000000, 5))
+
+	notAfter := &transaction.Transaction{NotAfterEpoch: 2}
+	assert.Nil(t, vwc.CheckValidityWindow(notAfter, 1, 0))
+	assert.Equal(t, process.ErrTxExpired, vwc.CheckValidityWindow(notAfter, 1, 3))
+}
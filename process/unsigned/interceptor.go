@@ -1,6 +1,8 @@
 package unsigned
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/core/logger"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -68,7 +70,7 @@ func NewUnsignedTxInterceptor(
 
 // ProcessReceivedMessage will be the callback func from the p2p.Messenger and will be called each time a new message was received
 // (for the topic this validator was registered to)
-func (utxi *UnsignedTxInterceptor) ProcessReceivedMessage(message p2p.MessageP2P) error {
+func (utxi *UnsignedTxInterceptor) ProcessReceivedMessage(ctx context.Context, message p2p.MessageP2P) error {
 	if message == nil || message.IsInterfaceNil() {
 		return process.ErrNilMessage
 	}
@@ -89,6 +91,10 @@ func (utxi *UnsignedTxInterceptor) ProcessReceivedMessage(message p2p.MessageP2P
 	filteredUTxsBuffs := make([][]byte, 0)
 	lastErrEncountered := error(nil)
 	for _, uTxBuff := range uTxsBuff {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
 		uTxIntercepted, err := NewInterceptedUnsignedTransaction(
 			uTxBuff,
 			utxi.marshalizer,
@@ -2,6 +2,7 @@ package unsigned_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"math/big"
 	"testing"
@@ -173,7 +174,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageNilMesssageShouldErr(t *te
 		mock.HasherMock{},
 		oneSharder)
 
-	err := scri.ProcessReceivedMessage(nil)
+	err := scri.ProcessReceivedMessage(context.Background(), nil)
 
 	assert.Equal(t, process.ErrNilMessage, err)
 }
@@ -196,7 +197,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageMilMessageDataShouldErr(t
 
 	msg := &mock.P2PMessageMock{}
 
-	err := scri.ProcessReceivedMessage(msg)
+	err := scri.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Equal(t, process.ErrNilDataToProcess, err)
 }
@@ -227,7 +228,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageMarshalizerFailsAtUnmarsha
 		DataField: make([]byte, 0),
 	}
 
-	err := scri.ProcessReceivedMessage(msg)
+	err := scri.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Equal(t, errMarshalizer, err)
 }
@@ -259,7 +260,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageNoTransactionInMessageShou
 		DataField: make([]byte, 0),
 	}
 
-	err := scri.ProcessReceivedMessage(msg)
+	err := scri.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Equal(t, process.ErrNoUnsignedTransactionInMessage, err)
 }
@@ -307,7 +308,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageOkValsSameShardShouldWork(
 		}
 	}
 
-	err := scri.ProcessReceivedMessage(msg)
+	err := scri.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Nil(t, err)
 	select {
@@ -361,7 +362,7 @@ func TestTransactionInterceptor_ProcessReceivedMessageOkValsOtherShardsShouldWor
 		}
 	}
 
-	err := scri.ProcessReceivedMessage(msg)
+	err := scri.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Nil(t, err)
 	select {
@@ -423,7 +424,7 @@ func TestTransactionInterceptor_ProcessReceivedMessagePresentInStorerShouldNotAd
 		}
 	}
 
-	err := scri.ProcessReceivedMessage(msg)
+	err := scri.ProcessReceivedMessage(context.Background(), msg)
 
 	assert.Nil(t, err)
 	select {
@@ -89,3 +89,21 @@ func (g *Genesis) InitialNodesBalances(shardCoordinator Coordinator, adrConv sta
 
 	return balances, nil
 }
+
+// ShardTotalSupply returns the sum of the initial balances of the accounts placed in the self shard
+func (g *Genesis) ShardTotalSupply(shardCoordinator Coordinator, adrConv state.AddressConverter) (*big.Int, error) {
+	balances, err := g.InitialNodesBalances(shardCoordinator, adrConv)
+	if err != nil {
+		return nil, err
+	}
+
+	totalSupply := big.NewInt(0)
+	for _, balance := range balances {
+		if balance == nil {
+			continue
+		}
+		totalSupply.Add(totalSupply, balance)
+	}
+
+	return totalSupply, nil
+}
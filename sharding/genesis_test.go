@@ -192,3 +192,22 @@ func TestGenesis_Initial5NodesBalancesGood(t *testing.T) {
 	assert.Equal(t, 3, len(inBalance))
 	assert.Nil(t, err)
 }
+
+func TestGenesis_ShardTotalSupplyNilShardCoordinatorShouldErr(t *testing.T) {
+	genesis := createGenesisTwoShard6NodesMeta()
+	adrConv := mock.NewAddressConverterFake(32, "")
+	totalSupply, err := genesis.ShardTotalSupply(nil, adrConv)
+
+	assert.Nil(t, totalSupply)
+	assert.Equal(t, sharding.ErrNilShardCoordinator, err)
+}
+
+func TestGenesis_ShardTotalSupplyShouldSumOnlyTheSelfShardBalances(t *testing.T) {
+	genesis := createGenesisTwoShard6NodesMeta()
+	shardCoordinator := mock.NewMultipleShardsCoordinatorFake(2, 1)
+	adrConv := mock.NewAddressConverterFake(32, "")
+	totalSupply, err := genesis.ShardTotalSupply(shardCoordinator, adrConv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(3*999), totalSupply)
+}
@@ -36,6 +36,10 @@ func (psh *PrometheusStatusHandler) InitMetrics() {
 	psh.addMetric(core.MetricNumConnectedPeers, "The current number of peers connected")
 	psh.addMetric(core.MetricIsSyncing, "The synchronization state. If it's in process of syncing will be 1"+
 		" and if it's synchronized will be 0")
+	psh.addMetric(core.MetricSeederConnectedPeers, "The current number of peers connected to the seeder")
+	psh.addMetric(core.MetricSeederRejectedConnections, "The number of connections rejected by the seeder")
+	psh.addMetric(core.MetricSeederPeerExchangeRequests, "The number of peer exchange requests served by the seeder")
+	psh.addMetric(core.MetricSeederDialBackRequests, "The number of dial back requests served by the seeder")
 
 	psh.prometheusGaugeMetrics.Range(func(key, value interface{}) bool {
 		gauge := value.(prometheus.Gauge)
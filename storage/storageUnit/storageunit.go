@@ -178,6 +178,11 @@ func (s *Unit) ClearCache() {
 	s.cacher.Clear()
 }
 
+// CachedItems returns the number of items currently held in the unit's cache
+func (s *Unit) CachedItems() int {
+	return s.cacher.Len()
+}
+
 // DestroyUnit cleans up the bloom filter, the cache, and the db
 func (s *Unit) DestroyUnit() error {
 	s.lock.Lock()
@@ -440,6 +440,18 @@ func TestClearCacheNotAffectPersist(t *testing.T) {
 	assert.Nil(t, err, "no error expected, but got %s", err)
 }
 
+func TestCachedItemsShouldReturnCacheLen(t *testing.T) {
+	s := initStorageUnitWithBloomFilter(t, 10)
+	_ = s.Put([]byte("key16"), []byte("value16"))
+	_ = s.Put([]byte("key17"), []byte("value17"))
+
+	assert.Equal(t, 2, s.CachedItems())
+
+	s.ClearCache()
+
+	assert.Equal(t, 0, s.CachedItems())
+}
+
 func TestDestroyUnitNoError(t *testing.T) {
 	s := initStorageUnitWithBloomFilter(t, 10)
 	err := s.DestroyUnit()